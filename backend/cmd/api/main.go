@@ -26,6 +26,7 @@ import (
 	"context"
 	"log"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,27 +36,42 @@ import (
 	barberturnUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/barberturn"
 	blockedtimeUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/blockedtime"
 	caixaUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/caixa"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarfeed"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarsync"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/categoria"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/categoriaproduto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/command"
 	commissionUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/commission"
 	customerUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/customer"
+	customerdebtUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/customerdebt"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/financial"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/meiopagamento"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/metas"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/noshow"
 	planUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/plan"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/pricing"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/resource"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/servico"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/stock"
 	subscriptionUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/subscription"
 	unitUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/unit"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/waitlist"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/walkin"
+	webhookUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/webhook"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/bankstatement"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/gateway/asaas"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/gateway/googlecalendar"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/http/handler"
 	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/mail"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/metrics"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/outboundwebhook"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/repository/postgres"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/scheduler"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/telemetry"
 	"github.com/getsentry/sentry-go"
 	sentryecho "github.com/getsentry/sentry-go/echo"
 	"github.com/go-playground/validator/v10"
@@ -64,6 +80,7 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"go.uber.org/zap"
 
@@ -102,6 +119,19 @@ func main() {
 	}
 	defer sentry.Flush(2 * time.Second)
 
+	// Initialize OpenTelemetry (OTEL_TRACES_EXPORTER=otlp|stdout|none)
+	shutdownTelemetry, err := telemetry.Setup(context.Background(), logger)
+	if err != nil {
+		logger.Fatal("Erro ao inicializar telemetria", zap.Error(err))
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTelemetry(shutdownCtx); err != nil {
+			logger.Warn("Erro ao finalizar telemetria", zap.Error(err))
+		}
+	}()
+
 	// Load environment
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -123,6 +153,8 @@ func main() {
 		// Ajuda quando DATABASE_URL aponta para poolers (ex.: PgBouncer/Neon) que têm problemas com prepared statements.
 		poolCfg.ConnConfig.DefaultQueryExecMode = pgx.QueryExecModeSimpleProtocol
 	}
+	// Um span por query (nome da query sqlc)
+	poolCfg.ConnConfig.Tracer = telemetry.NewPgxTracer()
 	dbPool, err := pgxpool.NewWithConfig(ctx, poolCfg)
 	if err != nil {
 		logger.Fatal("Erro ao conectar ao banco de dados", zap.Error(err))
@@ -165,6 +197,13 @@ func main() {
 
 	// Appointment repositories and readers
 	appointmentRepo := postgres.NewAppointmentRepository(queries, dbPool)
+	appointmentSeriesRepo := postgres.NewAppointmentSeriesRepository(queries)
+	appointmentStatusHistoryRepo := postgres.NewAppointmentStatusHistoryRepository(queries)
+	waitlistRepo := postgres.NewWaitlistRepository(queries)
+	noShowRepo := postgres.NewNoShowRepository(queries)
+	resourceRepo := postgres.NewResourceRepository(queries, dbPool)
+	walkInRepo := postgres.NewWalkInRepository(queries)
+	calendarFeedRepo := postgres.NewCalendarFeedRepository(queries)
 	professionalReader := postgres.NewProfessionalReader(queries)
 	customerReader := postgres.NewCustomerReader(queries)
 	serviceReader := postgres.NewServiceReader(queries)
@@ -204,6 +243,13 @@ func main() {
 	// Caixa Diário repository
 	caixaDiarioRepo := postgres.NewCaixaDiarioRepository(queries)
 
+	// Fiado (dívidas de clientes) repository
+	customerDebtRepo := postgres.NewCustomerDebtRepository(queries, dbPool)
+
+	// Conciliação bancária (extratos OFX/CSV) repository
+	bankStatementRepo := postgres.NewBankStatementRepository(queries)
+	planoContasRepo := postgres.NewPlanoContasRepository(queries)
+
 	// Unit repositories
 	unitRepo := postgres.NewUnitRepository(queries)
 	userUnitRepo := postgres.NewUserUnitRepository(queries)
@@ -221,6 +267,10 @@ func main() {
 	}, logger)
 	asaasGateway := asaas.NewGatewayAdapter(asaasClient, logger)
 
+	// Webhooks de saída: os use cases publicam eventos de domínio e o
+	// scheduler envia as entregas aos endpoints dos tenants
+	eventPublisher := outboundwebhook.NewPublisher(queries, logger)
+
 	// Initialize use cases - Meta Mensal
 	setMetaMensalUC := metas.NewSetMetaMensalUseCase(metaMensalRepo, logger)
 	getMetaMensalUC := metas.NewGetMetaMensalUseCase(metaMensalRepo, logger)
@@ -292,6 +342,19 @@ func main() {
 	toggleDespesaFixaUC := financial.NewToggleDespesaFixaUseCase(despesaFixaRepo, logger)
 	deleteDespesaFixaUC := financial.NewDeleteDespesaFixaUseCase(despesaFixaRepo, logger)
 	gerarContasFromDespesasUC := financial.NewGerarContasFromDespesasFixasUseCase(despesaFixaRepo, contaPagarRepo, logger)
+	// Conciliação bancária (7 use cases)
+	importarExtratoUC := financial.NewImportarExtratoUseCase(bankStatementRepo, bankstatement.NewParser(), compensacaoRepo, contaReceberRepo, contaPagarRepo, marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger)
+	listImportacoesExtratoUC := financial.NewListImportacoesExtratoUseCase(bankStatementRepo)
+	getImportacaoExtratoUC := financial.NewGetImportacaoExtratoUseCase(bankStatementRepo)
+	listRevisaoConciliacaoUC := financial.NewListRevisaoConciliacaoUseCase(bankStatementRepo)
+	conciliarLinhaManualUC := financial.NewConciliarLinhaManualUseCase(bankStatementRepo, compensacaoRepo, contaReceberRepo, contaPagarRepo, marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger)
+	aceitarDivergenciaUC := financial.NewAceitarDivergenciaUseCase(bankStatementRepo, compensacaoRepo, contaReceberRepo, contaPagarRepo, marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger)
+	ignorarLinhaExtratoUC := financial.NewIgnorarLinhaExtratoUseCase(bankStatementRepo, logger)
+	// Exportação contábil (4 use cases)
+	listPlanoContasUC := financial.NewListPlanoContasUseCase(planoContasRepo)
+	salvarMapeamentoContabilUC := financial.NewSalvarMapeamentoContabilUseCase(planoContasRepo, logger)
+	removerMapeamentoContabilUC := financial.NewRemoverMapeamentoContabilUseCase(planoContasRepo)
+	gerarLancamentosContabeisUC := financial.NewGerarLancamentosContabeisUseCase(planoContasRepo, contaPagarRepo, contaReceberRepo, caixaDiarioRepo, compensacaoRepo, meioPagamentoRepo, logger)
 	// Dashboard e Projeções (2 use cases)
 	getPainelMensalUC := financial.NewGetPainelMensalUseCase(contaPagarRepo, contaReceberRepo, despesaFixaRepo, metaMensalRepo, fluxoCaixaRepo, logger)
 	getProjecoesUC := financial.NewGetProjecoesUseCase(contaPagarRepo, contaReceberRepo, despesaFixaRepo, logger)
@@ -314,19 +377,110 @@ func main() {
 	// Initialize use cases - Stock (5 use cases)
 	criarProdutoUC := stock.NewCriarProdutoUseCase(produtoRepo, fornecedorRepo)
 	registrarEntradaUC := stock.NewRegistrarEntradaUseCase(produtoRepo, movimentacaoRepo, fornecedorRepo)
-	registrarSaidaUC := stock.NewRegistrarSaidaUseCase(produtoRepo, movimentacaoRepo)
-	ajustarEstoqueUC := stock.NewAjustarEstoqueUseCase(produtoRepo, movimentacaoRepo)
+	registrarSaidaUC := stock.NewRegistrarSaidaUseCase(produtoRepo, movimentacaoRepo, eventPublisher, logger)
+	ajustarEstoqueUC := stock.NewAjustarEstoqueUseCase(produtoRepo, movimentacaoRepo, eventPublisher, logger)
 	listarAlertasUC := stock.NewListarAlertasEstoqueBaixoUseCase(produtoRepo)
 
+	// Emails transacionais (recuperação de senha, convites, lista de espera)
+	// Sem SMTP_HOST os emails são gravados em MAIL_DIR (desenvolvimento)
+	mailSender := mail.NewSender(mail.ConfigFromEnv(), logger)
+	appURL := os.Getenv("APP_URL")
+	if appURL == "" {
+		appURL = "http://localhost:3000"
+	}
+
+	// Segredos TOTP e credenciais do Google Agenda cifrados com
+	// TOTP_ENCRYPTION_KEY (fallback JWT_SECRET em desenvolvimento)
+	totpCipher, err := auth.NewSecretCipher()
+	if err != nil {
+		logger.Fatal("Erro ao inicializar cifra TOTP", zap.Error(err))
+	}
+
+	// Sincronização com o Google Agenda: agendamentos entram numa fila de
+	// envio; sem GOOGLE_CALENDAR_* configurado a integração fica desativada
+	calendarSyncRepo := postgres.NewCalendarSyncRepository(queries, totpCipher)
+	calendarProvider := googlecalendar.NewProvider(googlecalendar.ConfigFromEnv(), logger)
+	calendarSyncNotifier := calendarsync.NewNotifier(calendarSyncRepo, logger)
+
+	// Initialize use cases - Lista de espera: horários liberados por
+	// cancelamento, remarcação ou não comparecimento são oferecidos por email
+	waitlistHold := waitlist.DefaultOfferHold
+	if minutos, err := strconv.Atoi(os.Getenv("WAITLIST_OFFER_HOLD_MINUTES")); err == nil && minutos > 0 {
+		waitlistHold = time.Duration(minutos) * time.Minute
+	}
+	waitlistNotifier := mail.NewWaitlistNotifier(mailSender, appURL)
+	offerWaitlistSlotUC := waitlist.NewOfferSlotUseCase(waitlistRepo, appointmentRepo, serviceReader, professionalReader, customerReader, waitlistNotifier, waitlistHold, logger)
+
+	// Initialize use cases - Não comparecimento: sinal PIX exigido ao agendar,
+	// creditado na comanda ou retido conforme o comparecimento
+	requireDepositUC := noshow.NewRequireDepositUseCase(noShowRepo, appointmentRepo, customerRepo, asaasGateway, logger)
+	settleDepositUC := noshow.NewSettleDepositUseCase(noShowRepo, commandRepo, meioPagamentoRepo, contaReceberRepo, asaasGateway, logger)
+
 	// Initialize use cases - Appointments (7 use cases)
 	// G-001: createAppointmentUC agora recebe commandRepo para criar comanda automaticamente
-	createAppointmentUC := appointment.NewCreateAppointmentUseCase(appointmentRepo, commandRepo, serviceReader, professionalReader, customerReader, logger)
+	createAppointmentUC := appointment.NewCreateAppointmentUseCase(appointmentRepo, commandRepo, serviceReader, professionalReader, customerReader, resourceRepo, eventPublisher, appointment.BookingListeners{requireDepositUC, calendarSyncNotifier}, logger)
 	listAppointmentsUC := appointment.NewListAppointmentsUseCase(appointmentRepo, logger)
 	getAppointmentUC := appointment.NewGetAppointmentUseCase(appointmentRepo, logger)
-	updateAppointmentStatusUC := appointment.NewUpdateAppointmentStatusUseCase(appointmentRepo, commandRepo, logger)
-	rescheduleAppointmentUC := appointment.NewRescheduleAppointmentUseCase(appointmentRepo, professionalReader, logger)
-	cancelAppointmentUC := appointment.NewCancelAppointmentUseCase(appointmentRepo, logger)
-	finishWithCommandUC := appointment.NewFinishServiceWithCommandUseCase(appointmentRepo, commandRepo, logger)
+	attendanceListeners := appointment.AttendanceListeners{settleDepositUC, calendarSyncNotifier}
+	updateAppointmentStatusUC := appointment.NewUpdateAppointmentStatusUseCase(appointmentRepo, commandRepo, eventPublisher, appointmentStatusHistoryRepo, offerWaitlistSlotUC, attendanceListeners, logger)
+	rescheduleAppointmentUC := appointment.NewRescheduleAppointmentUseCase(appointmentRepo, professionalReader, appointmentSeriesRepo, resourceRepo, offerWaitlistSlotUC, calendarSyncNotifier, logger)
+	cancelAppointmentUC := appointment.NewCancelAppointmentUseCase(appointmentRepo, appointmentSeriesRepo, eventPublisher, appointmentStatusHistoryRepo, offerWaitlistSlotUC, attendanceListeners, logger)
+	finishWithCommandUC := appointment.NewFinishServiceWithCommandUseCase(appointmentRepo, commandRepo, eventPublisher, appointmentStatusHistoryRepo, attendanceListeners, logger)
+
+	// Initialize use cases - Não comparecimento (política, confiabilidade e sinais)
+	getNoShowPolicyUC := noshow.NewGetPolicyUseCase(noShowRepo, logger)
+	updateNoShowPolicyUC := noshow.NewUpdatePolicyUseCase(noShowRepo, meioPagamentoRepo, logger)
+	getCustomerReliabilityUC := noshow.NewGetReliabilityUseCase(noShowRepo, logger)
+	getAppointmentDepositUC := noshow.NewGetDepositUseCase(noShowRepo, logger)
+	confirmDepositPaymentUC := noshow.NewConfirmDepositPaymentUseCase(noShowRepo, logger)
+	expireDepositsUC := noshow.NewExpireDepositsUseCase(noShowRepo, asaasGateway, confirmDepositPaymentUC, cancelAppointmentUC, logger)
+
+	// Initialize use cases - Appointment Series (agendamentos recorrentes)
+	createAppointmentSeriesUC := appointment.NewCreateAppointmentSeriesUseCase(appointmentSeriesRepo, createAppointmentUC, logger)
+	getAppointmentSeriesUC := appointment.NewGetAppointmentSeriesUseCase(appointmentSeriesRepo, logger)
+	listAppointmentSeriesUC := appointment.NewListAppointmentSeriesUseCase(appointmentSeriesRepo, logger)
+	endAppointmentSeriesUC := appointment.NewEndAppointmentSeriesUseCase(appointmentSeriesRepo, appointmentRepo, eventPublisher, appointmentStatusHistoryRepo, offerWaitlistSlotUC, attendanceListeners, logger)
+
+	// Histórico de status e indicadores de tempo da agenda
+	listAppointmentStatusHistoryUC := appointment.NewListStatusHistoryUseCase(appointmentRepo, appointmentStatusHistoryRepo, logger)
+	getAppointmentTimingUC := appointment.NewGetTimingAnalyticsUseCase(appointmentStatusHistoryRepo, logger)
+	generateAppointmentSeriesUC := appointment.NewGenerateAppointmentSeriesUseCase(appointmentSeriesRepo, createAppointmentUC, logger)
+
+	// Initialize use cases - Fila de encaixe (clientes sem horário pela lista da vez)
+	checkInWalkInUC := walkin.NewCheckInUseCase(walkInRepo, barberTurnRepo, appointmentRepo, customerReader, serviceReader, createAppointmentUC, updateAppointmentStatusUC, logger)
+	listWalkInQueueUC := walkin.NewListQueueUseCase(walkInRepo, barberTurnRepo, appointmentRepo, logger)
+	assignNextWalkInUC := walkin.NewAssignNextUseCase(walkInRepo, barberTurnRepo, appointmentRepo, createAppointmentUC, updateAppointmentStatusUC, logger)
+	cancelWalkInUC := walkin.NewCancelUseCase(walkInRepo, logger)
+
+	// Initialize use cases - Feeds .ics da agenda (assinatura no celular)
+	createCalendarFeedUC := calendarfeed.NewCreateFeedUseCase(calendarFeedRepo, professionalReader, logger)
+	listCalendarFeedsUC := calendarfeed.NewListFeedsUseCase(calendarFeedRepo, logger)
+	revokeCalendarFeedUC := calendarfeed.NewRevokeFeedUseCase(calendarFeedRepo, logger)
+	getCalendarFeedUC := calendarfeed.NewGetFeedUseCase(calendarFeedRepo, appointmentRepo, professionalReader, unitRepo, logger)
+
+	// Initialize use cases - Google Agenda (conexão, envio e leitura)
+	startCalendarConnectUC := calendarsync.NewStartConnectUseCase(calendarProvider, professionalReader, totpCipher, logger)
+	completeCalendarConnectUC := calendarsync.NewCompleteConnectUseCase(calendarSyncRepo, calendarProvider, totpCipher, logger)
+	listCalendarConnectionsUC := calendarsync.NewListConnectionsUseCase(calendarSyncRepo, logger)
+	disconnectCalendarUC := calendarsync.NewDisconnectUseCase(calendarSyncRepo, calendarProvider, logger)
+	pushCalendarUC := calendarsync.NewPushUseCase(calendarSyncRepo, calendarProvider, appointmentRepo, logger)
+	pullCalendarUC := calendarsync.NewPullUseCase(calendarSyncRepo, calendarProvider, appointmentRepo, blockedTimeRepo, logger)
+
+	// Initialize use cases - Recursos da unidade (cadeiras, salas, lavatório)
+	createResourceUC := resource.NewCreateResourceUseCase(resourceRepo, logger)
+	listResourcesUC := resource.NewListResourcesUseCase(resourceRepo, logger)
+	updateResourceUC := resource.NewUpdateResourceUseCase(resourceRepo, logger)
+	getServiceResourcesUC := resource.NewGetServiceResourcesUseCase(resourceRepo, logger)
+	setServiceResourcesUC := resource.NewSetServiceResourcesUseCase(resourceRepo, serviceReader, logger)
+
+	// Initialize use cases - Lista de espera (entradas e link da oferta)
+	createWaitlistEntryUC := waitlist.NewCreateEntryUseCase(waitlistRepo, customerReader, serviceReader, professionalReader, logger)
+	listWaitlistEntriesUC := waitlist.NewListEntriesUseCase(waitlistRepo, logger)
+	cancelWaitlistEntryUC := waitlist.NewCancelEntryUseCase(waitlistRepo, offerWaitlistSlotUC, logger)
+	getWaitlistOfferUC := waitlist.NewGetOfferUseCase(waitlistRepo, logger)
+	claimWaitlistOfferUC := waitlist.NewClaimOfferUseCase(waitlistRepo, createAppointmentUC, logger)
+	declineWaitlistOfferUC := waitlist.NewDeclineOfferUseCase(waitlistRepo, offerWaitlistSlotUC, logger)
+	expireWaitlistOffersUC := waitlist.NewExpireOffersUseCase(waitlistRepo, offerWaitlistSlotUC, logger)
 
 	// Initialize use cases - Blocked Times (3 use cases)
 	createBlockedTimeUC := blockedtimeUC.NewCreateBlockedTimeUseCase(blockedTimeRepo)
@@ -338,7 +492,7 @@ func main() {
 
 	// Initialize use cases - Commands (10 use cases)
 	createCommandUC := command.NewCreateCommandUseCase(commandRepo, commandMapper)
-	getCommandUC := command.NewGetCommandUseCase(commandRepo, commandMapper)
+	getCommandUC := command.NewGetCommandUseCase(commandRepo, customerDebtRepo, commandMapper, logger)
 	listCommandsUC := command.NewListCommandsUseCase(commandRepo, commandMapper)
 	getCommandByAppointmentUC := command.NewGetCommandByAppointmentUseCase(commandRepo, commandMapper)
 	// T-EST-001: Validação de estoque ao adicionar item PRODUTO
@@ -346,7 +500,7 @@ func main() {
 	removeCommandItemUC := command.NewRemoveCommandItemUseCase(commandRepo, commandMapper)
 	addCommandPaymentUC := command.NewAddCommandPaymentUseCase(commandRepo, meioPagamentoRepo, commandMapper)
 	removeCommandPaymentUC := command.NewRemoveCommandPaymentUseCase(commandRepo, commandMapper)
-	closeCommandUC := command.NewCloseCommandUseCase(commandRepo, appointmentRepo, commandMapper)
+	closeCommandUC := command.NewCloseCommandUseCase(commandRepo, appointmentRepo, commandMapper, eventPublisher, appointmentStatusHistoryRepo, logger)
 	// T-EST-002, T-COM-001: Finalização integrada com estoque e comissões
 	// COM-001: Agora com hierarquia de 4 níveis para regras de comissão
 	finalizarComandaIntegradaUC := command.NewFinalizarComandaIntegradaUseCase(
@@ -363,6 +517,8 @@ func main() {
 		serviceReader,      // COM-001: Para buscar comissão do serviço
 		professionalReader, // COM-001: Para buscar comissão do profissional
 		commandMapper,
+		eventPublisher,
+		appointmentStatusHistoryRepo, // Histórico de status do agendamento concluído
 		logger,
 	)
 	// T-EST-003: Cancelamento de comanda com reversão de estoque
@@ -377,13 +533,24 @@ func main() {
 		logger,
 	)
 
+	// Initialize use cases - Fiado (3 use cases)
+	listDividasClienteUC := customerdebtUC.NewListDividasClienteUseCase(customerDebtRepo)
+	registrarPagamentoDividaUC := customerdebtUC.NewRegistrarPagamentoUseCase(
+		customerDebtRepo,
+		commandRepo,
+		meioPagamentoRepo,
+		caixaDiarioRepo,
+		logger,
+	)
+	getAgingFiadoUC := customerdebtUC.NewGetAgingUseCase(customerDebtRepo)
+
 	// Initialize use cases - Customer (12 use cases)
 	createCustomerUC := customerUC.NewCreateCustomerUseCase(customerRepo, logger)
 	updateCustomerUC := customerUC.NewUpdateCustomerUseCase(customerRepo, logger)
 	listCustomersUC := customerUC.NewListCustomersUseCase(customerRepo, logger)
 	getCustomerUC := customerUC.NewGetCustomerUseCase(customerRepo, logger)
 	getCustomerWithHistoryUC := customerUC.NewGetCustomerWithHistoryUseCase(customerRepo, logger)
-	inactivateCustomerUC := customerUC.NewInactivateCustomerUseCase(customerRepo, logger)
+	inactivateCustomerUC := customerUC.NewInactivateCustomerUseCase(customerRepo, endAppointmentSeriesUC, logger)
 	searchCustomersUC := customerUC.NewSearchCustomersUseCase(customerRepo, logger)
 	exportCustomerDataUC := customerUC.NewExportCustomerDataUseCase(customerRepo, logger)
 	getCustomerStatsUC := customerUC.NewGetCustomerStatsUseCase(customerRepo, logger)
@@ -439,7 +606,7 @@ func main() {
 	cancelSubscriptionUC := subscriptionUC.NewCancelSubscriptionUseCase(subscriptionRepo, asaasGateway, logger)
 	renewSubscriptionUC := subscriptionUC.NewRenewSubscriptionUseCase(subscriptionRepo)
 	subscriptionMetricsUC := subscriptionUC.NewGetSubscriptionMetricsUseCase(subscriptionRepo)
-	overdueSubscriptionsUC := subscriptionUC.NewProcessOverdueSubscriptionsUseCase(subscriptionRepo)
+	overdueSubscriptionsUC := subscriptionUC.NewProcessOverdueSubscriptionsUseCase(subscriptionRepo, eventPublisher, logger)
 	// processWebhookUC (V1) mantido para retrocompatibilidade se necessário
 	_ = subscriptionUC.NewProcessWebhookUseCase(subscriptionRepo, subscriptionPaymentRepo, logger)
 	// T-ASAAS-001: ProcessWebhookV2 agora lança no caixa quando PAYMENT_RECEIVED
@@ -449,6 +616,7 @@ func main() {
 		contaReceberRepo,
 		webhookLogRepo,
 		caixaDiarioRepo, // T-ASAAS-001: Adicionar caixa para lançar pagamentos
+		eventPublisher,
 		logger,
 	)
 	// T-ASAAS-002: Reconciliação automática Asaas <-> NEXO
@@ -468,15 +636,18 @@ func main() {
 	deleteMeioPagamentoUC := meiopagamento.NewDeleteMeioPagamentoUseCase(meioPagamentoRepo)
 	toggleMeioPagamentoUC := meiopagamento.NewToggleMeioPagamentoUseCase(meioPagamentoRepo)
 
-	// Initialize use cases - Caixa Diário (8 use cases)
+	// Initialize use cases - Caixa Diário (11 use cases)
 	abrirCaixaUC := caixaUC.NewAbrirCaixaUseCase(caixaDiarioRepo, logger)
 	sangriaUC := caixaUC.NewSangriaUseCase(caixaDiarioRepo, contaPagarRepo, logger)
 	reforcoUC := caixaUC.NewReforcoUseCase(caixaDiarioRepo, logger)
-	fecharCaixaUC := caixaUC.NewFecharCaixaUseCase(caixaDiarioRepo, logger)
+	fecharCaixaUC := caixaUC.NewFecharCaixaUseCase(caixaDiarioRepo, eventPublisher, logger)
 	getCaixaAbertoUC := caixaUC.NewGetCaixaAbertoUseCase(caixaDiarioRepo, logger)
 	getCaixaByIDUC := caixaUC.NewGetCaixaByIDUseCase(caixaDiarioRepo, logger)
 	listHistoricoCaixaUC := caixaUC.NewListHistoricoUseCase(caixaDiarioRepo, logger)
 	getTotaisCaixaUC := caixaUC.NewGetTotaisCaixaUseCase(caixaDiarioRepo, logger)
+	listFechamentosPendentesUC := caixaUC.NewListFechamentosPendentesUseCase(caixaDiarioRepo)
+	aprovarFechamentoUC := caixaUC.NewAprovarFechamentoUseCase(caixaDiarioRepo, eventPublisher, logger)
+	rejeitarFechamentoUC := caixaUC.NewRejeitarFechamentoUseCase(caixaDiarioRepo, logger)
 
 	// Initialize use cases - Commission (31 use cases)
 	// Commission Rules (7)
@@ -531,11 +702,70 @@ func main() {
 	// Initialize JWT Manager
 	jwtManager := auth.NewJWTManager()
 
-	// Initialize use cases - Auth (4 use cases)
+	// Initialize use cases - Auth (7 use cases)
 	loginUC := authUC.NewLoginUseCase(queries, jwtManager, logger)
 	refreshUC := authUC.NewRefreshUseCase(queries, jwtManager, logger)
+	switchUnitUC := authUC.NewSwitchUnitUseCase(queries, jwtManager, logger)
 	meUC := authUC.NewMeUseCase(queries, logger)
 	logoutUC := authUC.NewLogoutUseCase(queries, logger)
+	listSessionsUC := authUC.NewListSessionsUseCase(queries, logger)
+	revokeSessionUC := authUC.NewRevokeSessionUseCase(queries, logger)
+	revokeOtherSessionsUC := authUC.NewRevokeOtherSessionsUseCase(queries, logger)
+
+	// Initialize use cases - 2FA (8 use cases)
+	loginMFAUC := authUC.NewLoginMFAUseCase(queries, jwtManager, totpCipher, logger)
+	loginMFASetupUC := authUC.NewLoginMFASetupUseCase(queries, totpCipher, logger)
+	twoFactorStatusUC := authUC.NewGetTwoFactorStatusUseCase(queries, logger)
+	setupTwoFactorUC := authUC.NewSetupTwoFactorUseCase(queries, totpCipher, logger)
+	confirmTwoFactorUC := authUC.NewConfirmTwoFactorUseCase(queries, totpCipher, logger)
+	disableTwoFactorUC := authUC.NewDisableTwoFactorUseCase(queries, totpCipher, logger)
+	regenerateRecoveryCodesUC := authUC.NewRegenerateRecoveryCodesUseCase(queries, totpCipher, logger)
+	updateTwoFactorPolicyUC := authUC.NewUpdateTwoFactorPolicyUseCase(queries, logger)
+
+	// Initialize use cases - Papéis personalizados (5 use cases)
+	listTenantRolesUC := authUC.NewListTenantRolesUseCase(queries, logger)
+	createTenantRoleUC := authUC.NewCreateTenantRoleUseCase(queries, logger)
+	updateTenantRoleUC := authUC.NewUpdateTenantRoleUseCase(queries, logger)
+	deleteTenantRoleUC := authUC.NewDeleteTenantRoleUseCase(queries, logger)
+	assignTenantRoleUC := authUC.NewAssignTenantRoleUseCase(queries, logger)
+
+	// Initialize use cases - Chaves de API de integrações
+	listAPIKeysUC := authUC.NewListAPIKeysUseCase(queries, logger)
+	createAPIKeyUC := authUC.NewCreateAPIKeyUseCase(queries, logger)
+	revokeAPIKeyUC := authUC.NewRevokeAPIKeyUseCase(queries, logger)
+	authenticateAPIKeyUC := authUC.NewAuthenticateAPIKeyUseCase(queries, logger)
+
+	// Initialize use cases - Webhooks de saída (segredos HMAC com a mesma cifra do TOTP)
+	listWebhookEndpointsUC := webhookUC.NewListWebhookEndpointsUseCase(queries, logger)
+	createWebhookEndpointUC := webhookUC.NewCreateWebhookEndpointUseCase(queries, totpCipher, logger)
+	updateWebhookEndpointUC := webhookUC.NewUpdateWebhookEndpointUseCase(queries, logger)
+	rotateWebhookSecretUC := webhookUC.NewRotateWebhookSecretUseCase(queries, totpCipher, logger)
+	deleteWebhookEndpointUC := webhookUC.NewDeleteWebhookEndpointUseCase(queries, logger)
+	listWebhookDeliveriesUC := webhookUC.NewListWebhookDeliveriesUseCase(queries, logger)
+	getWebhookDeliveryUC := webhookUC.NewGetWebhookDeliveryUseCase(queries, logger)
+	redeliverWebhookUC := webhookUC.NewRedeliverWebhookUseCase(queries, logger)
+	webhookDispatcher := outboundwebhook.NewDispatcher(queries, totpCipher, logger)
+
+	// Initialize use cases - Recuperação de senha e convites (7 use cases)
+	forgotPasswordUC := authUC.NewForgotPasswordUseCase(queries, mailSender, appURL, logger)
+	resetPasswordUC := authUC.NewResetPasswordUseCase(queries, logger)
+	createInvitationUC := authUC.NewCreateInvitationUseCase(queries, mailSender, appURL, logger)
+	listInvitationsUC := authUC.NewListInvitationsUseCase(queries)
+	revokeInvitationUC := authUC.NewRevokeInvitationUseCase(queries, logger)
+	previewInvitationUC := authUC.NewPreviewInvitationUseCase(queries)
+	acceptInvitationUC := authUC.NewAcceptInvitationUseCase(dbPool, queries, logger)
+
+	// Rate limiting: store Postgres (compartilhado entre réplicas) por padrão;
+	// RATE_LIMIT_STORE=memory mantém os contadores no processo (desenvolvimento)
+	var rateLimitStore mw.TokenBucketStore
+	var rateLimitPurger *postgres.RateLimitStorePG
+	if strings.EqualFold(os.Getenv("RATE_LIMIT_STORE"), "memory") {
+		rateLimitStore = mw.NewMemoryRateLimitStore()
+	} else {
+		rateLimitPurger = postgres.NewRateLimitStore(queries)
+		rateLimitStore = rateLimitPurger
+	}
+	rateLimiter := mw.NewRateLimiter(rateLimitStore, logger)
 
 	// Initialize scheduler for cron jobs
 	sched := scheduler.New(logger)
@@ -560,6 +790,20 @@ func main() {
 	}
 	scheduler.RegisterSubscriptionJobs(sched, logger, subscriptionDeps, tenants)
 
+	maintenanceDeps := scheduler.MaintenanceJobDeps{
+		BusinessMetrics:   metrics.NewBusinessMetricsRefresher(queries),
+		OutboundWebhooks:  webhookDispatcher,
+		AppointmentSeries: generateAppointmentSeriesUC,
+		WaitlistOffers:    expireWaitlistOffersUC,
+		NoShowDeposits:    expireDepositsUC,
+		CalendarPush:      pushCalendarUC,
+		CalendarPull:      pullCalendarUC,
+	}
+	if rateLimitPurger != nil {
+		maintenanceDeps.RateLimitStore = rateLimitPurger
+	}
+	scheduler.RegisterMaintenanceJobs(sched, logger, maintenanceDeps)
+
 	// Start scheduler in background
 	sched.Start()
 	defer sched.Stop(ctx)
@@ -579,6 +823,7 @@ func main() {
 		getDefaultUnitUC,
 		checkUserAccessToUnitUC,
 		listUnitUsersUC,
+		switchUnitUC,
 		logger,
 	)
 
@@ -667,6 +912,27 @@ func main() {
 	// Initialize handlers - Fornecedores
 	fornecedorHandler := handler.NewFornecedorHandler(fornecedorRepo, logger)
 
+	// Initialize handlers - Conciliação Bancária (7 use cases)
+	conciliacaoHandler := handler.NewConciliacaoHandler(
+		importarExtratoUC,
+		listImportacoesExtratoUC,
+		getImportacaoExtratoUC,
+		listRevisaoConciliacaoUC,
+		conciliarLinhaManualUC,
+		aceitarDivergenciaUC,
+		ignorarLinhaExtratoUC,
+		logger,
+	)
+
+	// Initialize handlers - Exportação Contábil (4 use cases)
+	contabilHandler := handler.NewContabilHandler(
+		listPlanoContasUC,
+		salvarMapeamentoContabilUC,
+		removerMapeamentoContabilUC,
+		gerarLancamentosContabeisUC,
+		logger,
+	)
+
 	// Initialize handlers - Despesa Fixa (7 use cases)
 	despesaFixaHandler := handler.NewDespesaFixaHandler(
 		createDespesaFixaUC,
@@ -680,16 +946,73 @@ func main() {
 		logger,
 	)
 
-	// Initialize handlers - Auth (4 use cases)
+	// Initialize handlers - Auth (7 use cases)
 	authHandler := handler.NewAuthHandler(
 		loginUC,
 		refreshUC,
 		meUC,
 		logoutUC,
+		listSessionsUC,
+		revokeSessionUC,
+		revokeOtherSessionsUC,
+		logger,
+	)
+
+	// Initialize handlers - 2FA (8 use cases)
+	twoFactorHandler := handler.NewTwoFactorHandler(
+		loginMFAUC,
+		loginMFASetupUC,
+		twoFactorStatusUC,
+		setupTwoFactorUC,
+		confirmTwoFactorUC,
+		disableTwoFactorUC,
+		regenerateRecoveryCodesUC,
+		updateTwoFactorPolicyUC,
+		logger,
+	)
+
+	// Initialize handlers - Papéis personalizados (5 use cases)
+	roleHandler := handler.NewRoleHandler(
+		listTenantRolesUC,
+		createTenantRoleUC,
+		updateTenantRoleUC,
+		deleteTenantRoleUC,
+		assignTenantRoleUC,
+		logger,
+	)
+
+	apiKeyHandler := handler.NewAPIKeyHandler(
+		listAPIKeysUC,
+		createAPIKeyUC,
+		revokeAPIKeyUC,
+		logger,
+	)
+
+	webhookEndpointHandler := handler.NewWebhookEndpointHandler(
+		listWebhookEndpointsUC,
+		createWebhookEndpointUC,
+		updateWebhookEndpointUC,
+		rotateWebhookSecretUC,
+		deleteWebhookEndpointUC,
+		listWebhookDeliveriesUC,
+		getWebhookDeliveryUC,
+		redeliverWebhookUC,
 		logger,
 	)
 
-	// Initialize handlers - Appointments (7 use cases)
+	// Initialize handlers - Recuperação de senha e convites (7 use cases)
+	accountHandler := handler.NewAccountHandler(
+		forgotPasswordUC,
+		resetPasswordUC,
+		createInvitationUC,
+		listInvitationsUC,
+		revokeInvitationUC,
+		previewInvitationUC,
+		acceptInvitationUC,
+		logger,
+	)
+
+	// Initialize handlers - Appointments (8 use cases)
 	appointmentHandler := handler.NewAppointmentHandler(
 		createAppointmentUC,
 		listAppointmentsUC,
@@ -698,6 +1021,73 @@ func main() {
 		rescheduleAppointmentUC,
 		cancelAppointmentUC,
 		finishWithCommandUC,
+		listAppointmentStatusHistoryUC,
+		logger,
+	)
+
+	appointmentAnalyticsHandler := handler.NewAppointmentAnalyticsHandler(getAppointmentTimingUC, logger)
+
+	// Initialize handlers - Appointment Series
+	appointmentSeriesHandler := handler.NewAppointmentSeriesHandler(
+		createAppointmentSeriesUC,
+		getAppointmentSeriesUC,
+		listAppointmentSeriesUC,
+		endAppointmentSeriesUC,
+		logger,
+	)
+
+	// Initialize handlers - Lista de espera
+	waitlistHandler := handler.NewWaitlistHandler(
+		createWaitlistEntryUC,
+		listWaitlistEntriesUC,
+		cancelWaitlistEntryUC,
+		getWaitlistOfferUC,
+		claimWaitlistOfferUC,
+		declineWaitlistOfferUC,
+		logger,
+	)
+
+	walkInHandler := handler.NewWalkInHandler(
+		checkInWalkInUC,
+		listWalkInQueueUC,
+		assignNextWalkInUC,
+		cancelWalkInUC,
+		logger,
+	)
+
+	calendarFeedHandler := handler.NewCalendarFeedHandler(
+		createCalendarFeedUC,
+		listCalendarFeedsUC,
+		revokeCalendarFeedUC,
+		getCalendarFeedUC,
+		logger,
+	)
+
+	calendarSyncHandler := handler.NewCalendarSyncHandler(
+		startCalendarConnectUC,
+		completeCalendarConnectUC,
+		listCalendarConnectionsUC,
+		disconnectCalendarUC,
+		pullCalendarUC,
+		appURL,
+		logger,
+	)
+
+	resourceHandler := handler.NewResourceHandler(
+		createResourceUC,
+		listResourcesUC,
+		updateResourceUC,
+		getServiceResourcesUC,
+		setServiceResourcesUC,
+		logger,
+	)
+
+	noShowHandler := handler.NewNoShowHandler(
+		getNoShowPolicyUC,
+		updateNoShowPolicyUC,
+		getCustomerReliabilityUC,
+		requireDepositUC,
+		getAppointmentDepositUC,
 		logger,
 	)
 
@@ -813,6 +1203,7 @@ func main() {
 	// Initialize webhook handler for Asaas (using V2 with full audit logging)
 	webhookHandler := handler.NewWebhookHandlerV2(
 		processWebhookUCV2,
+		confirmDepositPaymentUC,
 		os.Getenv("ASAAS_WEBHOOK_TOKEN"),
 		logger,
 	)
@@ -828,7 +1219,7 @@ func main() {
 		logger,
 	)
 
-	// Initialize handlers - Caixa Diário (8 use cases)
+	// Initialize handlers - Caixa Diário (11 use cases)
 	caixaHandler := handler.NewCaixaHandler(
 		abrirCaixaUC,
 		sangriaUC,
@@ -838,6 +1229,17 @@ func main() {
 		getCaixaByIDUC,
 		listHistoricoCaixaUC,
 		getTotaisCaixaUC,
+		listFechamentosPendentesUC,
+		aprovarFechamentoUC,
+		rejeitarFechamentoUC,
+		logger,
+	)
+
+	// Initialize handlers - Fiado (3 use cases)
+	customerDebtHandler := handler.NewCustomerDebtHandler(
+		listDividasClienteUC,
+		registrarPagamentoDividaUC,
+		getAgingFiadoUC,
 		logger,
 	)
 
@@ -888,6 +1290,14 @@ func main() {
 	// Create Echo instance
 	e := echo.New()
 
+	// IP do cliente (bloqueio de login e rate limit): X-Forwarded-For só vale
+	// vindo dos proxies em TRUSTED_PROXIES; sem eles, o endereço da conexão
+	ipExtractor, err := mw.IPExtractor(os.Getenv("TRUSTED_PROXIES"))
+	if err != nil {
+		logger.Fatal("TRUSTED_PROXIES inválido", zap.Error(err))
+	}
+	e.IPExtractor = ipExtractor
+
 	// Add Sentry middleware
 	e.Use(sentryecho.New(sentryecho.Options{}))
 
@@ -895,13 +1305,16 @@ func main() {
 	e.Validator = &CustomValidator{validate: validator.New()}
 
 	// Middleware
-	e.Use(middleware.Logger())
+	e.Use(telemetry.EchoMiddleware(logger))
+	e.Use(telemetry.AccessLog(logger))
 	e.Use(middleware.Recover())
+	e.Use(mw.HTTPMetrics())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		AllowOrigins:     []string{"http://localhost:3000", "http://localhost:3001", "http://localhost:3002", "http://localhost:3006", "http://localhost:8000"},
 		AllowMethods:     []string{echo.GET, echo.HEAD, echo.PUT, echo.PATCH, echo.POST, echo.DELETE, echo.OPTIONS},
 		AllowCredentials: true, // Permite cookies (refresh token)
-		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "X-Unit-ID"},
+		AllowHeaders:     []string{echo.HeaderOrigin, echo.HeaderContentType, echo.HeaderAccept, echo.HeaderAuthorization, "X-Unit-ID", "X-API-Key", "traceparent", "tracestate"},
+		ExposeHeaders:    []string{"X-Trace-ID"},
 	}))
 
 	// Health Check Endpoint
@@ -912,23 +1325,87 @@ func main() {
 		})
 	})
 
+	// Prometheus (METRICS_TOKEN exige Authorization: Bearer <token>). Sem
+	// token, /metrics só fica aberto em ENV=development
+	metricsToken := os.Getenv("METRICS_TOKEN")
+	switch {
+	case metricsToken != "":
+		e.GET("/metrics", echo.WrapHandler(promhttp.Handler()), mw.RequireMetricsToken(metricsToken))
+	case os.Getenv("ENV") == "development":
+		e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
+	default:
+		logger.Warn("METRICS_TOKEN não configurado: /metrics desativado")
+	}
+
 	// API Routes
 	api := e.Group("/api/v1")
 
+	// Rate limiting por classe de rota (RATE_LIMIT_<CLASSE>="limite/janela")
+	limitLogin := rateLimiter.Limit(mw.RateLimitLogin.FromEnv("RATE_LIMIT_LOGIN"))
+	limitPublic := rateLimiter.Limit(mw.RateLimitPublic.FromEnv("RATE_LIMIT_PUBLIC"))
+	limitWebhooks := rateLimiter.Limit(mw.RateLimitWebhooks.FromEnv("RATE_LIMIT_WEBHOOKS"))
+	limitAPI := rateLimiter.Limit(
+		mw.RateLimitAPIUser.FromEnv("RATE_LIMIT_API_USER"),
+		mw.RateLimitAPITenant.FromEnv("RATE_LIMIT_API_TENANT"),
+	)
+
 	// Auth routes - PÚBLICAS (sem middleware JWT)
-	authGroup := api.Group("/auth")
-	authGroup.POST("/login", authHandler.Login)                                // POST /api/v1/auth/login
+	authGroup := api.Group("/auth", limitPublic)
+	authGroup.POST("/login", authHandler.Login, limitLogin)                    // POST /api/v1/auth/login
 	authGroup.POST("/refresh", authHandler.Refresh)                            // POST /api/v1/auth/refresh
 	authGroup.POST("/logout", authHandler.Logout)                              // POST /api/v1/auth/logout
 	authGroup.GET("/me", authHandler.Me, mw.JWTMiddleware(jwtManager, logger)) // GET /api/v1/auth/me (protegido)
 
+	// Sessões por dispositivo (protegidas)
+	authGroup.GET("/sessions", authHandler.ListSessions, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.DELETE("/sessions", authHandler.RevokeOtherSessions, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.DELETE("/sessions/:id", authHandler.RevokeSession, mw.JWTMiddleware(jwtManager, logger))
+
+	// 2FA: segundo passo do login (público, validado pelo mfa_token) e gestão (protegida)
+	authGroup.POST("/login/2fa", twoFactorHandler.LoginMFA, limitLogin)
+	authGroup.POST("/login/2fa/setup", twoFactorHandler.LoginMFASetup, limitLogin)
+	authGroup.GET("/2fa", twoFactorHandler.Status, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/setup", twoFactorHandler.Setup, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/confirm", twoFactorHandler.Confirm, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/disable", twoFactorHandler.Disable, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.PUT("/2fa/policy", twoFactorHandler.UpdatePolicy, mw.JWTMiddleware(jwtManager, logger), mw.RequireRoles(logger, mw.RoleOwner))
+
+	// Recuperação de senha e aceite de convite (públicas, validadas pelo token do link)
+	authGroup.POST("/forgot-password", accountHandler.ForgotPassword, limitLogin) // POST /api/v1/auth/forgot-password
+	authGroup.POST("/reset-password", accountHandler.ResetPassword, limitLogin)   // POST /api/v1/auth/reset-password
+	authGroup.GET("/invitations/preview", accountHandler.PreviewInvitation)       // GET /api/v1/auth/invitations/preview?token=
+	authGroup.POST("/invitations/accept", accountHandler.AcceptInvitation)        // POST /api/v1/auth/invitations/accept
+
+	// Convites de colaboradores (dono/gerente)
+	invitationAuth := []echo.MiddlewareFunc{mw.JWTMiddleware(jwtManager, logger), mw.RequireOwnerOrManager(logger)}
+	authGroup.POST("/invitations", accountHandler.CreateInvitation, invitationAuth...)
+	authGroup.GET("/invitations", accountHandler.ListInvitations, invitationAuth...)
+	authGroup.DELETE("/invitations/:id", accountHandler.RevokeInvitation, invitationAuth...)
+
+	// Oferta da lista de espera - PÚBLICAS (validadas pelo token do link)
+	publicWaitlistGroup := api.Group("/public/waitlist", limitPublic)
+	publicWaitlistGroup.GET("/offers", waitlistHandler.GetOffer)              // GET /api/v1/public/waitlist/offers?token=
+	publicWaitlistGroup.POST("/offers/claim", waitlistHandler.ClaimOffer)     // POST /api/v1/public/waitlist/offers/claim
+	publicWaitlistGroup.POST("/offers/decline", waitlistHandler.DeclineOffer) // POST /api/v1/public/waitlist/offers/decline
+
+	// Feed .ics da agenda - PÚBLICO (validado pelo token do link)
+	api.GET("/public/calendar/:token", calendarFeedHandler.Feed, limitPublic) // GET /api/v1/public/calendar/{token}.ics
+	api.GET("/public/calendar-connections/google/callback", calendarSyncHandler.Callback, limitPublic)
+
 	// Webhook routes - PÚBLICAS (validação por token no header)
-	webhooksGroup := api.Group("/webhooks")
+	webhooksGroup := api.Group("/webhooks", limitWebhooks)
 	webhooksGroup.POST("/asaas", webhookHandler.HandleAsaasWebhook) // POST /api/v1/webhooks/asaas
 
-	// Middleware JWT para rotas protegidas
+	// Rotas protegidas: JWT de usuário ou chave de API (integrações). Chaves
+	// só acessam as rotas de mw.APIKeyRoutes permitidas pelos seus escopos.
+	authenticate := mw.AuthMiddleware(jwtManager, authenticateAPIKeyUC, logger)
+	apiKeyScopes := mw.APIKeyScopeGuard(logger, mw.APIKeyRoutes)
+
 	protected := api.Group("")
-	protected.Use(mw.JWTMiddleware(jwtManager, logger))
+	protected.Use(authenticate)
+	protected.Use(apiKeyScopes)
+	protected.Use(limitAPI)
 
 	// =============================================================================
 	// T-ASAAS-003: Middleware de verificação de assinatura
@@ -940,7 +1417,9 @@ func main() {
 	// Grupo guarded: JWT + verificação de assinatura ativa
 	// Usado em rotas críticas de negócio (agendamentos, comandas, caixa, financeiro)
 	guarded := api.Group("")
-	guarded.Use(mw.JWTMiddleware(jwtManager, logger))
+	guarded.Use(authenticate)
+	guarded.Use(apiKeyScopes)
+	guarded.Use(limitAPI)
 	guarded.Use(requireActiveSubscription)
 
 	// Unit routes - PROTEGIDAS (JWT)
@@ -960,6 +1439,34 @@ func main() {
 	unitGroup.DELETE("/:id/users/:userId", unitHandler.RemoveUserFromUnit, mw.RequireAdminAccess(logger))
 	unitGroup.GET("/:id/users", unitHandler.ListUnitUsers, mw.RequireAdminAccess(logger))
 
+	// Papéis personalizados - PERMISSÃO roles.manage (somente dono)
+	roleGroup := protected.Group("/roles", mw.RequirePermission(logger, valueobject.PermRolesManage))
+	roleGroup.GET("/permissions", roleHandler.Catalog)
+	roleGroup.GET("", roleHandler.List)
+	roleGroup.POST("", roleHandler.Create)
+	roleGroup.PUT("/assignments", roleHandler.Assign)
+	roleGroup.PUT("/:id", roleHandler.Update)
+	roleGroup.DELETE("/:id", roleHandler.Delete)
+
+	// Chaves de API de integrações - somente dono
+	apiKeyGroup := protected.Group("/api-keys", mw.RequireRoles(logger, mw.RoleOwner))
+	apiKeyGroup.GET("/scopes", apiKeyHandler.Scopes)
+	apiKeyGroup.GET("", apiKeyHandler.List)
+	apiKeyGroup.POST("", apiKeyHandler.Create)
+	apiKeyGroup.DELETE("/:id", apiKeyHandler.Revoke)
+
+	// Webhooks de saída - somente dono (/webhooks é o recebimento do Asaas)
+	webhookEndpointGroup := protected.Group("/webhook-endpoints", mw.RequireRoles(logger, mw.RoleOwner))
+	webhookEndpointGroup.GET("/events", webhookEndpointHandler.Events)
+	webhookEndpointGroup.GET("/deliveries", webhookEndpointHandler.ListDeliveries)
+	webhookEndpointGroup.GET("/deliveries/:id", webhookEndpointHandler.GetDelivery)
+	webhookEndpointGroup.POST("/deliveries/:id/redeliver", webhookEndpointHandler.Redeliver)
+	webhookEndpointGroup.GET("", webhookEndpointHandler.List)
+	webhookEndpointGroup.POST("", webhookEndpointHandler.Create)
+	webhookEndpointGroup.PUT("/:id", webhookEndpointHandler.Update)
+	webhookEndpointGroup.POST("/:id/rotate-secret", webhookEndpointHandler.RotateSecret)
+	webhookEndpointGroup.DELETE("/:id", webhookEndpointHandler.Delete)
+
 	// Metas routes - 15 endpoints completos (PROTEGIDAS)
 	metasGroup := protected.Group("/metas")
 
@@ -1000,6 +1507,7 @@ func main() {
 	appointmentsGroup.POST("", appointmentHandler.CreateAppointment, mw.RequireAnyRole(logger))
 	appointmentsGroup.GET("", appointmentHandler.ListAppointments, mw.RequireAnyRole(logger))
 	appointmentsGroup.GET("/:id", appointmentHandler.GetAppointment, mw.RequireAnyRole(logger))
+	appointmentsGroup.GET("/:id/history", appointmentHandler.GetStatusHistory, mw.RequireAnyRole(logger))
 	appointmentsGroup.PATCH("/:id/status", appointmentHandler.UpdateAppointmentStatus, mw.RequireAdminAccess(logger))
 	appointmentsGroup.PATCH("/:id/reschedule", appointmentHandler.RescheduleAppointment, mw.RequireAdminAccess(logger))
 	// Transições de status específicas
@@ -1010,6 +1518,62 @@ func main() {
 	appointmentsGroup.POST("/:id/finish", appointmentHandler.FinishServiceAppointment, mw.RequireAnyRole(logger))
 	appointmentsGroup.POST("/:id/complete", appointmentHandler.CompleteAppointment, mw.RequireAdminAccess(logger))
 	appointmentsGroup.POST("/:id/no-show", appointmentHandler.NoShowAppointment, mw.RequireOwnerOrManager(logger))
+	appointmentsGroup.GET("/:id/deposit", noShowHandler.GetDeposit, mw.RequireAnyRole(logger))
+	appointmentsGroup.POST("/:id/deposit", noShowHandler.RequireDeposit, mw.RequireAdminAccess(logger))
+
+	// Indicadores de tempo da agenda - unit_id opcional na query (todas as unidades sem ele)
+	appointmentAnalyticsGroup := guarded.Group("/appointment-analytics")
+	appointmentAnalyticsGroup.GET("/timing", appointmentAnalyticsHandler.Timing, mw.RequireAnyRole(logger))
+
+	// Appointment Series routes - agendamentos recorrentes (clientes fixos)
+	// Remarcar/cancelar "esta e as seguintes" usa /appointments com scope
+	appointmentSeriesGroup := guarded.Group("/appointment-series")
+	appointmentSeriesGroup.Use(mw.UnitMiddleware())
+	appointmentSeriesGroup.POST("", appointmentSeriesHandler.CreateSeries, mw.RequireAdminAccess(logger))
+	appointmentSeriesGroup.GET("", appointmentSeriesHandler.ListSeries, mw.RequireAdminAccess(logger))
+	appointmentSeriesGroup.GET("/:id", appointmentSeriesHandler.GetSeries, mw.RequireAdminAccess(logger))
+	appointmentSeriesGroup.POST("/:id/end", appointmentSeriesHandler.EndSeries, mw.RequireAdminAccess(logger))
+
+	// Lista de espera - horários liberados são oferecidos por prioridade
+	waitlistGroup := guarded.Group("/waitlist")
+	waitlistGroup.Use(mw.UnitMiddleware())
+	waitlistGroup.POST("", waitlistHandler.CreateEntry, mw.RequireAdminAccess(logger))
+	waitlistGroup.GET("", waitlistHandler.ListEntries, mw.RequireAdminAccess(logger))
+	waitlistGroup.DELETE("/:id", waitlistHandler.CancelEntry, mw.RequireAdminAccess(logger))
+
+	// Fila de encaixe - clientes sem horário atendidos pela lista da vez
+	walkInsGroup := guarded.Group("/walk-ins")
+	walkInsGroup.Use(mw.UnitMiddleware())
+	walkInsGroup.POST("", walkInHandler.CheckIn, mw.RequireAdminAccess(logger))
+	walkInsGroup.GET("", walkInHandler.ListQueue, mw.RequireAdminAccess(logger))
+	walkInsGroup.POST("/assign", walkInHandler.AssignNext, mw.RequireAdminAccess(logger))
+	walkInsGroup.DELETE("/:id", walkInHandler.Cancel, mw.RequireAdminAccess(logger))
+
+	// Feeds .ics da agenda - barbeiros gerenciam só os da própria agenda
+	calendarFeedsGroup := guarded.Group("/calendar-feeds")
+	calendarFeedsGroup.Use(mw.UnitMiddleware())
+	calendarFeedsGroup.POST("", calendarFeedHandler.Create, mw.RequireAnyRole(logger))
+	calendarFeedsGroup.GET("", calendarFeedHandler.List, mw.RequireAnyRole(logger))
+	calendarFeedsGroup.DELETE("/:id", calendarFeedHandler.Revoke, mw.RequireAnyRole(logger))
+
+	// Google Agenda: barbeiros conectam e sincronizam só a própria agenda
+	calendarConnectionsGroup := guarded.Group("/calendar-connections")
+	calendarConnectionsGroup.GET("", calendarSyncHandler.List, mw.RequireAnyRole(logger))
+	calendarConnectionsGroup.POST("/google/authorize", calendarSyncHandler.Authorize, mw.RequireAnyRole(logger))
+	calendarConnectionsGroup.DELETE("/:id", calendarSyncHandler.Disconnect, mw.RequireAnyRole(logger))
+	calendarConnectionsGroup.POST("/:id/sync", calendarSyncHandler.Sync, mw.RequireAnyRole(logger))
+
+	// Recursos da unidade - limitam quantos atendimentos cabem no mesmo horário
+	resourcesGroup := guarded.Group("/resources")
+	resourcesGroup.Use(mw.UnitMiddleware())
+	resourcesGroup.GET("", resourceHandler.ListResources, mw.RequireAdminAccess(logger))
+	resourcesGroup.POST("", resourceHandler.CreateResource, mw.RequireOwnerOrManager(logger))
+	resourcesGroup.PUT("/:id", resourceHandler.UpdateResource, mw.RequireOwnerOrManager(logger))
+
+	// Política de não comparecimento - clientes faltosos pagam sinal via PIX
+	noShowPolicyGroup := guarded.Group("/no-show-policy")
+	noShowPolicyGroup.GET("", noShowHandler.GetPolicy, mw.RequireAdminAccess(logger))
+	noShowPolicyGroup.PUT("", noShowHandler.UpdatePolicy, mw.RequireOwnerOrManager(logger))
 
 	// Blocked Times routes - 3 endpoints (PROTEGIDAS com JWT)
 	blockedTimesGroup := protected.Group("/blocked-times")
@@ -1017,20 +1581,20 @@ func main() {
 	blockedTimesGroup.GET("", blockedTimeHandler.ListBlockedTimes)
 	blockedTimesGroup.DELETE("/:id", blockedTimeHandler.DeleteBlockedTime)
 
-	// Command routes - 11 endpoints (PROTEGIDAS com JWT + RBAC + ASSINATURA ATIVA)
+	// Command routes - 11 endpoints (PROTEGIDAS com JWT + PERMISSÕES + ASSINATURA ATIVA)
 	// T-ASAAS-003: Requer assinatura ativa (grupo guarded)
 	commandsGroup := guarded.Group("/commands")
-	commandsGroup.POST("", commandHandler.CreateCommand, mw.RequireAnyRole(logger))
-	commandsGroup.GET("", commandHandler.ListCommands, mw.RequireAnyRole(logger)) // LIST - filtros e paginação
-	commandsGroup.GET("/by-appointment/:appointmentId", commandHandler.GetCommandByAppointment, mw.RequireAnyRole(logger))
-	commandsGroup.GET("/:id", commandHandler.GetCommand, mw.RequireAnyRole(logger))
-	commandsGroup.POST("/:id/items", commandHandler.AddCommandItem, mw.RequireAnyRole(logger))
-	commandsGroup.DELETE("/:id/items/:itemId", commandHandler.RemoveCommandItem, mw.RequireAdminAccess(logger))
-	commandsGroup.POST("/:id/payments", commandHandler.AddCommandPayment, mw.RequireAnyRole(logger))
-	commandsGroup.DELETE("/:id/payments/:paymentId", commandHandler.RemoveCommandPayment, mw.RequireAdminAccess(logger))
-	commandsGroup.POST("/:id/close", commandHandler.CloseCommand, mw.RequireAdminAccess(logger))
-	commandsGroup.POST("/:id/close-integrated", commandHandler.CloseCommandIntegrated, mw.RequireAdminAccess(logger)) // T-EST-002, T-COM-001
-	commandsGroup.POST("/:id/cancel", commandHandler.CancelCommand, mw.RequireAdminAccess(logger))                    // T-EST-003: Cancelar comanda com reversão de estoque
+	commandsGroup.POST("", commandHandler.CreateCommand, mw.RequirePermission(logger, valueobject.PermCommandOperate))
+	commandsGroup.GET("", commandHandler.ListCommands, mw.RequirePermission(logger, valueobject.PermCommandOperate)) // LIST - filtros e paginação
+	commandsGroup.GET("/by-appointment/:appointmentId", commandHandler.GetCommandByAppointment, mw.RequirePermission(logger, valueobject.PermCommandOperate))
+	commandsGroup.GET("/:id", commandHandler.GetCommand, mw.RequirePermission(logger, valueobject.PermCommandOperate))
+	commandsGroup.POST("/:id/items", commandHandler.AddCommandItem, mw.RequirePermission(logger, valueobject.PermCommandOperate))
+	commandsGroup.DELETE("/:id/items/:itemId", commandHandler.RemoveCommandItem, mw.RequirePermission(logger, valueobject.PermCommandItemRemove))
+	commandsGroup.POST("/:id/payments", commandHandler.AddCommandPayment, mw.RequirePermission(logger, valueobject.PermCommandOperate))
+	commandsGroup.DELETE("/:id/payments/:paymentId", commandHandler.RemoveCommandPayment, mw.RequirePermission(logger, valueobject.PermCommandPaymentRemove))
+	commandsGroup.POST("/:id/close", commandHandler.CloseCommand, mw.RequirePermission(logger, valueobject.PermCommandClose))
+	commandsGroup.POST("/:id/close-integrated", commandHandler.CloseCommandIntegrated, mw.RequirePermission(logger, valueobject.PermCommandClose)) // T-EST-002, T-COM-001
+	commandsGroup.POST("/:id/cancel", commandHandler.CancelCommand, mw.RequirePermission(logger, valueobject.PermCommandCancel))                   // T-EST-003: Cancelar comanda com reversão de estoque
 
 	// Customer routes - 11 endpoints (PROTEGIDAS com JWT)
 	customersGroup := protected.Group("/customers")
@@ -1043,6 +1607,7 @@ func main() {
 	customersGroup.GET("/:id", customerHandler.GetCustomer)
 	customersGroup.GET("/:id/history", customerHandler.GetCustomerWithHistory)
 	customersGroup.GET("/:id/export", customerHandler.ExportCustomerData)
+	customersGroup.GET("/:id/reliability", noShowHandler.GetCustomerReliability)
 	customersGroup.PUT("/:id", customerHandler.UpdateCustomer)
 	customersGroup.DELETE("/:id", customerHandler.InactivateCustomer)
 
@@ -1058,46 +1623,52 @@ func main() {
 	professionalsGroup.PUT("/:id/status", professionalHandler.UpdateProfessionalStatus)
 	professionalsGroup.DELETE("/:id", professionalHandler.DeleteProfessional)
 
-	// Financial routes - 19 endpoints (PROTEGIDAS com JWT + RBAC + ASSINATURA ATIVA)
+	// Financial routes - 19 endpoints (PROTEGIDAS com JWT + PERMISSÕES + ASSINATURA ATIVA)
 	// T-ASAAS-003: Requer assinatura ativa (grupo guarded)
 	financialGroup := guarded.Group("/financial")
 
-	// ContaPagar (6 endpoints: 5 CRUD + 1 marcarPagamento) - Apenas Admin
-	financialGroup.POST("/payables", financialHandler.CreateContaPagar, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/payables/:id", financialHandler.GetContaPagar, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/payables", financialHandler.ListContasPagar, mw.RequireAdminAccess(logger))
-	financialGroup.PUT("/payables/:id", financialHandler.UpdateContaPagar, mw.RequireOwnerOrManager(logger))
-	financialGroup.DELETE("/payables/:id", financialHandler.DeleteContaPagar, mw.RequireOwnerOrManager(logger))
-	financialGroup.POST("/payables/:id/payment", financialHandler.MarcarPagamento, mw.RequireOwnerOrManager(logger))
-
-	// ContaReceber (6 endpoints: 5 CRUD + 1 marcarRecebimento) - Apenas Admin
-	financialGroup.POST("/receivables", financialHandler.CreateContaReceber, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/receivables/:id", financialHandler.GetContaReceber, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/receivables", financialHandler.ListContasReceber, mw.RequireAdminAccess(logger))
-	financialGroup.PUT("/receivables/:id", financialHandler.UpdateContaReceber, mw.RequireOwnerOrManager(logger))
-	financialGroup.DELETE("/receivables/:id", financialHandler.DeleteContaReceber, mw.RequireOwnerOrManager(logger))
-	financialGroup.POST("/receivables/:id/receipt", financialHandler.MarcarRecebimento, mw.RequireOwnerOrManager(logger))
-
-	// Compensação (3 endpoints: Get, List, Delete) - Apenas Admin
-	financialGroup.GET("/compensations/:id", financialHandler.GetCompensacao, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/compensations", financialHandler.ListCompensacoes, mw.RequireAdminAccess(logger))
-	financialGroup.DELETE("/compensations/:id", financialHandler.DeleteCompensacao, mw.RequireOwnerOrManager(logger))
-
-	// FluxoCaixa (2 endpoints: Get, List) - Apenas Admin
-	financialGroup.GET("/cashflow/:id", financialHandler.GetFluxoCaixa, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/cashflow", financialHandler.ListFluxoCaixa, mw.RequireAdminAccess(logger))
-
-	// DRE (2 endpoints: Get, List) - Apenas Owner/Manager
-	financialGroup.GET("/dre/:month", financialHandler.GetDRE, mw.RequireOwnerOrManager(logger))
-	financialGroup.GET("/dre", financialHandler.ListDRE, mw.RequireOwnerOrManager(logger))
-
-	// Dashboard e Projeções (2 endpoints: dashboard, projections) - Apenas Admin
-	financialGroup.GET("/dashboard", financialHandler.GetDashboard, mw.RequireAdminAccess(logger))
-	financialGroup.GET("/projections", financialHandler.GetProjections, mw.RequireAdminAccess(logger))
+	// ContaPagar (6 endpoints: 5 CRUD + 1 marcarPagamento)
+	financialGroup.POST("/payables", financialHandler.CreateContaPagar, mw.RequirePermission(logger, valueobject.PermPayablesCreate))
+	financialGroup.GET("/payables/:id", financialHandler.GetContaPagar, mw.RequirePermission(logger, valueobject.PermPayablesRead))
+	financialGroup.GET("/payables", financialHandler.ListContasPagar, mw.RequirePermission(logger, valueobject.PermPayablesRead))
+	financialGroup.PUT("/payables/:id", financialHandler.UpdateContaPagar, mw.RequirePermission(logger, valueobject.PermPayablesManage))
+	financialGroup.DELETE("/payables/:id", financialHandler.DeleteContaPagar, mw.RequirePermission(logger, valueobject.PermPayablesManage))
+	financialGroup.POST("/payables/:id/payment", financialHandler.MarcarPagamento, mw.RequirePermission(logger, valueobject.PermPayablesManage))
+
+	// ContaReceber (6 endpoints: 5 CRUD + 1 marcarRecebimento)
+	financialGroup.POST("/receivables", financialHandler.CreateContaReceber, mw.RequirePermission(logger, valueobject.PermReceivablesCreate))
+	financialGroup.GET("/receivables/:id", financialHandler.GetContaReceber, mw.RequirePermission(logger, valueobject.PermReceivablesRead))
+	financialGroup.GET("/receivables", financialHandler.ListContasReceber, mw.RequirePermission(logger, valueobject.PermReceivablesRead))
+	financialGroup.PUT("/receivables/:id", financialHandler.UpdateContaReceber, mw.RequirePermission(logger, valueobject.PermReceivablesManage))
+	financialGroup.DELETE("/receivables/:id", financialHandler.DeleteContaReceber, mw.RequirePermission(logger, valueobject.PermReceivablesManage))
+	financialGroup.POST("/receivables/:id/receipt", financialHandler.MarcarRecebimento, mw.RequirePermission(logger, valueobject.PermReceivablesManage))
+
+	// Compensação (3 endpoints: Get, List, Delete)
+	financialGroup.GET("/compensations/:id", financialHandler.GetCompensacao, mw.RequirePermission(logger, valueobject.PermCompensationsRead))
+	financialGroup.GET("/compensations", financialHandler.ListCompensacoes, mw.RequirePermission(logger, valueobject.PermCompensationsRead))
+	financialGroup.DELETE("/compensations/:id", financialHandler.DeleteCompensacao, mw.RequirePermission(logger, valueobject.PermCompensationsManage))
+
+	// FluxoCaixa (2 endpoints: Get, List)
+	financialGroup.GET("/cashflow/:id", financialHandler.GetFluxoCaixa, mw.RequirePermission(logger, valueobject.PermCashflowRead))
+	financialGroup.GET("/cashflow", financialHandler.ListFluxoCaixa, mw.RequirePermission(logger, valueobject.PermCashflowRead))
+
+	// DRE (2 endpoints: Get, List)
+	financialGroup.GET("/dre/:month", financialHandler.GetDRE, mw.RequirePermission(logger, valueobject.PermDRERead))
+	financialGroup.GET("/dre", financialHandler.ListDRE, mw.RequirePermission(logger, valueobject.PermDRERead))
+
+	// Dashboard e Projeções (2 endpoints: dashboard, projections)
+	financialGroup.GET("/dashboard", financialHandler.GetDashboard, mw.RequirePermission(logger, valueobject.PermFinancialDashboardRead))
+	financialGroup.GET("/projections", financialHandler.GetProjections, mw.RequirePermission(logger, valueobject.PermFinancialDashboardRead))
 
 	// Despesas Fixas (8 endpoints: CRUD + toggle + summary + generate)
 	despesaFixaHandler.RegisterRoutes(financialGroup)
 
+	// Conciliação bancária (7 endpoints: importação de extratos + fila de revisão)
+	conciliacaoHandler.RegisterRoutes(financialGroup)
+
+	// Exportação contábil (4 endpoints: plano de contas + lançamentos do mês)
+	contabilHandler.RegisterRoutes(financialGroup)
+
 	// Barber Turn (Lista da Vez) routes - 9 endpoints (PROTEGIDAS com JWT)
 	turnGroup := protected.Group("/barber-turn")
 	turnGroup.GET("/list", barberTurnHandler.ListBarbersTurn)                              // GET /api/v1/barber-turn/list
@@ -1147,13 +1718,15 @@ func main() {
 	// Servicos routes - 9 endpoints (PROTEGIDAS com JWT)
 	servicosGroup := protected.Group("/servicos")
 	servicosGroup.Use(mw.UnitMiddleware())
-	servicosGroup.POST("", servicoHandler.Create)                          // POST /api/v1/servicos
-	servicosGroup.GET("", servicoHandler.List)                             // GET /api/v1/servicos
-	servicosGroup.GET("/stats", servicoHandler.GetStats)                   // GET /api/v1/servicos/stats
-	servicosGroup.GET("/:id", servicoHandler.GetByID)                      // GET /api/v1/servicos/:id
-	servicosGroup.PUT("/:id", servicoHandler.Update)                       // PUT /api/v1/servicos/:id
-	servicosGroup.DELETE("/:id", servicoHandler.Delete)                    // DELETE /api/v1/servicos/:id
-	servicosGroup.PATCH("/:id/toggle-status", servicoHandler.ToggleStatus) // PATCH /api/v1/servicos/:id/toggle-status
+	servicosGroup.POST("", servicoHandler.Create)                            // POST /api/v1/servicos
+	servicosGroup.GET("", servicoHandler.List)                               // GET /api/v1/servicos
+	servicosGroup.GET("/stats", servicoHandler.GetStats)                     // GET /api/v1/servicos/stats
+	servicosGroup.GET("/:id", servicoHandler.GetByID)                        // GET /api/v1/servicos/:id
+	servicosGroup.PUT("/:id", servicoHandler.Update)                         // PUT /api/v1/servicos/:id
+	servicosGroup.DELETE("/:id", servicoHandler.Delete)                      // DELETE /api/v1/servicos/:id
+	servicosGroup.PATCH("/:id/toggle-status", servicoHandler.ToggleStatus)   // PATCH /api/v1/servicos/:id/toggle-status
+	servicosGroup.GET("/:id/resources", resourceHandler.GetServiceResources) // GET /api/v1/servicos/:id/resources
+	servicosGroup.PUT("/:id/resources", resourceHandler.SetServiceResources) // PUT /api/v1/servicos/:id/resources
 
 	// Plans (assinaturas) routes
 	plansGroup := protected.Group("/plans")
@@ -1182,10 +1755,13 @@ func main() {
 	meiosPagamentoGroup.DELETE("/:id", meioPagamentoHandler.Delete)       // DELETE /api/v1/meios-pagamento/:id
 	meiosPagamentoGroup.PATCH("/:id/toggle", meioPagamentoHandler.Toggle) // PATCH /api/v1/meios-pagamento/:id/toggle
 
-	// Caixa Diário routes - 9 endpoints (PROTEGIDAS com JWT + ASSINATURA ATIVA)
+	// Caixa Diário routes - 13 endpoints (PROTEGIDAS com JWT + ASSINATURA ATIVA)
 	// T-ASAAS-003: Requer assinatura ativa (grupo guarded)
 	caixaHandler.RegisterRoutes(guarded)
 
+	// Fiado routes - 3 endpoints (PROTEGIDAS com JWT + ASSINATURA ATIVA)
+	customerDebtHandler.RegisterRoutes(guarded)
+
 	// Commission routes - 35+ endpoints (PROTEGIDAS com JWT + RBAC)
 	// Regras:
 	// - BARBER pode ver suas próprias comissões e solicitar adiantamentos
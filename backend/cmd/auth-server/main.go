@@ -69,6 +69,24 @@ func main() {
 	refreshUC := authUC.NewRefreshUseCase(queries, jwtManager, logger)
 	meUC := authUC.NewMeUseCase(queries, logger)
 	logoutUC := authUC.NewLogoutUseCase(queries, logger)
+	listSessionsUC := authUC.NewListSessionsUseCase(queries, logger)
+	revokeSessionUC := authUC.NewRevokeSessionUseCase(queries, logger)
+	revokeOtherSessionsUC := authUC.NewRevokeOtherSessionsUseCase(queries, logger)
+
+	// Initialize use cases - 2FA (8 use cases)
+	// Segredos TOTP cifrados com TOTP_ENCRYPTION_KEY (fallback JWT_SECRET em desenvolvimento)
+	totpCipher, err := auth.NewSecretCipher()
+	if err != nil {
+		logger.Fatal("Erro ao inicializar cifra TOTP", zap.Error(err))
+	}
+	loginMFAUC := authUC.NewLoginMFAUseCase(queries, jwtManager, totpCipher, logger)
+	loginMFASetupUC := authUC.NewLoginMFASetupUseCase(queries, totpCipher, logger)
+	twoFactorStatusUC := authUC.NewGetTwoFactorStatusUseCase(queries, logger)
+	setupTwoFactorUC := authUC.NewSetupTwoFactorUseCase(queries, totpCipher, logger)
+	confirmTwoFactorUC := authUC.NewConfirmTwoFactorUseCase(queries, totpCipher, logger)
+	disableTwoFactorUC := authUC.NewDisableTwoFactorUseCase(queries, totpCipher, logger)
+	regenerateRecoveryCodesUC := authUC.NewRegenerateRecoveryCodesUseCase(queries, totpCipher, logger)
+	updateTwoFactorPolicyUC := authUC.NewUpdateTwoFactorPolicyUseCase(queries, logger)
 
 	// Initialize handlers - Auth
 	authHandler := handler.NewAuthHandler(
@@ -76,6 +94,22 @@ func main() {
 		refreshUC,
 		meUC,
 		logoutUC,
+		listSessionsUC,
+		revokeSessionUC,
+		revokeOtherSessionsUC,
+		logger,
+	)
+
+	// Initialize handlers - 2FA (8 use cases)
+	twoFactorHandler := handler.NewTwoFactorHandler(
+		loginMFAUC,
+		loginMFASetupUC,
+		twoFactorStatusUC,
+		setupTwoFactorUC,
+		confirmTwoFactorUC,
+		disableTwoFactorUC,
+		regenerateRecoveryCodesUC,
+		updateTwoFactorPolicyUC,
 		logger,
 	)
 
@@ -109,6 +143,19 @@ func main() {
 	authGroup.POST("/refresh", authHandler.Refresh)
 	authGroup.POST("/logout", authHandler.Logout)
 	authGroup.GET("/me", authHandler.Me, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.GET("/sessions", authHandler.ListSessions, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.DELETE("/sessions", authHandler.RevokeOtherSessions, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.DELETE("/sessions/:id", authHandler.RevokeSession, mw.JWTMiddleware(jwtManager, logger))
+
+	// 2FA: segundo passo do login (público, validado pelo mfa_token) e gestão (protegida)
+	authGroup.POST("/login/2fa", twoFactorHandler.LoginMFA)
+	authGroup.POST("/login/2fa/setup", twoFactorHandler.LoginMFASetup)
+	authGroup.GET("/2fa", twoFactorHandler.Status, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/setup", twoFactorHandler.Setup, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/confirm", twoFactorHandler.Confirm, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/disable", twoFactorHandler.Disable, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.POST("/2fa/recovery-codes", twoFactorHandler.RegenerateRecoveryCodes, mw.JWTMiddleware(jwtManager, logger))
+	authGroup.PUT("/2fa/policy", twoFactorHandler.UpdatePolicy, mw.JWTMiddleware(jwtManager, logger), mw.RequireRoles(logger, mw.RoleOwner))
 
 	// Start server
 	logger.Info("Iniciando servidor",
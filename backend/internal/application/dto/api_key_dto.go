@@ -0,0 +1,50 @@
+package dto
+
+// =============================================================================
+// CHAVES DE API (integrações)
+// =============================================================================
+
+// APIKeyScopeResponse - Escopo do catálogo
+type APIKeyScopeResponse struct {
+	Code      string `json:"code"`
+	Descricao string `json:"descricao"`
+}
+
+// CreateAPIKeyRequest - Criação de chave de API
+type CreateAPIKeyRequest struct {
+	Nome     string   `json:"nome" validate:"required,min=2,max=60"`
+	Escopos  []string `json:"escopos" validate:"required,min=1"`
+	UnitID   *string  `json:"unit_id,omitempty" validate:"omitempty,uuid"`
+	ExpiraEm *string  `json:"expira_em,omitempty"` // RFC3339; vazio = sem expiração
+}
+
+// APIKeyResponse - Chave de API (sem o valor da chave)
+type APIKeyResponse struct {
+	ID          string   `json:"id"`
+	Nome        string   `json:"nome"`
+	Prefixo     string   `json:"prefixo"`
+	Escopos     []string `json:"escopos"`
+	UnitID      *string  `json:"unit_id,omitempty"`
+	CriadoPor   *string  `json:"criado_por,omitempty"`
+	ExpiraEm    *string  `json:"expira_em,omitempty"`
+	UltimoUsoEm *string  `json:"ultimo_uso_em,omitempty"`
+	UltimoUsoIP *string  `json:"ultimo_uso_ip,omitempty"`
+	RevogadaEm  *string  `json:"revogada_em,omitempty"`
+	Ativa       bool     `json:"ativa"`
+	CriadoEm    string   `json:"criado_em"`
+}
+
+// CreateAPIKeyResponse - Chave criada; Chave só é retornada nesta resposta
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Chave string `json:"chave"`
+}
+
+// APIKeyPrincipal - Identidade de uma requisição autenticada por chave de API
+type APIKeyPrincipal struct {
+	KeyID    string
+	TenantID string
+	UnitID   string // vazio: unidade vem do header X-Unit-ID
+	Nome     string
+	Escopos  []string
+}
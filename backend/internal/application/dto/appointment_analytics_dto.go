@@ -0,0 +1,99 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs do Histórico de Status e dos Indicadores de Tempo dos Agendamentos
+// =============================================================================
+
+// AppointmentStatusChangeResponse mudança de status do agendamento
+type AppointmentStatusChangeResponse struct {
+	ID         string    `json:"id"`
+	FromStatus string    `json:"from_status"`
+	ToStatus   string    `json:"to_status"`
+	ActorID    string    `json:"actor_id,omitempty"` // vazio para jobs e integrações
+	ActorName  string    `json:"actor_name,omitempty"`
+	Reason     string    `json:"reason,omitempty"`
+	ChangedAt  time.Time `json:"changed_at"`
+}
+
+// AppointmentTimingRequest filtros dos indicadores de tempo
+type AppointmentTimingRequest struct {
+	StartDate        string `query:"start_date"` // YYYY-MM-DD; padrão: 30 dias até end_date
+	EndDate          string `query:"end_date"`   // YYYY-MM-DD (inclusive); padrão: hoje
+	UnitID           string `query:"unit_id" validate:"omitempty,uuid"`
+	ProfessionalID   string `query:"professional_id" validate:"omitempty,uuid"`
+	ToleranceMinutes *int   `query:"tolerance_minutes"` // padrão: 5
+}
+
+// TimingWaitResponse espera entre o check-in e o início do atendimento
+type TimingWaitResponse struct {
+	Count      int     `json:"count"`
+	AvgMinutes float64 `json:"avg_minutes"`
+}
+
+// TimingServiceDurationResponse duração real x soma das durações dos serviços
+type TimingServiceDurationResponse struct {
+	Count              int     `json:"count"`
+	AvgActualMinutes   float64 `json:"avg_actual_minutes"`
+	AvgExpectedMinutes float64 `json:"avg_expected_minutes"`
+	AvgOverrunMinutes  float64 `json:"avg_overrun_minutes"` // negativo: termina antes
+	OverrunCount       int     `json:"overrun_count"`
+}
+
+// TimingPunctualityResponse chegada do cliente e início do atendimento em
+// relação ao horário marcado
+type TimingPunctualityResponse struct {
+	CheckIns               int     `json:"check_ins"`
+	LateArrivals           int     `json:"late_arrivals"`
+	OnTimeArrivalRate      float64 `json:"on_time_arrival_rate"` // %
+	AvgArrivalDelayMinutes float64 `json:"avg_arrival_delay_minutes"`
+	Starts                 int     `json:"starts"`
+	LateStarts             int     `json:"late_starts"`
+	OnTimeStartRate        float64 `json:"on_time_start_rate"` // %
+	AvgStartDelayMinutes   float64 `json:"avg_start_delay_minutes"`
+}
+
+// TimingCancellationResponse antecedência dos cancelamentos
+type TimingCancellationResponse struct {
+	Count        int     `json:"count"`
+	AvgLeadHours float64 `json:"avg_lead_hours"`
+	LateCount    int     `json:"late_count"`
+	LateRate     float64 `json:"late_rate"` // %
+}
+
+// AppointmentTimingMetricsResponse indicadores de um grupo de agendamentos
+type AppointmentTimingMetricsResponse struct {
+	Appointments    int                           `json:"appointments"`
+	Wait            TimingWaitResponse            `json:"wait"`
+	ServiceDuration TimingServiceDurationResponse `json:"service_duration"`
+	Punctuality     TimingPunctualityResponse     `json:"punctuality"`
+	Cancellations   TimingCancellationResponse    `json:"cancellations"`
+}
+
+// AppointmentTimingUnitResponse indicadores da unidade
+type AppointmentTimingUnitResponse struct {
+	UnitID   string `json:"unit_id,omitempty"`
+	UnitName string `json:"unit_name,omitempty"`
+	AppointmentTimingMetricsResponse
+}
+
+// AppointmentTimingProfessionalResponse indicadores do profissional. Os tempos
+// de atendimento vêm dos agendamentos em que ele é o responsável; agendamentos
+// e cancelamentos incluem também aqueles em que fez algum dos serviços
+type AppointmentTimingProfessionalResponse struct {
+	ProfessionalID   string `json:"professional_id"`
+	ProfessionalName string `json:"professional_name"`
+	AppointmentTimingMetricsResponse
+}
+
+// AppointmentTimingResponse indicadores de tempo do período
+type AppointmentTimingResponse struct {
+	StartDate             string                                  `json:"start_date"`
+	EndDate               string                                  `json:"end_date"`
+	ToleranceMinutes      int                                     `json:"tolerance_minutes"`
+	LateCancellationHours int                                     `json:"late_cancellation_hours"`
+	Total                 AppointmentTimingMetricsResponse        `json:"total"`
+	Units                 []AppointmentTimingUnitResponse         `json:"units"`
+	Professionals         []AppointmentTimingProfessionalResponse `json:"professionals"`
+}
@@ -8,11 +8,20 @@ import "time"
 
 // CreateAppointmentRequest requisição para criar agendamento
 type CreateAppointmentRequest struct {
-	ProfessionalID string    `json:"professional_id" validate:"required,uuid"`
-	CustomerID     string    `json:"customer_id" validate:"required,uuid"`
-	StartTime      time.Time `json:"start_time" validate:"required"`
-	ServiceIDs     []string  `json:"service_ids" validate:"required,min=1,dive,uuid"`
-	Notes          string    `json:"notes,omitempty"`
+	ProfessionalID string                      `json:"professional_id,omitempty" validate:"required_without=Services,omitempty,uuid"`
+	CustomerID     string                      `json:"customer_id" validate:"required,uuid"`
+	StartTime      time.Time                   `json:"start_time" validate:"required"`
+	ServiceIDs     []string                    `json:"service_ids,omitempty" validate:"required_without=Services,dive,uuid"`
+	Services       []AppointmentServiceRequest `json:"services,omitempty" validate:"omitempty,dive"`
+	Notes          string                      `json:"notes,omitempty"`
+}
+
+// AppointmentServiceRequest serviço com o profissional que o executa. Os
+// serviços são executados na ordem enviada; sem professional_id, o serviço
+// fica com o profissional responsável do agendamento.
+type AppointmentServiceRequest struct {
+	ServiceID      string `json:"service_id" validate:"required,uuid"`
+	ProfessionalID string `json:"professional_id,omitempty" validate:"omitempty,uuid"`
 }
 
 // UpdateAppointmentStatusRequest requisição para atualizar status
@@ -21,15 +30,23 @@ type UpdateAppointmentStatusRequest struct {
 	Reason string `json:"reason,omitempty"`
 }
 
+// Escopo de reagendamento/cancelamento em agendamentos de uma série
+const (
+	SeriesScopeThis          = "THIS"               // só a ocorrência (padrão)
+	SeriesScopeThisAndFollow = "THIS_AND_FOLLOWING" // a ocorrência e as seguintes
+)
+
 // RescheduleAppointmentRequest requisição para reagendar
 type RescheduleAppointmentRequest struct {
 	NewStartTime   time.Time `json:"new_start_time" validate:"required"`
 	ProfessionalID string    `json:"professional_id,omitempty" validate:"omitempty,uuid"`
+	Scope          string    `json:"scope,omitempty" validate:"omitempty,oneof=THIS THIS_AND_FOLLOWING"`
 }
 
 // CancelAppointmentRequest requisição para cancelar
 type CancelAppointmentRequest struct {
 	Reason string `json:"reason,omitempty"`
+	Scope  string `json:"scope,omitempty"` // THIS (padrão) ou THIS_AND_FOLLOWING
 }
 
 // ListAppointmentsRequest query params para listagem
@@ -79,10 +96,15 @@ type AppointmentResponse struct {
 
 // AppointmentServiceResponse resposta de serviço do agendamento
 type AppointmentServiceResponse struct {
-	ServiceID   string `json:"service_id"`
-	ServiceName string `json:"service_name"`
-	Price       string `json:"price"`
-	Duration    int    `json:"duration"`
+	ServiceID        string    `json:"service_id"`
+	ServiceName      string    `json:"service_name"`
+	ProfessionalID   string    `json:"professional_id"`
+	ProfessionalName string    `json:"professional_name,omitempty"`
+	Sequence         int       `json:"sequence"`
+	StartTime        time.Time `json:"start_time"`
+	EndTime          time.Time `json:"end_time"`
+	Price            string    `json:"price"`
+	Duration         int       `json:"duration"`
 }
 
 // ListAppointmentsResponse resposta de listagem paginada
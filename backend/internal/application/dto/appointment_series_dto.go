@@ -0,0 +1,76 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Séries de Agendamentos Recorrentes
+// =============================================================================
+
+// CreateAppointmentSeriesRequest requisição para criar uma série
+type CreateAppointmentSeriesRequest struct {
+	ProfessionalID string    `json:"professional_id" validate:"required,uuid"`
+	CustomerID     string    `json:"customer_id" validate:"required,uuid"`
+	ServiceIDs     []string  `json:"service_ids" validate:"required,min=1,dive,uuid"`
+	StartTime      time.Time `json:"start_time" validate:"required"` // primeira ocorrência
+	IntervalWeeks  int       `json:"interval_weeks" validate:"required,min=1,max=8"`
+	EndsOn         string    `json:"ends_on,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	Notes          string    `json:"notes,omitempty"`
+}
+
+// EndAppointmentSeriesRequest requisição para encerrar uma série
+type EndAppointmentSeriesRequest struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// AppointmentSeriesResponse resposta de série
+type AppointmentSeriesResponse struct {
+	ID             string     `json:"id"`
+	UnitID         string     `json:"unit_id"`
+	ProfessionalID string     `json:"professional_id"`
+	CustomerID     string     `json:"customer_id"`
+	ServiceIDs     []string   `json:"service_ids"`
+	StartTime      time.Time  `json:"start_time"`
+	IntervalWeeks  int        `json:"interval_weeks"`
+	EndsOn         *string    `json:"ends_on,omitempty"`
+	Notes          string     `json:"notes,omitempty"`
+	Status         string     `json:"status"`
+	EndedReason    string     `json:"ended_reason,omitempty"`
+	EndedAt        *time.Time `json:"ended_at,omitempty"`
+	GeneratedUntil *time.Time `json:"generated_until,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// SeriesOccurrenceResponse resultado de uma ocorrência da série
+type SeriesOccurrenceResponse struct {
+	Index         int       `json:"index"`
+	StartTime     time.Time `json:"start_time"`
+	Status        string    `json:"status"` // BOOKED, CONFLICT, SKIPPED
+	AppointmentID string    `json:"appointment_id,omitempty"`
+	Detail        string    `json:"detail,omitempty"`
+}
+
+// AppointmentSeriesDetailResponse série com as ocorrências
+type AppointmentSeriesDetailResponse struct {
+	AppointmentSeriesResponse
+	Occurrences []SeriesOccurrenceResponse `json:"occurrences"`
+}
+
+// EndAppointmentSeriesResponse resposta do encerramento
+type EndAppointmentSeriesResponse struct {
+	Series        AppointmentSeriesResponse `json:"series"`
+	CanceledCount int                       `json:"canceled_count"`
+}
+
+// RescheduleFollowingResponse resposta de "remarcar esta e as seguintes"
+type RescheduleFollowingResponse struct {
+	Appointment AppointmentResponse        `json:"appointment"`
+	Series      AppointmentSeriesResponse  `json:"series"`
+	Occurrences []SeriesOccurrenceResponse `json:"occurrences"`
+}
+
+// CancelFollowingResponse resposta de "cancelar esta e as seguintes"
+type CancelFollowingResponse struct {
+	Appointment   AppointmentResponse       `json:"appointment"`
+	Series        AppointmentSeriesResponse `json:"series"`
+	CanceledCount int                       `json:"canceled_count"`
+}
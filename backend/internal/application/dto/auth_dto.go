@@ -16,9 +16,16 @@ type LoginRequest struct {
 }
 
 // LoginResponse - Response de /auth/login
+// Com MFARequired o login só é concluído em /auth/login/2fa com o MFAToken;
+// MFAEnrollmentRequired indica que a política exige cadastrar o 2FA antes.
 type LoginResponse struct {
-	AccessToken string       `json:"access_token"`
-	User        UserResponse `json:"user"`
+	AccessToken           string        `json:"access_token,omitempty"`
+	User                  *UserResponse `json:"user,omitempty"`
+	MFARequired           bool          `json:"mfa_required,omitempty"`
+	MFAEnrollmentRequired bool          `json:"mfa_enrollment_required,omitempty"`
+	MFAToken              string        `json:"mfa_token,omitempty"`
+	// RecoveryCodes vem preenchido só quando o login concluiu o cadastro do 2FA
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
 }
 
 // RefreshResponse - Response de /auth/refresh
@@ -35,6 +42,8 @@ type UserResponse struct {
 	Role     string `json:"role"` // owner, manager, recepcionista, barbeiro, contador
 	// CurrentUnitID representa a unidade ativa do usuário (multi-unidade). Pode vir vazio quando não selecionada.
 	CurrentUnitID string `json:"current_unit_id,omitempty"`
+	// Permissions são as permissões efetivas na unidade ativa (catálogo em GET /roles/permissions)
+	Permissions []string `json:"permissions,omitempty"`
 }
 
 // MeResponse - Response de /auth/me (mesmo que UserResponse)
@@ -47,8 +56,11 @@ type JWTClaims struct {
 	UnitID    string `json:"unit_id,omitempty"`
 	Email     string `json:"email"`
 	Role      string `json:"role"`
-	IssuedAt  int64  `json:"iat"`
-	ExpiresAt int64  `json:"exp"`
+	SessionID string `json:"sid,omitempty"`
+	// Permissions são as permissões efetivas na unidade ativa (nil em tokens antigos)
+	Permissions []string `json:"perms,omitempty"`
+	IssuedAt    int64    `json:"iat"`
+	ExpiresAt   int64    `json:"exp"`
 }
 
 // RefreshTokenData - Dados armazenados do refresh token
@@ -59,3 +71,107 @@ type RefreshTokenData struct {
 	ExpiresAt time.Time
 	CreatedAt time.Time
 }
+
+// SessionResponse - Sessão ativa (dispositivo) em GET /auth/sessions
+type SessionResponse struct {
+	ID         string  `json:"id"`
+	UserID     string  `json:"user_id"`
+	UserNome   string  `json:"user_nome"`
+	UserEmail  string  `json:"user_email"`
+	UserAgent  *string `json:"user_agent,omitempty"`
+	IPAddress  *string `json:"ip_address,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	LastUsedAt string  `json:"last_used_at"`
+	ExpiresAt  string  `json:"expires_at"`
+	Atual      bool    `json:"atual"` // sessão da própria requisição
+}
+
+// ForgotPasswordRequest - Request para /auth/forgot-password
+type ForgotPasswordRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// ResetPasswordRequest - Request para /auth/reset-password
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// CreateInvitationRequest - Request para POST /auth/invitations
+type CreateInvitationRequest struct {
+	Email  string `json:"email" validate:"required,email"`
+	Nome   string `json:"nome" validate:"required,min=2,max=255"`
+	Role   string `json:"role" validate:"required,oneof=MANAGER RECEPTIONIST BARBER"`
+	UnitID string `json:"unit_id" validate:"required,uuid"`
+}
+
+// AcceptInvitationRequest - Request para /auth/invitations/accept
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// InvitationResponse - Convite de colaborador
+type InvitationResponse struct {
+	ID         string  `json:"id"`
+	UnitID     string  `json:"unit_id"`
+	Email      string  `json:"email"`
+	Nome       string  `json:"nome"`
+	Role       string  `json:"role"`
+	Status     string  `json:"status"` // PENDENTE, ACEITO, REVOGADO, EXPIRADO
+	InvitedBy  *string `json:"invited_by,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+	ExpiresAt  string  `json:"expires_at"`
+	AcceptedAt *string `json:"accepted_at,omitempty"`
+}
+
+// InvitationPreviewResponse - Dados exibidos na tela de aceite do convite
+type InvitationPreviewResponse struct {
+	Email      string `json:"email"`
+	Nome       string `json:"nome"`
+	Role       string `json:"role"`
+	TenantNome string `json:"tenant_nome"`
+	UnitNome   string `json:"unit_nome"`
+	ExpiresAt  string `json:"expires_at"`
+}
+
+// LoginMFARequest - Request para /auth/login/2fa (segundo passo do login)
+type LoginMFARequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+	Code     string `json:"code" validate:"required"` // código TOTP ou de recuperação
+}
+
+// LoginMFASetupRequest - Request para /auth/login/2fa/setup (cadastro exigido pela política)
+type LoginMFASetupRequest struct {
+	MFAToken string `json:"mfa_token" validate:"required"`
+}
+
+// TwoFactorCodeRequest - Código TOTP (ou de recuperação) para confirmar/desativar o 2FA
+type TwoFactorCodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// TwoFactorSetupResponse - Dados para cadastrar o app autenticador (QR code)
+type TwoFactorSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth://totp/...
+}
+
+// TwoFactorStatusResponse - Situação do 2FA do usuário
+type TwoFactorStatusResponse struct {
+	Ativo                  bool    `json:"ativo"`
+	ConfirmadoEm           *string `json:"confirmado_em,omitempty"`
+	CodigosRecuperacao     int64   `json:"codigos_recuperacao_restantes"`
+	ExigidoPelaPolitica    bool    `json:"exigido_pela_politica"`
+	PoliticaExigir2FAAdmin bool    `json:"politica_exigir_2fa_admin"`
+}
+
+// RecoveryCodesResponse - Códigos de recuperação (exibidos uma única vez)
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// TwoFactorPolicyRequest - Política do tenant para 2FA de proprietários e gerentes
+type TwoFactorPolicyRequest struct {
+	Exigir2FAAdmin *bool `json:"exigir_2fa_admin" validate:"required"`
+}
@@ -10,7 +10,8 @@ package dto
 
 // AbrirCaixaRequest representa a requisição para abrir o caixa
 type AbrirCaixaRequest struct {
-	SaldoInicial string `json:"saldo_inicial" validate:"required"`
+	SaldoInicial   string `json:"saldo_inicial" validate:"required"`
+	FechamentoCego bool   `json:"fechamento_cego"`
 }
 
 // ---------- SANGRIA ----------
@@ -34,10 +35,23 @@ type ReforcoRequest struct {
 
 // ---------- FECHAMENTO ----------
 
-// FecharCaixaRequest representa a requisição para fechar o caixa
+// FecharCaixaRequest representa a requisição para fechar o caixa.
+// Informe a contagem por denominação ou, sem ela, o saldo_real total.
 type FecharCaixaRequest struct {
-	SaldoReal     string  `json:"saldo_real" validate:"required"`
-	Justificativa *string `json:"justificativa,omitempty"`
+	SaldoReal     string                `json:"saldo_real,omitempty"`
+	Contagem      []ItemContagemRequest `json:"contagem,omitempty"`
+	Justificativa *string               `json:"justificativa,omitempty"`
+}
+
+// ItemContagemRequest representa a quantidade contada de uma cédula ou moeda
+type ItemContagemRequest struct {
+	Denominacao string `json:"denominacao" validate:"required"` // ex.: "100.00", "0.25"
+	Quantidade  int    `json:"quantidade" validate:"min=0"`
+}
+
+// RejeitarFechamentoRequest representa a devolução do caixa para recontagem
+type RejeitarFechamentoRequest struct {
+	Motivo string `json:"motivo" validate:"required,min=5"`
 }
 
 // ---------- LISTAGEM ----------
@@ -63,20 +77,43 @@ type CaixaDiarioResponse struct {
 	DataAbertura             string                  `json:"data_abertura"`
 	DataFechamento           *string                 `json:"data_fechamento,omitempty"`
 	SaldoInicial             string                  `json:"saldo_inicial"`
-	TotalEntradas            string                  `json:"total_entradas"`
+	TotalEntradas            string                  `json:"total_entradas,omitempty"`
 	TotalSaidas              string                  `json:"total_saidas"`
 	TotalSangrias            string                  `json:"total_sangrias"`
 	TotalReforcos            string                  `json:"total_reforcos"`
-	SaldoEsperado            string                  `json:"saldo_esperado"`
+	SaldoEsperado            string                  `json:"saldo_esperado,omitempty"`
+	SaldoEsperadoDinheiro    string                  `json:"saldo_esperado_dinheiro,omitempty"`
+	TotaisPorFormaPagamento  map[string]string       `json:"totais_por_forma_pagamento,omitempty"`
 	SaldoReal                *string                 `json:"saldo_real,omitempty"`
 	Divergencia              *string                 `json:"divergencia,omitempty"`
 	Status                   string                  `json:"status"`
 	JustificativaDivergencia *string                 `json:"justificativa_divergencia,omitempty"`
+	FechamentoCego           bool                    `json:"fechamento_cego"`
+	ValoresOcultos           bool                    `json:"valores_ocultos,omitempty"`
+	Contagem                 *ContagemCaixaResponse  `json:"contagem,omitempty"`
+	UsuarioAprovacaoID       *string                 `json:"usuario_aprovacao_id,omitempty"`
+	DataAprovacao            *string                 `json:"data_aprovacao,omitempty"`
 	CreatedAt                string                  `json:"created_at"`
 	UpdatedAt                string                  `json:"updated_at"`
 	Operacoes                []OperacaoCaixaResponse `json:"operacoes,omitempty"`
 }
 
+// ContagemCaixaResponse representa a contagem da gaveta no fechamento
+type ContagemCaixaResponse struct {
+	Itens        []ItemContagemResponse `json:"itens"`
+	TotalCedulas string                 `json:"total_cedulas"`
+	TotalMoedas  string                 `json:"total_moedas"`
+	Total        string                 `json:"total"`
+}
+
+// ItemContagemResponse representa uma denominação contada
+type ItemContagemResponse struct {
+	Denominacao string `json:"denominacao"`
+	Tipo        string `json:"tipo"` // CEDULA ou MOEDA
+	Quantidade  int    `json:"quantidade"`
+	Subtotal    string `json:"subtotal"`
+}
+
 // CaixaDiarioResumoResponse representa um resumo do caixa (para listagens)
 type CaixaDiarioResumoResponse struct {
 	ID                    string  `json:"id"`
@@ -94,16 +131,17 @@ type CaixaDiarioResumoResponse struct {
 
 // OperacaoCaixaResponse representa uma operação do caixa
 type OperacaoCaixaResponse struct {
-	ID           string  `json:"id"`
-	Tipo         string  `json:"tipo"`
-	Valor        string  `json:"valor"`
-	Descricao    string  `json:"descricao"`
-	Destino      *string `json:"destino,omitempty"`
-	Origem       *string `json:"origem,omitempty"`
-	UsuarioID    string  `json:"usuario_id"`
-	UsuarioNome  string  `json:"usuario_nome"`
-	ContaPagarID *string `json:"conta_pagar_id,omitempty"`
-	CreatedAt    string  `json:"created_at"`
+	ID             string  `json:"id"`
+	Tipo           string  `json:"tipo"`
+	Valor          string  `json:"valor"`
+	Descricao      string  `json:"descricao"`
+	Destino        *string `json:"destino,omitempty"`
+	Origem         *string `json:"origem,omitempty"`
+	UsuarioID      string  `json:"usuario_id"`
+	UsuarioNome    string  `json:"usuario_nome"`
+	ContaPagarID   *string `json:"conta_pagar_id,omitempty"`
+	FormaPagamento *string `json:"forma_pagamento,omitempty"`
+	CreatedAt      string  `json:"created_at"`
 }
 
 // CaixaStatusResponse representa o status atual do caixa
@@ -129,4 +167,7 @@ type TotaisCaixaResponse struct {
 	TotalReforcos string `json:"total_reforcos"`
 	TotalDespesas string `json:"total_despesas"`
 	SaldoAtual    string `json:"saldo_atual"`
+	// Vendas por forma de pagamento e dinheiro esperado na gaveta
+	PorFormaPagamento map[string]string `json:"por_forma_pagamento,omitempty"`
+	SaldoDinheiro     string            `json:"saldo_dinheiro"`
 }
@@ -0,0 +1,25 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Feeds iCalendar da Agenda
+// =============================================================================
+
+// CreateCalendarFeedRequest requisição para criar um feed .ics. Sem
+// professional_id o feed traz a agenda da unidade inteira.
+type CreateCalendarFeedRequest struct {
+	ProfessionalID string `json:"professional_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// CalendarFeedResponse feed da agenda
+type CalendarFeedResponse struct {
+	ID               string     `json:"id"`
+	UnitID           string     `json:"unit_id"`
+	ProfessionalID   string     `json:"professional_id,omitempty"`
+	ProfessionalName string     `json:"professional_name,omitempty"`
+	Scope            string     `json:"scope"`         // PROFESSIONAL ou UNIT
+	URL              string     `json:"url,omitempty"` // só na criação: o token não é recuperável
+	LastAccessedAt   *time.Time `json:"last_accessed_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Sincronização com Calendários Externos
+// =============================================================================
+
+// AuthorizeCalendarConnectionRequest requisição para conectar o Google Agenda
+// de um profissional. Barbeiros conectam a própria agenda (campo ignorado).
+type AuthorizeCalendarConnectionRequest struct {
+	ProfessionalID string `json:"professional_id,omitempty" validate:"omitempty,uuid"`
+}
+
+// AuthorizeCalendarConnectionResponse link de consentimento do provedor
+type AuthorizeCalendarConnectionResponse struct {
+	URL string `json:"url"`
+}
+
+// CalendarConnectionResponse conexão com calendário externo
+type CalendarConnectionResponse struct {
+	ID               string     `json:"id"`
+	ProfessionalID   string     `json:"professional_id"`
+	ProfessionalName string     `json:"professional_name,omitempty"`
+	Provider         string     `json:"provider"`
+	AccountEmail     string     `json:"account_email,omitempty"`
+	Status           string     `json:"status"` // ACTIVE ou ERROR (reconectar)
+	LastError        string     `json:"last_error,omitempty"`
+	LastSyncedAt     *time.Time `json:"last_synced_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+// CalendarSyncConflictResponse evento externo em conflito com agendamento
+type CalendarSyncConflictResponse struct {
+	ExternalEventID string    `json:"external_event_id"`
+	BlockedTimeID   string    `json:"blocked_time_id"`
+	StartTime       time.Time `json:"start_time"`
+	EndTime         time.Time `json:"end_time"`
+}
+
+// CalendarSyncResponse resultado da sincronização manual
+type CalendarSyncResponse struct {
+	Connection CalendarConnectionResponse     `json:"connection"`
+	FullSync   bool                           `json:"full_sync"`
+	Imported   int                            `json:"imported"`
+	Removed    int                            `json:"removed"`
+	Conflicts  []CalendarSyncConflictResponse `json:"conflicts"`
+}
@@ -80,6 +80,7 @@ type CommandResponse struct {
 	TotalRecebido      string                   `json:"total_recebido"`
 	Troco              string                   `json:"troco"`
 	SaldoDevedor       string                   `json:"saldo_devedor"`
+	SaldoFiadoCliente  *string                  `json:"saldo_fiado_cliente,omitempty"` // Dívidas em aberto do cliente
 	Observacoes        *string                  `json:"observacoes,omitempty"`
 	DeixarTrocoGorjeta bool                     `json:"deixar_troco_gorjeta"`
 	DeixarSaldoDivida  bool                     `json:"deixar_saldo_divida"`
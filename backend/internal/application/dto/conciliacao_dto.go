@@ -0,0 +1,67 @@
+package dto
+
+// ============================================================
+// CONCILIAÇÃO BANCÁRIA - Request DTOs
+// ============================================================
+
+// ConciliarLinhaRequest vincula manualmente um lançamento do extrato a um registro interno
+type ConciliarLinhaRequest struct {
+	MatchTipo string `json:"match_tipo" validate:"required,oneof=COMPENSACAO CONTA_RECEBER CONTA_PAGAR"`
+	MatchID   string `json:"match_id" validate:"required,uuid"`
+}
+
+// RevisarLinhaRequest representa a decisão (aceitar/ignorar) sobre um lançamento em revisão
+type RevisarLinhaRequest struct {
+	Observacao *string `json:"observacao,omitempty"`
+}
+
+// ============================================================
+// CONCILIAÇÃO BANCÁRIA - Response DTOs
+// ============================================================
+
+// BankStatementImportResponse representa um extrato importado
+type BankStatementImportResponse struct {
+	ID               string  `json:"id"`
+	ArquivoNome      string  `json:"arquivo_nome"`
+	Formato          string  `json:"formato"`
+	ContaBancaria    *string `json:"conta_bancaria,omitempty"`
+	PeriodoInicio    *string `json:"periodo_inicio,omitempty"`
+	PeriodoFim       *string `json:"periodo_fim,omitempty"`
+	TotalLinhas      int     `json:"total_linhas"`
+	TotalConciliadas int     `json:"total_conciliadas"`
+	TotalPendentes   int     `json:"total_pendentes"`
+	ImportadoPor     *string `json:"importado_por,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+}
+
+// BankStatementLineResponse representa um lançamento do extrato
+type BankStatementLineResponse struct {
+	ID             string  `json:"id"`
+	ImportID       string  `json:"import_id"`
+	DataLancamento string  `json:"data_lancamento"`
+	Descricao      string  `json:"descricao"`
+	Valor          string  `json:"valor"`
+	Tipo           string  `json:"tipo"`
+	Status         string  `json:"status"`
+	MatchTipo      *string `json:"match_tipo,omitempty"`
+	MatchID        *string `json:"match_id,omitempty"`
+	Diferenca      *string `json:"diferenca,omitempty"`
+	Observacao     *string `json:"observacao,omitempty"`
+	ConciliadoEm   *string `json:"conciliado_em,omitempty"`
+}
+
+// ImportarExtratoResponse resume o resultado da importação
+type ImportarExtratoResponse struct {
+	Import      BankStatementImportResponse `json:"import"`
+	Novas       int                         `json:"novas"`
+	Duplicadas  int                         `json:"duplicadas"`
+	Conciliadas int                         `json:"conciliadas"`
+	Divergentes int                         `json:"divergentes"`
+	Linhas      []BankStatementLineResponse `json:"linhas"`
+}
+
+// BankStatementImportDetailResponse representa um extrato com seus lançamentos
+type BankStatementImportDetailResponse struct {
+	Import BankStatementImportResponse `json:"import"`
+	Linhas []BankStatementLineResponse `json:"linhas"`
+}
@@ -0,0 +1,67 @@
+package dto
+
+// ============================================================
+// EXPORTAÇÃO CONTÁBIL - Request DTOs
+// ============================================================
+
+// SalvarMapeamentoContabilRequest associa uma origem a uma conta do plano de contas
+type SalvarMapeamentoContabilRequest struct {
+	OrigemTipo  string `json:"origem_tipo" validate:"required,oneof=CATEGORIA MEIO_PAGAMENTO PADRAO"`
+	OrigemChave string `json:"origem_chave" validate:"required"`
+	ContaCodigo string `json:"conta_codigo" validate:"required"`
+	ContaNome   string `json:"conta_nome"`
+}
+
+// ============================================================
+// EXPORTAÇÃO CONTÁBIL - Response DTOs
+// ============================================================
+
+// ContaPadraoResponse representa uma conta fixa do plano (com personalização, se houver)
+type ContaPadraoResponse struct {
+	Chave       string `json:"chave"`
+	ContaCodigo string `json:"conta_codigo"`
+	ContaNome   string `json:"conta_nome"`
+}
+
+// MapeamentoContabilResponse representa um mapeamento cadastrado
+type MapeamentoContabilResponse struct {
+	ID          string `json:"id"`
+	OrigemTipo  string `json:"origem_tipo"`
+	OrigemChave string `json:"origem_chave"`
+	ContaCodigo string `json:"conta_codigo"`
+	ContaNome   string `json:"conta_nome"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// PlanoContasResponse representa o plano de contas do tenant
+type PlanoContasResponse struct {
+	Padrao      []ContaPadraoResponse        `json:"padrao"`
+	Mapeamentos []MapeamentoContabilResponse `json:"mapeamentos"`
+}
+
+// PartidaContabilResponse representa uma partida (débito ou crédito)
+type PartidaContabilResponse struct {
+	ContaCodigo string `json:"conta_codigo"`
+	ContaNome   string `json:"conta_nome"`
+	Natureza    string `json:"natureza"`
+	Valor       string `json:"valor"`
+}
+
+// LancamentoContabilResponse representa um lançamento de partidas dobradas
+type LancamentoContabilResponse struct {
+	Lote      int                       `json:"lote"`
+	Data      string                    `json:"data"`
+	Historico string                    `json:"historico"`
+	Documento string                    `json:"documento"`
+	Origem    string                    `json:"origem"`
+	Partidas  []PartidaContabilResponse `json:"partidas"`
+}
+
+// LancamentosContabeisResponse representa os lançamentos do mês com a prova de fechamento
+type LancamentosContabeisResponse struct {
+	MesAno        string                       `json:"mes_ano"`
+	TotalDebitos  string                       `json:"total_debitos"`
+	TotalCreditos string                       `json:"total_creditos"`
+	Balanceado    bool                         `json:"balanceado"`
+	Lancamentos   []LancamentoContabilResponse `json:"lancamentos"`
+}
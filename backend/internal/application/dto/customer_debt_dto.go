@@ -0,0 +1,69 @@
+package dto
+
+// ============================================================
+// FIADO (DÍVIDAS DE CLIENTES) - Request DTOs
+// ============================================================
+
+// RegistrarPagamentoDividaRequest representa o pagamento (parcial ou total) de uma dívida
+type RegistrarPagamentoDividaRequest struct {
+	MeioPagamentoID string  `json:"meio_pagamento_id" validate:"required,uuid"`
+	Valor           string  `json:"valor" validate:"required"`
+	CommandID       *string `json:"command_id,omitempty" validate:"omitempty,uuid"` // Comanda atual em que o saldo é quitado
+	Observacoes     *string `json:"observacoes,omitempty"`
+}
+
+// ============================================================
+// FIADO (DÍVIDAS DE CLIENTES) - Response DTOs
+// ============================================================
+
+// CustomerDebtResponse representa uma dívida do cliente
+type CustomerDebtResponse struct {
+	ID            string                        `json:"id"`
+	CustomerID    string                        `json:"customer_id"`
+	CommandID     string                        `json:"command_id"`
+	ValorOriginal string                        `json:"valor_original"`
+	ValorPago     string                        `json:"valor_pago"`
+	Saldo         string                        `json:"saldo"`
+	Status        string                        `json:"status"`
+	DataAbertura  string                        `json:"data_abertura"`
+	DataQuitacao  *string                       `json:"data_quitacao,omitempty"`
+	DiasEmAberto  int                           `json:"dias_em_aberto"`
+	Observacoes   *string                       `json:"observacoes,omitempty"`
+	Pagamentos    []CustomerDebtPaymentResponse `json:"pagamentos"`
+}
+
+// CustomerDebtPaymentResponse representa um pagamento de dívida
+type CustomerDebtPaymentResponse struct {
+	ID              string  `json:"id"`
+	CommandID       *string `json:"command_id,omitempty"`
+	MeioPagamentoID string  `json:"meio_pagamento_id"`
+	Valor           string  `json:"valor"`
+	ContaReceberID  *string `json:"conta_receber_id,omitempty"`
+	OperacaoCaixaID *string `json:"operacao_caixa_id,omitempty"`
+	Observacoes     *string `json:"observacoes,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+}
+
+// CustomerDebtListResponse representa as dívidas de um cliente com o saldo consolidado
+type CustomerDebtListResponse struct {
+	CustomerID string                 `json:"customer_id"`
+	SaldoTotal string                 `json:"saldo_total"`
+	Dividas    []CustomerDebtResponse `json:"dividas"`
+}
+
+// CustomerDebtAgingResponse representa uma linha do relatório de fiado
+type CustomerDebtAgingResponse struct {
+	CustomerID        string `json:"customer_id"`
+	CustomerNome      string `json:"customer_nome"`
+	CustomerTelefone  string `json:"customer_telefone"`
+	QuantidadeDividas int64  `json:"quantidade_dividas"`
+	SaldoTotal        string `json:"saldo_total"`
+	DividaMaisAntiga  string `json:"divida_mais_antiga"`
+	DiasEmAberto      int    `json:"dias_em_aberto"`
+}
+
+// CustomerDebtAgingReportResponse representa o relatório de fiado completo
+type CustomerDebtAgingReportResponse struct {
+	Clientes   []CustomerDebtAgingResponse `json:"clientes"`
+	SaldoTotal string                      `json:"saldo_total"`
+}
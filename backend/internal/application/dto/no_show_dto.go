@@ -0,0 +1,54 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Política de Não Comparecimento
+// =============================================================================
+
+// UpdateNoShowPolicyRequest requisição para configurar a política do tenant
+type UpdateNoShowPolicyRequest struct {
+	Enabled              bool   `json:"enabled"`
+	MaxNoShows           int    `json:"max_no_shows" validate:"required,min=1"`
+	WindowDays           int    `json:"window_days" validate:"required,min=1,max=730"`
+	DepositType          string `json:"deposit_type" validate:"required,oneof=FIXED PERCENTAGE"`
+	DepositValue         string `json:"deposit_value" validate:"required"` // R$ (FIXED) ou % (PERCENTAGE)
+	PaymentDeadlineHours int    `json:"payment_deadline_hours" validate:"required,min=1,max=168"`
+	MeioPagamentoID      string `json:"meio_pagamento_id,omitempty" validate:"omitempty,uuid"` // crédito do sinal na comanda
+}
+
+// NoShowPolicyResponse política de não comparecimento do tenant
+type NoShowPolicyResponse struct {
+	Enabled              bool   `json:"enabled"`
+	MaxNoShows           int    `json:"max_no_shows"`
+	WindowDays           int    `json:"window_days"`
+	DepositType          string `json:"deposit_type"`
+	DepositValue         string `json:"deposit_value"`
+	PaymentDeadlineHours int    `json:"payment_deadline_hours"`
+	MeioPagamentoID      string `json:"meio_pagamento_id,omitempty"`
+}
+
+// CustomerReliabilityResponse confiabilidade do cliente exibida ao agendar
+type CustomerReliabilityResponse struct {
+	CustomerID      string `json:"customer_id"`
+	Score           int    `json:"score"` // 0 a 100
+	Attended        int    `json:"attended"`
+	NoShows         int    `json:"no_shows"`
+	Canceled        int    `json:"canceled"`
+	WindowDays      int    `json:"window_days"`
+	DepositRequired bool   `json:"deposit_required"`
+}
+
+// AppointmentDepositResponse sinal do agendamento
+type AppointmentDepositResponse struct {
+	ID            string     `json:"id"`
+	AppointmentID string     `json:"appointment_id"`
+	CustomerID    string     `json:"customer_id"`
+	Amount        string     `json:"amount"`
+	Status        string     `json:"status"` // PENDING, PAID, CREDITED, RETAINED, CANCELED, EXPIRED
+	InvoiceURL    string     `json:"invoice_url,omitempty"`
+	PixPayload    string     `json:"pix_payload,omitempty"` // PIX copia e cola
+	DueAt         time.Time  `json:"due_at"`
+	PaidAt        *time.Time `json:"paid_at,omitempty"`
+	SettledAt     *time.Time `json:"settled_at,omitempty"`
+}
@@ -0,0 +1,52 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Recursos da Unidade
+// =============================================================================
+
+// CreateResourceRequest requisição para cadastrar um recurso (cadeira, sala,
+// lavatório)
+type CreateResourceRequest struct {
+	Nome     string `json:"nome" validate:"required,max=100"`
+	Capacity int    `json:"capacity" validate:"required,min=1"` // atendimentos simultâneos
+}
+
+// UpdateResourceRequest requisição para alterar um recurso
+type UpdateResourceRequest struct {
+	Nome     string `json:"nome" validate:"required,max=100"`
+	Capacity int    `json:"capacity" validate:"required,min=1"`
+	Ativo    bool   `json:"ativo"`
+}
+
+// ResourceResponse recurso da unidade
+type ResourceResponse struct {
+	ID        string    `json:"id"`
+	UnitID    string    `json:"unit_id"`
+	Nome      string    `json:"nome"`
+	Capacity  int       `json:"capacity"`
+	Ativo     bool      `json:"ativo"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ServiceResourceRequest recurso exigido por um serviço
+type ServiceResourceRequest struct {
+	ResourceID      string `json:"resource_id" validate:"required,uuid"`
+	OffsetMinutes   int    `json:"offset_minutes" validate:"min=0"`   // minutos após o início do serviço
+	DurationMinutes int    `json:"duration_minutes" validate:"min=0"` // 0 = até o fim do serviço
+}
+
+// SetServiceResourcesRequest requisição para definir os recursos do serviço
+type SetServiceResourcesRequest struct {
+	Resources []ServiceResourceRequest `json:"resources" validate:"dive"`
+}
+
+// ServiceResourceResponse recurso exigido por um serviço
+type ServiceResourceResponse struct {
+	ResourceID      string `json:"resource_id"`
+	ResourceName    string `json:"resource_name"`
+	OffsetMinutes   int    `json:"offset_minutes"`
+	DurationMinutes int    `json:"duration_minutes"`
+}
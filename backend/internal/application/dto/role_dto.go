@@ -0,0 +1,44 @@
+package dto
+
+// =============================================================================
+// PERMISSÕES E PAPÉIS PERSONALIZADOS
+// =============================================================================
+
+// PermissionInfoResponse - Permissão do catálogo
+type PermissionInfoResponse struct {
+	Code      string `json:"code"`
+	Descricao string `json:"descricao"`
+}
+
+// PermissionCatalogResponse - Catálogo de permissões e modelos dos papéis fixos
+type PermissionCatalogResponse struct {
+	Permissions []PermissionInfoResponse `json:"permissions"`
+	Templates   map[string][]string      `json:"templates"` // papel fixo → permissões
+}
+
+// TenantRoleRequest - Criação/edição de papel personalizado
+type TenantRoleRequest struct {
+	Nome       string   `json:"nome" validate:"required,min=2,max=60"`
+	Descricao  *string  `json:"descricao,omitempty" validate:"omitempty,max=255"`
+	BaseRole   string   `json:"base_role" validate:"required,oneof=MANAGER RECEPTIONIST BARBER ACCOUNTANT"`
+	Permissoes []string `json:"permissoes" validate:"required"`
+}
+
+// TenantRoleResponse - Papel personalizado do tenant
+type TenantRoleResponse struct {
+	ID           string   `json:"id"`
+	Nome         string   `json:"nome"`
+	Descricao    *string  `json:"descricao,omitempty"`
+	BaseRole     string   `json:"base_role"`
+	Permissoes   []string `json:"permissoes"`
+	CriadoEm     string   `json:"criado_em"`
+	AtualizadoEm string   `json:"atualizado_em"`
+}
+
+// AssignTenantRoleRequest - Atribui (ou remove, com custom_role_id vazio) o
+// papel personalizado do usuário em uma unidade
+type AssignTenantRoleRequest struct {
+	UserID       string  `json:"user_id" validate:"required,uuid"`
+	UnitID       string  `json:"unit_id" validate:"required,uuid"`
+	CustomRoleID *string `json:"custom_role_id,omitempty" validate:"omitempty,uuid"`
+}
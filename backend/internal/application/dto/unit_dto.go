@@ -83,6 +83,8 @@ type SwitchUnitRequest struct {
 type SwitchUnitResponse struct {
 	Unit        UserUnitResponse `json:"unit"`
 	AccessToken string           `json:"access_token"` // Novo token com unit_id
+	Role        string           `json:"role"`         // Papel do usuário na unidade
+	Permissions []string         `json:"permissions"`  // Permissões efetivas na unidade
 }
 
 // AddUserToUnitRequest request para adicionar usuário à unidade
@@ -90,7 +92,7 @@ type AddUserToUnitRequest struct {
 	UserID       string  `json:"user_id" validate:"required,uuid"`
 	UnitID       string  `json:"unit_id" validate:"required,uuid"`
 	IsDefault    bool    `json:"is_default,omitempty"`
-	RoleOverride *string `json:"role_override,omitempty"`
+	RoleOverride *string `json:"role_override,omitempty" validate:"omitempty,oneof=MANAGER RECEPTIONIST BARBER ACCOUNTANT"`
 }
 
 // SetDefaultUnitRequest request para definir unidade padrão
@@ -0,0 +1,56 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Lista de Espera
+// =============================================================================
+
+// CreateWaitlistEntryRequest requisição para incluir cliente na lista de espera
+type CreateWaitlistEntryRequest struct {
+	CustomerID      string    `json:"customer_id" validate:"required,uuid"`
+	ServiceIDs      []string  `json:"service_ids" validate:"required,min=1,dive,uuid"`
+	ProfessionalIDs []string  `json:"professional_ids,omitempty" validate:"omitempty,dive,uuid"` // vazio = qualquer profissional
+	WindowStart     time.Time `json:"window_start" validate:"required"`
+	WindowEnd       time.Time `json:"window_end" validate:"required"`
+	Priority        int       `json:"priority,omitempty"`
+	Notes           string    `json:"notes,omitempty"`
+}
+
+// WaitlistEntryResponse resposta de entrada da lista de espera
+type WaitlistEntryResponse struct {
+	ID              string    `json:"id"`
+	UnitID          string    `json:"unit_id"`
+	CustomerID      string    `json:"customer_id"`
+	ServiceIDs      []string  `json:"service_ids"`
+	ProfessionalIDs []string  `json:"professional_ids"`
+	WindowStart     time.Time `json:"window_start"`
+	WindowEnd       time.Time `json:"window_end"`
+	Priority        int       `json:"priority"`
+	Notes           string    `json:"notes,omitempty"`
+	Status          string    `json:"status"` // WAITING, OFFERED, FULFILLED, CANCELED, EXPIRED
+	AppointmentID   string    `json:"appointment_id,omitempty"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+// WaitlistOfferTokenRequest confirmação ou recusa pelo token do link
+type WaitlistOfferTokenRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// WaitlistOfferResponse oferta exibida na página do link (pública)
+type WaitlistOfferResponse struct {
+	ProfessionalID string    `json:"professional_id"`
+	ServiceIDs     []string  `json:"service_ids"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	ExpiresAt      time.Time `json:"expires_at"`
+	Status         string    `json:"status"` // PENDING, CLAIMED, DECLINED, EXPIRED, CANCELED
+}
+
+// ClaimWaitlistOfferResponse resposta da confirmação
+type ClaimWaitlistOfferResponse struct {
+	AppointmentID string    `json:"appointment_id"`
+	StartTime     time.Time `json:"start_time"`
+	EndTime       time.Time `json:"end_time"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+// =============================================================================
+// DTOs para Fila de Encaixe
+// =============================================================================
+
+// CheckInWalkInRequest requisição para registrar a chegada de cliente sem
+// horário marcado
+type CheckInWalkInRequest struct {
+	CustomerID string   `json:"customer_id" validate:"required,uuid"`
+	ServiceIDs []string `json:"service_ids" validate:"required,min=1,dive,uuid"` // na ordem de execução
+	Notes      string   `json:"notes,omitempty"`
+}
+
+// WalkInResponse cliente da fila de encaixe
+type WalkInResponse struct {
+	ID                   string     `json:"id"`
+	UnitID               string     `json:"unit_id"`
+	CustomerID           string     `json:"customer_id"`
+	CustomerName         string     `json:"customer_name,omitempty"`
+	ServiceIDs           []string   `json:"service_ids"`
+	DurationMinutes      int        `json:"duration_minutes"`
+	Notes                string     `json:"notes,omitempty"`
+	Status               string     `json:"status"` // WAITING, ASSIGNED, CANCELED
+	ProfessionalID       string     `json:"professional_id,omitempty"`
+	AppointmentID        string     `json:"appointment_id,omitempty"`
+	Position             int        `json:"position,omitempty"`               // posição na fila (WAITING)
+	EstimatedStart       *time.Time `json:"estimated_start,omitempty"`        // previsão de início (WAITING)
+	EstimatedWaitMinutes *int       `json:"estimated_wait_minutes,omitempty"` // espera prevista a partir de agora
+	CheckedInAt          time.Time  `json:"checked_in_at"`
+	AssignedAt           *time.Time `json:"assigned_at,omitempty"`
+}
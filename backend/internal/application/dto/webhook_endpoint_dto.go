@@ -0,0 +1,83 @@
+package dto
+
+// =============================================================================
+// WEBHOOKS DE SAÍDA
+// =============================================================================
+
+// WebhookEventResponse - Evento do catálogo
+type WebhookEventResponse struct {
+	Code      string `json:"code"`
+	Descricao string `json:"descricao"`
+}
+
+// CreateWebhookEndpointRequest - Cadastro de endpoint
+type CreateWebhookEndpointRequest struct {
+	URL       string   `json:"url" validate:"required,url,max=500"`
+	Descricao *string  `json:"descricao,omitempty" validate:"omitempty,max=120"`
+	Eventos   []string `json:"eventos" validate:"required,min=1"`
+}
+
+// UpdateWebhookEndpointRequest - Alteração de endpoint
+type UpdateWebhookEndpointRequest struct {
+	URL       string   `json:"url" validate:"required,url,max=500"`
+	Descricao *string  `json:"descricao,omitempty" validate:"omitempty,max=120"`
+	Eventos   []string `json:"eventos" validate:"required,min=1"`
+	Ativo     bool     `json:"ativo"`
+}
+
+// WebhookEndpointResponse - Endpoint (sem o segredo)
+type WebhookEndpointResponse struct {
+	ID           string   `json:"id"`
+	URL          string   `json:"url"`
+	Descricao    *string  `json:"descricao,omitempty"`
+	Eventos      []string `json:"eventos"`
+	Ativo        bool     `json:"ativo"`
+	CriadoEm     string   `json:"criado_em"`
+	AtualizadoEm string   `json:"atualizado_em"`
+}
+
+// WebhookEndpointSecretResponse - Endpoint criado ou com segredo rotacionado;
+// Secret só é retornado nesta resposta
+type WebhookEndpointSecretResponse struct {
+	WebhookEndpointResponse
+	Secret string `json:"secret"`
+}
+
+// ListWebhookDeliveriesFilter - Filtros da listagem de entregas
+type ListWebhookDeliveriesFilter struct {
+	EndpointID string `query:"endpoint_id"`
+	Status     string `query:"status"`
+	Limit      int    `query:"limit"`
+}
+
+// WebhookDeliveryResponse - Entrega de um evento a um endpoint
+type WebhookDeliveryResponse struct {
+	ID                 string  `json:"id"`
+	EndpointID         string  `json:"endpoint_id"`
+	Evento             string  `json:"evento"`
+	EventID            string  `json:"event_id"`
+	Status             string  `json:"status"`
+	Tentativas         int32   `json:"tentativas"`
+	ProximaTentativaEm *string `json:"proxima_tentativa_em,omitempty"`
+	UltimoStatusHTTP   *int32  `json:"ultimo_status_http,omitempty"`
+	UltimoErro         *string `json:"ultimo_erro,omitempty"`
+	EntregueEm         *string `json:"entregue_em,omitempty"`
+	CriadoEm           string  `json:"criado_em"`
+}
+
+// WebhookDeliveryAttemptResponse - Tentativa de entrega
+type WebhookDeliveryAttemptResponse struct {
+	Tentativa  int32   `json:"tentativa"`
+	StatusHTTP *int32  `json:"status_http,omitempty"`
+	Erro       *string `json:"erro,omitempty"`
+	Resposta   *string `json:"resposta,omitempty"`
+	DuracaoMs  int32   `json:"duracao_ms"`
+	CriadoEm   string  `json:"criado_em"`
+}
+
+// WebhookDeliveryDetailResponse - Entrega com payload e histórico de tentativas
+type WebhookDeliveryDetailResponse struct {
+	WebhookDeliveryResponse
+	Payload   map[string]any                   `json:"payload"`
+	Historico []WebhookDeliveryAttemptResponse `json:"historico"`
+}
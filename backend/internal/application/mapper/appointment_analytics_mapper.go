@@ -0,0 +1,87 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// AppointmentStatusHistoryToResponse converte o histórico de status para DTO
+func AppointmentStatusHistoryToResponse(changes []*entity.AppointmentStatusChange) []dto.AppointmentStatusChangeResponse {
+	out := make([]dto.AppointmentStatusChangeResponse, len(changes))
+	for i, c := range changes {
+		out[i] = dto.AppointmentStatusChangeResponse{
+			ID:         c.ID,
+			FromStatus: c.FromStatus.String(),
+			ToStatus:   c.ToStatus.String(),
+			ActorID:    c.ActorID,
+			ActorName:  c.ActorName,
+			Reason:     c.Reason,
+			ChangedAt:  c.ChangedAt,
+		}
+	}
+	return out
+}
+
+// AppointmentTimingToResponse converte os indicadores de tempo para DTO
+func AppointmentTimingToResponse(out *appointment.TimingAnalyticsOutput) dto.AppointmentTimingResponse {
+	units := make([]dto.AppointmentTimingUnitResponse, len(out.Units))
+	for i, u := range out.Units {
+		units[i] = dto.AppointmentTimingUnitResponse{
+			UnitID:                           u.UnitID,
+			UnitName:                         u.UnitName,
+			AppointmentTimingMetricsResponse: timingMetricsToResponse(u),
+		}
+	}
+	professionals := make([]dto.AppointmentTimingProfessionalResponse, len(out.Professionals))
+	for i, p := range out.Professionals {
+		professionals[i] = dto.AppointmentTimingProfessionalResponse{
+			ProfessionalID:                   p.ProfessionalID,
+			ProfessionalName:                 p.ProfessionalName,
+			AppointmentTimingMetricsResponse: timingMetricsToResponse(p),
+		}
+	}
+
+	return dto.AppointmentTimingResponse{
+		StartDate:             out.StartDate.Format("2006-01-02"),
+		EndDate:               out.EndDate.Format("2006-01-02"),
+		ToleranceMinutes:      int(out.Tolerance.Minutes()),
+		LateCancellationHours: int(entity.LateCancellationWindow.Hours()),
+		Total:                 timingMetricsToResponse(out.Total),
+		Units:                 units,
+		Professionals:         professionals,
+	}
+}
+
+func timingMetricsToResponse(s entity.AppointmentTimingStats) dto.AppointmentTimingMetricsResponse {
+	return dto.AppointmentTimingMetricsResponse{
+		Appointments: s.Appointments,
+		Wait: dto.TimingWaitResponse{
+			Count:      s.WaitCount,
+			AvgMinutes: s.AvgWaitMinutes(),
+		},
+		ServiceDuration: dto.TimingServiceDurationResponse{
+			Count:              s.ServiceCount,
+			AvgActualMinutes:   s.AvgServiceMinutes(),
+			AvgExpectedMinutes: s.AvgExpectedMinutes(),
+			AvgOverrunMinutes:  s.AvgOverrunMinutes(),
+			OverrunCount:       s.OverrunCount,
+		},
+		Punctuality: dto.TimingPunctualityResponse{
+			CheckIns:               s.CheckInCount,
+			LateArrivals:           s.LateArrivals,
+			OnTimeArrivalRate:      s.OnTimeArrivalRate(),
+			AvgArrivalDelayMinutes: s.AvgArrivalDelayMinutes(),
+			Starts:                 s.StartCount,
+			LateStarts:             s.LateStarts,
+			OnTimeStartRate:        s.OnTimeStartRate(),
+			AvgStartDelayMinutes:   s.AvgStartDelayMinutes(),
+		},
+		Cancellations: dto.TimingCancellationResponse{
+			Count:        s.CancelCount,
+			AvgLeadHours: s.AvgCancelLeadHours(),
+			LateCount:    s.LateCancellations,
+			LateRate:     s.LateCancellationRate(),
+		},
+	}
+}
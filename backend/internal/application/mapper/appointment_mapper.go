@@ -10,10 +10,15 @@ func AppointmentToResponse(a *entity.Appointment) dto.AppointmentResponse {
 	services := make([]dto.AppointmentServiceResponse, 0, len(a.Services))
 	for _, svc := range a.Services {
 		services = append(services, dto.AppointmentServiceResponse{
-			ServiceID:   svc.ServiceID,
-			ServiceName: svc.ServiceName,
-			Price:       svc.PriceAtBooking.Raw(), // Retorna "50.00" em vez de "R$ 50,00" para evitar NaN no frontend
-			Duration:    svc.DurationAtBooking,
+			ServiceID:        svc.ServiceID,
+			ServiceName:      svc.ServiceName,
+			ProfessionalID:   svc.ProfessionalID,
+			ProfessionalName: svc.ProfessionalName,
+			Sequence:         svc.Sequence,
+			StartTime:        svc.StartTime,
+			EndTime:          svc.EndTime,
+			Price:            svc.PriceAtBooking.Raw(), // Retorna "50.00" em vez de "R$ 50,00" para evitar NaN no frontend
+			Duration:         svc.DurationAtBooking,
 		})
 	}
 
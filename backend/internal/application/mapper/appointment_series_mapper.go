@@ -0,0 +1,65 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// AppointmentSeriesToResponse converte a série para DTO de resposta
+func AppointmentSeriesToResponse(s *entity.AppointmentSeries) dto.AppointmentSeriesResponse {
+	var endsOn *string
+	if s.EndsOn != nil {
+		d := s.EndsOn.Format("2006-01-02")
+		endsOn = &d
+	}
+
+	return dto.AppointmentSeriesResponse{
+		ID:             s.ID,
+		UnitID:         s.UnitID.String(),
+		ProfessionalID: s.ProfessionalID,
+		CustomerID:     s.CustomerID,
+		ServiceIDs:     s.ServiceIDs,
+		StartTime:      s.StartTime,
+		IntervalWeeks:  s.IntervalWeeks,
+		EndsOn:         endsOn,
+		Notes:          s.Notes,
+		Status:         s.Status,
+		EndedReason:    s.EndedReason,
+		EndedAt:        s.EndedAt,
+		GeneratedUntil: s.GeneratedUntil,
+		CreatedAt:      s.CreatedAt,
+	}
+}
+
+// AppointmentSeriesListToResponse converte lista de séries para DTOs
+func AppointmentSeriesListToResponse(series []*entity.AppointmentSeries) []dto.AppointmentSeriesResponse {
+	result := make([]dto.AppointmentSeriesResponse, 0, len(series))
+	for _, s := range series {
+		result = append(result, AppointmentSeriesToResponse(s))
+	}
+	return result
+}
+
+// SeriesOccurrencesToResponse converte os resultados das ocorrências
+func SeriesOccurrencesToResponse(occurrences []appointment.SeriesOccurrenceResult) []dto.SeriesOccurrenceResponse {
+	result := make([]dto.SeriesOccurrenceResponse, 0, len(occurrences))
+	for _, o := range occurrences {
+		result = append(result, dto.SeriesOccurrenceResponse{
+			Index:         o.Index,
+			StartTime:     o.StartTime,
+			Status:        o.Status,
+			AppointmentID: o.AppointmentID,
+			Detail:        o.Detail,
+		})
+	}
+	return result
+}
+
+// AppointmentSeriesOutputToResponse converte série + ocorrências
+func AppointmentSeriesOutputToResponse(out *appointment.AppointmentSeriesOutput) dto.AppointmentSeriesDetailResponse {
+	return dto.AppointmentSeriesDetailResponse{
+		AppointmentSeriesResponse: AppointmentSeriesToResponse(out.Series),
+		Occurrences:               SeriesOccurrencesToResponse(out.Occurrences),
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/shopspring/decimal"
 )
 
 // ============================================================
@@ -25,8 +26,10 @@ func ToCaixaDiarioResponse(caixa *entity.CaixaDiario) dto.CaixaDiarioResponse {
 		TotalSangrias:            caixa.TotalSangrias.String(),
 		TotalReforcos:            caixa.TotalReforcos.String(),
 		SaldoEsperado:            caixa.SaldoEsperado.String(),
+		SaldoEsperadoDinheiro:    caixa.SaldoEsperadoDinheiro().String(),
 		Status:                   string(caixa.Status),
 		JustificativaDivergencia: caixa.JustificativaDivergencia,
+		FechamentoCego:           caixa.FechamentoCego,
 		CreatedAt:                caixa.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:                caixa.UpdatedAt.Format(time.RFC3339),
 		UsuarioFechamentoNome:    caixa.UsuarioFechamentoNome,
@@ -53,6 +56,25 @@ func ToCaixaDiarioResponse(caixa *entity.CaixaDiario) dto.CaixaDiarioResponse {
 		resp.Divergencia = &div
 	}
 
+	if caixa.Contagem != nil {
+		resp.Contagem = ToContagemCaixaResponse(caixa.Contagem)
+	}
+
+	if caixa.UsuarioAprovacaoID != nil {
+		uaID := caixa.UsuarioAprovacaoID.String()
+		resp.UsuarioAprovacaoID = &uaID
+	}
+
+	if caixa.DataAprovacao != nil {
+		da := caixa.DataAprovacao.Format(time.RFC3339)
+		resp.DataAprovacao = &da
+	}
+
+	// Totais por forma de pagamento dependem das operações carregadas
+	if len(caixa.Operacoes) > 0 {
+		resp.TotaisPorFormaPagamento = ToTotaisPorFormaPagamento(caixa.TotaisPorFormaPagamento())
+	}
+
 	// Mapear operações se existirem
 	if len(caixa.Operacoes) > 0 {
 		resp.Operacoes = make([]dto.OperacaoCaixaResponse, len(caixa.Operacoes))
@@ -64,6 +86,49 @@ func ToCaixaDiarioResponse(caixa *entity.CaixaDiario) dto.CaixaDiarioResponse {
 	return resp
 }
 
+// OcultarValoresEsperados remove da resposta tudo que revela o saldo esperado
+// (fechamento cego: o operador só vê os valores depois de enviar a contagem)
+func OcultarValoresEsperados(resp *dto.CaixaDiarioResponse) {
+	resp.TotalEntradas = ""
+	resp.SaldoEsperado = ""
+	resp.SaldoEsperadoDinheiro = ""
+	resp.TotaisPorFormaPagamento = nil
+	resp.Operacoes = nil
+	resp.ValoresOcultos = true
+}
+
+// ToContagemCaixaResponse converte a contagem da gaveta
+func ToContagemCaixaResponse(contagem *entity.ContagemCaixa) *dto.ContagemCaixaResponse {
+	resp := &dto.ContagemCaixaResponse{
+		Itens:        make([]dto.ItemContagemResponse, len(contagem.Itens)),
+		TotalCedulas: contagem.TotalCedulas().StringFixed(2),
+		TotalMoedas:  contagem.TotalMoedas().StringFixed(2),
+		Total:        contagem.Total().StringFixed(2),
+	}
+	for i, item := range contagem.Itens {
+		tipo := "MOEDA"
+		if item.Cedula() {
+			tipo = "CEDULA"
+		}
+		resp.Itens[i] = dto.ItemContagemResponse{
+			Denominacao: item.Denominacao.StringFixed(2),
+			Tipo:        tipo,
+			Quantidade:  item.Quantidade,
+			Subtotal:    item.Subtotal().StringFixed(2),
+		}
+	}
+	return resp
+}
+
+// ToTotaisPorFormaPagamento converte o mapa de totais para a resposta
+func ToTotaisPorFormaPagamento(totais map[entity.TipoPagamento]decimal.Decimal) map[string]string {
+	resp := make(map[string]string, len(totais))
+	for forma, total := range totais {
+		resp[string(forma)] = total.String()
+	}
+	return resp
+}
+
 // ToCaixaDiarioResumoResponse converte entity.CaixaDiario para dto.CaixaDiarioResumoResponse
 func ToCaixaDiarioResumoResponse(caixa *entity.CaixaDiario) dto.CaixaDiarioResumoResponse {
 	resp := dto.CaixaDiarioResumoResponse{
@@ -97,7 +162,7 @@ func ToCaixaDiarioResumoResponse(caixa *entity.CaixaDiario) dto.CaixaDiarioResum
 
 // ToOperacaoCaixaResponse converte entity.OperacaoCaixa para dto.OperacaoCaixaResponse
 func ToOperacaoCaixaResponse(op *entity.OperacaoCaixa) dto.OperacaoCaixaResponse {
-	return dto.OperacaoCaixaResponse{
+	resp := dto.OperacaoCaixaResponse{
 		ID:          op.ID.String(),
 		Tipo:        string(op.Tipo),
 		Valor:       op.Valor.String(),
@@ -108,6 +173,11 @@ func ToOperacaoCaixaResponse(op *entity.OperacaoCaixa) dto.OperacaoCaixaResponse
 		UsuarioNome: op.UsuarioNome,
 		CreatedAt:   op.CreatedAt.Format(time.RFC3339),
 	}
+	if op.Tipo == entity.TipoOperacaoVenda {
+		forma := string(op.FormaPagamentoOuDinheiro())
+		resp.FormaPagamento = &forma
+	}
+	return resp
 }
 
 // ToCaixaStatusResponse cria resposta de status do caixa
@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// CalendarFeedToResponse converte o feed da agenda para DTO
+func CalendarFeedToResponse(f *entity.CalendarFeed) dto.CalendarFeedResponse {
+	scope := "PROFESSIONAL"
+	if f.IsUnitFeed() {
+		scope = "UNIT"
+	}
+	return dto.CalendarFeedResponse{
+		ID:               f.ID,
+		UnitID:           f.UnitID.String(),
+		ProfessionalID:   f.ProfessionalID,
+		ProfessionalName: f.ProfessionalName,
+		Scope:            scope,
+		LastAccessedAt:   f.LastAccessedAt,
+		CreatedAt:        f.CreatedAt,
+	}
+}
+
+// CalendarFeedsToResponse converte a lista de feeds para DTO
+func CalendarFeedsToResponse(feeds []*entity.CalendarFeed) []dto.CalendarFeedResponse {
+	out := make([]dto.CalendarFeedResponse, len(feeds))
+	for i, f := range feeds {
+		out[i] = CalendarFeedToResponse(f)
+	}
+	return out
+}
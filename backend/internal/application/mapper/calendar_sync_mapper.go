@@ -0,0 +1,52 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarsync"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// CalendarConnectionToResponse converte a conexão com calendário para DTO
+// (as credenciais nunca saem da API)
+func CalendarConnectionToResponse(c *entity.CalendarConnection) dto.CalendarConnectionResponse {
+	return dto.CalendarConnectionResponse{
+		ID:               c.ID,
+		ProfessionalID:   c.ProfessionalID,
+		ProfessionalName: c.ProfessionalName,
+		Provider:         c.Provider,
+		AccountEmail:     c.AccountEmail,
+		Status:           c.Status,
+		LastError:        c.LastError,
+		LastSyncedAt:     c.LastSyncedAt,
+		CreatedAt:        c.CreatedAt,
+	}
+}
+
+// CalendarConnectionsToResponse converte a lista de conexões para DTO
+func CalendarConnectionsToResponse(conns []*entity.CalendarConnection) []dto.CalendarConnectionResponse {
+	out := make([]dto.CalendarConnectionResponse, len(conns))
+	for i, c := range conns {
+		out[i] = CalendarConnectionToResponse(c)
+	}
+	return out
+}
+
+// CalendarSyncResultToResponse converte o resultado da sincronização para DTO
+func CalendarSyncResultToResponse(r *calendarsync.SyncResult) dto.CalendarSyncResponse {
+	conflicts := make([]dto.CalendarSyncConflictResponse, len(r.Conflicts))
+	for i, c := range r.Conflicts {
+		conflicts[i] = dto.CalendarSyncConflictResponse{
+			ExternalEventID: c.ExternalEventID,
+			BlockedTimeID:   c.BlockedTimeID,
+			StartTime:       c.StartTime,
+			EndTime:         c.EndTime,
+		}
+	}
+	return dto.CalendarSyncResponse{
+		Connection: CalendarConnectionToResponse(r.Connection),
+		FullSync:   r.FullSync,
+		Imported:   r.Imported,
+		Removed:    r.Removed,
+		Conflicts:  conflicts,
+	}
+}
@@ -0,0 +1,92 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// ============================================================
+// CONCILIAÇÃO BANCÁRIA - Mappers
+// ============================================================
+
+// ToBankStatementImportResponse converte entity.BankStatementImport para dto.BankStatementImportResponse
+func ToBankStatementImportResponse(imp *entity.BankStatementImport) dto.BankStatementImportResponse {
+	resp := dto.BankStatementImportResponse{
+		ID:               imp.ID.String(),
+		ArquivoNome:      imp.ArquivoNome,
+		Formato:          string(imp.Formato),
+		ContaBancaria:    imp.ContaBancaria,
+		TotalLinhas:      imp.TotalLinhas,
+		TotalConciliadas: imp.TotalConciliadas,
+		TotalPendentes:   imp.TotalPendentes,
+		CreatedAt:        imp.CreatedAt.Format(time.RFC3339),
+	}
+
+	if imp.PeriodoInicio != nil {
+		s := imp.PeriodoInicio.Format("2006-01-02")
+		resp.PeriodoInicio = &s
+	}
+	if imp.PeriodoFim != nil {
+		s := imp.PeriodoFim.Format("2006-01-02")
+		resp.PeriodoFim = &s
+	}
+	if imp.ImportadoPor != nil {
+		s := imp.ImportadoPor.String()
+		resp.ImportadoPor = &s
+	}
+
+	return resp
+}
+
+// ToBankStatementImportListResponse converte lista de importações
+func ToBankStatementImportListResponse(imports []*entity.BankStatementImport) []dto.BankStatementImportResponse {
+	resp := make([]dto.BankStatementImportResponse, 0, len(imports))
+	for _, imp := range imports {
+		resp = append(resp, ToBankStatementImportResponse(imp))
+	}
+	return resp
+}
+
+// ToBankStatementLineResponse converte entity.BankStatementLine para dto.BankStatementLineResponse
+func ToBankStatementLineResponse(line *entity.BankStatementLine) dto.BankStatementLineResponse {
+	resp := dto.BankStatementLineResponse{
+		ID:             line.ID.String(),
+		ImportID:       line.ImportID.String(),
+		DataLancamento: line.DataLancamento.Format("2006-01-02"),
+		Descricao:      line.Descricao,
+		Valor:          line.Valor.StringFixed(2),
+		Tipo:           string(line.Tipo),
+		Status:         string(line.Status),
+		Observacao:     line.Observacao,
+	}
+
+	if line.MatchTipo != nil {
+		s := string(*line.MatchTipo)
+		resp.MatchTipo = &s
+	}
+	if line.MatchID != nil {
+		s := line.MatchID.String()
+		resp.MatchID = &s
+	}
+	if line.Diferenca != nil {
+		s := line.Diferenca.StringFixed(2)
+		resp.Diferenca = &s
+	}
+	if line.ConciliadoEm != nil {
+		s := line.ConciliadoEm.Format(time.RFC3339)
+		resp.ConciliadoEm = &s
+	}
+
+	return resp
+}
+
+// ToBankStatementLineListResponse converte lista de lançamentos
+func ToBankStatementLineListResponse(lines []*entity.BankStatementLine) []dto.BankStatementLineResponse {
+	resp := make([]dto.BankStatementLineResponse, 0, len(lines))
+	for _, line := range lines {
+		resp = append(resp, ToBankStatementLineResponse(line))
+	}
+	return resp
+}
@@ -0,0 +1,76 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/financial"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// ============================================================
+// EXPORTAÇÃO CONTÁBIL - Mappers
+// ============================================================
+
+// ToPlanoContasResponse converte o plano de contas do tenant
+func ToPlanoContasResponse(out *financial.PlanoContasOutput) dto.PlanoContasResponse {
+	resp := dto.PlanoContasResponse{
+		Padrao:      make([]dto.ContaPadraoResponse, 0, len(entity.ContasPadrao)),
+		Mapeamentos: make([]dto.MapeamentoContabilResponse, 0, len(out.Mapeamentos)),
+	}
+	for _, chave := range entity.ContasPadrao {
+		conta := out.Padrao[chave]
+		resp.Padrao = append(resp.Padrao, dto.ContaPadraoResponse{
+			Chave:       string(chave),
+			ContaCodigo: conta.Codigo,
+			ContaNome:   conta.Nome,
+		})
+	}
+	for _, m := range out.Mapeamentos {
+		resp.Mapeamentos = append(resp.Mapeamentos, ToMapeamentoContabilResponse(m))
+	}
+	return resp
+}
+
+// ToMapeamentoContabilResponse converte entity.PlanoContasMapeamento para dto.MapeamentoContabilResponse
+func ToMapeamentoContabilResponse(m *entity.PlanoContasMapeamento) dto.MapeamentoContabilResponse {
+	return dto.MapeamentoContabilResponse{
+		ID:          m.ID.String(),
+		OrigemTipo:  string(m.OrigemTipo),
+		OrigemChave: m.OrigemChave,
+		ContaCodigo: m.ContaCodigo,
+		ContaNome:   m.ContaNome,
+		UpdatedAt:   m.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// ToLancamentosContabeisResponse converte os lançamentos do mês
+func ToLancamentosContabeisResponse(out *financial.GerarLancamentosContabeisOutput) dto.LancamentosContabeisResponse {
+	resp := dto.LancamentosContabeisResponse{
+		MesAno:        out.MesAno.String(),
+		TotalDebitos:  out.TotalDebitos.StringFixed(2),
+		TotalCreditos: out.TotalCreditos.StringFixed(2),
+		Balanceado:    out.TotalDebitos.Equal(out.TotalCreditos),
+		Lancamentos:   make([]dto.LancamentoContabilResponse, 0, len(out.Lancamentos)),
+	}
+	for _, l := range out.Lancamentos {
+		partidas := make([]dto.PartidaContabilResponse, 0, len(l.Partidas))
+		for _, p := range l.Partidas {
+			partidas = append(partidas, dto.PartidaContabilResponse{
+				ContaCodigo: p.Conta.Codigo,
+				ContaNome:   p.Conta.Nome,
+				Natureza:    string(p.Natureza),
+				Valor:       p.Valor.StringFixed(2),
+			})
+		}
+		resp.Lancamentos = append(resp.Lancamentos, dto.LancamentoContabilResponse{
+			Lote:      l.Lote,
+			Data:      l.Data.Format("2006-01-02"),
+			Historico: l.Historico,
+			Documento: l.Documento,
+			Origem:    string(l.Origem),
+			Partidas:  partidas,
+		})
+	}
+	return resp
+}
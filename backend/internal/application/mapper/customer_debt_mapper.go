@@ -0,0 +1,101 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+// ============================================================
+// FIADO (DÍVIDAS DE CLIENTES) - Mappers
+// ============================================================
+
+// ToCustomerDebtResponse converte entity.CustomerDebt para dto.CustomerDebtResponse
+func ToCustomerDebtResponse(debt *entity.CustomerDebt) dto.CustomerDebtResponse {
+	resp := dto.CustomerDebtResponse{
+		ID:            debt.ID.String(),
+		CustomerID:    debt.CustomerID.String(),
+		CommandID:     debt.CommandID.String(),
+		ValorOriginal: debt.ValorOriginal.StringFixed(2),
+		ValorPago:     debt.ValorPago.StringFixed(2),
+		Saldo:         debt.Saldo.StringFixed(2),
+		Status:        string(debt.Status),
+		DataAbertura:  debt.DataAbertura.Format(time.RFC3339),
+		DiasEmAberto:  debt.DiasEmAberto(time.Now()),
+		Observacoes:   debt.Observacoes,
+		Pagamentos:    make([]dto.CustomerDebtPaymentResponse, 0, len(debt.Pagamentos)),
+	}
+
+	if debt.DataQuitacao != nil {
+		s := debt.DataQuitacao.Format(time.RFC3339)
+		resp.DataQuitacao = &s
+	}
+
+	for _, p := range debt.Pagamentos {
+		pr := dto.CustomerDebtPaymentResponse{
+			ID:              p.ID.String(),
+			MeioPagamentoID: p.MeioPagamentoID.String(),
+			Valor:           p.Valor.StringFixed(2),
+			Observacoes:     p.Observacoes,
+			CreatedAt:       p.CreatedAt.Format(time.RFC3339),
+		}
+		if p.CommandID != nil {
+			s := p.CommandID.String()
+			pr.CommandID = &s
+		}
+		if p.ContaReceberID != nil {
+			s := p.ContaReceberID.String()
+			pr.ContaReceberID = &s
+		}
+		if p.OperacaoCaixaID != nil {
+			s := p.OperacaoCaixaID.String()
+			pr.OperacaoCaixaID = &s
+		}
+		resp.Pagamentos = append(resp.Pagamentos, pr)
+	}
+
+	return resp
+}
+
+// ToCustomerDebtListResponse converte as dívidas de um cliente, consolidando o saldo em aberto
+func ToCustomerDebtListResponse(customerID string, debts []*entity.CustomerDebt) dto.CustomerDebtListResponse {
+	saldo := decimal.Zero
+	dividas := make([]dto.CustomerDebtResponse, 0, len(debts))
+	for _, d := range debts {
+		if d.IsAberta() {
+			saldo = saldo.Add(d.Saldo)
+		}
+		dividas = append(dividas, ToCustomerDebtResponse(d))
+	}
+
+	return dto.CustomerDebtListResponse{
+		CustomerID: customerID,
+		SaldoTotal: saldo.StringFixed(2),
+		Dividas:    dividas,
+	}
+}
+
+// ToCustomerDebtAgingReportResponse converte o relatório de fiado para DTO
+func ToCustomerDebtAgingReportResponse(items []*entity.CustomerDebtAging) dto.CustomerDebtAgingReportResponse {
+	saldo := decimal.Zero
+	clientes := make([]dto.CustomerDebtAgingResponse, 0, len(items))
+	for _, a := range items {
+		saldo = saldo.Add(a.SaldoTotal)
+		clientes = append(clientes, dto.CustomerDebtAgingResponse{
+			CustomerID:        a.CustomerID.String(),
+			CustomerNome:      a.CustomerNome,
+			CustomerTelefone:  a.CustomerTelefone,
+			QuantidadeDividas: a.QuantidadeDividas,
+			SaldoTotal:        a.SaldoTotal.StringFixed(2),
+			DividaMaisAntiga:  a.DividaMaisAntiga.Format(time.RFC3339),
+			DiasEmAberto:      a.DiasEmAberto,
+		})
+	}
+
+	return dto.CustomerDebtAgingReportResponse{
+		Clientes:   clientes,
+		SaldoTotal: saldo.StringFixed(2),
+	}
+}
@@ -0,0 +1,49 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/noshow"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// NoShowPolicyToResponse converte a política de não comparecimento para DTO
+func NoShowPolicyToResponse(p *entity.NoShowPolicy) dto.NoShowPolicyResponse {
+	return dto.NoShowPolicyResponse{
+		Enabled:              p.Enabled,
+		MaxNoShows:           p.MaxNoShows,
+		WindowDays:           p.WindowDays,
+		DepositType:          p.DepositType,
+		DepositValue:         p.DepositValue.StringFixed(2),
+		PaymentDeadlineHours: p.PaymentDeadlineHours,
+		MeioPagamentoID:      p.MeioPagamentoID,
+	}
+}
+
+// CustomerReliabilityToResponse converte a confiabilidade do cliente para DTO
+func CustomerReliabilityToResponse(out *noshow.ReliabilityOutput) dto.CustomerReliabilityResponse {
+	return dto.CustomerReliabilityResponse{
+		CustomerID:      out.CustomerID,
+		Score:           out.Score,
+		Attended:        out.Stats.Attended,
+		NoShows:         out.Stats.NoShows,
+		Canceled:        out.Stats.Canceled,
+		WindowDays:      out.WindowDays,
+		DepositRequired: out.DepositRequired,
+	}
+}
+
+// AppointmentDepositToResponse converte o sinal do agendamento para DTO
+func AppointmentDepositToResponse(d *entity.AppointmentDeposit) dto.AppointmentDepositResponse {
+	return dto.AppointmentDepositResponse{
+		ID:            d.ID,
+		AppointmentID: d.AppointmentID,
+		CustomerID:    d.CustomerID,
+		Amount:        d.Amount.StringFixed(2),
+		Status:        d.Status,
+		InvoiceURL:    d.InvoiceURL,
+		PixPayload:    d.PixPayload,
+		DueAt:         d.DueAt,
+		PaidAt:        d.PaidAt,
+		SettledAt:     d.SettledAt,
+	}
+}
@@ -0,0 +1,55 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// ResourceToResponse converte o recurso da unidade para DTO
+func ResourceToResponse(r *entity.Resource) dto.ResourceResponse {
+	return dto.ResourceResponse{
+		ID:        r.ID,
+		UnitID:    r.UnitID.String(),
+		Nome:      r.Nome,
+		Capacity:  r.Capacity,
+		Ativo:     r.Ativo,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+// ResourcesToResponse converte a lista de recursos para DTO
+func ResourcesToResponse(resources []*entity.Resource) []dto.ResourceResponse {
+	out := make([]dto.ResourceResponse, 0, len(resources))
+	for _, r := range resources {
+		out = append(out, ResourceToResponse(r))
+	}
+	return out
+}
+
+// ServiceResourcesFromRequest converte a requisição nas exigências do serviço
+func ServiceResourcesFromRequest(req dto.SetServiceResourcesRequest) []entity.ServiceResourceRequirement {
+	out := make([]entity.ServiceResourceRequirement, 0, len(req.Resources))
+	for _, r := range req.Resources {
+		out = append(out, entity.ServiceResourceRequirement{
+			ResourceID:      r.ResourceID,
+			OffsetMinutes:   r.OffsetMinutes,
+			DurationMinutes: r.DurationMinutes,
+		})
+	}
+	return out
+}
+
+// ServiceResourcesToResponse converte as exigências do serviço para DTO
+func ServiceResourcesToResponse(reqs []entity.ServiceResourceRequirement) []dto.ServiceResourceResponse {
+	out := make([]dto.ServiceResourceResponse, 0, len(reqs))
+	for _, r := range reqs {
+		out = append(out, dto.ServiceResourceResponse{
+			ResourceID:      r.ResourceID,
+			ResourceName:    r.ResourceName,
+			OffsetMinutes:   r.OffsetMinutes,
+			DurationMinutes: r.DurationMinutes,
+		})
+	}
+	return out
+}
@@ -0,0 +1,46 @@
+package mapper
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/waitlist"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// WaitlistEntryToResponse converte a entrada da lista de espera para DTO
+func WaitlistEntryToResponse(e *entity.WaitlistEntry) dto.WaitlistEntryResponse {
+	return dto.WaitlistEntryResponse{
+		ID:              e.ID,
+		UnitID:          e.UnitID.String(),
+		CustomerID:      e.CustomerID,
+		ServiceIDs:      e.ServiceIDs,
+		ProfessionalIDs: e.ProfessionalIDs,
+		WindowStart:     e.WindowStart,
+		WindowEnd:       e.WindowEnd,
+		Priority:        e.Priority,
+		Notes:           e.Notes,
+		Status:          e.Status,
+		AppointmentID:   e.AppointmentID,
+		CreatedAt:       e.CreatedAt,
+	}
+}
+
+// WaitlistEntriesToResponse converte lista de entradas para DTOs
+func WaitlistEntriesToResponse(entries []*entity.WaitlistEntry) []dto.WaitlistEntryResponse {
+	result := make([]dto.WaitlistEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, WaitlistEntryToResponse(e))
+	}
+	return result
+}
+
+// WaitlistOfferToResponse converte a oferta para a página pública do link
+func WaitlistOfferToResponse(out *waitlist.OfferOutput) dto.WaitlistOfferResponse {
+	return dto.WaitlistOfferResponse{
+		ProfessionalID: out.Offer.ProfessionalID,
+		ServiceIDs:     out.Entry.ServiceIDs,
+		StartTime:      out.Offer.StartTime,
+		EndTime:        out.Offer.EndTime,
+		ExpiresAt:      out.Offer.ExpiresAt,
+		Status:         out.Offer.Status,
+	}
+}
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"math"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/walkin"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// WalkInToResponse converte o cliente da fila de encaixe para DTO
+func WalkInToResponse(w *entity.WalkIn) dto.WalkInResponse {
+	return dto.WalkInResponse{
+		ID:              w.ID,
+		UnitID:          w.UnitID.String(),
+		CustomerID:      w.CustomerID,
+		CustomerName:    w.CustomerName,
+		ServiceIDs:      w.ServiceIDs,
+		DurationMinutes: w.DurationMinutes,
+		Notes:           w.Notes,
+		Status:          w.Status,
+		ProfessionalID:  w.ProfessionalID,
+		AppointmentID:   w.AppointmentID,
+		CheckedInAt:     w.CheckedInAt,
+		AssignedAt:      w.AssignedAt,
+	}
+}
+
+// WalkInsToResponse converte a lista de encaixados para DTO
+func WalkInsToResponse(walkIns []*entity.WalkIn) []dto.WalkInResponse {
+	out := make([]dto.WalkInResponse, 0, len(walkIns))
+	for _, w := range walkIns {
+		out = append(out, WalkInToResponse(w))
+	}
+	return out
+}
+
+// WalkInQueueItemToResponse converte o cliente aguardando, com posição e
+// previsão de início, para DTO
+func WalkInQueueItemToResponse(item walkin.QueueItem) dto.WalkInResponse {
+	resp := WalkInToResponse(item.WalkIn)
+	resp.Position = item.Position
+	if item.EstimatedStart != nil {
+		resp.EstimatedStart = item.EstimatedStart
+		minutes := int(math.Ceil(time.Until(*item.EstimatedStart).Minutes()))
+		if minutes < 0 {
+			minutes = 0
+		}
+		resp.EstimatedWaitMinutes = &minutes
+	}
+	return resp
+}
+
+// WalkInQueueToResponse converte a fila de encaixe para DTO
+func WalkInQueueToResponse(items []walkin.QueueItem) []dto.WalkInResponse {
+	out := make([]dto.WalkInResponse, 0, len(items))
+	for _, item := range items {
+		out = append(out, WalkInQueueItemToResponse(item))
+	}
+	return out
+}
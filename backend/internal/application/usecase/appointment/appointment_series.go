@@ -0,0 +1,539 @@
+package appointment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// SÉRIES DE AGENDAMENTOS RECORRENTES
+// Clientes fixos (ex.: a cada 15 dias, mesmo barbeiro e horário). As
+// ocorrências viram agendamentos comuns, criados por CreateAppointmentUseCase
+// com as mesmas verificações de conflito, até SeriesGenerationHorizon à
+// frente; o job diário estende esse horizonte. Cada ocorrência registra se
+// virou agendamento ou se deu conflito.
+// =============================================================================
+
+// SeriesGenerationHorizon define até quando as ocorrências são geradas
+const SeriesGenerationHorizon = 8 * 7 * 24 * time.Hour
+
+// seriesGenerationBatch limita as séries processadas por execução do job
+const seriesGenerationBatch = 200
+
+// SeriesOccurrenceResult resultado de uma ocorrência gerada ou remarcada
+type SeriesOccurrenceResult struct {
+	Index         int
+	StartTime     time.Time
+	Status        string // BOOKED, CONFLICT, SKIPPED
+	AppointmentID string
+	Detail        string
+}
+
+// ocorrenciaIndisponivel indica erros que afetam só a ocorrência (horário
+// ocupado/bloqueado, recurso esgotado, profissional ou serviço indisponível
+// na data)
+func ocorrenciaIndisponivel(err error) bool {
+	return errors.Is(err, domain.ErrAppointmentConflict) ||
+		errors.Is(err, domain.ErrAppointmentBlockedTimeConflict) ||
+		errors.Is(err, domain.ErrAppointmentMinimumInterval) ||
+		errors.Is(err, domain.ErrResourceUnavailable) ||
+		errors.Is(err, domain.ErrAppointmentProfessionalNotFound) ||
+		errors.Is(err, domain.ErrAppointmentServiceNotFound)
+}
+
+// generateSeriesOccurrences gera as ocorrências ainda não registradas até
+// until. Cliente removido/inativo encerra a série.
+func generateSeriesOccurrences(
+	ctx context.Context,
+	seriesRepo port.AppointmentSeriesRepository,
+	createUC *CreateAppointmentUseCase,
+	logger *zap.Logger,
+	series *entity.AppointmentSeries,
+	until time.Time,
+) ([]SeriesOccurrenceResult, error) {
+	next, err := seriesRepo.NextOccurrenceIndex(ctx, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var results []SeriesOccurrenceResult
+	for idx := next; series.IsActive() && series.HasOccurrence(idx); idx++ {
+		start := series.OccurrenceStart(idx)
+		if start.After(until) {
+			break
+		}
+
+		occurrence := &entity.AppointmentSeriesOccurrence{
+			SeriesID:       series.ID,
+			Index:          idx,
+			TenantID:       series.TenantID,
+			ScheduledStart: start,
+		}
+
+		if !start.After(now) {
+			occurrence.Status = entity.SeriesOccurrenceSkipped
+			occurrence.Detail = "horário já passou"
+		} else {
+			appointment, err := createUC.Execute(ctx, CreateAppointmentInput{
+				TenantID:       series.TenantID.String(),
+				UnitID:         series.UnitID.String(),
+				ProfessionalID: series.ProfessionalID,
+				CustomerID:     series.CustomerID,
+				StartTime:      start,
+				ServiceIDs:     series.ServiceIDs,
+				Notes:          series.Notes,
+			})
+			switch {
+			case err == nil:
+				occurrence.Status = entity.SeriesOccurrenceBooked
+				occurrence.AppointmentID = appointment.ID
+			case ocorrenciaIndisponivel(err):
+				occurrence.Status = entity.SeriesOccurrenceConflict
+				occurrence.Detail = err.Error()
+			case errors.Is(err, domain.ErrAppointmentCustomerNotFound):
+				if err := series.End("cliente inativo ou removido"); err != nil {
+					return results, err
+				}
+				continue
+			default:
+				return results, fmt.Errorf("erro ao gerar ocorrência %d da série: %w", idx, err)
+			}
+		}
+
+		created, err := seriesRepo.CreateOccurrence(ctx, occurrence)
+		if err != nil {
+			return results, err
+		}
+		if !created {
+			// Outra geração concorrente registrou a ocorrência primeiro
+			logger.Warn("Ocorrência da série já registrada",
+				zap.String("series_id", series.ID),
+				zap.Int("occurrence_index", idx),
+				zap.String("appointment_id", occurrence.AppointmentID),
+			)
+			continue
+		}
+
+		results = append(results, SeriesOccurrenceResult{
+			Index:         idx,
+			StartTime:     start,
+			Status:        occurrence.Status,
+			AppointmentID: occurrence.AppointmentID,
+			Detail:        occurrence.Detail,
+		})
+	}
+
+	series.GeneratedUntil = &until
+	if err := seriesRepo.Update(ctx, series); err != nil {
+		return results, err
+	}
+	return results, nil
+}
+
+// -----------------------------------------------------------------------------
+// Criar
+// -----------------------------------------------------------------------------
+
+// CreateAppointmentSeriesInput dados de entrada para criar uma série
+type CreateAppointmentSeriesInput struct {
+	TenantID       string
+	UnitID         string
+	ProfessionalID string
+	CustomerID     string
+	ServiceIDs     []string
+	StartTime      time.Time // primeira ocorrência
+	IntervalWeeks  int
+	EndsOn         *time.Time
+	Notes          string
+	CreatedBy      string
+}
+
+// AppointmentSeriesOutput série com o resultado das ocorrências
+type AppointmentSeriesOutput struct {
+	Series      *entity.AppointmentSeries
+	Occurrences []SeriesOccurrenceResult
+}
+
+// CreateAppointmentSeriesUseCase cria a série e gera as primeiras ocorrências
+type CreateAppointmentSeriesUseCase struct {
+	seriesRepo port.AppointmentSeriesRepository
+	createUC   *CreateAppointmentUseCase
+	logger     *zap.Logger
+}
+
+// NewCreateAppointmentSeriesUseCase cria nova instância do use case
+func NewCreateAppointmentSeriesUseCase(
+	seriesRepo port.AppointmentSeriesRepository,
+	createUC *CreateAppointmentUseCase,
+	logger *zap.Logger,
+) *CreateAppointmentSeriesUseCase {
+	return &CreateAppointmentSeriesUseCase{
+		seriesRepo: seriesRepo,
+		createUC:   createUC,
+		logger:     logger,
+	}
+}
+
+// Execute cria a série. Conflitos não impedem a criação: são reportados por
+// ocorrência e o horário fica livre para remarcação manual.
+func (uc *CreateAppointmentSeriesUseCase) Execute(ctx context.Context, input CreateAppointmentSeriesInput) (*AppointmentSeriesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.CreateAppointmentSeries")
+	defer span.End()
+
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	unitUUID, err := uuid.Parse(input.UnitID)
+	if err != nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	series, err := entity.NewAppointmentSeries(
+		tenantUUID,
+		unitUUID,
+		input.ProfessionalID,
+		input.CustomerID,
+		input.ServiceIDs,
+		input.StartTime,
+		input.IntervalWeeks,
+		input.EndsOn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !series.StartTime.After(time.Now()) {
+		return nil, domain.ErrAppointmentInvalidTimeRange
+	}
+	series.Notes = input.Notes
+	series.CreatedBy = input.CreatedBy
+
+	// Mesmas verificações de cadastro da criação de agendamento, antes de
+	// gravar a série
+	profExists, err := uc.createUC.professionalReader.Exists(ctx, input.TenantID, input.ProfessionalID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar profissional: %w", err)
+	}
+	if !profExists {
+		return nil, domain.ErrAppointmentProfessionalNotFound
+	}
+	customerExists, err := uc.createUC.customerReader.Exists(ctx, input.TenantID, input.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar cliente: %w", err)
+	}
+	if !customerExists {
+		return nil, domain.ErrAppointmentCustomerNotFound
+	}
+	services, err := uc.createUC.serviceReader.FindByIDs(ctx, input.TenantID, input.ServiceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar serviços: %w", err)
+	}
+	if len(services) != len(input.ServiceIDs) {
+		return nil, domain.ErrAppointmentServiceNotFound
+	}
+
+	if err := uc.seriesRepo.Create(ctx, series); err != nil {
+		return nil, err
+	}
+
+	occurrences, err := generateSeriesOccurrences(ctx, uc.seriesRepo, uc.createUC, uc.logger, series, time.Now().Add(SeriesGenerationHorizon))
+	if err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Série de agendamentos criada",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("series_id", series.ID),
+		zap.String("customer_id", input.CustomerID),
+		zap.Int("interval_weeks", input.IntervalWeeks),
+		zap.Int("occurrences", len(occurrences)),
+	)
+
+	return &AppointmentSeriesOutput{Series: series, Occurrences: occurrences}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Consultar
+// -----------------------------------------------------------------------------
+
+// GetAppointmentSeriesUseCase busca a série com as ocorrências geradas
+type GetAppointmentSeriesUseCase struct {
+	seriesRepo port.AppointmentSeriesRepository
+	logger     *zap.Logger
+}
+
+// NewGetAppointmentSeriesUseCase cria nova instância do use case
+func NewGetAppointmentSeriesUseCase(seriesRepo port.AppointmentSeriesRepository, logger *zap.Logger) *GetAppointmentSeriesUseCase {
+	return &GetAppointmentSeriesUseCase{seriesRepo: seriesRepo, logger: logger}
+}
+
+// Execute busca a série e suas ocorrências
+func (uc *GetAppointmentSeriesUseCase) Execute(ctx context.Context, tenantID, id string) (*AppointmentSeriesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.GetAppointmentSeries")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	series, err := uc.seriesRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := uc.seriesRepo.ListOccurrences(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &AppointmentSeriesOutput{Series: series, Occurrences: make([]SeriesOccurrenceResult, len(occurrences))}
+	for i, o := range occurrences {
+		out.Occurrences[i] = SeriesOccurrenceResult{
+			Index:         o.Index,
+			StartTime:     o.ScheduledStart,
+			Status:        o.Status,
+			AppointmentID: o.AppointmentID,
+			Detail:        o.Detail,
+		}
+	}
+	return out, nil
+}
+
+// ListAppointmentSeriesUseCase lista as séries de um cliente
+type ListAppointmentSeriesUseCase struct {
+	seriesRepo port.AppointmentSeriesRepository
+	logger     *zap.Logger
+}
+
+// NewListAppointmentSeriesUseCase cria nova instância do use case
+func NewListAppointmentSeriesUseCase(seriesRepo port.AppointmentSeriesRepository, logger *zap.Logger) *ListAppointmentSeriesUseCase {
+	return &ListAppointmentSeriesUseCase{seriesRepo: seriesRepo, logger: logger}
+}
+
+// Execute lista as séries do cliente (ativas e encerradas)
+func (uc *ListAppointmentSeriesUseCase) Execute(ctx context.Context, tenantID, customerID string) ([]*entity.AppointmentSeries, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.ListAppointmentSeries")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if customerID == "" {
+		return nil, domain.ErrAppointmentCustomerRequired
+	}
+	return uc.seriesRepo.ListByCustomer(ctx, tenantID, customerID)
+}
+
+// -----------------------------------------------------------------------------
+// Encerrar
+// -----------------------------------------------------------------------------
+
+// EndAppointmentSeriesUseCase encerra a série e cancela as ocorrências futuras
+type EndAppointmentSeriesUseCase struct {
+	seriesRepo      port.AppointmentSeriesRepository
+	appointmentRepo port.AppointmentRepository
+	events          port.EventPublisher
+	history         port.AppointmentStatusHistoryRepository
+	slots           SlotReleaseListener
+	attendance      AttendanceListener
+	logger          *zap.Logger
+}
+
+// NewEndAppointmentSeriesUseCase cria nova instância do use case
+func NewEndAppointmentSeriesUseCase(
+	seriesRepo port.AppointmentSeriesRepository,
+	appointmentRepo port.AppointmentRepository,
+	events port.EventPublisher,
+	history port.AppointmentStatusHistoryRepository,
+	slots SlotReleaseListener,
+	attendance AttendanceListener,
+	logger *zap.Logger,
+) *EndAppointmentSeriesUseCase {
+	return &EndAppointmentSeriesUseCase{
+		seriesRepo:      seriesRepo,
+		appointmentRepo: appointmentRepo,
+		events:          events,
+		history:         history,
+		slots:           slots,
+		attendance:      attendance,
+		logger:          logger,
+	}
+}
+
+// Execute encerra a série e cancela os agendamentos futuros ainda não
+// iniciados; retorna quantos foram cancelados. actorID é o usuário que
+// encerrou (registrado no histórico de status).
+func (uc *EndAppointmentSeriesUseCase) Execute(ctx context.Context, tenantID, id, actorID, reason string) (*entity.AppointmentSeries, int, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.EndAppointmentSeries")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, 0, domain.ErrTenantIDRequired
+	}
+
+	series, err := uc.seriesRepo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := series.End(reason); err != nil {
+		return nil, 0, err
+	}
+
+	occurrences, err := uc.seriesRepo.ListOccurrences(ctx, tenantID, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	canceled := 0
+	for _, o := range occurrences {
+		if o.AppointmentID == "" {
+			continue
+		}
+		a, err := uc.appointmentRepo.FindByID(ctx, tenantID, series.UnitID.String(), o.AppointmentID)
+		if err != nil {
+			if errors.Is(err, domain.ErrAppointmentNotFound) {
+				continue
+			}
+			return nil, 0, fmt.Errorf("erro ao buscar ocorrência %d da série: %w", o.Index, err)
+		}
+		if !a.IsFuture() {
+			continue
+		}
+		statusAnterior := a.Status
+		if a.Cancel(reason) != nil {
+			continue
+		}
+		if err := uc.appointmentRepo.Update(ctx, a); err != nil {
+			return nil, 0, fmt.Errorf("erro ao cancelar ocorrência %d da série: %w", o.Index, err)
+		}
+		common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, a, statusAnterior, actorID, reason)
+		common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, a, statusAnterior)
+		releaseSlot(ctx, uc.slots, a, SlotReleasedCanceled)
+		notifyAttendance(ctx, uc.attendance, a)
+		canceled++
+	}
+
+	if err := uc.seriesRepo.Update(ctx, series); err != nil {
+		return nil, 0, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Série de agendamentos encerrada",
+		zap.String("tenant_id", tenantID),
+		zap.String("series_id", id),
+		zap.Int("canceled", canceled),
+	)
+
+	return series, canceled, nil
+}
+
+// EndByCustomer encerra as séries ativas do cliente (ex.: cliente inativado)
+// cancelando as ocorrências futuras como em Execute; retorna quantas séries
+// foram encerradas e quantos agendamentos cancelados
+func (uc *EndAppointmentSeriesUseCase) EndByCustomer(ctx context.Context, tenantID, customerID, actorID, reason string) (int, int, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.EndAppointmentSeriesByCustomer")
+	defer span.End()
+
+	if tenantID == "" {
+		return 0, 0, domain.ErrTenantIDRequired
+	}
+
+	all, err := uc.seriesRepo.ListByCustomer(ctx, tenantID, customerID)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	ended, canceled := 0, 0
+	for _, series := range all {
+		if !series.IsActive() {
+			continue
+		}
+		_, n, err := uc.Execute(ctx, tenantID, series.ID, actorID, reason)
+		if err != nil {
+			return ended, canceled, err
+		}
+		ended++
+		canceled += n
+	}
+	return ended, canceled, nil
+}
+
+// -----------------------------------------------------------------------------
+// Gerar (job)
+// -----------------------------------------------------------------------------
+
+// GenerateAppointmentSeriesUseCase estende as séries ativas até o horizonte
+type GenerateAppointmentSeriesUseCase struct {
+	seriesRepo port.AppointmentSeriesRepository
+	createUC   *CreateAppointmentUseCase
+	logger     *zap.Logger
+}
+
+// NewGenerateAppointmentSeriesUseCase cria nova instância do use case
+func NewGenerateAppointmentSeriesUseCase(
+	seriesRepo port.AppointmentSeriesRepository,
+	createUC *CreateAppointmentUseCase,
+	logger *zap.Logger,
+) *GenerateAppointmentSeriesUseCase {
+	return &GenerateAppointmentSeriesUseCase{
+		seriesRepo: seriesRepo,
+		createUC:   createUC,
+		logger:     logger,
+	}
+}
+
+// Execute gera as ocorrências pendentes de todas as séries ativas. Falha em
+// uma série não interrompe as demais; retorna quantas séries foram
+// processadas.
+func (uc *GenerateAppointmentSeriesUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.GenerateAppointmentSeries")
+	defer span.End()
+
+	until := time.Now().Add(SeriesGenerationHorizon)
+	series, err := uc.seriesRepo.ListToGenerate(ctx, until, seriesGenerationBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	var booked, conflicts int
+	for _, s := range series {
+		results, err := generateSeriesOccurrences(ctx, uc.seriesRepo, uc.createUC, uc.logger, s, until)
+		if err != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao gerar ocorrências da série",
+				zap.String("tenant_id", s.TenantID.String()),
+				zap.String("series_id", s.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		for _, r := range results {
+			switch r.Status {
+			case entity.SeriesOccurrenceBooked:
+				booked++
+			case entity.SeriesOccurrenceConflict:
+				conflicts++
+				common.Logger(ctx, uc.logger).Warn("Ocorrência da série com conflito",
+					zap.String("tenant_id", s.TenantID.String()),
+					zap.String("series_id", s.ID),
+					zap.Int("occurrence_index", r.Index),
+					zap.Time("start_time", r.StartTime),
+					zap.String("detail", r.Detail),
+				)
+			}
+		}
+	}
+
+	common.Logger(ctx, uc.logger).Info("Séries de agendamentos estendidas",
+		zap.Int("series", len(series)),
+		zap.Int("booked", booked),
+		zap.Int("conflicts", conflicts),
+	)
+	return len(series), nil
+}
@@ -0,0 +1,185 @@
+package appointment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"go.uber.org/zap"
+)
+
+func TestCreateAppointmentSeriesUseCase_Execute(t *testing.T) {
+	logger := zap.NewNop()
+
+	t.Run("should book occurrences and report conflicts per occurrence", func(t *testing.T) {
+		firstStart := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+		conflictStart := firstStart.AddDate(0, 0, 14)
+
+		mockRepo := &MockAppointmentRepository{
+			CheckConflictFn: func(ctx context.Context, tenantID, unitID, professionalID string, startTime, endTime time.Time, excludeAppointmentID string) (bool, error) {
+				return startTime.Equal(conflictStart), nil
+			},
+		}
+		mockSvcReader := &MockServiceReader{
+			FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+				return []*port.ServiceInfo{
+					{ID: "svc-1", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+				}, nil
+			},
+		}
+		createUC := NewCreateAppointmentUseCase(mockRepo, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, nil, nil, nil, logger)
+		seriesRepo := NewMockAppointmentSeriesRepository()
+		uc := NewCreateAppointmentSeriesUseCase(seriesRepo, createUC, logger)
+
+		endsOn := firstStart.AddDate(0, 0, 21)
+		result, err := uc.Execute(context.Background(), CreateAppointmentSeriesInput{
+			TenantID:       testTenantID,
+			UnitID:         testUnitID,
+			ProfessionalID: "prof-123",
+			CustomerID:     "cust-123",
+			ServiceIDs:     []string{"svc-1"},
+			StartTime:      firstStart,
+			IntervalWeeks:  1,
+			EndsOn:         &endsOn,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if len(result.Occurrences) != 4 {
+			t.Fatalf("expected 4 occurrences, got %d", len(result.Occurrences))
+		}
+		for _, o := range result.Occurrences {
+			want := entity.SeriesOccurrenceBooked
+			if o.StartTime.Equal(conflictStart) {
+				want = entity.SeriesOccurrenceConflict
+			}
+			if o.Status != want {
+				t.Errorf("occurrence %d: expected status %s, got %s", o.Index, want, o.Status)
+			}
+		}
+		if mockRepo.CreateCalls != 3 {
+			t.Errorf("expected 3 appointments created, got %d", mockRepo.CreateCalls)
+		}
+		if result.Series.GeneratedUntil == nil {
+			t.Error("expected generated_until to be set")
+		}
+	})
+
+	t.Run("should not generate occurrences twice", func(t *testing.T) {
+		firstStart := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+		mockSvcReader := &MockServiceReader{
+			FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+				return []*port.ServiceInfo{
+					{ID: "svc-1", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+				}, nil
+			},
+		}
+		mockRepo := &MockAppointmentRepository{}
+		createUC := NewCreateAppointmentUseCase(mockRepo, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, nil, nil, nil, logger)
+		seriesRepo := NewMockAppointmentSeriesRepository()
+
+		result, err := NewCreateAppointmentSeriesUseCase(seriesRepo, createUC, logger).Execute(context.Background(), CreateAppointmentSeriesInput{
+			TenantID:       testTenantID,
+			UnitID:         testUnitID,
+			ProfessionalID: "prof-123",
+			CustomerID:     "cust-123",
+			ServiceIDs:     []string{"svc-1"},
+			StartTime:      firstStart,
+			IntervalWeeks:  2,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		created := mockRepo.CreateCalls
+
+		again, err := generateSeriesOccurrences(context.Background(), seriesRepo, createUC, logger, result.Series, time.Now().Add(SeriesGenerationHorizon))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(again) != 0 || mockRepo.CreateCalls != created {
+			t.Errorf("expected no new occurrences, got %d (creates %d -> %d)", len(again), created, mockRepo.CreateCalls)
+		}
+	})
+}
+
+// seriesListenerSpy registra os horários liberados e os avisos de status
+type seriesListenerSpy struct {
+	released   []ReleasedSlot
+	attendance []*entity.Appointment
+}
+
+func (s *seriesListenerSpy) SlotReleased(ctx context.Context, slot ReleasedSlot) {
+	s.released = append(s.released, slot)
+}
+
+func (s *seriesListenerSpy) AttendanceChanged(ctx context.Context, a *entity.Appointment) {
+	s.attendance = append(s.attendance, a)
+}
+
+func TestEndAppointmentSeriesUseCase_EndByCustomer(t *testing.T) {
+	logger := zap.NewNop()
+	firstStart := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+
+	booked := map[string]*entity.Appointment{}
+	mockRepo := &MockAppointmentRepository{
+		CreateFn: func(ctx context.Context, a *entity.Appointment) error {
+			booked[a.ID] = a
+			return nil
+		},
+		FindByIDFn: func(ctx context.Context, tenantID, unitID, id string) (*entity.Appointment, error) {
+			return booked[id], nil
+		},
+	}
+	mockSvcReader := &MockServiceReader{
+		FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+			return []*port.ServiceInfo{
+				{ID: "svc-1", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+			}, nil
+		},
+	}
+	createUC := NewCreateAppointmentUseCase(mockRepo, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, nil, nil, nil, logger)
+	seriesRepo := NewMockAppointmentSeriesRepository()
+
+	endsOn := firstStart.AddDate(0, 0, 14)
+	result, err := NewCreateAppointmentSeriesUseCase(seriesRepo, createUC, logger).Execute(context.Background(), CreateAppointmentSeriesInput{
+		TenantID:       testTenantID,
+		UnitID:         testUnitID,
+		ProfessionalID: "prof-123",
+		CustomerID:     "cust-123",
+		ServiceIDs:     []string{"svc-1"},
+		StartTime:      firstStart,
+		IntervalWeeks:  1,
+		EndsOn:         &endsOn,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	spy := &seriesListenerSpy{}
+	history := &fakeStatusHistory{}
+	uc := NewEndAppointmentSeriesUseCase(seriesRepo, mockRepo, nil, history, spy, spy, logger)
+
+	ended, canceled, err := uc.EndByCustomer(context.Background(), testTenantID, "cust-123", "user-1", "cliente inativado")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if ended != 1 || canceled != 3 {
+		t.Fatalf("expected 1 series and 3 appointments, got %d and %d", ended, canceled)
+	}
+	if result.Series.IsActive() {
+		t.Error("expected series to be ended")
+	}
+	if len(spy.released) != 3 || spy.released[0].Reason != SlotReleasedCanceled {
+		t.Errorf("expected 3 released slots, got %+v", spy.released)
+	}
+	if len(spy.attendance) != 3 {
+		t.Errorf("expected 3 attendance notifications, got %d", len(spy.attendance))
+	}
+	if len(history.changes) != 3 || history.changes[0].ActorID != "user-1" {
+		t.Errorf("expected 3 history entries by user-1, got %+v", history.changes)
+	}
+}
@@ -0,0 +1,71 @@
+package appointment
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// BookingListener é avisado dos agendamentos criados (ex.: exigir sinal pela
+// política de não comparecimento). Erros ficam com o listener: o agendamento
+// já foi criado.
+type BookingListener interface {
+	AppointmentBooked(ctx context.Context, a *entity.Appointment)
+}
+
+// AttendanceListener é avisado quando o cliente comparece, falta ou o
+// agendamento é cancelado (ex.: creditar ou reter o sinal). O status novo
+// já está no agendamento.
+type AttendanceListener interface {
+	AttendanceChanged(ctx context.Context, a *entity.Appointment)
+}
+
+// RescheduleListener é avisado dos agendamentos remarcados (ex.: atualizar o
+// evento no calendário externo). O horário novo já está no agendamento.
+type RescheduleListener interface {
+	AppointmentRescheduled(ctx context.Context, a *entity.Appointment)
+}
+
+// BookingListeners repassa o agendamento criado a vários listeners, na ordem
+type BookingListeners []BookingListener
+
+// AppointmentBooked implementa BookingListener
+func (ls BookingListeners) AppointmentBooked(ctx context.Context, a *entity.Appointment) {
+	for _, l := range ls {
+		notifyBooked(ctx, l, a)
+	}
+}
+
+// AttendanceListeners repassa a mudança de status a vários listeners, na ordem
+type AttendanceListeners []AttendanceListener
+
+// AttendanceChanged implementa AttendanceListener
+func (ls AttendanceListeners) AttendanceChanged(ctx context.Context, a *entity.Appointment) {
+	for _, l := range ls {
+		notifyAttendance(ctx, l, a)
+	}
+}
+
+// notifyBooked avisa o listener (nil-safe) do agendamento criado
+func notifyBooked(ctx context.Context, listener BookingListener, a *entity.Appointment) {
+	if listener == nil {
+		return
+	}
+	listener.AppointmentBooked(ctx, a)
+}
+
+// notifyAttendance avisa o listener (nil-safe) da mudança de status
+func notifyAttendance(ctx context.Context, listener AttendanceListener, a *entity.Appointment) {
+	if listener == nil {
+		return
+	}
+	listener.AttendanceChanged(ctx, a)
+}
+
+// notifyRescheduled avisa o listener (nil-safe) da remarcação
+func notifyRescheduled(ctx context.Context, listener RescheduleListener, a *entity.Appointment) {
+	if listener == nil {
+		return
+	}
+	listener.AppointmentRescheduled(ctx, a)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -16,27 +17,46 @@ type CancelAppointmentInput struct {
 	UnitID        string
 	AppointmentID string
 	Reason        string
+	ActorID       string // Usuário que cancelou
 }
 
 // CancelAppointmentUseCase implementa o cancelamento de agendamentos
 type CancelAppointmentUseCase struct {
-	repo   port.AppointmentRepository
-	logger *zap.Logger
+	repo       port.AppointmentRepository
+	seriesRepo port.AppointmentSeriesRepository
+	events     port.EventPublisher
+	history    port.AppointmentStatusHistoryRepository
+	slots      SlotReleaseListener
+	attendance AttendanceListener
+	logger     *zap.Logger
 }
 
 // NewCancelAppointmentUseCase cria nova instância do use case
 func NewCancelAppointmentUseCase(
 	repo port.AppointmentRepository,
+	seriesRepo port.AppointmentSeriesRepository,
+	events port.EventPublisher,
+	history port.AppointmentStatusHistoryRepository,
+	slots SlotReleaseListener,
+	attendance AttendanceListener,
 	logger *zap.Logger,
 ) *CancelAppointmentUseCase {
 	return &CancelAppointmentUseCase{
-		repo:   repo,
-		logger: logger,
+		repo:       repo,
+		seriesRepo: seriesRepo,
+		events:     events,
+		history:    history,
+		slots:      slots,
+		attendance: attendance,
+		logger:     logger,
 	}
 }
 
 // Execute cancela um agendamento
 func (uc *CancelAppointmentUseCase) Execute(ctx context.Context, input CancelAppointmentInput) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.CancelAppointment")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -53,6 +73,8 @@ func (uc *CancelAppointmentUseCase) Execute(ctx context.Context, input CancelApp
 		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
 	}
 
+	statusAnterior := appointment.Status
+
 	// Cancelar
 	if err := appointment.Cancel(input.Reason); err != nil {
 		return nil, fmt.Errorf("erro ao cancelar agendamento: %w", err)
@@ -63,11 +85,97 @@ func (uc *CancelAppointmentUseCase) Execute(ctx context.Context, input CancelApp
 		return nil, fmt.Errorf("erro ao salvar agendamento: %w", err)
 	}
 
-	uc.logger.Info("Agendamento cancelado",
+	common.Logger(ctx, uc.logger).Info("Agendamento cancelado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("appointment_id", input.AppointmentID),
 		zap.String("reason", input.Reason),
 	)
 
+	common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, input.ActorID, input.Reason)
+	common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
+	releaseSlot(ctx, uc.slots, appointment, SlotReleasedCanceled)
+	notifyAttendance(ctx, uc.attendance, appointment)
+
 	return appointment, nil
 }
+
+// CancelFollowingOutput resultado de "cancelar esta e as seguintes"
+type CancelFollowingOutput struct {
+	Appointment   *entity.Appointment
+	Series        *entity.AppointmentSeries
+	CanceledCount int // ocorrências seguintes canceladas
+}
+
+// ExecuteFollowing cancela o agendamento e as ocorrências seguintes ainda
+// ativas da série, e encerra a série antes dele (nada mais é gerado)
+func (uc *CancelAppointmentUseCase) ExecuteFollowing(ctx context.Context, input CancelAppointmentInput) (*CancelFollowingOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.CancelFollowing")
+	defer span.End()
+
+	if input.TenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.AppointmentID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	occurrence, err := uc.seriesRepo.FindOccurrenceByAppointment(ctx, input.TenantID, input.AppointmentID)
+	if err != nil {
+		return nil, err
+	}
+	series, err := uc.seriesRepo.FindByID(ctx, input.TenantID, occurrence.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	appointment, err := uc.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := uc.seriesRepo.ListOccurrences(ctx, input.TenantID, series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &CancelFollowingOutput{Appointment: appointment, Series: series}
+	for _, o := range occurrences {
+		if o.Index <= occurrence.Index || o.AppointmentID == "" {
+			continue
+		}
+		a, err := uc.repo.FindByID(ctx, input.TenantID, input.UnitID, o.AppointmentID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar ocorrência %d da série: %w", o.Index, err)
+		}
+		statusAnterior := a.Status
+		if a.Cancel(input.Reason) != nil {
+			continue // em atendimento ou já finalizada
+		}
+		if err := uc.repo.Update(ctx, a); err != nil {
+			return nil, fmt.Errorf("erro ao cancelar ocorrência %d da série: %w", o.Index, err)
+		}
+		common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, a, statusAnterior, input.ActorID, input.Reason)
+		common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, a, statusAnterior)
+		releaseSlot(ctx, uc.slots, a, SlotReleasedCanceled)
+		notifyAttendance(ctx, uc.attendance, a)
+		out.CanceledCount++
+	}
+
+	if series.IsActive() {
+		if err := series.EndBefore(occurrence.Index, input.Reason); err != nil {
+			return nil, err
+		}
+		if err := uc.seriesRepo.Update(ctx, series); err != nil {
+			return nil, err
+		}
+	}
+
+	common.Logger(ctx, uc.logger).Info("Série cancelada a partir de uma ocorrência",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("series_id", series.ID),
+		zap.Int("occurrence_index", occurrence.Index),
+		zap.Int("canceled", out.CanceledCount),
+	)
+
+	return out, nil
+}
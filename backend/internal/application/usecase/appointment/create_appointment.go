@@ -2,9 +2,11 @@ package appointment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -17,13 +19,33 @@ import (
 type CreateAppointmentInput struct {
 	TenantID       string
 	UnitID         string
-	ProfessionalID string
+	ProfessionalID string // Responsável; opcional quando Services informa os profissionais
 	CustomerID     string
 	StartTime      time.Time
 	ServiceIDs     []string
+	Services       []ServiceAssignment // Alternativa a ServiceIDs com profissional por serviço
 	Notes          string
 }
 
+// ServiceAssignment serviço do agendamento com o profissional que o executa.
+// Os serviços são executados na ordem informada.
+type ServiceAssignment struct {
+	ServiceID      string
+	ProfessionalID string // Opcional: vazio usa o profissional responsável
+}
+
+// assignments retorna os serviços do agendamento na ordem de execução
+func (in CreateAppointmentInput) assignments() []ServiceAssignment {
+	if len(in.Services) > 0 {
+		return in.Services
+	}
+	out := make([]ServiceAssignment, 0, len(in.ServiceIDs))
+	for _, id := range in.ServiceIDs {
+		out = append(out, ServiceAssignment{ServiceID: id})
+	}
+	return out
+}
+
 // CreateAppointmentOutput dados de saída da criação de agendamento
 type CreateAppointmentOutput struct {
 	Appointment *entity.Appointment
@@ -37,6 +59,9 @@ type CreateAppointmentUseCase struct {
 	serviceReader      port.ServiceReader
 	professionalReader port.ProfessionalReader
 	customerReader     port.CustomerReader
+	resources          port.ResourceRepository // Recursos da unidade (cadeiras, salas)
+	events             port.EventPublisher
+	bookings           BookingListener
 	logger             *zap.Logger
 }
 
@@ -47,6 +72,9 @@ func NewCreateAppointmentUseCase(
 	serviceReader port.ServiceReader,
 	professionalReader port.ProfessionalReader,
 	customerReader port.CustomerReader,
+	resources port.ResourceRepository,
+	events port.EventPublisher,
+	bookings BookingListener,
 	logger *zap.Logger,
 ) *CreateAppointmentUseCase {
 	return &CreateAppointmentUseCase{
@@ -55,12 +83,18 @@ func NewCreateAppointmentUseCase(
 		serviceReader:      serviceReader,
 		professionalReader: professionalReader,
 		customerReader:     customerReader,
+		resources:          resources,
+		events:             events,
+		bookings:           bookings,
 		logger:             logger,
 	}
 }
 
 // Execute cria um novo agendamento
 func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateAppointmentInput) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.CreateAppointment")
+	defer span.End()
+
 	// 1. Validações básicas
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -68,6 +102,13 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 	if input.UnitID == "" {
 		return nil, domain.ErrUnitIDRequired
 	}
+	assignments := input.assignments()
+	if len(assignments) == 0 {
+		return nil, domain.ErrAppointmentServicesRequired
+	}
+	if input.ProfessionalID == "" {
+		input.ProfessionalID = assignments[0].ProfessionalID
+	}
 	if input.ProfessionalID == "" {
 		return nil, domain.ErrAppointmentProfessionalRequired
 	}
@@ -77,17 +118,29 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 	if input.StartTime.IsZero() {
 		return nil, domain.ErrAppointmentStartTimeRequired
 	}
-	if len(input.ServiceIDs) == 0 {
-		return nil, domain.ErrAppointmentServicesRequired
-	}
 
-	// 2. Verificar se profissional existe e está ativo
-	profExists, err := uc.professionalReader.Exists(ctx, input.TenantID, input.ProfessionalID)
-	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar profissional: %w", err)
+	// 2. Verificar se os profissionais existem e estão ativos
+	checked := map[string]bool{}
+	serviceIDs := make([]string, 0, len(assignments))
+	professionalIDs := []string{input.ProfessionalID}
+	for _, a := range assignments {
+		serviceIDs = append(serviceIDs, a.ServiceID)
+		if a.ProfessionalID != "" {
+			professionalIDs = append(professionalIDs, a.ProfessionalID)
+		}
 	}
-	if !profExists {
-		return nil, domain.ErrAppointmentProfessionalNotFound
+	for _, professionalID := range professionalIDs {
+		if checked[professionalID] {
+			continue
+		}
+		checked[professionalID] = true
+		profExists, err := uc.professionalReader.Exists(ctx, input.TenantID, professionalID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao verificar profissional: %w", err)
+		}
+		if !profExists {
+			return nil, domain.ErrAppointmentProfessionalNotFound
+		}
 	}
 
 	// 3. Verificar se cliente existe e está ativo
@@ -100,22 +153,31 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 	}
 
 	// 4. Buscar dados dos serviços
-	services, err := uc.serviceReader.FindByIDs(ctx, input.TenantID, input.ServiceIDs)
+	services, err := uc.serviceReader.FindByIDs(ctx, input.TenantID, serviceIDs)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao buscar serviços: %w", err)
 	}
-	if len(services) != len(input.ServiceIDs) {
+	if len(services) != len(serviceIDs) {
 		return nil, domain.ErrAppointmentServiceNotFound
 	}
-
-	// 5. Montar lista de serviços do agendamento
-	appointmentServices := make([]entity.AppointmentService, 0, len(services))
+	servicesByID := make(map[string]*port.ServiceInfo, len(services))
 	for _, svc := range services {
+		servicesByID[svc.ID] = svc
+	}
+
+	// 5. Montar lista de serviços do agendamento, na ordem de execução
+	appointmentServices := make([]entity.AppointmentService, 0, len(assignments))
+	for _, a := range assignments {
+		svc, ok := servicesByID[a.ServiceID]
+		if !ok {
+			return nil, domain.ErrAppointmentServiceNotFound
+		}
 		if !svc.Active {
 			return nil, fmt.Errorf("serviço %s está inativo", svc.Name)
 		}
 		appointmentServices = append(appointmentServices, entity.AppointmentService{
 			ServiceID:         svc.ID,
+			ProfessionalID:    a.ProfessionalID,
 			ServiceName:       svc.Name,
 			PriceAtBooking:    svc.Price,
 			DurationAtBooking: svc.Duration,
@@ -144,56 +206,19 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 		return nil, fmt.Errorf("erro ao criar agendamento: %w", err)
 	}
 
-	// 7. Verificar conflito de horário com outros agendamentos
-	hasConflict, err := uc.appointmentRepo.CheckConflict(
-		ctx,
-		input.TenantID,
-		input.UnitID, // Added UnitID
-		input.ProfessionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-		"", // Não excluir nenhum agendamento
-	)
-	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar conflito: %w", err)
-	}
-	if hasConflict {
-		return nil, domain.ErrAppointmentConflict
+	// 7-9. Verificar, na agenda de cada profissional, conflito com outros
+	// agendamentos, horários bloqueados e o intervalo mínimo
+	if err := verificarHorario(ctx, uc.appointmentRepo, input.TenantID, appointment); err != nil {
+		return nil, err
 	}
 
-	// 8. Verificar conflito com horários bloqueados (blocked_times)
-	hasBlockedConflict, err := uc.appointmentRepo.CheckBlockedTimeConflict(
-		ctx,
-		input.TenantID,
-		input.UnitID, // Added UnitID
-		input.ProfessionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar bloqueio: %w", err)
+	// Reservar os recursos da unidade exigidos pelos serviços e verificar se
+	// há capacidade no horário
+	if err := reservarRecursos(ctx, uc.resources, input.TenantID, appointment); err != nil {
+		return nil, err
 	}
-	if hasBlockedConflict {
-		return nil, domain.ErrAppointmentBlockedTimeConflict
-	}
-
-	// 9. Verificar intervalo mínimo entre agendamentos (RN-AGE-003: 10 minutos)
-	const minimumIntervalMinutes = 10
-	hasIntervalConflict, err := uc.appointmentRepo.CheckMinimumIntervalConflict(
-		ctx,
-		input.TenantID,
-		input.UnitID, // Added UnitID
-		input.ProfessionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-		"",
-		minimumIntervalMinutes,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar intervalo mínimo: %w", err)
-	}
-	if hasIntervalConflict {
-		return nil, domain.ErrAppointmentMinimumInterval
+	if err := verificarRecursos(ctx, uc.resources, input.TenantID, appointment); err != nil {
+		return nil, err
 	}
 
 	// 10. Definir observações
@@ -201,8 +226,12 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 		appointment.SetNotes(input.Notes)
 	}
 
-	// 11. Persistir agendamento
+	// 11. Persistir agendamento. A constraint do banco decide reservas
+	// simultâneas no mesmo horário que passaram pela verificação do passo 7.
 	if err := uc.appointmentRepo.Create(ctx, appointment); err != nil {
+		if errors.Is(err, domain.ErrAppointmentConflict) || errors.Is(err, domain.ErrResourceUnavailable) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("erro ao salvar agendamento: %w", err)
 	}
 
@@ -212,13 +241,14 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 
 	command, err := entity.NewCommand(tenantUUID, customerUUID, &appointmentUUID)
 	if err != nil {
-		uc.logger.Warn("Falha ao criar entidade de comanda",
+		common.Logger(ctx, uc.logger).Warn("Falha ao criar entidade de comanda",
 			zap.String("appointment_id", appointment.ID),
 			zap.Error(err))
 		// Não bloqueia criação do agendamento - comanda pode ser criada depois
 	} else {
 		// Adicionar os serviços como itens da comanda
-		for _, svc := range services {
+		for _, as := range appointment.Services {
+			svc := servicesByID[as.ServiceID]
 			item := entity.CommandItem{
 				ID:            uuid.New(),
 				CommandID:     command.ID,
@@ -231,7 +261,7 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 				CriadoEm:      time.Now(),
 			}
 			if err := command.AddItem(item); err != nil {
-				uc.logger.Warn("Falha ao adicionar item à comanda",
+				common.Logger(ctx, uc.logger).Warn("Falha ao adicionar item à comanda",
 					zap.String("service_id", svc.ID),
 					zap.Error(err))
 			}
@@ -239,13 +269,13 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 
 		// Persistir comanda (o número será gerado automaticamente pelo repositório)
 		if err := uc.commandRepo.Create(ctx, command); err != nil {
-			uc.logger.Warn("Falha ao salvar comanda",
+			common.Logger(ctx, uc.logger).Warn("Falha ao salvar comanda",
 				zap.String("appointment_id", appointment.ID),
 				zap.Error(err))
 			// Não bloqueia - comanda pode ser criada manualmente depois
 			command = nil
 		} else {
-			uc.logger.Info("Comanda criada automaticamente",
+			common.Logger(ctx, uc.logger).Info("Comanda criada automaticamente",
 				zap.String("appointment_id", appointment.ID),
 				zap.String("command_id", command.ID.String()),
 				zap.String("numero", *command.Numero),
@@ -254,7 +284,7 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 		}
 	}
 
-	uc.logger.Info("Agendamento criado",
+	common.Logger(ctx, uc.logger).Info("Agendamento criado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("appointment_id", appointment.ID),
 		zap.String("professional_id", input.ProfessionalID),
@@ -263,6 +293,9 @@ func (uc *CreateAppointmentUseCase) Execute(ctx context.Context, input CreateApp
 		zap.String("total_price", appointment.TotalPrice.String()),
 	)
 
+	common.PublishAppointmentCreated(ctx, uc.events, uc.logger, appointment)
+	notifyBooked(ctx, uc.bookings, appointment)
+
 	return appointment, nil
 }
 
@@ -306,6 +339,9 @@ func NewListAppointmentsUseCase(
 
 // Execute lista agendamentos com filtros
 func (uc *ListAppointmentsUseCase) Execute(ctx context.Context, input ListAppointmentsInput) (*ListAppointmentsOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.ListAppointments")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -35,7 +35,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -79,7 +79,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       "",
@@ -107,7 +107,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -135,7 +135,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -163,7 +163,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -191,7 +191,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -223,7 +223,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		mockCustReader := &MockCustomerReader{}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -255,7 +255,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		}
 		mockSvcReader := &MockServiceReader{}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -288,7 +288,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -326,7 +326,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -347,6 +347,43 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 		}
 	})
 
+	t.Run("should return conflict when a concurrent booking takes the slot", func(t *testing.T) {
+		// A verificação prévia não vê conflito, mas a constraint do banco
+		// rejeita o insert porque outra reserva gravou o mesmo horário antes
+		mockRepo := &MockAppointmentRepository{
+			CreateFn: func(ctx context.Context, appointment *entity.Appointment) error {
+				return domain.ErrAppointmentConflict
+			},
+		}
+		mockCommandRepo := &MockCommandRepository{}
+		mockProfReader := &MockProfessionalReader{}
+		mockCustReader := &MockCustomerReader{}
+		mockSvcReader := &MockServiceReader{
+			FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+				return []*port.ServiceInfo{
+					{ID: "svc-1", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+				}, nil
+			},
+		}
+
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
+
+		input := CreateAppointmentInput{
+			TenantID:       testTenantID,
+			UnitID:         testUnitID,
+			ProfessionalID: "prof-123",
+			CustomerID:     "cust-123",
+			StartTime:      time.Now().Add(24 * time.Hour),
+			ServiceIDs:     []string{"svc-1"},
+		}
+
+		_, err := uc.Execute(context.Background(), input)
+
+		if err != domain.ErrAppointmentConflict {
+			t.Errorf("expected ErrAppointmentConflict, got %v", err)
+		}
+	})
+
 	t.Run("should calculate end_time and total_price correctly", func(t *testing.T) {
 		mockRepo := &MockAppointmentRepository{}
 		mockCommandRepo := &MockCommandRepository{}
@@ -361,7 +398,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		startTime := time.Now().Add(24 * time.Hour)
 		input := CreateAppointmentInput{
@@ -405,7 +442,7 @@ func TestCreateAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, logger)
+		uc := NewCreateAppointmentUseCase(mockRepo, mockCommandRepo, mockSvcReader, mockProfReader, mockCustReader, nil, nil, nil, logger)
 
 		input := CreateAppointmentInput{
 			TenantID:       testTenantID,
@@ -602,3 +639,152 @@ func TestListAppointmentsUseCase_Execute(t *testing.T) {
 		}
 	})
 }
+
+func TestCreateAppointmentUseCase_MultipleProfessionals(t *testing.T) {
+	logger := zap.NewNop()
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	mockSvcReader := &MockServiceReader{
+		FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+			// Ordem diferente da pedida: a sequência segue o input
+			return []*port.ServiceInfo{
+				{ID: "svc-barba", Name: "Barba", Price: valueobject.NewMoneyFromFloat(30.0), Duration: 20, Active: true},
+				{ID: "svc-corte", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+			}, nil
+		},
+	}
+	input := CreateAppointmentInput{
+		TenantID:   testTenantID,
+		UnitID:     testUnitID,
+		CustomerID: "cust-123",
+		StartTime:  start,
+		Services: []ServiceAssignment{
+			{ServiceID: "svc-corte", ProfessionalID: "prof-joao"},
+			{ServiceID: "svc-barba", ProfessionalID: "prof-pedro"},
+		},
+	}
+
+	t.Run("should schedule each service with its professional", func(t *testing.T) {
+		checked := map[string][2]time.Time{}
+		mockRepo := &MockAppointmentRepository{
+			CheckConflictFn: func(ctx context.Context, tenantID, unitID, professionalID string, startTime, endTime time.Time, excludeAppointmentID string) (bool, error) {
+				checked[professionalID] = [2]time.Time{startTime, endTime}
+				return false, nil
+			},
+		}
+		uc := NewCreateAppointmentUseCase(mockRepo, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, nil, nil, nil, logger)
+
+		result, err := uc.Execute(context.Background(), input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if result.ProfessionalID != "prof-joao" {
+			t.Errorf("expected responsible prof-joao, got %s", result.ProfessionalID)
+		}
+		if len(result.Services) != 2 || result.Services[0].ServiceID != "svc-corte" || result.Services[1].ProfessionalID != "prof-pedro" {
+			t.Fatalf("unexpected services: %+v", result.Services)
+		}
+		if !result.EndTime.Equal(start.Add(50 * time.Minute)) {
+			t.Errorf("expected end %v, got %v", start.Add(50*time.Minute), result.EndTime)
+		}
+		if got := checked["prof-joao"]; !got[0].Equal(start) || !got[1].Equal(start.Add(30*time.Minute)) {
+			t.Errorf("unexpected slot checked for prof-joao: %v", got)
+		}
+		if got := checked["prof-pedro"]; !got[0].Equal(start.Add(30*time.Minute)) || !got[1].Equal(start.Add(50*time.Minute)) {
+			t.Errorf("unexpected slot checked for prof-pedro: %v", got)
+		}
+	})
+
+	t.Run("should fail when the second professional is busy", func(t *testing.T) {
+		mockRepo := &MockAppointmentRepository{
+			CheckConflictFn: func(ctx context.Context, tenantID, unitID, professionalID string, startTime, endTime time.Time, excludeAppointmentID string) (bool, error) {
+				return professionalID == "prof-pedro", nil
+			},
+		}
+		uc := NewCreateAppointmentUseCase(mockRepo, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, nil, nil, nil, logger)
+
+		_, err := uc.Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrAppointmentConflict) {
+			t.Fatalf("expected ErrAppointmentConflict, got %v", err)
+		}
+		if mockRepo.CreateCalls != 0 {
+			t.Errorf("expected Create not to be called, got %d", mockRepo.CreateCalls)
+		}
+	})
+}
+
+// fakeResourceRepo responde só o necessário para a verificação de recursos
+type fakeResourceRepo struct {
+	port.ResourceRepository
+	resource     *entity.Resource
+	requirements []entity.ServiceResourceRequirement
+	peak         int
+	windows      [][2]time.Time
+}
+
+func (r *fakeResourceRepo) FindByID(ctx context.Context, tenantID, id string) (*entity.Resource, error) {
+	return r.resource, nil
+}
+
+func (r *fakeResourceRepo) ListRequirementsForServices(ctx context.Context, tenantID, unitID string, serviceIDs []string) ([]entity.ServiceResourceRequirement, error) {
+	return r.requirements, nil
+}
+
+func (r *fakeResourceRepo) PeakUsage(ctx context.Context, tenantID, resourceID string, start, end time.Time, excludeAppointmentID string) (int, error) {
+	r.windows = append(r.windows, [2]time.Time{start, end})
+	return r.peak, nil
+}
+
+func TestCreateAppointmentUseCase_Resources(t *testing.T) {
+	logger := zap.NewNop()
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Minute)
+	mockSvcReader := &MockServiceReader{
+		FindByIDsFn: func(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+			return []*port.ServiceInfo{
+				{ID: "svc-corte", Name: "Corte", Price: valueobject.NewMoneyFromFloat(50.0), Duration: 30, Active: true},
+			}, nil
+		},
+	}
+	input := CreateAppointmentInput{
+		TenantID:       testTenantID,
+		UnitID:         testUnitID,
+		ProfessionalID: "prof-123",
+		CustomerID:     "cust-123",
+		StartTime:      start,
+		ServiceIDs:     []string{"svc-corte"},
+	}
+	newRepo := func(peak int) *fakeResourceRepo {
+		return &fakeResourceRepo{
+			resource: &entity.Resource{ID: "res-lavatorio", Nome: "Lavatório", Capacity: 1, Ativo: true},
+			requirements: []entity.ServiceResourceRequirement{
+				// Lavagem nos 10 primeiros minutos do corte
+				{ServiceID: "svc-corte", ResourceID: "res-lavatorio", DurationMinutes: 10},
+			},
+			peak: peak,
+		}
+	}
+
+	t.Run("should reserve the resource only while it is used", func(t *testing.T) {
+		resources := newRepo(0)
+		uc := NewCreateAppointmentUseCase(&MockAppointmentRepository{}, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, resources, nil, nil, logger)
+
+		result, err := uc.Execute(context.Background(), input)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(result.Resources) != 1 || result.Resources[0].ResourceID != "res-lavatorio" {
+			t.Fatalf("unexpected resources: %+v", result.Resources)
+		}
+		if len(resources.windows) != 1 || !resources.windows[0][0].Equal(start) || !resources.windows[0][1].Equal(start.Add(10*time.Minute)) {
+			t.Errorf("unexpected window checked: %v", resources.windows)
+		}
+	})
+
+	t.Run("should fail when the resource is exhausted", func(t *testing.T) {
+		uc := NewCreateAppointmentUseCase(&MockAppointmentRepository{}, &MockCommandRepository{}, mockSvcReader, &MockProfessionalReader{}, &MockCustomerReader{}, newRepo(1), nil, nil, logger)
+
+		_, err := uc.Execute(context.Background(), input)
+		if !errors.Is(err, domain.ErrResourceUnavailable) {
+			t.Fatalf("expected ErrResourceUnavailable, got %v", err)
+		}
+	})
+}
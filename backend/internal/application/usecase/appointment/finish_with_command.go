@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -16,6 +17,7 @@ type FinishServiceWithCommandInput struct {
 	TenantID      string
 	UnitID        string
 	AppointmentID string
+	ActorID       string // Usuário que finalizou
 }
 
 // FinishServiceWithCommandOutput resultado da operação
@@ -29,6 +31,9 @@ type FinishServiceWithCommandOutput struct {
 type FinishServiceWithCommandUseCase struct {
 	appointmentRepo port.AppointmentRepository
 	commandRepo     port.CommandRepository
+	events          port.EventPublisher
+	history         port.AppointmentStatusHistoryRepository
+	attendance      AttendanceListener
 	logger          *zap.Logger
 }
 
@@ -36,17 +41,26 @@ type FinishServiceWithCommandUseCase struct {
 func NewFinishServiceWithCommandUseCase(
 	appointmentRepo port.AppointmentRepository,
 	commandRepo port.CommandRepository,
+	events port.EventPublisher,
+	history port.AppointmentStatusHistoryRepository,
+	attendance AttendanceListener,
 	logger *zap.Logger,
 ) *FinishServiceWithCommandUseCase {
 	return &FinishServiceWithCommandUseCase{
 		appointmentRepo: appointmentRepo,
 		commandRepo:     commandRepo,
+		events:          events,
+		history:         history,
+		attendance:      attendance,
 		logger:          logger,
 	}
 }
 
 // Execute finaliza o atendimento e cria comanda automaticamente se não existir
 func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input FinishServiceWithCommandInput) (*FinishServiceWithCommandOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.FinishServiceWithCommand")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -64,6 +78,7 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 	}
 
 	// 2. Tentar transição de status
+	statusAnterior := appointment.Status
 	if err := appointment.FinishService(); err != nil {
 		return nil, err
 	}
@@ -87,7 +102,7 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 
 		command, err := uc.commandRepo.FindByID(ctx, commandUUID, tenantUUID)
 		if err != nil {
-			uc.logger.Warn("Comanda existente não encontrada, criando nova",
+			common.Logger(ctx, uc.logger).Warn("Comanda existente não encontrada, criando nova",
 				zap.String("command_id", appointment.CommandID),
 				zap.Error(err),
 			)
@@ -101,6 +116,9 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 				return nil, fmt.Errorf("erro ao atualizar agendamento: %w", err)
 			}
 
+			common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, input.ActorID, "Atendimento finalizado")
+			common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
+			notifyAttendance(ctx, uc.attendance, appointment)
 			return output, nil
 		}
 	}
@@ -128,7 +146,7 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 	for _, svc := range appointment.Services {
 		serviceUUID, err := uuid.Parse(svc.ServiceID)
 		if err != nil {
-			uc.logger.Warn("ServiceID inválido, ignorando serviço",
+			common.Logger(ctx, uc.logger).Warn("ServiceID inválido, ignorando serviço",
 				zap.String("service_id", svc.ServiceID),
 				zap.Error(err),
 			)
@@ -147,7 +165,7 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 			1, // quantidade
 		)
 		if err != nil {
-			uc.logger.Warn("Erro ao criar item da comanda, ignorando serviço",
+			common.Logger(ctx, uc.logger).Warn("Erro ao criar item da comanda, ignorando serviço",
 				zap.String("service_id", svc.ServiceID),
 				zap.Error(err),
 			)
@@ -175,12 +193,16 @@ func (uc *FinishServiceWithCommandUseCase) Execute(ctx context.Context, input Fi
 	output.Command = command
 	output.Created = true
 
-	uc.logger.Info("Serviço finalizado com comanda criada automaticamente",
+	common.Logger(ctx, uc.logger).Info("Serviço finalizado com comanda criada automaticamente",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("appointment_id", input.AppointmentID),
 		zap.String("command_id", command.ID.String()),
 		zap.Float64("total", command.Total),
 	)
 
+	common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, input.ActorID, "Atendimento finalizado")
+	common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
+	notifyAttendance(ctx, uc.attendance, appointment)
+
 	return output, nil
 }
@@ -244,6 +244,7 @@ type MockCommandRepository struct {
 	FindByIDFn            func(ctx context.Context, commandID, tenantID uuid.UUID) (*entity.Command, error)
 	FindByAppointmentIDFn func(ctx context.Context, appointmentID, tenantID uuid.UUID) (*entity.Command, error)
 	UpdateFn              func(ctx context.Context, command *entity.Command) error
+	CloseFn               func(ctx context.Context, command *entity.Command, debt *entity.CustomerDebt) (*entity.CustomerDebt, error)
 	DeleteFn              func(ctx context.Context, commandID, tenantID uuid.UUID) error
 	ListFn                func(ctx context.Context, tenantID uuid.UUID, filters port.CommandFilters) ([]*entity.Command, error)
 	AddItemFn             func(ctx context.Context, item *entity.CommandItem) error
@@ -283,6 +284,13 @@ func (m *MockCommandRepository) Update(ctx context.Context, command *entity.Comm
 	return nil
 }
 
+func (m *MockCommandRepository) Close(ctx context.Context, command *entity.Command, debt *entity.CustomerDebt) (*entity.CustomerDebt, error) {
+	if m.CloseFn != nil {
+		return m.CloseFn(ctx, command, debt)
+	}
+	return debt, nil
+}
+
 func (m *MockCommandRepository) Delete(ctx context.Context, commandID, tenantID uuid.UUID) error {
 	if m.DeleteFn != nil {
 		return m.DeleteFn(ctx, commandID, tenantID)
@@ -345,3 +353,92 @@ func (m *MockCommandRepository) GetPayments(ctx context.Context, commandID, tena
 	}
 	return nil, nil
 }
+
+// ============================================================================
+// Mock AppointmentSeriesRepository
+// ============================================================================
+
+// MockAppointmentSeriesRepository implementa port.AppointmentSeriesRepository
+// em memória para testes.
+type MockAppointmentSeriesRepository struct {
+	Series      map[string]*entity.AppointmentSeries
+	Occurrences []*entity.AppointmentSeriesOccurrence
+}
+
+func NewMockAppointmentSeriesRepository() *MockAppointmentSeriesRepository {
+	return &MockAppointmentSeriesRepository{Series: map[string]*entity.AppointmentSeries{}}
+}
+
+func (m *MockAppointmentSeriesRepository) Create(ctx context.Context, series *entity.AppointmentSeries) error {
+	m.Series[series.ID] = series
+	return nil
+}
+
+func (m *MockAppointmentSeriesRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.AppointmentSeries, error) {
+	if s, ok := m.Series[id]; ok {
+		return s, nil
+	}
+	return nil, nil
+}
+
+func (m *MockAppointmentSeriesRepository) Update(ctx context.Context, series *entity.AppointmentSeries) error {
+	m.Series[series.ID] = series
+	return nil
+}
+
+func (m *MockAppointmentSeriesRepository) ListByCustomer(ctx context.Context, tenantID, customerID string) ([]*entity.AppointmentSeries, error) {
+	var result []*entity.AppointmentSeries
+	for _, s := range m.Series {
+		if s.CustomerID == customerID {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAppointmentSeriesRepository) ListToGenerate(ctx context.Context, horizon time.Time, limit int) ([]*entity.AppointmentSeries, error) {
+	return nil, nil
+}
+
+func (m *MockAppointmentSeriesRepository) NextOccurrenceIndex(ctx context.Context, seriesID string) (int, error) {
+	next := 0
+	for _, o := range m.Occurrences {
+		if o.SeriesID == seriesID && o.Index >= next {
+			next = o.Index + 1
+		}
+	}
+	return next, nil
+}
+
+func (m *MockAppointmentSeriesRepository) CreateOccurrence(ctx context.Context, occurrence *entity.AppointmentSeriesOccurrence) (bool, error) {
+	for _, o := range m.Occurrences {
+		if o.SeriesID == occurrence.SeriesID && o.Index == occurrence.Index {
+			return false, nil
+		}
+	}
+	m.Occurrences = append(m.Occurrences, occurrence)
+	return true, nil
+}
+
+func (m *MockAppointmentSeriesRepository) UpdateOccurrence(ctx context.Context, occurrence *entity.AppointmentSeriesOccurrence) error {
+	return nil
+}
+
+func (m *MockAppointmentSeriesRepository) ListOccurrences(ctx context.Context, tenantID, seriesID string) ([]*entity.AppointmentSeriesOccurrence, error) {
+	var result []*entity.AppointmentSeriesOccurrence
+	for _, o := range m.Occurrences {
+		if o.SeriesID == seriesID {
+			result = append(result, o)
+		}
+	}
+	return result, nil
+}
+
+func (m *MockAppointmentSeriesRepository) FindOccurrenceByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentSeriesOccurrence, error) {
+	for _, o := range m.Occurrences {
+		if o.AppointmentID == appointmentID {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
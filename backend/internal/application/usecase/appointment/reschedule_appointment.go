@@ -2,9 +2,11 @@ package appointment
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -24,6 +26,10 @@ type RescheduleAppointmentInput struct {
 type RescheduleAppointmentUseCase struct {
 	repo               port.AppointmentRepository
 	professionalReader port.ProfessionalReader
+	seriesRepo         port.AppointmentSeriesRepository
+	resources          port.ResourceRepository
+	slots              SlotReleaseListener
+	rescheduled        RescheduleListener
 	logger             *zap.Logger
 }
 
@@ -31,17 +37,28 @@ type RescheduleAppointmentUseCase struct {
 func NewRescheduleAppointmentUseCase(
 	repo port.AppointmentRepository,
 	professionalReader port.ProfessionalReader,
+	seriesRepo port.AppointmentSeriesRepository,
+	resources port.ResourceRepository,
+	slots SlotReleaseListener,
+	rescheduled RescheduleListener,
 	logger *zap.Logger,
 ) *RescheduleAppointmentUseCase {
 	return &RescheduleAppointmentUseCase{
 		repo:               repo,
 		professionalReader: professionalReader,
+		seriesRepo:         seriesRepo,
+		resources:          resources,
+		slots:              slots,
+		rescheduled:        rescheduled,
 		logger:             logger,
 	}
 }
 
 // Execute reagenda um agendamento
 func (uc *RescheduleAppointmentUseCase) Execute(ctx context.Context, input RescheduleAppointmentInput) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.RescheduleAppointment")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -58,7 +75,9 @@ func (uc *RescheduleAppointmentUseCase) Execute(ctx context.Context, input Resch
 		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
 	}
 
-	// Guardar dados para verificação de conflito
+	// Guardar dados para verificação de conflito e para liberar o horário
+	// anterior
+	anterior := *appointment
 	oldStartTime := appointment.StartTime
 	professionalID := appointment.ProfessionalID
 
@@ -78,74 +97,206 @@ func (uc *RescheduleAppointmentUseCase) Execute(ctx context.Context, input Resch
 		return nil, fmt.Errorf("erro ao reagendar: %w", err)
 	}
 
-	// Se mudou de profissional, atualizar
-	if professionalID != appointment.ProfessionalID {
-		appointment.ProfessionalID = professionalID
+	// Se mudou de profissional, os serviços do responsável passam ao novo
+	appointment.ReassignProfessional(appointment.ProfessionalID, professionalID)
+
+	// Verificar conflitos com agendamentos, bloqueios, intervalo mínimo e
+	// capacidade dos recursos da unidade
+	if err := verificarHorario(ctx, uc.repo, input.TenantID, appointment); err != nil {
+		return nil, err
+	}
+	if err := verificarRecursos(ctx, uc.resources, input.TenantID, appointment); err != nil {
+		return nil, err
 	}
 
-	// Verificar conflito de horário
-	hasConflict, err := uc.repo.CheckConflict(
-		ctx,
-		input.TenantID,
-		appointment.UnitID.String(),
-		professionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-		appointment.ID, // Excluir o próprio agendamento da verificação
+	// Persistir (a constraint do banco decide remarcações concorrentes)
+	if err := uc.repo.Update(ctx, appointment); err != nil {
+		if errors.Is(err, domain.ErrAppointmentConflict) || errors.Is(err, domain.ErrResourceUnavailable) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("erro ao salvar agendamento: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Agendamento reagendado",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("appointment_id", input.AppointmentID),
+		zap.Time("old_start_time", oldStartTime),
+		zap.Time("new_start_time", appointment.StartTime),
 	)
+
+	releaseSlot(ctx, uc.slots, &anterior, SlotReleasedRescheduled)
+	notifyRescheduled(ctx, uc.rescheduled, appointment)
+
+	return appointment, nil
+}
+
+// RescheduleFollowingOutput resultado de "remarcar esta e as seguintes"
+type RescheduleFollowingOutput struct {
+	Appointment *entity.Appointment
+	Series      *entity.AppointmentSeries
+	Occurrences []SeriesOccurrenceResult // ocorrências seguintes, uma a uma
+}
+
+// ExecuteFollowing remarca o agendamento e as ocorrências seguintes da série
+// pelo mesmo deslocamento (e profissional). O agendamento informado segue as
+// regras de Execute; nas seguintes, um conflito não interrompe a operação: a
+// ocorrência mantém o horário anterior e o conflito é reportado.
+func (uc *RescheduleAppointmentUseCase) ExecuteFollowing(ctx context.Context, input RescheduleAppointmentInput) (*RescheduleFollowingOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.RescheduleFollowing")
+	defer span.End()
+
+	if input.TenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.AppointmentID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	atual, err := uc.repo.FindByID(ctx, input.TenantID, input.UnitID, input.AppointmentID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
+	}
+	occurrence, err := uc.seriesRepo.FindOccurrenceByAppointment(ctx, input.TenantID, input.AppointmentID)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar conflito: %w", err)
+		return nil, err
 	}
-	if hasConflict {
-		return nil, domain.ErrAppointmentConflict
+	series, err := uc.seriesRepo.FindByID(ctx, input.TenantID, occurrence.SeriesID)
+	if err != nil {
+		return nil, err
 	}
+	delta := input.NewStartTime.Sub(atual.StartTime)
 
-	// Verificar conflito com horários bloqueados
-	hasBlockedConflict, err := uc.repo.CheckBlockedTimeConflict(
-		ctx,
-		input.TenantID,
-		appointment.UnitID.String(),
-		professionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-	)
+	// A ocorrência escolhida segue o fluxo normal (conflito aqui é erro)
+	appointment, err := uc.Execute(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar bloqueio: %w", err)
-	}
-	if hasBlockedConflict {
-		return nil, domain.ErrAppointmentBlockedTimeConflict
-	}
-
-	// Verificar intervalo mínimo entre agendamentos (RN-AGE-003: 10 minutos)
-	const minimumIntervalMinutes = 10
-	hasIntervalConflict, err := uc.repo.CheckMinimumIntervalConflict(
-		ctx,
-		input.TenantID,
-		appointment.UnitID.String(),
-		professionalID,
-		appointment.StartTime,
-		appointment.EndTime,
-		appointment.ID,
-		minimumIntervalMinutes,
-	)
+		return nil, err
+	}
+	occurrence.ScheduledStart = appointment.StartTime
+	if err := uc.seriesRepo.UpdateOccurrence(ctx, occurrence); err != nil {
+		return nil, err
+	}
+
+	occurrences, err := uc.seriesRepo.ListOccurrences(ctx, input.TenantID, series.ID)
 	if err != nil {
-		return nil, fmt.Errorf("erro ao verificar intervalo mínimo: %w", err)
+		return nil, err
 	}
-	if hasIntervalConflict {
-		return nil, domain.ErrAppointmentMinimumInterval
+
+	out := &RescheduleFollowingOutput{Appointment: appointment, Series: series}
+	for _, o := range occurrences {
+		if o.Index <= occurrence.Index || o.AppointmentID == "" {
+			continue
+		}
+		a, err := uc.repo.FindByID(ctx, input.TenantID, input.UnitID, o.AppointmentID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar ocorrência %d da série: %w", o.Index, err)
+		}
+		anterior := *a
+		if !a.IsFuture() || a.Reschedule(a.StartTime.Add(delta)) != nil {
+			continue // já aconteceu ou está em status final
+		}
+		a.ReassignProfessional(a.ProfessionalID, appointment.ProfessionalID)
+
+		result := SeriesOccurrenceResult{Index: o.Index, StartTime: a.StartTime, AppointmentID: a.ID, Status: entity.SeriesOccurrenceBooked}
+		err = verificarHorario(ctx, uc.repo, input.TenantID, a)
+		if err == nil {
+			err = verificarRecursos(ctx, uc.resources, input.TenantID, a)
+		}
+		if err == nil {
+			err = uc.repo.Update(ctx, a)
+		}
+		switch {
+		case err == nil:
+			o.ScheduledStart = a.StartTime
+			if err := uc.seriesRepo.UpdateOccurrence(ctx, o); err != nil {
+				return nil, err
+			}
+			releaseSlot(ctx, uc.slots, &anterior, SlotReleasedRescheduled)
+			notifyRescheduled(ctx, uc.rescheduled, a)
+		case ocorrenciaIndisponivel(err):
+			result.Status = entity.SeriesOccurrenceConflict
+			result.StartTime = o.ScheduledStart
+			result.Detail = err.Error()
+		default:
+			return nil, fmt.Errorf("erro ao remarcar ocorrência %d da série: %w", o.Index, err)
+		}
+		out.Occurrences = append(out.Occurrences, result)
 	}
 
-	// Persistir
-	if err := uc.repo.Update(ctx, appointment); err != nil {
-		return nil, fmt.Errorf("erro ao salvar agendamento: %w", err)
+	// Próximas ocorrências geradas já no novo horário
+	series.Shift(delta, appointment.ProfessionalID)
+	if err := uc.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
 	}
 
-	uc.logger.Info("Agendamento reagendado",
+	common.Logger(ctx, uc.logger).Info("Série remarcada a partir de uma ocorrência",
 		zap.String("tenant_id", input.TenantID),
-		zap.String("appointment_id", input.AppointmentID),
-		zap.Time("old_start_time", oldStartTime),
-		zap.Time("new_start_time", appointment.StartTime),
+		zap.String("series_id", series.ID),
+		zap.Int("occurrence_index", occurrence.Index),
+		zap.Duration("delta", delta),
+		zap.Int("following", len(out.Occurrences)),
 	)
 
-	return appointment, nil
+	return out, nil
+}
+
+// verificarHorario aplica ao agendamento (já com o novo horário) as mesmas
+// verificações da criação, ignorando o próprio agendamento. Cada
+// profissional é verificado apenas no trecho dos serviços que executa.
+func verificarHorario(ctx context.Context, repo port.AppointmentRepository, tenantID string, appointment *entity.Appointment) error {
+	unitID := appointment.UnitID.String()
+
+	for _, slot := range appointment.ProfessionalSlots() {
+		// Verificar conflito de horário
+		hasConflict, err := repo.CheckConflict(
+			ctx,
+			tenantID,
+			unitID,
+			slot.ProfessionalID,
+			slot.StartTime,
+			slot.EndTime,
+			appointment.ID, // Excluir o próprio agendamento da verificação
+		)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar conflito: %w", err)
+		}
+		if hasConflict {
+			return domain.ErrAppointmentConflict
+		}
+
+		// Verificar conflito com horários bloqueados
+		hasBlockedConflict, err := repo.CheckBlockedTimeConflict(
+			ctx,
+			tenantID,
+			unitID,
+			slot.ProfessionalID,
+			slot.StartTime,
+			slot.EndTime,
+		)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar bloqueio: %w", err)
+		}
+		if hasBlockedConflict {
+			return domain.ErrAppointmentBlockedTimeConflict
+		}
+
+		// Verificar intervalo mínimo entre agendamentos (RN-AGE-003: 10 minutos)
+		const minimumIntervalMinutes = 10
+		hasIntervalConflict, err := repo.CheckMinimumIntervalConflict(
+			ctx,
+			tenantID,
+			unitID,
+			slot.ProfessionalID,
+			slot.StartTime,
+			slot.EndTime,
+			appointment.ID,
+			minimumIntervalMinutes,
+		)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar intervalo mínimo: %w", err)
+		}
+		if hasIntervalConflict {
+			return domain.ErrAppointmentMinimumInterval
+		}
+	}
+	return nil
 }
@@ -0,0 +1,72 @@
+package appointment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+)
+
+// reservarRecursos reserva no agendamento os recursos da unidade exigidos
+// pelos serviços (nil-safe: sem repositório de recursos não há reserva)
+func reservarRecursos(ctx context.Context, resources port.ResourceRepository, tenantID string, appointment *entity.Appointment) error {
+	if resources == nil {
+		return nil
+	}
+	serviceIDs := make([]string, 0, len(appointment.Services))
+	for _, svc := range appointment.Services {
+		serviceIDs = append(serviceIDs, svc.ServiceID)
+	}
+	requirements, err := resources.ListRequirementsForServices(ctx, tenantID, appointment.UnitID.String(), serviceIDs)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar recursos dos serviços: %w", err)
+	}
+	appointment.AllocateResources(requirements)
+	return nil
+}
+
+// verificarRecursos confere se os recursos reservados pelo agendamento
+// comportam mais um atendimento no horário, ignorando as reservas do próprio
+// agendamento. Recursos desativados deixam de limitar a agenda.
+func verificarRecursos(ctx context.Context, resources port.ResourceRepository, tenantID string, appointment *entity.Appointment) error {
+	if resources == nil {
+		return nil
+	}
+	windows := appointment.ResourceWindows()
+	capacity := make(map[string]int)
+	for _, w := range windows {
+		c, ok := capacity[w.ResourceID]
+		if !ok {
+			resource, err := resources.FindByID(ctx, tenantID, w.ResourceID)
+			if err != nil && !errors.Is(err, domain.ErrResourceNotFound) {
+				return fmt.Errorf("erro ao buscar recurso: %w", err)
+			}
+			if resource != nil && resource.Ativo {
+				c = resource.Capacity
+			}
+			capacity[w.ResourceID] = c
+		}
+		if c == 0 {
+			continue
+		}
+
+		peak, err := resources.PeakUsage(ctx, tenantID, w.ResourceID, w.StartTime, w.EndTime, appointment.ID)
+		if err != nil {
+			return fmt.Errorf("erro ao verificar recurso: %w", err)
+		}
+		// Serviços do mesmo agendamento que usam o recurso ao mesmo tempo
+		proprias := 0
+		for _, o := range windows {
+			if o.ResourceID == w.ResourceID && o.StartTime.Before(w.EndTime) && o.EndTime.After(w.StartTime) {
+				proprias++
+			}
+		}
+		if peak+proprias > c {
+			return domain.ErrResourceUnavailable
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package appointment
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// Motivos de liberação de horário
+const (
+	SlotReleasedCanceled    = "CANCELED"
+	SlotReleasedRescheduled = "RESCHEDULED"
+	SlotReleasedNoShow      = "NO_SHOW"
+)
+
+// ReleasedSlot horário de profissional que ficou livre
+type ReleasedSlot struct {
+	TenantID       string
+	UnitID         string
+	ProfessionalID string
+	StartTime      time.Time
+	EndTime        time.Time
+	Reason         string
+}
+
+// SlotReleaseListener é avisado quando um horário fica livre por
+// cancelamento, remarcação ou não comparecimento (ex.: lista de espera).
+// Erros ficam com o listener: a operação do agendamento já foi concluída.
+type SlotReleaseListener interface {
+	SlotReleased(ctx context.Context, slot ReleasedSlot)
+}
+
+// releaseSlot avisa o listener (nil-safe) dos horários liberados, um por
+// trecho de agenda de cada profissional do agendamento. Horários que já
+// terminaram são ignorados.
+func releaseSlot(ctx context.Context, listener SlotReleaseListener, a *entity.Appointment, reason string) {
+	if listener == nil {
+		return
+	}
+	for _, slot := range a.ProfessionalSlots() {
+		if !slot.EndTime.After(time.Now()) {
+			continue
+		}
+		listener.SlotReleased(ctx, ReleasedSlot{
+			TenantID:       a.TenantID.String(),
+			UnitID:         a.UnitID.String(),
+			ProfessionalID: slot.ProfessionalID,
+			StartTime:      slot.StartTime,
+			EndTime:        slot.EndTime,
+			Reason:         reason,
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package appointment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// ListStatusHistoryUseCase lista o histórico de status de um agendamento
+type ListStatusHistoryUseCase struct {
+	repo    port.AppointmentRepository
+	history port.AppointmentStatusHistoryRepository
+	logger  *zap.Logger
+}
+
+// NewListStatusHistoryUseCase cria nova instância do use case
+func NewListStatusHistoryUseCase(
+	repo port.AppointmentRepository,
+	history port.AppointmentStatusHistoryRepository,
+	logger *zap.Logger,
+) *ListStatusHistoryUseCase {
+	return &ListStatusHistoryUseCase{
+		repo:    repo,
+		history: history,
+		logger:  logger,
+	}
+}
+
+// Execute retorna as mudanças de status do agendamento da unidade, da mais
+// antiga para a mais recente
+func (uc *ListStatusHistoryUseCase) Execute(ctx context.Context, input GetAppointmentInput) ([]*entity.AppointmentStatusChange, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.ListStatusHistory")
+	defer span.End()
+
+	if input.TenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.AppointmentID == "" {
+		return nil, domain.ErrInvalidID
+	}
+
+	// Garante que o agendamento é do tenant e da unidade
+	if _, err := uc.repo.FindByID(ctx, input.TenantID, input.UnitID, input.AppointmentID); err != nil {
+		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
+	}
+
+	return uc.history.ListByAppointment(ctx, input.TenantID, input.AppointmentID)
+}
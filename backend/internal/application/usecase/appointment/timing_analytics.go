@@ -0,0 +1,177 @@
+package appointment
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+const (
+	// timingDefaultDays período padrão dos indicadores (até hoje)
+	timingDefaultDays = 30
+
+	// timingMaxDays maior período aceito
+	timingMaxDays = 366
+
+	// timingMaxTolerance maior tolerância de pontualidade aceita
+	timingMaxTolerance = 120 * time.Minute
+)
+
+// fusoAgenda fuso dos dias do período (a agenda é exibida em horário de
+// Brasília)
+var fusoAgenda = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// TimingAnalyticsInput período e recorte dos indicadores de tempo
+type TimingAnalyticsInput struct {
+	TenantID       string
+	UnitID         string    // opcional
+	ProfessionalID string    // opcional; responsável ou profissional de algum serviço
+	StartDate      time.Time // dia inicial; zero usa os últimos 30 dias
+	EndDate        time.Time // dia final (inclusive); zero usa hoje
+	Tolerance      *time.Duration
+}
+
+// TimingAnalyticsOutput indicadores do período por profissional, por unidade
+// e do total
+type TimingAnalyticsOutput struct {
+	StartDate     time.Time
+	EndDate       time.Time
+	Tolerance     time.Duration
+	Total         entity.AppointmentTimingStats
+	Units         []entity.AppointmentTimingStats
+	Professionals []entity.AppointmentTimingStats // somando as unidades; serviços em agendamentos de outro responsável contam só agendamentos e cancelamentos
+}
+
+// GetTimingAnalyticsUseCase calcula os indicadores de tempo da operação:
+// espera entre check-in e início, duração real x prevista, pontualidade e
+// antecedência dos cancelamentos
+type GetTimingAnalyticsUseCase struct {
+	history port.AppointmentStatusHistoryRepository
+	logger  *zap.Logger
+}
+
+// NewGetTimingAnalyticsUseCase cria nova instância do use case
+func NewGetTimingAnalyticsUseCase(history port.AppointmentStatusHistoryRepository, logger *zap.Logger) *GetTimingAnalyticsUseCase {
+	return &GetTimingAnalyticsUseCase{
+		history: history,
+		logger:  logger,
+	}
+}
+
+// Execute calcula os indicadores dos agendamentos com início no período
+func (uc *GetTimingAnalyticsUseCase) Execute(ctx context.Context, input TimingAnalyticsInput) (*TimingAnalyticsOutput, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.GetTimingAnalytics")
+	defer span.End()
+
+	if input.TenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	start, end := timingPeriod(input.StartDate, input.EndDate, time.Now())
+	if end.Before(start) {
+		return nil, domain.ErrAppointmentTimingPeriodInvalid
+	}
+	if end.Sub(start) >= timingMaxDays*24*time.Hour {
+		return nil, domain.ErrAppointmentTimingPeriodTooLong
+	}
+
+	tolerance := entity.DefaultPunctualityTolerance
+	if input.Tolerance != nil {
+		tolerance = *input.Tolerance
+	}
+	if tolerance < 0 || tolerance > timingMaxTolerance {
+		return nil, domain.ErrAppointmentTimingToleranceRange
+	}
+
+	rows, err := uc.history.TimingStats(ctx, input.TenantID, port.AppointmentTimingFilter{
+		UnitID:           input.UnitID,
+		ProfessionalID:   input.ProfessionalID,
+		StartFrom:        start,
+		StartTo:          end.AddDate(0, 0, 1),
+		Tolerance:        tolerance,
+		LateCancelWindow: entity.LateCancellationWindow,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &TimingAnalyticsOutput{
+		StartDate: start,
+		EndDate:   end,
+		Tolerance: tolerance,
+	}
+	// Filtrando um profissional, as linhas são só dele e todas entram nos totais
+	out.Units, out.Professionals = groupTimingStats(rows, input.ProfessionalID != "", &out.Total)
+	return out, nil
+}
+
+// timingPeriod normaliza o período para dias inteiros no fuso da agenda;
+// datas zeradas usam os últimos timingDefaultDays dias até hoje
+func timingPeriod(startDate, endDate, now time.Time) (time.Time, time.Time) {
+	day := func(t time.Time) time.Time {
+		t = t.In(fusoAgenda)
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, fusoAgenda)
+	}
+	// Datas vindas como YYYY-MM-DD (UTC) representam o dia, sem conversão
+	asDay := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, fusoAgenda)
+	}
+
+	end := day(now)
+	if !endDate.IsZero() {
+		end = asDay(endDate)
+	}
+	start := end.AddDate(0, 0, -(timingDefaultDays - 1))
+	if !startDate.IsZero() {
+		start = asDay(startDate)
+	}
+	return start, end
+}
+
+// groupTimingStats soma as linhas (unidade x profissional) por unidade, por
+// profissional e no total. O agendamento com serviços de vários profissionais
+// conta para cada um deles, mas só a linha do responsável entra na unidade e
+// no total, para não contá-lo mais de uma vez (exceto com todasNoTotal).
+func groupTimingStats(rows []entity.AppointmentTimingStats, todasNoTotal bool, total *entity.AppointmentTimingStats) ([]entity.AppointmentTimingStats, []entity.AppointmentTimingStats) {
+	units := make(map[string]*entity.AppointmentTimingStats)
+	professionals := make(map[string]*entity.AppointmentTimingStats)
+	for _, row := range rows {
+		if row.Responsible || todasNoTotal {
+			total.Add(row)
+
+			u, ok := units[row.UnitID]
+			if !ok {
+				u = &entity.AppointmentTimingStats{UnitID: row.UnitID, UnitName: row.UnitName}
+				units[row.UnitID] = u
+			}
+			u.Add(row)
+		}
+
+		p, ok := professionals[row.ProfessionalID]
+		if !ok {
+			p = &entity.AppointmentTimingStats{ProfessionalID: row.ProfessionalID, ProfessionalName: row.ProfessionalName}
+			professionals[row.ProfessionalID] = p
+		}
+		p.Add(row)
+	}
+
+	unitList := make([]entity.AppointmentTimingStats, 0, len(units))
+	for _, u := range units {
+		unitList = append(unitList, *u)
+	}
+	sort.Slice(unitList, func(i, j int) bool { return unitList[i].UnitName < unitList[j].UnitName })
+
+	profList := make([]entity.AppointmentTimingStats, 0, len(professionals))
+	for _, p := range professionals {
+		profList = append(profList, *p)
+	}
+	sort.Slice(profList, func(i, j int) bool { return profList[i].ProfessionalName < profList[j].ProfessionalName })
+
+	return unitList, profList
+}
@@ -0,0 +1,170 @@
+package appointment
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"go.uber.org/zap"
+)
+
+// fakeStatusHistory guarda as transições e devolve linhas fixas de indicadores
+type fakeStatusHistory struct {
+	changes []*entity.AppointmentStatusChange
+	rows    []entity.AppointmentTimingStats
+	filter  port.AppointmentTimingFilter
+}
+
+func (f *fakeStatusHistory) Append(ctx context.Context, change *entity.AppointmentStatusChange) error {
+	f.changes = append(f.changes, change)
+	return nil
+}
+
+func (f *fakeStatusHistory) ListByAppointment(ctx context.Context, tenantID, appointmentID string) ([]*entity.AppointmentStatusChange, error) {
+	return f.changes, nil
+}
+
+func (f *fakeStatusHistory) TimingStats(ctx context.Context, tenantID string, filter port.AppointmentTimingFilter) ([]entity.AppointmentTimingStats, error) {
+	f.filter = filter
+	return f.rows, nil
+}
+
+func TestCancelAppointmentUseCase_RecordsStatusHistory(t *testing.T) {
+	services := []entity.AppointmentService{
+		{ServiceID: "svc-1", ServiceName: "Corte", PriceAtBooking: valueobject.NewMoneyFromFloat(50.0), DurationAtBooking: 30},
+	}
+	a, _ := entity.NewAppointment(testTenantUUID, testUnitUUID, "prof-123", "cust-123", time.Now().Add(24*time.Hour), services)
+
+	repo := &MockAppointmentRepository{
+		FindByIDFn: func(ctx context.Context, tenantID, unitID, id string) (*entity.Appointment, error) {
+			return a, nil
+		},
+	}
+	history := &fakeStatusHistory{}
+	uc := NewCancelAppointmentUseCase(repo, nil, nil, history, nil, nil, zap.NewNop())
+
+	_, err := uc.Execute(context.Background(), CancelAppointmentInput{
+		TenantID:      testTenantUUID.String(),
+		UnitID:        testUnitUUID.String(),
+		AppointmentID: a.ID,
+		Reason:        "Cliente desmarcou",
+		ActorID:       "user-1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(history.changes) != 1 {
+		t.Fatalf("expected 1 history entry, got %d", len(history.changes))
+	}
+	c := history.changes[0]
+	if c.FromStatus != valueobject.AppointmentStatusCreated || c.ToStatus != valueobject.AppointmentStatusCanceled {
+		t.Errorf("unexpected transition %s -> %s", c.FromStatus, c.ToStatus)
+	}
+	if c.ActorID != "user-1" || c.Reason != "Cliente desmarcou" {
+		t.Errorf("unexpected actor/reason: %q %q", c.ActorID, c.Reason)
+	}
+}
+
+func TestGetTimingAnalyticsUseCase_Execute(t *testing.T) {
+	logger := zap.NewNop()
+	day := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+
+	t.Run("should group rows by unit and professional", func(t *testing.T) {
+		history := &fakeStatusHistory{rows: []entity.AppointmentTimingStats{
+			{UnitID: "u1", UnitName: "Centro", ProfessionalID: "p1", ProfessionalName: "Bruno", Responsible: true, Appointments: 4, WaitCount: 2, WaitSeconds: 600},
+			{UnitID: "u2", UnitName: "Alphaville", ProfessionalID: "p1", ProfessionalName: "Bruno", Responsible: true, Appointments: 2, WaitCount: 1, WaitSeconds: 1200},
+			{UnitID: "u1", UnitName: "Centro", ProfessionalID: "p2", ProfessionalName: "Ana", Responsible: true, Appointments: 1, CancelCount: 1, LateCancellations: 1},
+		}}
+		uc := NewGetTimingAnalyticsUseCase(history, logger)
+
+		out, err := uc.Execute(context.Background(), TimingAnalyticsInput{
+			TenantID:  testTenantUUID.String(),
+			StartDate: day("2026-03-01"),
+			EndDate:   day("2026-03-31"),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if out.Total.Appointments != 7 || out.Total.AvgWaitMinutes() != 10 {
+			t.Errorf("unexpected total: %+v", out.Total)
+		}
+		if len(out.Units) != 2 || out.Units[0].UnitName != "Alphaville" {
+			t.Errorf("expected units sorted by name, got %+v", out.Units)
+		}
+		if len(out.Professionals) != 2 || out.Professionals[1].ProfessionalName != "Bruno" || out.Professionals[1].Appointments != 6 {
+			t.Errorf("expected professionals summed across units, got %+v", out.Professionals)
+		}
+		if out.Professionals[0].LateCancellationRate() != 100 {
+			t.Errorf("expected late cancellation rate 100, got %v", out.Professionals[0].LateCancellationRate())
+		}
+
+		// Dia final é inclusive: a consulta vai até o início do dia seguinte
+		if got := history.filter.StartTo.Sub(history.filter.StartFrom); got != 31*24*time.Hour {
+			t.Errorf("expected 31 days window, got %v", got)
+		}
+		if history.filter.Tolerance != entity.DefaultPunctualityTolerance {
+			t.Errorf("expected default tolerance, got %v", history.filter.Tolerance)
+		}
+	})
+
+	t.Run("should count appointments for every professional of its services", func(t *testing.T) {
+		// Corte com Bruno (responsável) e barba com Ana no mesmo agendamento,
+		// cancelado duas horas antes da barba: os tempos ficam com o Bruno
+		history := &fakeStatusHistory{rows: []entity.AppointmentTimingStats{
+			{UnitID: "u1", UnitName: "Centro", ProfessionalID: "p1", ProfessionalName: "Bruno", Responsible: true, Appointments: 3, WaitCount: 3, WaitSeconds: 900},
+			{UnitID: "u1", UnitName: "Centro", ProfessionalID: "p2", ProfessionalName: "Ana", Appointments: 1, CancelCount: 1, CancelLeadSeconds: 7200},
+		}}
+		uc := NewGetTimingAnalyticsUseCase(history, logger)
+
+		out, err := uc.Execute(context.Background(), TimingAnalyticsInput{TenantID: testTenantUUID.String()})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Total.Appointments != 3 || len(out.Units) != 1 || out.Units[0].Appointments != 3 {
+			t.Errorf("expected appointments counted once in unit and total, got %+v / %+v", out.Total, out.Units)
+		}
+		if len(out.Professionals) != 2 || out.Professionals[0].ProfessionalName != "Ana" || out.Professionals[0].Appointments != 1 {
+			t.Errorf("expected appointment counted for the service professional, got %+v", out.Professionals)
+		}
+
+		// Filtrando a Ana, o agendamento em que ela só fez a barba entra no total
+		history.rows = history.rows[1:]
+		out, err = uc.Execute(context.Background(), TimingAnalyticsInput{TenantID: testTenantUUID.String(), ProfessionalID: "p2"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if out.Total.Appointments != 1 || out.Total.AvgCancelLeadHours() != 2 || out.Total.WaitCount != 0 {
+			t.Errorf("expected filtered professional rows in total, got %+v", out.Total)
+		}
+	})
+
+	t.Run("should reject invalid period and tolerance", func(t *testing.T) {
+		uc := NewGetTimingAnalyticsUseCase(&fakeStatusHistory{}, logger)
+		tooLate := 3 * time.Hour
+
+		cases := []struct {
+			input TimingAnalyticsInput
+			want  error
+		}{
+			{TimingAnalyticsInput{TenantID: "t", StartDate: day("2026-03-10"), EndDate: day("2026-03-01")}, domain.ErrAppointmentTimingPeriodInvalid},
+			{TimingAnalyticsInput{TenantID: "t", StartDate: day("2025-01-01"), EndDate: day("2026-03-01")}, domain.ErrAppointmentTimingPeriodTooLong},
+			{TimingAnalyticsInput{TenantID: "t", Tolerance: &tooLate}, domain.ErrAppointmentTimingToleranceRange},
+			{TimingAnalyticsInput{}, domain.ErrTenantIDRequired},
+		}
+		for _, tc := range cases {
+			if _, err := uc.Execute(context.Background(), tc.input); !errors.Is(err, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, err)
+			}
+		}
+	})
+}
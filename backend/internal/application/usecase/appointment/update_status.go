@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -19,12 +20,17 @@ type UpdateAppointmentStatusInput struct {
 	AppointmentID string
 	NewStatus     valueobject.AppointmentStatus
 	Reason        string // Para cancelamento ou no-show
+	ActorID       string // Usuário que fez a mudança (vazio para integrações)
 }
 
 // UpdateAppointmentStatusUseCase implementa a atualização de status
 type UpdateAppointmentStatusUseCase struct {
 	repo        port.AppointmentRepository
 	commandRepo port.CommandRepository // Adicionado para validar comanda fechada
+	events      port.EventPublisher
+	history     port.AppointmentStatusHistoryRepository
+	slots       SlotReleaseListener
+	attendance  AttendanceListener
 	logger      *zap.Logger
 }
 
@@ -32,17 +38,28 @@ type UpdateAppointmentStatusUseCase struct {
 func NewUpdateAppointmentStatusUseCase(
 	repo port.AppointmentRepository,
 	commandRepo port.CommandRepository,
+	events port.EventPublisher,
+	history port.AppointmentStatusHistoryRepository,
+	slots SlotReleaseListener,
+	attendance AttendanceListener,
 	logger *zap.Logger,
 ) *UpdateAppointmentStatusUseCase {
 	return &UpdateAppointmentStatusUseCase{
 		repo:        repo,
 		commandRepo: commandRepo,
+		events:      events,
+		history:     history,
+		slots:       slots,
+		attendance:  attendance,
 		logger:      logger,
 	}
 }
 
 // Execute atualiza o status de um agendamento
 func (uc *UpdateAppointmentStatusUseCase) Execute(ctx context.Context, input UpdateAppointmentStatusInput) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.UpdateAppointmentStatus")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -59,6 +76,8 @@ func (uc *UpdateAppointmentStatusUseCase) Execute(ctx context.Context, input Upd
 		return nil, fmt.Errorf("erro ao buscar agendamento: %w", err)
 	}
 
+	statusAnterior := appointment.Status
+
 	// Aplicar transição de status
 	switch input.NewStatus {
 	case valueobject.AppointmentStatusConfirmed:
@@ -124,12 +143,24 @@ func (uc *UpdateAppointmentStatusUseCase) Execute(ctx context.Context, input Upd
 		return nil, fmt.Errorf("erro ao salvar agendamento: %w", err)
 	}
 
-	uc.logger.Info("Status do agendamento atualizado",
+	common.Logger(ctx, uc.logger).Info("Status do agendamento atualizado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("appointment_id", input.AppointmentID),
 		zap.String("new_status", input.NewStatus.String()),
 	)
 
+	common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, input.ActorID, input.Reason)
+	common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
+
+	switch input.NewStatus {
+	case valueobject.AppointmentStatusCanceled:
+		releaseSlot(ctx, uc.slots, appointment, SlotReleasedCanceled)
+	case valueobject.AppointmentStatusNoShow:
+		// Só o restante do horário pode ser aproveitado
+		releaseSlot(ctx, uc.slots, appointment, SlotReleasedNoShow)
+	}
+	notifyAttendance(ctx, uc.attendance, appointment)
+
 	return appointment, nil
 }
 
@@ -159,6 +190,9 @@ func NewGetAppointmentUseCase(
 
 // Execute busca um agendamento por ID
 func (uc *GetAppointmentUseCase) Execute(ctx context.Context, input GetAppointmentInput) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "appointment.GetAppointment")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
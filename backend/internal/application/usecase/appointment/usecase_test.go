@@ -33,7 +33,7 @@ func TestCancelAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCancelAppointmentUseCase(mockRepo, logger)
+		uc := NewCancelAppointmentUseCase(mockRepo, nil, nil, nil, nil, nil, logger)
 
 		input := CancelAppointmentInput{
 			TenantID:      testTenantUUID.String(),
@@ -57,7 +57,7 @@ func TestCancelAppointmentUseCase_Execute(t *testing.T) {
 
 	t.Run("should fail without tenant_id", func(t *testing.T) {
 		mockRepo := &MockAppointmentRepository{}
-		uc := NewCancelAppointmentUseCase(mockRepo, logger)
+		uc := NewCancelAppointmentUseCase(mockRepo, nil, nil, nil, nil, nil, logger)
 
 		input := CancelAppointmentInput{
 			TenantID:      "",
@@ -77,7 +77,7 @@ func TestCancelAppointmentUseCase_Execute(t *testing.T) {
 
 	t.Run("should fail without appointment_id", func(t *testing.T) {
 		mockRepo := &MockAppointmentRepository{}
-		uc := NewCancelAppointmentUseCase(mockRepo, logger)
+		uc := NewCancelAppointmentUseCase(mockRepo, nil, nil, nil, nil, nil, logger)
 
 		input := CancelAppointmentInput{
 			TenantID:      testTenantUUID.String(),
@@ -102,7 +102,7 @@ func TestCancelAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCancelAppointmentUseCase(mockRepo, logger)
+		uc := NewCancelAppointmentUseCase(mockRepo, nil, nil, nil, nil, nil, logger)
 
 		input := CancelAppointmentInput{
 			TenantID:      testTenantUUID.String(),
@@ -132,7 +132,7 @@ func TestCancelAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewCancelAppointmentUseCase(mockRepo, logger)
+		uc := NewCancelAppointmentUseCase(mockRepo, nil, nil, nil, nil, nil, logger)
 
 		input := CancelAppointmentInput{
 			TenantID:      testTenantUUID.String(),
@@ -167,7 +167,7 @@ func TestRescheduleAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, logger)
+		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, nil, nil, nil, nil, logger)
 
 		newTime := time.Now().Add(48 * time.Hour)
 		input := RescheduleAppointmentInput{
@@ -205,7 +205,7 @@ func TestRescheduleAppointmentUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, logger)
+		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, nil, nil, nil, nil, logger)
 
 		input := RescheduleAppointmentInput{
 			TenantID:      testTenantUUID.String(),
@@ -226,7 +226,7 @@ func TestRescheduleAppointmentUseCase_Execute(t *testing.T) {
 
 	t.Run("should fail without tenant_id", func(t *testing.T) {
 		mockRepo := &MockAppointmentRepository{}
-		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, logger)
+		uc := NewRescheduleAppointmentUseCase(mockRepo, &MockProfessionalReader{}, nil, nil, nil, nil, logger)
 
 		input := RescheduleAppointmentInput{
 			TenantID:      "",
@@ -262,7 +262,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
@@ -294,7 +294,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
@@ -327,7 +327,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
@@ -374,7 +374,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, localMockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, localMockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
@@ -406,7 +406,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
@@ -435,7 +435,7 @@ func TestUpdateAppointmentStatusUseCase_Execute(t *testing.T) {
 			},
 		}
 
-		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, logger)
+		uc := NewUpdateAppointmentStatusUseCase(mockRepo, mockCommandRepo, nil, nil, nil, nil, logger)
 
 		input := UpdateAppointmentStatusInput{
 			TenantID:      testTenantUUID.String(),
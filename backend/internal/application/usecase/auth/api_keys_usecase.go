@@ -0,0 +1,300 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// CHAVES DE API
+// Credenciais de integrações (BI, bots de WhatsApp) vinculadas ao tenant, e
+// opcionalmente a uma unidade, com escopos em vez de papel. Só o hash da
+// chave é gravado; a chave aparece apenas na resposta da criação.
+// =============================================================================
+
+// APIKeyScopeCatalog monta o catálogo de escopos exibido ao dono
+func APIKeyScopeCatalog() []dto.APIKeyScopeResponse {
+	out := make([]dto.APIKeyScopeResponse, len(valueobject.APIKeyScopeCatalog))
+	for i, s := range valueobject.APIKeyScopeCatalog {
+		out[i] = dto.APIKeyScopeResponse{Code: s.Code.String(), Descricao: s.Descricao}
+	}
+	return out
+}
+
+// validarEscopos confere os escopos contra o catálogo, remove duplicados e ordena
+func validarEscopos(codes []string) ([]string, error) {
+	vistos := map[string]bool{}
+	out := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = strings.TrimSpace(c)
+		if !valueobject.APIKeyScope(c).IsValid() {
+			return nil, domain.ErrChaveAPIEscopoInvalido
+		}
+		if !vistos[c] {
+			vistos[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func formatTimestamptz(t pgtype.Timestamptz) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.Time.Format(time.RFC3339)
+	return &s
+}
+
+func formatUUID(u pgtype.UUID) *string {
+	if !u.Valid {
+		return nil
+	}
+	s := u.String()
+	return &s
+}
+
+func toAPIKeyResponse(k db.ApiKey) dto.APIKeyResponse {
+	escopos := k.Escopos
+	if escopos == nil {
+		escopos = []string{}
+	}
+	return dto.APIKeyResponse{
+		ID:          k.ID.String(),
+		Nome:        k.Nome,
+		Prefixo:     k.Prefixo,
+		Escopos:     escopos,
+		UnitID:      formatUUID(k.UnitID),
+		CriadoPor:   formatUUID(k.CriadoPor),
+		ExpiraEm:    formatTimestamptz(k.ExpiraEm),
+		UltimoUsoEm: formatTimestamptz(k.UltimoUsoEm),
+		UltimoUsoIP: k.UltimoUsoIp,
+		RevogadaEm:  formatTimestamptz(k.RevogadaEm),
+		Ativa:       !k.RevogadaEm.Valid && (!k.ExpiraEm.Valid || k.ExpiraEm.Time.After(time.Now())),
+		CriadoEm:    k.CriadoEm.Time.Format(time.RFC3339),
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Listar
+// -----------------------------------------------------------------------------
+
+type ListAPIKeysUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewListAPIKeysUseCase(queries *db.Queries, logger *zap.Logger) *ListAPIKeysUseCase {
+	return &ListAPIKeysUseCase{queries: queries, logger: logger}
+}
+
+// Execute lista as chaves do tenant, inclusive revogadas e expiradas
+func (uc *ListAPIKeysUseCase) Execute(ctx context.Context, tenantID string) ([]dto.APIKeyResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.ListAPIKeys")
+	defer span.End()
+
+	var tenantUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+
+	rows, err := uc.queries.ListAPIKeys(ctx, tenantUUID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar chaves de API: %w", err)
+	}
+
+	out := make([]dto.APIKeyResponse, len(rows))
+	for i, k := range rows {
+		out[i] = toAPIKeyResponse(k)
+	}
+	return out, nil
+}
+
+// -----------------------------------------------------------------------------
+// Criar
+// -----------------------------------------------------------------------------
+
+type CreateAPIKeyUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewCreateAPIKeyUseCase(queries *db.Queries, logger *zap.Logger) *CreateAPIKeyUseCase {
+	return &CreateAPIKeyUseCase{queries: queries, logger: logger}
+}
+
+// Execute cria a chave e devolve o valor em claro uma única vez
+func (uc *CreateAPIKeyUseCase) Execute(ctx context.Context, tenantID, userID string, req dto.CreateAPIKeyRequest) (*dto.CreateAPIKeyResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.CreateAPIKey")
+	defer span.End()
+
+	params := db.CreateAPIKeyParams{Nome: strings.TrimSpace(req.Nome)}
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	if err := params.CriadoPor.Scan(userID); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+
+	escopos, err := validarEscopos(req.Escopos)
+	if err != nil {
+		return nil, err
+	}
+	params.Escopos = escopos
+
+	if req.UnitID != nil && *req.UnitID != "" {
+		if err := params.UnitID.Scan(*req.UnitID); err != nil {
+			return nil, domain.ErrInvalidUnitID
+		}
+		if _, err := uc.queries.GetUnitByID(ctx, db.GetUnitByIDParams{ID: params.UnitID, TenantID: params.TenantID}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, entity.ErrUnitNaoEncontrada
+			}
+			return nil, fmt.Errorf("erro ao buscar unidade: %w", err)
+		}
+	}
+
+	if req.ExpiraEm != nil && *req.ExpiraEm != "" {
+		expiraEm, err := time.Parse(time.RFC3339, *req.ExpiraEm)
+		if err != nil || !expiraEm.After(time.Now()) {
+			return nil, domain.ErrChaveAPIExpiracao
+		}
+		params.ExpiraEm = pgtype.Timestamptz{Time: expiraEm, Valid: true}
+	}
+
+	chave, prefixo, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+	params.Prefixo = prefixo
+	params.KeyHash = auth.HashAPIKey(chave)
+
+	key, err := uc.queries.CreateAPIKey(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar chave de API: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Chave de API criada",
+		zap.String("tenant_id", tenantID),
+		zap.String("api_key_id", key.ID.String()),
+		zap.String("prefixo", prefixo),
+		zap.Strings("escopos", escopos),
+		zap.String("criado_por", userID),
+	)
+
+	return &dto.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Chave:          chave,
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Revogar
+// -----------------------------------------------------------------------------
+
+type RevokeAPIKeyUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewRevokeAPIKeyUseCase(queries *db.Queries, logger *zap.Logger) *RevokeAPIKeyUseCase {
+	return &RevokeAPIKeyUseCase{queries: queries, logger: logger}
+}
+
+// Execute revoga a chave; requisições seguintes com ela recebem 401
+func (uc *RevokeAPIKeyUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "auth.RevokeAPIKey")
+	defer span.End()
+
+	var params db.RevokeAPIKeyParams
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return domain.ErrInvalidTenantID
+	}
+	if err := params.ID.Scan(id); err != nil {
+		return domain.ErrChaveAPINaoEncontrada
+	}
+
+	n, err := uc.queries.RevokeAPIKey(ctx, params)
+	if err != nil {
+		return fmt.Errorf("erro ao revogar chave de API: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrChaveAPINaoEncontrada
+	}
+
+	common.Logger(ctx, uc.logger).Info("Chave de API revogada",
+		zap.String("tenant_id", tenantID),
+		zap.String("api_key_id", id),
+	)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Autenticar (middleware)
+// -----------------------------------------------------------------------------
+
+type AuthenticateAPIKeyUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewAuthenticateAPIKeyUseCase(queries *db.Queries, logger *zap.Logger) *AuthenticateAPIKeyUseCase {
+	return &AuthenticateAPIKeyUseCase{queries: queries, logger: logger}
+}
+
+// Execute valida a chave e registra o último uso (no máximo uma escrita por
+// minuto por chave). Falha ao registrar o uso não impede a requisição.
+func (uc *AuthenticateAPIKeyUseCase) Execute(ctx context.Context, chave, ip string) (*dto.APIKeyPrincipal, error) {
+	ctx, span := common.StartSpan(ctx, "auth.AuthenticateAPIKey")
+	defer span.End()
+
+	if !auth.IsAPIKey(chave) {
+		return nil, domain.ErrChaveAPIInvalida
+	}
+
+	key, err := uc.queries.GetActiveAPIKeyByHash(ctx, auth.HashAPIKey(chave))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrChaveAPIInvalida
+		}
+		return nil, fmt.Errorf("erro ao buscar chave de API: %w", err)
+	}
+
+	var ipPtr *string
+	if ip != "" {
+		ipPtr = &ip
+	}
+	if err := uc.queries.TouchAPIKeyLastUsed(ctx, db.TouchAPIKeyLastUsedParams{ID: key.ID, UltimoUsoIp: ipPtr}); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao registrar uso da chave de API",
+			zap.String("api_key_id", key.ID.String()),
+			zap.Error(err),
+		)
+	}
+
+	principal := &dto.APIKeyPrincipal{
+		KeyID:    key.ID.String(),
+		TenantID: key.TenantID.String(),
+		Nome:     key.Nome,
+		Escopos:  key.Escopos,
+	}
+	if key.UnitID.Valid {
+		principal.UnitID = key.UnitID.String()
+	}
+	return principal, nil
+}
@@ -0,0 +1,411 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// CONVITES DE COLABORADORES
+// Dono/gerente convida por email; o convidado define a senha pelo link (7 dias,
+// uso único) e passa a ter acesso à unidade com o papel do convite.
+// =============================================================================
+
+const (
+	validadeConvite = 7 * 24 * time.Hour
+	caminhoConvite  = "/aceitar-convite"
+	roleOwner       = "OWNER"
+	roleManager     = "MANAGER"
+)
+
+// Status do convite calculados na listagem
+const (
+	StatusConvitePendente = "PENDENTE"
+	StatusConviteAceito   = "ACEITO"
+	StatusConviteRevogado = "REVOGADO"
+	StatusConviteExpirado = "EXPIRADO"
+)
+
+// rolesConvidaveis são os papéis que podem ser atribuídos por convite
+var rolesConvidaveis = map[string]bool{
+	"MANAGER":      true,
+	"RECEPTIONIST": true,
+	"BARBER":       true,
+}
+
+// podeConvidar verifica se quem convida pode atribuir o papel: gerentes
+// convidam recepcionistas e barbeiros; apenas o dono convida gerentes
+func podeConvidar(roleConvidante, roleConvidado string) error {
+	if !rolesConvidaveis[roleConvidado] {
+		return domain.ErrConviteRoleInvalida
+	}
+	if roleConvidado == roleManager && roleConvidante != roleOwner {
+		return domain.ErrConviteSemPermissao
+	}
+	return nil
+}
+
+// statusConvite deriva o status do convite no instante now
+func statusConvite(inv db.UserInvitation, now time.Time) string {
+	switch {
+	case inv.AcceptedAt.Valid:
+		return StatusConviteAceito
+	case inv.RevokedAt.Valid:
+		return StatusConviteRevogado
+	case !inv.ExpiresAt.Time.After(now):
+		return StatusConviteExpirado
+	default:
+		return StatusConvitePendente
+	}
+}
+
+func toInvitationResponse(inv db.UserInvitation, now time.Time) dto.InvitationResponse {
+	resp := dto.InvitationResponse{
+		ID:        inv.ID.String(),
+		UnitID:    inv.UnitID.String(),
+		Email:     inv.Email,
+		Nome:      inv.Nome,
+		Role:      inv.Role,
+		Status:    statusConvite(inv, now),
+		CreatedAt: inv.CreatedAt.Time.Format(time.RFC3339),
+		ExpiresAt: inv.ExpiresAt.Time.Format(time.RFC3339),
+	}
+	if inv.InvitedBy.Valid {
+		s := inv.InvitedBy.String()
+		resp.InvitedBy = &s
+	}
+	if inv.AcceptedAt.Valid {
+		s := inv.AcceptedAt.Time.Format(time.RFC3339)
+		resp.AcceptedAt = &s
+	}
+	return resp
+}
+
+// CreateInvitationInput define o convite e quem convida
+type CreateInvitationInput struct {
+	TenantID       string
+	InvitedBy      string
+	RoleConvidante string
+	Email          string
+	Nome           string
+	Role           string
+	UnitID         string
+}
+
+type CreateInvitationUseCase struct {
+	queries *db.Queries
+	mailer  port.MailSender
+	appURL  string
+	logger  *zap.Logger
+}
+
+func NewCreateInvitationUseCase(queries *db.Queries, mailer port.MailSender, appURL string, logger *zap.Logger) *CreateInvitationUseCase {
+	return &CreateInvitationUseCase{
+		queries: queries,
+		mailer:  mailer,
+		appURL:  appURL,
+		logger:  logger,
+	}
+}
+
+// Execute cria o convite e envia o email. Um convite pendente para o mesmo
+// email é substituído (o link anterior deixa de valer).
+func (uc *CreateInvitationUseCase) Execute(ctx context.Context, input CreateInvitationInput) (*dto.InvitationResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.CreateInvitation")
+	defer span.End()
+
+	if err := podeConvidar(input.RoleConvidante, input.Role); err != nil {
+		return nil, err
+	}
+
+	var tenantID, unitID, invitedBy pgtype.UUID
+	if err := tenantID.Scan(input.TenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	if err := unitID.Scan(input.UnitID); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+	_ = invitedBy.Scan(input.InvitedBy)
+
+	unit, err := uc.queries.GetUnitByID(ctx, db.GetUnitByIDParams{ID: unitID, TenantID: tenantID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, entity.ErrUnitNaoEncontrada
+		}
+		return nil, fmt.Errorf("erro ao buscar unidade: %w", err)
+	}
+
+	// O login identifica a conta só pelo email: não pode haver duplicidade
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if _, err := uc.queries.GetUserByEmail(ctx, email); err == nil {
+		return nil, domain.ErrConviteEmailExistente
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.queries.RevokePendingInvitationsByEmail(ctx, db.RevokePendingInvitationsByEmailParams{
+		TenantID: tenantID,
+		Email:    email,
+	}); err != nil {
+		return nil, fmt.Errorf("erro ao substituir convite anterior: %w", err)
+	}
+	inv, err := uc.queries.CreateUserInvitation(ctx, db.CreateUserInvitationParams{
+		TenantID:  tenantID,
+		UnitID:    unitID,
+		Email:     email,
+		Nome:      strings.TrimSpace(input.Nome),
+		Role:      input.Role,
+		TokenHash: auth.HashRefreshToken(token),
+		InvitedBy: invitedBy,
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(validadeConvite), Valid: true},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar convite: %w", err)
+	}
+
+	msg := port.EmailMessage{
+		To:      inv.Email,
+		ToName:  inv.Nome,
+		Subject: fmt.Sprintf("Convite para a equipe %s", unit.Nome),
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nVocê foi convidado(a) para fazer parte da equipe da unidade %s. Acesse o link abaixo para definir a sua senha e ativar o acesso:\n\n%s\n\nO convite vale por 7 dias e só pode ser usado uma vez.\n",
+			inv.Nome, unit.Nome, montarLink(uc.appURL, caminhoConvite, token),
+		),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		// O convite fica registrado; pode ser reenviado criando-o novamente
+		common.Logger(ctx, uc.logger).Error("Erro ao enviar email de convite",
+			zap.String("invitation_id", inv.ID.String()),
+			zap.Error(err),
+		)
+		return nil, fmt.Errorf("erro ao enviar email de convite: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Convite de colaborador enviado",
+		zap.String("invitation_id", inv.ID.String()),
+		zap.String("tenant_id", input.TenantID),
+		zap.String("unit_id", input.UnitID),
+		zap.String("role", inv.Role),
+		zap.String("invited_by", input.InvitedBy),
+	)
+	resp := toInvitationResponse(inv, time.Now())
+	return &resp, nil
+}
+
+type ListInvitationsUseCase struct {
+	queries *db.Queries
+}
+
+func NewListInvitationsUseCase(queries *db.Queries) *ListInvitationsUseCase {
+	return &ListInvitationsUseCase{queries: queries}
+}
+
+// Execute lista os convites do tenant (mais recentes primeiro)
+func (uc *ListInvitationsUseCase) Execute(ctx context.Context, tenantID string) ([]dto.InvitationResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.ListInvitations")
+	defer span.End()
+
+	var tid pgtype.UUID
+	if err := tid.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+
+	rows, err := uc.queries.ListUserInvitations(ctx, tid)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar convites: %w", err)
+	}
+
+	now := time.Now()
+	convites := make([]dto.InvitationResponse, len(rows))
+	for i, inv := range rows {
+		convites[i] = toInvitationResponse(inv, now)
+	}
+	return convites, nil
+}
+
+type RevokeInvitationUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewRevokeInvitationUseCase(queries *db.Queries, logger *zap.Logger) *RevokeInvitationUseCase {
+	return &RevokeInvitationUseCase{
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute cancela um convite pendente
+func (uc *RevokeInvitationUseCase) Execute(ctx context.Context, tenantID, invitationID string) error {
+	ctx, span := common.StartSpan(ctx, "auth.RevokeInvitation")
+	defer span.End()
+
+	var params db.RevokeUserInvitationParams
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return domain.ErrInvalidTenantID
+	}
+	if err := params.ID.Scan(invitationID); err != nil {
+		return domain.ErrConviteNaoEncontrado
+	}
+
+	total, err := uc.queries.RevokeUserInvitation(ctx, params)
+	if err != nil {
+		return fmt.Errorf("erro ao revogar convite: %w", err)
+	}
+	if total == 0 {
+		return domain.ErrConviteNaoEncontrado
+	}
+
+	common.Logger(ctx, uc.logger).Info("Convite revogado",
+		zap.String("invitation_id", invitationID),
+		zap.String("tenant_id", tenantID),
+	)
+	return nil
+}
+
+type PreviewInvitationUseCase struct {
+	queries *db.Queries
+}
+
+func NewPreviewInvitationUseCase(queries *db.Queries) *PreviewInvitationUseCase {
+	return &PreviewInvitationUseCase{queries: queries}
+}
+
+// Execute retorna os dados do convite para a tela de aceite
+func (uc *PreviewInvitationUseCase) Execute(ctx context.Context, token string) (*dto.InvitationPreviewResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.PreviewInvitation")
+	defer span.End()
+
+	inv, err := uc.queries.GetPendingInvitationByHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrConviteInvalido
+		}
+		return nil, fmt.Errorf("erro ao buscar convite: %w", err)
+	}
+
+	return &dto.InvitationPreviewResponse{
+		Email:      inv.Email,
+		Nome:       inv.Nome,
+		Role:       inv.Role,
+		TenantNome: inv.TenantNome,
+		UnitNome:   inv.UnitNome,
+		ExpiresAt:  inv.ExpiresAt.Time.Format(time.RFC3339),
+	}, nil
+}
+
+type AcceptInvitationUseCase struct {
+	pool    *pgxpool.Pool
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewAcceptInvitationUseCase(pool *pgxpool.Pool, queries *db.Queries, logger *zap.Logger) *AcceptInvitationUseCase {
+	return &AcceptInvitationUseCase{
+		pool:    pool,
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute cria o usuário com a senha escolhida, vincula à unidade do convite e
+// marca o convite como aceito, tudo na mesma transação
+func (uc *AcceptInvitationUseCase) Execute(ctx context.Context, token, password string) error {
+	ctx, span := common.StartSpan(ctx, "auth.AcceptInvitation")
+	defer span.End()
+
+	inv, err := uc.queries.GetPendingInvitationByHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrConviteInvalido
+		}
+		return fmt.Errorf("erro ao buscar convite: %w", err)
+	}
+
+	if _, err := uc.queries.GetUserByEmail(ctx, inv.Email); err == nil {
+		return domain.ErrConviteEmailExistente
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("erro ao gerar hash da senha: %w", err)
+	}
+
+	tx, err := uc.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := uc.queries.WithTx(tx)
+
+	ativo := true
+	user, err := qtx.CreateUser(ctx, db.CreateUserParams{
+		TenantID:     inv.TenantID,
+		Nome:         inv.Nome,
+		Email:        inv.Email,
+		PasswordHash: passwordHash,
+		Role:         inv.Role,
+		Ativo:        &ativo,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao criar usuário: %w", err)
+	}
+
+	role := inv.Role
+	if _, err := qtx.CreateUserUnit(ctx, db.CreateUserUnitParams{
+		UserID:       user.ID,
+		UnitID:       inv.UnitID,
+		IsDefault:    true,
+		RoleOverride: &role,
+	}); err != nil {
+		return fmt.Errorf("erro ao vincular usuário à unidade: %w", err)
+	}
+
+	// Protege contra dois aceites simultâneos do mesmo link
+	aceitos, err := qtx.AcceptUserInvitation(ctx, db.AcceptUserInvitationParams{
+		ID:             inv.ID,
+		AcceptedUserID: user.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao aceitar convite: %w", err)
+	}
+	if aceitos == 0 {
+		return domain.ErrConviteInvalido
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Convite aceito",
+		zap.String("invitation_id", inv.ID.String()),
+		zap.String("user_id", user.ID.String()),
+		zap.String("tenant_id", inv.TenantID.String()),
+		zap.String("unit_id", inv.UnitID.String()),
+		zap.String("role", inv.Role),
+	)
+	return nil
+}
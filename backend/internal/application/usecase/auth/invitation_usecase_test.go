@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodeConvidar(t *testing.T) {
+	assert.NoError(t, podeConvidar("OWNER", "MANAGER"))
+	assert.NoError(t, podeConvidar("MANAGER", "BARBER"))
+	assert.NoError(t, podeConvidar("MANAGER", "RECEPTIONIST"))
+	assert.ErrorIs(t, podeConvidar("MANAGER", "MANAGER"), domain.ErrConviteSemPermissao)
+	assert.ErrorIs(t, podeConvidar("OWNER", "OWNER"), domain.ErrConviteRoleInvalida)
+}
+
+func TestStatusConvite(t *testing.T) {
+	now := time.Now()
+	ts := func(t time.Time) pgtype.Timestamptz { return pgtype.Timestamptz{Time: t, Valid: true} }
+
+	pendente := db.UserInvitation{ExpiresAt: ts(now.Add(time.Hour))}
+	assert.Equal(t, StatusConvitePendente, statusConvite(pendente, now))
+
+	expirado := db.UserInvitation{ExpiresAt: ts(now.Add(-time.Minute))}
+	assert.Equal(t, StatusConviteExpirado, statusConvite(expirado, now))
+
+	// Aceite e revogação prevalecem sobre a expiração
+	aceito := db.UserInvitation{ExpiresAt: ts(now.Add(-time.Minute)), AcceptedAt: ts(now.Add(-time.Hour))}
+	assert.Equal(t, StatusConviteAceito, statusConvite(aceito, now))
+
+	revogado := db.UserInvitation{ExpiresAt: ts(now.Add(time.Hour)), RevokedAt: ts(now)}
+	assert.Equal(t, StatusConviteRevogado, statusConvite(revogado, now))
+}
+
+func TestMontarLink(t *testing.T) {
+	assert.Equal(t,
+		"https://app.exemplo.com/aceitar-convite?token=abc-_123",
+		montarLink("https://app.exemplo.com/", caminhoConvite, "abc-_123"),
+	)
+}
@@ -0,0 +1,158 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// BLOQUEIO PROGRESSIVO DE LOGIN
+// Falhas são contadas por conta (email) e por IP. Ao atingir o limite o login
+// fica bloqueado por 1 minuto, dobrando a cada nova falha até 30 minutos.
+// =============================================================================
+
+const (
+	limiteFalhasConta = 5  // falhas por email antes do primeiro bloqueio
+	limiteFalhasIP    = 20 // falhas por IP (várias contas) antes do primeiro bloqueio
+	bloqueioInicial   = time.Minute
+	bloqueioMaximo    = 30 * time.Minute
+	janelaFalhas      = time.Hour // falhas mais antigas que isso são esquecidas
+)
+
+// BloqueioLoginError informa até quando o login está bloqueado
+type BloqueioLoginError struct {
+	Ate time.Time
+}
+
+func (e *BloqueioLoginError) Error() string { return domain.ErrLoginBloqueado.Error() }
+
+func (e *BloqueioLoginError) Unwrap() error { return domain.ErrLoginBloqueado }
+
+// RetryAfter retorna quanto falta para o desbloqueio (mínimo 1s)
+func (e *BloqueioLoginError) RetryAfter() time.Duration {
+	d := time.Until(e.Ate).Round(time.Second)
+	if d < time.Second {
+		return time.Second
+	}
+	return d
+}
+
+// duracaoBloqueio calcula o bloqueio após a N-ésima falha: zero abaixo do
+// limite, depois 1, 2, 4, 8... minutos até bloqueioMaximo
+func duracaoBloqueio(falhas, limite int) time.Duration {
+	if falhas < limite {
+		return 0
+	}
+	d := bloqueioInicial
+	for i := limite; i < falhas && d < bloqueioMaximo; i++ {
+		d *= 2
+	}
+	if d > bloqueioMaximo {
+		d = bloqueioMaximo
+	}
+	return d
+}
+
+type chaveThrottle struct {
+	chave  string
+	limite int
+}
+
+// chavesThrottle monta as chaves de contagem da tentativa (conta e IP)
+func chavesThrottle(email, ip string) []chaveThrottle {
+	chaves := []chaveThrottle{{chave: "email:" + strings.ToLower(strings.TrimSpace(email)), limite: limiteFalhasConta}}
+	if ip != "" {
+		chaves = append(chaves, chaveThrottle{chave: "ip:" + ip, limite: limiteFalhasIP})
+	}
+	return chaves
+}
+
+// loginThrottle verifica e registra falhas de login no banco
+type loginThrottle struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+// verificar retorna BloqueioLoginError se alguma das chaves estiver bloqueada.
+// Falhas de banco não impedem o login (apenas são registradas em log).
+func (t *loginThrottle) verificar(ctx context.Context, chaves []chaveThrottle) error {
+	var bloqueio *BloqueioLoginError
+	now := time.Now()
+	for _, c := range chaves {
+		row, err := t.queries.GetLoginThrottle(ctx, c.chave)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				common.Logger(ctx, t.logger).Warn("Erro ao consultar bloqueio de login", zap.String("chave", c.chave), zap.Error(err))
+			}
+			continue
+		}
+		if row.BloqueadoAte.Valid && row.BloqueadoAte.Time.After(now) {
+			if bloqueio == nil || row.BloqueadoAte.Time.After(bloqueio.Ate) {
+				bloqueio = &BloqueioLoginError{Ate: row.BloqueadoAte.Time}
+			}
+		}
+	}
+	if bloqueio != nil {
+		return bloqueio
+	}
+	return nil
+}
+
+// registrarFalha incrementa os contadores e aplica o bloqueio quando o limite é
+// atingido. Retorna BloqueioLoginError se esta falha disparou um bloqueio.
+func (t *loginThrottle) registrarFalha(ctx context.Context, chaves []chaveThrottle) error {
+	var bloqueio *BloqueioLoginError
+	now := time.Now()
+	for _, c := range chaves {
+		falhas, err := t.queries.RegistrarFalhaLogin(ctx, db.RegistrarFalhaLoginParams{
+			Chave:        c.chave,
+			JanelaInicio: pgtype.Timestamptz{Time: now.Add(-janelaFalhas), Valid: true},
+		})
+		if err != nil {
+			common.Logger(ctx, t.logger).Warn("Erro ao registrar falha de login", zap.String("chave", c.chave), zap.Error(err))
+			continue
+		}
+
+		d := duracaoBloqueio(int(falhas), c.limite)
+		if d == 0 {
+			continue
+		}
+		ate := now.Add(d)
+		if err := t.queries.DefinirBloqueioLogin(ctx, db.DefinirBloqueioLoginParams{
+			Chave:        c.chave,
+			BloqueadoAte: pgtype.Timestamptz{Time: ate, Valid: true},
+		}); err != nil {
+			common.Logger(ctx, t.logger).Warn("Erro ao bloquear login", zap.String("chave", c.chave), zap.Error(err))
+			continue
+		}
+		common.Logger(ctx, t.logger).Warn("Login bloqueado por excesso de tentativas",
+			zap.String("chave", c.chave),
+			zap.Int32("falhas", falhas),
+			zap.Duration("duracao", d),
+		)
+		if bloqueio == nil || ate.After(bloqueio.Ate) {
+			bloqueio = &BloqueioLoginError{Ate: ate}
+		}
+	}
+	if bloqueio != nil {
+		return bloqueio
+	}
+	return nil
+}
+
+// limparConta zera o contador da conta após login bem-sucedido. O contador do
+// IP não é zerado: um acerto não apaga tentativas contra outras contas.
+func (t *loginThrottle) limparConta(ctx context.Context, chaves []chaveThrottle) {
+	if err := t.queries.LimparLoginThrottle(ctx, chaves[0].chave); err != nil {
+		common.Logger(ctx, t.logger).Warn("Erro ao limpar contador de login", zap.Error(err))
+	}
+}
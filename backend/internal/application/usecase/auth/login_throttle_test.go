@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDuracaoBloqueio(t *testing.T) {
+	casos := []struct {
+		falhas int
+		want   time.Duration
+	}{
+		{4, 0},
+		{5, time.Minute},
+		{6, 2 * time.Minute},
+		{8, 8 * time.Minute},
+		{10, 30 * time.Minute}, // 32min limitado ao máximo
+		{50, 30 * time.Minute},
+	}
+	for _, c := range casos {
+		assert.Equal(t, c.want, duracaoBloqueio(c.falhas, limiteFalhasConta), "falhas=%d", c.falhas)
+	}
+	assert.Zero(t, duracaoBloqueio(19, limiteFalhasIP))
+}
+
+func TestChavesThrottle(t *testing.T) {
+	chaves := chavesThrottle("  Dono@Barbearia.com ", "10.0.0.1")
+	assert.Equal(t, "email:dono@barbearia.com", chaves[0].chave)
+	assert.Equal(t, "ip:10.0.0.1", chaves[1].chave)
+	assert.Len(t, chavesThrottle("a@b.com", ""), 1)
+}
+
+func TestBloqueioLoginError(t *testing.T) {
+	var err error = &BloqueioLoginError{Ate: time.Now().Add(90 * time.Second)}
+	assert.True(t, errors.Is(err, domain.ErrLoginBloqueado))
+	assert.InDelta(t, 90, err.(*BloqueioLoginError).RetryAfter().Seconds(), 1)
+}
@@ -2,14 +2,15 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
-	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 )
 
@@ -19,116 +20,119 @@ import (
 // =============================================================================
 
 type LoginUseCase struct {
-	queries    *db.Queries
-	jwtManager *auth.JWTManager
-	logger     *zap.Logger
+	queries     *db.Queries
+	jwtManager  *auth.JWTManager
+	throttle    *loginThrottle
+	doisFatores *doisFatores
+	logger      *zap.Logger
 }
 
 func NewLoginUseCase(queries *db.Queries, jwtManager *auth.JWTManager, logger *zap.Logger) *LoginUseCase {
 	return &LoginUseCase{
-		queries:    queries,
-		jwtManager: jwtManager,
-		logger:     logger,
+		queries:     queries,
+		jwtManager:  jwtManager,
+		throttle:    &loginThrottle{queries: queries, logger: logger},
+		doisFatores: &doisFatores{queries: queries, logger: logger},
+		logger:      logger,
 	}
 }
 
 // Execute executa o fluxo de login
-// Retorna: (accessToken, refreshToken, user, error)
-func (uc *LoginUseCase) Execute(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, string, error) {
-	// 1. Buscar usuário por email
-	user, err := uc.queries.GetUserByEmail(ctx, req.Email)
-	if err != nil {
-		uc.logger.Warn("Login falhou - email não encontrado",
+// Retorna: (response com access token, refreshToken, error). Quando o segundo
+// fator é necessário, a response traz só o desafio (MFARequired) e nenhum token.
+func (uc *LoginUseCase) Execute(ctx context.Context, req dto.LoginRequest, client ClientInfo) (*dto.LoginResponse, string, error) {
+	ctx, span := common.StartSpan(ctx, "auth.Login")
+	defer span.End()
+
+	// 1. Verificar bloqueio por tentativas (conta e IP)
+	chaves := chavesThrottle(req.Email, client.IP)
+	if err := uc.throttle.verificar(ctx, chaves); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Login recusado - bloqueado por tentativas",
 			zap.String("email", req.Email),
-			zap.Error(err),
+			zap.String("ip", client.IP),
 		)
-		return nil, "", domain.ErrEmailNaoEncontrado
+		return nil, "", err
 	}
 
-	// 2. Verificar se conta está ativa
-	if user.Ativo != nil && !*user.Ativo {
-		uc.logger.Warn("Login falhou - conta desativada",
+	// 2. Buscar usuário por email
+	user, err := uc.queries.GetUserByEmail(ctx, req.Email)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", fmt.Errorf("erro ao buscar usuário: %w", err)
+		}
+		// Mesmo custo de bcrypt de uma senha errada: o tempo não revela se o email existe
+		auth.CheckPasswordDummy(req.Password)
+		common.Logger(ctx, uc.logger).Warn("Login falhou - email não encontrado",
 			zap.String("email", req.Email),
-			zap.String("user_id", user.ID.String()),
+			zap.String("ip", client.IP),
 		)
-		return nil, "", domain.ErrContaDesativada
+		return nil, "", uc.falha(ctx, chaves)
 	}
 
 	// 3. Verificar senha
 	if !auth.CheckPassword(req.Password, user.PasswordHash) {
-		uc.logger.Warn("Login falhou - senha incorreta",
+		common.Logger(ctx, uc.logger).Warn("Login falhou - senha incorreta",
 			zap.String("email", req.Email),
 			zap.String("user_id", user.ID.String()),
+			zap.String("ip", client.IP),
 		)
-		return nil, "", domain.ErrSenhaIncorreta
+		return nil, "", uc.falha(ctx, chaves)
 	}
 
-	// 4. Gerar access token (15 minutos)
-	// unitID ainda não está implementado no banco; será preenchido após vínculo user↔unit
-	accessToken, err := uc.jwtManager.GenerateAccessToken(
-		user.ID.String(),
-		user.TenantID.String(),
-		"", // unit_id opcional até existir tabela/vínculo
-		user.Email,
-		user.Role,
-	)
-	if err != nil {
-		uc.logger.Error("Erro ao gerar access token",
+	// 4. Verificar se conta está ativa (só depois da senha, para não revelar a conta)
+	if user.Ativo != nil && !*user.Ativo {
+		common.Logger(ctx, uc.logger).Warn("Login falhou - conta desativada",
+			zap.String("email", req.Email),
 			zap.String("user_id", user.ID.String()),
-			zap.Error(err),
 		)
-		return nil, "", fmt.Errorf("erro ao gerar token: %w", err)
+		return nil, "", domain.ErrContaDesativada
 	}
 
-	// 5. Gerar refresh token (7 dias)
-	refreshToken, err := uc.jwtManager.GenerateRefreshToken()
+	// 5. Segundo fator: 2FA ativo ou exigido pela política do tenant para o
+	// papel do usuário em alguma das unidades
+	roles, err := papeisEfetivos(ctx, uc.queries, user.ID, user.Role)
 	if err != nil {
-		uc.logger.Error("Erro ao gerar refresh token",
-			zap.String("user_id", user.ID.String()),
-			zap.Error(err),
-		)
-		return nil, "", fmt.Errorf("erro ao gerar refresh token: %w", err)
+		return nil, "", err
 	}
-
-	// 6. Armazenar refresh token no banco
-	expiresAt := time.Now().Add(auth.RefreshTokenDuration)
-	err = uc.queries.SaveRefreshToken(ctx, db.SaveRefreshTokenParams{
-		UserID:    user.ID,
-		Token:     refreshToken,
-		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
-	})
+	etapa, err := uc.doisFatores.etapaLogin(ctx, user.ID, user.TenantID, roles)
 	if err != nil {
-		uc.logger.Error("Erro ao salvar refresh token",
+		return nil, "", err
+	}
+	if etapa != etapa2FANenhuma {
+		// O contador da conta só é zerado quando o segundo fator for aceito
+		// (LoginMFAUseCase); assim a senha certa não renova as tentativas de código
+		mfaToken, err := uc.doisFatores.criarDesafio(ctx, user.ID)
+		if err != nil {
+			return nil, "", err
+		}
+		common.Logger(ctx, uc.logger).Info("Login aguardando segundo fator",
 			zap.String("user_id", user.ID.String()),
-			zap.Error(err),
+			zap.Bool("cadastro_exigido", etapa == etapa2FACadastro),
 		)
-		return nil, "", fmt.Errorf("erro ao salvar refresh token: %w", err)
+		return &dto.LoginResponse{
+			MFARequired:           true,
+			MFAEnrollmentRequired: etapa == etapa2FACadastro,
+			MFAToken:              mfaToken,
+		}, "", nil
 	}
 
-	// 7. Atualizar último login
-	_ = uc.queries.UpdateLastLogin(ctx, user.ID)
+	uc.throttle.limparConta(ctx, chaves)
 
-	// 8. Log de sucesso
-	uc.logger.Info("Login bem-sucedido",
-		zap.String("user_id", user.ID.String()),
-		zap.String("email", user.Email),
-		zap.String("tenant_id", user.TenantID.String()),
-		zap.String("role", user.Role),
-	)
+	// 6. Criar sessão e emitir tokens
+	return iniciarSessao(ctx, uc.queries, uc.jwtManager, uc.logger, usuarioSessao{
+		ID:       user.ID,
+		TenantID: user.TenantID,
+		Nome:     user.Nome,
+		Email:    user.Email,
+		Role:     user.Role,
+	}, client)
+}
 
-	// 9. Montar response
-	response := &dto.LoginResponse{
-		AccessToken: accessToken,
-		User: dto.UserResponse{
-			ID:       user.ID.String(),
-			TenantID: user.TenantID.String(),
-			Nome:     user.Nome,
-			Email:    user.Email,
-			Role:     user.Role,
-			// current_unit_id vazio até o vínculo ser criado na etapa de banco de dados
-			CurrentUnitID: "",
-		},
+// falha registra a tentativa malsucedida. Email inexistente e senha errada
+// retornam o mesmo erro; se esta falha disparou o bloqueio, retorna o bloqueio.
+func (uc *LoginUseCase) falha(ctx context.Context, chaves []chaveThrottle) error {
+	if err := uc.throttle.registrarFalha(ctx, chaves); err != nil {
+		return err
 	}
-
-	return response, refreshToken, nil
+	return domain.ErrCredenciaisInvalidas
 }
@@ -3,13 +3,15 @@ package auth
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
 	"go.uber.org/zap"
 )
 
 // =============================================================================
 // LOGOUT USE CASE - VALTARIS v1.0
-// Invalida refresh token
+// Encerra a sessão do refresh token
 // =============================================================================
 
 type LogoutUseCase struct {
@@ -24,16 +26,32 @@ func NewLogoutUseCase(queries *db.Queries, logger *zap.Logger) *LogoutUseCase {
 	}
 }
 
-// Execute invalida refresh token no banco
+// Execute encerra a sessão do refresh token informado
 func (uc *LogoutUseCase) Execute(ctx context.Context, refreshToken string) error {
-	err := uc.queries.DeleteRefreshToken(ctx, refreshToken)
+	ctx, span := common.StartSpan(ctx, "auth.Logout")
+	defer span.End()
+
+	tokenData, err := uc.queries.GetRefreshTokenByHash(ctx, auth.HashRefreshToken(refreshToken))
 	if err != nil {
-		// Ignora erro se token já foi deletado ou não existe
-		uc.logger.Debug("Erro ao deletar refresh token (pode já estar deletado)",
+		// Ignora erro se token não existe (sessão já encerrada)
+		common.Logger(ctx, uc.logger).Debug("Refresh token não encontrado no logout",
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	motivo := MotivoRevogacaoLogout
+	if _, err := uc.queries.RevokeAuthSession(ctx, db.RevokeAuthSessionParams{
+		ID:            tokenData.SessionID,
+		RevokedReason: &motivo,
+	}); err != nil {
+		common.Logger(ctx, uc.logger).Debug("Erro ao revogar sessão no logout",
 			zap.Error(err),
 		)
 	}
 
-	uc.logger.Info("Logout executado com sucesso")
+	common.Logger(ctx, uc.logger).Info("Logout executado com sucesso",
+		zap.String("session_id", tokenData.SessionID.String()),
+	)
 	return nil
 }
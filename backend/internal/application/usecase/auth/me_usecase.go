@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
 	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
@@ -27,12 +29,16 @@ func NewMeUseCase(queries *db.Queries, logger *zap.Logger) *MeUseCase {
 	}
 }
 
-// Execute retorna dados do usuário autenticado
-func (uc *MeUseCase) Execute(ctx context.Context, userID string) (*dto.MeResponse, error) {
+// Execute retorna dados do usuário autenticado. unitID é a unidade ativa do
+// token: role passa a ser o papel do usuário nela.
+func (uc *MeUseCase) Execute(ctx context.Context, userID, unitID string) (*dto.MeResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.Me")
+	defer span.End()
+
 	// Converter string para pgtype.UUID
 	var userUUID pgtype.UUID
 	if err := userUUID.Scan(userID); err != nil {
-		uc.logger.Error("ID de usuário inválido",
+		common.Logger(ctx, uc.logger).Error("ID de usuário inválido",
 			zap.String("user_id", userID),
 			zap.Error(err),
 		)
@@ -41,7 +47,7 @@ func (uc *MeUseCase) Execute(ctx context.Context, userID string) (*dto.MeRespons
 
 	user, err := uc.queries.GetUserByID(ctx, userUUID)
 	if err != nil {
-		uc.logger.Error("Usuário não encontrado",
+		common.Logger(ctx, uc.logger).Error("Usuário não encontrado",
 			zap.String("user_id", userID),
 			zap.Error(err),
 		)
@@ -52,11 +58,28 @@ func (uc *MeUseCase) Execute(ctx context.Context, userID string) (*dto.MeRespons
 		return nil, domain.ErrContaDesativada
 	}
 
-	return &dto.MeResponse{
+	resp := &dto.MeResponse{
 		ID:       user.ID.String(),
 		TenantID: user.TenantID.String(),
 		Nome:     user.Nome,
 		Email:    user.Email,
 		Role:     user.Role,
-	}, nil
+		// Sem unidade ativa vale o modelo do papel global
+		Permissions: valueobject.TemplatePermissions(user.Role).List(),
+	}
+
+	var unitUUID pgtype.UUID
+	if unitID != "" && unitUUID.Scan(unitID) == nil {
+		unidade, err := buscarUnidade(ctx, uc.queries, user.ID, unitUUID)
+		if err != nil {
+			return nil, err
+		}
+		if unidade != nil {
+			resp.Role = unidade.Role
+			resp.CurrentUnitID = unidade.UnitID.String()
+			resp.Permissions = unidade.Permissions.List()
+		}
+	}
+
+	return resp, nil
 }
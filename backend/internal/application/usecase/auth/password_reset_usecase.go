@@ -0,0 +1,204 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// RECUPERAÇÃO DE SENHA
+// O link enviado por email vale 1 hora e é de uso único. A resposta do pedido é
+// sempre a mesma, exista ou não a conta, para não revelar emails cadastrados.
+// =============================================================================
+
+const (
+	validadeLinkSenha     = time.Hour
+	limitePedidosSenha    = 3 // pedidos por usuário dentro de janelaPedidosSenha
+	janelaPedidosSenha    = time.Hour
+	caminhoRedefinirSenha = "/redefinir-senha"
+)
+
+type ForgotPasswordUseCase struct {
+	queries *db.Queries
+	mailer  port.MailSender
+	appURL  string
+	logger  *zap.Logger
+}
+
+func NewForgotPasswordUseCase(queries *db.Queries, mailer port.MailSender, appURL string, logger *zap.Logger) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		queries: queries,
+		mailer:  mailer,
+		appURL:  appURL,
+		logger:  logger,
+	}
+}
+
+// Execute envia o link de redefinição. Conta inexistente, desativada ou com
+// pedidos demais não gera erro: apenas nada é enviado.
+func (uc *ForgotPasswordUseCase) Execute(ctx context.Context, email string, client ClientInfo) error {
+	ctx, span := common.StartSpan(ctx, "auth.ForgotPassword")
+	defer span.End()
+
+	email = strings.TrimSpace(email)
+
+	user, err := uc.queries.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			common.Logger(ctx, uc.logger).Info("Recuperação de senha para email não cadastrado",
+				zap.String("email", email),
+				zap.String("ip", client.IP),
+			)
+			return nil
+		}
+		return fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user.Ativo != nil && !*user.Ativo {
+		common.Logger(ctx, uc.logger).Warn("Recuperação de senha para conta desativada",
+			zap.String("user_id", user.ID.String()),
+		)
+		return nil
+	}
+
+	recentes, err := uc.queries.CountRecentPasswordResets(ctx, db.CountRecentPasswordResetsParams{
+		UserID: user.ID,
+		Desde:  pgtype.Timestamptz{Time: time.Now().Add(-janelaPedidosSenha), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao contar pedidos de recuperação: %w", err)
+	}
+	if recentes >= limitePedidosSenha {
+		common.Logger(ctx, uc.logger).Warn("Recuperação de senha ignorada - pedidos demais",
+			zap.String("user_id", user.ID.String()),
+			zap.String("ip", client.IP),
+		)
+		return nil
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return err
+	}
+
+	// Só o link mais recente vale
+	if err := uc.queries.InvalidatePasswordResetTokens(ctx, user.ID); err != nil {
+		return fmt.Errorf("erro ao invalidar links anteriores: %w", err)
+	}
+	if err := uc.queries.CreatePasswordResetToken(ctx, db.CreatePasswordResetTokenParams{
+		UserID:    user.ID,
+		TokenHash: auth.HashRefreshToken(token),
+		IpAddress: client.ipPtr(),
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(validadeLinkSenha), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("erro ao salvar link de recuperação: %w", err)
+	}
+
+	msg := port.EmailMessage{
+		To:      user.Email,
+		ToName:  user.Nome,
+		Subject: "Redefinição de senha",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nRecebemos um pedido para redefinir a sua senha. Acesse o link abaixo para criar uma nova senha:\n\n%s\n\nO link vale por 1 hora e só pode ser usado uma vez. Se você não fez este pedido, ignore este email: sua senha continua a mesma.\n",
+			user.Nome, montarLink(uc.appURL, caminhoRedefinirSenha, token),
+		),
+	}
+	// Falha no envio não muda a resposta (não revela a conta); fica no log
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao enviar email de recuperação de senha",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	common.Logger(ctx, uc.logger).Info("Link de recuperação de senha enviado",
+		zap.String("user_id", user.ID.String()),
+		zap.String("ip", client.IP),
+	)
+	return nil
+}
+
+type ResetPasswordUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewResetPasswordUseCase(queries *db.Queries, logger *zap.Logger) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute troca a senha usando o link recebido por email. Todas as sessões do
+// usuário são encerradas e o bloqueio de login da conta é zerado.
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, token, password string) error {
+	ctx, span := common.StartSpan(ctx, "auth.ResetPassword")
+	defer span.End()
+
+	passwordHash, err := auth.HashPassword(password)
+	if err != nil {
+		return fmt.Errorf("erro ao gerar hash da senha: %w", err)
+	}
+
+	userID, err := uc.queries.ConsumePasswordResetToken(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrLinkSenhaInvalido
+		}
+		return fmt.Errorf("erro ao validar link de recuperação: %w", err)
+	}
+
+	user, err := uc.queries.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrLinkSenhaInvalido
+		}
+		return fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user.Ativo != nil && !*user.Ativo {
+		return domain.ErrContaDesativada
+	}
+
+	if err := uc.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:           user.ID,
+		PasswordHash: passwordHash,
+	}); err != nil {
+		return fmt.Errorf("erro ao atualizar senha: %w", err)
+	}
+
+	// Outros links pendentes deixam de valer; sessões abertas com a senha antiga são encerradas
+	if err := uc.queries.InvalidatePasswordResetTokens(ctx, user.ID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao invalidar links de recuperação", zap.Error(err))
+	}
+	revogadas, err := uc.queries.RevokeOtherAuthSessions(ctx, db.RevokeOtherAuthSessionsParams{UserID: user.ID})
+	if err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao revogar sessões após troca de senha", zap.Error(err))
+	}
+	throttle := &loginThrottle{queries: uc.queries, logger: uc.logger}
+	throttle.limparConta(ctx, chavesThrottle(user.Email, ""))
+
+	common.Logger(ctx, uc.logger).Info("Senha redefinida",
+		zap.String("user_id", user.ID.String()),
+		zap.Int64("sessoes_revogadas", revogadas),
+	)
+	return nil
+}
+
+// montarLink monta a URL do frontend com o token de uso único
+func montarLink(appURL, caminho, token string) string {
+	return strings.TrimRight(appURL, "/") + caminho + "?token=" + url.QueryEscape(token)
+}
@@ -2,12 +2,17 @@ package auth
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"go.uber.org/zap"
 )
 
@@ -17,69 +22,194 @@ import (
 // =============================================================================
 
 type RefreshUseCase struct {
-	queries    *db.Queries
-	jwtManager *auth.JWTManager
-	logger     *zap.Logger
+	queries     *db.Queries
+	jwtManager  *auth.JWTManager
+	doisFatores *doisFatores
+	logger      *zap.Logger
 }
 
 func NewRefreshUseCase(queries *db.Queries, jwtManager *auth.JWTManager, logger *zap.Logger) *RefreshUseCase {
 	return &RefreshUseCase{
-		queries:    queries,
-		jwtManager: jwtManager,
-		logger:     logger,
+		queries:     queries,
+		jwtManager:  jwtManager,
+		doisFatores: &doisFatores{queries: queries, logger: logger},
+		logger:      logger,
 	}
 }
 
-// Execute renova access token usando refresh token
-func (uc *RefreshUseCase) Execute(ctx context.Context, refreshToken string) (*dto.RefreshResponse, error) {
-	// 1. Validar refresh token no banco
-	tokenData, err := uc.queries.GetRefreshToken(ctx, refreshToken)
+// Execute renova o access token e rotaciona o refresh token: cada refresh
+// token vale uma única vez. Apresentar um token já usado indica que ele vazou,
+// então a sessão inteira (todos os tokens da família) é revogada.
+// Retorna: (response com access token, novo refreshToken, error)
+func (uc *RefreshUseCase) Execute(ctx context.Context, refreshToken string, client ClientInfo) (*dto.RefreshResponse, string, error) {
+	ctx, span := common.StartSpan(ctx, "auth.Refresh")
+	defer span.End()
+
+	// 1. Localizar token pelo hash
+	tokenData, err := uc.queries.GetRefreshTokenByHash(ctx, auth.HashRefreshToken(refreshToken))
 	if err != nil {
-		uc.logger.Warn("Refresh token inválido ou não encontrado",
-			zap.Error(err),
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", fmt.Errorf("erro ao buscar refresh token: %w", err)
+		}
+		common.Logger(ctx, uc.logger).Warn("Refresh token não encontrado")
+		return nil, "", domain.ErrRefreshTokenInvalido
+	}
+
+	// 2. Sessão revogada (logout, revogação manual ou reuso anterior)
+	if tokenData.SessionRevokedAt.Valid {
+		common.Logger(ctx, uc.logger).Warn("Refresh token de sessão revogada",
+			zap.String("session_id", tokenData.SessionID.String()),
 		)
-		return nil, domain.ErrRefreshTokenInvalido
+		return nil, "", domain.ErrRefreshTokenInvalido
+	}
+
+	// 3. Detecção de reuso
+	if tokenData.UsedAt.Valid {
+		return nil, "", uc.revogarPorReuso(ctx, tokenData, client)
+	}
+
+	if !tokenData.ExpiresAt.Valid || tokenData.ExpiresAt.Time.Before(time.Now()) {
+		return nil, "", domain.ErrRefreshTokenInvalido
+	}
+
+	// 4. Consumir o token (só uma requisição concorrente vence)
+	rows, err := uc.queries.MarkRefreshTokenUsed(ctx, tokenData.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao consumir refresh token: %w", err)
+	}
+	if rows == 0 {
+		return nil, "", uc.revogarPorReuso(ctx, tokenData, client)
 	}
 
-	// 2. Buscar usuário
+	// 5. Buscar usuário
 	user, err := uc.queries.GetUserByID(ctx, tokenData.UserID)
 	if err != nil {
-		uc.logger.Error("Usuário do refresh token não encontrado",
+		common.Logger(ctx, uc.logger).Error("Usuário do refresh token não encontrado",
 			zap.String("user_id", tokenData.UserID.String()),
 			zap.Error(err),
 		)
-		return nil, domain.ErrUsuarioNaoEncontrado
+		return nil, "", domain.ErrUsuarioNaoEncontrado
 	}
 
-	// 3. Verificar se conta está ativa
+	// 6. Verificar se conta está ativa
 	if user.Ativo != nil && !*user.Ativo {
-		uc.logger.Warn("Tentativa de refresh com conta desativada",
+		common.Logger(ctx, uc.logger).Warn("Tentativa de refresh com conta desativada",
 			zap.String("user_id", user.ID.String()),
 		)
-		return nil, domain.ErrContaDesativada
+		return nil, "", domain.ErrContaDesativada
+	}
+
+	// 7. Unidade ativa da sessão e papel atual nela (o vínculo pode ter sido
+	// removido ou o papel alterado desde o último token)
+	unidade, err := resolverUnidade(ctx, uc.queries, user.ID, user.Role, tokenData.SessionUnitID)
+	if err != nil {
+		return nil, "", err
 	}
 
-	// 4. Gerar novo access token
+	// 8. Política do tenant passou a exigir 2FA para o papel na unidade e o
+	// usuário ainda não ativou: encerra a sessão para que o próximo login
+	// passe pelo cadastro
+	if err := uc.exigirDoisFatores(ctx, user.ID, user.TenantID, unidade.Role, tokenData.SessionID); err != nil {
+		return nil, "", err
+	}
+	if unidade.UnitID != tokenData.SessionUnitID {
+		if err := uc.queries.SetAuthSessionUnit(ctx, db.SetAuthSessionUnitParams{
+			ID:     tokenData.SessionID,
+			UnitID: unidade.UnitID,
+		}); err != nil {
+			common.Logger(ctx, uc.logger).Warn("Erro ao atualizar unidade da sessão", zap.Error(err))
+		}
+	}
+
+	// 9. Gerar novo access token
 	accessToken, err := uc.jwtManager.GenerateAccessToken(
 		user.ID.String(),
 		user.TenantID.String(),
-		"", // unit_id será populado quando vínculo unidade for implementado
+		unidade.UnitID.String(),
 		user.Email,
-		user.Role,
+		unidade.Role,
+		tokenData.SessionID.String(),
+		unidade.Permissions.List(),
 	)
 	if err != nil {
-		uc.logger.Error("Erro ao gerar novo access token",
+		common.Logger(ctx, uc.logger).Error("Erro ao gerar novo access token",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil, "", fmt.Errorf("erro ao gerar token: %w", err)
+	}
+
+	// 10. Emitir o próximo refresh token da mesma sessão
+	newRefreshToken, expiresAt, err := emitirRefreshToken(ctx, uc.queries, uc.jwtManager, user.ID, tokenData.SessionID)
+	if err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao rotacionar refresh token",
 			zap.String("user_id", user.ID.String()),
 			zap.Error(err),
 		)
-		return nil, fmt.Errorf("erro ao gerar token: %w", err)
+		return nil, "", err
+	}
+
+	if err := uc.queries.TouchAuthSession(ctx, db.TouchAuthSessionParams{
+		ID:        tokenData.SessionID,
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+		IpAddress: client.ipPtr(),
+	}); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao atualizar sessão", zap.Error(err))
 	}
 
-	uc.logger.Info("Access token renovado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Access token renovado com sucesso",
 		zap.String("user_id", user.ID.String()),
+		zap.String("session_id", tokenData.SessionID.String()),
 	)
 
 	return &dto.RefreshResponse{
 		AccessToken: accessToken,
-	}, nil
+	}, newRefreshToken, nil
+}
+
+// revogarPorReuso encerra a sessão cujo refresh token foi apresentado de novo
+func (uc *RefreshUseCase) revogarPorReuso(ctx context.Context, tokenData db.GetRefreshTokenByHashRow, client ClientInfo) error {
+	motivo := MotivoRevogacaoReutilizacao
+	if _, err := uc.queries.RevokeAuthSession(ctx, db.RevokeAuthSessionParams{
+		ID:            tokenData.SessionID,
+		RevokedReason: &motivo,
+	}); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao revogar sessão após reuso de refresh token",
+			zap.String("session_id", tokenData.SessionID.String()),
+			zap.Error(err),
+		)
+	}
+
+	common.Logger(ctx, uc.logger).Warn("Reuso de refresh token detectado - sessão revogada",
+		zap.String("user_id", tokenData.UserID.String()),
+		zap.String("session_id", tokenData.SessionID.String()),
+		zap.String("ip", client.IP),
+	)
+	return domain.ErrRefreshTokenReutilizado
+}
+
+// exigirDoisFatores revoga a sessão se a política exige 2FA para o papel e o
+// usuário não o ativou
+func (uc *RefreshUseCase) exigirDoisFatores(ctx context.Context, userID, tenantID pgtype.UUID, role string, sessionID pgtype.UUID) error {
+	exige, err := uc.doisFatores.politicaExige(ctx, tenantID, role)
+	if err != nil || !exige {
+		return err
+	}
+	ativo, err := uc.doisFatores.ativo(ctx, userID)
+	if err != nil || ativo {
+		return err
+	}
+
+	motivo := MotivoRevogacaoManual
+	if _, err := uc.queries.RevokeAuthSession(ctx, db.RevokeAuthSessionParams{
+		ID:            sessionID,
+		RevokedReason: &motivo,
+	}); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao revogar sessão sem 2FA", zap.Error(err))
+	}
+	common.Logger(ctx, uc.logger).Warn("Sessão encerrada - política exige 2FA",
+		zap.String("user_id", userID.String()),
+		zap.String("session_id", sessionID.String()),
+	)
+	return domain.ErrDoisFatoresObrigatorio
 }
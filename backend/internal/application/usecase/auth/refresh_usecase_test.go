@@ -0,0 +1,193 @@
+package auth
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// authDBFake guarda em memória os refresh tokens e as sessões, atendendo
+// só as queries usadas no login, na renovação e na troca de unidade
+// (identificadas pelo "-- name:" do sqlc)
+type authDBFake struct {
+	db.DBTX
+	user      db.GetUserByIDRow
+	tokens    map[string]*db.GetRefreshTokenByHashRow // por hash
+	revogada  map[pgtype.UUID]string                  // sessão -> motivo
+	unitID    pgtype.UUID
+	unitRole  string // papel efetivo na unidade; vazio: sem vínculo
+	exigir2FA bool   // política do tenant
+}
+
+// rowFake devolve os campos da struct na ordem do Scan gerado pelo sqlc
+type rowFake struct {
+	v   any
+	err error
+}
+
+func (r rowFake) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	v := reflect.ValueOf(r.v)
+	for i, d := range dest {
+		reflect.ValueOf(d).Elem().Set(v.Field(i))
+	}
+	return nil
+}
+
+// rowsFake devolve uma coluna escalar por linha
+type rowsFake struct {
+	pgx.Rows
+	vals []any
+	i    int
+}
+
+func (r *rowsFake) Next() bool { r.i++; return r.i <= len(r.vals) }
+func (r *rowsFake) Close()     {}
+func (r *rowsFake) Err() error { return nil }
+
+func (r *rowsFake) Scan(dest ...any) error {
+	reflect.ValueOf(dest[0]).Elem().Set(reflect.ValueOf(r.vals[r.i-1]))
+	return nil
+}
+
+func queryName(sql string) string {
+	name := strings.TrimPrefix(strings.SplitN(sql, "\n", 2)[0], "-- name: ")
+	return strings.Fields(name)[0]
+}
+
+func (f *authDBFake) QueryRow(_ context.Context, sql string, args ...any) pgx.Row {
+	switch queryName(sql) {
+	case "GetRefreshTokenByHash":
+		t, ok := f.tokens[args[0].(string)]
+		if !ok {
+			return rowFake{err: pgx.ErrNoRows}
+		}
+		row := *t
+		if _, ok := f.revogada[t.SessionID]; ok {
+			row.SessionRevokedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+		return rowFake{v: row}
+	case "GetUserByID":
+		return rowFake{v: f.user}
+	case "GetUserByEmail":
+		return rowFake{v: db.GetUserByEmailRow(f.user)}
+	case "ResolveUserUnit":
+		if f.unitRole != "" {
+			return rowFake{v: db.ResolveUserUnitRow{UnitID: f.unitID, Role: f.unitRole}}
+		}
+	case "GetTenantAuthPolicy":
+		if f.exigir2FA {
+			return rowFake{v: db.TenantAuthPolicy{TenantID: f.user.TenantID, Exigir2faAdmin: true}}
+		}
+	}
+	return rowFake{err: pgx.ErrNoRows} // sem bloqueio de login e sem 2FA cadastrado
+}
+
+func (f *authDBFake) Query(_ context.Context, sql string, _ ...any) (pgx.Rows, error) {
+	rows := &rowsFake{}
+	if queryName(sql) == "ListUserUnitRoles" && f.unitRole != "" {
+		rows.vals = []any{f.unitRole}
+	}
+	return rows, nil
+}
+
+func (f *authDBFake) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	switch queryName(sql) {
+	case "MarkRefreshTokenUsed":
+		for _, t := range f.tokens {
+			if t.ID == args[0] && !t.UsedAt.Valid {
+				t.UsedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+				return pgconn.NewCommandTag("UPDATE 1"), nil
+			}
+		}
+		return pgconn.NewCommandTag("UPDATE 0"), nil
+	case "SaveRefreshToken":
+		f.tokens[args[2].(string)] = &db.GetRefreshTokenByHashRow{
+			ID:        pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			UserID:    args[0].(pgtype.UUID),
+			SessionID: args[1].(pgtype.UUID),
+			ExpiresAt: args[3].(pgtype.Timestamptz),
+		}
+	case "RevokeAuthSession":
+		f.revogada[args[0].(pgtype.UUID)] = *args[1].(*string)
+	}
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func novaSessaoRefresh(t *testing.T) (*RefreshUseCase, *authDBFake, pgtype.UUID, string) {
+	t.Helper()
+	ativo := true
+	fake := &authDBFake{
+		user: db.GetUserByIDRow{
+			ID:       pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			TenantID: pgtype.UUID{Bytes: uuid.New(), Valid: true},
+			Email:    "barbeiro@exemplo.com",
+			Role:     "barbeiro",
+			Ativo:    &ativo,
+		},
+		tokens:   map[string]*db.GetRefreshTokenByHashRow{},
+		revogada: map[pgtype.UUID]string{},
+	}
+	jwtManager := auth.NewJWTManager()
+	sessionID := pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	token, _, err := emitirRefreshToken(context.Background(), db.New(fake), jwtManager, fake.user.ID, sessionID)
+	require.NoError(t, err)
+
+	return NewRefreshUseCase(db.New(fake), jwtManager, zap.NewNop()), fake, sessionID, token
+}
+
+func TestRefreshUseCase_RotacionaToken(t *testing.T) {
+	ctx := context.Background()
+	uc, fake, sessionID, primeiro := novaSessaoRefresh(t)
+
+	resp, segundo, err := uc.Execute(ctx, primeiro, ClientInfo{})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.AccessToken)
+	assert.NotEqual(t, primeiro, segundo)
+	assert.True(t, fake.tokens[auth.HashRefreshToken(primeiro)].UsedAt.Valid, "token rotacionado fica consumido")
+
+	// O novo token continua a mesma sessão
+	_, terceiro, err := uc.Execute(ctx, segundo, ClientInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, sessionID, fake.tokens[auth.HashRefreshToken(terceiro)].SessionID)
+	assert.Empty(t, fake.revogada)
+}
+
+func TestRefreshUseCase_ReusoRevogaFamilia(t *testing.T) {
+	ctx := context.Background()
+	uc, fake, sessionID, antigo := novaSessaoRefresh(t)
+
+	_, atual, err := uc.Execute(ctx, antigo, ClientInfo{})
+	require.NoError(t, err)
+
+	// Token já rotacionado apresentado de novo: vazou, a sessão cai
+	_, _, err = uc.Execute(ctx, antigo, ClientInfo{IP: "203.0.113.7"})
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenReutilizado)
+	assert.Equal(t, MotivoRevogacaoReutilizacao, fake.revogada[sessionID])
+
+	// O token mais recente da família também deixa de valer
+	_, _, err = uc.Execute(ctx, atual, ClientInfo{})
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenInvalido)
+}
+
+func TestRefreshUseCase_TokenDesconhecido(t *testing.T) {
+	uc, _, _, _ := novaSessaoRefresh(t)
+
+	_, _, err := uc.Execute(context.Background(), "token-inexistente", ClientInfo{})
+	assert.ErrorIs(t, err, domain.ErrRefreshTokenInvalido)
+}
@@ -0,0 +1,353 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// PAPÉIS PERSONALIZADOS
+// Cada papel parte de um papel fixo (base_role, usado pelas rotas ainda
+// protegidas por papel) e define as próprias permissões. As mudanças valem a
+// partir do próximo token do usuário (refresh ou troca de unidade).
+// =============================================================================
+
+// PermissionCatalog monta o catálogo de permissões e os modelos dos papéis fixos
+func PermissionCatalog() dto.PermissionCatalogResponse {
+	resp := dto.PermissionCatalogResponse{
+		Permissions: make([]dto.PermissionInfoResponse, len(valueobject.PermissionCatalog)),
+		Templates:   map[string][]string{},
+	}
+	for i, p := range valueobject.PermissionCatalog {
+		resp.Permissions[i] = dto.PermissionInfoResponse{Code: p.Code.String(), Descricao: p.Descricao}
+	}
+	resp.Templates[roleOwner] = valueobject.TemplatePermissions(roleOwner).List()
+	for role := range valueobject.RoleTemplates {
+		resp.Templates[role] = valueobject.TemplatePermissions(role).List()
+	}
+	return resp
+}
+
+// validarPermissoes confere os códigos contra o catálogo, remove duplicados e
+// ordena. roles.manage fica restrito ao dono.
+func validarPermissoes(codes []string) ([]string, error) {
+	vistos := map[string]bool{}
+	out := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = strings.TrimSpace(c)
+		p := valueobject.Permission(c)
+		if !p.IsValid() || p == valueobject.PermRolesManage {
+			return nil, domain.ErrPapelPermissaoInvalida
+		}
+		if !vistos[c] {
+			vistos[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// erroNomeDuplicado traduz a violação do índice único (tenant_id, nome)
+func erroNomeDuplicado(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+func toTenantRoleResponse(r db.TenantRole) dto.TenantRoleResponse {
+	perms := r.Permissoes
+	if perms == nil {
+		perms = []string{}
+	}
+	return dto.TenantRoleResponse{
+		ID:           r.ID.String(),
+		Nome:         r.Nome,
+		Descricao:    r.Descricao,
+		BaseRole:     r.BaseRole,
+		Permissoes:   perms,
+		CriadoEm:     r.CriadoEm.Time.Format(time.RFC3339),
+		AtualizadoEm: r.AtualizadoEm.Time.Format(time.RFC3339),
+	}
+}
+
+// parseTenantEID converte tenant e id do papel
+func parseTenantEID(tenantID, id string) (pgtype.UUID, pgtype.UUID, error) {
+	var tenantUUID, idUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return tenantUUID, idUUID, domain.ErrInvalidTenantID
+	}
+	if err := idUUID.Scan(id); err != nil {
+		return tenantUUID, idUUID, domain.ErrInvalidID
+	}
+	return tenantUUID, idUUID, nil
+}
+
+// -----------------------------------------------------------------------------
+// Listar
+// -----------------------------------------------------------------------------
+
+type ListTenantRolesUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewListTenantRolesUseCase(queries *db.Queries, logger *zap.Logger) *ListTenantRolesUseCase {
+	return &ListTenantRolesUseCase{queries: queries, logger: logger}
+}
+
+// Execute lista os papéis personalizados do tenant
+func (uc *ListTenantRolesUseCase) Execute(ctx context.Context, tenantID string) ([]dto.TenantRoleResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.ListTenantRoles")
+	defer span.End()
+
+	var tenantUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+
+	rows, err := uc.queries.ListTenantRoles(ctx, tenantUUID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar papéis: %w", err)
+	}
+
+	out := make([]dto.TenantRoleResponse, len(rows))
+	for i, r := range rows {
+		out[i] = toTenantRoleResponse(r)
+	}
+	return out, nil
+}
+
+// -----------------------------------------------------------------------------
+// Criar
+// -----------------------------------------------------------------------------
+
+type CreateTenantRoleUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewCreateTenantRoleUseCase(queries *db.Queries, logger *zap.Logger) *CreateTenantRoleUseCase {
+	return &CreateTenantRoleUseCase{queries: queries, logger: logger}
+}
+
+// Execute cria um papel personalizado
+func (uc *CreateTenantRoleUseCase) Execute(ctx context.Context, tenantID string, req dto.TenantRoleRequest) (*dto.TenantRoleResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.CreateTenantRole")
+	defer span.End()
+
+	var tenantUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	perms, err := validarPermissoes(req.Permissoes)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.queries.CreateTenantRole(ctx, db.CreateTenantRoleParams{
+		TenantID:   tenantUUID,
+		Nome:       strings.TrimSpace(req.Nome),
+		Descricao:  req.Descricao,
+		BaseRole:   req.BaseRole,
+		Permissoes: perms,
+	})
+	if err != nil {
+		if erroNomeDuplicado(err) {
+			return nil, domain.ErrPapelNomeDuplicado
+		}
+		return nil, fmt.Errorf("erro ao criar papel: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Papel personalizado criado",
+		zap.String("tenant_id", tenantID),
+		zap.String("role_id", role.ID.String()),
+		zap.Strings("permissoes", perms),
+	)
+
+	resp := toTenantRoleResponse(role)
+	return &resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// Editar
+// -----------------------------------------------------------------------------
+
+type UpdateTenantRoleUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewUpdateTenantRoleUseCase(queries *db.Queries, logger *zap.Logger) *UpdateTenantRoleUseCase {
+	return &UpdateTenantRoleUseCase{queries: queries, logger: logger}
+}
+
+// Execute substitui nome, papel base e permissões
+func (uc *UpdateTenantRoleUseCase) Execute(ctx context.Context, tenantID, id string, req dto.TenantRoleRequest) (*dto.TenantRoleResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.UpdateTenantRole")
+	defer span.End()
+
+	tenantUUID, idUUID, err := parseTenantEID(tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := validarPermissoes(req.Permissoes)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.queries.UpdateTenantRole(ctx, db.UpdateTenantRoleParams{
+		ID:         idUUID,
+		TenantID:   tenantUUID,
+		Nome:       strings.TrimSpace(req.Nome),
+		Descricao:  req.Descricao,
+		BaseRole:   req.BaseRole,
+		Permissoes: perms,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrPapelNaoEncontrado
+		}
+		if erroNomeDuplicado(err) {
+			return nil, domain.ErrPapelNomeDuplicado
+		}
+		return nil, fmt.Errorf("erro ao atualizar papel: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Papel personalizado atualizado",
+		zap.String("tenant_id", tenantID),
+		zap.String("role_id", id),
+		zap.Strings("permissoes", perms),
+	)
+
+	resp := toTenantRoleResponse(role)
+	return &resp, nil
+}
+
+// -----------------------------------------------------------------------------
+// Excluir
+// -----------------------------------------------------------------------------
+
+type DeleteTenantRoleUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewDeleteTenantRoleUseCase(queries *db.Queries, logger *zap.Logger) *DeleteTenantRoleUseCase {
+	return &DeleteTenantRoleUseCase{queries: queries, logger: logger}
+}
+
+// Execute exclui o papel se nenhum usuário o tiver atribuído
+func (uc *DeleteTenantRoleUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "auth.DeleteTenantRole")
+	defer span.End()
+
+	tenantUUID, idUUID, err := parseTenantEID(tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uc.queries.GetTenantRole(ctx, db.GetTenantRoleParams{ID: idUUID, TenantID: tenantUUID}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrPapelNaoEncontrado
+		}
+		return fmt.Errorf("erro ao buscar papel: %w", err)
+	}
+
+	emUso, err := uc.queries.CountTenantRoleAssignments(ctx, idUUID)
+	if err != nil {
+		return fmt.Errorf("erro ao verificar atribuições do papel: %w", err)
+	}
+	if emUso > 0 {
+		return domain.ErrPapelEmUso
+	}
+
+	n, err := uc.queries.DeleteTenantRole(ctx, db.DeleteTenantRoleParams{ID: idUUID, TenantID: tenantUUID})
+	if err != nil {
+		return fmt.Errorf("erro ao excluir papel: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrPapelNaoEncontrado
+	}
+
+	common.Logger(ctx, uc.logger).Info("Papel personalizado excluído",
+		zap.String("tenant_id", tenantID),
+		zap.String("role_id", id),
+	)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Atribuir
+// -----------------------------------------------------------------------------
+
+type AssignTenantRoleUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewAssignTenantRoleUseCase(queries *db.Queries, logger *zap.Logger) *AssignTenantRoleUseCase {
+	return &AssignTenantRoleUseCase{queries: queries, logger: logger}
+}
+
+// Execute define o papel personalizado do usuário na unidade; sem
+// custom_role_id volta a valer o papel fixo (role_override ou users.role)
+func (uc *AssignTenantRoleUseCase) Execute(ctx context.Context, tenantID string, req dto.AssignTenantRoleRequest) error {
+	ctx, span := common.StartSpan(ctx, "auth.AssignTenantRole")
+	defer span.End()
+
+	var params db.AssignUserUnitCustomRoleParams
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return domain.ErrInvalidTenantID
+	}
+	if err := params.UserID.Scan(req.UserID); err != nil {
+		return domain.ErrInvalidID
+	}
+	if err := params.UnitID.Scan(req.UnitID); err != nil {
+		return domain.ErrInvalidUnitID
+	}
+
+	if req.CustomRoleID != nil && *req.CustomRoleID != "" {
+		if err := params.CustomRoleID.Scan(*req.CustomRoleID); err != nil {
+			return domain.ErrInvalidID
+		}
+		if _, err := uc.queries.GetTenantRole(ctx, db.GetTenantRoleParams{
+			ID:       params.CustomRoleID,
+			TenantID: params.TenantID,
+		}); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrPapelNaoEncontrado
+			}
+			return fmt.Errorf("erro ao buscar papel: %w", err)
+		}
+	}
+
+	n, err := uc.queries.AssignUserUnitCustomRole(ctx, params)
+	if err != nil {
+		return fmt.Errorf("erro ao atribuir papel: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrVinculoUnidadeNaoExiste
+	}
+
+	common.Logger(ctx, uc.logger).Info("Papel personalizado atribuído",
+		zap.String("tenant_id", tenantID),
+		zap.String("user_id", req.UserID),
+		zap.String("unit_id", req.UnitID),
+		zap.String("role_id", params.CustomRoleID.String()),
+	)
+	return nil
+}
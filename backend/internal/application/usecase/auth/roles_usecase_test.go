@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidarPermissoes(t *testing.T) {
+	casos := []struct {
+		codes []string
+		want  []string
+		err   error
+	}{
+		{[]string{" financial.dre.read", "caixa.sangria", "caixa.sangria"}, []string{"caixa.sangria", "financial.dre.read"}, nil},
+		{[]string{}, []string{}, nil},
+		{[]string{"caixa.sangria", "modulo.inexistente"}, nil, domain.ErrPapelPermissaoInvalida},
+		{[]string{"roles.manage"}, nil, domain.ErrPapelPermissaoInvalida}, // exclusiva do dono
+	}
+	for _, c := range casos {
+		got, err := validarPermissoes(c.codes)
+		assert.ErrorIs(t, err, c.err, "codes=%v", c.codes)
+		assert.Equal(t, c.want, got, "codes=%v", c.codes)
+	}
+}
@@ -0,0 +1,241 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// SESSÕES - helpers compartilhados por login, 2FA e refresh
+// =============================================================================
+
+// Motivos de revogação de sessão (auth_sessions.revoked_reason)
+const (
+	MotivoRevogacaoLogout       = "LOGOUT"
+	MotivoRevogacaoManual       = "REVOGADA"
+	MotivoRevogacaoReutilizacao = "REUTILIZACAO"
+)
+
+// ClientInfo identifica o dispositivo que fez a requisição
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+}
+
+const maxUserAgent = 255
+
+func (c ClientInfo) userAgentPtr() *string {
+	if c.UserAgent == "" {
+		return nil
+	}
+	ua := c.UserAgent
+	if utf8.RuneCountInString(ua) > maxUserAgent {
+		ua = string([]rune(ua)[:maxUserAgent])
+	}
+	return &ua
+}
+
+func (c ClientInfo) ipPtr() *string {
+	if c.IP == "" {
+		return nil
+	}
+	ip := c.IP
+	return &ip
+}
+
+// emitirRefreshToken gera um novo refresh token da sessão e grava apenas o hash
+func emitirRefreshToken(ctx context.Context, queries *db.Queries, jwtManager *auth.JWTManager, userID, sessionID pgtype.UUID) (string, time.Time, error) {
+	token, err := jwtManager.GenerateRefreshToken()
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(auth.RefreshTokenDuration)
+	err = queries.SaveRefreshToken(ctx, db.SaveRefreshTokenParams{
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenHash: auth.HashRefreshToken(token),
+		ExpiresAt: pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("erro ao salvar refresh token: %w", err)
+	}
+	return token, expiresAt, nil
+}
+
+// unidadeSessao é a unidade ativa da sessão e o papel do usuário nela
+type unidadeSessao struct {
+	UnitID      pgtype.UUID // inválido quando o usuário não tem vínculo com unidades
+	Role        string
+	Permissions valueobject.PermissionSet
+}
+
+// permissoesEfetivas: papel personalizado usa a própria lista; os demais, o
+// modelo do papel fixo
+func permissoesEfetivas(role string, customRoleID pgtype.UUID, custom []string) valueobject.PermissionSet {
+	if customRoleID.Valid {
+		return valueobject.NewPermissionSet(custom...)
+	}
+	return valueobject.TemplatePermissions(role)
+}
+
+// buscarUnidade retorna a unidade (ou a padrão, se unitID for inválido) e o
+// papel efetivo do usuário nela; nil quando não há vínculo ativo
+func buscarUnidade(ctx context.Context, queries *db.Queries, userID, unitID pgtype.UUID) (*unidadeSessao, error) {
+	row, err := queries.ResolveUserUnit(ctx, db.ResolveUserUnitParams{
+		UserID: userID,
+		UnitID: unitID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao buscar unidade do usuário: %w", err)
+	}
+	return &unidadeSessao{
+		UnitID:      row.UnitID,
+		Role:        row.Role,
+		Permissions: permissoesEfetivas(row.Role, row.CustomRoleID, row.CustomPermissoes),
+	}, nil
+}
+
+// resolverUnidade escolhe a unidade ativa da sessão: a preferida, se o
+// usuário ainda tiver acesso a ela, senão a unidade padrão. Usuário sem
+// vínculo com unidades mantém o papel global (users.role) e token sem unidade.
+func resolverUnidade(ctx context.Context, queries *db.Queries, userID pgtype.UUID, roleGlobal string, preferida pgtype.UUID) (unidadeSessao, error) {
+	if preferida.Valid {
+		u, err := buscarUnidade(ctx, queries, userID, preferida)
+		if err != nil {
+			return unidadeSessao{}, err
+		}
+		if u != nil {
+			return *u, nil
+		}
+	}
+
+	u, err := buscarUnidade(ctx, queries, userID, pgtype.UUID{})
+	if err != nil {
+		return unidadeSessao{}, err
+	}
+	if u == nil {
+		return unidadeSessao{Role: roleGlobal, Permissions: valueobject.TemplatePermissions(roleGlobal)}, nil
+	}
+	return *u, nil
+}
+
+// papeisEfetivos retorna o papel do usuário em cada unidade ativa; sem vínculo
+// com unidades, o papel global (users.role), como em resolverUnidade
+func papeisEfetivos(ctx context.Context, queries *db.Queries, userID pgtype.UUID, roleGlobal string) ([]string, error) {
+	roles, err := queries.ListUserUnitRoles(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar papéis do usuário nas unidades: %w", err)
+	}
+	if len(roles) == 0 {
+		return []string{roleGlobal}, nil
+	}
+	return roles, nil
+}
+
+// usuarioSessao são os dados do usuário necessários para abrir a sessão
+type usuarioSessao struct {
+	ID       pgtype.UUID
+	TenantID pgtype.UUID
+	Nome     string
+	Email    string
+	Role     string
+}
+
+// iniciarSessao conclui o login: cria a sessão do dispositivo e emite access e
+// refresh token. Usado pelo login com senha e pelo segundo passo (2FA).
+func iniciarSessao(ctx context.Context, queries *db.Queries, jwtManager *auth.JWTManager, logger *zap.Logger, user usuarioSessao, client ClientInfo) (*dto.LoginResponse, string, error) {
+	// 1. Unidade padrão do usuário e o papel dele nela
+	unidade, err := resolverUnidade(ctx, queries, user.ID, user.Role, pgtype.UUID{})
+	if err != nil {
+		logger.Error("Erro ao resolver unidade do usuário",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil, "", err
+	}
+
+	// 2. Criar sessão do dispositivo
+	session, err := queries.CreateAuthSession(ctx, db.CreateAuthSessionParams{
+		UserID:    user.ID,
+		TenantID:  user.TenantID,
+		UserAgent: client.userAgentPtr(),
+		IpAddress: client.ipPtr(),
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(auth.RefreshTokenDuration), Valid: true},
+		UnitID:    unidade.UnitID,
+	})
+	if err != nil {
+		logger.Error("Erro ao criar sessão",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil, "", fmt.Errorf("erro ao criar sessão: %w", err)
+	}
+
+	// 3. Gerar access token (15 minutos) com a unidade ativa
+	accessToken, err := jwtManager.GenerateAccessToken(
+		user.ID.String(),
+		user.TenantID.String(),
+		unidade.UnitID.String(),
+		user.Email,
+		unidade.Role,
+		session.ID.String(),
+		unidade.Permissions.List(),
+	)
+	if err != nil {
+		logger.Error("Erro ao gerar access token",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil, "", fmt.Errorf("erro ao gerar token: %w", err)
+	}
+
+	// 4. Gerar refresh token (7 dias) e armazenar o hash
+	refreshToken, _, err := emitirRefreshToken(ctx, queries, jwtManager, user.ID, session.ID)
+	if err != nil {
+		logger.Error("Erro ao emitir refresh token",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+		return nil, "", err
+	}
+
+	// 5. Atualizar último login
+	_ = queries.UpdateLastLogin(ctx, user.ID)
+
+	logger.Info("Login bem-sucedido",
+		zap.String("user_id", user.ID.String()),
+		zap.String("email", user.Email),
+		zap.String("tenant_id", user.TenantID.String()),
+		zap.String("role", unidade.Role),
+		zap.String("unit_id", unidade.UnitID.String()),
+		zap.String("session_id", session.ID.String()),
+	)
+
+	return &dto.LoginResponse{
+		AccessToken: accessToken,
+		User: &dto.UserResponse{
+			ID:            user.ID.String(),
+			TenantID:      user.TenantID.String(),
+			Nome:          user.Nome,
+			Email:         user.Email,
+			Role:          unidade.Role,
+			CurrentUnitID: unidade.UnitID.String(),
+			Permissions:   unidade.Permissions.List(),
+		},
+	}, refreshToken, nil
+}
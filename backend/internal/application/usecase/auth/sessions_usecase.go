@@ -0,0 +1,180 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// SESSÕES - listagem e revogação por dispositivo
+// Revogar uma sessão invalida seus refresh tokens; o access token já emitido
+// continua válido até expirar (no máximo 15 minutos).
+// =============================================================================
+
+// ListSessionsInput define o escopo da listagem de sessões
+type ListSessionsInput struct {
+	TenantID         string
+	UserID           string // vazio lista todas as sessões do tenant (apenas dono)
+	CurrentSessionID string // marca a sessão da própria requisição
+}
+
+type ListSessionsUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewListSessionsUseCase(queries *db.Queries, logger *zap.Logger) *ListSessionsUseCase {
+	return &ListSessionsUseCase{
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute lista as sessões ativas (não revogadas e não expiradas)
+func (uc *ListSessionsUseCase) Execute(ctx context.Context, input ListSessionsInput) ([]dto.SessionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.ListSessions")
+	defer span.End()
+
+	var params db.ListActiveAuthSessionsParams
+	if err := params.TenantID.Scan(input.TenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	if input.UserID != "" {
+		if err := params.UserID.Scan(input.UserID); err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	rows, err := uc.queries.ListActiveAuthSessions(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar sessões: %w", err)
+	}
+
+	sessions := make([]dto.SessionResponse, len(rows))
+	for i, r := range rows {
+		sessions[i] = dto.SessionResponse{
+			ID:         r.ID.String(),
+			UserID:     r.UserID.String(),
+			UserNome:   r.UserNome,
+			UserEmail:  r.UserEmail,
+			UserAgent:  r.UserAgent,
+			IPAddress:  r.IpAddress,
+			CreatedAt:  r.CreatedAt.Time.Format(time.RFC3339),
+			LastUsedAt: r.LastUsedAt.Time.Format(time.RFC3339),
+			ExpiresAt:  r.ExpiresAt.Time.Format(time.RFC3339),
+			Atual:      r.ID.String() == input.CurrentSessionID,
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSessionInput define a sessão a revogar e quem pede
+type RevokeSessionInput struct {
+	TenantID          string
+	UserID            string
+	SessionID         string
+	PodeRevogarOutros bool // dono do tenant encerra sessões de qualquer usuário
+}
+
+type RevokeSessionUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewRevokeSessionUseCase(queries *db.Queries, logger *zap.Logger) *RevokeSessionUseCase {
+	return &RevokeSessionUseCase{
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute revoga uma sessão. Sessões de outros usuários só podem ser revogadas
+// pelo dono; para os demais elas se comportam como inexistentes.
+func (uc *RevokeSessionUseCase) Execute(ctx context.Context, input RevokeSessionInput) error {
+	ctx, span := common.StartSpan(ctx, "auth.RevokeSession")
+	defer span.End()
+
+	var params db.GetAuthSessionParams
+	if err := params.TenantID.Scan(input.TenantID); err != nil {
+		return domain.ErrInvalidTenantID
+	}
+	if err := params.ID.Scan(input.SessionID); err != nil {
+		return domain.ErrSessaoNaoEncontrada
+	}
+
+	session, err := uc.queries.GetAuthSession(ctx, params)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrSessaoNaoEncontrada
+		}
+		return fmt.Errorf("erro ao buscar sessão: %w", err)
+	}
+	if session.UserID.String() != input.UserID && !input.PodeRevogarOutros {
+		return domain.ErrSessaoNaoEncontrada
+	}
+
+	motivo := MotivoRevogacaoManual
+	if _, err := uc.queries.RevokeAuthSession(ctx, db.RevokeAuthSessionParams{
+		ID:            session.ID,
+		RevokedReason: &motivo,
+	}); err != nil {
+		return fmt.Errorf("erro ao revogar sessão: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Sessão revogada",
+		zap.String("session_id", input.SessionID),
+		zap.String("session_user_id", session.UserID.String()),
+		zap.String("revogada_por", input.UserID),
+	)
+	return nil
+}
+
+type RevokeOtherSessionsUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewRevokeOtherSessionsUseCase(queries *db.Queries, logger *zap.Logger) *RevokeOtherSessionsUseCase {
+	return &RevokeOtherSessionsUseCase{
+		queries: queries,
+		logger:  logger,
+	}
+}
+
+// Execute encerra todas as sessões do usuário, exceto a atual.
+// Retorna a quantidade de sessões revogadas.
+func (uc *RevokeOtherSessionsUseCase) Execute(ctx context.Context, userID, currentSessionID string) (int64, error) {
+	ctx, span := common.StartSpan(ctx, "auth.RevokeOtherSessions")
+	defer span.End()
+
+	var params db.RevokeOtherAuthSessionsParams
+	if err := params.UserID.Scan(userID); err != nil {
+		return 0, domain.ErrUsuarioNaoEncontrado
+	}
+	// Sem sessão atual (token anterior ao "sid") revoga todas
+	if currentSessionID != "" {
+		if err := params.ID.Scan(currentSessionID); err != nil {
+			return 0, domain.ErrSessaoNaoEncontrada
+		}
+	}
+
+	total, err := uc.queries.RevokeOtherAuthSessions(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao revogar sessões: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Outras sessões revogadas",
+		zap.String("user_id", userID),
+		zap.Int64("total", total),
+	)
+	return total, nil
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// SWITCH UNIT USE CASE
+// Troca a unidade ativa da sessão e emite novo access token com a unidade e o
+// papel do usuário nela. O refresh token continua o mesmo: a unidade fica
+// gravada na sessão e é preservada nos próximos refreshes.
+// =============================================================================
+
+type SwitchUnitUseCase struct {
+	queries     *db.Queries
+	jwtManager  *auth.JWTManager
+	doisFatores *doisFatores
+	logger      *zap.Logger
+}
+
+func NewSwitchUnitUseCase(queries *db.Queries, jwtManager *auth.JWTManager, logger *zap.Logger) *SwitchUnitUseCase {
+	return &SwitchUnitUseCase{
+		queries:     queries,
+		jwtManager:  jwtManager,
+		doisFatores: &doisFatores{queries: queries, logger: logger},
+		logger:      logger,
+	}
+}
+
+// SwitchUnitResult é o novo access token e o papel do usuário na unidade
+type SwitchUnitResult struct {
+	AccessToken string
+	Role        string
+	Permissions []string
+}
+
+// Execute ativa unitID na sessão sessionID (vazio em tokens emitidos antes das
+// sessões por dispositivo: apenas o token é reemitido)
+func (uc *SwitchUnitUseCase) Execute(ctx context.Context, userID, sessionID, unitID string) (*SwitchUnitResult, error) {
+	ctx, span := common.StartSpan(ctx, "auth.SwitchUnit")
+	defer span.End()
+
+	var userUUID, unitUUID, sessionUUID pgtype.UUID
+	if err := userUUID.Scan(userID); err != nil {
+		return nil, domain.ErrUsuarioNaoEncontrado
+	}
+	if err := unitUUID.Scan(unitID); err != nil {
+		return nil, domain.ErrInvalidUnitID
+	}
+	if sessionID != "" {
+		if err := sessionUUID.Scan(sessionID); err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	user, err := uc.queries.GetUserByID(ctx, userUUID)
+	if err != nil {
+		return nil, domain.ErrUsuarioNaoEncontrado
+	}
+	if user.Ativo != nil && !*user.Ativo {
+		return nil, domain.ErrContaDesativada
+	}
+
+	unidade, err := buscarUnidade(ctx, uc.queries, user.ID, unitUUID)
+	if err != nil {
+		return nil, err
+	}
+	if unidade == nil {
+		return nil, entity.ErrUserUnitNaoEncontrado
+	}
+
+	// O papel na unidade pode ser coberto pela política de 2FA do tenant
+	exige, err := uc.doisFatores.politicaExige(ctx, user.TenantID, unidade.Role)
+	if err != nil {
+		return nil, err
+	}
+	if exige {
+		ativo, err := uc.doisFatores.ativo(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !ativo {
+			return nil, domain.ErrDoisFatoresObrigatorio
+		}
+	}
+
+	if sessionUUID.Valid {
+		if err := uc.queries.SetAuthSessionUnit(ctx, db.SetAuthSessionUnitParams{
+			ID:     sessionUUID,
+			UnitID: unidade.UnitID,
+		}); err != nil {
+			return nil, fmt.Errorf("erro ao atualizar unidade da sessão: %w", err)
+		}
+	}
+
+	accessToken, err := uc.jwtManager.GenerateAccessToken(
+		user.ID.String(),
+		user.TenantID.String(),
+		unidade.UnitID.String(),
+		user.Email,
+		unidade.Role,
+		sessionID,
+		unidade.Permissions.List(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar token: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Unidade ativa alterada",
+		zap.String("user_id", user.ID.String()),
+		zap.String("unit_id", unidade.UnitID.String()),
+		zap.String("role", unidade.Role),
+		zap.String("session_id", sessionID),
+	)
+
+	return &SwitchUnitResult{
+		AccessToken: accessToken,
+		Role:        unidade.Role,
+		Permissions: unidade.Permissions.List(),
+	}, nil
+}
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// AUTENTICAÇÃO EM DOIS FATORES - helpers compartilhados por login e gestão
+// TOTP (RFC 6238) pelo aplicativo autenticador ou código de recuperação.
+// =============================================================================
+
+const (
+	validadeDesafio2FA      = 5 * time.Minute
+	maxTentativas2FA        = 5
+	totalCodigosRecuperacao = 10
+	emissorTOTP             = "Barber Analytics Pro"
+)
+
+// etapa2FA indica o que falta para concluir o login depois da senha
+type etapa2FA int
+
+const (
+	etapa2FANenhuma  etapa2FA = iota // login concluído só com a senha
+	etapa2FACodigo                   // 2FA ativo: pedir o código
+	etapa2FACadastro                 // política exige 2FA e o usuário ainda não cadastrou
+)
+
+// roleExige2FA indica os papéis cobertos pela política do tenant
+// (RoleOwner e RoleManager de middleware/rbac.go)
+func roleExige2FA(role string) bool {
+	return role == roleOwner || role == roleManager
+}
+
+// soDigitos indica se o código tem o formato de um código TOTP
+func soDigitos(s string) bool {
+	if len(s) != auth.TOTPDigits {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// doisFatores concentra o acesso a segredos, códigos de recuperação, desafios
+// de login e política do tenant. cipher pode ser nil quando só a política e o
+// estado do cadastro são consultados (login e refresh).
+type doisFatores struct {
+	queries *db.Queries
+	cipher  *auth.SecretCipher
+	logger  *zap.Logger
+}
+
+// politicaExige informa se a política do tenant obriga o 2FA para algum dos
+// papéis (o usuário pode ser gerente em uma unidade e barbeiro em outra)
+func (d *doisFatores) politicaExige(ctx context.Context, tenantID pgtype.UUID, roles ...string) (bool, error) {
+	coberto := false
+	for _, role := range roles {
+		coberto = coberto || roleExige2FA(role)
+	}
+	if !coberto {
+		return false, nil
+	}
+	p, err := d.queries.GetTenantAuthPolicy(ctx, tenantID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("erro ao buscar política de autenticação: %w", err)
+	}
+	return p.Exigir2faAdmin, nil
+}
+
+// cadastro retorna o TOTP do usuário (confirmado ou pendente); nil se não houver
+func (d *doisFatores) cadastro(ctx context.Context, userID pgtype.UUID) (*db.UserTotp, error) {
+	t, err := d.queries.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao buscar 2FA do usuário: %w", err)
+	}
+	return &t, nil
+}
+
+// ativo informa se o usuário tem 2FA confirmado
+func (d *doisFatores) ativo(ctx context.Context, userID pgtype.UUID) (bool, error) {
+	t, err := d.cadastro(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	return t != nil && t.ConfirmedAt.Valid, nil
+}
+
+// etapaLogin decide se o login com senha correta precisa do segundo fator.
+// roles são os papéis efetivos do usuário nas unidades (papeisEfetivos).
+func (d *doisFatores) etapaLogin(ctx context.Context, userID, tenantID pgtype.UUID, roles []string) (etapa2FA, error) {
+	ativo, err := d.ativo(ctx, userID)
+	if err != nil {
+		return etapa2FANenhuma, err
+	}
+	if ativo {
+		return etapa2FACodigo, nil
+	}
+	exige, err := d.politicaExige(ctx, tenantID, roles...)
+	if err != nil {
+		return etapa2FANenhuma, err
+	}
+	if exige {
+		return etapa2FACadastro, nil
+	}
+	return etapa2FANenhuma, nil
+}
+
+// criarDesafio registra o login pendente do segundo fator e retorna o token opaco
+func (d *doisFatores) criarDesafio(ctx context.Context, userID pgtype.UUID) (string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	if err := d.queries.CreateMFAChallenge(ctx, db.CreateMFAChallengeParams{
+		UserID:    userID,
+		TokenHash: auth.HashRefreshToken(token),
+		ExpiresAt: pgtype.Timestamptz{Time: time.Now().Add(validadeDesafio2FA), Valid: true},
+	}); err != nil {
+		return "", fmt.Errorf("erro ao criar desafio 2FA: %w", err)
+	}
+	return token, nil
+}
+
+// buscarDesafio carrega o desafio ainda válido (não usado e não expirado)
+func (d *doisFatores) buscarDesafio(ctx context.Context, token string) (*db.AuthMfaChallenge, error) {
+	ch, err := d.queries.GetMFAChallenge(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDesafio2FAInvalido
+		}
+		return nil, fmt.Errorf("erro ao buscar desafio 2FA: %w", err)
+	}
+	return &ch, nil
+}
+
+// validarTOTP confere o código do aplicativo contra o segredo cifrado
+func (d *doisFatores) validarTOTP(t db.UserTotp, code string) (int64, bool, error) {
+	secret, err := d.cipher.Decrypt(t.SecretEnc)
+	if err != nil {
+		return 0, false, err
+	}
+	step, ok := auth.ValidateTOTP(secret, code, time.Now(), t.LastUsedStep)
+	return step, ok, nil
+}
+
+// verificarCodigo aceita um código TOTP (que não pode ser reutilizado) ou um
+// código de recuperação (consumido no uso)
+func (d *doisFatores) verificarCodigo(ctx context.Context, t db.UserTotp, code string) error {
+	code = strings.TrimSpace(code)
+
+	if soDigitos(code) {
+		step, ok, err := d.validarTOTP(t, code)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return domain.ErrCodigo2FAInvalido
+		}
+		n, err := d.queries.AdvanceUserTOTPStep(ctx, db.AdvanceUserTOTPStepParams{
+			UserID:       t.UserID,
+			LastUsedStep: step,
+		})
+		if err != nil {
+			return fmt.Errorf("erro ao registrar uso do código: %w", err)
+		}
+		if n == 0 {
+			return domain.ErrCodigo2FAInvalido
+		}
+		return nil
+	}
+
+	n, err := d.queries.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		UserID:   t.UserID,
+		CodeHash: auth.HashRecoveryCode(code),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao usar código de recuperação: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrCodigo2FAInvalido
+	}
+	common.Logger(ctx, d.logger).Warn("Código de recuperação 2FA utilizado",
+		zap.String("user_id", t.UserID.String()),
+	)
+	return nil
+}
+
+// iniciarCadastro gera um novo segredo pendente e a URI para o QR code
+func (d *doisFatores) iniciarCadastro(ctx context.Context, userID pgtype.UUID, email string) (*dto.TwoFactorSetupResponse, error) {
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	enc, err := d.cipher.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := d.queries.UpsertPendingUserTOTP(ctx, db.UpsertPendingUserTOTPParams{
+		UserID:    userID,
+		SecretEnc: enc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao salvar segredo 2FA: %w", err)
+	}
+	if n == 0 {
+		return nil, domain.ErrDoisFatoresJaAtivo
+	}
+
+	return &dto.TwoFactorSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: auth.TOTPProvisioningURI(secret, emissorTOTP, email),
+	}, nil
+}
+
+// confirmarCadastro ativa o 2FA com o primeiro código do aplicativo e devolve
+// os códigos de recuperação
+func (d *doisFatores) confirmarCadastro(ctx context.Context, userID pgtype.UUID, code string) ([]string, error) {
+	t, err := d.cadastro(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, domain.ErrDoisFatoresNaoIniciado
+	}
+	if t.ConfirmedAt.Valid {
+		return nil, domain.ErrDoisFatoresJaAtivo
+	}
+
+	step, ok, err := d.validarTOTP(*t, strings.TrimSpace(code))
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrCodigo2FAInvalido
+	}
+
+	n, err := d.queries.ConfirmUserTOTP(ctx, db.ConfirmUserTOTPParams{
+		UserID:       userID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao confirmar 2FA: %w", err)
+	}
+	if n == 0 {
+		return nil, domain.ErrDoisFatoresJaAtivo
+	}
+
+	common.Logger(ctx, d.logger).Info("2FA ativado", zap.String("user_id", userID.String()))
+	return d.gerarCodigosRecuperacao(ctx, userID)
+}
+
+// gerarCodigosRecuperacao substitui os códigos de recuperação do usuário
+func (d *doisFatores) gerarCodigosRecuperacao(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	codes, err := auth.GenerateRecoveryCodes(totalCodigosRecuperacao)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.queries.DeleteRecoveryCodes(ctx, userID); err != nil {
+		return nil, fmt.Errorf("erro ao remover códigos de recuperação: %w", err)
+	}
+	for _, c := range codes {
+		if err := d.queries.CreateRecoveryCode(ctx, db.CreateRecoveryCodeParams{
+			UserID:   userID,
+			CodeHash: auth.HashRecoveryCode(c),
+		}); err != nil {
+			return nil, fmt.Errorf("erro ao salvar código de recuperação: %w", err)
+		}
+	}
+	return codes, nil
+}
@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestRoleExige2FA(t *testing.T) {
+	assert.True(t, roleExige2FA("OWNER"))
+	assert.True(t, roleExige2FA("MANAGER"))
+	assert.False(t, roleExige2FA("RECEPTIONIST"))
+	assert.False(t, roleExige2FA("BARBER"))
+}
+
+func TestSoDigitos(t *testing.T) {
+	// Seis dígitos são tratados como TOTP; o resto como código de recuperação
+	assert.True(t, soDigitos("012345"))
+	assert.False(t, soDigitos("12345"))
+	assert.False(t, soDigitos("abcde-fghij"))
+	assert.False(t, soDigitos("12a456"))
+}
+
+// Barbeiro no cadastro e gerente numa unidade (role_override ou papel
+// personalizado): a política de 2FA vale para o papel na unidade
+func TestPoliticaDoisFatores_PapelNaUnidade(t *testing.T) {
+	ctx := context.Background()
+	jwtManager := auth.NewJWTManager()
+	uc, fake, sessionID, token := novaSessaoRefresh(t)
+	fake.user.Role = "BARBER"
+	fake.unitID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	fake.unitRole = "MANAGER"
+	fake.exigir2FA = true
+
+	t.Run("login pede o cadastro do 2FA", func(t *testing.T) {
+		hash, err := auth.HashPassword("senha-forte-123")
+		require.NoError(t, err)
+		fake.user.PasswordHash = hash
+
+		login := NewLoginUseCase(db.New(fake), jwtManager, zap.NewNop())
+		resp, refresh, err := login.Execute(ctx, dto.LoginRequest{Email: fake.user.Email, Password: "senha-forte-123"}, ClientInfo{})
+		require.NoError(t, err)
+		assert.True(t, resp.MFARequired)
+		assert.True(t, resp.MFAEnrollmentRequired)
+		assert.Empty(t, resp.AccessToken)
+		assert.Empty(t, refresh)
+	})
+
+	t.Run("troca de unidade recusada", func(t *testing.T) {
+		sw := NewSwitchUnitUseCase(db.New(fake), jwtManager, zap.NewNop())
+		_, err := sw.Execute(ctx, fake.user.ID.String(), "", fake.unitID.String())
+		assert.ErrorIs(t, err, domain.ErrDoisFatoresObrigatorio)
+	})
+
+	t.Run("refresh encerra a sessão", func(t *testing.T) {
+		_, _, err := uc.Execute(ctx, token, ClientInfo{})
+		assert.ErrorIs(t, err, domain.ErrDoisFatoresObrigatorio)
+		assert.Equal(t, MotivoRevogacaoManual, fake.revogada[sessionID])
+	})
+}
+
+func TestPoliticaDoisFatores_BarbeiroNaUnidade(t *testing.T) {
+	// Gerente no cadastro, mas barbeiro na unidade: a política não se aplica
+	uc, fake, _, token := novaSessaoRefresh(t)
+	fake.user.Role = "MANAGER"
+	fake.unitID = pgtype.UUID{Bytes: uuid.New(), Valid: true}
+	fake.unitRole = "BARBER"
+	fake.exigir2FA = true
+
+	_, _, err := uc.Execute(context.Background(), token, ClientInfo{})
+	assert.NoError(t, err)
+	assert.Empty(t, fake.revogada)
+}
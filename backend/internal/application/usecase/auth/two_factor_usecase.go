@@ -0,0 +1,446 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// 2FA - SEGUNDO PASSO DO LOGIN
+// =============================================================================
+
+type LoginMFAUseCase struct {
+	queries     *db.Queries
+	jwtManager  *auth.JWTManager
+	throttle    *loginThrottle
+	doisFatores *doisFatores
+	logger      *zap.Logger
+}
+
+func NewLoginMFAUseCase(queries *db.Queries, jwtManager *auth.JWTManager, cipher *auth.SecretCipher, logger *zap.Logger) *LoginMFAUseCase {
+	return &LoginMFAUseCase{
+		queries:     queries,
+		jwtManager:  jwtManager,
+		throttle:    &loginThrottle{queries: queries, logger: logger},
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+		logger:      logger,
+	}
+}
+
+// Execute conclui o login com o código do aplicativo (ou de recuperação).
+// Se o login exigia cadastro, o código confirma o 2FA e a response traz os
+// códigos de recuperação.
+func (uc *LoginMFAUseCase) Execute(ctx context.Context, req dto.LoginMFARequest, client ClientInfo) (*dto.LoginResponse, string, error) {
+	ctx, span := common.StartSpan(ctx, "auth.LoginMFA")
+	defer span.End()
+
+	ch, err := uc.doisFatores.buscarDesafio(ctx, req.MFAToken)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Cada desafio aceita poucas tentativas; depois é preciso refazer o login com senha
+	tentativas, err := uc.queries.IncrementMFAChallengeTentativas(ctx, ch.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao registrar tentativa 2FA: %w", err)
+	}
+	if tentativas > maxTentativas2FA {
+		_, _ = uc.queries.ConsumeMFAChallenge(ctx, ch.ID)
+		common.Logger(ctx, uc.logger).Warn("Desafio 2FA invalidado por excesso de tentativas",
+			zap.String("user_id", ch.UserID.String()),
+			zap.String("ip", client.IP),
+		)
+		return nil, "", domain.ErrDesafio2FAInvalido
+	}
+
+	user, err := uc.queries.GetUserByID(ctx, ch.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, "", domain.ErrDesafio2FAInvalido
+		}
+		return nil, "", fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	if user.Ativo != nil && !*user.Ativo {
+		return nil, "", domain.ErrContaDesativada
+	}
+
+	// Códigos errados contam no mesmo bloqueio progressivo da senha (chave da
+	// conta): refazer o login para obter outro desafio não renova as tentativas
+	chaves := chavesThrottle(user.Email, "")
+	if err := uc.throttle.verificar(ctx, chaves); err != nil {
+		return nil, "", err
+	}
+
+	cadastro, err := uc.doisFatores.cadastro(ctx, user.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var recoveryCodes []string
+	switch {
+	case cadastro == nil:
+		return nil, "", domain.ErrDoisFatoresNaoIniciado
+	case !cadastro.ConfirmedAt.Valid:
+		recoveryCodes, err = uc.doisFatores.confirmarCadastro(ctx, user.ID, req.Code)
+	default:
+		err = uc.doisFatores.verificarCodigo(ctx, *cadastro, req.Code)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrCodigo2FAInvalido) {
+			common.Logger(ctx, uc.logger).Warn("Login 2FA falhou - código inválido",
+				zap.String("user_id", user.ID.String()),
+				zap.Int32("tentativa", tentativas),
+				zap.String("ip", client.IP),
+			)
+			if bloqueio := uc.throttle.registrarFalha(ctx, chaves); bloqueio != nil {
+				return nil, "", bloqueio
+			}
+		}
+		return nil, "", err
+	}
+
+	// Só uma requisição conclui o desafio
+	n, err := uc.queries.ConsumeMFAChallenge(ctx, ch.ID)
+	if err != nil {
+		return nil, "", fmt.Errorf("erro ao concluir desafio 2FA: %w", err)
+	}
+	if n == 0 {
+		return nil, "", domain.ErrDesafio2FAInvalido
+	}
+	uc.throttle.limparConta(ctx, chaves)
+
+	response, refreshToken, err := iniciarSessao(ctx, uc.queries, uc.jwtManager, uc.logger, usuarioSessao{
+		ID:       user.ID,
+		TenantID: user.TenantID,
+		Nome:     user.Nome,
+		Email:    user.Email,
+		Role:     user.Role,
+	}, client)
+	if err != nil {
+		return nil, "", err
+	}
+	response.RecoveryCodes = recoveryCodes
+	return response, refreshToken, nil
+}
+
+type LoginMFASetupUseCase struct {
+	queries     *db.Queries
+	doisFatores *doisFatores
+}
+
+func NewLoginMFASetupUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *LoginMFASetupUseCase {
+	return &LoginMFASetupUseCase{
+		queries:     queries,
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+	}
+}
+
+// Execute gera o segredo TOTP durante o login quando a política exige o
+// cadastro; a confirmação acontece em LoginMFAUseCase
+func (uc *LoginMFASetupUseCase) Execute(ctx context.Context, mfaToken string) (*dto.TwoFactorSetupResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.LoginMFASetup")
+	defer span.End()
+
+	ch, err := uc.doisFatores.buscarDesafio(ctx, mfaToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.queries.GetUserByID(ctx, ch.UserID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrDesafio2FAInvalido
+		}
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	return uc.doisFatores.iniciarCadastro(ctx, user.ID, user.Email)
+}
+
+// =============================================================================
+// 2FA - GESTÃO PELO PRÓPRIO USUÁRIO
+// =============================================================================
+
+type GetTwoFactorStatusUseCase struct {
+	queries     *db.Queries
+	doisFatores *doisFatores
+}
+
+func NewGetTwoFactorStatusUseCase(queries *db.Queries, logger *zap.Logger) *GetTwoFactorStatusUseCase {
+	return &GetTwoFactorStatusUseCase{
+		queries:     queries,
+		doisFatores: &doisFatores{queries: queries, logger: logger},
+	}
+}
+
+// Execute retorna a situação do 2FA do usuário e a política do tenant
+func (uc *GetTwoFactorStatusUseCase) Execute(ctx context.Context, userID string) (*dto.TwoFactorStatusResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.GetTwoFactorStatus")
+	defer span.End()
+
+	user, err := buscarUsuario(ctx, uc.queries, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.TwoFactorStatusResponse{}
+	cadastro, err := uc.doisFatores.cadastro(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if cadastro != nil && cadastro.ConfirmedAt.Valid {
+		resp.Ativo = true
+		s := cadastro.ConfirmedAt.Time.Format(time.RFC3339)
+		resp.ConfirmadoEm = &s
+		if resp.CodigosRecuperacao, err = uc.queries.CountAvailableRecoveryCodes(ctx, user.ID); err != nil {
+			return nil, fmt.Errorf("erro ao contar códigos de recuperação: %w", err)
+		}
+	}
+
+	politica, err := uc.queries.GetTenantAuthPolicy(ctx, user.TenantID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("erro ao buscar política de autenticação: %w", err)
+	}
+	roles, err := papeisEfetivos(ctx, uc.queries, user.ID, user.Role)
+	if err != nil {
+		return nil, err
+	}
+	resp.PoliticaExigir2FAAdmin = politica.Exigir2faAdmin
+	for _, role := range roles {
+		if roleExige2FA(role) {
+			resp.ExigidoPelaPolitica = politica.Exigir2faAdmin
+		}
+	}
+	return resp, nil
+}
+
+type SetupTwoFactorUseCase struct {
+	queries     *db.Queries
+	doisFatores *doisFatores
+}
+
+func NewSetupTwoFactorUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *SetupTwoFactorUseCase {
+	return &SetupTwoFactorUseCase{
+		queries:     queries,
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+	}
+}
+
+// Execute inicia o cadastro do 2FA (novo segredo pendente de confirmação)
+func (uc *SetupTwoFactorUseCase) Execute(ctx context.Context, userID string) (*dto.TwoFactorSetupResponse, error) {
+	ctx, span := common.StartSpan(ctx, "auth.SetupTwoFactor")
+	defer span.End()
+
+	user, err := buscarUsuario(ctx, uc.queries, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.doisFatores.iniciarCadastro(ctx, user.ID, user.Email)
+}
+
+type ConfirmTwoFactorUseCase struct {
+	doisFatores *doisFatores
+}
+
+func NewConfirmTwoFactorUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *ConfirmTwoFactorUseCase {
+	return &ConfirmTwoFactorUseCase{
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+	}
+}
+
+// Execute ativa o 2FA com o primeiro código e retorna os códigos de recuperação
+func (uc *ConfirmTwoFactorUseCase) Execute(ctx context.Context, userID, code string) ([]string, error) {
+	ctx, span := common.StartSpan(ctx, "auth.ConfirmTwoFactor")
+	defer span.End()
+
+	var uid pgtype.UUID
+	if err := uid.Scan(userID); err != nil {
+		return nil, domain.ErrUsuarioNaoEncontrado
+	}
+	return uc.doisFatores.confirmarCadastro(ctx, uid, code)
+}
+
+type DisableTwoFactorUseCase struct {
+	queries     *db.Queries
+	doisFatores *doisFatores
+	logger      *zap.Logger
+}
+
+func NewDisableTwoFactorUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *DisableTwoFactorUseCase {
+	return &DisableTwoFactorUseCase{
+		queries:     queries,
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+		logger:      logger,
+	}
+}
+
+// Execute desativa o 2FA mediante um código válido. Não é permitido quando a
+// política do tenant exige 2FA para o papel do usuário em alguma unidade.
+func (uc *DisableTwoFactorUseCase) Execute(ctx context.Context, userID, code string) error {
+	ctx, span := common.StartSpan(ctx, "auth.DisableTwoFactor")
+	defer span.End()
+
+	user, err := buscarUsuario(ctx, uc.queries, userID)
+	if err != nil {
+		return err
+	}
+
+	roles, err := papeisEfetivos(ctx, uc.queries, user.ID, user.Role)
+	if err != nil {
+		return err
+	}
+	exige, err := uc.doisFatores.politicaExige(ctx, user.TenantID, roles...)
+	if err != nil {
+		return err
+	}
+	if exige {
+		return domain.ErrDoisFatoresObrigatorio
+	}
+
+	cadastro, err := uc.doisFatores.cadastro(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if cadastro == nil || !cadastro.ConfirmedAt.Valid {
+		return domain.ErrDoisFatoresInativo
+	}
+	if err := uc.doisFatores.verificarCodigo(ctx, *cadastro, code); err != nil {
+		return err
+	}
+
+	if err := uc.queries.DeleteUserTOTP(ctx, user.ID); err != nil {
+		return fmt.Errorf("erro ao desativar 2FA: %w", err)
+	}
+	if err := uc.queries.DeleteRecoveryCodes(ctx, user.ID); err != nil {
+		return fmt.Errorf("erro ao remover códigos de recuperação: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Warn("2FA desativado", zap.String("user_id", userID))
+	return nil
+}
+
+type RegenerateRecoveryCodesUseCase struct {
+	doisFatores *doisFatores
+	logger      *zap.Logger
+}
+
+func NewRegenerateRecoveryCodesUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *RegenerateRecoveryCodesUseCase {
+	return &RegenerateRecoveryCodesUseCase{
+		doisFatores: &doisFatores{queries: queries, cipher: cipher, logger: logger},
+		logger:      logger,
+	}
+}
+
+// Execute gera novos códigos de recuperação (os anteriores deixam de valer)
+func (uc *RegenerateRecoveryCodesUseCase) Execute(ctx context.Context, userID, code string) ([]string, error) {
+	ctx, span := common.StartSpan(ctx, "auth.RegenerateRecoveryCodes")
+	defer span.End()
+
+	var uid pgtype.UUID
+	if err := uid.Scan(userID); err != nil {
+		return nil, domain.ErrUsuarioNaoEncontrado
+	}
+
+	cadastro, err := uc.doisFatores.cadastro(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if cadastro == nil || !cadastro.ConfirmedAt.Valid {
+		return nil, domain.ErrDoisFatoresInativo
+	}
+	if err := uc.doisFatores.verificarCodigo(ctx, *cadastro, code); err != nil {
+		return nil, err
+	}
+
+	codes, err := uc.doisFatores.gerarCodigosRecuperacao(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	common.Logger(ctx, uc.logger).Info("Códigos de recuperação 2FA regenerados", zap.String("user_id", userID))
+	return codes, nil
+}
+
+// =============================================================================
+// 2FA - POLÍTICA DO TENANT
+// =============================================================================
+
+type UpdateTwoFactorPolicyUseCase struct {
+	queries     *db.Queries
+	doisFatores *doisFatores
+	logger      *zap.Logger
+}
+
+func NewUpdateTwoFactorPolicyUseCase(queries *db.Queries, logger *zap.Logger) *UpdateTwoFactorPolicyUseCase {
+	return &UpdateTwoFactorPolicyUseCase{
+		queries:     queries,
+		doisFatores: &doisFatores{queries: queries, logger: logger},
+		logger:      logger,
+	}
+}
+
+// Execute liga/desliga a exigência de 2FA para proprietários e gerentes. Para
+// ligar, quem altera precisa ter o próprio 2FA ativo (evita se trancar fora).
+// Sessões já abertas sem 2FA são encerradas no próximo refresh.
+func (uc *UpdateTwoFactorPolicyUseCase) Execute(ctx context.Context, tenantID, userID string, exigir bool) (bool, error) {
+	ctx, span := common.StartSpan(ctx, "auth.UpdateTwoFactorPolicy")
+	defer span.End()
+
+	var tid, uid pgtype.UUID
+	if err := tid.Scan(tenantID); err != nil {
+		return false, domain.ErrInvalidTenantID
+	}
+	if err := uid.Scan(userID); err != nil {
+		return false, domain.ErrUsuarioNaoEncontrado
+	}
+
+	if exigir {
+		ativo, err := uc.doisFatores.ativo(ctx, uid)
+		if err != nil {
+			return false, err
+		}
+		if !ativo {
+			return false, domain.ErrDoisFatoresPoliticaSem2FA
+		}
+	}
+
+	p, err := uc.queries.UpsertTenantAuthPolicy(ctx, db.UpsertTenantAuthPolicyParams{
+		TenantID:       tid,
+		Exigir2faAdmin: exigir,
+		AtualizadoPor:  uid,
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao salvar política de autenticação: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Política de 2FA atualizada",
+		zap.String("tenant_id", tenantID),
+		zap.String("atualizado_por", userID),
+		zap.Bool("exigir_2fa_admin", p.Exigir2faAdmin),
+	)
+	return p.Exigir2faAdmin, nil
+}
+
+// buscarUsuario carrega o usuário autenticado pelo ID do token
+func buscarUsuario(ctx context.Context, queries *db.Queries, userID string) (*db.GetUserByIDRow, error) {
+	var uid pgtype.UUID
+	if err := uid.Scan(userID); err != nil {
+		return nil, domain.ErrUsuarioNaoEncontrado
+	}
+	user, err := queries.GetUserByID(ctx, uid)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrUsuarioNaoEncontrado
+		}
+		return nil, fmt.Errorf("erro ao buscar usuário: %w", err)
+	}
+	return &user, nil
+}
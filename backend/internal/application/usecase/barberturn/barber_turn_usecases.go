@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -37,29 +38,32 @@ func NewListBarberTurnUseCase(repo port.BarberTurnRepository, logger *zap.Logger
 
 // Execute lista todos os barbeiros na fila
 func (uc *ListBarberTurnUseCase) Execute(ctx context.Context, tenantID string, isActive *bool) (*dto.ListBarbersTurnResponse, error) {
-	uc.logger.Info("🔍 [DEBUG] ListBarberTurnUseCase.Execute iniciado",
+	ctx, span := common.StartSpan(ctx, "barberturn.ListBarberTurn")
+	defer span.End()
+
+	common.Logger(ctx, uc.logger).Info("🔍 [DEBUG] ListBarberTurnUseCase.Execute iniciado",
 		zap.String("tenantID", tenantID),
 		zap.Any("isActive", isActive))
 
 	// Buscar lista de barbeiros
 	barbers, err := uc.repo.List(ctx, tenantID, isActive)
 	if err != nil {
-		uc.logger.Error("erro ao listar barbeiros na fila", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar barbeiros na fila", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("🔍 [DEBUG] Barbeiros retornados do repositório",
+	common.Logger(ctx, uc.logger).Info("🔍 [DEBUG] Barbeiros retornados do repositório",
 		zap.Int("count", len(barbers)),
 		zap.Any("barbers", barbers))
 
 	// Buscar estatísticas
 	stats, err := uc.repo.GetStats(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar estatísticas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar estatísticas", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("🔍 [DEBUG] Estatísticas retornadas", zap.Any("stats", stats))
+	common.Logger(ctx, uc.logger).Info("🔍 [DEBUG] Estatísticas retornadas", zap.Any("stats", stats))
 
 	// Buscar próximo barbeiro
 	var nextBarber *dto.NextBarberResponse
@@ -90,7 +94,7 @@ func (uc *ListBarberTurnUseCase) Execute(ctx context.Context, tenantID string, i
 		response.Barbers = append(response.Barbers, mapBarberTurnToResponse(b))
 	}
 
-	uc.logger.Info("🔍 [DEBUG] Resposta final montada",
+	common.Logger(ctx, uc.logger).Info("🔍 [DEBUG] Resposta final montada",
 		zap.Int("total_barbers", len(response.Barbers)),
 		zap.Any("response", response))
 
@@ -117,10 +121,13 @@ func NewAddBarberToTurnListUseCase(repo port.BarberTurnRepository, logger *zap.L
 
 // Execute adiciona um barbeiro à lista da vez
 func (uc *AddBarberToTurnListUseCase) Execute(ctx context.Context, tenantID string, req dto.AddBarberToTurnListRequest) (*dto.BarberTurnResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.AddBarberToTurnList")
+	defer span.End()
+
 	// Verificar se profissional é do tipo BARBEIRO
 	isBarber, err := uc.repo.CheckProfessionalIsBarber(ctx, tenantID, req.ProfessionalID)
 	if err != nil {
-		uc.logger.Error("erro ao verificar tipo do profissional", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao verificar tipo do profissional", zap.Error(err))
 		return nil, err
 	}
 	if !isBarber {
@@ -130,7 +137,7 @@ func (uc *AddBarberToTurnListUseCase) Execute(ctx context.Context, tenantID stri
 	// Verificar se já está na lista
 	inList, err := uc.repo.CheckProfessionalInList(ctx, tenantID, req.ProfessionalID)
 	if err != nil {
-		uc.logger.Error("erro ao verificar se profissional está na lista", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao verificar se profissional está na lista", zap.Error(err))
 		return nil, err
 	}
 	if inList {
@@ -145,18 +152,18 @@ func (uc *AddBarberToTurnListUseCase) Execute(ctx context.Context, tenantID stri
 
 	// Persistir
 	if err := uc.repo.Add(ctx, barberTurn); err != nil {
-		uc.logger.Error("erro ao adicionar barbeiro à fila", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao adicionar barbeiro à fila", zap.Error(err))
 		return nil, err
 	}
 
 	// Buscar dados completos (com JOIN)
 	result, err := uc.repo.FindByProfessionalID(ctx, tenantID, req.ProfessionalID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar barbeiro adicionado", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar barbeiro adicionado", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("barbeiro adicionado à lista da vez",
+	common.Logger(ctx, uc.logger).Info("barbeiro adicionado à lista da vez",
 		zap.String("professional_id", req.ProfessionalID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -185,10 +192,13 @@ func NewRecordTurnUseCase(repo port.BarberTurnRepository, logger *zap.Logger) *R
 
 // Execute registra um atendimento para um barbeiro
 func (uc *RecordTurnUseCase) Execute(ctx context.Context, tenantID string, req dto.RecordTurnRequest) (*dto.RecordTurnResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.RecordTurn")
+	defer span.End()
+
 	// Buscar barbeiro atual
 	barber, err := uc.repo.FindByProfessionalID(ctx, tenantID, req.ProfessionalID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar barbeiro", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar barbeiro", zap.Error(err))
 		return nil, domain.ErrBarberTurnNotFound
 	}
 
@@ -203,11 +213,11 @@ func (uc *RecordTurnUseCase) Execute(ctx context.Context, tenantID string, req d
 	// Registrar atendimento
 	result, err := uc.repo.RecordTurn(ctx, tenantID, req.ProfessionalID)
 	if err != nil {
-		uc.logger.Error("erro ao registrar atendimento", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao registrar atendimento", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("atendimento registrado",
+	common.Logger(ctx, uc.logger).Info("atendimento registrado",
 		zap.String("professional_id", req.ProfessionalID),
 		zap.String("tenant_id", tenantID),
 		zap.Int("previous_points", previousPoints),
@@ -244,17 +254,20 @@ func NewToggleBarberStatusUseCase(repo port.BarberTurnRepository, logger *zap.Lo
 
 // Execute alterna o status de um barbeiro
 func (uc *ToggleBarberStatusUseCase) Execute(ctx context.Context, tenantID, professionalID string) (*dto.ToggleStatusResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.ToggleBarberStatus")
+	defer span.End()
+
 	// Verificar se existe
 	barber, err := uc.repo.FindByProfessionalID(ctx, tenantID, professionalID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar barbeiro", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar barbeiro", zap.Error(err))
 		return nil, domain.ErrBarberTurnNotFound
 	}
 
 	// Alternar status
 	result, err := uc.repo.ToggleStatus(ctx, tenantID, professionalID)
 	if err != nil {
-		uc.logger.Error("erro ao alternar status", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao alternar status", zap.Error(err))
 		return nil, err
 	}
 
@@ -265,7 +278,7 @@ func (uc *ToggleBarberStatusUseCase) Execute(ctx context.Context, tenantID, prof
 		msg = "Barbeiro pausado na fila"
 	}
 
-	uc.logger.Info("status do barbeiro alterado",
+	common.Logger(ctx, uc.logger).Info("status do barbeiro alterado",
 		zap.String("professional_id", professionalID),
 		zap.String("tenant_id", tenantID),
 		zap.Bool("is_active", result.IsActive),
@@ -299,20 +312,23 @@ func NewRemoveBarberFromTurnListUseCase(repo port.BarberTurnRepository, logger *
 
 // Execute remove um barbeiro da lista
 func (uc *RemoveBarberFromTurnListUseCase) Execute(ctx context.Context, tenantID, professionalID string) (*dto.RemoveBarberResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.RemoveBarberFromTurnList")
+	defer span.End()
+
 	// Verificar se existe
 	_, err := uc.repo.FindByProfessionalID(ctx, tenantID, professionalID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar barbeiro", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar barbeiro", zap.Error(err))
 		return nil, domain.ErrBarberTurnNotFound
 	}
 
 	// Remover
 	if err := uc.repo.Remove(ctx, tenantID, professionalID); err != nil {
-		uc.logger.Error("erro ao remover barbeiro", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao remover barbeiro", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("barbeiro removido da lista da vez",
+	common.Logger(ctx, uc.logger).Info("barbeiro removido da lista da vez",
 		zap.String("professional_id", professionalID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -343,10 +359,13 @@ func NewResetTurnListUseCase(repo port.BarberTurnRepository, logger *zap.Logger)
 
 // Execute executa reset mensal da lista
 func (uc *ResetTurnListUseCase) Execute(ctx context.Context, tenantID string, saveHistory bool) (*dto.ResetTurnListResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.ResetTurnList")
+	defer span.End()
+
 	// Buscar estatísticas atuais antes do reset
 	stats, err := uc.repo.GetStats(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar estatísticas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar estatísticas", zap.Error(err))
 		return nil, err
 	}
 
@@ -358,7 +377,7 @@ func (uc *ResetTurnListUseCase) Execute(ctx context.Context, tenantID string, sa
 	if saveHistory {
 		// Salvar histórico
 		if err := uc.repo.SaveHistoryBeforeReset(ctx, tenantID, monthYear); err != nil {
-			uc.logger.Error("erro ao salvar histórico", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao salvar histórico", zap.Error(err))
 			return nil, err
 		}
 
@@ -372,11 +391,11 @@ func (uc *ResetTurnListUseCase) Execute(ctx context.Context, tenantID string, sa
 
 	// Executar reset
 	if err := uc.repo.ResetAll(ctx, tenantID); err != nil {
-		uc.logger.Error("erro ao executar reset", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao executar reset", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("reset mensal executado",
+	common.Logger(ctx, uc.logger).Info("reset mensal executado",
 		zap.String("tenant_id", tenantID),
 		zap.String("month_year", monthYear),
 		zap.Int64("total_points_reset", stats.TotalPontosMes),
@@ -408,9 +427,12 @@ func NewGetTurnHistoryUseCase(repo port.BarberTurnRepository, logger *zap.Logger
 
 // Execute busca histórico
 func (uc *GetTurnHistoryUseCase) Execute(ctx context.Context, tenantID string, monthYear *string) (*dto.ListTurnHistoryResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.GetTurnHistory")
+	defer span.End()
+
 	history, err := uc.repo.ListHistory(ctx, tenantID, monthYear)
 	if err != nil {
-		uc.logger.Error("erro ao buscar histórico", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar histórico", zap.Error(err))
 		return nil, err
 	}
 
@@ -455,9 +477,12 @@ func NewGetHistorySummaryUseCase(repo port.BarberTurnRepository, logger *zap.Log
 
 // Execute busca resumo do histórico
 func (uc *GetHistorySummaryUseCase) Execute(ctx context.Context, tenantID string) (*dto.ListHistorySummaryResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.GetHistorySummary")
+	defer span.End()
+
 	summary, err := uc.repo.GetHistorySummary(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar resumo do histórico", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar resumo do histórico", zap.Error(err))
 		return nil, err
 	}
 
@@ -497,9 +522,12 @@ func NewGetAvailableBarbersUseCase(repo port.BarberTurnRepository, logger *zap.L
 
 // Execute lista barbeiros disponíveis
 func (uc *GetAvailableBarbersUseCase) Execute(ctx context.Context, tenantID string) (*dto.ListAvailableBarbersResponse, error) {
+	ctx, span := common.StartSpan(ctx, "barberturn.GetAvailableBarbers")
+	defer span.End()
+
 	barbers, err := uc.repo.GetAvailableBarbers(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar barbeiros disponíveis", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar barbeiros disponíveis", zap.Error(err))
 		return nil, err
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 	"github.com/google/uuid"
@@ -39,6 +40,9 @@ func NewCreateBlockedTimeUseCase(blockedTimeRepo repository.BlockedTimeRepositor
 
 // Execute executa o use case
 func (uc *CreateBlockedTimeUseCase) Execute(ctx context.Context, input CreateBlockedTimeInput) (*CreateBlockedTimeOutput, error) {
+	ctx, span := common.StartSpan(ctx, "blockedtime.CreateBlockedTime")
+	defer span.End()
+
 	// Converter tenant_id de string para uuid.UUID
 	tenantUUID, err := uuid.Parse(input.TenantID)
 	if err != nil {
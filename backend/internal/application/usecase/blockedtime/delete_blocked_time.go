@@ -3,6 +3,7 @@ package blockedtime
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
 
@@ -26,6 +27,9 @@ func NewDeleteBlockedTimeUseCase(blockedTimeRepo repository.BlockedTimeRepositor
 
 // Execute executa o use case
 func (uc *DeleteBlockedTimeUseCase) Execute(ctx context.Context, input DeleteBlockedTimeInput) error {
+	ctx, span := common.StartSpan(ctx, "blockedtime.DeleteBlockedTime")
+	defer span.End()
+
 	// Verifica se existe
 	_, err := uc.blockedTimeRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -35,6 +36,9 @@ func NewListBlockedTimesUseCase(blockedTimeRepo repository.BlockedTimeRepository
 
 // Execute executa o use case
 func (uc *ListBlockedTimesUseCase) Execute(ctx context.Context, input ListBlockedTimesInput) (*ListBlockedTimesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "blockedtime.ListBlockedTimes")
+	defer span.End()
+
 	blockedTimes, err := uc.blockedTimeRepo.List(
 		ctx,
 		input.TenantID,
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -18,6 +19,8 @@ type AbrirCaixaInput struct {
 	TenantID     uuid.UUID
 	UsuarioID    uuid.UUID
 	SaldoInicial decimal.Decimal
+	// FechamentoCego oculta o saldo esperado do operador até a contagem do fechamento
+	FechamentoCego bool
 }
 
 // AbrirCaixaUseCase implementa a abertura de caixa
@@ -36,6 +39,9 @@ func NewAbrirCaixaUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger) *
 
 // Execute abre um novo caixa diário
 func (uc *AbrirCaixaUseCase) Execute(ctx context.Context, input AbrirCaixaInput) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.AbrirCaixa")
+	defer span.End()
+
 	// Validar tenant_id
 	if input.TenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
@@ -49,7 +55,7 @@ func (uc *AbrirCaixaUseCase) Execute(ctx context.Context, input AbrirCaixaInput)
 	// Verificar se já existe caixa aberto (RN-CAI-001)
 	caixaAberto, err := uc.repo.FindAberto(ctx, input.TenantID)
 	if err != nil && err != domain.ErrCaixaNaoAberto {
-		uc.logger.Error("Erro ao verificar caixa aberto",
+		common.Logger(ctx, uc.logger).Error("Erro ao verificar caixa aberto",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID.String()),
 		)
@@ -57,36 +63,47 @@ func (uc *AbrirCaixaUseCase) Execute(ctx context.Context, input AbrirCaixaInput)
 	}
 
 	if caixaAberto != nil {
-		uc.logger.Warn("Tentativa de abrir caixa com outro já aberto",
+		common.Logger(ctx, uc.logger).Warn("Tentativa de abrir caixa com outro já aberto",
 			zap.String("tenant_id", input.TenantID.String()),
 			zap.String("caixa_aberto_id", caixaAberto.ID.String()),
 		)
 		return nil, domain.ErrCaixaJaAberto
 	}
 
+	// Caixa contado com divergência pendente ainda pode voltar para recontagem
+	pendentes, err := uc.repo.ListAguardandoAprovacao(ctx, input.TenantID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar caixas aguardando aprovação: %w", err)
+	}
+	if len(pendentes) > 0 {
+		return nil, domain.ErrCaixaAguardandoAprovacao
+	}
+
 	// Criar novo caixa
 	caixa, err := entity.NewCaixaDiario(input.TenantID, input.UsuarioID, input.SaldoInicial)
 	if err != nil {
-		uc.logger.Error("Erro ao criar entidade CaixaDiario",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar entidade CaixaDiario",
 			zap.Error(err),
 		)
 		return nil, fmt.Errorf("erro ao criar caixa: %w", err)
 	}
+	caixa.FechamentoCego = input.FechamentoCego
 
 	// Persistir
 	if err := uc.repo.Create(ctx, caixa); err != nil {
-		uc.logger.Error("Erro ao persistir caixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao persistir caixa",
 			zap.Error(err),
 			zap.String("caixa_id", caixa.ID.String()),
 		)
 		return nil, fmt.Errorf("erro ao salvar caixa: %w", err)
 	}
 
-	uc.logger.Info("Caixa aberto com sucesso",
+	common.Logger(ctx, uc.logger).Info("Caixa aberto com sucesso",
 		zap.String("caixa_id", caixa.ID.String()),
 		zap.String("tenant_id", input.TenantID.String()),
 		zap.String("usuario_id", input.UsuarioID.String()),
 		zap.String("saldo_inicial", input.SaldoInicial.String()),
+		zap.Bool("fechamento_cego", input.FechamentoCego),
 	)
 
 	return caixa, nil
@@ -0,0 +1,150 @@
+package caixa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ListFechamentosPendentesUseCase lista os caixas com divergência aguardando aprovação
+type ListFechamentosPendentesUseCase struct {
+	repo port.CaixaDiarioRepository
+}
+
+// NewListFechamentosPendentesUseCase cria nova instância do use case
+func NewListFechamentosPendentesUseCase(repo port.CaixaDiarioRepository) *ListFechamentosPendentesUseCase {
+	return &ListFechamentosPendentesUseCase{repo: repo}
+}
+
+// Execute retorna os fechamentos pendentes do tenant
+func (uc *ListFechamentosPendentesUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.ListFechamentosPendentes")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.ListAguardandoAprovacao(ctx, tenantID)
+}
+
+// DecisaoFechamentoInput define os dados da aprovação/rejeição de um fechamento
+type DecisaoFechamentoInput struct {
+	TenantID  uuid.UUID
+	CaixaID   uuid.UUID
+	UsuarioID uuid.UUID // gerente que decide
+	Motivo    string    // obrigatório apenas na rejeição
+}
+
+// AprovarFechamentoUseCase conclui o fechamento com divergência acima do limite
+type AprovarFechamentoUseCase struct {
+	repo   port.CaixaDiarioRepository
+	events port.EventPublisher
+	logger *zap.Logger
+}
+
+// NewAprovarFechamentoUseCase cria nova instância do use case
+func NewAprovarFechamentoUseCase(repo port.CaixaDiarioRepository, events port.EventPublisher, logger *zap.Logger) *AprovarFechamentoUseCase {
+	return &AprovarFechamentoUseCase{repo: repo, events: events, logger: logger}
+}
+
+// Execute aprova a divergência e marca o caixa como FECHADO
+func (uc *AprovarFechamentoUseCase) Execute(ctx context.Context, input DecisaoFechamentoInput) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.AprovarFechamento")
+	defer span.End()
+
+	caixa, err := buscarFechamentoPendente(ctx, uc.repo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := caixa.Aprovar(input.UsuarioID); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Aprovar(ctx, caixa); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Divergência de fechamento aprovada",
+		zap.String("caixa_id", caixa.ID.String()),
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("aprovado_por", input.UsuarioID.String()),
+		zap.String("divergencia", caixa.Divergencia.String()),
+	)
+
+	publicarCaixaFechado(ctx, uc.events, uc.logger, caixa)
+	return caixa, nil
+}
+
+// RejeitarFechamentoUseCase devolve o caixa ao operador para nova contagem
+type RejeitarFechamentoUseCase struct {
+	repo   port.CaixaDiarioRepository
+	logger *zap.Logger
+}
+
+// NewRejeitarFechamentoUseCase cria nova instância do use case
+func NewRejeitarFechamentoUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger) *RejeitarFechamentoUseCase {
+	return &RejeitarFechamentoUseCase{repo: repo, logger: logger}
+}
+
+// Execute reabre o caixa descartando a contagem anterior
+func (uc *RejeitarFechamentoUseCase) Execute(ctx context.Context, input DecisaoFechamentoInput) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.RejeitarFechamento")
+	defer span.End()
+
+	if len(input.Motivo) < 5 {
+		return nil, domain.ErrCaixaMotivoRejeicaoCurto
+	}
+
+	caixa, err := buscarFechamentoPendente(ctx, uc.repo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	divergencia := caixa.Divergencia.String()
+	if err := caixa.Reabrir(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Reabrir(ctx, caixa); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Warn("Fechamento de caixa rejeitado para recontagem",
+		zap.String("caixa_id", caixa.ID.String()),
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("rejeitado_por", input.UsuarioID.String()),
+		zap.String("divergencia", divergencia),
+		zap.String("motivo", input.Motivo),
+	)
+	return caixa, nil
+}
+
+// buscarFechamentoPendente valida a entrada e carrega o caixa aguardando aprovação com as operações
+func buscarFechamentoPendente(ctx context.Context, repo port.CaixaDiarioRepository, input DecisaoFechamentoInput) (*entity.CaixaDiario, error) {
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.UsuarioID == uuid.Nil {
+		return nil, fmt.Errorf("usuario_id é obrigatório")
+	}
+
+	caixa, err := repo.FindByID(ctx, input.CaixaID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if caixa.Status != entity.StatusCaixaAguardandoAprovacao {
+		return nil, domain.ErrCaixaNaoAguardaAprovacao
+	}
+
+	operacoes, err := repo.ListOperacoes(ctx, caixa.ID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	caixa.Operacoes = operacoes
+	return caixa, nil
+}
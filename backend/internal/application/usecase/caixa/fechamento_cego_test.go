@@ -0,0 +1,133 @@
+package caixa
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// caixaRepoFake implementa apenas os métodos usados no fechamento
+type caixaRepoFake struct {
+	port.CaixaDiarioRepository
+	caixa     *entity.CaixaDiario
+	operacoes []entity.OperacaoCaixa
+}
+
+func (f *caixaRepoFake) FindAberto(_ context.Context, _ uuid.UUID) (*entity.CaixaDiario, error) {
+	if f.caixa == nil || f.caixa.Status != entity.StatusCaixaAberto {
+		return nil, domain.ErrCaixaNaoAberto
+	}
+	return f.caixa, nil
+}
+
+func (f *caixaRepoFake) FindByID(_ context.Context, _, _ uuid.UUID) (*entity.CaixaDiario, error) {
+	return f.caixa, nil
+}
+
+func (f *caixaRepoFake) ListOperacoes(_ context.Context, _, _ uuid.UUID) ([]entity.OperacaoCaixa, error) {
+	return f.operacoes, nil
+}
+
+func (f *caixaRepoFake) Fechar(_ context.Context, _ *entity.CaixaDiario) error  { return nil }
+func (f *caixaRepoFake) Aprovar(_ context.Context, _ *entity.CaixaDiario) error { return nil }
+func (f *caixaRepoFake) Reabrir(_ context.Context, _ *entity.CaixaDiario) error { return nil }
+
+// eventosFake registra os eventos publicados
+type eventosFake struct {
+	tipos []port.EventType
+}
+
+func (f *eventosFake) Publish(_ context.Context, evt port.DomainEvent) error {
+	f.tipos = append(f.tipos, evt.Type)
+	return nil
+}
+
+// novoCaixaComVendas abre um caixa cego com R$ 100 de troco, R$ 150 em dinheiro e R$ 80 no PIX
+func novoCaixaComVendas(t *testing.T) *caixaRepoFake {
+	tenantID, operadorID := uuid.New(), uuid.New()
+	cx, err := entity.NewCaixaDiario(tenantID, operadorID, decimal.NewFromInt(100))
+	require.NoError(t, err)
+	cx.FechamentoCego = true
+
+	repo := &caixaRepoFake{caixa: cx}
+	for _, v := range []struct {
+		valor int64
+		forma entity.TipoPagamento
+	}{{150, entity.TipoPagamentoDinheiro}, {80, entity.TipoPagamentoPIX}} {
+		op, err := entity.NewOperacaoVenda(cx.ID, tenantID, operadorID, decimal.NewFromInt(v.valor), "Comanda", v.forma)
+		require.NoError(t, err)
+		require.NoError(t, cx.RegistrarEntrada(op.Valor))
+		repo.operacoes = append(repo.operacoes, *op)
+	}
+	return repo
+}
+
+func contagem(t *testing.T, itens map[string]int) *entity.ContagemCaixa {
+	lista := make([]entity.ItemContagem, 0, len(itens))
+	for d, q := range itens {
+		lista = append(lista, entity.ItemContagem{Denominacao: decimal.RequireFromString(d), Quantidade: q})
+	}
+	c, err := entity.NewContagemCaixa(lista)
+	require.NoError(t, err)
+	return c
+}
+
+func TestFecharCaixaCegoComContagem(t *testing.T) {
+	repo := novoCaixaComVendas(t)
+	uc := NewFecharCaixaUseCase(repo, nil, zap.NewNop())
+	input := FecharCaixaInput{TenantID: repo.caixa.TenantID, UsuarioID: uuid.New()}
+
+	// Fechamento cego exige contagem
+	_, err := uc.Execute(context.Background(), input)
+	assert.ErrorIs(t, err, domain.ErrCaixaContagemObrigatoria)
+
+	// Gaveta: 100 + 150 em dinheiro = 250, contado 2×100 + 1×50 + 2×0,50 = 251
+	input.Contagem = contagem(t, map[string]int{"100": 2, "50": 1, "0.50": 2})
+	cx, err := uc.Execute(context.Background(), input)
+	require.NoError(t, err)
+
+	assert.Equal(t, entity.StatusCaixaFechado, cx.Status)
+	assert.Equal(t, "250", cx.SaldoEsperadoDinheiro().String())
+	assert.Equal(t, "331", cx.SaldoReal.String()) // 251 contados + 80 no PIX
+	assert.Equal(t, "1", cx.Divergencia.String())
+	assert.Equal(t, "1", cx.Contagem.TotalMoedas().String())
+	assert.Nil(t, cx.UsuarioAprovacaoID)
+}
+
+func TestFecharCaixaDivergenciaAguardaAprovacao(t *testing.T) {
+	repo := novoCaixaComVendas(t)
+	justificativa := "faltou troco"
+	input := FecharCaixaInput{
+		TenantID:      repo.caixa.TenantID,
+		UsuarioID:     uuid.New(),
+		Contagem:      contagem(t, map[string]int{"200": 1}), // faltam R$ 50
+		Justificativa: &justificativa,
+	}
+
+	eventos := &eventosFake{}
+	cx, err := NewFecharCaixaUseCase(repo, eventos, zap.NewNop()).Execute(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusCaixaAguardandoAprovacao, cx.Status)
+	assert.Empty(t, eventos.tipos, "caixa aguardando aprovação ainda não fechou")
+
+	decisao := DecisaoFechamentoInput{TenantID: cx.TenantID, CaixaID: cx.ID, UsuarioID: uuid.New(), Motivo: "ok"}
+	_, err = NewRejeitarFechamentoUseCase(repo, zap.NewNop()).Execute(context.Background(), decisao)
+	assert.ErrorIs(t, err, domain.ErrCaixaMotivoRejeicaoCurto)
+
+	cx, err = NewAprovarFechamentoUseCase(repo, eventos, zap.NewNop()).Execute(context.Background(), decisao)
+	require.NoError(t, err)
+	assert.Equal(t, entity.StatusCaixaFechado, cx.Status)
+	assert.Equal(t, decisao.UsuarioID, *cx.UsuarioAprovacaoID)
+	assert.Equal(t, []port.EventType{port.EventCaixaClosed}, eventos.tipos)
+
+	_, err = NewAprovarFechamentoUseCase(repo, nil, zap.NewNop()).Execute(context.Background(), decisao)
+	assert.ErrorIs(t, err, domain.ErrCaixaNaoAguardaAprovacao)
+}
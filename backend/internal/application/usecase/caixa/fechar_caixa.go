@@ -3,7 +3,9 @@ package caixa
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -12,30 +14,40 @@ import (
 	"go.uber.org/zap"
 )
 
-// FecharCaixaInput define os dados de entrada para fechamento
+// FecharCaixaInput define os dados de entrada para fechamento.
+// Com Contagem, o saldo real vem da soma das cédulas e moedas; sem ela, de SaldoReal.
 type FecharCaixaInput struct {
 	TenantID      uuid.UUID
 	UsuarioID     uuid.UUID
 	SaldoReal     decimal.Decimal
+	Contagem      *entity.ContagemCaixa
 	Justificativa *string
+	// PodeAprovar indica que quem fecha é gerente: divergências acima do limite
+	// são aprovadas no próprio fechamento em vez de aguardar aprovação
+	PodeAprovar bool
 }
 
 // FecharCaixaUseCase implementa o fechamento de caixa
 type FecharCaixaUseCase struct {
 	repo   port.CaixaDiarioRepository
+	events port.EventPublisher
 	logger *zap.Logger
 }
 
 // NewFecharCaixaUseCase cria nova instância do use case
-func NewFecharCaixaUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger) *FecharCaixaUseCase {
+func NewFecharCaixaUseCase(repo port.CaixaDiarioRepository, events port.EventPublisher, logger *zap.Logger) *FecharCaixaUseCase {
 	return &FecharCaixaUseCase{
 		repo:   repo,
+		events: events,
 		logger: logger,
 	}
 }
 
 // Execute fecha o caixa diário atual
 func (uc *FecharCaixaUseCase) Execute(ctx context.Context, input FecharCaixaInput) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.FecharCaixa")
+	defer span.End()
+
 	// Validações
 	if input.TenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
@@ -56,10 +68,18 @@ func (uc *FecharCaixaUseCase) Execute(ctx context.Context, input FecharCaixaInpu
 		return nil, fmt.Errorf("erro ao buscar caixa aberto: %w", err)
 	}
 
+	if caixa.FechamentoCego && input.Contagem == nil {
+		return nil, domain.ErrCaixaContagemObrigatoria
+	}
+
 	// Carregar operações para recálculo se necessário
 	operacoes, err := uc.repo.ListOperacoes(ctx, caixa.ID, input.TenantID)
 	if err != nil {
-		uc.logger.Warn("Erro ao carregar operações para recálculo",
+		// A contagem separa dinheiro de PIX/cartão pelas operações: sem elas a divergência sairia errada
+		if input.Contagem != nil {
+			return nil, fmt.Errorf("erro ao carregar operações do caixa: %w", err)
+		}
+		common.Logger(ctx, uc.logger).Warn("Erro ao carregar operações para recálculo",
 			zap.Error(err),
 		)
 		// Continuar sem operações - usar valores do caixa
@@ -67,7 +87,11 @@ func (uc *FecharCaixaUseCase) Execute(ctx context.Context, input FecharCaixaInpu
 	caixa.Operacoes = operacoes
 
 	// Fechar caixa (calcula divergência automaticamente)
-	err = caixa.Fechar(input.UsuarioID, input.SaldoReal, input.Justificativa)
+	if input.Contagem != nil {
+		err = caixa.FecharComContagem(input.UsuarioID, input.Contagem, input.Justificativa)
+	} else {
+		err = caixa.Fechar(input.UsuarioID, input.SaldoReal, input.Justificativa)
+	}
 	if err != nil {
 		// Verificar se é erro de justificativa obrigatória
 		if err.Error() == "justificativa obrigatória para divergência maior que R$ 5,00" {
@@ -75,6 +99,7 @@ func (uc *FecharCaixaUseCase) Execute(ctx context.Context, input FecharCaixaInpu
 		}
 		return nil, fmt.Errorf("erro ao fechar caixa: %w", err)
 	}
+	caixa.ResolverAprovacao(input.UsuarioID, input.PodeAprovar)
 
 	// Persistir fechamento
 	if err := uc.repo.Fechar(ctx, caixa); err != nil {
@@ -86,18 +111,53 @@ func (uc *FecharCaixaUseCase) Execute(ctx context.Context, input FecharCaixaInpu
 		zap.String("caixa_id", caixa.ID.String()),
 		zap.String("tenant_id", input.TenantID.String()),
 		zap.String("saldo_esperado", caixa.SaldoEsperado.String()),
-		zap.String("saldo_real", input.SaldoReal.String()),
+		zap.String("saldo_real", caixa.SaldoReal.String()),
+		zap.String("status", string(caixa.Status)),
 	}
 
 	if caixa.Divergencia != nil {
 		logFields = append(logFields, zap.String("divergencia", caixa.Divergencia.String()))
 	}
 
-	if caixa.TemDivergencia() {
-		uc.logger.Warn("Caixa fechado com divergência", logFields...)
+	if caixa.Status == entity.StatusCaixaAguardandoAprovacao {
+		common.Logger(ctx, uc.logger).Warn("Caixa contado aguardando aprovação da divergência", logFields...)
+	} else if caixa.TemDivergencia() {
+		common.Logger(ctx, uc.logger).Warn("Caixa fechado com divergência", logFields...)
 	} else {
-		uc.logger.Info("Caixa fechado com sucesso", logFields...)
+		common.Logger(ctx, uc.logger).Info("Caixa fechado com sucesso", logFields...)
+	}
+
+	// Aguardando aprovação o caixa ainda não fechou: o evento sai na aprovação
+	if caixa.Status == entity.StatusCaixaFechado {
+		publicarCaixaFechado(ctx, uc.events, uc.logger, caixa)
 	}
 
 	return caixa, nil
 }
+
+// publicarCaixaFechado emite caixa.closed com os totais do caixa já FECHADO
+func publicarCaixaFechado(ctx context.Context, events port.EventPublisher, logger *zap.Logger, caixa *entity.CaixaDiario) {
+	evento := map[string]any{
+		"caixa_id":       caixa.ID.String(),
+		"status":         string(caixa.Status),
+		"saldo_inicial":  caixa.SaldoInicial.String(),
+		"saldo_esperado": caixa.SaldoEsperado.String(),
+		"total_entradas": caixa.TotalEntradas.String(),
+		"total_saidas":   caixa.TotalSaidas.String(),
+		"data_abertura":  caixa.DataAbertura.Format(time.RFC3339),
+	}
+	if caixa.SaldoReal != nil {
+		evento["saldo_real"] = caixa.SaldoReal.String()
+	}
+	if caixa.Divergencia != nil {
+		evento["divergencia"] = caixa.Divergencia.String()
+	}
+	if caixa.DataFechamento != nil {
+		evento["data_fechamento"] = caixa.DataFechamento.Format(time.RFC3339)
+	}
+	common.PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventCaixaClosed,
+		TenantID: caixa.TenantID.String(),
+		Data:     evento,
+	})
+}
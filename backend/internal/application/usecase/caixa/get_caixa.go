@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -27,6 +28,9 @@ func NewGetCaixaAbertoUseCase(repo port.CaixaDiarioRepository, logger *zap.Logge
 
 // Execute retorna o caixa aberto com operações
 func (uc *GetCaixaAbertoUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.GetCaixaAberto")
+	defer span.End()
+
 	if tenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -42,7 +46,7 @@ func (uc *GetCaixaAbertoUseCase) Execute(ctx context.Context, tenantID uuid.UUID
 	// Carregar operações
 	operacoes, err := uc.repo.ListOperacoes(ctx, caixa.ID, tenantID)
 	if err != nil {
-		uc.logger.Warn("Erro ao carregar operações do caixa",
+		common.Logger(ctx, uc.logger).Warn("Erro ao carregar operações do caixa",
 			zap.Error(err),
 			zap.String("caixa_id", caixa.ID.String()),
 		)
@@ -70,6 +74,9 @@ func NewGetCaixaByIDUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger)
 
 // Execute retorna um caixa por ID com operações
 func (uc *GetCaixaByIDUseCase) Execute(ctx context.Context, caixaID, tenantID uuid.UUID) (*entity.CaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.GetCaixaByID")
+	defer span.End()
+
 	if tenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -85,7 +92,7 @@ func (uc *GetCaixaByIDUseCase) Execute(ctx context.Context, caixaID, tenantID uu
 	// Carregar operações
 	operacoes, err := uc.repo.ListOperacoes(ctx, caixa.ID, tenantID)
 	if err != nil {
-		uc.logger.Warn("Erro ao carregar operações do caixa",
+		common.Logger(ctx, uc.logger).Warn("Erro ao carregar operações do caixa",
 			zap.Error(err),
 			zap.String("caixa_id", caixa.ID.String()),
 		)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -19,6 +20,13 @@ type TotaisCaixa struct {
 	TotalReforcos decimal.Decimal
 	TotalDespesas decimal.Decimal
 	SaldoAtual    decimal.Decimal
+
+	// Vendas por forma de pagamento e o dinheiro que deveria estar na gaveta
+	PorFormaPagamento map[entity.TipoPagamento]decimal.Decimal
+	SaldoDinheiro     decimal.Decimal
+
+	// FechamentoCego indica que os totais não devem ser exibidos ao operador
+	FechamentoCego bool
 }
 
 // GetTotaisCaixaUseCase retorna os totais do caixa aberto
@@ -37,6 +45,9 @@ func NewGetTotaisCaixaUseCase(repo port.CaixaDiarioRepository, logger *zap.Logge
 
 // Execute retorna os totais do caixa aberto
 func (uc *GetTotaisCaixaUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (*TotaisCaixa, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.GetTotaisCaixa")
+	defer span.End()
+
 	if tenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -53,25 +64,27 @@ func (uc *GetTotaisCaixaUseCase) Execute(ctx context.Context, tenantID uuid.UUID
 	// Buscar somas por tipo
 	sums, err := uc.repo.SumOperacoesByTipo(ctx, caixa.ID, tenantID)
 	if err != nil {
-		uc.logger.Warn("Erro ao somar operações, usando valores do caixa",
+		common.Logger(ctx, uc.logger).Warn("Erro ao somar operações, usando valores do caixa",
 			zap.Error(err),
 		)
 		// Usar valores do próprio caixa
 		return &TotaisCaixa{
-			TotalVendas:   caixa.TotalEntradas,
-			TotalSangrias: caixa.TotalSangrias,
-			TotalReforcos: caixa.TotalReforcos,
-			TotalDespesas: decimal.Zero,
-			SaldoAtual:    caixa.SaldoEsperado,
+			TotalVendas:    caixa.TotalEntradas,
+			TotalSangrias:  caixa.TotalSangrias,
+			TotalReforcos:  caixa.TotalReforcos,
+			TotalDespesas:  decimal.Zero,
+			SaldoAtual:     caixa.SaldoEsperado,
+			FechamentoCego: caixa.FechamentoCego,
 		}, nil
 	}
 
 	// Montar totais a partir das somas
 	totais := &TotaisCaixa{
-		TotalVendas:   sums[entity.TipoOperacaoVenda],
-		TotalSangrias: sums[entity.TipoOperacaoSangria],
-		TotalReforcos: sums[entity.TipoOperacaoReforco],
-		TotalDespesas: sums[entity.TipoOperacaoDespesa],
+		TotalVendas:    sums[entity.TipoOperacaoVenda],
+		TotalSangrias:  sums[entity.TipoOperacaoSangria],
+		TotalReforcos:  sums[entity.TipoOperacaoReforco],
+		TotalDespesas:  sums[entity.TipoOperacaoDespesa],
+		FechamentoCego: caixa.FechamentoCego,
 	}
 
 	// Calcular saldo atual
@@ -82,5 +95,15 @@ func (uc *GetTotaisCaixaUseCase) Execute(ctx context.Context, tenantID uuid.UUID
 		Sub(totais.TotalSangrias).
 		Sub(totais.TotalDespesas)
 
+	operacoes, err := uc.repo.ListOperacoes(ctx, caixa.ID, tenantID)
+	if err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao carregar operações para totais por forma de pagamento",
+			zap.Error(err),
+		)
+	}
+	caixa.Operacoes = operacoes
+	totais.PorFormaPagamento = caixa.TotaisPorFormaPagamento()
+	totais.SaldoDinheiro = totais.SaldoAtual.Sub(caixa.TotalVendasNaoDinheiro())
+
 	return totais, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -44,6 +45,9 @@ func NewListHistoricoUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger
 
 // Execute retorna o histórico de caixas fechados
 func (uc *ListHistoricoUseCase) Execute(ctx context.Context, input ListHistoricoInput) (*ListHistoricoOutput, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.ListHistorico")
+	defer span.End()
+
 	if input.TenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -76,7 +80,7 @@ func (uc *ListHistoricoUseCase) Execute(ctx context.Context, input ListHistorico
 	// Contar total
 	total, err := uc.repo.CountHistorico(ctx, input.TenantID, filters)
 	if err != nil {
-		uc.logger.Warn("Erro ao contar histórico, usando len(caixas)",
+		common.Logger(ctx, uc.logger).Warn("Erro ao contar histórico, usando len(caixas)",
 			zap.Error(err),
 		)
 		total = int64(len(caixas))
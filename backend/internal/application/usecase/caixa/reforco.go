@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -37,6 +38,9 @@ func NewReforcoUseCase(repo port.CaixaDiarioRepository, logger *zap.Logger) *Ref
 
 // Execute registra um reforço no caixa
 func (uc *ReforcoUseCase) Execute(ctx context.Context, input ReforcoInput) (*entity.OperacaoCaixa, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.Reforco")
+	defer span.End()
+
 	// Validações
 	if input.TenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
@@ -88,7 +92,7 @@ func (uc *ReforcoUseCase) Execute(ctx context.Context, input ReforcoInput) (*ent
 		return nil, fmt.Errorf("erro ao atualizar totais do caixa: %w", err)
 	}
 
-	uc.logger.Info("Reforço registrado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Reforço registrado com sucesso",
 		zap.String("operacao_id", operacao.ID.String()),
 		zap.String("caixa_id", caixa.ID.String()),
 		zap.String("valor", input.Valor.String()),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -42,6 +43,9 @@ func NewSangriaUseCase(repo port.CaixaDiarioRepository, contasRepo port.ContaPag
 
 // Execute registra uma sangria no caixa
 func (uc *SangriaUseCase) Execute(ctx context.Context, input SangriaInput) (*entity.OperacaoCaixa, error) {
+	ctx, span := common.StartSpan(ctx, "caixa.Sangria")
+	defer span.End()
+
 	// Validações
 	if input.TenantID == uuid.Nil {
 		return nil, domain.ErrTenantIDRequired
@@ -122,7 +126,7 @@ func (uc *SangriaUseCase) Execute(ctx context.Context, input SangriaInput) (*ent
 		if conta != nil {
 			_ = conta.MarcarComoPago(now, "CAIXA_"+caixa.ID.String())
 			if err := uc.contasRepo.Update(ctx, conta); err != nil {
-				uc.logger.Error("CRITICAL: Sangria realizada mas falha ao baixar conta a pagar",
+				common.Logger(ctx, uc.logger).Error("CRITICAL: Sangria realizada mas falha ao baixar conta a pagar",
 					zap.String("operacao_id", operacao.ID.String()),
 					zap.String("conta_id", conta.ID),
 					zap.Error(err),
@@ -132,7 +136,7 @@ func (uc *SangriaUseCase) Execute(ctx context.Context, input SangriaInput) (*ent
 		}
 	}
 
-	uc.logger.Info("Sangria registrada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Sangria registrada com sucesso",
 		zap.String("operacao_id", operacao.ID.String()),
 		zap.String("caixa_id", caixa.ID.String()),
 		zap.String("valor", input.Valor.String()),
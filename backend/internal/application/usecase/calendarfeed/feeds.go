@@ -0,0 +1,369 @@
+// Package calendarfeed contém os use cases dos feeds iCalendar (.ics): links
+// somente leitura, protegidos por token, que os barbeiros assinam no celular
+// (Google Agenda, Apple Calendar, Outlook) para ver a agenda do profissional
+// ou da unidade.
+package calendarfeed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Janela de agendamentos exibida no feed
+const (
+	feedPast   = 30 * 24 * time.Hour
+	feedFuture = 180 * 24 * time.Hour
+)
+
+// maxUnitAppointments limita os agendamentos do feed da unidade
+const maxUnitAppointments = 5000
+
+// uidDomain completa o UID dos eventos (RFC 5545 recomenda UID@domínio)
+const uidDomain = "barber-analytics"
+
+// -----------------------------------------------------------------------------
+// Gestão dos feeds
+// -----------------------------------------------------------------------------
+
+// CreateFeedInput dados para criar um feed
+type CreateFeedInput struct {
+	TenantID       string
+	UnitID         string
+	ProfessionalID string // vazio = agenda da unidade
+	CreatedBy      string
+}
+
+// CreateFeedOutput feed criado com o token do link. O token só é conhecido
+// neste momento: o banco guarda apenas o hash.
+type CreateFeedOutput struct {
+	Feed  *entity.CalendarFeed
+	Token string
+}
+
+// CreateFeedUseCase cria um feed iCalendar
+type CreateFeedUseCase struct {
+	repo          port.CalendarFeedRepository
+	professionals port.ProfessionalReader
+	logger        *zap.Logger
+}
+
+// NewCreateFeedUseCase cria uma nova instância do use case
+func NewCreateFeedUseCase(repo port.CalendarFeedRepository, professionals port.ProfessionalReader, logger *zap.Logger) *CreateFeedUseCase {
+	return &CreateFeedUseCase{repo: repo, professionals: professionals, logger: logger}
+}
+
+// Execute cria o feed e retorna o token do link
+func (uc *CreateFeedUseCase) Execute(ctx context.Context, input CreateFeedInput) (*CreateFeedOutput, error) {
+	ctx, span := common.StartSpan(ctx, "calendarfeed.CreateFeed")
+	defer span.End()
+
+	tenantID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	unitID, err := uuid.Parse(input.UnitID)
+	if err != nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+	feed, err := entity.NewCalendarFeed(tenantID, unitID, input.ProfessionalID, auth.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+	feed.CreatedBy = input.CreatedBy
+
+	if !feed.IsUnitFeed() {
+		exists, err := uc.professionals.Exists(ctx, input.TenantID, input.ProfessionalID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao verificar profissional: %w", err)
+		}
+		if !exists {
+			return nil, domain.ErrAppointmentProfessionalNotFound
+		}
+	}
+
+	if err := uc.repo.Create(ctx, feed); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Feed de agenda criado",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("feed_id", feed.ID),
+		zap.String("professional_id", input.ProfessionalID),
+	)
+	return &CreateFeedOutput{Feed: feed, Token: token}, nil
+}
+
+// ListFeedsUseCase lista os feeds ativos da unidade
+type ListFeedsUseCase struct {
+	repo   port.CalendarFeedRepository
+	logger *zap.Logger
+}
+
+// NewListFeedsUseCase cria uma nova instância do use case
+func NewListFeedsUseCase(repo port.CalendarFeedRepository, logger *zap.Logger) *ListFeedsUseCase {
+	return &ListFeedsUseCase{repo: repo, logger: logger}
+}
+
+// Execute lista os feeds (sem os tokens, que não são recuperáveis)
+func (uc *ListFeedsUseCase) Execute(ctx context.Context, tenantID, unitID string) ([]*entity.CalendarFeed, error) {
+	ctx, span := common.StartSpan(ctx, "calendarfeed.ListFeeds")
+	defer span.End()
+
+	return uc.repo.List(ctx, tenantID, unitID)
+}
+
+// RevokeFeedUseCase revoga um feed
+type RevokeFeedUseCase struct {
+	repo   port.CalendarFeedRepository
+	logger *zap.Logger
+}
+
+// NewRevokeFeedUseCase cria uma nova instância do use case
+func NewRevokeFeedUseCase(repo port.CalendarFeedRepository, logger *zap.Logger) *RevokeFeedUseCase {
+	return &RevokeFeedUseCase{repo: repo, logger: logger}
+}
+
+// Execute revoga o feed; o link deixa de funcionar na próxima atualização
+// do aplicativo de calendário. Com professionalID (usuário restrito à própria
+// agenda), só revoga os feeds do próprio profissional.
+func (uc *RevokeFeedUseCase) Execute(ctx context.Context, tenantID, id, professionalID string) error {
+	ctx, span := common.StartSpan(ctx, "calendarfeed.RevokeFeed")
+	defer span.End()
+
+	if err := uc.repo.Revoke(ctx, tenantID, id, professionalID); err != nil {
+		return err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Feed de agenda revogado",
+		zap.String("tenant_id", tenantID),
+		zap.String("feed_id", id),
+	)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Feed público
+// -----------------------------------------------------------------------------
+
+// FeedOutput calendário do feed
+type FeedOutput struct {
+	Name   string
+	Events []entity.CalendarEvent
+}
+
+// GetFeedUseCase monta o calendário do link
+type GetFeedUseCase struct {
+	repo          port.CalendarFeedRepository
+	appointments  port.AppointmentRepository
+	professionals port.ProfessionalReader
+	units         port.UnitRepository
+	logger        *zap.Logger
+}
+
+// NewGetFeedUseCase cria uma nova instância do use case
+func NewGetFeedUseCase(
+	repo port.CalendarFeedRepository,
+	appointments port.AppointmentRepository,
+	professionals port.ProfessionalReader,
+	units port.UnitRepository,
+	logger *zap.Logger,
+) *GetFeedUseCase {
+	return &GetFeedUseCase{
+		repo:          repo,
+		appointments:  appointments,
+		professionals: professionals,
+		units:         units,
+		logger:        logger,
+	}
+}
+
+// Execute valida o token e monta os eventos: agendamentos (exceto
+// cancelados) dos últimos 30 e próximos 180 dias e os bloqueios de horário
+func (uc *GetFeedUseCase) Execute(ctx context.Context, token string) (*FeedOutput, error) {
+	ctx, span := common.StartSpan(ctx, "calendarfeed.GetFeed")
+	defer span.End()
+
+	feed, err := uc.repo.FindByTokenHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	since, until := now.Add(-feedPast), now.Add(feedFuture)
+
+	name, appointments, err := uc.load(ctx, feed, since, until)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := uc.repo.ListBlockedTimes(ctx, feed, since)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Touch(ctx, feed.ID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Erro ao registrar acesso ao feed de agenda", zap.String("feed_id", feed.ID), zap.Error(err))
+	}
+
+	return &FeedOutput{Name: name, Events: buildEvents(feed, appointments, blocked)}, nil
+}
+
+// load busca o nome do calendário e os agendamentos do feed
+func (uc *GetFeedUseCase) load(ctx context.Context, feed *entity.CalendarFeed, since, until time.Time) (string, []*entity.Appointment, error) {
+	tenantID := feed.TenantID.String()
+
+	if !feed.IsUnitFeed() {
+		name := "Agenda"
+		if info, err := uc.professionals.FindByID(ctx, tenantID, feed.ProfessionalID); err == nil && info != nil {
+			name = "Agenda - " + info.Name
+		}
+		appointments, err := uc.appointments.ListByProfessionalAndDateRange(ctx, tenantID, "", feed.ProfessionalID, since, until)
+		return name, appointments, err
+	}
+
+	name := "Agenda da unidade"
+	if unit, err := uc.units.FindByID(ctx, feed.TenantID, feed.UnitID); err == nil && unit != nil {
+		name = "Agenda - " + unit.Nome
+	}
+	appointments, _, err := uc.appointments.List(ctx, tenantID, port.AppointmentFilter{
+		UnitID: feed.UnitID.String(),
+		Statuses: []valueobject.AppointmentStatus{
+			valueobject.AppointmentStatusCreated,
+			valueobject.AppointmentStatusConfirmed,
+			valueobject.AppointmentStatusCheckedIn,
+			valueobject.AppointmentStatusInService,
+			valueobject.AppointmentStatusAwaitingPayment,
+			valueobject.AppointmentStatusDone,
+			valueobject.AppointmentStatusNoShow,
+		},
+		StartDate: since,
+		EndDate:   until,
+		Page:      1,
+		PageSize:  maxUnitAppointments,
+	})
+	return name, appointments, err
+}
+
+// buildEvents converte agendamentos e bloqueios em eventos. Cada trecho de
+// profissional do agendamento vira um evento (um serviço com João e outro
+// com Pedro aparecem na agenda de cada um); no feed do profissional só
+// entram os trechos dele.
+func buildEvents(feed *entity.CalendarFeed, appointments []*entity.Appointment, blocked []*port.CalendarFeedBlockedTime) []entity.CalendarEvent {
+	events := make([]entity.CalendarEvent, 0, len(appointments)+len(blocked))
+
+	for _, a := range appointments {
+		if a.Status == valueobject.AppointmentStatusCanceled {
+			continue
+		}
+		for i, slot := range a.ProfessionalSlots() {
+			if !feed.IsUnitFeed() && slot.ProfessionalID != feed.ProfessionalID {
+				continue
+			}
+			events = append(events, AppointmentSlotEvent(a, i, slot, feed.IsUnitFeed()))
+		}
+	}
+
+	for _, b := range blocked {
+		summary := "Bloqueio: " + b.Reason
+		if feed.IsUnitFeed() {
+			summary += " (" + b.ProfessionalName + ")"
+		}
+		ev := entity.CalendarEvent{
+			UID:       fmt.Sprintf("blocked-%s@%s", b.ID, uidDomain),
+			Summary:   summary,
+			Start:     b.StartTime,
+			End:       b.EndTime,
+			Status:    entity.CalendarEventConfirmed,
+			UpdatedAt: b.UpdatedAt,
+		}
+		if b.IsRecurring && b.RecurrenceRule != nil {
+			ev.RRule = *b.RecurrenceRule
+		}
+		events = append(events, ev)
+	}
+
+	return events
+}
+
+// AppointmentSlotEvent monta o evento de um trecho do agendamento (index é a
+// posição em ProfessionalSlots). O UID depende só do agendamento e da posição
+// do trecho: remarcar ou confirmar atualiza o mesmo evento no calendário.
+// withProfessional acrescenta o profissional ao título (agenda da unidade).
+func AppointmentSlotEvent(a *entity.Appointment, index int, slot entity.ProfessionalSlot, withProfessional bool) entity.CalendarEvent {
+	uid := fmt.Sprintf("appointment-%s@%s", a.ID, uidDomain)
+	if index > 0 {
+		uid = fmt.Sprintf("appointment-%s-%d@%s", a.ID, index+1, uidDomain)
+	}
+
+	professional := a.ProfessionalName
+	var services []string
+	for _, s := range a.Services {
+		if s.ProfessionalID != slot.ProfessionalID || s.StartTime.Before(slot.StartTime) || s.EndTime.After(slot.EndTime) {
+			continue
+		}
+		services = append(services, s.ServiceName)
+		if s.ProfessionalName != "" {
+			professional = s.ProfessionalName
+		}
+	}
+	if len(services) == 0 {
+		for _, s := range a.Services {
+			services = append(services, s.ServiceName)
+		}
+	}
+
+	// Só o primeiro nome do cliente: o feed sai do sistema
+	customer := firstName(a.CustomerName)
+	summary := customer
+	if len(services) > 0 {
+		summary += " - " + strings.Join(services, ", ")
+	}
+	if withProfessional && professional != "" {
+		summary += " (" + professional + ")"
+	}
+
+	description := "Cliente: " + customer
+	if len(services) > 0 {
+		description += "\nServiços: " + strings.Join(services, ", ")
+	}
+	if professional != "" {
+		description += "\nProfissional: " + professional
+	}
+
+	status := entity.CalendarEventConfirmed
+	if a.Status == valueobject.AppointmentStatusCreated {
+		status = entity.CalendarEventTentative
+	}
+
+	return entity.CalendarEvent{
+		UID:         uid,
+		Summary:     summary,
+		Description: description,
+		Start:       slot.StartTime,
+		End:         slot.EndTime,
+		Status:      status,
+		UpdatedAt:   a.UpdatedAt,
+	}
+}
+
+func firstName(name string) string {
+	if fields := strings.Fields(name); len(fields) > 0 {
+		return fields[0]
+	}
+	return "Cliente"
+}
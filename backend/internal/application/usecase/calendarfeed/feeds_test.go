@@ -0,0 +1,58 @@
+package calendarfeed
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+func TestBuildEvents(t *testing.T) {
+	joao, pedro := uuid.NewString(), uuid.NewString()
+	start := time.Date(2026, 5, 4, 13, 0, 0, 0, time.UTC)
+	appt := &entity.Appointment{
+		ID:               "a1",
+		ProfessionalID:   joao,
+		ProfessionalName: "João",
+		CustomerName:     "Carlos da Silva",
+		Status:           valueobject.AppointmentStatusConfirmed,
+		StartTime:        start,
+		EndTime:          start.Add(50 * time.Minute),
+		Services: []entity.AppointmentService{
+			{ServiceName: "Corte", ProfessionalID: joao, ProfessionalName: "João", StartTime: start, EndTime: start.Add(30 * time.Minute)},
+			{ServiceName: "Barba", ProfessionalID: pedro, ProfessionalName: "Pedro", StartTime: start.Add(30 * time.Minute), EndTime: start.Add(50 * time.Minute)},
+		},
+	}
+	rule := "FREQ=DAILY"
+	blocked := []*port.CalendarFeedBlockedTime{{
+		BlockedTime:      entity.BlockedTime{ID: "b1", ProfessionalID: pedro, Reason: "Almoço", IsRecurring: true, RecurrenceRule: &rule},
+		ProfessionalName: "Pedro",
+	}}
+
+	// Feed do Pedro: só o trecho da barba, com UID do segundo trecho
+	events := buildEvents(&entity.CalendarFeed{ProfessionalID: pedro}, []*entity.Appointment{appt}, blocked)
+	if len(events) != 2 {
+		t.Fatalf("esperados 2 eventos, obtidos %d", len(events))
+	}
+	if events[0].UID != "appointment-a1-2@barber-analytics" || events[0].Summary != "Carlos - Barba" {
+		t.Errorf("evento inesperado: %+v", events[0])
+	}
+	if !events[0].Start.Equal(start.Add(30 * time.Minute)) {
+		t.Errorf("início esperado no trecho da barba, obtido %v", events[0].Start)
+	}
+	if events[1].UID != "blocked-b1@barber-analytics" || events[1].RRule != rule {
+		t.Errorf("bloqueio inesperado: %+v", events[1])
+	}
+
+	// Feed da unidade: os dois trechos, com o nome do profissional
+	events = buildEvents(&entity.CalendarFeed{}, []*entity.Appointment{appt}, nil)
+	if len(events) != 2 {
+		t.Fatalf("esperados 2 eventos, obtidos %d", len(events))
+	}
+	if events[0].UID != "appointment-a1@barber-analytics" || events[0].Summary != "Carlos - Corte (João)" {
+		t.Errorf("evento inesperado: %+v", events[0])
+	}
+}
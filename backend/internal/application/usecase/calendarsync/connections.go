@@ -0,0 +1,305 @@
+// Package calendarsync sincroniza a agenda com calendários externos (Google
+// Agenda): os agendamentos dos profissionais conectados são enviados como
+// eventos e os eventos ocupados do calendário viram bloqueios de horário.
+package calendarsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// connectStateTTL é a validade do link de consentimento
+const connectStateTTL = 15 * time.Minute
+
+// connectState identifica, no callback do provedor, quem pediu a conexão.
+// Vai cifrado no parâmetro state: o callback é público.
+type connectState struct {
+	TenantID       string `json:"t"`
+	ProfessionalID string `json:"p"`
+	UserID         string `json:"u"`
+	ExpiresAt      int64  `json:"e"`
+}
+
+// -----------------------------------------------------------------------------
+// Conectar
+// -----------------------------------------------------------------------------
+
+// StartConnectInput dados para iniciar a conexão
+type StartConnectInput struct {
+	TenantID       string
+	ProfessionalID string
+	UserID         string
+}
+
+// StartConnectUseCase gera o link de consentimento do provedor
+type StartConnectUseCase struct {
+	provider      port.CalendarProvider
+	professionals port.ProfessionalReader
+	cipher        *auth.SecretCipher
+	logger        *zap.Logger
+}
+
+// NewStartConnectUseCase cria uma nova instância do use case. provider nil
+// indica integração não configurada.
+func NewStartConnectUseCase(provider port.CalendarProvider, professionals port.ProfessionalReader, cipher *auth.SecretCipher, logger *zap.Logger) *StartConnectUseCase {
+	return &StartConnectUseCase{provider: provider, professionals: professionals, cipher: cipher, logger: logger}
+}
+
+// Execute retorna o link para o profissional autorizar o acesso à agenda
+func (uc *StartConnectUseCase) Execute(ctx context.Context, input StartConnectInput) (string, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.StartConnect")
+	defer span.End()
+
+	if uc.provider == nil {
+		return "", domain.ErrCalendarProviderNotConfigured
+	}
+	if input.TenantID == "" {
+		return "", domain.ErrTenantIDRequired
+	}
+	if _, err := uuid.Parse(input.ProfessionalID); err != nil {
+		return "", domain.ErrAppointmentProfessionalRequired
+	}
+
+	exists, err := uc.professionals.Exists(ctx, input.TenantID, input.ProfessionalID)
+	if err != nil {
+		return "", fmt.Errorf("erro ao verificar profissional: %w", err)
+	}
+	if !exists {
+		return "", domain.ErrAppointmentProfessionalNotFound
+	}
+
+	raw, err := json.Marshal(connectState{
+		TenantID:       input.TenantID,
+		ProfessionalID: input.ProfessionalID,
+		UserID:         input.UserID,
+		ExpiresAt:      time.Now().Add(connectStateTTL).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	state, err := uc.cipher.Encrypt(string(raw))
+	if err != nil {
+		return "", err
+	}
+
+	return uc.provider.AuthCodeURL(state), nil
+}
+
+// CompleteConnectUseCase conclui a conexão no callback do provedor
+type CompleteConnectUseCase struct {
+	repo     port.CalendarSyncRepository
+	provider port.CalendarProvider
+	cipher   *auth.SecretCipher
+	logger   *zap.Logger
+}
+
+// NewCompleteConnectUseCase cria uma nova instância do use case
+func NewCompleteConnectUseCase(repo port.CalendarSyncRepository, provider port.CalendarProvider, cipher *auth.SecretCipher, logger *zap.Logger) *CompleteConnectUseCase {
+	return &CompleteConnectUseCase{repo: repo, provider: provider, cipher: cipher, logger: logger}
+}
+
+// Execute valida o state, troca o código pelas credenciais e grava a conexão.
+// A primeira leitura do calendário acontece no próximo ciclo do job.
+func (uc *CompleteConnectUseCase) Execute(ctx context.Context, state, code string) (*entity.CalendarConnection, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.CompleteConnect")
+	defer span.End()
+
+	if uc.provider == nil {
+		return nil, domain.ErrCalendarProviderNotConfigured
+	}
+
+	st, err := uc.decodeState(state)
+	if err != nil {
+		return nil, err
+	}
+	if code == "" {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	tenantID, err := uuid.Parse(st.TenantID)
+	if err != nil {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+
+	authz, err := uc.provider.Exchange(ctx, code)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := entity.NewCalendarConnection(tenantID, st.ProfessionalID, uc.provider.Name(), authz.CalendarID, authz.Token)
+	if err != nil {
+		return nil, err
+	}
+	conn.AccountEmail = authz.AccountEmail
+	conn.CreatedBy = st.UserID
+
+	if err := uc.repo.SaveConnection(ctx, conn); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Calendário externo conectado",
+		zap.String("tenant_id", st.TenantID),
+		zap.String("connection_id", conn.ID),
+		zap.String("professional_id", conn.ProfessionalID),
+		zap.String("provider", conn.Provider),
+	)
+	return conn, nil
+}
+
+func (uc *CompleteConnectUseCase) decodeState(state string) (*connectState, error) {
+	if state == "" {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	raw, err := uc.cipher.Decrypt(state)
+	if err != nil {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	var st connectState
+	if err := json.Unmarshal([]byte(raw), &st); err != nil {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	if time.Now().Unix() > st.ExpiresAt {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	return &st, nil
+}
+
+// -----------------------------------------------------------------------------
+// Listar e desconectar
+// -----------------------------------------------------------------------------
+
+// ListConnectionsUseCase lista as conexões do tenant
+type ListConnectionsUseCase struct {
+	repo   port.CalendarSyncRepository
+	logger *zap.Logger
+}
+
+// NewListConnectionsUseCase cria uma nova instância do use case
+func NewListConnectionsUseCase(repo port.CalendarSyncRepository, logger *zap.Logger) *ListConnectionsUseCase {
+	return &ListConnectionsUseCase{repo: repo, logger: logger}
+}
+
+// Execute lista as conexões; professionalID não vazio restringe ao profissional
+func (uc *ListConnectionsUseCase) Execute(ctx context.Context, tenantID, professionalID string) ([]*entity.CalendarConnection, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.ListConnections")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.ListConnections(ctx, tenantID, professionalID)
+}
+
+// DisconnectUseCase remove a conexão com o calendário externo
+type DisconnectUseCase struct {
+	repo     port.CalendarSyncRepository
+	provider port.CalendarProvider
+	logger   *zap.Logger
+}
+
+// NewDisconnectUseCase cria uma nova instância do use case
+func NewDisconnectUseCase(repo port.CalendarSyncRepository, provider port.CalendarProvider, logger *zap.Logger) *DisconnectUseCase {
+	return &DisconnectUseCase{repo: repo, provider: provider, logger: logger}
+}
+
+// Execute revoga o acesso no provedor (melhor esforço) e remove a conexão e
+// os bloqueios importados. Os eventos já enviados permanecem no calendário.
+// professionalID não vazio restringe às conexões do profissional.
+func (uc *DisconnectUseCase) Execute(ctx context.Context, tenantID, id, professionalID string) error {
+	ctx, span := common.StartSpan(ctx, "calendarsync.Disconnect")
+	defer span.End()
+
+	if tenantID == "" {
+		return domain.ErrTenantIDRequired
+	}
+
+	conn, err := uc.repo.FindConnection(ctx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if professionalID != "" && conn.ProfessionalID != professionalID {
+		return domain.ErrCalendarConnectionNotFound
+	}
+
+	if uc.provider != nil {
+		if err := uc.provider.RevokeToken(ctx, conn.Token); err != nil && !errors.Is(err, domain.ErrCalendarAccessRevoked) {
+			common.Logger(ctx, uc.logger).Warn("Erro ao revogar acesso ao calendário externo",
+				zap.String("tenant_id", tenantID),
+				zap.String("connection_id", id),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if err := uc.repo.DeleteConnection(ctx, conn); err != nil {
+		return err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Calendário externo desconectado",
+		zap.String("tenant_id", tenantID),
+		zap.String("connection_id", id),
+		zap.String("professional_id", conn.ProfessionalID),
+	)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Credenciais
+// -----------------------------------------------------------------------------
+
+// session executa chamadas ao provedor com access token válido
+type session struct {
+	repo     port.CalendarSyncRepository
+	provider port.CalendarProvider
+	logger   *zap.Logger
+}
+
+// call renova o token se estiver expirando e repete a chamada uma vez se o
+// provedor recusar a credencial
+func (s *session) call(ctx context.Context, conn *entity.CalendarConnection, fn func() error) error {
+	if err := s.refresh(ctx, conn, false); err != nil {
+		return err
+	}
+	err := fn()
+	if errors.Is(err, domain.ErrCalendarUnauthorized) {
+		if err := s.refresh(ctx, conn, true); err != nil {
+			return err
+		}
+		err = fn()
+	}
+	return err
+}
+
+func (s *session) refresh(ctx context.Context, conn *entity.CalendarConnection, force bool) error {
+	if !force && !conn.TokenExpired(time.Now()) {
+		return nil
+	}
+	token, err := s.provider.RefreshToken(ctx, conn.Token)
+	if err != nil {
+		return err
+	}
+	conn.Token = token
+	return s.repo.UpdateTokens(ctx, conn)
+}
+
+// fail registra o erro na conexão; acesso revogado desativa a conexão
+func (s *session) fail(ctx context.Context, conn *entity.CalendarConnection, cause error) {
+	conn.Fail(cause, errors.Is(cause, domain.ErrCalendarAccessRevoked))
+	if err := s.repo.UpdateSyncState(ctx, conn); err != nil {
+		common.Logger(ctx, s.logger).Error("Erro ao registrar falha da sincronização do calendário",
+			zap.String("tenant_id", conn.TenantID.String()),
+			zap.String("connection_id", conn.ID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,307 @@
+package calendarsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// ImportedBlockReason é o motivo dos bloqueios criados a partir do
+	// calendário externo
+	ImportedBlockReason = "Ocupado (Google Agenda)"
+
+	// pullWindowPast e pullWindowAhead delimitam a leitura completa
+	pullWindowPast  = 24 * time.Hour
+	pullWindowAhead = 90 * 24 * time.Hour
+)
+
+// SyncConflict evento ocupado do calendário externo que coincide com
+// agendamento do profissional. O bloqueio é criado mesmo assim; o conflito é
+// reportado para a recepção remarcar.
+type SyncConflict struct {
+	ExternalEventID string
+	BlockedTimeID   string
+	StartTime       time.Time
+	EndTime         time.Time
+}
+
+// SyncResult resultado da leitura de uma conexão
+type SyncResult struct {
+	Connection *entity.CalendarConnection
+	FullSync   bool
+	Imported   int // bloqueios criados ou atualizados
+	Removed    int // bloqueios removidos (evento cancelado, livre ou apagado)
+	Conflicts  []SyncConflict
+}
+
+// PullUseCase importa os eventos ocupados dos calendários externos como
+// bloqueios de horário
+type PullUseCase struct {
+	session
+	appointments port.AppointmentRepository
+	blockedTimes repository.BlockedTimeRepository
+}
+
+// NewPullUseCase cria uma nova instância do use case. provider nil desativa
+// a leitura.
+func NewPullUseCase(
+	repo port.CalendarSyncRepository,
+	provider port.CalendarProvider,
+	appointments port.AppointmentRepository,
+	blockedTimes repository.BlockedTimeRepository,
+	logger *zap.Logger,
+) *PullUseCase {
+	return &PullUseCase{
+		session:      session{repo: repo, provider: provider, logger: logger},
+		appointments: appointments,
+		blockedTimes: blockedTimes,
+	}
+}
+
+// Execute lê todas as conexões ativas (job). Falha em uma conexão não
+// interrompe as demais; retorna quantas foram sincronizadas.
+func (uc *PullUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.Pull")
+	defer span.End()
+
+	if uc.provider == nil {
+		return 0, nil
+	}
+
+	conns, err := uc.repo.ListActiveConnections(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for _, conn := range conns {
+		result, err := uc.sync(ctx, conn)
+		if err != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao ler calendário externo",
+				zap.String("tenant_id", conn.TenantID.String()),
+				zap.String("connection_id", conn.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		synced++
+		uc.logResult(result)
+	}
+	return synced, nil
+}
+
+// SyncConnection lê a conexão agora (sincronização manual). professionalID
+// não vazio restringe às conexões do profissional.
+func (uc *PullUseCase) SyncConnection(ctx context.Context, tenantID, id, professionalID string) (*SyncResult, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.SyncConnection")
+	defer span.End()
+
+	if uc.provider == nil {
+		return nil, domain.ErrCalendarProviderNotConfigured
+	}
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	conn, err := uc.repo.FindConnection(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if professionalID != "" && conn.ProfessionalID != professionalID {
+		return nil, domain.ErrCalendarConnectionNotFound
+	}
+	if !conn.IsActive() {
+		return nil, domain.ErrCalendarAccessRevoked
+	}
+
+	result, err := uc.sync(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	uc.logResult(result)
+	return result, nil
+}
+
+// sync lê as mudanças do calendário (ou tudo, na leitura completa) e
+// reconcilia os bloqueios importados
+func (uc *PullUseCase) sync(ctx context.Context, conn *entity.CalendarConnection) (*SyncResult, error) {
+	now := time.Now()
+	from, to := now.Add(-pullWindowPast), now.Add(pullWindowAhead)
+	full := conn.NeedsFullSync(now)
+
+	changes, err := uc.listEvents(ctx, conn, full, from, to)
+	if errors.Is(err, domain.ErrCalendarSyncTokenExpired) && !full {
+		full = true
+		changes, err = uc.listEvents(ctx, conn, full, from, to)
+	}
+	if err != nil {
+		uc.fail(ctx, conn, err)
+		return nil, err
+	}
+
+	imported, err := uc.repo.ListImportedEvents(ctx, conn.ID)
+	if err != nil {
+		return nil, err
+	}
+	byEvent := make(map[string]entity.CalendarImportedEvent, len(imported))
+	for _, ie := range imported {
+		byEvent[ie.ExternalEventID] = ie
+	}
+
+	result := &SyncResult{Connection: conn, FullSync: full}
+	seen := make(map[string]bool, len(changes.Events))
+	for _, ev := range changes.Events {
+		if ev.Managed {
+			continue // agendamento enviado pela própria sincronização
+		}
+		seen[ev.ID] = true
+		existing, ok := byEvent[ev.ID]
+
+		if !ev.Busy {
+			if ok {
+				if err := uc.removeBlock(ctx, conn, existing); err != nil {
+					return nil, err
+				}
+				result.Removed++
+			}
+			continue
+		}
+
+		blockID, err := uc.upsertBlock(ctx, conn, ev, existing, ok)
+		if err != nil {
+			return nil, err
+		}
+		result.Imported++
+
+		conflict, err := uc.appointments.CheckConflict(ctx, conn.TenantID.String(), "", conn.ProfessionalID, ev.Start, ev.End, "")
+		if err != nil {
+			return nil, fmt.Errorf("erro ao verificar conflito com agendamentos: %w", err)
+		}
+		if conflict {
+			result.Conflicts = append(result.Conflicts, SyncConflict{
+				ExternalEventID: ev.ID,
+				BlockedTimeID:   blockID,
+				StartTime:       ev.Start,
+				EndTime:         ev.End,
+			})
+		}
+	}
+
+	// Leitura completa: eventos importados que sumiram da janela foram
+	// apagados no calendário
+	if full {
+		for _, ie := range imported {
+			if seen[ie.ExternalEventID] || !ie.EndTime.After(from) {
+				continue
+			}
+			if err := uc.removeBlock(ctx, conn, ie); err != nil {
+				return nil, err
+			}
+			result.Removed++
+		}
+	}
+
+	conn.Synced(changes.NextSyncToken, full, now)
+	if err := uc.repo.UpdateSyncState(ctx, conn); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (uc *PullUseCase) listEvents(ctx context.Context, conn *entity.CalendarConnection, full bool, from, to time.Time) (*port.CalendarChanges, error) {
+	syncToken := conn.SyncToken
+	if full {
+		syncToken = ""
+	}
+	var changes *port.CalendarChanges
+	err := uc.call(ctx, conn, func() error {
+		var err error
+		changes, err = uc.provider.ListEvents(ctx, conn, syncToken, from, to)
+		return err
+	})
+	return changes, err
+}
+
+// upsertBlock cria o bloqueio do evento ou atualiza o horário do existente
+func (uc *PullUseCase) upsertBlock(ctx context.Context, conn *entity.CalendarConnection, ev entity.ExternalCalendarEvent, existing entity.CalendarImportedEvent, exists bool) (string, error) {
+	tenantID := conn.TenantID.String()
+
+	// Bloqueio removido na agenda some da lista de importados (cascade) e é
+	// recriado abaixo
+	if exists {
+		bt, err := uc.blockedTimes.GetByID(ctx, tenantID, existing.BlockedTimeID)
+		if err != nil {
+			return "", fmt.Errorf("erro ao buscar bloqueio importado: %w", err)
+		}
+		if bt.StartTime.Equal(ev.Start) && bt.EndTime.Equal(ev.End) {
+			return bt.ID, nil
+		}
+		if err := bt.Update(ev.Start, ev.End, bt.Reason); err != nil {
+			return "", err
+		}
+		if _, err := uc.blockedTimes.Update(ctx, bt); err != nil {
+			return "", fmt.Errorf("erro ao atualizar bloqueio importado: %w", err)
+		}
+		return bt.ID, nil
+	}
+
+	bt, err := entity.NewBlockedTime(conn.TenantID, conn.ProfessionalID, ev.Start, ev.End, ImportedBlockReason)
+	if err != nil {
+		return "", err
+	}
+	if conn.CreatedBy != "" {
+		createdBy := conn.CreatedBy
+		bt.CreatedBy = &createdBy
+	}
+	created, err := uc.blockedTimes.Create(ctx, bt)
+	if err != nil {
+		return "", fmt.Errorf("erro ao criar bloqueio importado: %w", err)
+	}
+
+	if err := uc.repo.SaveImportedEvent(ctx, conn.ID, entity.CalendarImportedEvent{
+		ExternalEventID: ev.ID,
+		BlockedTimeID:   created.ID,
+		EndTime:         created.EndTime,
+	}); err != nil {
+		return "", err
+	}
+	return created.ID, nil
+}
+
+// removeBlock remove o bloqueio importado (o vínculo cai junto)
+func (uc *PullUseCase) removeBlock(ctx context.Context, conn *entity.CalendarConnection, ie entity.CalendarImportedEvent) error {
+	if err := uc.blockedTimes.Delete(ctx, conn.TenantID.String(), ie.BlockedTimeID); err != nil {
+		return fmt.Errorf("erro ao remover bloqueio importado: %w", err)
+	}
+	return nil
+}
+
+func (uc *PullUseCase) logResult(result *SyncResult) {
+	conn := result.Connection
+	if len(result.Conflicts) > 0 {
+		uc.logger.Warn("Eventos do calendário externo em conflito com agendamentos",
+			zap.String("tenant_id", conn.TenantID.String()),
+			zap.String("connection_id", conn.ID),
+			zap.String("professional_id", conn.ProfessionalID),
+			zap.Int("conflicts", len(result.Conflicts)),
+		)
+	}
+	if result.Imported > 0 || result.Removed > 0 {
+		uc.logger.Info("Calendário externo sincronizado",
+			zap.String("tenant_id", conn.TenantID.String()),
+			zap.String("connection_id", conn.ID),
+			zap.Bool("full_sync", result.FullSync),
+			zap.Int("imported", result.Imported),
+			zap.Int("removed", result.Removed),
+		)
+	}
+}
@@ -0,0 +1,105 @@
+package calendarsync
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/repository/postgres"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// Seeds do tenant E2E (os mesmos dos testes de integração dos handlers)
+const (
+	e2eTenantID       = "e2e00000-0000-0000-0000-000000000001"
+	e2eProfessionalID = "a0000000-0000-0000-0000-000000000001" // Carlos Silva
+	e2eCustomerID     = "c1000000-0000-0000-0000-000000000001" // João Santos
+)
+
+// fakeServices responde um único serviço de 30 minutos
+type fakeServices struct {
+	port.ServiceReader
+	svc *port.ServiceInfo
+}
+
+func (f *fakeServices) FindByIDs(context.Context, string, []string) ([]*port.ServiceInfo, error) {
+	return []*port.ServiceInfo{f.svc}, nil
+}
+
+// TestPullImportedEventRejectsBooking_Integration importa um evento ocupado
+// para o banco e tenta agendar o profissional no mesmo horário, numa unidade:
+// o bloqueio importado (sem unidade) precisa recusar a reserva.
+func TestPullImportedEventRejectsBooking_Integration(t *testing.T) {
+	databaseURL := os.Getenv("DATABASE_URL")
+	if databaseURL == "" {
+		t.Skip("DATABASE_URL não configurada, pulando testes de integração")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, databaseURL)
+	if err != nil {
+		t.Fatalf("Erro ao conectar ao banco de testes: %v", err)
+	}
+	defer pool.Close()
+
+	queries := db.New(pool)
+	appointments := postgres.NewAppointmentRepository(queries, pool)
+	blockedTimes := postgres.NewBlockedTimeRepository(queries)
+
+	f := newFixture(t)
+	f.conn.TenantID = uuid.MustParse(e2eTenantID)
+	f.conn.ProfessionalID = e2eProfessionalID
+	pull := NewPullUseCase(f.repo, f.provider, appointments, blockedTimes, zap.NewNop())
+
+	// Horário distante e aleatório para não colidir com execuções anteriores
+	start := time.Now().Add(time.Duration(24+rand.Intn(24*60)) * time.Hour).Truncate(time.Minute)
+	f.provider.external = []entity.ExternalCalendarEvent{
+		{ID: "busy", Start: start, End: start.Add(time.Hour), Busy: true},
+	}
+
+	result, err := pull.SyncConnection(ctx, e2eTenantID, f.conn.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		for _, ie := range f.repo.imported[f.conn.ID] {
+			_ = blockedTimes.Delete(ctx, e2eTenantID, ie.BlockedTimeID)
+		}
+	}()
+	if result.Imported != 1 {
+		t.Fatalf("esperado 1 bloqueio importado: %+v", result)
+	}
+
+	services := &fakeServices{svc: &port.ServiceInfo{
+		ID:       uuid.NewString(),
+		Name:     "Corte",
+		Price:    valueobject.NewMoneyFromDecimal(decimal.NewFromInt(50)),
+		Duration: 30,
+		Active:   true,
+	}}
+	create := appointment.NewCreateAppointmentUseCase(appointments, nil, services,
+		postgres.NewProfessionalReader(queries), postgres.NewCustomerReader(queries), nil, nil, nil, zap.NewNop())
+
+	_, err = create.Execute(ctx, appointment.CreateAppointmentInput{
+		TenantID:       e2eTenantID,
+		UnitID:         uuid.NewString(),
+		ProfessionalID: e2eProfessionalID,
+		CustomerID:     e2eCustomerID,
+		StartTime:      start.Add(15 * time.Minute),
+		ServiceIDs:     []string{services.svc.ID},
+	})
+	if !errors.Is(err, domain.ErrAppointmentBlockedTimeConflict) {
+		t.Fatalf("esperado ErrAppointmentBlockedTimeConflict, obtido %v", err)
+	}
+}
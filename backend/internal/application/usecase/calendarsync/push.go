@@ -0,0 +1,346 @@
+package calendarsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarfeed"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"go.uber.org/zap"
+)
+
+const (
+	// pushBatch é o máximo de agendamentos enviados por execução do job
+	pushBatch = 50
+
+	// pushMaxAttempts é o limite de tentativas antes de desistir do envio
+	pushMaxAttempts = 10
+
+	// pushMaxBackoff é o maior intervalo entre tentativas
+	pushMaxBackoff = 6 * time.Hour
+)
+
+// -----------------------------------------------------------------------------
+// Fila de envio
+// -----------------------------------------------------------------------------
+
+// Notifier coloca na fila de envio os agendamentos criados, remarcados ou com
+// status alterado dos profissionais conectados. Implementa
+// appointment.BookingListener, appointment.AttendanceListener e
+// appointment.RescheduleListener; o envio fica com o PushUseCase.
+type Notifier struct {
+	repo   port.CalendarSyncRepository
+	logger *zap.Logger
+}
+
+// NewNotifier cria uma nova instância do notifier
+func NewNotifier(repo port.CalendarSyncRepository, logger *zap.Logger) *Notifier {
+	return &Notifier{repo: repo, logger: logger}
+}
+
+// AppointmentBooked enfileira o agendamento criado
+func (n *Notifier) AppointmentBooked(ctx context.Context, a *entity.Appointment) {
+	n.enqueue(ctx, a)
+}
+
+// AttendanceChanged enfileira o agendamento com status alterado
+func (n *Notifier) AttendanceChanged(ctx context.Context, a *entity.Appointment) {
+	n.enqueue(ctx, a)
+}
+
+// AppointmentRescheduled enfileira o agendamento remarcado
+func (n *Notifier) AppointmentRescheduled(ctx context.Context, a *entity.Appointment) {
+	n.enqueue(ctx, a)
+}
+
+// enqueue só enfileira se algum profissional do agendamento está conectado
+// ou se o agendamento já tem eventos (o profissional pode ter sido trocado)
+func (n *Notifier) enqueue(ctx context.Context, a *entity.Appointment) {
+	tenantID := a.TenantID.String()
+
+	connected, err := n.repo.HasConnections(ctx, tenantID, slotProfessionals(a))
+	if err == nil && !connected {
+		var links []entity.CalendarEventLink
+		links, err = n.repo.ListEventLinks(ctx, a.ID)
+		connected = len(links) > 0
+	}
+	if err == nil && connected {
+		err = n.repo.Enqueue(ctx, tenantID, a.ID)
+	}
+	if err != nil {
+		common.Logger(ctx, n.logger).Error("Erro ao enfileirar agendamento para o calendário externo",
+			zap.String("tenant_id", tenantID),
+			zap.String("appointment_id", a.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Envio (job)
+// -----------------------------------------------------------------------------
+
+// PushUseCase envia aos calendários externos os agendamentos da fila
+type PushUseCase struct {
+	session
+	appointments port.AppointmentRepository
+}
+
+// NewPushUseCase cria uma nova instância do use case. provider nil desativa
+// o envio.
+func NewPushUseCase(repo port.CalendarSyncRepository, provider port.CalendarProvider, appointments port.AppointmentRepository, logger *zap.Logger) *PushUseCase {
+	return &PushUseCase{
+		session:      session{repo: repo, provider: provider, logger: logger},
+		appointments: appointments,
+	}
+}
+
+// Execute processa a fila. Falhas são reagendadas com espera exponencial;
+// retorna quantos agendamentos foram sincronizados.
+func (uc *PushUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := common.StartSpan(ctx, "calendarsync.Push")
+	defer span.End()
+
+	if uc.provider == nil {
+		return 0, nil
+	}
+
+	items, err := uc.repo.ListDue(ctx, pushBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for _, item := range items {
+		err := uc.sync(ctx, item)
+		if err == nil {
+			if err := uc.repo.Dequeue(ctx, item); err != nil {
+				return synced, err
+			}
+			synced++
+			continue
+		}
+
+		item.Attempts++
+		item.LastError = err.Error()
+		if item.Attempts >= pushMaxAttempts {
+			common.Logger(ctx, uc.logger).Error("Envio ao calendário externo abandonado",
+				zap.String("tenant_id", item.TenantID),
+				zap.String("appointment_id", item.AppointmentID),
+				zap.Int("attempts", item.Attempts),
+				zap.Error(err),
+			)
+			if err := uc.repo.Dequeue(ctx, item); err != nil {
+				return synced, err
+			}
+			continue
+		}
+
+		item.NextAttemptAt = time.Now().Add(pushBackoff(item.Attempts))
+		common.Logger(ctx, uc.logger).Warn("Erro ao enviar agendamento ao calendário externo",
+			zap.String("tenant_id", item.TenantID),
+			zap.String("appointment_id", item.AppointmentID),
+			zap.Int("attempts", item.Attempts),
+			zap.Time("next_attempt_at", item.NextAttemptAt),
+			zap.Error(err),
+		)
+		if err := uc.repo.Retry(ctx, item); err != nil {
+			return synced, err
+		}
+	}
+
+	return synced, nil
+}
+
+// eventKey identifica o evento de um trecho do agendamento numa conexão
+type eventKey struct {
+	connectionID string
+	slotIndex    int
+}
+
+// sync reconcilia os eventos do agendamento com o estado atual: cria os
+// trechos novos, atualiza os existentes e remove os que deixaram de existir
+// (cancelamento, troca de profissional ou agendamento removido)
+func (uc *PushUseCase) sync(ctx context.Context, item entity.CalendarSyncItem) error {
+	a, err := uc.appointments.FindByID(ctx, item.TenantID, "", item.AppointmentID)
+	if err != nil && !errors.Is(err, domain.ErrAppointmentNotFound) {
+		return fmt.Errorf("erro ao buscar agendamento: %w", err)
+	}
+
+	links, err := uc.repo.ListEventLinks(ctx, item.AppointmentID)
+	if err != nil {
+		return err
+	}
+	current := make(map[eventKey]entity.CalendarEventLink, len(links))
+	for _, l := range links {
+		current[eventKey{l.ConnectionID, l.SlotIndex}] = l
+	}
+
+	conns := make(map[string]*entity.CalendarConnection)
+	wanted := make(map[eventKey]entity.CalendarEvent)
+	mainKey := eventKey{slotIndex: -1}
+	if a != nil && a.Status != valueobject.AppointmentStatusCanceled {
+		byProfessional, err := uc.connectionsByProfessional(ctx, item.TenantID, slotProfessionals(a))
+		if err != nil {
+			return err
+		}
+		for i, slot := range a.ProfessionalSlots() {
+			conn, ok := byProfessional[slot.ProfessionalID]
+			if !ok {
+				continue
+			}
+			conns[conn.ID] = conn
+			key := eventKey{conn.ID, i}
+			wanted[key] = calendarfeed.AppointmentSlotEvent(a, i, slot, false)
+			if mainKey.slotIndex < 0 && slot.ProfessionalID == a.ProfessionalID {
+				mainKey = key
+			}
+		}
+	}
+
+	var firstErr error
+	keep := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	mainEventID := ""
+	for key, ev := range wanted {
+		conn := conns[key.connectionID]
+		link, exists := current[key]
+		eventID, err := uc.upsertEvent(ctx, conn, link, exists, ev)
+		if err != nil {
+			uc.connectionError(ctx, conn, err, keep)
+			continue
+		}
+		if !exists || link.ExternalEventID != eventID {
+			if err := uc.repo.SaveEventLink(ctx, entity.CalendarEventLink{
+				ConnectionID:    conn.ID,
+				AppointmentID:   item.AppointmentID,
+				SlotIndex:       key.slotIndex,
+				ExternalEventID: eventID,
+			}); err != nil {
+				keep(err)
+				continue
+			}
+		}
+		if key == mainKey {
+			mainEventID = eventID
+		}
+	}
+
+	for key, link := range current {
+		if _, ok := wanted[key]; ok {
+			continue
+		}
+		conn, ok := conns[key.connectionID]
+		if !ok {
+			conn, err = uc.repo.FindConnection(ctx, item.TenantID, key.connectionID)
+			if err != nil {
+				if !errors.Is(err, domain.ErrCalendarConnectionNotFound) {
+					keep(err)
+				}
+				continue
+			}
+			conns[conn.ID] = conn
+		}
+		if !conn.IsActive() {
+			continue // sem acesso à conta: o vínculo fica até reconectar
+		}
+		err := uc.call(ctx, conn, func() error {
+			return uc.provider.DeleteEvent(ctx, conn, link.ExternalEventID)
+		})
+		if err != nil {
+			uc.connectionError(ctx, conn, err, keep)
+			continue
+		}
+		if err := uc.repo.DeleteEventLink(ctx, link); err != nil {
+			keep(err)
+		}
+	}
+
+	if a != nil && firstErr == nil && a.GoogleCalendarEventID != mainEventID {
+		if err := uc.repo.SetAppointmentEventID(ctx, item.TenantID, a.ID, mainEventID); err != nil {
+			keep(err)
+		}
+	}
+	return firstErr
+}
+
+// upsertEvent atualiza o evento vinculado ou cria um novo (também quando o
+// evento foi apagado direto no calendário)
+func (uc *PushUseCase) upsertEvent(ctx context.Context, conn *entity.CalendarConnection, link entity.CalendarEventLink, exists bool, ev entity.CalendarEvent) (string, error) {
+	if exists {
+		err := uc.call(ctx, conn, func() error {
+			return uc.provider.UpdateEvent(ctx, conn, link.ExternalEventID, ev)
+		})
+		if !errors.Is(err, domain.ErrCalendarEventNotFound) {
+			return link.ExternalEventID, err
+		}
+	}
+
+	var eventID string
+	err := uc.call(ctx, conn, func() error {
+		var err error
+		eventID, err = uc.provider.CreateEvent(ctx, conn, ev)
+		return err
+	})
+	return eventID, err
+}
+
+// connectionError trata o erro do provedor: acesso revogado desativa a
+// conexão e não bloqueia a fila; os demais erros levam a nova tentativa
+func (uc *PushUseCase) connectionError(ctx context.Context, conn *entity.CalendarConnection, err error, keep func(error)) {
+	if errors.Is(err, domain.ErrCalendarAccessRevoked) {
+		uc.fail(ctx, conn, err)
+		return
+	}
+	keep(err)
+}
+
+// connectionsByProfessional indexa as conexões ativas por profissional
+func (uc *PushUseCase) connectionsByProfessional(ctx context.Context, tenantID string, professionalIDs []string) (map[string]*entity.CalendarConnection, error) {
+	list, err := uc.repo.ListConnectionsByProfessionals(ctx, tenantID, professionalIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]*entity.CalendarConnection, len(list))
+	for _, conn := range list {
+		if conn.IsActive() {
+			out[conn.ProfessionalID] = conn
+		}
+	}
+	return out, nil
+}
+
+// pushBackoff espera 1min, 2min, 4min... até pushMaxBackoff
+func pushBackoff(attempts int) time.Duration {
+	if attempts > 16 {
+		return pushMaxBackoff
+	}
+	d := time.Minute << (attempts - 1)
+	if d > pushMaxBackoff {
+		return pushMaxBackoff
+	}
+	return d
+}
+
+// slotProfessionals lista os profissionais do agendamento, sem repetição
+func slotProfessionals(a *entity.Appointment) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, slot := range a.ProfessionalSlots() {
+		if !seen[slot.ProfessionalID] {
+			seen[slot.ProfessionalID] = true
+			out = append(out, slot.ProfessionalID)
+		}
+	}
+	return out
+}
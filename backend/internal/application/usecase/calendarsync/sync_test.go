@@ -0,0 +1,444 @@
+package calendarsync
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// -----------------------------------------------------------------------------
+// Fakes
+// -----------------------------------------------------------------------------
+
+type fakeSyncRepo struct {
+	conns    map[string]*entity.CalendarConnection
+	links    map[string]entity.CalendarEventLink // chave: conexão/agendamento/trecho
+	imported map[string]map[string]entity.CalendarImportedEvent
+	queue    map[string]entity.CalendarSyncItem
+	eventIDs map[string]string
+	blocks   *fakeBlockedTimes
+}
+
+func newFakeSyncRepo(blocks *fakeBlockedTimes) *fakeSyncRepo {
+	return &fakeSyncRepo{
+		conns:    map[string]*entity.CalendarConnection{},
+		links:    map[string]entity.CalendarEventLink{},
+		imported: map[string]map[string]entity.CalendarImportedEvent{},
+		queue:    map[string]entity.CalendarSyncItem{},
+		eventIDs: map[string]string{},
+		blocks:   blocks,
+	}
+}
+
+func linkKey(l entity.CalendarEventLink) string {
+	return fmt.Sprintf("%s/%s/%d", l.ConnectionID, l.AppointmentID, l.SlotIndex)
+}
+
+func (r *fakeSyncRepo) SaveConnection(_ context.Context, c *entity.CalendarConnection) error {
+	r.conns[c.ID] = c
+	return nil
+}
+
+func (r *fakeSyncRepo) FindConnection(_ context.Context, _, id string) (*entity.CalendarConnection, error) {
+	if c, ok := r.conns[id]; ok {
+		return c, nil
+	}
+	return nil, domain.ErrCalendarConnectionNotFound
+}
+
+func (r *fakeSyncRepo) ListConnections(_ context.Context, _, professionalID string) ([]*entity.CalendarConnection, error) {
+	var out []*entity.CalendarConnection
+	for _, c := range r.conns {
+		if professionalID == "" || c.ProfessionalID == professionalID {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) ListActiveConnections(ctx context.Context) ([]*entity.CalendarConnection, error) {
+	var out []*entity.CalendarConnection
+	for _, c := range r.conns {
+		if c.IsActive() {
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) ListConnectionsByProfessionals(_ context.Context, _ string, ids []string) ([]*entity.CalendarConnection, error) {
+	var out []*entity.CalendarConnection
+	for _, c := range r.conns {
+		for _, id := range ids {
+			if c.ProfessionalID == id {
+				out = append(out, c)
+			}
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) HasConnections(ctx context.Context, tenantID string, ids []string) (bool, error) {
+	conns, _ := r.ListConnectionsByProfessionals(ctx, tenantID, ids)
+	return len(conns) > 0, nil
+}
+
+func (r *fakeSyncRepo) UpdateTokens(context.Context, *entity.CalendarConnection) error    { return nil }
+func (r *fakeSyncRepo) UpdateSyncState(context.Context, *entity.CalendarConnection) error { return nil }
+
+func (r *fakeSyncRepo) DeleteConnection(_ context.Context, c *entity.CalendarConnection) error {
+	delete(r.conns, c.ID)
+	return nil
+}
+
+func (r *fakeSyncRepo) ListEventLinks(_ context.Context, appointmentID string) ([]entity.CalendarEventLink, error) {
+	var out []entity.CalendarEventLink
+	for _, l := range r.links {
+		if l.AppointmentID == appointmentID {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) SaveEventLink(_ context.Context, l entity.CalendarEventLink) error {
+	r.links[linkKey(l)] = l
+	return nil
+}
+
+func (r *fakeSyncRepo) DeleteEventLink(_ context.Context, l entity.CalendarEventLink) error {
+	delete(r.links, linkKey(l))
+	return nil
+}
+
+// ListImportedEvents reproduz o join com blocked_times: bloqueio removido
+// some da lista
+func (r *fakeSyncRepo) ListImportedEvents(_ context.Context, connectionID string) ([]entity.CalendarImportedEvent, error) {
+	var out []entity.CalendarImportedEvent
+	for _, ie := range r.imported[connectionID] {
+		if bt, ok := r.blocks.items[ie.BlockedTimeID]; ok {
+			ie.EndTime = bt.EndTime
+			out = append(out, ie)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) SaveImportedEvent(_ context.Context, connectionID string, ie entity.CalendarImportedEvent) error {
+	if r.imported[connectionID] == nil {
+		r.imported[connectionID] = map[string]entity.CalendarImportedEvent{}
+	}
+	r.imported[connectionID][ie.ExternalEventID] = ie
+	return nil
+}
+
+func (r *fakeSyncRepo) SetAppointmentEventID(_ context.Context, _, appointmentID, eventID string) error {
+	r.eventIDs[appointmentID] = eventID
+	return nil
+}
+
+func (r *fakeSyncRepo) Enqueue(_ context.Context, tenantID, appointmentID string) error {
+	r.queue[appointmentID] = entity.CalendarSyncItem{AppointmentID: appointmentID, TenantID: tenantID, EnqueuedAt: time.Now()}
+	return nil
+}
+
+func (r *fakeSyncRepo) ListDue(context.Context, int) ([]entity.CalendarSyncItem, error) {
+	var out []entity.CalendarSyncItem
+	for _, item := range r.queue {
+		if !item.NextAttemptAt.After(time.Now()) {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (r *fakeSyncRepo) Dequeue(_ context.Context, item entity.CalendarSyncItem) error {
+	delete(r.queue, item.AppointmentID)
+	return nil
+}
+
+func (r *fakeSyncRepo) Retry(_ context.Context, item entity.CalendarSyncItem) error {
+	r.queue[item.AppointmentID] = item
+	return nil
+}
+
+type fakeProvider struct {
+	events    map[string]entity.CalendarEvent
+	external  []entity.ExternalCalendarEvent
+	expired   bool // próximo ListEvents com sync token responde "expirado"
+	syncToken string
+	nextID    int
+}
+
+func (p *fakeProvider) Name() string                { return entity.CalendarProviderGoogle }
+func (p *fakeProvider) AuthCodeURL(s string) string { return "https://auth?state=" + s }
+
+func (p *fakeProvider) Exchange(context.Context, string) (*port.CalendarAuthorization, error) {
+	return nil, domain.ErrCalendarAuthorizationInvalid
+}
+
+func (p *fakeProvider) RefreshToken(_ context.Context, t entity.CalendarToken) (entity.CalendarToken, error) {
+	t.ExpiresAt = time.Now().Add(time.Hour)
+	return t, nil
+}
+
+func (p *fakeProvider) RevokeToken(context.Context, entity.CalendarToken) error { return nil }
+
+func (p *fakeProvider) CreateEvent(_ context.Context, _ *entity.CalendarConnection, ev entity.CalendarEvent) (string, error) {
+	p.nextID++
+	id := fmt.Sprintf("g%d", p.nextID)
+	p.events[id] = ev
+	return id, nil
+}
+
+func (p *fakeProvider) UpdateEvent(_ context.Context, _ *entity.CalendarConnection, id string, ev entity.CalendarEvent) error {
+	if _, ok := p.events[id]; !ok {
+		return domain.ErrCalendarEventNotFound
+	}
+	p.events[id] = ev
+	return nil
+}
+
+func (p *fakeProvider) DeleteEvent(_ context.Context, _ *entity.CalendarConnection, id string) error {
+	delete(p.events, id)
+	return nil
+}
+
+func (p *fakeProvider) ListEvents(_ context.Context, _ *entity.CalendarConnection, syncToken string, _, _ time.Time) (*port.CalendarChanges, error) {
+	if syncToken != "" && p.expired {
+		p.expired = false
+		return nil, domain.ErrCalendarSyncTokenExpired
+	}
+	p.syncToken += "x"
+	return &port.CalendarChanges{Events: p.external, NextSyncToken: p.syncToken}, nil
+}
+
+type fakeAppointments struct {
+	port.AppointmentRepository
+	items map[string]*entity.Appointment
+}
+
+func (f *fakeAppointments) FindByID(_ context.Context, _, _, id string) (*entity.Appointment, error) {
+	if a, ok := f.items[id]; ok {
+		return a, nil
+	}
+	return nil, domain.ErrAppointmentNotFound
+}
+
+func (f *fakeAppointments) CheckConflict(_ context.Context, _, _, professionalID string, start, end time.Time, _ string) (bool, error) {
+	for _, a := range f.items {
+		if a.ProfessionalID == professionalID && a.StartTime.Before(end) && a.EndTime.After(start) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type fakeBlockedTimes struct {
+	items map[string]*entity.BlockedTime
+}
+
+func (f *fakeBlockedTimes) Create(_ context.Context, bt *entity.BlockedTime) (*entity.BlockedTime, error) {
+	f.items[bt.ID] = bt
+	return bt, nil
+}
+
+func (f *fakeBlockedTimes) GetByID(_ context.Context, _, id string) (*entity.BlockedTime, error) {
+	if bt, ok := f.items[id]; ok {
+		return bt, nil
+	}
+	return nil, fmt.Errorf("bloqueio não encontrado")
+}
+
+func (f *fakeBlockedTimes) List(context.Context, string, *string, *time.Time, *time.Time) ([]*entity.BlockedTime, error) {
+	return nil, nil
+}
+
+func (f *fakeBlockedTimes) GetInRange(context.Context, string, string, time.Time, time.Time) ([]*entity.BlockedTime, error) {
+	return nil, nil
+}
+
+func (f *fakeBlockedTimes) CheckConflict(context.Context, string, string, time.Time, time.Time, *string) (bool, error) {
+	return false, nil
+}
+
+func (f *fakeBlockedTimes) Update(_ context.Context, bt *entity.BlockedTime) (*entity.BlockedTime, error) {
+	f.items[bt.ID] = bt
+	return bt, nil
+}
+
+func (f *fakeBlockedTimes) Delete(_ context.Context, _, id string) error {
+	delete(f.items, id)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Cenários
+// -----------------------------------------------------------------------------
+
+type fixture struct {
+	repo         *fakeSyncRepo
+	provider     *fakeProvider
+	appointments *fakeAppointments
+	blocks       *fakeBlockedTimes
+	conn         *entity.CalendarConnection
+}
+
+func newFixture(t *testing.T) *fixture {
+	t.Helper()
+	blocks := &fakeBlockedTimes{items: map[string]*entity.BlockedTime{}}
+	f := &fixture{
+		repo:         newFakeSyncRepo(blocks),
+		provider:     &fakeProvider{events: map[string]entity.CalendarEvent{}},
+		appointments: &fakeAppointments{items: map[string]*entity.Appointment{}},
+		blocks:       blocks,
+	}
+	conn, err := entity.NewCalendarConnection(uuid.New(), uuid.NewString(), entity.CalendarProviderGoogle, "", entity.CalendarToken{
+		AccessToken: "a", RefreshToken: "r", ExpiresAt: time.Now().Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.conn = conn
+	f.repo.conns[conn.ID] = conn
+	return f
+}
+
+func (f *fixture) appointment(start time.Time) *entity.Appointment {
+	a := &entity.Appointment{
+		ID:               uuid.NewString(),
+		TenantID:         f.conn.TenantID,
+		ProfessionalID:   f.conn.ProfessionalID,
+		ProfessionalName: "João",
+		CustomerName:     "Carlos Souza",
+		Status:           valueobject.AppointmentStatusConfirmed,
+		StartTime:        start,
+		EndTime:          start.Add(30 * time.Minute),
+	}
+	f.appointments.items[a.ID] = a
+	return a
+}
+
+func TestPushFollowsAppointmentLifecycle(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	logger := zap.NewNop()
+	notifier := NewNotifier(f.repo, logger)
+	push := NewPushUseCase(f.repo, f.provider, f.appointments, logger)
+
+	start := time.Now().Add(48 * time.Hour).Truncate(time.Hour)
+	a := f.appointment(start)
+
+	// Criado: evento enviado e vinculado ao agendamento
+	notifier.AppointmentBooked(ctx, a)
+	if n, err := push.Execute(ctx); err != nil || n != 1 {
+		t.Fatalf("envio: n=%d err=%v", n, err)
+	}
+	if len(f.provider.events) != 1 || len(f.repo.links) != 1 {
+		t.Fatalf("esperado 1 evento vinculado, obtidos %d eventos e %d vínculos", len(f.provider.events), len(f.repo.links))
+	}
+	eventID := f.repo.eventIDs[a.ID]
+	if ev := f.provider.events[eventID]; ev.Summary != "Carlos" || !ev.Start.Equal(start) {
+		t.Errorf("evento inesperado: %+v", ev)
+	}
+	a.GoogleCalendarEventID = eventID
+
+	// Remarcado: o mesmo evento é atualizado
+	if err := a.Reschedule(start.Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	notifier.AppointmentRescheduled(ctx, a)
+	if _, err := push.Execute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.provider.events) != 1 || !f.provider.events[eventID].Start.Equal(start.Add(2*time.Hour)) {
+		t.Errorf("evento não foi atualizado: %+v", f.provider.events)
+	}
+
+	// Cancelado: evento e vínculo removidos
+	if err := a.Cancel("cliente desistiu"); err != nil {
+		t.Fatal(err)
+	}
+	notifier.AttendanceChanged(ctx, a)
+	if _, err := push.Execute(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if len(f.provider.events) != 0 || len(f.repo.links) != 0 || len(f.repo.queue) != 0 {
+		t.Errorf("esperado calendário e fila vazios: eventos=%d vínculos=%d fila=%d", len(f.provider.events), len(f.repo.links), len(f.repo.queue))
+	}
+	if f.repo.eventIDs[a.ID] != "" {
+		t.Errorf("evento principal deveria ser limpo, obtido %q", f.repo.eventIDs[a.ID])
+	}
+}
+
+func TestNotifierIgnoresProfessionalsWithoutConnection(t *testing.T) {
+	f := newFixture(t)
+	a := f.appointment(time.Now().Add(time.Hour))
+	a.ProfessionalID = uuid.NewString()
+
+	NewNotifier(f.repo, zap.NewNop()).AppointmentBooked(context.Background(), a)
+	if len(f.repo.queue) != 0 {
+		t.Errorf("agendamento sem profissional conectado não deveria entrar na fila")
+	}
+}
+
+func TestPullImportsBusyEventsAndReportsConflicts(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	pull := NewPullUseCase(f.repo, f.provider, f.appointments, f.blocks, zap.NewNop())
+
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	f.appointment(start)
+	f.provider.external = []entity.ExternalCalendarEvent{
+		{ID: "busy", Start: start, End: start.Add(time.Hour), Busy: true},
+		{ID: "free", Start: start.Add(3 * time.Hour), End: start.Add(4 * time.Hour)},
+		{ID: "ours", Start: start, End: start.Add(30 * time.Minute), Busy: true, Managed: true},
+	}
+
+	result, err := pull.SyncConnection(ctx, f.conn.TenantID.String(), f.conn.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.FullSync || result.Imported != 1 || len(f.blocks.items) != 1 {
+		t.Fatalf("esperado 1 bloqueio na leitura completa: %+v, bloqueios=%d", result, len(f.blocks.items))
+	}
+	if len(result.Conflicts) != 1 || result.Conflicts[0].ExternalEventID != "busy" {
+		t.Errorf("conflito com o agendamento não reportado: %+v", result.Conflicts)
+	}
+	for _, bt := range f.blocks.items {
+		if bt.Reason != ImportedBlockReason || bt.ProfessionalID != f.conn.ProfessionalID {
+			t.Errorf("bloqueio inesperado: %+v", bt)
+		}
+	}
+
+	// Incremental: evento movido atualiza o mesmo bloqueio
+	f.provider.external = []entity.ExternalCalendarEvent{
+		{ID: "busy", Start: start.Add(5 * time.Hour), End: start.Add(6 * time.Hour), Busy: true},
+	}
+	result, err = pull.SyncConnection(ctx, f.conn.TenantID.String(), f.conn.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.FullSync || len(f.blocks.items) != 1 || len(result.Conflicts) != 0 {
+		t.Fatalf("leitura incremental inesperada: %+v, bloqueios=%d", result, len(f.blocks.items))
+	}
+
+	// Sync token expirado: leitura completa remove o bloqueio do evento apagado
+	f.provider.expired = true
+	f.provider.external = nil
+	result, err = pull.SyncConnection(ctx, f.conn.TenantID.String(), f.conn.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.FullSync || result.Removed != 1 || len(f.blocks.items) != 0 {
+		t.Errorf("esperada leitura completa removendo o bloqueio: %+v, bloqueios=%d", result, len(f.blocks.items))
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -32,6 +33,9 @@ func (uc *CreateCategoriaServicoUseCase) Execute(
 	tenantID, unitID string,
 	req dto.CreateCategoriaServicoRequest,
 ) (*dto.CategoriaServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "categoria.CreateCategoriaServico")
+	defer span.End()
+
 	// Validar tenant_id
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
@@ -41,7 +45,7 @@ func (uc *CreateCategoriaServicoUseCase) Execute(
 	// Verificar duplicidade de nome
 	exists, err := uc.repo.CheckNomeExists(ctx, tenantID, unitID, req.Nome, "")
 	if err != nil {
-		uc.logger.Error("Erro ao verificar nome duplicado",
+		common.Logger(ctx, uc.logger).Error("Erro ao verificar nome duplicado",
 			zap.String("tenant_id", tenantID),
 			zap.String("nome", req.Nome),
 			zap.Error(err),
@@ -80,7 +84,7 @@ func (uc *CreateCategoriaServicoUseCase) Execute(
 
 	// Persistir
 	if err := uc.repo.Create(ctx, categoria); err != nil {
-		uc.logger.Error("Erro ao criar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar categoria de serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("nome", req.Nome),
 			zap.Error(err),
@@ -88,7 +92,7 @@ func (uc *CreateCategoriaServicoUseCase) Execute(
 		return nil, err
 	}
 
-	uc.logger.Info("Categoria de serviço criada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Categoria de serviço criada com sucesso",
 		zap.String("categoria_id", categoria.ID.String()),
 		zap.String("tenant_id", tenantID),
 		zap.String("nome", categoria.Nome),
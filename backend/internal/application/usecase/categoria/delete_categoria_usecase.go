@@ -3,6 +3,7 @@ package categoria
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -27,10 +28,13 @@ func (uc *DeleteCategoriaServicoUseCase) Execute(
 	ctx context.Context,
 	tenantID, unitID, categoriaID string,
 ) error {
+	ctx, span := common.StartSpan(ctx, "categoria.DeleteCategoriaServico")
+	defer span.End()
+
 	// Verificar se categoria existe
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, categoriaID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao buscar categoria de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
@@ -45,7 +49,7 @@ func (uc *DeleteCategoriaServicoUseCase) Execute(
 	// Verificar se há serviços vinculados
 	count, err := uc.repo.CountServicos(ctx, tenantID, unitID, categoriaID)
 	if err != nil {
-		uc.logger.Error("erro ao contar serviços da categoria",
+		common.Logger(ctx, uc.logger).Error("erro ao contar serviços da categoria",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
@@ -54,7 +58,7 @@ func (uc *DeleteCategoriaServicoUseCase) Execute(
 	}
 
 	if count > 0 {
-		uc.logger.Warn("tentativa de deletar categoria com serviços vinculados",
+		common.Logger(ctx, uc.logger).Warn("tentativa de deletar categoria com serviços vinculados",
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
 			zap.Int64("total_servicos", count),
@@ -64,7 +68,7 @@ func (uc *DeleteCategoriaServicoUseCase) Execute(
 
 	// Deletar categoria
 	if err := uc.repo.Delete(ctx, tenantID, unitID, categoriaID); err != nil {
-		uc.logger.Error("erro ao deletar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao deletar categoria de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
@@ -72,7 +76,7 @@ func (uc *DeleteCategoriaServicoUseCase) Execute(
 		return err
 	}
 
-	uc.logger.Info("categoria de serviço deletada com sucesso",
+	common.Logger(ctx, uc.logger).Info("categoria de serviço deletada com sucesso",
 		zap.String("categoria_id", categoriaID),
 		zap.String("tenant_id", tenantID),
 		zap.String("nome", categoria.Nome),
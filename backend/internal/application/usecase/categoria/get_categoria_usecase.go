@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -29,10 +30,13 @@ func (uc *GetCategoriaServicoUseCase) Execute(
 	ctx context.Context,
 	tenantID, unitID, categoriaID string,
 ) (*dto.CategoriaServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "categoria.GetCategoriaServico")
+	defer span.End()
+
 	// Buscar categoria
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, categoriaID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao buscar categoria de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
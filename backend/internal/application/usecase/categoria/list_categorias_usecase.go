@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
 )
@@ -29,6 +30,9 @@ func (uc *ListCategoriasServicosUseCase) Execute(
 	tenantID, unitID string,
 	req dto.ListCategoriasServicosRequest,
 ) (*dto.ListCategoriasServicosResponse, error) {
+	ctx, span := common.StartSpan(ctx, "categoria.ListCategoriasServicos")
+	defer span.End()
+
 	// Construir filtros
 	filter := port.CategoriaServicoFilter{
 		ApenasAtivas: req.ApenasAtivas,
@@ -43,7 +47,7 @@ func (uc *ListCategoriasServicosUseCase) Execute(
 	// Buscar categorias
 	categorias, err := uc.repo.List(ctx, tenantID, unitID, filter)
 	if err != nil {
-		uc.logger.Error("erro ao listar categorias de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao listar categorias de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 		)
@@ -56,7 +60,7 @@ func (uc *ListCategoriasServicosUseCase) Execute(
 		Total:      len(categorias),
 	}
 
-	uc.logger.Info("categorias listadas com sucesso",
+	common.Logger(ctx, uc.logger).Info("categorias listadas com sucesso",
 		zap.String("tenant_id", tenantID),
 		zap.Int("total", response.Total),
 	)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -30,10 +31,13 @@ func (uc *UpdateCategoriaServicoUseCase) Execute(
 	tenantID, unitID, categoriaID string,
 	req dto.UpdateCategoriaServicoRequest,
 ) (*dto.CategoriaServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "categoria.UpdateCategoriaServico")
+	defer span.End()
+
 	// Buscar categoria existente
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, categoriaID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao buscar categoria de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
@@ -49,7 +53,7 @@ func (uc *UpdateCategoriaServicoUseCase) Execute(
 	if req.Nome != categoria.Nome {
 		exists, err := uc.repo.CheckNomeExists(ctx, tenantID, unitID, req.Nome, categoriaID)
 		if err != nil {
-			uc.logger.Error("erro ao verificar nome duplicado",
+			common.Logger(ctx, uc.logger).Error("erro ao verificar nome duplicado",
 				zap.Error(err),
 				zap.String("nome", req.Nome),
 			)
@@ -67,7 +71,7 @@ func (uc *UpdateCategoriaServicoUseCase) Execute(
 
 	// Persistir alterações
 	if err := uc.repo.Update(ctx, categoria); err != nil {
-		uc.logger.Error("erro ao atualizar categoria de serviço",
+		common.Logger(ctx, uc.logger).Error("erro ao atualizar categoria de serviço",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 			zap.String("categoria_id", categoriaID),
@@ -75,7 +79,7 @@ func (uc *UpdateCategoriaServicoUseCase) Execute(
 		return nil, err
 	}
 
-	uc.logger.Info("categoria de serviço atualizada com sucesso",
+	common.Logger(ctx, uc.logger).Info("categoria de serviço atualizada com sucesso",
 		zap.String("categoria_id", categoriaID),
 		zap.String("tenant_id", tenantID),
 		zap.String("nome", categoria.Nome),
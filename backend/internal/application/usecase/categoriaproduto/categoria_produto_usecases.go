@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -34,6 +35,9 @@ func NewCreateCategoriaProdutoUseCase(repo port.CategoriaProdutoRepository, logg
 }
 
 func (uc *CreateCategoriaProdutoUseCase) Execute(ctx context.Context, input CreateCategoriaProdutoInput) (*entity.CategoriaProdutoEntity, error) {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.CreateCategoriaProduto")
+	defer span.End()
+
 	// 1. Validar se nome já existe
 	exists, err := uc.repo.ExistsWithNome(ctx, input.TenantID, input.UnitID, input.Nome, nil)
 	if err != nil {
@@ -89,6 +93,9 @@ func NewListCategoriasProdutosUseCase(repo port.CategoriaProdutoRepository, logg
 }
 
 func (uc *ListCategoriasProdutosUseCase) Execute(ctx context.Context, tenantID, unitID uuid.UUID, apenasAtivas bool) ([]*entity.CategoriaProdutoEntity, error) {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.ListCategoriasProdutos")
+	defer span.End()
+
 	if apenasAtivas {
 		return uc.repo.ListAtivas(ctx, tenantID, unitID)
 	}
@@ -109,6 +116,9 @@ func NewGetCategoriaProdutoUseCase(repo port.CategoriaProdutoRepository, logger
 }
 
 func (uc *GetCategoriaProdutoUseCase) Execute(ctx context.Context, tenantID, unitID, id uuid.UUID) (*entity.CategoriaProdutoEntity, error) {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.GetCategoriaProduto")
+	defer span.End()
+
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, id)
 	if err != nil {
 		return nil, err
@@ -145,6 +155,9 @@ func NewUpdateCategoriaProdutoUseCase(repo port.CategoriaProdutoRepository, logg
 }
 
 func (uc *UpdateCategoriaProdutoUseCase) Execute(ctx context.Context, input UpdateCategoriaProdutoInput) (*entity.CategoriaProdutoEntity, error) {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.UpdateCategoriaProduto")
+	defer span.End()
+
 	// 1. Buscar categoria existente
 	categoria, err := uc.repo.FindByID(ctx, input.TenantID, input.UnitID, input.ID)
 	if err != nil {
@@ -203,6 +216,9 @@ func NewDeleteCategoriaProdutoUseCase(repo port.CategoriaProdutoRepository, logg
 }
 
 func (uc *DeleteCategoriaProdutoUseCase) Execute(ctx context.Context, tenantID, unitID, id uuid.UUID) error {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.DeleteCategoriaProduto")
+	defer span.End()
+
 	// 1. Verificar se categoria existe
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, id)
 	if err != nil {
@@ -239,6 +255,9 @@ func NewToggleCategoriaProdutoUseCase(repo port.CategoriaProdutoRepository, logg
 }
 
 func (uc *ToggleCategoriaProdutoUseCase) Execute(ctx context.Context, tenantID, unitID, id uuid.UUID) (*entity.CategoriaProdutoEntity, error) {
+	ctx, span := common.StartSpan(ctx, "categoriaproduto.ToggleCategoriaProduto")
+	defer span.End()
+
 	// 1. Buscar categoria
 	categoria, err := uc.repo.FindByID(ctx, tenantID, unitID, id)
 	if err != nil {
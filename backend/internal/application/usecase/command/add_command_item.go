@@ -6,8 +6,11 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -34,7 +37,11 @@ func NewAddCommandItemUseCase(
 
 // Execute adiciona um item à comanda e recalcula totais
 // T-EST-001: Para produtos, valida disponibilidade de estoque antes de adicionar
-func (uc *AddCommandItemUseCase) Execute(ctx context.Context, commandID, tenantID, userID uuid.UUID, req *dto.AddCommandItemRequest) (*dto.CommandResponse, error) {
+// perms limita o desconto por item (acima de 10% exige command.discount.above_10pct)
+func (uc *AddCommandItemUseCase) Execute(ctx context.Context, commandID, tenantID, userID uuid.UUID, req *dto.AddCommandItemRequest, perms valueobject.PermissionSet) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.AddCommandItem")
+	defer span.End()
+
 	// Buscar comanda existente
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
@@ -83,6 +90,10 @@ func (uc *AddCommandItemUseCase) Execute(ctx context.Context, commandID, tenantI
 		return nil, fmt.Errorf("failed to map item: %w", err)
 	}
 
+	if !perms.PodeConcederDesconto(item.PercentualDescontoTotal()) {
+		return nil, domain.ErrDescontoAcimaDoLimite
+	}
+
 	// Adicionar item via domain logic
 	if err := command.AddItem(*item); err != nil {
 		return nil, fmt.Errorf("failed to add item to command: %w", err)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 )
@@ -33,6 +34,9 @@ func NewAddCommandPaymentUseCase(
 // Execute adiciona um pagamento à comanda
 // As taxas são buscadas automaticamente do MeioPagamento configurado
 func (uc *AddCommandPaymentUseCase) Execute(ctx context.Context, commandID, tenantID, userID uuid.UUID, req *dto.AddCommandPaymentRequest) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.AddCommandPayment")
+	defer span.End()
+
 	// Buscar comanda existente
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
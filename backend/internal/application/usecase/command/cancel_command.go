@@ -9,6 +9,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
@@ -72,7 +73,10 @@ func NewCancelCommandUseCase(
 
 // Execute cancela uma comanda e reverte o estoque se necessário
 func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommandInput) (*CancelCommandOutput, error) {
-	uc.logger.Info("Iniciando cancelamento de comanda",
+	ctx, span := common.StartSpan(ctx, "command.CancelCommand")
+	defer span.End()
+
+	common.Logger(ctx, uc.logger).Info("Iniciando cancelamento de comanda",
 		zap.String("command_id", input.CommandID.String()),
 		zap.String("tenant_id", input.TenantID.String()),
 		zap.String("motivo", input.Motivo),
@@ -105,7 +109,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 				// Buscar produto
 				produto, err := uc.produtoRepo.FindByID(ctx, input.TenantID, item.ItemID)
 				if err != nil {
-					uc.logger.Warn("Erro ao buscar produto para reversão",
+					common.Logger(ctx, uc.logger).Warn("Erro ao buscar produto para reversão",
 						zap.String("item_id", item.ItemID.String()),
 						zap.Error(err),
 					)
@@ -141,7 +145,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 					observacao,
 				)
 				if err != nil {
-					uc.logger.Error("Erro ao criar movimentação de reversão",
+					common.Logger(ctx, uc.logger).Error("Erro ao criar movimentação de reversão",
 						zap.String("produto_id", item.ItemID.String()),
 						zap.Error(err),
 					)
@@ -150,7 +154,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 
 				// Persistir movimentação
 				if err := uc.movimentacaoRepo.Create(ctx, movimentacao); err != nil {
-					uc.logger.Error("Erro ao salvar movimentação de reversão",
+					common.Logger(ctx, uc.logger).Error("Erro ao salvar movimentação de reversão",
 						zap.String("produto_id", item.ItemID.String()),
 						zap.Error(err),
 					)
@@ -160,7 +164,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 				// Atualizar quantidade do produto
 				novaQuantidade := produto.QuantidadeAtual.Add(quantidadeReverter)
 				if err := uc.produtoRepo.AtualizarQuantidade(ctx, input.TenantID, item.ItemID, novaQuantidade); err != nil {
-					uc.logger.Error("Erro ao atualizar quantidade do produto",
+					common.Logger(ctx, uc.logger).Error("Erro ao atualizar quantidade do produto",
 						zap.String("produto_id", item.ItemID.String()),
 						zap.Error(err),
 					)
@@ -170,7 +174,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 				movimentacoesEstoque = append(movimentacoesEstoque, movimentacao.ID.String())
 				quantidadeItensRevertidos++
 
-				uc.logger.Info("Estoque revertido com sucesso",
+				common.Logger(ctx, uc.logger).Info("Estoque revertido com sucesso",
 					zap.String("produto_id", item.ItemID.String()),
 					zap.String("quantidade", quantidadeReverter.String()),
 					zap.String("nova_quantidade", novaQuantidade.String()),
@@ -180,7 +184,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 
 		// 5. Deletar comissões geradas (soft delete via status)
 		if err := uc.deleteCommissionItems(ctx, command.ID, input.TenantID.String()); err != nil {
-			uc.logger.Warn("Erro ao deletar itens de comissão",
+			common.Logger(ctx, uc.logger).Warn("Erro ao deletar itens de comissão",
 				zap.String("command_id", command.ID.String()),
 				zap.Error(err),
 			)
@@ -188,7 +192,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 
 		// 6. Estornar lançamentos financeiros vinculados à comanda fechada
 		if err := uc.estornarFinanceiro(ctx, command, input); err != nil {
-			uc.logger.Warn("Erro ao estornar financeiro da comanda",
+			common.Logger(ctx, uc.logger).Warn("Erro ao estornar financeiro da comanda",
 				zap.String("command_id", command.ID.String()),
 				zap.Error(err),
 			)
@@ -211,7 +215,7 @@ func (uc *CancelCommandUseCase) Execute(ctx context.Context, input CancelCommand
 		return nil, fmt.Errorf("erro ao salvar comanda cancelada: %w", err)
 	}
 
-	uc.logger.Info("Comanda cancelada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Comanda cancelada com sucesso",
 		zap.String("command_id", command.ID.String()),
 		zap.Bool("estoque_revertido", precisaReverterEstoque),
 		zap.Int("itens_revertidos", quantidadeItensRevertidos),
@@ -236,7 +240,7 @@ func (uc *CancelCommandUseCase) deleteCommissionItems(ctx context.Context, comma
 	// Deletar cada item
 	for _, item := range items {
 		if err := uc.commissionItemRepo.Delete(ctx, tenantID, item.ID); err != nil {
-			uc.logger.Warn("Erro ao deletar item de comissão",
+			common.Logger(ctx, uc.logger).Warn("Erro ao deletar item de comissão",
 				zap.String("item_id", item.ID),
 				zap.Error(err),
 			)
@@ -270,7 +274,7 @@ func (uc *CancelCommandUseCase) estornarFinanceiro(ctx context.Context, command
 			conta.AddObservacao(obs)
 
 			if err := uc.contaReceberRepo.Update(ctx, conta); err != nil {
-				uc.logger.Warn("erro ao estornar conta a receber",
+				common.Logger(ctx, uc.logger).Warn("erro ao estornar conta a receber",
 					zap.String("conta_receber_id", conta.ID),
 					zap.Error(err),
 				)
@@ -282,7 +286,7 @@ func (uc *CancelCommandUseCase) estornarFinanceiro(ctx context.Context, command
 	if uc.caixaRepo != nil {
 		caixaAberto, err := uc.caixaRepo.FindAberto(ctx, input.TenantID)
 		if err != nil || caixaAberto == nil {
-			uc.logger.Warn("não foi possível estornar no caixa (nenhum caixa aberto)",
+			common.Logger(ctx, uc.logger).Warn("não foi possível estornar no caixa (nenhum caixa aberto)",
 				zap.String("tenant_id", input.TenantID.String()),
 				zap.String("command_id", command.ID.String()),
 			)
@@ -310,18 +314,18 @@ func (uc *CancelCommandUseCase) estornarFinanceiro(ctx context.Context, command
 				nil,
 			)
 			if err != nil {
-				uc.logger.Warn("erro ao criar operação de estorno no caixa", zap.Error(err))
+				common.Logger(ctx, uc.logger).Warn("erro ao criar operação de estorno no caixa", zap.Error(err))
 				continue
 			}
 
 			if err := uc.caixaRepo.CreateOperacao(ctx, operacao); err != nil {
-				uc.logger.Warn("erro ao registrar estorno no caixa", zap.Error(err))
+				common.Logger(ctx, uc.logger).Warn("erro ao registrar estorno no caixa", zap.Error(err))
 				continue
 			}
 
 			caixaAberto.TotalSangrias = caixaAberto.TotalSangrias.Add(valor)
 			if err := uc.caixaRepo.UpdateTotais(ctx, caixaAberto.ID, input.TenantID, caixaAberto.TotalSangrias, caixaAberto.TotalReforcos, caixaAberto.TotalEntradas); err != nil {
-				uc.logger.Warn("erro ao atualizar totais do caixa após estorno", zap.Error(err))
+				common.Logger(ctx, uc.logger).Warn("erro ao atualizar totais do caixa após estorno", zap.Error(err))
 			}
 		}
 	}
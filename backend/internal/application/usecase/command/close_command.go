@@ -6,9 +6,11 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 // CloseCommandUseCase implementa o fechamento de uma comanda
@@ -16,19 +18,28 @@ type CloseCommandUseCase struct {
 	repo            port.CommandRepository
 	appointmentRepo port.AppointmentRepository
 	mapper          *mapper.CommandMapper
+	events          port.EventPublisher
+	history         port.AppointmentStatusHistoryRepository
+	logger          *zap.Logger
 }
 
 // NewCloseCommandUseCase cria uma nova instância do use case
-func NewCloseCommandUseCase(repo port.CommandRepository, appointmentRepo port.AppointmentRepository, mapper *mapper.CommandMapper) *CloseCommandUseCase {
+func NewCloseCommandUseCase(repo port.CommandRepository, appointmentRepo port.AppointmentRepository, mapper *mapper.CommandMapper, events port.EventPublisher, history port.AppointmentStatusHistoryRepository, logger *zap.Logger) *CloseCommandUseCase {
 	return &CloseCommandUseCase{
 		repo:            repo,
 		appointmentRepo: appointmentRepo,
 		mapper:          mapper,
+		events:          events,
+		history:         history,
+		logger:          logger,
 	}
 }
 
 // Execute fecha uma comanda (validações + atualização de status)
 func (uc *CloseCommandUseCase) Execute(ctx context.Context, commandID, tenantID, userID uuid.UUID, req *dto.CloseCommandRequest) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.CloseCommand")
+	defer span.End()
+
 	// Buscar comanda
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
@@ -62,8 +73,12 @@ func (uc *CloseCommandUseCase) Execute(ctx context.Context, commandID, tenantID,
 		return nil, fmt.Errorf("failed to close command: %w", err)
 	}
 
-	// Persistir
-	if err := uc.repo.Update(ctx, command); err != nil {
+	// Persistir, com o saldo devedor no fiado do cliente na mesma transação
+	debt, err := novaDividaCliente(command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open customer debt: %w", err)
+	}
+	if _, err := uc.repo.Close(ctx, command, debt); err != nil {
 		return nil, fmt.Errorf("failed to update command: %w", err)
 	}
 
@@ -73,16 +88,25 @@ func (uc *CloseCommandUseCase) Execute(ctx context.Context, commandID, tenantID,
 		appointment, err := uc.appointmentRepo.FindByID(ctx, tenantID.String(), "", command.AppointmentID.String())
 		if err == nil && appointment != nil {
 			// Atualizar status para DONE
-			newStatus := valueobject.AppointmentStatusDone
-			appointment.Status = newStatus
+			statusAnterior := appointment.Status
+			appointment.Status = valueobject.AppointmentStatusDone
 
 			// Persistir atualização do appointment
 			if err := uc.appointmentRepo.Update(ctx, appointment); err != nil {
 				// Log error mas não falhar o fechamento da comanda
-				fmt.Printf("Warning: failed to update appointment status to DONE: %v\n", err)
+				common.Logger(ctx, uc.logger).Warn("failed to update appointment status to DONE",
+					zap.String("appointment_id", appointment.ID),
+					zap.Error(err))
+			} else {
+				common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, statusActorID(userID), appointmentDoneReason)
+				common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
 			}
 		}
-	} // Buscar comanda fechada
+	}
+
+	publishCommandClosed(ctx, uc.events, uc.logger, command)
+
+	// Buscar comanda fechada
 	closed, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get closed command: %w", err)
@@ -92,3 +116,16 @@ func (uc *CloseCommandUseCase) Execute(ctx context.Context, commandID, tenantID,
 	response := uc.mapper.ToCommandResponse(closed)
 	return response, nil
 }
+
+// appointmentDoneReason motivo registrado no histórico de status quando o
+// fechamento da comanda conclui o agendamento
+const appointmentDoneReason = "Comanda fechada"
+
+// statusActorID usuário do fechamento para o histórico de status do
+// agendamento (uuid.Nil vira vazio)
+func statusActorID(userID uuid.UUID) string {
+	if userID == uuid.Nil {
+		return ""
+	}
+	return userID.String()
+}
@@ -6,7 +6,10 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
@@ -25,13 +28,23 @@ func NewCreateCommandUseCase(repo port.CommandRepository, mapper *mapper.Command
 }
 
 // Execute cria uma nova comanda
-func (uc *CreateCommandUseCase) Execute(ctx context.Context, tenantID uuid.UUID, req *dto.CreateCommandRequest) (*dto.CommandResponse, error) {
+// perms limita o desconto por item (acima de 10% exige command.discount.above_10pct)
+func (uc *CreateCommandUseCase) Execute(ctx context.Context, tenantID uuid.UUID, req *dto.CreateCommandRequest, perms valueobject.PermissionSet) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.CreateCommand")
+	defer span.End()
+
 	// Converter DTO para Entity
 	command, err := uc.mapper.FromCreateCommandRequest(req, tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to map request: %w", err)
 	}
 
+	for i := range command.Items {
+		if !perms.PodeConcederDesconto(command.Items[i].PercentualDescontoTotal()) {
+			return nil, domain.ErrDescontoAcimaDoLimite
+		}
+	}
+
 	// Persistir comanda
 	if err := uc.repo.Create(ctx, command); err != nil {
 		return nil, fmt.Errorf("failed to create command: %w", err)
@@ -0,0 +1,23 @@
+package command
+
+import (
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+// novaDividaCliente monta a dívida do fiado para o saldo devedor de uma
+// comanda fechada com DeixarSaldoDivida (nil se não há saldo). A dívida é
+// gravada junto com a comanda por CommandRepository.Close.
+func novaDividaCliente(command *entity.Command) (*entity.CustomerDebt, error) {
+	if command.SaldoDevedor <= 0 {
+		return nil, nil
+	}
+
+	saldo := decimal.NewFromFloat(command.SaldoDevedor).Round(2)
+	debt, err := entity.NewCustomerDebt(command.TenantID, command.CustomerID, command.ID, saldo)
+	if err != nil {
+		return nil, err
+	}
+	debt.Observacoes = command.Observacoes
+	return debt, nil
+}
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNovaDividaCliente(t *testing.T) {
+	command := &entity.Command{ID: uuid.New(), TenantID: uuid.New(), CustomerID: uuid.New()}
+
+	debt, err := novaDividaCliente(command)
+	require.NoError(t, err)
+	assert.Nil(t, debt, "comanda quitada não gera dívida")
+
+	command.SaldoDevedor = 35.456
+	debt, err = novaDividaCliente(command)
+	require.NoError(t, err)
+	require.NotNil(t, debt)
+	assert.Equal(t, command.ID, debt.CommandID)
+	assert.Equal(t, command.CustomerID, debt.CustomerID)
+	assert.True(t, debt.Saldo.Equal(decimal.RequireFromString("35.46")), "saldo arredondado: %s", debt.Saldo)
+}
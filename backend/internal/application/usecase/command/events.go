@@ -0,0 +1,40 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// publishCommandClosed publica command.closed com os totais da comanda
+func publishCommandClosed(ctx context.Context, events port.EventPublisher, logger *zap.Logger, c *entity.Command) {
+	data := map[string]any{
+		"command_id":     c.ID.String(),
+		"customer_id":    c.CustomerID.String(),
+		"subtotal":       c.Subtotal,
+		"desconto":       c.Desconto,
+		"total":          c.Total,
+		"total_recebido": c.TotalRecebido,
+		"troco":          c.Troco,
+		"saldo_devedor":  c.SaldoDevedor,
+		"itens":          len(c.Items),
+	}
+	if c.Numero != nil {
+		data["numero"] = *c.Numero
+	}
+	if c.AppointmentID != nil {
+		data["appointment_id"] = c.AppointmentID.String()
+	}
+	if c.FechadoEm != nil {
+		data["fechado_em"] = c.FechadoEm.Format(time.RFC3339)
+	}
+	common.PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventCommandClosed,
+		TenantID: c.TenantID.String(),
+		Data:     data,
+	})
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
@@ -34,6 +35,7 @@ type FinalizarComandaIntegradaOutput struct {
 	OperacoesCaixa       []string // IDs das operações de caixa criadas
 	CommissionItems      []string // IDs dos itens de comissão criados
 	MovimentacoesEstoque []string // IDs das movimentações de estoque criadas
+	DividaID             string   // ID da dívida aberta no fiado (se houver saldo devedor)
 	TotalLancadoCaixa    decimal.Decimal
 	TotalContasReceber   decimal.Decimal
 	TotalComissoes       decimal.Decimal
@@ -56,6 +58,8 @@ type FinalizarComandaIntegradaUseCase struct {
 	serviceReader      port.ServiceReader
 	professionalReader port.ProfessionalReader
 	mapper             *mapper.CommandMapper
+	events             port.EventPublisher
+	history            port.AppointmentStatusHistoryRepository
 	logger             *zap.Logger
 }
 
@@ -75,6 +79,8 @@ func NewFinalizarComandaIntegradaUseCase(
 	serviceReader port.ServiceReader,
 	professionalReader port.ProfessionalReader,
 	mapper *mapper.CommandMapper,
+	events port.EventPublisher,
+	history port.AppointmentStatusHistoryRepository,
 	logger *zap.Logger,
 ) *FinalizarComandaIntegradaUseCase {
 	return &FinalizarComandaIntegradaUseCase{
@@ -91,6 +97,8 @@ func NewFinalizarComandaIntegradaUseCase(
 		serviceReader:      serviceReader,
 		professionalReader: professionalReader,
 		mapper:             mapper,
+		events:             events,
+		history:            history,
 		logger:             logger,
 	}
 }
@@ -105,17 +113,21 @@ func NewFinalizarComandaIntegradaUseCase(
 //   - Outros: cria ContaReceber com D+ do meio de pagamento
 //
 // 6. Fecha a comanda
-// 7. Atualiza o agendamento para DONE (se vinculado)
+// 7. Registra o saldo devedor no fiado do cliente (DeixarSaldoDivida)
+// 8. Atualiza o agendamento para DONE (se vinculado)
 func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input FinalizarComandaIntegradaInput) (*FinalizarComandaIntegradaOutput, error) {
+	ctx, span := common.StartSpan(ctx, "command.FinalizarComandaIntegrada")
+	defer span.End()
+
 	// G-003: Verificar se há caixa aberto ANTES de qualquer operação
 	// Comanda só pode ser fechada com caixa aberto para garantir integridade financeira
 	caixaAberto, err := uc.caixaRepo.FindAberto(ctx, input.TenantID)
 	if err != nil {
-		uc.logger.Error("erro ao verificar caixa aberto", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao verificar caixa aberto", zap.Error(err))
 		return nil, fmt.Errorf("erro ao verificar caixa: %w", err)
 	}
 	if caixaAberto == nil {
-		uc.logger.Warn("tentativa de fechar comanda sem caixa aberto",
+		common.Logger(ctx, uc.logger).Warn("tentativa de fechar comanda sem caixa aberto",
 			zap.String("tenant_id", input.TenantID.String()),
 			zap.String("command_id", input.CommandID.String()))
 		return nil, fmt.Errorf("não é possível fechar a comanda: caixa não está aberto. Abra o caixa antes de finalizar vendas")
@@ -163,13 +175,19 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 	// Buscar profissional do appointment (para comissões)
 	var professionalID string
 	var professionalInfo *port.ProfessionalInfo
+	var appointment *entity.Appointment
 	var appointmentDate *time.Time // Data do agendamento para reference_date
+	// Info dos profissionais por ID: em agendamentos com vários
+	// profissionais, cada serviço comissiona quem o executou
+	professionalInfos := make(map[string]*port.ProfessionalInfo)
 	if command.AppointmentID != nil {
-		appointment, err := uc.appointmentRepo.FindByID(ctx, input.TenantID.String(), "", command.AppointmentID.String())
-		if err == nil && appointment != nil {
+		found, err := uc.appointmentRepo.FindByID(ctx, input.TenantID.String(), "", command.AppointmentID.String())
+		if err == nil && found != nil {
+			appointment = found
 			professionalID = appointment.ProfessionalID
 			// COM-001: Buscar info completa do profissional (inclui comissão)
 			professionalInfo, _ = uc.professionalReader.FindByID(ctx, input.TenantID.String(), professionalID)
+			professionalInfos[professionalID] = professionalInfo
 			// COM-004: Usar data do agendamento como reference_date
 			if !appointment.StartTime.IsZero() {
 				t := appointment.StartTime
@@ -182,6 +200,9 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 	// Por enquanto usa nil (sem unidade) - vai direto para regra global
 	var unitID *string
 
+	// Profissional que executou cada serviço da comanda
+	executores := profissionaisDosServicos(appointment, command.Items)
+
 	// T-EST-002 & T-COM-001: Processar cada item da comanda
 	for _, item := range command.Items {
 		switch item.Tipo {
@@ -189,7 +210,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 			totalProdutos = totalProdutos.Add(decimal.NewFromFloat(item.PrecoFinal))
 			// T-EST-002: Abater estoque para produtos
 			if err := uc.processarEstoqueProduto(ctx, input.TenantID, input.UserID, &item, output); err != nil {
-				uc.logger.Warn("erro ao abater estoque do produto",
+				common.Logger(ctx, uc.logger).Warn("erro ao abater estoque do produto",
 					zap.String("item_id", item.ID.String()),
 					zap.Error(err))
 				// Continua mesmo com erro - log mas não bloqueia fechamento
@@ -210,13 +231,13 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 						ctx, input.TenantID, professionalID, command, &item,
 						ruleResult, appointmentDate, output,
 					); err != nil {
-						uc.logger.Warn("erro ao gerar comissão do produto",
+						common.Logger(ctx, uc.logger).Warn("erro ao gerar comissão do produto",
 							zap.String("item_id", item.ID.String()),
 							zap.String("source", ruleResult.Source),
 							zap.Error(err))
 					}
 				} else {
-					uc.logger.Debug("nenhuma regra de comissão encontrada para o produto",
+					common.Logger(ctx, uc.logger).Debug("nenhuma regra de comissão encontrada para o produto",
 						zap.String("item_id", item.ID.String()),
 						zap.String("produto_id", item.ItemID.String()))
 				}
@@ -224,23 +245,36 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 
 		case entity.CommandItemTypeServico:
 			totalServicos = totalServicos.Add(decimal.NewFromFloat(item.PrecoFinal))
+			// Comissão vai para o profissional que executou o serviço
+			serviceProfessionalID := professionalID
+			serviceProfessionalInfo := professionalInfo
+			if appointment != nil {
+				serviceProfessionalID = executores[item.ID]
+				info, ok := professionalInfos[serviceProfessionalID]
+				if !ok {
+					info, _ = uc.professionalReader.FindByID(ctx, input.TenantID.String(), serviceProfessionalID)
+					professionalInfos[serviceProfessionalID] = info
+				}
+				serviceProfessionalInfo = info
+			}
+
 			// COM-001: Buscar regra usando hierarquia de 5 níveis
-			if professionalID != "" {
+			if serviceProfessionalID != "" {
 				ruleResult := uc.buscarRegraComissaoHierarquica(
 					ctx,
 					input.TenantID,
 					unitID,
-					professionalID,
-					professionalInfo,
+					serviceProfessionalID,
+					serviceProfessionalInfo,
 					&item,
 				)
 
 				if ruleResult != nil {
 					if err := uc.processarComissaoServicoHierarquicaComData(
-						ctx, input.TenantID, professionalID, command, &item,
+						ctx, input.TenantID, serviceProfessionalID, command, &item,
 						ruleResult, appointmentDate, output,
 					); err != nil {
-						uc.logger.Warn("erro ao gerar comissão do serviço",
+						common.Logger(ctx, uc.logger).Warn("erro ao gerar comissão do serviço",
 							zap.String("item_id", item.ID.String()),
 							zap.String("source", ruleResult.Source),
 							zap.String("calculation_base", ruleResult.CalculationBase),
@@ -248,10 +282,10 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 						// Continua mesmo com erro - log mas não bloqueia fechamento
 					}
 				} else {
-					uc.logger.Warn("nenhuma regra de comissão encontrada para o serviço",
+					common.Logger(ctx, uc.logger).Warn("nenhuma regra de comissão encontrada para o serviço",
 						zap.String("item_id", item.ID.String()),
 						zap.String("servico_id", item.ItemID.String()),
-						zap.String("profissional_id", professionalID))
+						zap.String("profissional_id", serviceProfessionalID))
 				}
 			}
 		}
@@ -290,7 +324,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 		// Buscar meio de pagamento para obter tipo e D+
 		meioPagamento, err := uc.meioPagamentoRepo.FindByID(ctx, input.TenantID.String(), payment.MeioPagamentoID.String())
 		if err != nil {
-			uc.logger.Warn("meio de pagamento não encontrado, usando padrões",
+			common.Logger(ctx, uc.logger).Warn("meio de pagamento não encontrado, usando padrões",
 				zap.String("meio_pagamento_id", payment.MeioPagamentoID.String()),
 				zap.Error(err))
 			// Continua com valores padrão
@@ -316,14 +350,15 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 			input.UserID,
 			valorRecebido,
 			fmt.Sprintf("Comanda #%s - %s", command.ID.String()[:8], nomeDescricao),
+			meioPagamento.Tipo,
 		)
 		if err != nil {
-			uc.logger.Error("erro ao criar operação de venda", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao criar operação de venda", zap.Error(err))
 			continue
 		}
 
 		if err := uc.caixaRepo.CreateOperacao(ctx, operacao); err != nil {
-			uc.logger.Error("erro ao registrar operação no caixa", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao registrar operação no caixa", zap.Error(err))
 			continue
 		}
 
@@ -331,13 +366,13 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 		novoTotalEntradas := caixaAberto.TotalEntradas.Add(valorRecebido)
 		caixaAberto.TotalEntradas = novoTotalEntradas // Atualizar local para próximos pagamentos
 		if err := uc.caixaRepo.UpdateTotais(ctx, caixaAberto.ID, input.TenantID, caixaAberto.TotalSangrias, caixaAberto.TotalReforcos, novoTotalEntradas); err != nil {
-			uc.logger.Error("erro ao atualizar totais do caixa", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao atualizar totais do caixa", zap.Error(err))
 		}
 
 		output.OperacoesCaixa = append(output.OperacoesCaixa, operacao.ID.String())
 		output.TotalLancadoCaixa = output.TotalLancadoCaixa.Add(valorRecebido)
 
-		uc.logger.Info("operação de venda registrada no caixa",
+		common.Logger(ctx, uc.logger).Info("operação de venda registrada no caixa",
 			zap.String("operacao_id", operacao.ID.String()),
 			zap.String("meio_pagamento", nomeDescricao),
 			zap.String("tipo", string(meioPagamento.Tipo)),
@@ -395,7 +430,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 					dataVencimento,
 				)
 				if err != nil {
-					uc.logger.Warn("erro ao criar conta a receber da comanda", zap.Error(err))
+					common.Logger(ctx, uc.logger).Warn("erro ao criar conta a receber da comanda", zap.Error(err))
 					continue
 				}
 
@@ -417,7 +452,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 				}
 
 				if err := uc.contaReceberRepo.Create(ctx, contaReceber); err != nil {
-					uc.logger.Warn("erro ao persistir conta a receber da comanda", zap.Error(err))
+					common.Logger(ctx, uc.logger).Warn("erro ao persistir conta a receber da comanda", zap.Error(err))
 					continue
 				}
 
@@ -425,7 +460,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 				output.ContasReceber = append(output.ContasReceber, contaReceber.ID)
 				output.TotalContasReceber = output.TotalContasReceber.Add(sp.valor)
 
-				uc.logger.Info("conta a receber criada para comanda",
+				common.Logger(ctx, uc.logger).Info("conta a receber criada para comanda",
 					zap.String("conta_receber_id", contaReceber.ID),
 					zap.String("origem", sp.origem),
 					zap.String("valor", sp.valor.String()),
@@ -460,17 +495,17 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 						dMaisVO,
 					)
 					if err != nil {
-						uc.logger.Warn("erro ao criar compensação bancária automática", zap.Error(err))
+						common.Logger(ctx, uc.logger).Warn("erro ao criar compensação bancária automática", zap.Error(err))
 						continue
 					}
 
 					_ = comp.MarcarComoConfirmado()
 					if err := uc.compensacaoRepo.Create(ctx, comp); err != nil {
-						uc.logger.Warn("erro ao persistir compensação bancária automática", zap.Error(err))
+						common.Logger(ctx, uc.logger).Warn("erro ao persistir compensação bancária automática", zap.Error(err))
 						continue
 					}
 
-					uc.logger.Info("compensação bancária criada para pagamento D+",
+					common.Logger(ctx, uc.logger).Info("compensação bancária criada para pagamento D+",
 						zap.String("compensacao_id", comp.ID),
 						zap.String("receita_id", comp.ReceitaID),
 						zap.Int("d_mais", meioPagamento.DMais),
@@ -485,24 +520,41 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 		return nil, fmt.Errorf("falha ao fechar comanda: %w", err)
 	}
 
-	// Persistir atualização da comanda
-	if err := uc.commandRepo.Update(ctx, command); err != nil {
+	// Persistir a comanda fechada e, na mesma transação, o saldo devedor no
+	// fiado do cliente
+	debt, err := novaDividaCliente(command)
+	if err != nil {
+		return nil, fmt.Errorf("falha ao registrar dívida do cliente: %w", err)
+	}
+	if debt, err = uc.commandRepo.Close(ctx, command, debt); err != nil {
 		return nil, fmt.Errorf("falha ao atualizar comanda: %w", err)
+	} else if debt != nil {
+		output.DividaID = debt.ID.String()
+		common.Logger(ctx, uc.logger).Info("saldo devedor registrado no fiado do cliente",
+			zap.String("debt_id", debt.ID.String()),
+			zap.String("customer_id", debt.CustomerID.String()),
+			zap.String("saldo", debt.Saldo.String()))
 	}
 
 	// Atualizar status do appointment para DONE (se houver)
 	if command.AppointmentID != nil {
 		appointment, err := uc.appointmentRepo.FindByID(ctx, input.TenantID.String(), "", command.AppointmentID.String())
 		if err == nil && appointment != nil {
+			statusAnterior := appointment.Status
 			appointment.Status = valueobject.AppointmentStatusDone
 			if err := uc.appointmentRepo.Update(ctx, appointment); err != nil {
-				uc.logger.Warn("falha ao atualizar status do agendamento",
+				common.Logger(ctx, uc.logger).Warn("falha ao atualizar status do agendamento",
 					zap.String("appointment_id", command.AppointmentID.String()),
 					zap.Error(err))
+			} else {
+				common.RecordAppointmentStatusChange(ctx, uc.history, uc.logger, appointment, statusAnterior, statusActorID(input.UserID), appointmentDoneReason)
+				common.PublishAppointmentStatusChanged(ctx, uc.events, uc.logger, appointment, statusAnterior)
 			}
 		}
 	}
 
+	publishCommandClosed(ctx, uc.events, uc.logger, command)
+
 	// Buscar comanda atualizada para retorno
 	closedCommand, err := uc.commandRepo.FindByID(ctx, input.CommandID, input.TenantID)
 	if err != nil {
@@ -511,7 +563,7 @@ func (uc *FinalizarComandaIntegradaUseCase) Execute(ctx context.Context, input F
 
 	output.Command = uc.mapper.ToCommandResponse(closedCommand)
 
-	uc.logger.Info("comanda finalizada com integração financeira completa",
+	common.Logger(ctx, uc.logger).Info("comanda finalizada com integração financeira completa",
 		zap.String("command_id", command.ID.String()),
 		zap.Int("contas_receber_criadas", len(output.ContasReceber)),
 		zap.Int("operacoes_caixa_criadas", len(output.OperacoesCaixa)),
@@ -564,9 +616,10 @@ func (uc *FinalizarComandaIntegradaUseCase) processarEstoqueProduto(
 	}
 
 	// Atualizar quantidade do produto (abater estoque)
+	quantidadeAnterior := produto.QuantidadeAtual
 	novaQuantidade := produto.QuantidadeAtual.Sub(quantidade)
 	if novaQuantidade.IsNegative() {
-		uc.logger.Warn("estoque ficará negativo após venda",
+		common.Logger(ctx, uc.logger).Warn("estoque ficará negativo após venda",
 			zap.String("produto_id", produto.ID.String()),
 			zap.String("quantidade_atual", produto.QuantidadeAtual.String()),
 			zap.String("quantidade_vendida", quantidade.String()))
@@ -579,7 +632,10 @@ func (uc *FinalizarComandaIntegradaUseCase) processarEstoqueProduto(
 
 	output.MovimentacoesEstoque = append(output.MovimentacoesEstoque, movimentacao.ID.String())
 
-	uc.logger.Info("estoque abatido",
+	produto.QuantidadeAtual = novaQuantidade
+	common.PublishStockBelowMinimum(ctx, uc.events, uc.logger, produto, quantidadeAnterior)
+
+	common.Logger(ctx, uc.logger).Info("estoque abatido",
 		zap.String("produto_id", item.ItemID.String()),
 		zap.String("quantidade", quantidade.String()),
 		zap.String("movimentacao_id", movimentacao.ID.String()))
@@ -634,7 +690,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoServico(
 	output.CommissionItems = append(output.CommissionItems, created.ID)
 	output.TotalComissoes = output.TotalComissoes.Add(created.CommissionValue)
 
-	uc.logger.Info("comissão gerada",
+	common.Logger(ctx, uc.logger).Info("comissão gerada",
 		zap.String("commission_item_id", created.ID),
 		zap.String("professional_id", professionalID),
 		zap.String("valor_bruto", grossValue.String()),
@@ -679,7 +735,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoHierarquica(
 		// Converter comissão do serviço para decimal
 		comissaoServico, err := decimal.NewFromString(*servico.Comissao)
 		if err == nil && comissaoServico.GreaterThan(decimal.Zero) {
-			uc.logger.Debug("usando comissão do serviço (nível 1)",
+			common.Logger(ctx, uc.logger).Debug("usando comissão do serviço (nível 1)",
 				zap.String("servico_id", servico.ID),
 				zap.String("comissao", comissaoServico.String()))
 			return &CommissionRuleResult{
@@ -703,7 +759,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoHierarquica(
 		if err == nil && comissaoCategoria != nil && *comissaoCategoria != "" {
 			comissaoCat, err := decimal.NewFromString(*comissaoCategoria)
 			if err == nil && comissaoCat.GreaterThan(decimal.Zero) {
-				uc.logger.Debug("usando comissão da categoria (nível 2)",
+				common.Logger(ctx, uc.logger).Debug("usando comissão da categoria (nível 2)",
 					zap.String("categoria_id", *servico.CategoriaID),
 					zap.String("profissional_id", professionalID),
 					zap.String("comissao", comissaoCat.String()))
@@ -731,7 +787,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoHierarquica(
 			if professionalInfo.TipoComissao != nil && *professionalInfo.TipoComissao != "" {
 				tipoComissao = *professionalInfo.TipoComissao
 			}
-			uc.logger.Debug("usando comissão do profissional (nível 3)",
+			common.Logger(ctx, uc.logger).Debug("usando comissão do profissional (nível 3)",
 				zap.String("profissional_id", professionalID),
 				zap.String("comissao", comissaoProf.String()),
 				zap.String("tipo", tipoComissao))
@@ -758,7 +814,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoHierarquica(
 			if rule.CalculationBase != nil {
 				calcBase = *rule.CalculationBase
 			}
-			uc.logger.Debug("usando regra da unidade (nível 4)",
+			common.Logger(ctx, uc.logger).Debug("usando regra da unidade (nível 4)",
 				zap.String("unit_id", *unitID),
 				zap.String("rule_id", rule.ID),
 				zap.String("calculation_base", calcBase))
@@ -777,7 +833,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoHierarquica(
 		if rule.CalculationBase != nil {
 			calcBase = *rule.CalculationBase
 		}
-		uc.logger.Debug("usando regra global do tenant (nível 5)",
+		common.Logger(ctx, uc.logger).Debug("usando regra global do tenant (nível 5)",
 			zap.String("tenant_id", tenantIDStr),
 			zap.String("rule_id", rule.ID),
 			zap.String("calculation_base", calcBase))
@@ -812,7 +868,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoServicoHierarquica(
 	if calculationBase == "LIQUIDO" {
 		// Calcular valor líquido proporcional do item
 		baseValue = uc.calcularValorLiquidoProporcional(command, item)
-		uc.logger.Debug("usando base LIQUIDO para comissão",
+		common.Logger(ctx, uc.logger).Debug("usando base LIQUIDO para comissão",
 			zap.String("item_id", item.ID.String()),
 			zap.String("preco_final", decimal.NewFromFloat(item.PrecoFinal).String()),
 			zap.String("valor_liquido_proporcional", baseValue.String()))
@@ -863,7 +919,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoServicoHierarquica(
 	output.CommissionItems = append(output.CommissionItems, created.ID)
 	output.TotalComissoes = output.TotalComissoes.Add(created.CommissionValue)
 
-	uc.logger.Info("comissão gerada com hierarquia",
+	common.Logger(ctx, uc.logger).Info("comissão gerada com hierarquia",
 		zap.String("commission_item_id", created.ID),
 		zap.String("professional_id", professionalID),
 		zap.String("source", commissionSource),
@@ -936,7 +992,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoServicoHierarquicaC
 	var baseValue decimal.Decimal
 	if calculationBase == "LIQUIDO" {
 		baseValue = uc.calcularValorLiquidoProporcional(command, item)
-		uc.logger.Debug("usando base LIQUIDO para comissão",
+		common.Logger(ctx, uc.logger).Debug("usando base LIQUIDO para comissão",
 			zap.String("item_id", item.ID.String()),
 			zap.String("preco_final", decimal.NewFromFloat(item.PrecoFinal).String()),
 			zap.String("valor_liquido_proporcional", baseValue.String()))
@@ -987,7 +1043,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoServicoHierarquicaC
 	output.CommissionItems = append(output.CommissionItems, created.ID)
 	output.TotalComissoes = output.TotalComissoes.Add(created.CommissionValue)
 
-	uc.logger.Info("comissão de serviço gerada",
+	common.Logger(ctx, uc.logger).Info("comissão de serviço gerada",
 		zap.String("commission_item_id", created.ID),
 		zap.String("professional_id", professionalID),
 		zap.String("source", commissionSource),
@@ -1021,7 +1077,7 @@ func (uc *FinalizarComandaIntegradaUseCase) buscarRegraComissaoProduto(
 			if professionalInfo.TipoComissao != nil && *professionalInfo.TipoComissao != "" {
 				tipoComissao = *professionalInfo.TipoComissao
 			}
-			uc.logger.Debug("usando comissão do profissional para produto",
+			common.Logger(ctx, uc.logger).Debug("usando comissão do profissional para produto",
 				zap.String("profissional_id", professionalID),
 				zap.String("comissao", comissaoProf.String()))
 			return &CommissionRuleResult{
@@ -1140,7 +1196,7 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoProduto(
 	output.CommissionItems = append(output.CommissionItems, created.ID)
 	output.TotalComissoes = output.TotalComissoes.Add(created.CommissionValue)
 
-	uc.logger.Info("comissão de produto gerada",
+	common.Logger(ctx, uc.logger).Info("comissão de produto gerada",
 		zap.String("commission_item_id", created.ID),
 		zap.String("professional_id", professionalID),
 		zap.String("produto_id", item.ItemID.String()),
@@ -1150,3 +1206,25 @@ func (uc *FinalizarComandaIntegradaUseCase) processarComissaoProduto(
 
 	return nil
 }
+
+// profissionaisDosServicos associa cada item de serviço da comanda ao
+// profissional que o executou no agendamento. Os itens nascem na ordem das
+// linhas do agendamento: o n-ésimo item de um serviço corresponde à n-ésima
+// linha desse serviço, o que separa o mesmo serviço feito por dois
+// profissionais.
+func profissionaisDosServicos(appointment *entity.Appointment, items []entity.CommandItem) map[uuid.UUID]string {
+	out := make(map[uuid.UUID]string)
+	if appointment == nil {
+		return out
+	}
+	vistos := make(map[string]int)
+	for _, item := range items {
+		if item.Tipo != entity.CommandItemTypeServico {
+			continue
+		}
+		serviceID := item.ItemID.String()
+		out[item.ID] = appointment.ServiceProfessional(serviceID, vistos[serviceID])
+		vistos[serviceID]++
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfissionaisDosServicos_MesmoServicoComDoisProfissionais(t *testing.T) {
+	corte, barba, produto := uuid.New(), uuid.New(), uuid.New()
+	appointment := &entity.Appointment{
+		ProfessionalID: "joao",
+		Services: []entity.AppointmentService{
+			{ServiceID: corte.String(), ProfessionalID: "joao"},
+			{ServiceID: barba.String(), ProfessionalID: "joao"},
+			{ServiceID: corte.String(), ProfessionalID: "pedro"}, // corte do acompanhante
+		},
+	}
+	items := []entity.CommandItem{
+		{ID: uuid.New(), Tipo: entity.CommandItemTypeServico, ItemID: corte},
+		{ID: uuid.New(), Tipo: entity.CommandItemTypeServico, ItemID: barba},
+		{ID: uuid.New(), Tipo: entity.CommandItemTypeProduto, ItemID: produto},
+		{ID: uuid.New(), Tipo: entity.CommandItemTypeServico, ItemID: corte},
+		{ID: uuid.New(), Tipo: entity.CommandItemTypeServico, ItemID: barba}, // lançado fora do agendamento
+	}
+
+	executores := profissionaisDosServicos(appointment, items)
+
+	assert.Equal(t, "joao", executores[items[0].ID])
+	assert.Equal(t, "joao", executores[items[1].ID])
+	assert.Equal(t, "pedro", executores[items[3].ID], "segundo corte comissiona quem o executou")
+	assert.Equal(t, "joao", executores[items[4].ID], "serviço extra fica com o responsável")
+	assert.NotContains(t, executores, items[2].ID, "produto não entra na associação")
+
+	assert.Empty(t, profissionaisDosServicos(nil, items), "comanda sem agendamento")
+}
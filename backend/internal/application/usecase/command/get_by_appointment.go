@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,9 @@ func NewGetCommandByAppointmentUseCase(repo port.CommandRepository, mapper *mapp
 
 // Execute busca uma comanda por appointment_id (inclui items e payments)
 func (uc *GetCommandByAppointmentUseCase) Execute(ctx context.Context, appointmentID, tenantID uuid.UUID) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.GetCommandByAppointment")
+	defer span.End()
+
 	// Buscar comanda pelo appointment_id
 	command, err := uc.repo.FindByAppointmentID(ctx, appointmentID, tenantID)
 	if err != nil {
@@ -6,26 +6,35 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 // GetCommandUseCase implementa a busca de uma comanda por ID
 type GetCommandUseCase struct {
-	repo   port.CommandRepository
-	mapper *mapper.CommandMapper
+	repo     port.CommandRepository
+	debtRepo port.CustomerDebtRepository
+	mapper   *mapper.CommandMapper
+	logger   *zap.Logger
 }
 
 // NewGetCommandUseCase cria uma nova instância do use case
-func NewGetCommandUseCase(repo port.CommandRepository, mapper *mapper.CommandMapper) *GetCommandUseCase {
+func NewGetCommandUseCase(repo port.CommandRepository, debtRepo port.CustomerDebtRepository, mapper *mapper.CommandMapper, logger *zap.Logger) *GetCommandUseCase {
 	return &GetCommandUseCase{
-		repo:   repo,
-		mapper: mapper,
+		repo:     repo,
+		debtRepo: debtRepo,
+		mapper:   mapper,
+		logger:   logger,
 	}
 }
 
 // Execute busca uma comanda por ID (inclui items e payments)
 func (uc *GetCommandUseCase) Execute(ctx context.Context, commandID, tenantID uuid.UUID) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.GetCommand")
+	defer span.End()
+
 	// Buscar comanda
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
@@ -38,5 +47,22 @@ func (uc *GetCommandUseCase) Execute(ctx context.Context, commandID, tenantID uu
 
 	// Converter para DTO
 	response := uc.mapper.ToCommandResponse(command)
+
+	// Saldo em aberto do cliente no fiado (para cobrança na comanda atual)
+	// Falha aqui não impede a leitura da comanda: só fica sem o saldo
+	if uc.debtRepo != nil {
+		saldo, err := uc.debtRepo.GetSaldoCliente(ctx, tenantID, command.CustomerID)
+		if err != nil {
+			common.Logger(ctx, uc.logger).Error("erro ao buscar saldo de fiado do cliente",
+				zap.String("command_id", commandID.String()),
+				zap.String("customer_id", command.CustomerID.String()),
+				zap.Error(err),
+			)
+		} else if saldo.IsPositive() {
+			s := saldo.StringFixed(2)
+			response.SaldoFiadoCliente = &s
+		}
+	}
+
 	return response, nil
 }
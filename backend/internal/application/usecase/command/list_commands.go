@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -41,6 +42,9 @@ func NewListCommandsUseCase(
 
 // Execute lista comandas com filtros e paginação
 func (uc *ListCommandsUseCase) Execute(ctx context.Context, input ListCommandsInput) (*dto.CommandListResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.ListCommands")
+	defer span.End()
+
 	// Validar paginação
 	if input.Page < 1 {
 		input.Page = 1
@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,9 @@ func NewRemoveCommandItemUseCase(repo port.CommandRepository, mapper *mapper.Com
 
 // Execute remove um item da comanda e recalcula totais
 func (uc *RemoveCommandItemUseCase) Execute(ctx context.Context, commandID, itemID, tenantID uuid.UUID) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.RemoveCommandItem")
+	defer span.End()
+
 	// Buscar comanda
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
@@ -78,6 +82,9 @@ func NewRemoveCommandPaymentUseCase(repo port.CommandRepository, mapper *mapper.
 
 // Execute remove um pagamento da comanda e recalcula totais
 func (uc *RemoveCommandPaymentUseCase) Execute(ctx context.Context, commandID, paymentID, tenantID uuid.UUID) (*dto.CommandResponse, error) {
+	ctx, span := common.StartSpan(ctx, "command.RemoveCommandPayment")
+	defer span.End()
+
 	// Buscar comanda
 	command, err := uc.repo.FindByID(ctx, commandID, tenantID)
 	if err != nil {
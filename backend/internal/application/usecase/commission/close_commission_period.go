@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -69,6 +70,9 @@ func NewCloseCommissionPeriodUseCase(
 // 4. Cria ContaPagar para o profissional
 // 5. Fecha o período vinculando à ContaPagar
 func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input CloseCommissionPeriodInput) (*CloseCommissionPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CloseCommissionPeriod")
+	defer span.End()
+
 	// Verifica se existe
 	period, err := uc.commissionPeriodRepo.GetByID(ctx, input.TenantID, input.PeriodID)
 	if err != nil {
@@ -93,12 +97,12 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 	if period.ProfessionalID != nil {
 		advances, err := uc.advanceRepo.GetApprovedByProfessional(ctx, input.TenantID, *period.ProfessionalID)
 		if err != nil {
-			uc.logger.Warn("erro ao buscar adiantamentos do profissional",
+			common.Logger(ctx, uc.logger).Warn("erro ao buscar adiantamentos do profissional",
 				zap.String("professional_id", *period.ProfessionalID),
 				zap.Error(err))
 			// Continua mesmo com erro - não bloqueia fechamento
 		} else if len(advances) > 0 {
-			uc.logger.Info("adiantamentos encontrados para dedução",
+			common.Logger(ctx, uc.logger).Info("adiantamentos encontrados para dedução",
 				zap.String("professional_id", *period.ProfessionalID),
 				zap.Int("quantidade", len(advances)))
 
@@ -106,7 +110,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 			for _, advance := range advances {
 				_, err := uc.advanceRepo.MarkDeducted(ctx, input.TenantID, advance.ID, input.PeriodID)
 				if err != nil {
-					uc.logger.Warn("erro ao marcar adiantamento como deduzido",
+					common.Logger(ctx, uc.logger).Warn("erro ao marcar adiantamento como deduzido",
 						zap.String("advance_id", advance.ID),
 						zap.Error(err))
 					continue
@@ -115,7 +119,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 				advancesDeductedCount++
 			}
 
-			uc.logger.Info("adiantamentos deduzidos com sucesso",
+			common.Logger(ctx, uc.logger).Info("adiantamentos deduzidos com sucesso",
 				zap.Int("quantidade", advancesDeductedCount),
 				zap.String("total", totalAdvancesDeducted.String()))
 		}
@@ -132,7 +136,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 	// Buscar sumário do período para obter totais de comissões
 	summary, err := uc.commissionPeriodRepo.GetSummary(ctx, input.TenantID, input.PeriodID)
 	if err != nil {
-		uc.logger.Warn("erro ao buscar sumário do período", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao buscar sumário do período", zap.Error(err))
 		// Continua mesmo sem sumário
 	}
 
@@ -163,7 +167,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 
 		tenantUUID, err := uuid.Parse(input.TenantID)
 		if err != nil {
-			uc.logger.Error("erro ao converter tenant_id para uuid", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao converter tenant_id para uuid", zap.Error(err))
 			return nil, err
 		}
 
@@ -179,7 +183,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 			"",    // Sem periodicidade
 		)
 		if err != nil {
-			uc.logger.Error("erro ao criar conta a pagar para comissão", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao criar conta a pagar para comissão", zap.Error(err))
 			// Não bloqueia fechamento do período
 		} else {
 			contaPagar.Observacoes = fmt.Sprintf("Período de comissão: %s a %s",
@@ -187,13 +191,13 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 				period.PeriodEnd.Format("02/01/2006"))
 
 			if err := uc.contaPagarRepo.Create(ctx, contaPagar); err != nil {
-				uc.logger.Error("erro ao persistir conta a pagar", zap.Error(err))
+				common.Logger(ctx, uc.logger).Error("erro ao persistir conta a pagar", zap.Error(err))
 			} else {
 				output.ContaPagar = contaPagar
 				// Vincular ContaPagar ao período
 				period.ContaPagarID = &contaPagar.ID
 
-				uc.logger.Info("conta a pagar criada para comissão",
+				common.Logger(ctx, uc.logger).Info("conta a pagar criada para comissão",
 					zap.String("conta_pagar_id", contaPagar.ID),
 					zap.String("period_id", period.ID),
 					zap.String("valor", totalNet.String()))
@@ -218,12 +222,12 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 			period.PeriodEnd,
 		)
 		if err != nil {
-			uc.logger.Warn("erro ao processar itens de comissão do período",
+			common.Logger(ctx, uc.logger).Warn("erro ao processar itens de comissão do período",
 				zap.String("period_id", input.PeriodID),
 				zap.Error(err))
 			// Não bloqueia - o período já foi fechado
 		} else {
-			uc.logger.Info("itens de comissão processados",
+			common.Logger(ctx, uc.logger).Info("itens de comissão processados",
 				zap.String("period_id", input.PeriodID),
 				zap.Int64("itens_processados", itemsProcessed))
 		}
@@ -231,7 +235,7 @@ func (uc *CloseCommissionPeriodUseCase) Execute(ctx context.Context, input Close
 
 	output.CommissionPeriod = closed
 
-	uc.logger.Info("período de comissão fechado",
+	common.Logger(ctx, uc.logger).Info("período de comissão fechado",
 		zap.String("period_id", input.PeriodID),
 		zap.String("total_comissao", totalNet.String()),
 		zap.Int("adiantamentos_deduzidos", advancesDeductedCount),
@@ -267,6 +271,9 @@ func NewMarkPeriodAsPaidUseCase(commissionPeriodRepo repository.CommissionPeriod
 
 // Execute executa o use case
 func (uc *MarkPeriodAsPaidUseCase) Execute(ctx context.Context, input MarkPeriodAsPaidInput) (*MarkPeriodAsPaidOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.MarkPeriodAsPaid")
+	defer span.End()
+
 	// Verifica se existe
 	period, err := uc.commissionPeriodRepo.GetByID(ctx, input.TenantID, input.PeriodID)
 	if err != nil {
@@ -316,6 +323,9 @@ func NewDeleteCommissionPeriodUseCase(commissionPeriodRepo repository.Commission
 
 // Execute executa o use case
 func (uc *DeleteCommissionPeriodUseCase) Execute(ctx context.Context, input DeleteCommissionPeriodInput) (*DeleteCommissionPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeleteCommissionPeriod")
+	defer span.End()
+
 	// Verifica se existe
 	period, err := uc.commissionPeriodRepo.GetByID(ctx, input.TenantID, input.PeriodID)
 	if err != nil {
@@ -3,6 +3,7 @@ package commission
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 	"github.com/google/uuid"
@@ -38,6 +39,9 @@ func NewCreateAdvanceUseCase(advanceRepo repository.AdvanceRepository) *CreateAd
 
 // Execute executa o use case
 func (uc *CreateAdvanceUseCase) Execute(ctx context.Context, input CreateAdvanceInput) (*CreateAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CreateAdvance")
+	defer span.End()
+
 	// Converte TenantID para UUID
 	tenantUUID, err := uuid.Parse(input.TenantID)
 	if err != nil {
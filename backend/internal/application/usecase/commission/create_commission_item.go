@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 	"github.com/google/uuid"
@@ -49,6 +50,9 @@ func NewCreateCommissionItemUseCase(commissionItemRepo repository.CommissionItem
 
 // Execute executa o use case
 func (uc *CreateCommissionItemUseCase) Execute(ctx context.Context, input CreateCommissionItemInput) (*CreateCommissionItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CreateCommissionItem")
+	defer span.End()
+
 	// Converte valores para decimal
 	grossValue, err := decimal.NewFromString(input.GrossValue)
 	if err != nil {
@@ -123,6 +127,9 @@ func NewCreateCommissionItemBatchUseCase(commissionItemRepo repository.Commissio
 
 // Execute executa o use case
 func (uc *CreateCommissionItemBatchUseCase) Execute(ctx context.Context, input CreateCommissionItemBatchInput) (*CreateCommissionItemBatchOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CreateCommissionItemBatch")
+	defer span.End()
+
 	items := make([]*entity.CommissionItem, 0, len(input.Items))
 
 	for _, itemInput := range input.Items {
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 	"github.com/google/uuid"
@@ -40,6 +41,9 @@ func NewCreateCommissionPeriodUseCase(commissionPeriodRepo repository.Commission
 
 // Execute executa o use case
 func (uc *CreateCommissionPeriodUseCase) Execute(ctx context.Context, input CreateCommissionPeriodInput) (*CreateCommissionPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CreateCommissionPeriod")
+	defer span.End()
+
 	// Verifica se já existe período aberto para o profissional
 	existingPeriod, err := uc.commissionPeriodRepo.GetOpenByProfessional(ctx, input.TenantID, input.ProfessionalID)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 	"github.com/google/uuid"
@@ -47,6 +48,9 @@ func NewCreateCommissionRuleUseCase(commissionRuleRepo repository.CommissionRule
 
 // Execute executa o use case
 func (uc *CreateCommissionRuleUseCase) Execute(ctx context.Context, input CreateCommissionRuleInput) (*CreateCommissionRuleOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CreateCommissionRule")
+	defer span.End()
+
 	// Converte DefaultRate para decimal
 	defaultRate, err := decimal.NewFromString(input.DefaultRate)
 	if err != nil {
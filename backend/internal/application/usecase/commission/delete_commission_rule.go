@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -33,6 +34,9 @@ func NewDeleteCommissionRuleUseCase(commissionRuleRepo repository.CommissionRule
 
 // Execute executa o use case
 func (uc *DeleteCommissionRuleUseCase) Execute(ctx context.Context, input DeleteCommissionRuleInput) (*DeleteCommissionRuleOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeleteCommissionRule")
+	defer span.End()
+
 	// Verifica se existe
 	rule, err := uc.commissionRuleRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
@@ -77,6 +81,9 @@ func NewDeactivateCommissionRuleUseCase(commissionRuleRepo repository.Commission
 
 // Execute executa o use case
 func (uc *DeactivateCommissionRuleUseCase) Execute(ctx context.Context, input DeactivateCommissionRuleInput) (*DeactivateCommissionRuleOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeactivateCommissionRule")
+	defer span.End()
+
 	// Verifica se existe
 	rule, err := uc.commissionRuleRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
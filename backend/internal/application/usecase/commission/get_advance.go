@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -34,6 +35,9 @@ func NewGetAdvanceUseCase(advanceRepo repository.AdvanceRepository) *GetAdvanceU
 
 // Execute executa o use case
 func (uc *GetAdvanceUseCase) Execute(ctx context.Context, input GetAdvanceInput) (*GetAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetAdvance")
+	defer span.End()
+
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
@@ -70,6 +74,9 @@ func NewListAdvancesUseCase(advanceRepo repository.AdvanceRepository) *ListAdvan
 
 // Execute executa o use case
 func (uc *ListAdvancesUseCase) Execute(ctx context.Context, input ListAdvancesInput) (*ListAdvancesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListAdvances")
+	defer span.End()
+
 	limit := input.Limit
 	if limit <= 0 {
 		limit = 50
@@ -109,6 +116,9 @@ func NewGetPendingAdvancesUseCase(advanceRepo repository.AdvanceRepository) *Get
 
 // Execute executa o use case
 func (uc *GetPendingAdvancesUseCase) Execute(ctx context.Context, input GetPendingAdvancesInput) (*GetPendingAdvancesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetPendingAdvances")
+	defer span.End()
+
 	advances, err := uc.advanceRepo.GetPendingByProfessional(ctx, input.TenantID, input.ProfessionalID)
 	if err != nil {
 		return nil, err
@@ -148,6 +158,9 @@ func NewGetApprovedAdvancesUseCase(advanceRepo repository.AdvanceRepository) *Ge
 
 // Execute executa o use case
 func (uc *GetApprovedAdvancesUseCase) Execute(ctx context.Context, input GetApprovedAdvancesInput) (*GetApprovedAdvancesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetApprovedAdvances")
+	defer span.End()
+
 	advances, err := uc.advanceRepo.GetApprovedByProfessional(ctx, input.TenantID, input.ProfessionalID)
 	if err != nil {
 		return nil, err
@@ -187,6 +200,9 @@ func NewListAdvancesByDateRangeUseCase(advanceRepo repository.AdvanceRepository)
 
 // Execute executa o use case
 func (uc *ListAdvancesByDateRangeUseCase) Execute(ctx context.Context, input ListAdvancesByDateRangeInput) (*ListAdvancesByDateRangeOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListAdvancesByDateRange")
+	defer span.End()
+
 	advances, err := uc.advanceRepo.GetByDateRange(ctx, input.TenantID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -34,6 +35,9 @@ func NewGetCommissionItemUseCase(commissionItemRepo repository.CommissionItemRep
 
 // Execute executa o use case
 func (uc *GetCommissionItemUseCase) Execute(ctx context.Context, input GetCommissionItemInput) (*GetCommissionItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionItem")
+	defer span.End()
+
 	item, err := uc.commissionItemRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
@@ -67,6 +71,9 @@ func NewGetCommissionItemByCommandItemUseCase(commissionItemRepo repository.Comm
 
 // Execute executa o use case
 func (uc *GetCommissionItemByCommandItemUseCase) Execute(ctx context.Context, input GetCommissionItemByCommandItemInput) (*GetCommissionItemByCommandItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionItemByCommandItem")
+	defer span.End()
+
 	item, err := uc.commissionItemRepo.GetByCommandItem(ctx, input.TenantID, input.CommandItemID)
 	if err != nil {
 		return nil, err
@@ -104,6 +111,9 @@ func NewListCommissionItemsUseCase(commissionItemRepo repository.CommissionItemR
 
 // Execute executa o use case
 func (uc *ListCommissionItemsUseCase) Execute(ctx context.Context, input ListCommissionItemsInput) (*ListCommissionItemsOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListCommissionItems")
+	defer span.End()
+
 	limit := input.Limit
 	if limit <= 0 {
 		limit = 50
@@ -142,6 +152,9 @@ func NewGetPendingCommissionItemsUseCase(commissionItemRepo repository.Commissio
 
 // Execute executa o use case
 func (uc *GetPendingCommissionItemsUseCase) Execute(ctx context.Context, input GetPendingCommissionItemsInput) (*GetPendingCommissionItemsOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetPendingCommissionItems")
+	defer span.End()
+
 	items, err := uc.commissionItemRepo.GetPendingByProfessional(ctx, input.TenantID, input.ProfessionalID)
 	if err != nil {
 		return nil, err
@@ -176,6 +189,9 @@ func NewListCommissionItemsByDateRangeUseCase(commissionItemRepo repository.Comm
 
 // Execute executa o use case
 func (uc *ListCommissionItemsByDateRangeUseCase) Execute(ctx context.Context, input ListCommissionItemsByDateRangeInput) (*ListCommissionItemsByDateRangeOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListCommissionItemsByDateRange")
+	defer span.End()
+
 	items, err := uc.commissionItemRepo.GetByDateRange(ctx, input.TenantID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
@@ -210,6 +226,9 @@ func NewGetCommissionSummaryByProfessionalUseCase(commissionItemRepo repository.
 
 // Execute executa o use case
 func (uc *GetCommissionSummaryByProfessionalUseCase) Execute(ctx context.Context, input GetCommissionSummaryByProfessionalInput) (*GetCommissionSummaryByProfessionalOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionSummaryByProfessional")
+	defer span.End()
+
 	summaries, err := uc.commissionItemRepo.GetSummaryByProfessional(ctx, input.TenantID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
@@ -244,6 +263,9 @@ func NewGetCommissionSummaryByServiceUseCase(commissionItemRepo repository.Commi
 
 // Execute executa o use case
 func (uc *GetCommissionSummaryByServiceUseCase) Execute(ctx context.Context, input GetCommissionSummaryByServiceInput) (*GetCommissionSummaryByServiceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionSummaryByService")
+	defer span.End()
+
 	summaries, err := uc.commissionItemRepo.GetSummaryByService(ctx, input.TenantID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -34,6 +35,9 @@ func NewGetCommissionPeriodUseCase(commissionPeriodRepo repository.CommissionPer
 
 // Execute executa o use case
 func (uc *GetCommissionPeriodUseCase) Execute(ctx context.Context, input GetCommissionPeriodInput) (*GetCommissionPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionPeriod")
+	defer span.End()
+
 	period, err := uc.commissionPeriodRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
@@ -67,6 +71,9 @@ func NewGetOpenCommissionPeriodUseCase(commissionPeriodRepo repository.Commissio
 
 // Execute executa o use case
 func (uc *GetOpenCommissionPeriodUseCase) Execute(ctx context.Context, input GetOpenCommissionPeriodInput) (*GetOpenCommissionPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetOpenCommissionPeriod")
+	defer span.End()
+
 	period, err := uc.commissionPeriodRepo.GetOpenByProfessional(ctx, input.TenantID, input.ProfessionalID)
 	if err != nil {
 		return nil, err
@@ -100,6 +107,9 @@ func NewGetCommissionPeriodSummaryUseCase(commissionPeriodRepo repository.Commis
 
 // Execute executa o use case
 func (uc *GetCommissionPeriodSummaryUseCase) Execute(ctx context.Context, input GetCommissionPeriodSummaryInput) (*GetCommissionPeriodSummaryOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionPeriodSummary")
+	defer span.End()
+
 	summary, err := uc.commissionPeriodRepo.GetSummary(ctx, input.TenantID, input.PeriodID)
 	if err != nil {
 		return nil, err
@@ -136,6 +146,9 @@ func NewListCommissionPeriodsUseCase(commissionPeriodRepo repository.CommissionP
 
 // Execute executa o use case
 func (uc *ListCommissionPeriodsUseCase) Execute(ctx context.Context, input ListCommissionPeriodsInput) (*ListCommissionPeriodsOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListCommissionPeriods")
+	defer span.End()
+
 	limit := input.Limit
 	if limit <= 0 {
 		limit = 50
@@ -175,6 +188,9 @@ func NewListCommissionPeriodsByDateRangeUseCase(commissionPeriodRepo repository.
 
 // Execute executa o use case
 func (uc *ListCommissionPeriodsByDateRangeUseCase) Execute(ctx context.Context, input ListCommissionPeriodsByDateRangeInput) (*ListCommissionPeriodsByDateRangeOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListCommissionPeriodsByDateRange")
+	defer span.End()
+
 	periods, err := uc.commissionPeriodRepo.GetByDateRange(ctx, input.TenantID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
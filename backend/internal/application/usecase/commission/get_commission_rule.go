@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -33,6 +34,9 @@ func NewGetCommissionRuleUseCase(commissionRuleRepo repository.CommissionRuleRep
 
 // Execute executa o use case
 func (uc *GetCommissionRuleUseCase) Execute(ctx context.Context, input GetCommissionRuleInput) (*GetCommissionRuleOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetCommissionRule")
+	defer span.End()
+
 	rule, err := uc.commissionRuleRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
 		return nil, err
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
 )
@@ -34,6 +35,9 @@ func NewListCommissionRulesUseCase(commissionRuleRepo repository.CommissionRuleR
 
 // Execute executa o use case
 func (uc *ListCommissionRulesUseCase) Execute(ctx context.Context, input ListCommissionRulesInput) (*ListCommissionRulesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ListCommissionRules")
+	defer span.End()
+
 	var rules []*entity.CommissionRule
 	var err error
 
@@ -75,6 +79,9 @@ func NewGetEffectiveCommissionRulesUseCase(commissionRuleRepo repository.Commiss
 
 // Execute executa o use case
 func (uc *GetEffectiveCommissionRulesUseCase) Execute(ctx context.Context, input GetEffectiveCommissionRulesInput) (*GetEffectiveCommissionRulesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.GetEffectiveCommissionRules")
+	defer span.End()
+
 	rules, err := uc.commissionRuleRepo.GetEffective(ctx, input.TenantID, input.Date)
 	if err != nil {
 		return nil, err
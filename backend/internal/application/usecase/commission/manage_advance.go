@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
@@ -35,6 +36,9 @@ func NewApproveAdvanceUseCase(advanceRepo repository.AdvanceRepository) *Approve
 
 // Execute executa o use case
 func (uc *ApproveAdvanceUseCase) Execute(ctx context.Context, input ApproveAdvanceInput) (*ApproveAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ApproveAdvance")
+	defer span.End()
+
 	// Verifica se existe
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.AdvanceID)
 	if err != nil {
@@ -86,6 +90,9 @@ func NewRejectAdvanceUseCase(advanceRepo repository.AdvanceRepository) *RejectAd
 
 // Execute executa o use case
 func (uc *RejectAdvanceUseCase) Execute(ctx context.Context, input RejectAdvanceInput) (*RejectAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.RejectAdvance")
+	defer span.End()
+
 	// Verifica se existe
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.AdvanceID)
 	if err != nil {
@@ -141,6 +148,9 @@ func NewMarkAdvanceDeductedUseCase(advanceRepo repository.AdvanceRepository) *Ma
 
 // Execute executa o use case
 func (uc *MarkAdvanceDeductedUseCase) Execute(ctx context.Context, input MarkAdvanceDeductedInput) (*MarkAdvanceDeductedOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.MarkAdvanceDeducted")
+	defer span.End()
+
 	// Verifica se existe
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.AdvanceID)
 	if err != nil {
@@ -190,6 +200,9 @@ func NewCancelAdvanceUseCase(advanceRepo repository.AdvanceRepository) *CancelAd
 
 // Execute executa o use case
 func (uc *CancelAdvanceUseCase) Execute(ctx context.Context, input CancelAdvanceInput) (*CancelAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.CancelAdvance")
+	defer span.End()
+
 	// Verifica se existe
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.AdvanceID)
 	if err != nil {
@@ -239,6 +252,9 @@ func NewDeleteAdvanceUseCase(advanceRepo repository.AdvanceRepository) *DeleteAd
 
 // Execute executa o use case
 func (uc *DeleteAdvanceUseCase) Execute(ctx context.Context, input DeleteAdvanceInput) (*DeleteAdvanceOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeleteAdvance")
+	defer span.End()
+
 	// Verifica se existe
 	advance, err := uc.advanceRepo.GetByID(ctx, input.TenantID, input.AdvanceID)
 	if err != nil {
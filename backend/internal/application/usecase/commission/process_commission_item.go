@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
@@ -36,6 +37,9 @@ func NewProcessCommissionItemUseCase(commissionItemRepo repository.CommissionIte
 
 // Execute executa o use case
 func (uc *ProcessCommissionItemUseCase) Execute(ctx context.Context, input ProcessCommissionItemInput) (*ProcessCommissionItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.ProcessCommissionItem")
+	defer span.End()
+
 	// Verifica se existe
 	item, err := uc.commissionItemRepo.GetByID(ctx, input.TenantID, input.ItemID)
 	if err != nil {
@@ -88,6 +92,9 @@ func NewAssignItemsToPeriodUseCase(commissionItemRepo repository.CommissionItemR
 
 // Execute executa o use case
 func (uc *AssignItemsToPeriodUseCase) Execute(ctx context.Context, input AssignItemsToPeriodInput) (*AssignItemsToPeriodOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.AssignItemsToPeriod")
+	defer span.End()
+
 	count, err := uc.commissionItemRepo.AssignToPeriod(ctx, input.TenantID, input.ProfessionalID, input.PeriodID, input.StartDate, input.EndDate)
 	if err != nil {
 		return nil, err
@@ -121,6 +128,9 @@ func NewDeleteCommissionItemUseCase(commissionItemRepo repository.CommissionItem
 
 // Execute executa o use case
 func (uc *DeleteCommissionItemUseCase) Execute(ctx context.Context, input DeleteCommissionItemInput) (*DeleteCommissionItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeleteCommissionItem")
+	defer span.End()
+
 	// Verifica se existe
 	item, err := uc.commissionItemRepo.GetByID(ctx, input.TenantID, input.ItemID)
 	if err != nil {
@@ -170,6 +180,9 @@ func NewDeleteCommissionItemByCommandItemUseCase(commissionItemRepo repository.C
 
 // Execute executa o use case
 func (uc *DeleteCommissionItemByCommandItemUseCase) Execute(ctx context.Context, input DeleteCommissionItemByCommandItemInput) (*DeleteCommissionItemByCommandItemOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.DeleteCommissionItemByCommandItem")
+	defer span.End()
+
 	// Verifica se existe
 	item, err := uc.commissionItemRepo.GetByCommandItem(ctx, input.TenantID, input.CommandItemID)
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/repository"
@@ -47,6 +48,9 @@ func NewUpdateCommissionRuleUseCase(commissionRuleRepo repository.CommissionRule
 
 // Execute executa o use case
 func (uc *UpdateCommissionRuleUseCase) Execute(ctx context.Context, input UpdateCommissionRuleInput) (*UpdateCommissionRuleOutput, error) {
+	ctx, span := common.StartSpan(ctx, "commission.UpdateCommissionRule")
+	defer span.End()
+
 	// Busca a regra existente
 	rule, err := uc.commissionRuleRepo.GetByID(ctx, input.TenantID, input.ID)
 	if err != nil {
@@ -0,0 +1,96 @@
+package common
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// PublishEvent publica um evento de domínio sem afetar o resultado do use
+// case: publisher nil é ignorado e falhas são apenas registradas no log.
+func PublishEvent(ctx context.Context, events port.EventPublisher, logger *zap.Logger, evt port.DomainEvent) {
+	if events == nil {
+		return
+	}
+	if evt.OccurredAt.IsZero() {
+		evt.OccurredAt = time.Now()
+	}
+	if err := events.Publish(ctx, evt); err != nil && logger != nil {
+		logger.Warn("Erro ao publicar evento de domínio",
+			zap.String("evento", string(evt.Type)),
+			zap.String("tenant_id", evt.TenantID),
+			zap.Error(err),
+		)
+	}
+}
+
+// PublishStockBelowMinimum publica stock.below_minimum quando a movimentação
+// leva o produto ao estoque mínimo. Só dispara na virada (acima → no mínimo
+// ou abaixo), não a cada saída com o estoque já baixo.
+func PublishStockBelowMinimum(ctx context.Context, events port.EventPublisher, logger *zap.Logger, produto *entity.Produto, quantidadeAnterior decimal.Decimal) {
+	if !produto.EstaBaixo() || quantidadeAnterior.LessThanOrEqual(produto.QuantidadeMinima) {
+		return
+	}
+	PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventStockBelowMinimum,
+		TenantID: produto.TenantID.String(),
+		Data: map[string]any{
+			"produto_id":          produto.ID.String(),
+			"nome":                produto.Nome,
+			"quantidade_atual":    produto.QuantidadeAtual.String(),
+			"quantidade_minima":   produto.QuantidadeMinima.String(),
+			"quantidade_anterior": quantidadeAnterior.String(),
+		},
+	})
+}
+
+// appointmentEventData monta o payload dos eventos de agendamento
+func appointmentEventData(a *entity.Appointment) map[string]any {
+	data := map[string]any{
+		"appointment_id":  a.ID,
+		"professional_id": a.ProfessionalID,
+		"customer_id":     a.CustomerID,
+		"status":          a.Status.String(),
+		"start_time":      a.StartTime.Format(time.RFC3339),
+		"end_time":        a.EndTime.Format(time.RFC3339),
+		"total_price":     a.TotalPrice.String(),
+	}
+	if a.CommandID != "" {
+		data["command_id"] = a.CommandID
+	}
+	return data
+}
+
+// PublishAppointmentCreated publica appointment.created
+func PublishAppointmentCreated(ctx context.Context, events port.EventPublisher, logger *zap.Logger, a *entity.Appointment) {
+	PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventAppointmentCreated,
+		TenantID: a.TenantID.String(),
+		UnitID:   a.UnitID.String(),
+		Data:     appointmentEventData(a),
+	})
+}
+
+// PublishAppointmentStatusChanged publica appointment.status_changed com o
+// status anterior; não publica se o status não mudou
+func PublishAppointmentStatusChanged(ctx context.Context, events port.EventPublisher, logger *zap.Logger, a *entity.Appointment, anterior valueobject.AppointmentStatus) {
+	if a.Status == anterior {
+		return
+	}
+	data := appointmentEventData(a)
+	data["previous_status"] = anterior.String()
+	if a.CanceledReason != "" {
+		data["reason"] = a.CanceledReason
+	}
+	PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventAppointmentStatusChanged,
+		TenantID: a.TenantID.String(),
+		UnitID:   a.UnitID.String(),
+		Data:     data,
+	})
+}
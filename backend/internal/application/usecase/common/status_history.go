@@ -0,0 +1,30 @@
+package common
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"go.uber.org/zap"
+)
+
+// RecordAppointmentStatusChange grava a transição no histórico de status do
+// agendamento sem afetar o resultado do use case (o agendamento já foi
+// salvo): repositório nil é ignorado e falhas são registradas no log. Não
+// grava se o status não mudou. actorID vazio indica job ou integração.
+func RecordAppointmentStatusChange(ctx context.Context, history port.AppointmentStatusHistoryRepository, logger *zap.Logger, a *entity.Appointment, anterior valueobject.AppointmentStatus, actorID, reason string) {
+	if history == nil || a.Status == anterior {
+		return
+	}
+	change := entity.NewAppointmentStatusChange(a, anterior, actorID, reason)
+	if err := history.Append(ctx, change); err != nil && logger != nil {
+		logger.Error("Erro ao gravar histórico de status do agendamento",
+			zap.String("tenant_id", a.TenantID.String()),
+			zap.String("appointment_id", a.ID),
+			zap.String("from_status", anterior.String()),
+			zap.String("to_status", a.Status.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,37 @@
+package common
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// tracerName identifica os spans dos use cases
+const tracerName = "github.com/andviana23/barber-analytics-backend/usecase"
+
+// StartSpan abre o span de um use case ("command.CloseCommand"). Usa apenas a
+// API do OpenTelemetry: sem provider configurado o span é no-op e o contexto
+// recebido é devolvido sem alteração.
+func StartSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	spanCtx, span := otel.Tracer(tracerName).Start(ctx, name)
+	if !span.IsRecording() {
+		return ctx, span
+	}
+	return spanCtx, span
+}
+
+// Logger retorna o logger com trace_id e span_id do span ativo no contexto,
+// para os logs do use case aparecerem junto do trace da requisição. Sem span
+// amostrado, devolve o próprio logger.
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return logger
+	}
+	return logger.With(
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -35,10 +36,13 @@ func NewCreateCustomerUseCase(repo port.CustomerRepository, logger *zap.Logger)
 
 // Execute cria um novo cliente
 func (uc *CreateCustomerUseCase) Execute(ctx context.Context, tenantID string, req dto.CreateCustomerRequest) (*entity.Customer, error) {
+	ctx, span := common.StartSpan(ctx, "customer.CreateCustomer")
+	defer span.End()
+
 	// Verificar duplicidade de telefone
 	exists, err := uc.repo.CheckPhoneExists(ctx, tenantID, req.Telefone, nil)
 	if err != nil {
-		uc.logger.Error("erro ao verificar telefone duplicado", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao verificar telefone duplicado", zap.Error(err))
 		return nil, err
 	}
 	if exists {
@@ -49,7 +53,7 @@ func (uc *CreateCustomerUseCase) Execute(ctx context.Context, tenantID string, r
 	if req.CPF != nil && *req.CPF != "" {
 		cpfExists, err := uc.repo.CheckCPFExists(ctx, tenantID, *req.CPF, nil)
 		if err != nil {
-			uc.logger.Error("erro ao verificar CPF duplicado", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("erro ao verificar CPF duplicado", zap.Error(err))
 			return nil, err
 		}
 		if cpfExists {
@@ -126,11 +130,11 @@ func (uc *CreateCustomerUseCase) Execute(ctx context.Context, tenantID string, r
 
 	// Persistir
 	if err := uc.repo.Create(ctx, customer); err != nil {
-		uc.logger.Error("erro ao criar cliente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao criar cliente", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("cliente criado com sucesso",
+	common.Logger(ctx, uc.logger).Info("cliente criado com sucesso",
 		zap.String("customer_id", customer.ID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -158,6 +162,9 @@ func NewUpdateCustomerUseCase(repo port.CustomerRepository, logger *zap.Logger)
 
 // Execute atualiza um cliente existente
 func (uc *UpdateCustomerUseCase) Execute(ctx context.Context, tenantID, customerID string, req dto.UpdateCustomerRequest) (*entity.Customer, error) {
+	ctx, span := common.StartSpan(ctx, "customer.UpdateCustomer")
+	defer span.End()
+
 	// Buscar cliente existente
 	customer, err := uc.repo.FindByID(ctx, tenantID, customerID)
 	if err != nil {
@@ -258,11 +265,11 @@ func (uc *UpdateCustomerUseCase) Execute(ctx context.Context, tenantID, customer
 
 	// Persistir
 	if err := uc.repo.Update(ctx, customer); err != nil {
-		uc.logger.Error("erro ao atualizar cliente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao atualizar cliente", zap.Error(err))
 		return nil, err
 	}
 
-	uc.logger.Info("cliente atualizado com sucesso",
+	common.Logger(ctx, uc.logger).Info("cliente atualizado com sucesso",
 		zap.String("customer_id", customer.ID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -290,9 +297,12 @@ func NewListCustomersUseCase(repo port.CustomerRepository, logger *zap.Logger) *
 
 // Execute lista clientes com filtros
 func (uc *ListCustomersUseCase) Execute(ctx context.Context, tenantID string, filter port.CustomerFilter) ([]*entity.Customer, int64, error) {
+	ctx, span := common.StartSpan(ctx, "customer.ListCustomers")
+	defer span.End()
+
 	customers, total, err := uc.repo.List(ctx, tenantID, filter)
 	if err != nil {
-		uc.logger.Error("erro ao listar clientes", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar clientes", zap.Error(err))
 		return nil, 0, err
 	}
 
@@ -319,9 +329,12 @@ func NewGetCustomerUseCase(repo port.CustomerRepository, logger *zap.Logger) *Ge
 
 // Execute busca um cliente por ID
 func (uc *GetCustomerUseCase) Execute(ctx context.Context, tenantID, customerID string) (*entity.Customer, error) {
+	ctx, span := common.StartSpan(ctx, "customer.GetCustomer")
+	defer span.End()
+
 	customer, err := uc.repo.FindByID(ctx, tenantID, customerID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar cliente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar cliente", zap.Error(err))
 		return nil, err
 	}
 	if customer == nil {
@@ -351,9 +364,12 @@ func NewGetCustomerWithHistoryUseCase(repo port.CustomerRepository, logger *zap.
 
 // Execute busca um cliente com histórico completo
 func (uc *GetCustomerWithHistoryUseCase) Execute(ctx context.Context, tenantID, customerID string) (*port.CustomerWithHistory, error) {
+	ctx, span := common.StartSpan(ctx, "customer.GetCustomerWithHistory")
+	defer span.End()
+
 	cwh, err := uc.repo.GetWithHistory(ctx, tenantID, customerID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar cliente com histórico", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar cliente com histórico", zap.Error(err))
 		return nil, err
 	}
 	if cwh == nil {
@@ -370,19 +386,31 @@ func (uc *GetCustomerWithHistoryUseCase) Execute(ctx context.Context, tenantID,
 // InactivateCustomerUseCase inativa um cliente
 type InactivateCustomerUseCase struct {
 	repo   port.CustomerRepository
+	series SeriesEnder
 	logger *zap.Logger
 }
 
+// SeriesEnder encerra as séries de agendamentos recorrentes do cliente,
+// cancelando as ocorrências futuras pelo fluxo normal de cancelamento
+// (histórico, eventos, lista de espera, sinal e calendário externo)
+type SeriesEnder interface {
+	EndByCustomer(ctx context.Context, tenantID, customerID, actorID, reason string) (seriesEnded, appointmentsCanceled int, err error)
+}
+
 // NewInactivateCustomerUseCase cria uma nova instância do use case
-func NewInactivateCustomerUseCase(repo port.CustomerRepository, logger *zap.Logger) *InactivateCustomerUseCase {
+func NewInactivateCustomerUseCase(repo port.CustomerRepository, series SeriesEnder, logger *zap.Logger) *InactivateCustomerUseCase {
 	return &InactivateCustomerUseCase{
 		repo:   repo,
+		series: series,
 		logger: logger,
 	}
 }
 
-// Execute inativa um cliente (soft delete)
-func (uc *InactivateCustomerUseCase) Execute(ctx context.Context, tenantID, customerID string) error {
+// Execute inativa um cliente (soft delete); actorID é o usuário que inativou
+func (uc *InactivateCustomerUseCase) Execute(ctx context.Context, tenantID, customerID, actorID string) error {
+	ctx, span := common.StartSpan(ctx, "customer.InactivateCustomer")
+	defer span.End()
+
 	// Verificar se existe
 	customer, err := uc.repo.FindByID(ctx, tenantID, customerID)
 	if err != nil {
@@ -393,11 +421,27 @@ func (uc *InactivateCustomerUseCase) Execute(ctx context.Context, tenantID, cust
 	}
 
 	if err := uc.repo.Inactivate(ctx, tenantID, customerID); err != nil {
-		uc.logger.Error("erro ao inativar cliente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao inativar cliente", zap.Error(err))
 		return err
 	}
 
-	uc.logger.Info("cliente inativado com sucesso",
+	// Agendamentos recorrentes do cliente param junto com o cadastro
+	if uc.series != nil {
+		ended, canceled, err := uc.series.EndByCustomer(ctx, tenantID, customerID, actorID, "cliente inativado")
+		if err != nil {
+			common.Logger(ctx, uc.logger).Error("erro ao encerrar séries do cliente inativado", zap.Error(err))
+			return err
+		}
+		if ended > 0 {
+			common.Logger(ctx, uc.logger).Info("séries de agendamentos do cliente encerradas",
+				zap.String("customer_id", customerID),
+				zap.Int("series", ended),
+				zap.Int("appointments_canceled", canceled),
+			)
+		}
+	}
+
+	common.Logger(ctx, uc.logger).Info("cliente inativado com sucesso",
 		zap.String("customer_id", customerID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -425,9 +469,12 @@ func NewSearchCustomersUseCase(repo port.CustomerRepository, logger *zap.Logger)
 
 // Execute busca clientes por nome, telefone ou email
 func (uc *SearchCustomersUseCase) Execute(ctx context.Context, tenantID, term string) ([]*port.CustomerSummary, error) {
+	ctx, span := common.StartSpan(ctx, "customer.SearchCustomers")
+	defer span.End()
+
 	customers, err := uc.repo.Search(ctx, tenantID, term)
 	if err != nil {
-		uc.logger.Error("erro ao buscar clientes", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar clientes", zap.Error(err))
 		return nil, err
 	}
 
@@ -454,16 +501,19 @@ func NewExportCustomerDataUseCase(repo port.CustomerRepository, logger *zap.Logg
 
 // Execute exporta todos os dados do cliente para LGPD
 func (uc *ExportCustomerDataUseCase) Execute(ctx context.Context, tenantID, customerID string) (*port.CustomerExport, error) {
+	ctx, span := common.StartSpan(ctx, "customer.ExportCustomerData")
+	defer span.End()
+
 	export, err := uc.repo.GetDataForExport(ctx, tenantID, customerID)
 	if err != nil {
-		uc.logger.Error("erro ao exportar dados do cliente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao exportar dados do cliente", zap.Error(err))
 		return nil, err
 	}
 	if export == nil {
 		return nil, domain.ErrCustomerNotFound
 	}
 
-	uc.logger.Info("dados do cliente exportados (LGPD)",
+	common.Logger(ctx, uc.logger).Info("dados do cliente exportados (LGPD)",
 		zap.String("customer_id", customerID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -491,9 +541,12 @@ func NewGetCustomerStatsUseCase(repo port.CustomerRepository, logger *zap.Logger
 
 // Execute retorna estatísticas dos clientes
 func (uc *GetCustomerStatsUseCase) Execute(ctx context.Context, tenantID string) (*port.CustomerStats, error) {
+	ctx, span := common.StartSpan(ctx, "customer.GetCustomerStats")
+	defer span.End()
+
 	stats, err := uc.repo.GetStats(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao obter estatísticas de clientes", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao obter estatísticas de clientes", zap.Error(err))
 		return nil, err
 	}
 
@@ -520,6 +573,9 @@ func NewCheckPhoneDuplicateUseCase(repo port.CustomerRepository, logger *zap.Log
 
 // Execute verifica duplicidade de telefone
 func (uc *CheckPhoneDuplicateUseCase) Execute(ctx context.Context, tenantID, phone string, excludeID *string) (bool, error) {
+	ctx, span := common.StartSpan(ctx, "customer.CheckPhoneDuplicate")
+	defer span.End()
+
 	return uc.repo.CheckPhoneExists(ctx, tenantID, phone, excludeID)
 }
 
@@ -543,6 +599,9 @@ func NewCheckCPFDuplicateUseCase(repo port.CustomerRepository, logger *zap.Logge
 
 // Execute verifica duplicidade de CPF
 func (uc *CheckCPFDuplicateUseCase) Execute(ctx context.Context, tenantID, cpf string, excludeID *string) (bool, error) {
+	ctx, span := common.StartSpan(ctx, "customer.CheckCPFDuplicate")
+	defer span.End()
+
 	return uc.repo.CheckCPFExists(ctx, tenantID, cpf, excludeID)
 }
 
@@ -566,9 +625,12 @@ func NewListActiveCustomersUseCase(repo port.CustomerRepository, logger *zap.Log
 
 // Execute lista clientes ativos para selects/autocomplete
 func (uc *ListActiveCustomersUseCase) Execute(ctx context.Context, tenantID string) ([]*port.CustomerSummary, error) {
+	ctx, span := common.StartSpan(ctx, "customer.ListActiveCustomers")
+	defer span.End()
+
 	customers, err := uc.repo.ListActive(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("erro ao listar clientes ativos", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar clientes ativos", zap.Error(err))
 		return nil, err
 	}
 
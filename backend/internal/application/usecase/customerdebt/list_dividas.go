@@ -0,0 +1,67 @@
+package customerdebt
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+)
+
+// ListDividasClienteUseCase lista as dívidas (fiado) de um cliente
+type ListDividasClienteUseCase struct {
+	repo port.CustomerDebtRepository
+}
+
+// NewListDividasClienteUseCase cria nova instância do use case
+func NewListDividasClienteUseCase(repo port.CustomerDebtRepository) *ListDividasClienteUseCase {
+	return &ListDividasClienteUseCase{repo: repo}
+}
+
+// Execute lista as dívidas do cliente com seus pagamentos
+func (uc *ListDividasClienteUseCase) Execute(ctx context.Context, tenantID, customerID uuid.UUID, somenteAbertas bool) ([]*entity.CustomerDebt, error) {
+	ctx, span := common.StartSpan(ctx, "customerdebt.ListDividasCliente")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	debts, err := uc.repo.ListByCustomer(ctx, tenantID, customerID, somenteAbertas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, debt := range debts {
+		pagamentos, err := uc.repo.ListPayments(ctx, debt.ID, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		debt.Pagamentos = pagamentos
+	}
+
+	return debts, nil
+}
+
+// GetAgingUseCase gera o relatório de fiado por cliente (saldo e idade)
+type GetAgingUseCase struct {
+	repo port.CustomerDebtRepository
+}
+
+// NewGetAgingUseCase cria nova instância do use case
+func NewGetAgingUseCase(repo port.CustomerDebtRepository) *GetAgingUseCase {
+	return &GetAgingUseCase{repo: repo}
+}
+
+// Execute retorna clientes com saldo devedor, do maior saldo para o menor
+func (uc *GetAgingUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*entity.CustomerDebtAging, error) {
+	ctx, span := common.StartSpan(ctx, "customerdebt.GetAging")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.ListAging(ctx, tenantID)
+}
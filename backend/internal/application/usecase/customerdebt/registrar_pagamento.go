@@ -0,0 +1,253 @@
+// Package customerdebt contém os use cases do fiado (dívidas de clientes)
+package customerdebt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// RegistrarPagamentoInput define os dados de entrada para pagamento de dívida
+type RegistrarPagamentoInput struct {
+	TenantID        uuid.UUID
+	UserID          uuid.UUID
+	DebtID          uuid.UUID
+	MeioPagamentoID uuid.UUID
+	Valor           decimal.Decimal
+	CommandID       *uuid.UUID // Comanda atual em que o cliente está quitando o saldo
+	Observacoes     *string
+}
+
+// RegistrarPagamentoUseCase registra pagamento (parcial ou total) de uma dívida.
+// O valor recebido entra no caixa do dia e gera ContaReceber como um pagamento
+// normal de comanda, vinculada à comanda que originou a dívida. O saldo, o
+// pagamento, a operação de caixa e as contas são gravados juntos pelo
+// CustomerDebtRepository (transação).
+type RegistrarPagamentoUseCase struct {
+	debtRepo          port.CustomerDebtRepository
+	commandRepo       port.CommandRepository
+	meioPagamentoRepo port.MeioPagamentoRepository
+	caixaRepo         port.CaixaDiarioRepository
+	logger            *zap.Logger
+}
+
+// NewRegistrarPagamentoUseCase cria nova instância do use case
+func NewRegistrarPagamentoUseCase(
+	debtRepo port.CustomerDebtRepository,
+	commandRepo port.CommandRepository,
+	meioPagamentoRepo port.MeioPagamentoRepository,
+	caixaRepo port.CaixaDiarioRepository,
+	logger *zap.Logger,
+) *RegistrarPagamentoUseCase {
+	return &RegistrarPagamentoUseCase{
+		debtRepo:          debtRepo,
+		commandRepo:       commandRepo,
+		meioPagamentoRepo: meioPagamentoRepo,
+		caixaRepo:         caixaRepo,
+		logger:            logger,
+	}
+}
+
+// Execute registra o pagamento da dívida
+// 1. Valida caixa aberto (obrigatório para registro financeiro)
+// 2. Valida o valor contra o saldo lido (domain logic)
+// 3. Monta a OperacaoCaixa de venda
+// 4. Monta as ContaReceber (rateio SERVICO/PRODUTO da comanda de origem) com D+ do meio de pagamento
+// 5. Abate o saldo de forma atômica e grava tudo na mesma transação (pagamento concorrente que esgote o saldo faz este falhar)
+func (uc *RegistrarPagamentoUseCase) Execute(ctx context.Context, input RegistrarPagamentoInput) (*entity.CustomerDebt, error) {
+	ctx, span := common.StartSpan(ctx, "customerdebt.RegistrarPagamento")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.MeioPagamentoID == uuid.Nil {
+		return nil, fmt.Errorf("meio_pagamento_id é obrigatório")
+	}
+	if !input.Valor.IsPositive() {
+		return nil, domain.ErrCustomerDebtValorInvalido
+	}
+
+	caixaAberto, err := uc.caixaRepo.FindAberto(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	debt, err := uc.debtRepo.FindByID(ctx, input.DebtID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validação antecipada; o abatimento atômico no repositório é quem decide
+	if err := debt.RegistrarPagamento(input.Valor); err != nil {
+		return nil, err
+	}
+
+	meioPagamento, err := uc.meioPagamentoRepo.FindByID(ctx, input.TenantID.String(), input.MeioPagamentoID.String())
+	if err != nil || meioPagamento == nil {
+		return nil, domain.ErrMeioPagamentoNotFound
+	}
+
+	nomeDescricao := meioPagamento.Nome
+	if nomeDescricao == "" {
+		nomeDescricao = string(meioPagamento.Tipo)
+	}
+	descricao := fmt.Sprintf("Fiado Comanda #%s - %s", debt.CommandID.String()[:8], nomeDescricao)
+
+	// Operação no caixa do dia
+	operacao, err := entity.NewOperacaoVenda(caixaAberto.ID, input.TenantID, input.UserID, input.Valor, descricao, meioPagamento.Tipo)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar operação de venda: %w", err)
+	}
+
+	payment := &entity.CustomerDebtPayment{
+		ID:              uuid.New(),
+		TenantID:        input.TenantID,
+		DebtID:          debt.ID,
+		CommandID:       input.CommandID,
+		MeioPagamentoID: input.MeioPagamentoID,
+		Valor:           input.Valor,
+		OperacaoCaixaID: &operacao.ID,
+		RecebidoPor:     &input.UserID,
+		Observacoes:     input.Observacoes,
+	}
+
+	// Contas a receber por origem, como nos pagamentos de comanda
+	contas := uc.montarContasReceber(ctx, debt, payment, meioPagamento, descricao)
+
+	debt, err = uc.debtRepo.RegistrarPagamento(ctx, payment, operacao, contas)
+	if err != nil {
+		return nil, err
+	}
+
+	novoTotalEntradas := caixaAberto.TotalEntradas.Add(input.Valor)
+	if err := uc.caixaRepo.UpdateTotais(ctx, caixaAberto.ID, input.TenantID, caixaAberto.TotalSangrias, caixaAberto.TotalReforcos, novoTotalEntradas); err != nil {
+		common.Logger(ctx, uc.logger).Error("erro ao atualizar totais do caixa", zap.Error(err))
+	}
+
+	common.Logger(ctx, uc.logger).Info("pagamento de fiado registrado",
+		zap.String("debt_id", debt.ID.String()),
+		zap.String("customer_id", debt.CustomerID.String()),
+		zap.String("valor", input.Valor.String()),
+		zap.String("saldo", debt.Saldo.String()),
+		zap.String("status", string(debt.Status)))
+
+	debt.Pagamentos = append(debt.Pagamentos, *payment)
+	return debt, nil
+}
+
+// montarContasReceber monta as contas a receber do pagamento, rateando entre
+// SERVICO e PRODUTO conforme os itens da comanda que originou a dívida; são
+// gravadas junto com o abatimento do saldo
+func (uc *RegistrarPagamentoUseCase) montarContasReceber(
+	ctx context.Context,
+	debt *entity.CustomerDebt,
+	payment *entity.CustomerDebtPayment,
+	meioPagamento *entity.MeioPagamento,
+	descricao string,
+) []port.CustomerDebtReceivable {
+	contas := make([]port.CustomerDebtReceivable, 0, 2)
+
+	ratioServicos := decimal.NewFromInt(1)
+	if command, err := uc.commandRepo.FindByID(ctx, debt.CommandID, debt.TenantID); err == nil && command != nil {
+		var totalServicos, totalItens decimal.Decimal
+		for _, item := range command.Items {
+			valor := decimal.NewFromFloat(item.PrecoFinal)
+			totalItens = totalItens.Add(valor)
+			if item.Tipo == entity.CommandItemTypeServico {
+				totalServicos = totalServicos.Add(valor)
+			}
+		}
+		if !totalItens.IsZero() {
+			ratioServicos = totalServicos.Div(totalItens)
+		}
+	}
+
+	valorServicos := payment.Valor.Mul(ratioServicos).Round(2)
+	splits := []struct {
+		origem string
+		valor  decimal.Decimal
+	}{
+		{origem: "SERVICO", valor: valorServicos},
+		{origem: "PRODUTO", valor: payment.Valor.Sub(valorServicos)},
+	}
+
+	now := time.Now()
+	competencia := now.Format("2006-01")
+	commandIDStr := debt.CommandID.String()
+
+	for _, sp := range splits {
+		if !sp.valor.IsPositive() {
+			continue
+		}
+
+		valorMoney := valueobject.NewMoneyFromDecimal(sp.valor)
+		dataVencimento := now
+		if meioPagamento.DMais > 0 {
+			dataVencimento = now.AddDate(0, 0, meioPagamento.DMais)
+		}
+
+		conta, err := entity.NewContaReceber(
+			debt.TenantID,
+			sp.origem,
+			nil,
+			fmt.Sprintf("%s (%s)", descricao, sp.origem),
+			valorMoney,
+			dataVencimento,
+		)
+		if err != nil {
+			common.Logger(ctx, uc.logger).Warn("erro ao criar conta a receber do fiado", zap.Error(err))
+			continue
+		}
+
+		conta.CommandID = &commandIDStr
+		conta.CompetenciaMes = &competencia
+		if meioPagamento.DMais > 0 {
+			conta.Status = valueobject.StatusContaConfirmado
+			conta.ValorPago = valueobject.Zero()
+			conta.ValorAberto = valorMoney
+			conta.ConfirmedAt = &now
+		} else {
+			conta.Status = valueobject.StatusContaRecebido
+			conta.ValorPago = valorMoney
+			conta.ValorAberto = valueobject.Zero()
+			conta.DataRecebimento = &now
+			conta.ReceivedAt = &now
+		}
+		receivable := port.CustomerDebtReceivable{Conta: conta}
+
+		// Compensação bancária automática para pagamentos D+
+		if meioPagamento.DMais > 0 {
+			taxaFixaDec := meioPagamento.TaxaFixa.Mul(sp.valor.Div(payment.Valor)).Round(2)
+			comp, err := entity.NewCompensacaoBancaria(
+				debt.TenantID,
+				conta.ID,
+				payment.MeioPagamentoID.String(),
+				now,
+				valorMoney,
+				valueobject.NewPercentageUnsafe(meioPagamento.Taxa),
+				valueobject.NewMoneyFromDecimal(taxaFixaDec),
+				valueobject.NewDMaisUnsafe(meioPagamento.DMais),
+			)
+			if err != nil {
+				common.Logger(ctx, uc.logger).Warn("erro ao criar compensação bancária do fiado", zap.Error(err))
+			} else {
+				_ = comp.MarcarComoConfirmado()
+				receivable.Compensacao = comp
+			}
+		}
+
+		contas = append(contas, receivable)
+	}
+
+	return contas
+}
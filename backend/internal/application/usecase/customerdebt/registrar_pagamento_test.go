@@ -0,0 +1,152 @@
+package customerdebt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// debtRepoFake guarda a dívida e abate o saldo como a query atômica:
+// recusa o pagamento se o saldo atual não cobre o valor
+type debtRepoFake struct {
+	port.CustomerDebtRepository
+	debt     entity.CustomerDebt
+	stale    *entity.CustomerDebt // leitura antiga devolvida por FindByID (pagamento concorrente)
+	payments []*entity.CustomerDebtPayment
+	contas   []port.CustomerDebtReceivable
+}
+
+func (f *debtRepoFake) FindByID(_ context.Context, _, _ uuid.UUID) (*entity.CustomerDebt, error) {
+	if f.stale != nil {
+		d := *f.stale
+		return &d, nil
+	}
+	d := f.debt
+	return &d, nil
+}
+
+func (f *debtRepoFake) RegistrarPagamento(_ context.Context, payment *entity.CustomerDebtPayment, _ *entity.OperacaoCaixa, contas []port.CustomerDebtReceivable) (*entity.CustomerDebt, error) {
+	if !f.debt.IsAberta() {
+		return nil, domain.ErrCustomerDebtJaQuitada
+	}
+	if f.debt.Saldo.LessThan(payment.Valor) {
+		return nil, domain.ErrCustomerDebtValorExcedente
+	}
+	if err := f.debt.RegistrarPagamento(payment.Valor); err != nil {
+		return nil, err
+	}
+	f.payments = append(f.payments, payment)
+	f.contas = append(f.contas, contas...)
+	d := f.debt
+	return &d, nil
+}
+
+type caixaRepoFake struct {
+	port.CaixaDiarioRepository
+	caixa *entity.CaixaDiario
+}
+
+func (f *caixaRepoFake) FindAberto(_ context.Context, _ uuid.UUID) (*entity.CaixaDiario, error) {
+	return f.caixa, nil
+}
+
+func (f *caixaRepoFake) UpdateTotais(_ context.Context, _, _ uuid.UUID, _, _, entradas decimal.Decimal) error {
+	f.caixa.TotalEntradas = entradas
+	return nil
+}
+
+type meioPagamentoRepoFake struct {
+	port.MeioPagamentoRepository
+	meio *entity.MeioPagamento
+}
+
+func (f *meioPagamentoRepoFake) FindByID(_ context.Context, _, _ string) (*entity.MeioPagamento, error) {
+	return f.meio, nil
+}
+
+type commandRepoFake struct {
+	port.CommandRepository
+}
+
+func (f *commandRepoFake) FindByID(_ context.Context, _, _ uuid.UUID) (*entity.Command, error) {
+	return nil, nil
+}
+
+func novoPagamentoUC(t *testing.T, valorDivida int64) (*RegistrarPagamentoUseCase, *debtRepoFake, RegistrarPagamentoInput) {
+	tenantID, userID := uuid.New(), uuid.New()
+	debt, err := entity.NewCustomerDebt(tenantID, uuid.New(), uuid.New(), decimal.NewFromInt(valorDivida))
+	require.NoError(t, err)
+	caixa, err := entity.NewCaixaDiario(tenantID, userID, decimal.NewFromInt(100))
+	require.NoError(t, err)
+
+	debtRepo := &debtRepoFake{debt: *debt}
+	meio := &entity.MeioPagamento{ID: uuid.New(), TenantID: tenantID, Nome: "PIX", Tipo: entity.TipoPagamentoPIX}
+	uc := NewRegistrarPagamentoUseCase(debtRepo, &commandRepoFake{}, &meioPagamentoRepoFake{meio: meio}, &caixaRepoFake{caixa: caixa}, zap.NewNop())
+
+	return uc, debtRepo, RegistrarPagamentoInput{
+		TenantID:        tenantID,
+		UserID:          userID,
+		DebtID:          debt.ID,
+		MeioPagamentoID: meio.ID,
+	}
+}
+
+func TestRegistrarPagamentoUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("pagamento parcial deixa a dívida PARCIAL", func(t *testing.T) {
+		uc, repo, input := novoPagamentoUC(t, 100)
+		input.Valor = decimal.NewFromInt(40)
+
+		debt, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StatusCustomerDebtParcial, debt.Status)
+		assert.True(t, debt.Saldo.Equal(decimal.NewFromInt(60)))
+		assert.Len(t, repo.payments, 1)
+		require.Len(t, repo.contas, 1)
+		assert.True(t, repo.contas[0].Conta.Valor.Value().Equal(decimal.NewFromInt(40)))
+	})
+
+	t.Run("pagamento total quita a dívida", func(t *testing.T) {
+		uc, _, input := novoPagamentoUC(t, 100)
+		input.Valor = decimal.NewFromInt(100)
+
+		debt, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+		assert.Equal(t, entity.StatusCustomerDebtQuitada, debt.Status)
+		assert.True(t, debt.Saldo.IsZero())
+		assert.NotNil(t, debt.DataQuitacao)
+	})
+
+	t.Run("valor maior que o saldo é recusado", func(t *testing.T) {
+		uc, repo, input := novoPagamentoUC(t, 100)
+		input.Valor = decimal.NewFromInt(150)
+
+		_, err := uc.Execute(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrCustomerDebtValorExcedente)
+		assert.Empty(t, repo.payments)
+	})
+
+	t.Run("pagamento concorrente que excede o saldo atual é recusado", func(t *testing.T) {
+		uc, repo, input := novoPagamentoUC(t, 100)
+		leitura := repo.debt
+		repo.stale = &leitura // os dois caixas leram saldo 100
+		input.Valor = decimal.NewFromInt(60)
+
+		_, err := uc.Execute(ctx, input)
+		require.NoError(t, err)
+
+		_, err = uc.Execute(ctx, input)
+		assert.ErrorIs(t, err, domain.ErrCustomerDebtValorExcedente)
+		assert.Len(t, repo.payments, 1)
+		assert.True(t, repo.debt.Saldo.Equal(decimal.NewFromInt(40)))
+	})
+}
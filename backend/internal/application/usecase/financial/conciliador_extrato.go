@@ -0,0 +1,391 @@
+package financial
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+const (
+	// janelaConciliacaoPadrao é a tolerância de dias entre a data do extrato e a data prevista
+	janelaConciliacaoPadrao = 3
+)
+
+// toleranciaDivergencia é a diferença máxima (5%) aceita para sugerir um lançamento
+// como DIVERGENTE (ex.: taxa da adquirente diferente da cadastrada, juros de boleto)
+var toleranciaDivergencia = decimal.NewFromFloat(0.05)
+
+// candidatoConciliacao é um lançamento interno que pode corresponder a uma linha do extrato
+type candidatoConciliacao struct {
+	tipo      entity.MatchTipoExtrato
+	id        uuid.UUID
+	valor     decimal.Decimal
+	data      time.Time
+	descricao string
+}
+
+// conciliadorExtrato concentra as regras de correspondência e a baixa dos lançamentos
+// conciliados. Reaproveita os use cases de baixa já existentes para manter as
+// mesmas regras de domínio das baixas manuais.
+type conciliadorExtrato struct {
+	statementRepo       port.BankStatementRepository
+	compensacaoRepo     port.CompensacaoBancariaRepository
+	contaReceberRepo    port.ContaReceberRepository
+	contaPagarRepo      port.ContaPagarRepository
+	marcarCompensacaoUC *MarcarCompensacaoUseCase
+	marcarRecebimentoUC *MarcarRecebimentoUseCase
+	marcarPagamentoUC   *MarcarPagamentoUseCase
+	janelaDias          int
+	logger              *zap.Logger
+}
+
+func newConciliadorExtrato(
+	statementRepo port.BankStatementRepository,
+	compensacaoRepo port.CompensacaoBancariaRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	contaPagarRepo port.ContaPagarRepository,
+	marcarCompensacaoUC *MarcarCompensacaoUseCase,
+	marcarRecebimentoUC *MarcarRecebimentoUseCase,
+	marcarPagamentoUC *MarcarPagamentoUseCase,
+	logger *zap.Logger,
+) *conciliadorExtrato {
+	return &conciliadorExtrato{
+		statementRepo:       statementRepo,
+		compensacaoRepo:     compensacaoRepo,
+		contaReceberRepo:    contaReceberRepo,
+		contaPagarRepo:      contaPagarRepo,
+		marcarCompensacaoUC: marcarCompensacaoUC,
+		marcarRecebimentoUC: marcarRecebimentoUC,
+		marcarPagamentoUC:   marcarPagamentoUC,
+		janelaDias:          janelaConciliacaoPadrao,
+		logger:              logger,
+	}
+}
+
+// conciliar procura o melhor candidato para cada linha pendente e aplica a conciliação.
+// Retorna quantas linhas foram conciliadas (exatas) e quantas ficaram divergentes.
+func (c *conciliadorExtrato) conciliar(ctx context.Context, tenantID uuid.UUID, linhas []*entity.BankStatementLine) (int, int) {
+	if len(linhas) == 0 {
+		return 0, 0
+	}
+
+	inicio, fim := linhas[0].DataLancamento, linhas[0].DataLancamento
+	for _, l := range linhas {
+		if l.DataLancamento.Before(inicio) {
+			inicio = l.DataLancamento
+		}
+		if l.DataLancamento.After(fim) {
+			fim = l.DataLancamento
+		}
+	}
+	janela := time.Duration(c.janelaDias) * 24 * time.Hour
+	inicio, fim = inicio.Add(-janela), fim.Add(janela)
+
+	creditos := c.candidatosCredito(ctx, tenantID, inicio, fim)
+	debitos := c.candidatosDebito(ctx, tenantID, inicio, fim)
+	usados := make(map[uuid.UUID]struct{})
+
+	conciliadas, divergentes := 0, 0
+	for _, linha := range linhas {
+		if linha.Status != entity.StatusLinhaPendente {
+			continue
+		}
+
+		candidatos := creditos
+		if linha.Tipo == entity.TipoLancamentoDebito {
+			candidatos = debitos
+		}
+
+		melhor := c.melhorCandidato(ctx, tenantID, linha, candidatos, usados)
+		if melhor == nil {
+			continue
+		}
+
+		linha.Conciliar(melhor.tipo, melhor.id, melhor.valor, nil)
+		if linha.Status == entity.StatusLinhaConciliada {
+			if err := c.baixar(ctx, tenantID, linha); err != nil {
+				common.Logger(ctx, c.logger).Warn("erro ao baixar lançamento conciliado",
+					zap.String("linha_id", linha.ID.String()),
+					zap.String("match_tipo", string(melhor.tipo)),
+					zap.String("match_id", melhor.id.String()),
+					zap.Error(err))
+				continue
+			}
+		}
+
+		if err := c.statementRepo.UpdateLineConciliacao(ctx, linha); err != nil {
+			common.Logger(ctx, c.logger).Warn("erro ao salvar conciliação do lançamento",
+				zap.String("linha_id", linha.ID.String()),
+				zap.Error(err))
+			continue
+		}
+
+		usados[melhor.id] = struct{}{}
+		if linha.Status == entity.StatusLinhaConciliada {
+			conciliadas++
+		} else {
+			divergentes++
+		}
+	}
+
+	return conciliadas, divergentes
+}
+
+// melhorCandidato escolhe o candidato por valor (exato antes de aproximado),
+// proximidade de data e semelhança de descrição
+func (c *conciliadorExtrato) melhorCandidato(
+	ctx context.Context,
+	tenantID uuid.UUID,
+	linha *entity.BankStatementLine,
+	candidatos []candidatoConciliacao,
+	usados map[uuid.UUID]struct{},
+) *candidatoConciliacao {
+	var melhor *candidatoConciliacao
+	melhorScore := -1.0
+
+	for i := range candidatos {
+		cand := &candidatos[i]
+		if _, ok := usados[cand.id]; ok {
+			continue
+		}
+
+		dias := diasEntre(linha.DataLancamento, cand.data)
+		if dias > c.janelaDias {
+			continue
+		}
+
+		diferenca := linha.Valor.Sub(cand.valor).Abs()
+		exato := diferenca.IsZero()
+		if !exato && (cand.valor.IsZero() || diferenca.Div(cand.valor).GreaterThan(toleranciaDivergencia)) {
+			continue
+		}
+
+		// Valor exato pesa mais que qualquer combinação de data/descrição
+		score := 0.0
+		if exato {
+			score += 100
+		} else {
+			score += 50 * (1 - diferenca.Div(cand.valor).InexactFloat64()/toleranciaDivergencia.InexactFloat64())
+		}
+		score += float64(c.janelaDias-dias) * 2
+		score += similaridadeDescricao(linha.Descricao, cand.descricao) * 10
+
+		if score > melhorScore {
+			if ja, err := c.statementRepo.ExistsMatch(ctx, tenantID, cand.id); err == nil && ja {
+				usados[cand.id] = struct{}{}
+				continue
+			}
+			melhor = cand
+			melhorScore = score
+		}
+	}
+
+	return melhor
+}
+
+// candidatosCredito lista compensações e contas a receber em aberto no período
+func (c *conciliadorExtrato) candidatosCredito(ctx context.Context, tenantID uuid.UUID, inicio, fim time.Time) []candidatoConciliacao {
+	candidatos := make([]candidatoConciliacao, 0)
+	tenant := tenantID.String()
+
+	// Recebíveis de cartão: o banco credita o valor líquido na data de compensação
+	comps, err := c.compensacaoRepo.ListByDateRange(ctx, tenant, inicio, fim)
+	if err != nil {
+		common.Logger(ctx, c.logger).Warn("erro ao buscar compensações para conciliação", zap.Error(err))
+	}
+	comReceita := make(map[string]struct{})
+	for _, comp := range comps {
+		if comp.ReceitaID != "" {
+			comReceita[comp.ReceitaID] = struct{}{}
+		}
+		// A data prevista pode divergir da real: não exigir PodeSerCompensado
+		if comp.JaCompensado() || comp.Status == valueobject.StatusCompensacaoCancelado {
+			continue
+		}
+		id, err := uuid.Parse(comp.ID)
+		if err != nil {
+			continue
+		}
+		candidatos = append(candidatos, candidatoConciliacao{
+			tipo:  entity.MatchTipoCompensacao,
+			id:    id,
+			valor: comp.ValorLiquido.Value(),
+			data:  comp.DataCompensacao,
+		})
+	}
+
+	// Demais recebíveis (PIX, boleto, transferência) pelo valor em aberto no vencimento
+	contas, err := c.contaReceberRepo.ListByDateRange(ctx, tenant, inicio, fim)
+	if err != nil {
+		common.Logger(ctx, c.logger).Warn("erro ao buscar contas a receber para conciliação", zap.Error(err))
+	}
+	for _, conta := range contas {
+		if _, ok := comReceita[conta.ID]; ok {
+			continue // liquidada via compensação bancária
+		}
+		if conta.Status != valueobject.StatusContaPendente &&
+			conta.Status != valueobject.StatusContaConfirmado &&
+			conta.Status != valueobject.StatusContaAtrasado {
+			continue
+		}
+		id, err := uuid.Parse(conta.ID)
+		if err != nil {
+			continue
+		}
+		valor := conta.ValorAberto.Value()
+		if valor.IsZero() {
+			valor = conta.Valor.Value()
+		}
+		candidatos = append(candidatos, candidatoConciliacao{
+			tipo:      entity.MatchTipoContaReceber,
+			id:        id,
+			valor:     valor,
+			data:      conta.DataVencimento,
+			descricao: conta.DescricaoOrigem,
+		})
+	}
+
+	return candidatos
+}
+
+// candidatosDebito lista contas a pagar em aberto no período
+func (c *conciliadorExtrato) candidatosDebito(ctx context.Context, tenantID uuid.UUID, inicio, fim time.Time) []candidatoConciliacao {
+	candidatos := make([]candidatoConciliacao, 0)
+
+	contas, err := c.contaPagarRepo.ListByDateRange(ctx, tenantID.String(), inicio, fim)
+	if err != nil {
+		common.Logger(ctx, c.logger).Warn("erro ao buscar contas a pagar para conciliação", zap.Error(err))
+	}
+	for _, conta := range contas {
+		if conta.Status != valueobject.StatusContaPendente && conta.Status != valueobject.StatusContaAtrasado {
+			continue
+		}
+		id, err := uuid.Parse(conta.ID)
+		if err != nil {
+			continue
+		}
+		candidatos = append(candidatos, candidatoConciliacao{
+			tipo:      entity.MatchTipoContaPagar,
+			id:        id,
+			valor:     conta.Valor.Value(),
+			data:      conta.DataVencimento,
+			descricao: strings.TrimSpace(conta.Descricao + " " + conta.Fornecedor),
+		})
+	}
+
+	return candidatos
+}
+
+// candidatoPorID carrega um lançamento interno específico (conciliação manual)
+func (c *conciliadorExtrato) candidatoPorID(ctx context.Context, tenantID uuid.UUID, tipo entity.MatchTipoExtrato, id uuid.UUID) (*candidatoConciliacao, error) {
+	tenant := tenantID.String()
+
+	switch tipo {
+	case entity.MatchTipoCompensacao:
+		comp, err := c.compensacaoRepo.FindByID(ctx, tenant, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar compensação: %w", err)
+		}
+		return &candidatoConciliacao{tipo: tipo, id: id, valor: comp.ValorLiquido.Value(), data: comp.DataCompensacao}, nil
+	case entity.MatchTipoContaReceber:
+		conta, err := c.contaReceberRepo.FindByID(ctx, tenant, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar conta a receber: %w", err)
+		}
+		valor := conta.ValorAberto.Value()
+		if valor.IsZero() {
+			valor = conta.Valor.Value()
+		}
+		return &candidatoConciliacao{tipo: tipo, id: id, valor: valor, data: conta.DataVencimento, descricao: conta.DescricaoOrigem}, nil
+	case entity.MatchTipoContaPagar:
+		conta, err := c.contaPagarRepo.FindByID(ctx, tenant, id.String())
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar conta a pagar: %w", err)
+		}
+		return &candidatoConciliacao{tipo: tipo, id: id, valor: conta.Valor.Value(), data: conta.DataVencimento, descricao: conta.Descricao}, nil
+	}
+
+	return nil, domain.ErrExtratoMatchTipoInvalido
+}
+
+// baixar marca o lançamento interno como compensado/recebido/pago na data do extrato
+func (c *conciliadorExtrato) baixar(ctx context.Context, tenantID uuid.UUID, linha *entity.BankStatementLine) error {
+	if linha.MatchTipo == nil || linha.MatchID == nil {
+		return nil
+	}
+	tenant := tenantID.String()
+	matchID := linha.MatchID.String()
+
+	switch *linha.MatchTipo {
+	case entity.MatchTipoCompensacao:
+		_, err := c.marcarCompensacaoUC.Execute(ctx, MarcarCompensacaoInput{
+			TenantID:        tenant,
+			CompensacaoID:   matchID,
+			DataConfirmacao: linha.DataLancamento,
+		})
+		return err
+	case entity.MatchTipoContaReceber:
+		_, err := c.marcarRecebimentoUC.Execute(ctx, MarcarRecebimentoInput{
+			TenantID:        tenant,
+			ContaID:         matchID,
+			DataRecebimento: linha.DataLancamento,
+		})
+		return err
+	case entity.MatchTipoContaPagar:
+		_, err := c.marcarPagamentoUC.Execute(ctx, MarcarPagamentoInput{
+			TenantID:      tenant,
+			ContaID:       matchID,
+			DataPagamento: linha.DataLancamento,
+		})
+		return err
+	}
+
+	return domain.ErrExtratoMatchTipoInvalido
+}
+
+// diasEntre retorna a distância absoluta em dias entre duas datas
+func diasEntre(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	da := time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)
+	db := time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC)
+	d := int(da.Sub(db).Hours() / 24)
+	if d < 0 {
+		return -d
+	}
+	return d
+}
+
+// similaridadeDescricao retorna a fração (0..1) de palavras da descrição interna
+// presentes no histórico do extrato
+func similaridadeDescricao(extrato, interna string) float64 {
+	palavras := strings.Fields(strings.ToUpper(interna))
+	if len(palavras) == 0 {
+		return 0
+	}
+	historico := strings.ToUpper(extrato)
+	encontradas, consideradas := 0, 0
+	for _, p := range palavras {
+		if len([]rune(p)) < 3 {
+			continue
+		}
+		consideradas++
+		if strings.Contains(historico, p) {
+			encontradas++
+		}
+	}
+	if consideradas == 0 {
+		return 0
+	}
+	return float64(encontradas) / float64(consideradas)
+}
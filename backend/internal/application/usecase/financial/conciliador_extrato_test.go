@@ -0,0 +1,128 @@
+package financial
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// statementRepoFake responde quais lançamentos internos já foram conciliados
+type statementRepoFake struct {
+	port.BankStatementRepository
+	conciliados map[uuid.UUID]bool
+}
+
+func (f *statementRepoFake) ExistsMatch(_ context.Context, _, matchID uuid.UUID) (bool, error) {
+	return f.conciliados[matchID], nil
+}
+
+func TestConciliadorExtrato_MelhorCandidato(t *testing.T) {
+	dia := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+	cand := func(valor string, d int, descricao string) candidatoConciliacao {
+		return candidatoConciliacao{
+			tipo:      entity.MatchTipoContaPagar,
+			id:        uuid.New(),
+			valor:     decimal.RequireFromString(valor),
+			data:      dia(d),
+			descricao: descricao,
+		}
+	}
+
+	casos := []struct {
+		nome        string
+		valor       string
+		dia         int
+		historico   string
+		candidatos  []candidatoConciliacao
+		conciliados []int // índices já conciliados em outra importação
+		want        int   // índice esperado; -1 = sem correspondência
+	}{
+		{
+			nome: "valor e data exatos", valor: "150.00", dia: 10,
+			candidatos: []candidatoConciliacao{cand("80.00", 10, ""), cand("150.00", 10, "")},
+			want:       1,
+		},
+		{
+			nome: "dentro da tolerância de valor e de dias", valor: "95.50", dia: 10,
+			candidatos: []candidatoConciliacao{cand("100.00", 13, "")},
+			want:       0,
+		},
+		{
+			nome: "valor fora da tolerância de 5%", valor: "94.90", dia: 10,
+			candidatos: []candidatoConciliacao{cand("100.00", 10, "")},
+			want:       -1,
+		},
+		{
+			nome: "data fora da janela", valor: "100.00", dia: 10,
+			candidatos: []candidatoConciliacao{cand("100.00", 14, "")},
+			want:       -1,
+		},
+		{
+			nome: "valor exato vence aproximado mais próximo", valor: "100.00", dia: 10,
+			candidatos: []candidatoConciliacao{cand("98.00", 10, ""), cand("100.00", 13, "")},
+			want:       1,
+		},
+		{
+			nome: "ambíguo no valor: data mais próxima", valor: "100.00", dia: 10,
+			candidatos: []candidatoConciliacao{cand("100.00", 12, ""), cand("100.00", 11, "")},
+			want:       1,
+		},
+		{
+			nome: "ambíguo no valor e na data: descrição decide", valor: "100.00", dia: 10,
+			historico:  "PAG BOLETO ALUGUEL IMOBILIARIA CENTRO",
+			candidatos: []candidatoConciliacao{cand("100.00", 10, "Energia elétrica"), cand("100.00", 10, "Aluguel Imobiliária Centro")},
+			want:       1,
+		},
+		{
+			nome: "candidato já conciliado é ignorado", valor: "100.00", dia: 10,
+			candidatos:  []candidatoConciliacao{cand("100.00", 10, ""), cand("100.00", 12, "")},
+			conciliados: []int{0},
+			want:        1,
+		},
+		{
+			nome: "sem candidatos", valor: "100.00", dia: 10,
+			want: -1,
+		},
+	}
+
+	for _, c := range casos {
+		t.Run(c.nome, func(t *testing.T) {
+			repo := &statementRepoFake{conciliados: map[uuid.UUID]bool{}}
+			for _, i := range c.conciliados {
+				repo.conciliados[c.candidatos[i].id] = true
+			}
+			conciliador := newConciliadorExtrato(repo, nil, nil, nil, nil, nil, nil, zap.NewNop())
+			linha := &entity.BankStatementLine{
+				Valor:          decimal.RequireFromString(c.valor),
+				DataLancamento: dia(c.dia),
+				Descricao:      c.historico,
+				Tipo:           entity.TipoLancamentoDebito,
+			}
+
+			got := conciliador.melhorCandidato(context.Background(), uuid.New(), linha, c.candidatos, map[uuid.UUID]struct{}{})
+			if c.want < 0 {
+				assert.Nil(t, got)
+				return
+			}
+			if assert.NotNil(t, got) {
+				assert.Equal(t, c.candidatos[c.want].id, got.id)
+			}
+		})
+	}
+}
+
+func TestConciliadorExtrato_CandidatoUsadoNaMesmaImportacao(t *testing.T) {
+	conciliador := newConciliadorExtrato(&statementRepoFake{}, nil, nil, nil, nil, nil, nil, zap.NewNop())
+	unico := candidatoConciliacao{tipo: entity.MatchTipoContaPagar, id: uuid.New(), valor: decimal.NewFromInt(100), data: time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)}
+	linha := &entity.BankStatementLine{Valor: decimal.NewFromInt(100), DataLancamento: unico.data}
+
+	usados := map[uuid.UUID]struct{}{unico.id: {}}
+	assert.Nil(t, conciliador.melhorCandidato(context.Background(), uuid.New(), linha, []candidatoConciliacao{unico}, usados))
+}
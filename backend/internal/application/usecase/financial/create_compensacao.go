@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -45,6 +46,9 @@ func NewCreateCompensacaoUseCase(
 
 // Execute cria uma nova compensação bancária
 func (uc *CreateCompensacaoUseCase) Execute(ctx context.Context, input CreateCompensacaoInput) (*entity.CompensacaoBancaria, error) {
+	ctx, span := common.StartSpan(ctx, "financial.CreateCompensacao")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -80,7 +84,7 @@ func (uc *CreateCompensacaoUseCase) Execute(ctx context.Context, input CreateCom
 		input.DMais,
 	)
 	if err != nil {
-		uc.logger.Error("Erro ao criar entidade CompensacaoBancaria",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar entidade CompensacaoBancaria",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("receita_id", input.ReceitaID),
@@ -90,7 +94,7 @@ func (uc *CreateCompensacaoUseCase) Execute(ctx context.Context, input CreateCom
 
 	// Persistir no repositório
 	if err := uc.repo.Create(ctx, comp); err != nil {
-		uc.logger.Error("Erro ao persistir compensação bancária",
+		common.Logger(ctx, uc.logger).Error("Erro ao persistir compensação bancária",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("comp_id", comp.ID),
@@ -98,7 +102,7 @@ func (uc *CreateCompensacaoUseCase) Execute(ctx context.Context, input CreateCom
 		return nil, fmt.Errorf("erro ao salvar compensação: %w", err)
 	}
 
-	uc.logger.Info("Compensação bancária criada",
+	common.Logger(ctx, uc.logger).Info("Compensação bancária criada",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("comp_id", comp.ID),
 		zap.String("receita_id", comp.ReceitaID),
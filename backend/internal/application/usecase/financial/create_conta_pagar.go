@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -48,6 +49,9 @@ func NewCreateContaPagarUseCase(
 
 // Execute cria uma nova conta a pagar
 func (uc *CreateContaPagarUseCase) Execute(ctx context.Context, input CreateContaPagarInput) (*entity.ContaPagar, error) {
+	ctx, span := common.StartSpan(ctx, "financial.CreateContaPagar")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -84,7 +88,7 @@ func (uc *CreateContaPagarUseCase) Execute(ctx context.Context, input CreateCont
 		input.Periodicidade,
 	)
 	if err != nil {
-		uc.logger.Error("Erro ao criar entidade ContaPagar",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar entidade ContaPagar",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("descricao", input.Descricao),
@@ -98,7 +102,7 @@ func (uc *CreateContaPagarUseCase) Execute(ctx context.Context, input CreateCont
 
 	// Persistir no repositório
 	if err := uc.repo.Create(ctx, conta); err != nil {
-		uc.logger.Error("Erro ao persistir conta a pagar",
+		common.Logger(ctx, uc.logger).Error("Erro ao persistir conta a pagar",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", conta.ID),
@@ -106,7 +110,7 @@ func (uc *CreateContaPagarUseCase) Execute(ctx context.Context, input CreateCont
 		return nil, fmt.Errorf("erro ao salvar conta a pagar: %w", err)
 	}
 
-	uc.logger.Info("Conta a pagar criada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Conta a pagar criada com sucesso",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("conta_id", conta.ID),
 		zap.String("descricao", conta.Descricao),
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -46,6 +47,9 @@ func NewCreateContaReceberUseCase(
 
 // Execute cria uma nova conta a receber
 func (uc *CreateContaReceberUseCase) Execute(ctx context.Context, input CreateContaReceberInput) (*entity.ContaReceber, error) {
+	ctx, span := common.StartSpan(ctx, "financial.CreateContaReceber")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -79,7 +83,7 @@ func (uc *CreateContaReceberUseCase) Execute(ctx context.Context, input CreateCo
 		input.DataVencimento,
 	)
 	if err != nil {
-		uc.logger.Error("Erro ao criar entidade ContaReceber",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar entidade ContaReceber",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("descricao", input.Descricao),
@@ -92,7 +96,7 @@ func (uc *CreateContaReceberUseCase) Execute(ctx context.Context, input CreateCo
 
 	// Persistir no repositório
 	if err := uc.repo.Create(ctx, conta); err != nil {
-		uc.logger.Error("Erro ao persistir conta a receber",
+		common.Logger(ctx, uc.logger).Error("Erro ao persistir conta a receber",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", conta.ID),
@@ -100,7 +104,7 @@ func (uc *CreateContaReceberUseCase) Execute(ctx context.Context, input CreateCo
 		return nil, fmt.Errorf("erro ao salvar conta a receber: %w", err)
 	}
 
-	uc.logger.Info("Conta a receber criada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Conta a receber criada com sucesso",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("conta_id", conta.ID),
 		zap.String("descricao", conta.DescricaoOrigem),
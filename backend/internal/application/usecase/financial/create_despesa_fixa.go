@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -43,6 +44,9 @@ func NewCreateDespesaFixaUseCase(
 
 // Execute cria uma nova despesa fixa
 func (uc *CreateDespesaFixaUseCase) Execute(ctx context.Context, input CreateDespesaFixaInput) (*entity.DespesaFixa, error) {
+	ctx, span := common.StartSpan(ctx, "financial.CreateDespesaFixa")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -63,7 +67,7 @@ func (uc *CreateDespesaFixaUseCase) Execute(ctx context.Context, input CreateDes
 	// Verificar duplicidade de descrição
 	exists, err := uc.repo.ExistsByDescricao(ctx, input.TenantID, input.Descricao, nil)
 	if err != nil {
-		uc.logger.Error("Erro ao verificar duplicidade de despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao verificar duplicidade de despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 		)
@@ -87,7 +91,7 @@ func (uc *CreateDespesaFixaUseCase) Execute(ctx context.Context, input CreateDes
 		input.DiaVencimento,
 	)
 	if err != nil {
-		uc.logger.Error("Erro ao criar entidade DespesaFixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar entidade DespesaFixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 		)
@@ -110,14 +114,14 @@ func (uc *CreateDespesaFixaUseCase) Execute(ctx context.Context, input CreateDes
 
 	// Persistir
 	if err := uc.repo.Create(ctx, despesa); err != nil {
-		uc.logger.Error("Erro ao criar despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 		)
 		return nil, err
 	}
 
-	uc.logger.Info("Despesa fixa criada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Despesa fixa criada com sucesso",
 		zap.String("id", despesa.ID),
 		zap.String("tenant_id", despesa.TenantID.String()),
 		zap.String("descricao", despesa.Descricao),
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
 "go.uber.org/zap"
@@ -20,6 +21,9 @@ func NewDeleteCompensacaoUseCase(repo port.CompensacaoBancariaRepository, logger
 }
 
 func (uc *DeleteCompensacaoUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "financial.DeleteCompensacao")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -35,7 +39,7 @@ func (uc *DeleteCompensacaoUseCase) Execute(ctx context.Context, tenantID, id st
 		return fmt.Errorf("erro ao deletar compensação: %w", err)
 	}
 
-	uc.logger.Info("Compensação deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
+	common.Logger(ctx, uc.logger).Info("Compensação deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
 
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
 "go.uber.org/zap"
@@ -20,6 +21,9 @@ func NewDeleteContaReceberUseCase(repo port.ContaReceberRepository, logger *zap.
 }
 
 func (uc *DeleteContaReceberUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "financial.DeleteContaReceber")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -35,7 +39,7 @@ func (uc *DeleteContaReceberUseCase) Execute(ctx context.Context, tenantID, id s
 		return fmt.Errorf("erro ao deletar conta: %w", err)
 	}
 
-	uc.logger.Info("Conta a receber deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
+	common.Logger(ctx, uc.logger).Info("Conta a receber deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -34,6 +35,9 @@ func NewDeleteDespesaFixaUseCase(
 
 // Execute remove uma despesa fixa
 func (uc *DeleteDespesaFixaUseCase) Execute(ctx context.Context, input DeleteDespesaFixaInput) error {
+	ctx, span := common.StartSpan(ctx, "financial.DeleteDespesaFixa")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -45,7 +49,7 @@ func (uc *DeleteDespesaFixaUseCase) Execute(ctx context.Context, input DeleteDes
 	// Verificar se existe antes de deletar
 	_, err := uc.repo.FindByID(ctx, input.TenantID, input.ID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar despesa fixa para deleção",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar despesa fixa para deleção",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -54,7 +58,7 @@ func (uc *DeleteDespesaFixaUseCase) Execute(ctx context.Context, input DeleteDes
 	}
 
 	if err := uc.repo.Delete(ctx, input.TenantID, input.ID); err != nil {
-		uc.logger.Error("Erro ao deletar despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao deletar despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -62,7 +66,7 @@ func (uc *DeleteDespesaFixaUseCase) Execute(ctx context.Context, input DeleteDes
 		return err
 	}
 
-	uc.logger.Info("Despesa fixa deletada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Despesa fixa deletada com sucesso",
 		zap.String("id", input.ID),
 		zap.String("tenant_id", input.TenantID),
 	)
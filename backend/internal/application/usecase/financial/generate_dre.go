@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -52,6 +53,9 @@ func NewGenerateDREUseCase(
 
 // Execute gera ou atualiza o DRE de um mês
 func (uc *GenerateDREUseCase) Execute(ctx context.Context, input GenerateDREInput) (*entity.DREMensal, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GenerateDRE")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -82,21 +86,21 @@ func (uc *GenerateDREUseCase) Execute(ctx context.Context, input GenerateDREInpu
 	// Receitas de serviços
 	receitaServicos, err := uc.contasReceberRepo.SumByOrigem(ctx, input.TenantID, "SERVICO", inicio, fim)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular receitas de serviços", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular receitas de serviços", zap.Error(err))
 		receitaServicos = valueobject.Zero()
 	}
 
 	// Receitas de produtos
 	receitaProdutos, err := uc.contasReceberRepo.SumByOrigem(ctx, input.TenantID, "PRODUTO", inicio, fim)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular receitas de produtos", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular receitas de produtos", zap.Error(err))
 		receitaProdutos = valueobject.Zero()
 	}
 
 	// Receitas de assinaturas
 	receitaAssinaturas, err := uc.contasReceberRepo.SumByOrigem(ctx, input.TenantID, "ASSINATURA", inicio, fim)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular receitas de assinaturas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular receitas de assinaturas", zap.Error(err))
 		receitaAssinaturas = valueobject.Zero()
 	}
 
@@ -117,13 +121,13 @@ func (uc *GenerateDREUseCase) Execute(ctx context.Context, input GenerateDREInpu
 	if uc.commissionItemRepo != nil {
 		totalComissoes, err := uc.commissionItemRepo.SumByDateRange(ctx, input.TenantID, inicio, fim)
 		if err != nil {
-			uc.logger.Warn("erro ao calcular comissões do período", zap.Error(err))
+			common.Logger(ctx, uc.logger).Warn("erro ao calcular comissões do período", zap.Error(err))
 			custoComissoes = valueobject.Zero()
 		} else {
 			custoComissoes = valueobject.NewMoneyFromDecimal(decimal.NewFromFloat(totalComissoes))
 		}
 	} else {
-		uc.logger.Warn("commissionItemRepo não configurado, usando comissões zeradas")
+		common.Logger(ctx, uc.logger).Warn("commissionItemRepo não configurado, usando comissões zeradas")
 		custoComissoes = valueobject.Zero()
 	}
 
@@ -175,7 +179,7 @@ func (uc *GenerateDREUseCase) Execute(ctx context.Context, input GenerateDREInpu
 		}
 	}
 
-	uc.logger.Info("DRE mensal gerado",
+	common.Logger(ctx, uc.logger).Info("DRE mensal gerado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("mes_ano", input.MesAno.String()),
 		zap.String("receita_total", dre.ReceitaTotal.String()),
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -53,6 +54,9 @@ func NewGenerateDREV2UseCase(
 
 // Execute gera ou atualiza o DRE de um mês usando regime de competência ou caixa
 func (uc *GenerateDREV2UseCase) Execute(ctx context.Context, input GenerateDREV2Input) (*entity.DREMensal, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GenerateDREV2")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -90,14 +94,14 @@ func (uc *GenerateDREV2UseCase) Execute(ctx context.Context, input GenerateDREV2
 	if input.Regime == "COMPETENCIA" {
 		// Regime de competência: usa confirmed_at e competencia_mes
 		// Considera receita quando CONFIRMADA, não quando recebida
-		uc.logger.Debug("Calculando receitas por competência",
+		common.Logger(ctx, uc.logger).Debug("Calculando receitas por competência",
 			zap.String("competencia", competenciaMes),
 		)
 
 		// Receitas de assinaturas por competência (status CONFIRMADO ou RECEBIDO)
 		receitaAssinaturas, err = uc.contasReceberRepo.SumByCompetencia(ctx, input.TenantID, competenciaMes, nil)
 		if err != nil {
-			uc.logger.Warn("erro ao calcular receitas por competência, usando fallback",
+			common.Logger(ctx, uc.logger).Warn("erro ao calcular receitas por competência, usando fallback",
 				zap.Error(err),
 			)
 			// Fallback: usar método antigo
@@ -108,7 +112,7 @@ func (uc *GenerateDREV2UseCase) Execute(ctx context.Context, input GenerateDREV2
 		totalReceitas = receitaAssinaturas
 	} else {
 		// Regime de caixa: usa received_at (quando dinheiro entrou)
-		uc.logger.Debug("Calculando receitas por regime de caixa",
+		common.Logger(ctx, uc.logger).Debug("Calculando receitas por regime de caixa",
 			zap.Time("inicio", inicio),
 			zap.Time("fim", fim),
 		)
@@ -158,7 +162,7 @@ func (uc *GenerateDREV2UseCase) Execute(ctx context.Context, input GenerateDREV2
 		}
 	}
 
-	uc.logger.Info("DRE mensal V2 gerado",
+	common.Logger(ctx, uc.logger).Info("DRE mensal V2 gerado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("mes_ano", input.MesAno.String()),
 		zap.String("regime", input.Regime),
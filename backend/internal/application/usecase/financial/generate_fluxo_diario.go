@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -49,6 +50,9 @@ func NewGenerateFluxoDiarioUseCase(
 
 // Execute gera ou atualiza o fluxo de caixa de um dia
 func (uc *GenerateFluxoDiarioUseCase) Execute(ctx context.Context, input GenerateFluxoDiarioInput) (*entity.FluxoCaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GenerateFluxoDiario")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -137,7 +141,7 @@ func (uc *GenerateFluxoDiarioUseCase) Execute(ctx context.Context, input Generat
 		}
 	}
 
-	uc.logger.Info("Fluxo de caixa diário gerado",
+	common.Logger(ctx, uc.logger).Info("Fluxo de caixa diário gerado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("data", input.Data.Format("2006-01-02")),
 		zap.String("saldo_final", fluxo.SaldoFinal.String()),
@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -50,6 +51,9 @@ func NewGenerateFluxoDiarioV2UseCase(
 
 // Execute gera ou atualiza o fluxo de caixa de um dia usando received_at para assinaturas
 func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input GenerateFluxoDiarioV2Input) (*entity.FluxoCaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GenerateFluxoDiarioV2")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -89,7 +93,7 @@ func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input Gener
 	// Somar por received_at (regime de caixa real)
 	entradasAsaas, err := uc.contasReceberRepo.SumByReceivedDate(ctx, input.TenantID, data, proximoDia)
 	if err != nil {
-		uc.logger.Warn("erro ao buscar entradas por received_at, usando fallback",
+		common.Logger(ctx, uc.logger).Warn("erro ao buscar entradas por received_at, usando fallback",
 			zap.Error(err),
 		)
 	}
@@ -98,14 +102,14 @@ func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input Gener
 	statusRecebido := valueobject.StatusContaRecebido
 	entradasTradicionais, err := uc.contasReceberRepo.SumByPeriod(ctx, input.TenantID, data, data, &statusRecebido)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular entradas tradicionais", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular entradas tradicionais", zap.Error(err))
 	}
 
 	// 3. Entradas previstas (contas pendentes para o dia)
 	statusPendente := valueobject.StatusContaPendente
 	entradasPrevistas, err := uc.contasReceberRepo.SumByPeriod(ctx, input.TenantID, data, data, &statusPendente)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular entradas previstas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular entradas previstas", zap.Error(err))
 	}
 
 	// Combinar entradas confirmadas (evitar duplicação)
@@ -118,7 +122,7 @@ func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input Gener
 	if uc.compensacaoRepo != nil {
 		comps, err := uc.compensacaoRepo.ListByDateRange(ctx, input.TenantID, data, data)
 		if err != nil {
-			uc.logger.Warn("erro ao listar compensações para fluxo diário V2", zap.Error(err))
+			common.Logger(ctx, uc.logger).Warn("erro ao listar compensações para fluxo diário V2", zap.Error(err))
 		} else {
 			for _, comp := range comps {
 				switch comp.Status {
@@ -139,14 +143,14 @@ func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input Gener
 	statusPago := valueobject.StatusContaPago
 	saidasPagas, err := uc.contasPagarRepo.SumByPeriod(ctx, input.TenantID, data, data, &statusPago)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular saídas pagas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular saídas pagas", zap.Error(err))
 	}
 	fluxo.SaidasPagas = saidasPagas
 
 	// 5. Saídas previstas (contas pendentes para o dia)
 	saidasPrevistas, err := uc.contasPagarRepo.SumByPeriod(ctx, input.TenantID, data, data, &statusPendente)
 	if err != nil {
-		uc.logger.Warn("erro ao calcular saídas previstas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao calcular saídas previstas", zap.Error(err))
 	}
 	fluxo.SaidasPrevistas = saidasPrevistas
 
@@ -164,7 +168,7 @@ func (uc *GenerateFluxoDiarioV2UseCase) Execute(ctx context.Context, input Gener
 		}
 	}
 
-	uc.logger.Info("Fluxo de caixa diário V2 gerado",
+	common.Logger(ctx, uc.logger).Info("Fluxo de caixa diário V2 gerado",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("data", data.Format("2006-01-02")),
 		zap.String("entradas_confirmadas", fluxo.EntradasConfirmadas.String()),
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -49,6 +50,9 @@ func NewGerarContasFromDespesasFixasUseCase(
 
 // Execute gera as contas a pagar para o mês especificado
 func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, input GerarContasFromDespesasFixasInput) (*GerarContasFromDespesasFixasOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GerarContasFromDespesasFixas")
+	defer span.End()
+
 	startTime := time.Now()
 
 	output := &GerarContasFromDespesasFixasOutput{}
@@ -66,7 +70,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 		return nil, fmt.Errorf("mês inválido: %d", input.Mes)
 	}
 
-	uc.logger.Info("Iniciando geração de contas a pagar a partir de despesas fixas",
+	common.Logger(ctx, uc.logger).Info("Iniciando geração de contas a pagar a partir de despesas fixas",
 		zap.Int("ano", input.Ano),
 		zap.Int("mes", input.Mes),
 		zap.String("tenant_id", input.TenantID),
@@ -82,7 +86,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 		// Processar todos os tenants (usado pelo cron job)
 		despesasComTenant, err := uc.despesaFixaRepo.ListAtivasPorTenants(ctx)
 		if err != nil {
-			uc.logger.Error("Erro ao listar despesas fixas ativas por tenants",
+			common.Logger(ctx, uc.logger).Error("Erro ao listar despesas fixas ativas por tenants",
 				zap.Error(err),
 			)
 			return nil, err
@@ -93,7 +97,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 	}
 
 	if err != nil {
-		uc.logger.Error("Erro ao listar despesas fixas ativas",
+		common.Logger(ctx, uc.logger).Error("Erro ao listar despesas fixas ativas",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 		)
@@ -109,7 +113,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 			output.Erros++
 			errMsg := fmt.Sprintf("Despesa %s (tenant: %s): %v", despesa.ID, despesa.TenantID, err)
 			output.DetalhesErros = append(output.DetalhesErros, errMsg)
-			uc.logger.Warn("Erro ao converter despesa fixa para conta a pagar",
+			common.Logger(ctx, uc.logger).Warn("Erro ao converter despesa fixa para conta a pagar",
 				zap.Error(err),
 				zap.String("despesa_id", despesa.ID),
 				zap.String("tenant_id", despesa.TenantID.String()),
@@ -122,7 +126,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 			output.Erros++
 			errMsg := fmt.Sprintf("Despesa %s (tenant: %s): erro ao persistir - %v", despesa.ID, despesa.TenantID, err)
 			output.DetalhesErros = append(output.DetalhesErros, errMsg)
-			uc.logger.Warn("Erro ao criar conta a pagar a partir de despesa fixa",
+			common.Logger(ctx, uc.logger).Warn("Erro ao criar conta a pagar a partir de despesa fixa",
 				zap.Error(err),
 				zap.String("despesa_id", despesa.ID),
 				zap.String("tenant_id", despesa.TenantID.String()),
@@ -131,7 +135,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 		}
 
 		output.ContasCriadas++
-		uc.logger.Debug("Conta a pagar criada a partir de despesa fixa",
+		common.Logger(ctx, uc.logger).Debug("Conta a pagar criada a partir de despesa fixa",
 			zap.String("conta_id", conta.ID),
 			zap.String("despesa_id", despesa.ID),
 			zap.String("tenant_id", despesa.TenantID.String()),
@@ -142,7 +146,7 @@ func (uc *GerarContasFromDespesasFixasUseCase) Execute(ctx context.Context, inpu
 
 	output.TempoExecucaoMs = time.Since(startTime).Milliseconds()
 
-	uc.logger.Info("Geração de contas a pagar concluída",
+	common.Logger(ctx, uc.logger).Info("Geração de contas a pagar concluída",
 		zap.Int("total_despesas", output.TotalDespesas),
 		zap.Int("contas_criadas", output.ContasCriadas),
 		zap.Int("erros", output.Erros),
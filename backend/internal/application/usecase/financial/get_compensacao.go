@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -21,6 +22,9 @@ func NewGetCompensacaoUseCase(repo port.CompensacaoBancariaRepository, logger *z
 }
 
 func (uc *GetCompensacaoUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.CompensacaoBancaria, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetCompensacao")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -21,6 +22,9 @@ func NewGetContaPagarUseCase(repo port.ContaPagarRepository, logger *zap.Logger)
 }
 
 func (uc *GetContaPagarUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.ContaPagar, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetContaPagar")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -35,6 +36,9 @@ func NewGetDespesaFixaUseCase(
 
 // Execute busca uma despesa fixa por ID
 func (uc *GetDespesaFixaUseCase) Execute(ctx context.Context, input GetDespesaFixaInput) (*entity.DespesaFixa, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetDespesaFixa")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -45,7 +49,7 @@ func (uc *GetDespesaFixaUseCase) Execute(ctx context.Context, input GetDespesaFi
 
 	despesa, err := uc.repo.FindByID(ctx, input.TenantID, input.ID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -21,6 +22,9 @@ func NewGetDREUseCase(repo port.DREMensalRepository, logger *zap.Logger) *GetDRE
 }
 
 func (uc *GetDREUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.DREMensal, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetDRE")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
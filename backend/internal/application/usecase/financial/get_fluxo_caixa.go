@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -21,6 +22,9 @@ func NewGetFluxoCaixaUseCase(repo port.FluxoCaixaDiarioRepository, logger *zap.L
 }
 
 func (uc *GetFluxoCaixaUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.FluxoCaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetFluxoCaixa")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
@@ -90,6 +91,9 @@ func NewGetPainelMensalUseCase(
 
 // Execute executa a lógica de agregação do painel mensal
 func (uc *GetPainelMensalUseCase) Execute(ctx context.Context, input PainelMensalInput) (*PainelMensalOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetPainelMensal")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -110,7 +114,7 @@ func (uc *GetPainelMensalUseCase) Execute(ctx context.Context, input PainelMensa
 	statusRecebido := valueobject.StatusContaRecebido
 	receitaRealizada, err := uc.contaReceberRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusRecebido)
 	if err != nil {
-		uc.logger.Error("erro ao buscar receita realizada", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar receita realizada", zap.Error(err))
 		receitaRealizada = valueobject.Zero()
 	}
 
@@ -118,7 +122,7 @@ func (uc *GetPainelMensalUseCase) Execute(ctx context.Context, input PainelMensa
 	statusPendente := valueobject.StatusContaPendente
 	receitaPendente, err := uc.contaReceberRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusPendente)
 	if err != nil {
-		uc.logger.Error("erro ao buscar receita pendente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar receita pendente", zap.Error(err))
 		receitaPendente = valueobject.Zero()
 	}
 
@@ -126,21 +130,21 @@ func (uc *GetPainelMensalUseCase) Execute(ctx context.Context, input PainelMensa
 	statusPago := valueobject.StatusContaPago
 	despesasPagas, err := uc.contaPagarRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusPago)
 	if err != nil {
-		uc.logger.Error("erro ao buscar despesas pagas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar despesas pagas", zap.Error(err))
 		despesasPagas = valueobject.Zero()
 	}
 
 	// 5. Buscar despesas pendentes
 	despesasPendentes, err := uc.contaPagarRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusPendente)
 	if err != nil {
-		uc.logger.Error("erro ao buscar despesas pendentes", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar despesas pendentes", zap.Error(err))
 		despesasPendentes = valueobject.Zero()
 	}
 
 	// 6. Buscar despesas fixas ativas
 	despesasFixas, err := uc.despesaFixaRepo.SumAtivas(ctx, input.TenantID)
 	if err != nil {
-		uc.logger.Error("erro ao buscar despesas fixas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao buscar despesas fixas", zap.Error(err))
 		despesasFixas = valueobject.Zero()
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
@@ -66,6 +67,9 @@ func NewGetProjecoesUseCase(
 
 // Execute executa a lógica de projeção financeira
 func (uc *GetProjecoesUseCase) Execute(ctx context.Context, input ProjecoesInput) (*ProjecoesOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetProjecoes")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -93,13 +97,13 @@ func (uc *GetProjecoesUseCase) Execute(ctx context.Context, input ProjecoesInput
 
 		receita, err := uc.contaReceberRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusRecebido)
 		if err != nil {
-			uc.logger.Warn("erro ao buscar receita do histórico", zap.Error(err), zap.Int("mes", i))
+			common.Logger(ctx, uc.logger).Warn("erro ao buscar receita do histórico", zap.Error(err), zap.Int("mes", i))
 			receita = valueobject.Zero()
 		}
 
 		despesa, err := uc.contaPagarRepo.SumByPeriod(ctx, input.TenantID, inicio, fim, &statusPago)
 		if err != nil {
-			uc.logger.Warn("erro ao buscar despesa do histórico", zap.Error(err), zap.Int("mes", i))
+			common.Logger(ctx, uc.logger).Warn("erro ao buscar despesa do histórico", zap.Error(err), zap.Int("mes", i))
 			despesa = valueobject.Zero()
 		}
 
@@ -117,7 +121,7 @@ func (uc *GetProjecoesUseCase) Execute(ctx context.Context, input ProjecoesInput
 	// 4. Buscar despesas fixas (garantidas)
 	despesasFixas, err := uc.despesaFixaRepo.SumAtivas(ctx, input.TenantID)
 	if err != nil {
-		uc.logger.Warn("erro ao buscar despesas fixas", zap.Error(err))
+		common.Logger(ctx, uc.logger).Warn("erro ao buscar despesas fixas", zap.Error(err))
 		despesasFixas = valueobject.Zero()
 	}
 
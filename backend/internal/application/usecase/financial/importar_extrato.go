@@ -0,0 +1,230 @@
+package financial
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ImportarExtratoInput define os dados de entrada para importação de extrato
+type ImportarExtratoInput struct {
+	TenantID    uuid.UUID
+	UsuarioID   uuid.UUID
+	ArquivoNome string
+	Formato     string // OFX, CSV (vazio = detectar pela extensão)
+	Arquivo     io.Reader
+}
+
+// ImportarExtratoOutput resume o resultado da importação
+type ImportarExtratoOutput struct {
+	Import      *entity.BankStatementImport
+	Linhas      []*entity.BankStatementLine
+	Novas       int // Lançamentos inseridos
+	Duplicadas  int // Lançamentos já importados anteriormente
+	Conciliadas int // Conciliados automaticamente
+	Divergentes int // Correspondência com diferença de valor (revisão)
+}
+
+// ImportarExtratoUseCase importa um extrato OFX/CSV e concilia automaticamente
+// os créditos com compensações/contas a receber e os débitos com contas a pagar
+type ImportarExtratoUseCase struct {
+	statementRepo port.BankStatementRepository
+	parser        port.BankStatementParser
+	conciliador   *conciliadorExtrato
+	logger        *zap.Logger
+}
+
+// NewImportarExtratoUseCase cria nova instância do use case
+func NewImportarExtratoUseCase(
+	statementRepo port.BankStatementRepository,
+	parser port.BankStatementParser,
+	compensacaoRepo port.CompensacaoBancariaRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	contaPagarRepo port.ContaPagarRepository,
+	marcarCompensacaoUC *MarcarCompensacaoUseCase,
+	marcarRecebimentoUC *MarcarRecebimentoUseCase,
+	marcarPagamentoUC *MarcarPagamentoUseCase,
+	logger *zap.Logger,
+) *ImportarExtratoUseCase {
+	return &ImportarExtratoUseCase{
+		statementRepo: statementRepo,
+		parser:        parser,
+		conciliador: newConciliadorExtrato(statementRepo, compensacaoRepo, contaReceberRepo, contaPagarRepo,
+			marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger),
+		logger: logger,
+	}
+}
+
+// Execute importa o extrato
+// 1. Lê o arquivo (OFX/CSV)
+// 2. Registra a importação e os lançamentos (ignorando duplicados pelo identificador)
+// 3. Concilia os lançamentos novos por valor, janela de data e descrição
+// 4. Atualiza os totais da importação
+func (uc *ImportarExtratoUseCase) Execute(ctx context.Context, input ImportarExtratoInput) (*ImportarExtratoOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ImportarExtrato")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if input.Arquivo == nil {
+		return nil, domain.ErrExtratoVazio
+	}
+
+	formato := detectarFormatoExtrato(input.Formato, input.ArquivoNome)
+	if formato == "" {
+		return nil, domain.ErrExtratoFormatoInvalido
+	}
+
+	extrato, err := uc.parser.Parse(formato, input.Arquivo)
+	if err != nil {
+		return nil, err
+	}
+
+	var importadoPor *uuid.UUID
+	if input.UsuarioID != uuid.Nil {
+		importadoPor = &input.UsuarioID
+	}
+
+	imp, err := entity.NewBankStatementImport(input.TenantID, input.ArquivoNome, formato, importadoPor)
+	if err != nil {
+		return nil, err
+	}
+	imp.ContaBancaria = extrato.ContaBancaria
+	imp.PeriodoInicio = extrato.PeriodoInicio
+	imp.PeriodoFim = extrato.PeriodoFim
+
+	if err := uc.statementRepo.CreateImport(ctx, imp); err != nil {
+		return nil, err
+	}
+
+	output := &ImportarExtratoOutput{Import: imp}
+	novas := make([]*entity.BankStatementLine, 0, len(extrato.Linhas))
+
+	for i := range extrato.Linhas {
+		linha := extrato.Linhas[i]
+		linha.ID = uuid.New()
+		linha.TenantID = input.TenantID
+		linha.ImportID = imp.ID
+		linha.Status = entity.StatusLinhaPendente
+
+		inserida, err := uc.statementRepo.CreateLine(ctx, &linha)
+		if err != nil {
+			return nil, err
+		}
+		if !inserida {
+			output.Duplicadas++
+			continue
+		}
+		novas = append(novas, &linha)
+	}
+	output.Novas = len(novas)
+
+	output.Conciliadas, output.Divergentes = uc.conciliador.conciliar(ctx, input.TenantID, novas)
+
+	if err := uc.statementRepo.RefreshImportTotais(ctx, imp.ID, input.TenantID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("erro ao atualizar totais da importação", zap.Error(err))
+	}
+	if atualizado, err := uc.statementRepo.FindImportByID(ctx, imp.ID, input.TenantID); err == nil {
+		output.Import = atualizado
+	}
+	output.Linhas = novas
+
+	common.Logger(ctx, uc.logger).Info("extrato bancário importado",
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("import_id", imp.ID.String()),
+		zap.String("formato", string(formato)),
+		zap.Int("novas", output.Novas),
+		zap.Int("duplicadas", output.Duplicadas),
+		zap.Int("conciliadas", output.Conciliadas),
+		zap.Int("divergentes", output.Divergentes))
+
+	return output, nil
+}
+
+// detectarFormatoExtrato resolve o formato informado ou pela extensão do arquivo
+func detectarFormatoExtrato(formato, arquivoNome string) entity.FormatoExtrato {
+	f := strings.ToUpper(strings.TrimSpace(formato))
+	if f == "" {
+		nome := strings.ToLower(arquivoNome)
+		switch {
+		case strings.HasSuffix(nome, ".ofx"):
+			f = string(entity.FormatoExtratoOFX)
+		case strings.HasSuffix(nome, ".csv"), strings.HasSuffix(nome, ".txt"):
+			f = string(entity.FormatoExtratoCSV)
+		}
+	}
+
+	switch entity.FormatoExtrato(f) {
+	case entity.FormatoExtratoOFX, entity.FormatoExtratoCSV:
+		return entity.FormatoExtrato(f)
+	}
+	return ""
+}
+
+// ListImportacoesExtratoUseCase lista os extratos importados
+type ListImportacoesExtratoUseCase struct {
+	repo port.BankStatementRepository
+}
+
+// NewListImportacoesExtratoUseCase cria nova instância do use case
+func NewListImportacoesExtratoUseCase(repo port.BankStatementRepository) *ListImportacoesExtratoUseCase {
+	return &ListImportacoesExtratoUseCase{repo: repo}
+}
+
+// Execute lista importações paginadas
+func (uc *ListImportacoesExtratoUseCase) Execute(ctx context.Context, tenantID uuid.UUID, page, pageSize int) ([]*entity.BankStatementImport, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListImportacoesExtrato")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return uc.repo.ListImports(ctx, tenantID, pageSize, (page-1)*pageSize)
+}
+
+// GetImportacaoExtratoUseCase busca uma importação com seus lançamentos
+type GetImportacaoExtratoUseCase struct {
+	repo port.BankStatementRepository
+}
+
+// NewGetImportacaoExtratoUseCase cria nova instância do use case
+func NewGetImportacaoExtratoUseCase(repo port.BankStatementRepository) *GetImportacaoExtratoUseCase {
+	return &GetImportacaoExtratoUseCase{repo: repo}
+}
+
+// Execute retorna a importação e seus lançamentos
+func (uc *GetImportacaoExtratoUseCase) Execute(ctx context.Context, tenantID, importID uuid.UUID) (*entity.BankStatementImport, []*entity.BankStatementLine, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GetImportacaoExtrato")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, nil, domain.ErrTenantIDRequired
+	}
+
+	imp, err := uc.repo.FindImportByID(ctx, importID, tenantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	linhas, err := uc.repo.ListLinesByImport(ctx, importID, tenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao buscar lançamentos: %w", err)
+	}
+
+	return imp, linhas, nil
+}
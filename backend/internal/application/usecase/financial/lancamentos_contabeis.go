@@ -0,0 +1,383 @@
+package financial
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// janelaCompensacao amplia a busca de compensações em torno do mês (D+N de cartões)
+const janelaCompensacao = 60 * 24 * time.Hour
+
+// GerarLancamentosContabeisInput define o mês a exportar
+type GerarLancamentosContabeisInput struct {
+	TenantID uuid.UUID
+	MesAno   valueobject.MesAno
+}
+
+// GerarLancamentosContabeisOutput traz os lançamentos do mês e a prova de fechamento
+type GerarLancamentosContabeisOutput struct {
+	MesAno        valueobject.MesAno
+	Lancamentos   []*entity.LancamentoContabil
+	TotalDebitos  decimal.Decimal
+	TotalCreditos decimal.Decimal
+}
+
+// GerarLancamentosContabeisUseCase converte a movimentação do mês em lançamentos de
+// partidas dobradas para o contador.
+//
+// Regras:
+//   - Conta a pagar (vencimento no mês): D categoria / C fornecedores
+//   - Conta paga no mês: D fornecedores / C bancos (pagas pelo caixa são baixadas pela sangria)
+//   - Conta a receber (vencimento no mês): D conta de liquidação / C receita pela origem
+//   - Sangria: D bancos (ou fornecedores, se for pagamento) / C caixa
+//   - Reforço: D caixa / C bancos
+//   - Despesa no caixa: D despesas gerais / C caixa
+//   - Compensação no mês: D bancos (líquido) + D taxas / C conta do meio de pagamento (bruto)
+//
+// Vendas do caixa não geram lançamento: cada pagamento de comanda também gera a conta a
+// receber correspondente, que já é contabilizada.
+type GerarLancamentosContabeisUseCase struct {
+	planoRepo         port.PlanoContasRepository
+	contaPagarRepo    port.ContaPagarRepository
+	contaReceberRepo  port.ContaReceberRepository
+	caixaRepo         port.CaixaDiarioRepository
+	compensacaoRepo   port.CompensacaoBancariaRepository
+	meioPagamentoRepo port.MeioPagamentoRepository
+	logger            *zap.Logger
+}
+
+// NewGerarLancamentosContabeisUseCase cria nova instância do use case
+func NewGerarLancamentosContabeisUseCase(
+	planoRepo port.PlanoContasRepository,
+	contaPagarRepo port.ContaPagarRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	caixaRepo port.CaixaDiarioRepository,
+	compensacaoRepo port.CompensacaoBancariaRepository,
+	meioPagamentoRepo port.MeioPagamentoRepository,
+	logger *zap.Logger,
+) *GerarLancamentosContabeisUseCase {
+	return &GerarLancamentosContabeisUseCase{
+		planoRepo:         planoRepo,
+		contaPagarRepo:    contaPagarRepo,
+		contaReceberRepo:  contaReceberRepo,
+		caixaRepo:         caixaRepo,
+		compensacaoRepo:   compensacaoRepo,
+		meioPagamentoRepo: meioPagamentoRepo,
+		logger:            logger,
+	}
+}
+
+// Execute gera os lançamentos do mês. Retorna ErrLancamentosDesbalanceados se algum
+// lançamento (ou o total do mês) não fechar débitos com créditos.
+func (uc *GerarLancamentosContabeisUseCase) Execute(ctx context.Context, input GerarLancamentosContabeisInput) (*GerarLancamentosContabeisOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.GerarLancamentosContabeis")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	tenantID := input.TenantID.String()
+	inicio := input.MesAno.PrimeiroDia()
+	fim := input.MesAno.UltimoDia()
+
+	mapeamentos, err := uc.planoRepo.List(ctx, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	meios, err := uc.meioPagamentoRepo.List(ctx, tenantID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar meios de pagamento: %w", err)
+	}
+	contasPagar, err := uc.contaPagarRepo.ListByDateRange(ctx, tenantID, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+	contasPagas, err := uc.contaPagarRepo.ListPagasByPeriod(ctx, tenantID, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+	contasReceber, err := uc.contaReceberRepo.ListByDateRange(ctx, tenantID, inicio, fim)
+	if err != nil {
+		return nil, err
+	}
+	operacoes, err := uc.caixaRepo.ListOperacoesByPeriodo(ctx, input.TenantID, inicio, inicio.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+	compensacoes, err := uc.compensacaoRepo.ListByDateRange(ctx, tenantID, inicio.Add(-janelaCompensacao), fim.Add(janelaCompensacao))
+	if err != nil {
+		return nil, err
+	}
+
+	g := newGeradorLancamentos(entity.NewPlanoContas(mapeamentos), meios, compensacoes)
+	g.contasPagar(contasPagar)
+	g.pagamentos(contasPagas)
+	g.contasReceber(contasReceber)
+	g.operacoesCaixa(operacoes)
+	g.compensacoes(compensacoes, inicio, fim)
+
+	out := &GerarLancamentosContabeisOutput{MesAno: input.MesAno, Lancamentos: g.finalizar()}
+	if err := fecharLancamentos(out); err != nil {
+		common.Logger(ctx, uc.logger).Error("Lançamentos contábeis desbalanceados",
+			zap.String("tenant_id", tenantID),
+			zap.String("mes_ano", input.MesAno.String()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Lançamentos contábeis gerados",
+		zap.String("tenant_id", tenantID),
+		zap.String("mes_ano", input.MesAno.String()),
+		zap.Int("lancamentos", len(out.Lancamentos)),
+		zap.String("total", out.TotalDebitos.StringFixed(2)),
+	)
+	return out, nil
+}
+
+// fecharLancamentos confere cada lançamento e o total do mês (débitos = créditos)
+func fecharLancamentos(out *GerarLancamentosContabeisOutput) error {
+	out.TotalDebitos = decimal.Zero
+	out.TotalCreditos = decimal.Zero
+	for _, l := range out.Lancamentos {
+		if !l.Balanceado() {
+			return fmt.Errorf("%w: lote %d (%s) D %s / C %s", domain.ErrLancamentosDesbalanceados,
+				l.Lote, l.Historico, l.TotalDebitos().StringFixed(2), l.TotalCreditos().StringFixed(2))
+		}
+		out.TotalDebitos = out.TotalDebitos.Add(l.TotalDebitos())
+		out.TotalCreditos = out.TotalCreditos.Add(l.TotalCreditos())
+	}
+	if !out.TotalDebitos.Equal(out.TotalCreditos) {
+		return fmt.Errorf("%w: total D %s / C %s", domain.ErrLancamentosDesbalanceados,
+			out.TotalDebitos.StringFixed(2), out.TotalCreditos.StringFixed(2))
+	}
+	return nil
+}
+
+// geradorLancamentos acumula os lançamentos do mês a partir de cada tipo de registro
+type geradorLancamentos struct {
+	plano       *entity.PlanoContas
+	meios       map[string]*entity.MeioPagamento
+	meioReceita map[string]string // receita_id → meio_pagamento_id (via compensação)
+	lancamentos []*entity.LancamentoContabil
+}
+
+func newGeradorLancamentos(plano *entity.PlanoContas, meios []*entity.MeioPagamento, compensacoes []*entity.CompensacaoBancaria) *geradorLancamentos {
+	g := &geradorLancamentos{
+		plano:       plano,
+		meios:       make(map[string]*entity.MeioPagamento, len(meios)),
+		meioReceita: make(map[string]string, len(compensacoes)),
+	}
+	for _, m := range meios {
+		g.meios[m.ID.String()] = m
+	}
+	for _, c := range compensacoes {
+		if c.Status != valueobject.StatusCompensacaoCancelado {
+			g.meioReceita[c.ReceitaID] = c.MeioPagamentoID
+		}
+	}
+	return g
+}
+
+func (g *geradorLancamentos) novo(origem entity.OrigemLancamentoContabil, data time.Time, documento, historico string) *entity.LancamentoContabil {
+	l := &entity.LancamentoContabil{
+		Data:      data,
+		Historico: historico,
+		Documento: documento,
+		Origem:    origem,
+	}
+	g.lancamentos = append(g.lancamentos, l)
+	return l
+}
+
+// contasPagar apropria a despesa na competência (vencimento)
+func (g *geradorLancamentos) contasPagar(contas []*entity.ContaPagar) {
+	fornecedores := g.plano.Padrao(entity.ContaPadraoFornecedores)
+	for _, c := range contas {
+		if c.Status == valueobject.StatusContaCancelado {
+			continue
+		}
+		l := g.novo(entity.OrigemLancamentoContaPagar, c.DataVencimento, documentoCurto(c.ID), historicoComFornecedor(c.Descricao, c.Fornecedor))
+		l.Debitar(g.plano.Categoria(c.CategoriaID), c.Valor.Value())
+		l.Creditar(fornecedores, c.Valor.Value())
+	}
+}
+
+// pagamentos baixa fornecedores contra bancos. Contas pagas por sangria do caixa
+// (comprovante CAIXA_<id>) já são baixadas pela própria sangria.
+func (g *geradorLancamentos) pagamentos(contas []*entity.ContaPagar) {
+	fornecedores := g.plano.Padrao(entity.ContaPadraoFornecedores)
+	bancos := g.plano.Padrao(entity.ContaPadraoBancos)
+	for _, c := range contas {
+		if c.DataPagamento == nil || strings.HasPrefix(c.ComprovanteURL, "CAIXA_") {
+			continue
+		}
+		l := g.novo(entity.OrigemLancamentoPagamento, *c.DataPagamento, documentoCurto(c.ID), "Pagamento: "+historicoComFornecedor(c.Descricao, c.Fornecedor))
+		l.Debitar(fornecedores, c.Valor.Value())
+		l.Creditar(bancos, c.Valor.Value())
+	}
+}
+
+// contasReceber reconhece a receita contra a conta de liquidação do recebimento
+func (g *geradorLancamentos) contasReceber(contas []*entity.ContaReceber) {
+	for _, c := range contas {
+		if c.Status == valueobject.StatusContaCancelado || c.Status == valueobject.StatusContaEstornado {
+			continue
+		}
+		historico := c.DescricaoOrigem
+		if historico == "" {
+			historico = "Receita " + strings.ToLower(c.Origem)
+		}
+		l := g.novo(entity.OrigemLancamentoContaReceber, c.DataVencimento, documentoCurto(c.ID), historico)
+		l.Debitar(g.contaLiquidacaoReceita(c), c.Valor.Value())
+		l.Creditar(g.contaReceita(c.Origem), c.Valor.Value())
+	}
+}
+
+// contaLiquidacaoReceita escolhe a conta debitada pela receita: a do meio de pagamento
+// (quando há compensação), clientes se ainda em aberto, ou caixa/bancos se já recebida.
+func (g *geradorLancamentos) contaLiquidacaoReceita(c *entity.ContaReceber) entity.ContaContabil {
+	if meioID, ok := g.meioReceita[c.ID]; ok {
+		return g.plano.MeioPagamento(g.meioPagamento(meioID))
+	}
+	switch c.Status {
+	case valueobject.StatusContaRecebido, valueobject.StatusContaConfirmado:
+		if c.Origem == "ASSINATURA" || c.AsaasPaymentID != nil {
+			return g.plano.Padrao(entity.ContaPadraoBancos)
+		}
+		return g.plano.Padrao(entity.ContaPadraoCaixa)
+	}
+	return g.plano.Padrao(entity.ContaPadraoClientes)
+}
+
+func (g *geradorLancamentos) contaReceita(origem string) entity.ContaContabil {
+	switch origem {
+	case "SERVICO":
+		return g.plano.Padrao(entity.ContaPadraoReceitaServicos)
+	case "PRODUTO":
+		return g.plano.Padrao(entity.ContaPadraoReceitaProdutos)
+	case "ASSINATURA":
+		return g.plano.Padrao(entity.ContaPadraoReceitaAssinaturas)
+	}
+	return g.plano.Padrao(entity.ContaPadraoReceitaOutras)
+}
+
+// operacoesCaixa registra sangrias, reforços e despesas pagas em dinheiro
+func (g *geradorLancamentos) operacoesCaixa(operacoes []entity.OperacaoCaixa) {
+	caixa := g.plano.Padrao(entity.ContaPadraoCaixa)
+	bancos := g.plano.Padrao(entity.ContaPadraoBancos)
+	for i := range operacoes {
+		op := &operacoes[i]
+		historico := op.Descricao
+		switch op.Tipo {
+		case entity.TipoOperacaoSangria:
+			destino := ""
+			if op.Destino != nil {
+				destino = *op.Destino
+			}
+			if destino == string(entity.DestinoCofre) {
+				continue // dinheiro continua em espécie
+			}
+			if historico == "" {
+				historico = "Sangria " + strings.ToLower(destino)
+			}
+			l := g.novo(entity.OrigemLancamentoOperacaoCaixa, op.CreatedAt, documentoCurto(op.ID.String()), historico)
+			if destino == string(entity.DestinoPagamento) {
+				l.Debitar(g.plano.Padrao(entity.ContaPadraoFornecedores), op.Valor)
+			} else {
+				l.Debitar(bancos, op.Valor)
+			}
+			l.Creditar(caixa, op.Valor)
+		case entity.TipoOperacaoReforco:
+			if historico == "" {
+				historico = "Reforço de caixa"
+			}
+			l := g.novo(entity.OrigemLancamentoOperacaoCaixa, op.CreatedAt, documentoCurto(op.ID.String()), historico)
+			l.Debitar(caixa, op.Valor)
+			l.Creditar(bancos, op.Valor)
+		case entity.TipoOperacaoDespesa:
+			if historico == "" {
+				historico = "Despesa paga no caixa"
+			}
+			l := g.novo(entity.OrigemLancamentoOperacaoCaixa, op.CreatedAt, documentoCurto(op.ID.String()), historico)
+			l.Debitar(g.plano.Padrao(entity.ContaPadraoDespesasGerais), op.Valor)
+			l.Creditar(caixa, op.Valor)
+		}
+	}
+}
+
+// compensacoes liquida os recebíveis compensados no mês, separando a taxa
+func (g *geradorLancamentos) compensacoes(compensacoes []*entity.CompensacaoBancaria, inicio, fim time.Time) {
+	bancos := g.plano.Padrao(entity.ContaPadraoBancos)
+	taxas := g.plano.Padrao(entity.ContaPadraoTaxasCartao)
+	limite := fim.AddDate(0, 0, 1)
+	for _, c := range compensacoes {
+		if c.Status != valueobject.StatusCompensacaoCompensado || c.DataCompensado == nil {
+			continue
+		}
+		if c.DataCompensado.Before(inicio) || !c.DataCompensado.Before(limite) {
+			continue
+		}
+
+		meio := g.meioPagamento(c.MeioPagamentoID)
+		historico := "Compensação bancária"
+		if meio != nil && meio.Nome != "" {
+			historico += " - " + meio.Nome
+		}
+
+		bruto := c.ValorBruto.Value().Round(2)
+		liquido := c.ValorLiquido.Value().Round(2)
+		l := g.novo(entity.OrigemLancamentoCompensacao, *c.DataCompensado, documentoCurto(c.ID), historico)
+		l.Debitar(bancos, liquido)
+		l.Debitar(taxas, bruto.Sub(liquido))
+		l.Creditar(g.plano.MeioPagamento(meio), bruto)
+	}
+}
+
+func (g *geradorLancamentos) meioPagamento(id string) *entity.MeioPagamento {
+	return g.meios[id]
+}
+
+// finalizar ordena por data e numera os lotes. Lançamentos sem valor são descartados.
+func (g *geradorLancamentos) finalizar() []*entity.LancamentoContabil {
+	lancamentos := make([]*entity.LancamentoContabil, 0, len(g.lancamentos))
+	for _, l := range g.lancamentos {
+		if len(l.Partidas) > 0 {
+			lancamentos = append(lancamentos, l)
+		}
+	}
+	sort.SliceStable(lancamentos, func(i, j int) bool {
+		return lancamentos[i].Data.Before(lancamentos[j].Data)
+	})
+	for i, l := range lancamentos {
+		l.Lote = i + 1
+	}
+	return lancamentos
+}
+
+func historicoComFornecedor(descricao, fornecedor string) string {
+	if fornecedor == "" {
+		return descricao
+	}
+	return descricao + " - " + fornecedor
+}
+
+// documentoCurto usa o prefixo do ID como número do documento
+func documentoCurto(id string) string {
+	if len(id) > 8 {
+		return id[:8]
+	}
+	return id
+}
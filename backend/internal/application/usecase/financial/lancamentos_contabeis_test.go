@@ -0,0 +1,108 @@
+package financial
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func money(s string) valueobject.Money {
+	return valueobject.NewMoneyFromDecimal(decimal.RequireFromString(s))
+}
+
+func TestGeradorLancamentos(t *testing.T) {
+	inicio := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	fim := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	dia := func(d int) time.Time { return time.Date(2024, 3, d, 0, 0, 0, 0, time.UTC) }
+
+	categoriaAluguel := uuid.NewString()
+	credito := &entity.MeioPagamento{ID: uuid.New(), Nome: "Crédito", Tipo: entity.TipoPagamentoCredito}
+
+	plano := entity.NewPlanoContas([]*entity.PlanoContasMapeamento{
+		{OrigemTipo: entity.OrigemContabilCategoria, OrigemChave: categoriaAluguel, ContaCodigo: "4.2.1.01", ContaNome: "Aluguel"},
+		{OrigemTipo: entity.OrigemContabilPadrao, OrigemChave: string(entity.ContaPadraoBancos), ContaCodigo: "1.1.1.05"},
+	})
+
+	pago := dia(10)
+	compensado := dia(20)
+	compensacoes := []*entity.CompensacaoBancaria{{
+		ID:              uuid.NewString(),
+		ReceitaID:       "receita-cartao",
+		MeioPagamentoID: credito.ID.String(),
+		ValorBruto:      money("100"),
+		ValorLiquido:    money("96.50"),
+		DataCompensado:  &compensado,
+		Status:          valueobject.StatusCompensacaoCompensado,
+	}}
+	cofre := string(entity.DestinoCofre)
+	deposito := string(entity.DestinoDeposito)
+
+	g := newGeradorLancamentos(plano, []*entity.MeioPagamento{credito}, compensacoes)
+	g.contasPagar([]*entity.ContaPagar{
+		{ID: uuid.NewString(), Descricao: "Aluguel", CategoriaID: categoriaAluguel, Valor: money("1500"), DataVencimento: dia(5), Status: valueobject.StatusContaPago},
+		{ID: uuid.NewString(), Descricao: "Cancelada", Valor: money("10"), DataVencimento: dia(5), Status: valueobject.StatusContaCancelado},
+	})
+	g.pagamentos([]*entity.ContaPagar{
+		{ID: uuid.NewString(), Descricao: "Aluguel", Valor: money("1500"), DataPagamento: &pago},
+		{ID: uuid.NewString(), Descricao: "Paga no caixa", Valor: money("50"), DataPagamento: &pago, ComprovanteURL: "CAIXA_123"},
+	})
+	g.contasReceber([]*entity.ContaReceber{
+		{ID: "receita-cartao", Origem: "SERVICO", Valor: money("100"), DataVencimento: dia(2), Status: valueobject.StatusContaConfirmado},
+		{ID: "receita-dinheiro", Origem: "PRODUTO", Valor: money("40"), DataVencimento: dia(2), Status: valueobject.StatusContaRecebido},
+	})
+	g.operacoesCaixa([]entity.OperacaoCaixa{
+		{ID: uuid.New(), Tipo: entity.TipoOperacaoVenda, Valor: decimal.NewFromInt(140), CreatedAt: dia(2)},
+		{ID: uuid.New(), Tipo: entity.TipoOperacaoSangria, Valor: decimal.NewFromInt(30), Destino: &cofre, CreatedAt: dia(3)},
+		{ID: uuid.New(), Tipo: entity.TipoOperacaoSangria, Valor: decimal.NewFromInt(40), Destino: &deposito, CreatedAt: dia(3)},
+	})
+	g.compensacoes(compensacoes, inicio, fim)
+
+	out := &GerarLancamentosContabeisOutput{Lancamentos: g.finalizar()}
+	require.NoError(t, fecharLancamentos(out))
+
+	// apropriação, pagamento, 2 receitas, sangria para depósito e compensação
+	require.Len(t, out.Lancamentos, 6)
+	assert.Equal(t, "3280", out.TotalDebitos.String()) // 1500 + 1500 + 100 + 40 + 40 + 100
+	assert.True(t, out.TotalDebitos.Equal(out.TotalCreditos))
+
+	porOrigem := map[entity.OrigemLancamentoContabil][]*entity.LancamentoContabil{}
+	for i, l := range out.Lancamentos {
+		assert.Equal(t, i+1, l.Lote)
+		porOrigem[l.Origem] = append(porOrigem[l.Origem], l)
+	}
+
+	apropriacao := porOrigem[entity.OrigemLancamentoContaPagar][0]
+	assert.Equal(t, "4.2.1.01", apropriacao.Partidas[0].Conta.Codigo)
+	assert.Equal(t, "2.1.1.01", apropriacao.Partidas[1].Conta.Codigo)
+
+	pagamento := porOrigem[entity.OrigemLancamentoPagamento][0]
+	assert.Equal(t, "1.1.1.05", pagamento.Partidas[1].Conta.Codigo)
+	assert.Equal(t, "Bancos conta movimento", pagamento.Partidas[1].Conta.Nome)
+
+	receitas := porOrigem[entity.OrigemLancamentoContaReceber]
+	require.Len(t, receitas, 2)
+	assert.Equal(t, "1.1.2.02", receitas[0].Partidas[0].Conta.Codigo) // cartões a receber
+	assert.Equal(t, "1.1.1.01", receitas[1].Partidas[0].Conta.Codigo) // caixa
+
+	compensacao := porOrigem[entity.OrigemLancamentoCompensacao][0]
+	require.Len(t, compensacao.Partidas, 3)
+	assert.Equal(t, "96.5", compensacao.Partidas[0].Valor.String())
+	assert.Equal(t, "3.5", compensacao.Partidas[1].Valor.String())
+	assert.Equal(t, "1.1.2.02", compensacao.Partidas[2].Conta.Codigo)
+}
+
+func TestFecharLancamentosDesbalanceado(t *testing.T) {
+	l := &entity.LancamentoContabil{Lote: 1, Historico: "teste"}
+	l.Debitar(entity.ContaContabil{Codigo: "1"}, decimal.NewFromInt(10))
+	l.Creditar(entity.ContaContabil{Codigo: "2"}, decimal.NewFromInt(9))
+
+	err := fecharLancamentos(&GerarLancamentosContabeisOutput{Lancamentos: []*entity.LancamentoContabil{l}})
+	assert.ErrorIs(t, err, domain.ErrLancamentosDesbalanceados)
+}
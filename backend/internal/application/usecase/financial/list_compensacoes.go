@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -28,6 +29,9 @@ func NewListCompensacoesUseCase(repo port.CompensacaoBancariaRepository, logger
 }
 
 func (uc *ListCompensacoesUseCase) Execute(ctx context.Context, input ListCompensacoesInput) ([]*entity.CompensacaoBancaria, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListCompensacoes")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
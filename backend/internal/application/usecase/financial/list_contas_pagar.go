@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -34,6 +35,9 @@ func NewListContasPagarUseCase(repo port.ContaPagarRepository, logger *zap.Logge
 }
 
 func (uc *ListContasPagarUseCase) Execute(ctx context.Context, input ListContasPagarInput) ([]*entity.ContaPagar, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListContasPagar")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -33,6 +34,9 @@ func NewListContasReceberUseCase(repo port.ContaReceberRepository, logger *zap.L
 }
 
 func (uc *ListContasReceberUseCase) Execute(ctx context.Context, input ListContasReceberInput) ([]*entity.ContaReceber, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListContasReceber")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -47,6 +48,9 @@ func NewListDespesasFixasUseCase(
 
 // Execute lista despesas fixas com paginação
 func (uc *ListDespesasFixasUseCase) Execute(ctx context.Context, input ListDespesasFixasInput) (*ListDespesasFixasOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListDespesasFixas")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -72,7 +76,7 @@ func (uc *ListDespesasFixasUseCase) Execute(ctx context.Context, input ListDespe
 
 	despesas, total, err := uc.repo.List(ctx, input.TenantID, filters)
 	if err != nil {
-		uc.logger.Error("Erro ao listar despesas fixas",
+		common.Logger(ctx, uc.logger).Error("Erro ao listar despesas fixas",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 		)
@@ -5,6 +5,7 @@ import (
 
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -28,6 +29,9 @@ func NewListDREUseCase(repo port.DREMensalRepository, logger *zap.Logger) *ListD
 }
 
 func (uc *ListDREUseCase) Execute(ctx context.Context, input ListDREInput) ([]*entity.DREMensal, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListDRE")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
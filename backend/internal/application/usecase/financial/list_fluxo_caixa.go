@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -28,6 +29,9 @@ func NewListFluxoCaixaUseCase(repo port.FluxoCaixaDiarioRepository, logger *zap.
 }
 
 func (uc *ListFluxoCaixaUseCase) Execute(ctx context.Context, input ListFluxoCaixaInput) ([]*entity.FluxoCaixaDiario, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListFluxoCaixa")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -43,6 +44,9 @@ func NewMarcarCompensacaoUseCase(
 
 // Execute marca uma compensação como compensada
 func (uc *MarcarCompensacaoUseCase) Execute(ctx context.Context, input MarcarCompensacaoInput) (*entity.CompensacaoBancaria, error) {
+	ctx, span := common.StartSpan(ctx, "financial.MarcarCompensacao")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -55,7 +59,7 @@ func (uc *MarcarCompensacaoUseCase) Execute(ctx context.Context, input MarcarCom
 	// Buscar compensação existente
 	comp, err := uc.repo.FindByID(ctx, input.TenantID, input.CompensacaoID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar compensação",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar compensação",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("comp_id", input.CompensacaoID),
@@ -65,7 +69,7 @@ func (uc *MarcarCompensacaoUseCase) Execute(ctx context.Context, input MarcarCom
 
 	// Marcar como compensado (método do domínio)
 	if err := comp.MarcarComoCompensado(); err != nil {
-		uc.logger.Error("Erro ao marcar compensação como compensada",
+		common.Logger(ctx, uc.logger).Error("Erro ao marcar compensação como compensada",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("comp_id", input.CompensacaoID),
@@ -75,7 +79,7 @@ func (uc *MarcarCompensacaoUseCase) Execute(ctx context.Context, input MarcarCom
 
 	// Atualizar no repositório
 	if err := uc.repo.Update(ctx, comp); err != nil {
-		uc.logger.Error("Erro ao atualizar compensação",
+		common.Logger(ctx, uc.logger).Error("Erro ao atualizar compensação",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("comp_id", input.CompensacaoID),
@@ -83,7 +87,7 @@ func (uc *MarcarCompensacaoUseCase) Execute(ctx context.Context, input MarcarCom
 		return nil, fmt.Errorf("erro ao atualizar compensação: %w", err)
 	}
 
-	uc.logger.Info("Compensação marcada como compensada",
+	common.Logger(ctx, uc.logger).Info("Compensação marcada como compensada",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("comp_id", comp.ID),
 		zap.String("data_compensado", comp.DataCompensado.Format("2006-01-02")),
@@ -104,7 +108,7 @@ func (uc *MarcarCompensacaoUseCase) ExecuteBatch(ctx context.Context, tenantID s
 	// Buscar compensações pendentes
 	compensacoes, err := uc.repo.ListPendentesCompensacao(ctx, tenantID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar compensações pendentes",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar compensações pendentes",
 			zap.Error(err),
 			zap.String("tenant_id", tenantID),
 		)
@@ -115,7 +119,7 @@ func (uc *MarcarCompensacaoUseCase) ExecuteBatch(ctx context.Context, tenantID s
 
 	for _, comp := range compensacoes {
 		if err := comp.MarcarComoCompensado(); err != nil {
-			uc.logger.Warn("Erro ao marcar compensação individual",
+			common.Logger(ctx, uc.logger).Warn("Erro ao marcar compensação individual",
 				zap.Error(err),
 				zap.String("comp_id", comp.ID),
 			)
@@ -123,7 +127,7 @@ func (uc *MarcarCompensacaoUseCase) ExecuteBatch(ctx context.Context, tenantID s
 		}
 
 		if err := uc.repo.Update(ctx, comp); err != nil {
-			uc.logger.Warn("Erro ao atualizar compensação individual",
+			common.Logger(ctx, uc.logger).Warn("Erro ao atualizar compensação individual",
 				zap.Error(err),
 				zap.String("comp_id", comp.ID),
 			)
@@ -134,7 +138,7 @@ func (uc *MarcarCompensacaoUseCase) ExecuteBatch(ctx context.Context, tenantID s
 		count++
 	}
 
-	uc.logger.Info("Compensações processadas em lote",
+	common.Logger(ctx, uc.logger).Info("Compensações processadas em lote",
 		zap.String("tenant_id", tenantID),
 		zap.Int("total", len(compensacoes)),
 		zap.Int("marcadas", count),
@@ -151,7 +155,7 @@ func (uc *MarcarCompensacaoUseCase) atualizarContaReceberCompensada(ctx context.
 
 	conta, err := uc.contaReceberRepo.FindByID(ctx, tenantID, comp.ReceitaID)
 	if err != nil || conta == nil {
-		uc.logger.Debug("conta a receber vinculada não encontrada para compensação",
+		common.Logger(ctx, uc.logger).Debug("conta a receber vinculada não encontrada para compensação",
 			zap.String("tenant_id", tenantID),
 			zap.String("receita_id", comp.ReceitaID),
 			zap.Error(err),
@@ -171,7 +175,7 @@ func (uc *MarcarCompensacaoUseCase) atualizarContaReceberCompensada(ctx context.
 	}
 
 	if err := conta.MarcarComoRecebido(dataRecebimento); err != nil {
-		uc.logger.Warn("erro ao marcar conta a receber como recebida via compensação",
+		common.Logger(ctx, uc.logger).Warn("erro ao marcar conta a receber como recebida via compensação",
 			zap.String("tenant_id", tenantID),
 			zap.String("conta_receber_id", conta.ID),
 			zap.Error(err),
@@ -181,7 +185,7 @@ func (uc *MarcarCompensacaoUseCase) atualizarContaReceberCompensada(ctx context.
 
 	conta.ReceivedAt = &dataRecebimento
 	if err := uc.contaReceberRepo.Update(ctx, conta); err != nil {
-		uc.logger.Warn("erro ao atualizar conta a receber após compensação",
+		common.Logger(ctx, uc.logger).Warn("erro ao atualizar conta a receber após compensação",
 			zap.String("tenant_id", tenantID),
 			zap.String("conta_receber_id", conta.ID),
 			zap.Error(err),
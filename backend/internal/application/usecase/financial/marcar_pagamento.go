@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -39,6 +40,9 @@ func NewMarcarPagamentoUseCase(
 
 // Execute marca uma conta a pagar como paga
 func (uc *MarcarPagamentoUseCase) Execute(ctx context.Context, input MarcarPagamentoInput) (*entity.ContaPagar, error) {
+	ctx, span := common.StartSpan(ctx, "financial.MarcarPagamento")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -55,7 +59,7 @@ func (uc *MarcarPagamentoUseCase) Execute(ctx context.Context, input MarcarPagam
 	// Buscar conta existente
 	conta, err := uc.repo.FindByID(ctx, input.TenantID, input.ContaID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar conta a pagar",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar conta a pagar",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -65,7 +69,7 @@ func (uc *MarcarPagamentoUseCase) Execute(ctx context.Context, input MarcarPagam
 
 	// Marcar como pago (método do domínio)
 	if err := conta.MarcarComoPago(input.DataPagamento, input.ComprovanteURL); err != nil {
-		uc.logger.Error("Erro ao marcar conta como paga",
+		common.Logger(ctx, uc.logger).Error("Erro ao marcar conta como paga",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -75,7 +79,7 @@ func (uc *MarcarPagamentoUseCase) Execute(ctx context.Context, input MarcarPagam
 
 	// Atualizar no repositório
 	if err := uc.repo.Update(ctx, conta); err != nil {
-		uc.logger.Error("Erro ao atualizar conta a pagar",
+		common.Logger(ctx, uc.logger).Error("Erro ao atualizar conta a pagar",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -83,7 +87,7 @@ func (uc *MarcarPagamentoUseCase) Execute(ctx context.Context, input MarcarPagam
 		return nil, fmt.Errorf("erro ao atualizar conta: %w", err)
 	}
 
-	uc.logger.Info("Conta a pagar marcada como paga",
+	common.Logger(ctx, uc.logger).Info("Conta a pagar marcada como paga",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("conta_id", conta.ID),
 		zap.Time("data_pagamento", input.DataPagamento),
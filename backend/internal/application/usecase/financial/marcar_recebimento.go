@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -39,6 +40,9 @@ func NewMarcarRecebimentoUseCase(
 
 // Execute marca uma conta a receber como recebida
 func (uc *MarcarRecebimentoUseCase) Execute(ctx context.Context, input MarcarRecebimentoInput) (*entity.ContaReceber, error) {
+	ctx, span := common.StartSpan(ctx, "financial.MarcarRecebimento")
+	defer span.End()
+
 	// Validações de entrada
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
@@ -55,7 +59,7 @@ func (uc *MarcarRecebimentoUseCase) Execute(ctx context.Context, input MarcarRec
 	// Buscar conta existente
 	conta, err := uc.repo.FindByID(ctx, input.TenantID, input.ContaID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar conta a receber",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar conta a receber",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -65,7 +69,7 @@ func (uc *MarcarRecebimentoUseCase) Execute(ctx context.Context, input MarcarRec
 
 	// Marcar como recebido (método do domínio)
 	if err := conta.MarcarComoRecebido(input.DataRecebimento); err != nil {
-		uc.logger.Error("Erro ao marcar conta como recebida",
+		common.Logger(ctx, uc.logger).Error("Erro ao marcar conta como recebida",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -75,7 +79,7 @@ func (uc *MarcarRecebimentoUseCase) Execute(ctx context.Context, input MarcarRec
 
 	// Atualizar no repositório
 	if err := uc.repo.Update(ctx, conta); err != nil {
-		uc.logger.Error("Erro ao atualizar conta a receber",
+		common.Logger(ctx, uc.logger).Error("Erro ao atualizar conta a receber",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("conta_id", input.ContaID),
@@ -83,7 +87,7 @@ func (uc *MarcarRecebimentoUseCase) Execute(ctx context.Context, input MarcarRec
 		return nil, fmt.Errorf("erro ao atualizar conta: %w", err)
 	}
 
-	uc.logger.Info("Conta a receber marcada como recebida",
+	common.Logger(ctx, uc.logger).Info("Conta a receber marcada como recebida",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("conta_id", conta.ID),
 		zap.Time("data_recebimento", input.DataRecebimento),
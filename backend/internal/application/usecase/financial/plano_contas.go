@@ -0,0 +1,115 @@
+package financial
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// PlanoContasOutput traz as contas padrão resolvidas e os mapeamentos personalizados
+type PlanoContasOutput struct {
+	Padrao      map[entity.ContaPadrao]entity.ContaContabil
+	Mapeamentos []*entity.PlanoContasMapeamento
+}
+
+// ListPlanoContasUseCase lista o plano de contas contábil do tenant
+type ListPlanoContasUseCase struct {
+	repo port.PlanoContasRepository
+}
+
+// NewListPlanoContasUseCase cria nova instância do use case
+func NewListPlanoContasUseCase(repo port.PlanoContasRepository) *ListPlanoContasUseCase {
+	return &ListPlanoContasUseCase{repo: repo}
+}
+
+// Execute retorna as contas padrão (já com personalizações) e os mapeamentos cadastrados
+func (uc *ListPlanoContasUseCase) Execute(ctx context.Context, tenantID uuid.UUID) (*PlanoContasOutput, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListPlanoContas")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	mapeamentos, err := uc.repo.List(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	plano := entity.NewPlanoContas(mapeamentos)
+	padrao := make(map[entity.ContaPadrao]entity.ContaContabil, len(entity.ContasPadrao))
+	for _, chave := range entity.ContasPadrao {
+		padrao[chave] = plano.Padrao(chave)
+	}
+
+	return &PlanoContasOutput{Padrao: padrao, Mapeamentos: mapeamentos}, nil
+}
+
+// SalvarMapeamentoContabilInput define os dados do mapeamento
+type SalvarMapeamentoContabilInput struct {
+	TenantID    uuid.UUID
+	OrigemTipo  string
+	OrigemChave string
+	ContaCodigo string
+	ContaNome   string
+}
+
+// SalvarMapeamentoContabilUseCase cria ou substitui o mapeamento de uma origem
+type SalvarMapeamentoContabilUseCase struct {
+	repo   port.PlanoContasRepository
+	logger *zap.Logger
+}
+
+// NewSalvarMapeamentoContabilUseCase cria nova instância do use case
+func NewSalvarMapeamentoContabilUseCase(repo port.PlanoContasRepository, logger *zap.Logger) *SalvarMapeamentoContabilUseCase {
+	return &SalvarMapeamentoContabilUseCase{repo: repo, logger: logger}
+}
+
+// Execute valida e persiste o mapeamento
+func (uc *SalvarMapeamentoContabilUseCase) Execute(ctx context.Context, input SalvarMapeamentoContabilInput) (*entity.PlanoContasMapeamento, error) {
+	ctx, span := common.StartSpan(ctx, "financial.SalvarMapeamentoContabil")
+	defer span.End()
+
+	m, err := entity.NewPlanoContasMapeamento(input.TenantID, input.OrigemTipo, input.OrigemChave, input.ContaCodigo, input.ContaNome)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.Upsert(ctx, m); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Mapeamento contábil salvo",
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("origem_tipo", string(m.OrigemTipo)),
+		zap.String("origem_chave", m.OrigemChave),
+		zap.String("conta", m.ContaCodigo),
+	)
+	return m, nil
+}
+
+// RemoverMapeamentoContabilUseCase remove um mapeamento (a origem volta à conta padrão)
+type RemoverMapeamentoContabilUseCase struct {
+	repo port.PlanoContasRepository
+}
+
+// NewRemoverMapeamentoContabilUseCase cria nova instância do use case
+func NewRemoverMapeamentoContabilUseCase(repo port.PlanoContasRepository) *RemoverMapeamentoContabilUseCase {
+	return &RemoverMapeamentoContabilUseCase{repo: repo}
+}
+
+// Execute remove o mapeamento
+func (uc *RemoverMapeamentoContabilUseCase) Execute(ctx context.Context, tenantID, id uuid.UUID) error {
+	ctx, span := common.StartSpan(ctx, "financial.RemoverMapeamentoContabil")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return domain.ErrTenantIDRequired
+	}
+	return uc.repo.Delete(ctx, id, tenantID)
+}
@@ -0,0 +1,245 @@
+package financial
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// ListRevisaoConciliacaoUseCase lista a fila de revisão (lançamentos pendentes e divergentes)
+type ListRevisaoConciliacaoUseCase struct {
+	repo port.BankStatementRepository
+}
+
+// NewListRevisaoConciliacaoUseCase cria nova instância do use case
+func NewListRevisaoConciliacaoUseCase(repo port.BankStatementRepository) *ListRevisaoConciliacaoUseCase {
+	return &ListRevisaoConciliacaoUseCase{repo: repo}
+}
+
+// Execute lista lançamentos aguardando revisão
+func (uc *ListRevisaoConciliacaoUseCase) Execute(ctx context.Context, tenantID uuid.UUID) ([]*entity.BankStatementLine, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ListRevisaoConciliacao")
+	defer span.End()
+
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.ListLinesRevisao(ctx, tenantID)
+}
+
+// ConciliarLinhaManualInput define os dados para conciliação manual
+type ConciliarLinhaManualInput struct {
+	TenantID  uuid.UUID
+	UsuarioID uuid.UUID
+	LinhaID   uuid.UUID
+	MatchTipo string
+	MatchID   uuid.UUID
+}
+
+// ConciliarLinhaManualUseCase vincula manualmente um lançamento do extrato a um registro interno
+type ConciliarLinhaManualUseCase struct {
+	repo        port.BankStatementRepository
+	conciliador *conciliadorExtrato
+	logger      *zap.Logger
+}
+
+// NewConciliarLinhaManualUseCase cria nova instância do use case
+func NewConciliarLinhaManualUseCase(
+	repo port.BankStatementRepository,
+	compensacaoRepo port.CompensacaoBancariaRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	contaPagarRepo port.ContaPagarRepository,
+	marcarCompensacaoUC *MarcarCompensacaoUseCase,
+	marcarRecebimentoUC *MarcarRecebimentoUseCase,
+	marcarPagamentoUC *MarcarPagamentoUseCase,
+	logger *zap.Logger,
+) *ConciliarLinhaManualUseCase {
+	return &ConciliarLinhaManualUseCase{
+		repo: repo,
+		conciliador: newConciliadorExtrato(repo, compensacaoRepo, contaReceberRepo, contaPagarRepo,
+			marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger),
+		logger: logger,
+	}
+}
+
+// Execute concilia o lançamento. Com valor exato o registro interno é baixado;
+// com diferença o lançamento fica DIVERGENTE até ser aceito.
+func (uc *ConciliarLinhaManualUseCase) Execute(ctx context.Context, input ConciliarLinhaManualInput) (*entity.BankStatementLine, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ConciliarLinhaManual")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if !entity.ValidarMatchTipoExtrato(input.MatchTipo) {
+		return nil, domain.ErrExtratoMatchTipoInvalido
+	}
+	tipo := entity.MatchTipoExtrato(input.MatchTipo)
+
+	linha, err := uc.repo.FindLineByID(ctx, input.LinhaID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if linha.Status == entity.StatusLinhaConciliada {
+		return nil, domain.ErrExtratoLinhaJaConciliada
+	}
+
+	// Crédito só concilia com recebíveis; débito só com contas a pagar
+	if (linha.Tipo == entity.TipoLancamentoCredito) == (tipo == entity.MatchTipoContaPagar) {
+		return nil, domain.ErrExtratoMatchIncompativel
+	}
+
+	ja, err := uc.repo.ExistsMatch(ctx, input.TenantID, input.MatchID)
+	if err != nil {
+		return nil, err
+	}
+	if ja {
+		return nil, domain.ErrExtratoLinhaJaConciliada
+	}
+
+	cand, err := uc.conciliador.candidatoPorID(ctx, input.TenantID, tipo, input.MatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	usuario := uuidPtrOrNil(input.UsuarioID)
+	linha.Conciliar(cand.tipo, cand.id, cand.valor, usuario)
+
+	if linha.Status == entity.StatusLinhaConciliada {
+		if err := uc.conciliador.baixar(ctx, input.TenantID, linha); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := uc.repo.UpdateLineConciliacao(ctx, linha); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RefreshImportTotais(ctx, linha.ImportID, input.TenantID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("erro ao atualizar totais da importação", zap.Error(err))
+	}
+
+	common.Logger(ctx, uc.logger).Info("lançamento de extrato conciliado manualmente",
+		zap.String("tenant_id", input.TenantID.String()),
+		zap.String("linha_id", linha.ID.String()),
+		zap.String("match_tipo", string(tipo)),
+		zap.String("status", string(linha.Status)))
+
+	return linha, nil
+}
+
+// RevisarLinhaInput define os dados para aceitar/ignorar um lançamento na revisão
+type RevisarLinhaInput struct {
+	TenantID   uuid.UUID
+	UsuarioID  uuid.UUID
+	LinhaID    uuid.UUID
+	Observacao *string
+}
+
+// AceitarDivergenciaUseCase confirma uma conciliação com diferença de valor e baixa o registro interno
+type AceitarDivergenciaUseCase struct {
+	repo        port.BankStatementRepository
+	conciliador *conciliadorExtrato
+	logger      *zap.Logger
+}
+
+// NewAceitarDivergenciaUseCase cria nova instância do use case
+func NewAceitarDivergenciaUseCase(
+	repo port.BankStatementRepository,
+	compensacaoRepo port.CompensacaoBancariaRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	contaPagarRepo port.ContaPagarRepository,
+	marcarCompensacaoUC *MarcarCompensacaoUseCase,
+	marcarRecebimentoUC *MarcarRecebimentoUseCase,
+	marcarPagamentoUC *MarcarPagamentoUseCase,
+	logger *zap.Logger,
+) *AceitarDivergenciaUseCase {
+	return &AceitarDivergenciaUseCase{
+		repo: repo,
+		conciliador: newConciliadorExtrato(repo, compensacaoRepo, contaReceberRepo, contaPagarRepo,
+			marcarCompensacaoUC, marcarRecebimentoUC, marcarPagamentoUC, logger),
+		logger: logger,
+	}
+}
+
+// Execute aceita a divergência
+func (uc *AceitarDivergenciaUseCase) Execute(ctx context.Context, input RevisarLinhaInput) (*entity.BankStatementLine, error) {
+	ctx, span := common.StartSpan(ctx, "financial.AceitarDivergencia")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	linha, err := uc.repo.FindLineByID(ctx, input.LinhaID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if linha.Status == entity.StatusLinhaConciliada {
+		return nil, domain.ErrExtratoLinhaJaConciliada
+	}
+	if err := linha.AceitarDivergencia(uuidPtrOrNil(input.UsuarioID), input.Observacao); err != nil {
+		return nil, domain.ErrExtratoLinhaNaoDivergente
+	}
+
+	if err := uc.conciliador.baixar(ctx, input.TenantID, linha); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateLineConciliacao(ctx, linha); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RefreshImportTotais(ctx, linha.ImportID, input.TenantID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("erro ao atualizar totais da importação", zap.Error(err))
+	}
+
+	return linha, nil
+}
+
+// IgnorarLinhaExtratoUseCase descarta um lançamento da fila de revisão (tarifas, transferências)
+type IgnorarLinhaExtratoUseCase struct {
+	repo   port.BankStatementRepository
+	logger *zap.Logger
+}
+
+// NewIgnorarLinhaExtratoUseCase cria nova instância do use case
+func NewIgnorarLinhaExtratoUseCase(repo port.BankStatementRepository, logger *zap.Logger) *IgnorarLinhaExtratoUseCase {
+	return &IgnorarLinhaExtratoUseCase{repo: repo, logger: logger}
+}
+
+// Execute ignora o lançamento
+func (uc *IgnorarLinhaExtratoUseCase) Execute(ctx context.Context, input RevisarLinhaInput) (*entity.BankStatementLine, error) {
+	ctx, span := common.StartSpan(ctx, "financial.IgnorarLinhaExtrato")
+	defer span.End()
+
+	if input.TenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+
+	linha, err := uc.repo.FindLineByID(ctx, input.LinhaID, input.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := linha.Ignorar(uuidPtrOrNil(input.UsuarioID), input.Observacao); err != nil {
+		return nil, domain.ErrExtratoLinhaJaConciliada
+	}
+
+	if err := uc.repo.UpdateLineConciliacao(ctx, linha); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.RefreshImportTotais(ctx, linha.ImportID, input.TenantID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("erro ao atualizar totais da importação", zap.Error(err))
+	}
+
+	return linha, nil
+}
+
+func uuidPtrOrNil(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
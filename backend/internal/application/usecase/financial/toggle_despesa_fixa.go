@@ -3,6 +3,7 @@ package financial
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -34,6 +35,9 @@ func NewToggleDespesaFixaUseCase(
 
 // Execute alterna o status ativo/inativo da despesa fixa
 func (uc *ToggleDespesaFixaUseCase) Execute(ctx context.Context, input ToggleDespesaFixaInput) (*entity.DespesaFixa, error) {
+	ctx, span := common.StartSpan(ctx, "financial.ToggleDespesaFixa")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -44,7 +48,7 @@ func (uc *ToggleDespesaFixaUseCase) Execute(ctx context.Context, input ToggleDes
 
 	despesa, err := uc.repo.Toggle(ctx, input.TenantID, input.ID)
 	if err != nil {
-		uc.logger.Error("Erro ao alternar status de despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao alternar status de despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -52,7 +56,7 @@ func (uc *ToggleDespesaFixaUseCase) Execute(ctx context.Context, input ToggleDes
 		return nil, err
 	}
 
-	uc.logger.Info("Status de despesa fixa alternado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Status de despesa fixa alternado com sucesso",
 		zap.String("id", despesa.ID),
 		zap.String("tenant_id", despesa.TenantID.String()),
 		zap.Bool("ativo", despesa.Ativo),
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -27,6 +28,9 @@ func NewUpdateContaPagarUseCase(repo port.ContaPagarRepository, logger *zap.Logg
 }
 
 func (uc *UpdateContaPagarUseCase) Execute(ctx context.Context, input UpdateContaPagarInput) (*entity.ContaPagar, error) {
+	ctx, span := common.StartSpan(ctx, "financial.UpdateContaPagar")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -45,7 +49,7 @@ func (uc *UpdateContaPagarUseCase) Execute(ctx context.Context, input UpdateCont
 		return nil, fmt.Errorf("erro ao atualizar conta: %w", err)
 	}
 
-	uc.logger.Info("Conta a pagar atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
+	common.Logger(ctx, uc.logger).Info("Conta a pagar atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
 
 	return input.Conta, nil
 }
@@ -5,6 +5,7 @@ import (
 
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -27,6 +28,9 @@ func NewUpdateContaReceberUseCase(repo port.ContaReceberRepository, logger *zap.
 }
 
 func (uc *UpdateContaReceberUseCase) Execute(ctx context.Context, input UpdateContaReceberInput) (*entity.ContaReceber, error) {
+	ctx, span := common.StartSpan(ctx, "financial.UpdateContaReceber")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -43,7 +47,7 @@ func (uc *UpdateContaReceberUseCase) Execute(ctx context.Context, input UpdateCo
 		return nil, fmt.Errorf("erro ao atualizar conta: %w", err)
 	}
 
-	uc.logger.Info("Conta a receber atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
+	common.Logger(ctx, uc.logger).Info("Conta a receber atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
 
 	return input.Conta, nil
 }
@@ -3,6 +3,7 @@ package financial
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -42,6 +43,9 @@ func NewUpdateDespesaFixaUseCase(
 
 // Execute atualiza uma despesa fixa existente
 func (uc *UpdateDespesaFixaUseCase) Execute(ctx context.Context, input UpdateDespesaFixaInput) (*entity.DespesaFixa, error) {
+	ctx, span := common.StartSpan(ctx, "financial.UpdateDespesaFixa")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -65,7 +69,7 @@ func (uc *UpdateDespesaFixaUseCase) Execute(ctx context.Context, input UpdateDes
 	// Buscar despesa existente
 	despesa, err := uc.repo.FindByID(ctx, input.TenantID, input.ID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar despesa fixa para atualização",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar despesa fixa para atualização",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -77,7 +81,7 @@ func (uc *UpdateDespesaFixaUseCase) Execute(ctx context.Context, input UpdateDes
 	if input.Descricao != despesa.Descricao {
 		exists, err := uc.repo.ExistsByDescricao(ctx, input.TenantID, input.Descricao, &input.ID)
 		if err != nil {
-			uc.logger.Error("Erro ao verificar duplicidade de despesa fixa",
+			common.Logger(ctx, uc.logger).Error("Erro ao verificar duplicidade de despesa fixa",
 				zap.Error(err),
 				zap.String("tenant_id", input.TenantID),
 			)
@@ -104,7 +108,7 @@ func (uc *UpdateDespesaFixaUseCase) Execute(ctx context.Context, input UpdateDes
 
 	// Persistir
 	if err := uc.repo.Update(ctx, despesa); err != nil {
-		uc.logger.Error("Erro ao atualizar despesa fixa",
+		common.Logger(ctx, uc.logger).Error("Erro ao atualizar despesa fixa",
 			zap.Error(err),
 			zap.String("tenant_id", input.TenantID),
 			zap.String("id", input.ID),
@@ -112,7 +116,7 @@ func (uc *UpdateDespesaFixaUseCase) Execute(ctx context.Context, input UpdateDes
 		return nil, err
 	}
 
-	uc.logger.Info("Despesa fixa atualizada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Despesa fixa atualizada com sucesso",
 		zap.String("id", despesa.ID),
 		zap.String("tenant_id", despesa.TenantID.String()),
 		zap.String("descricao", despesa.Descricao),
@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -24,6 +25,9 @@ func NewCreateMeioPagamentoUseCase(repo port.MeioPagamentoRepository) *CreateMei
 
 // Execute cria um novo meio de pagamento
 func (uc *CreateMeioPagamentoUseCase) Execute(ctx context.Context, tenantID string, req dto.CreateMeioPagamentoRequest) (*dto.MeioPagamentoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.CreateMeioPagamento")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, fmt.Errorf("tenant_id inválido: %w", err)
@@ -106,6 +110,9 @@ func NewListMeiosPagamentoUseCase(repo port.MeioPagamentoRepository) *ListMeiosP
 
 // Execute lista meios de pagamento com filtros
 func (uc *ListMeiosPagamentoUseCase) Execute(ctx context.Context, tenantID string, filter dto.MeioPagamentoFilter) (*dto.ListMeiosPagamentoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.ListMeiosPagamento")
+	defer span.End()
+
 	var meios []*entity.MeioPagamento
 	var err error
 
@@ -152,6 +159,9 @@ func NewGetMeioPagamentoUseCase(repo port.MeioPagamentoRepository) *GetMeioPagam
 
 // Execute busca um meio de pagamento por ID
 func (uc *GetMeioPagamentoUseCase) Execute(ctx context.Context, tenantID, id string) (*dto.MeioPagamentoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.GetMeioPagamento")
+	defer span.End()
+
 	meio, err := uc.repo.FindByID(ctx, tenantID, id)
 	if err != nil {
 		return nil, err
@@ -173,6 +183,9 @@ func NewUpdateMeioPagamentoUseCase(repo port.MeioPagamentoRepository) *UpdateMei
 
 // Execute atualiza um meio de pagamento
 func (uc *UpdateMeioPagamentoUseCase) Execute(ctx context.Context, tenantID, id string, req dto.UpdateMeioPagamentoRequest) (*dto.MeioPagamentoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.UpdateMeioPagamento")
+	defer span.End()
+
 	meio, err := uc.repo.FindByID(ctx, tenantID, id)
 	if err != nil {
 		return nil, err
@@ -251,6 +264,9 @@ func NewToggleMeioPagamentoUseCase(repo port.MeioPagamentoRepository) *ToggleMei
 
 // Execute alterna o status ativo/inativo
 func (uc *ToggleMeioPagamentoUseCase) Execute(ctx context.Context, tenantID, id string) (*dto.MeioPagamentoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.ToggleMeioPagamento")
+	defer span.End()
+
 	meio, err := uc.repo.Toggle(ctx, tenantID, id)
 	if err != nil {
 		return nil, err
@@ -272,6 +288,9 @@ func NewDeleteMeioPagamentoUseCase(repo port.MeioPagamentoRepository) *DeleteMei
 
 // Execute exclui um meio de pagamento
 func (uc *DeleteMeioPagamentoUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "meiopagamento.DeleteMeioPagamento")
+	defer span.End()
+
 	return uc.repo.Delete(ctx, tenantID, id)
 }
 
@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
 "go.uber.org/zap"
@@ -19,6 +20,9 @@ func NewDeleteMetaBarbeiroUseCase(repo port.MetaBarbeiroRepository, logger *zap.
 }
 
 func (uc *DeleteMetaBarbeiroUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "metas.DeleteMetaBarbeiro")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -34,7 +38,7 @@ func (uc *DeleteMetaBarbeiroUseCase) Execute(ctx context.Context, tenantID, id s
 		return fmt.Errorf("erro ao deletar meta: %w", err)
 	}
 
-	uc.logger.Info("Meta barbeiro deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
+	common.Logger(ctx, uc.logger).Info("Meta barbeiro deletada", zap.String("tenant_id", tenantID), zap.String("id", id))
 
 	return nil
 }
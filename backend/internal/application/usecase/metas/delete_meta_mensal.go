@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
 "go.uber.org/zap"
@@ -28,6 +29,9 @@ logger *zap.Logger,
 
 // Execute remove uma meta mensal
 func (uc *DeleteMetaMensalUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "metas.DeleteMetaMensal")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -46,7 +50,7 @@ func (uc *DeleteMetaMensalUseCase) Execute(ctx context.Context, tenantID, id str
 		return fmt.Errorf("erro ao deletar meta: %w", err)
 	}
 
-	uc.logger.Info("Meta mensal deletada",
+	common.Logger(ctx, uc.logger).Info("Meta mensal deletada",
 zap.String("tenant_id", tenantID),
 zap.String("id", id),
 )
@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -20,6 +21,9 @@ func NewGetMetaBarbeiroUseCase(repo port.MetaBarbeiroRepository, logger *zap.Log
 }
 
 func (uc *GetMetaBarbeiroUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.MetaBarbeiro, error) {
+	ctx, span := common.StartSpan(ctx, "metas.GetMetaBarbeiro")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -29,6 +30,9 @@ logger *zap.Logger,
 
 // Execute busca uma meta mensal por ID
 func (uc *GetMetaMensalUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.MetaMensal, error) {
+	ctx, span := common.StartSpan(ctx, "metas.GetMetaMensal")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -20,6 +21,9 @@ func NewGetMetaTicketMedioUseCase(repo port.MetaTicketMedioRepository, logger *z
 }
 
 func (uc *GetMetaTicketMedioUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.MetaTicketMedio, error) {
+	ctx, span := common.StartSpan(ctx, "metas.GetMetaTicketMedio")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
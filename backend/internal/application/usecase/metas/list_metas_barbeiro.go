@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -28,6 +29,9 @@ func NewListMetasBarbeiroUseCase(repo port.MetaBarbeiroRepository, logger *zap.L
 }
 
 func (uc *ListMetasBarbeiroUseCase) Execute(ctx context.Context, input ListMetasBarbeiroInput) ([]*entity.MetaBarbeiro, error) {
+	ctx, span := common.StartSpan(ctx, "metas.ListMetasBarbeiro")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
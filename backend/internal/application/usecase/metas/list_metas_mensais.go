@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -37,6 +38,9 @@ func NewListMetasMensaisUseCase(
 
 // Execute lista metas mensais com filtros
 func (uc *ListMetasMensaisUseCase) Execute(ctx context.Context, input ListMetasMensaisInput) ([]*entity.MetaMensal, error) {
+	ctx, span := common.StartSpan(ctx, "metas.ListMetasMensais")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -27,6 +28,9 @@ func NewListMetasTicketMedioUseCase(repo port.MetaTicketMedioRepository, logger
 }
 
 func (uc *ListMetasTicketMedioUseCase) Execute(ctx context.Context, input ListMetasTicketMedioInput) ([]*entity.MetaTicketMedio, error) {
+	ctx, span := common.StartSpan(ctx, "metas.ListMetasTicketMedio")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -42,6 +43,9 @@ func NewSetMetaBarbeiroUseCase(
 
 // Execute cria ou atualiza uma meta de barbeiro
 func (uc *SetMetaBarbeiroUseCase) Execute(ctx context.Context, input SetMetaBarbeiroInput) (*entity.MetaBarbeiro, error) {
+	ctx, span := common.StartSpan(ctx, "metas.SetMetaBarbeiro")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -87,7 +91,7 @@ func (uc *SetMetaBarbeiroUseCase) Execute(ctx context.Context, input SetMetaBarb
 		return nil, fmt.Errorf("erro ao salvar meta de barbeiro: %w", err)
 	}
 
-	uc.logger.Info("Meta de barbeiro definida",
+	common.Logger(ctx, uc.logger).Info("Meta de barbeiro definida",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("barbeiro_id", input.BarbeiroID),
 		zap.String("mes_ano", input.MesAno.String()),
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -39,6 +40,9 @@ func NewSetMetaMensalUseCase(
 
 // Execute cria ou atualiza uma meta mensal
 func (uc *SetMetaMensalUseCase) Execute(ctx context.Context, input SetMetaMensalInput) (*entity.MetaMensal, error) {
+	ctx, span := common.StartSpan(ctx, "metas.SetMetaMensal")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -72,7 +76,7 @@ func (uc *SetMetaMensalUseCase) Execute(ctx context.Context, input SetMetaMensal
 		return nil, fmt.Errorf("erro ao salvar meta mensal: %w", err)
 	}
 
-	uc.logger.Info("Meta mensal definida",
+	common.Logger(ctx, uc.logger).Info("Meta mensal definida",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("mes_ano", input.MesAno.String()),
 		zap.String("meta", input.MetaFaturamento.String()),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -41,6 +42,9 @@ func NewSetMetaTicketUseCase(
 
 // Execute cria ou atualiza uma meta de ticket médio
 func (uc *SetMetaTicketUseCase) Execute(ctx context.Context, input SetMetaTicketInput) (*entity.MetaTicketMedio, error) {
+	ctx, span := common.StartSpan(ctx, "metas.SetMetaTicket")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -92,7 +96,7 @@ func (uc *SetMetaTicketUseCase) Execute(ctx context.Context, input SetMetaTicket
 		return nil, fmt.Errorf("erro ao salvar meta de ticket médio: %w", err)
 	}
 
-	uc.logger.Info("Meta de ticket médio definida",
+	common.Logger(ctx, uc.logger).Info("Meta de ticket médio definida",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("tipo", string(input.Tipo)),
 		zap.String("mes_ano", input.MesAno.String()),
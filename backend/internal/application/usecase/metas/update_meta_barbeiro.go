@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -29,6 +30,9 @@ func NewUpdateMetaBarbeiroUseCase(repo port.MetaBarbeiroRepository, logger *zap.
 }
 
 func (uc *UpdateMetaBarbeiroUseCase) Execute(ctx context.Context, input UpdateMetaBarbeiroInput) (*entity.MetaBarbeiro, error) {
+	ctx, span := common.StartSpan(ctx, "metas.UpdateMetaBarbeiro")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -51,7 +55,7 @@ func (uc *UpdateMetaBarbeiroUseCase) Execute(ctx context.Context, input UpdateMe
 		return nil, fmt.Errorf("erro ao salvar meta: %w", err)
 	}
 
-	uc.logger.Info("Meta barbeiro atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
+	common.Logger(ctx, uc.logger).Info("Meta barbeiro atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
 
 	return meta, nil
 }
@@ -4,6 +4,7 @@ import (
 "context"
 "fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 "github.com/andviana23/barber-analytics-backend/internal/domain"
 "github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 "github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -37,6 +38,9 @@ logger *zap.Logger,
 
 // Execute atualiza uma meta mensal existente
 func (uc *UpdateMetaMensalUseCase) Execute(ctx context.Context, input UpdateMetaMensalInput) (*entity.MetaMensal, error) {
+	ctx, span := common.StartSpan(ctx, "metas.UpdateMetaMensal")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -60,7 +64,7 @@ func (uc *UpdateMetaMensalUseCase) Execute(ctx context.Context, input UpdateMeta
 		return nil, fmt.Errorf("erro ao salvar meta: %w", err)
 	}
 
-	uc.logger.Info("Meta mensal atualizada",
+	common.Logger(ctx, uc.logger).Info("Meta mensal atualizada",
 zap.String("tenant_id", input.TenantID),
 zap.String("id", input.ID),
 zap.String("nova_meta", input.MetaFaturamento.String()),
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -28,6 +29,9 @@ func NewUpdateMetaTicketMedioUseCase(repo port.MetaTicketMedioRepository, logger
 }
 
 func (uc *UpdateMetaTicketMedioUseCase) Execute(ctx context.Context, input UpdateMetaTicketMedioInput) (*entity.MetaTicketMedio, error) {
+	ctx, span := common.StartSpan(ctx, "metas.UpdateMetaTicketMedio")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -48,7 +52,7 @@ func (uc *UpdateMetaTicketMedioUseCase) Execute(ctx context.Context, input Updat
 		return nil, fmt.Errorf("erro ao salvar meta: %w", err)
 	}
 
-	uc.logger.Info("Meta ticket médio atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
+	common.Logger(ctx, uc.logger).Info("Meta ticket médio atualizada", zap.String("tenant_id", input.TenantID), zap.String("id", input.ID))
 
 	return meta, nil
 }
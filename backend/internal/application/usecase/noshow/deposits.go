@@ -0,0 +1,211 @@
+package noshow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// depositReferencePrefix identifica os sinais no painel do Asaas
+const depositReferencePrefix = "sinal:"
+
+// fusoBrasilia datas exibidas ao cliente e vencimento no Asaas
+var fusoBrasilia = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// -----------------------------------------------------------------------------
+// Exigir sinal
+// -----------------------------------------------------------------------------
+
+// RequireDepositUseCase aplica a política ao agendamento: se o cliente atingiu
+// o limite de faltas, registra o sinal e gera a cobrança PIX no Asaas.
+// Implementa appointment.BookingListener.
+type RequireDepositUseCase struct {
+	repo            port.NoShowRepository
+	appointmentRepo port.AppointmentRepository
+	customerRepo    port.CustomerRepository
+	gateway         port.AsaasGateway
+	logger          *zap.Logger
+}
+
+// NewRequireDepositUseCase cria nova instância do use case
+func NewRequireDepositUseCase(
+	repo port.NoShowRepository,
+	appointmentRepo port.AppointmentRepository,
+	customerRepo port.CustomerRepository,
+	gateway port.AsaasGateway,
+	logger *zap.Logger,
+) *RequireDepositUseCase {
+	return &RequireDepositUseCase{
+		repo:            repo,
+		appointmentRepo: appointmentRepo,
+		customerRepo:    customerRepo,
+		gateway:         gateway,
+		logger:          logger,
+	}
+}
+
+// AppointmentBooked exige o sinal de um agendamento recém-criado. Falhas são
+// registradas e podem ser refeitas pelo Execute.
+func (uc *RequireDepositUseCase) AppointmentBooked(ctx context.Context, a *entity.Appointment) {
+	deposit, err := uc.require(ctx, a)
+	if errors.Is(err, domain.ErrAppointmentDepositNotNeeded) {
+		return
+	}
+	if err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao exigir sinal do agendamento",
+			zap.String("tenant_id", a.TenantID.String()),
+			zap.String("appointment_id", a.ID),
+			zap.Error(err),
+		)
+		return
+	}
+	common.Logger(ctx, uc.logger).Info("Sinal exigido pela política de não comparecimento",
+		zap.String("tenant_id", a.TenantID.String()),
+		zap.String("appointment_id", a.ID),
+		zap.String("customer_id", a.CustomerID),
+		zap.String("amount", deposit.Amount.String()),
+		zap.Bool("charged", deposit.HasCharge()),
+	)
+}
+
+// Execute retorna o sinal do agendamento, gerando a cobrança se ainda não
+// existir. ErrAppointmentDepositNotNeeded se a política não exige sinal.
+func (uc *RequireDepositUseCase) Execute(ctx context.Context, tenantID, unitID, appointmentID string) (*entity.AppointmentDeposit, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.RequireDeposit")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if appointmentID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	a, err := uc.appointmentRepo.FindByID(ctx, tenantID, unitID, appointmentID)
+	if err != nil {
+		return nil, err
+	}
+	if !a.IsActive() {
+		return nil, domain.ErrAppointmentInvalidStatusTransition
+	}
+	return uc.require(ctx, a)
+}
+
+func (uc *RequireDepositUseCase) require(ctx context.Context, a *entity.Appointment) (*entity.AppointmentDeposit, error) {
+	tenantID := a.TenantID.String()
+
+	deposit, err := uc.repo.FindDepositByAppointment(ctx, tenantID, a.ID)
+	switch {
+	case err == nil:
+		if deposit.Status == entity.DepositStatusPending && !deposit.HasCharge() {
+			return deposit, uc.charge(ctx, deposit, a)
+		}
+		return deposit, nil
+	case !errors.Is(err, domain.ErrAppointmentDepositNotFound):
+		return nil, err
+	}
+
+	policy, err := loadPolicy(ctx, uc.repo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if !policy.Enabled {
+		return nil, domain.ErrAppointmentDepositNotNeeded
+	}
+	now := time.Now()
+	stats, err := uc.repo.AttendanceStats(ctx, tenantID, a.CustomerID, policy.WindowStart(now))
+	if err != nil {
+		return nil, err
+	}
+	amount := policy.DepositAmount(a.TotalPrice.Value())
+	if !policy.RequiresDeposit(stats) || !amount.IsPositive() {
+		return nil, domain.ErrAppointmentDepositNotNeeded
+	}
+
+	deposit, err = entity.NewAppointmentDeposit(a, amount, policy.PaymentDueAt(now, a.StartTime))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.CreateDeposit(ctx, deposit); err != nil {
+		return nil, err
+	}
+	return deposit, uc.charge(ctx, deposit, a)
+}
+
+// charge gera a cobrança PIX do sinal no Asaas
+func (uc *RequireDepositUseCase) charge(ctx context.Context, deposit *entity.AppointmentDeposit, a *entity.Appointment) error {
+	cliente, err := uc.customerRepo.FindByID(ctx, deposit.TenantID.String(), deposit.CustomerID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar cliente do sinal: %w", err)
+	}
+	if cliente == nil {
+		return domain.ErrAppointmentCustomerNotFound
+	}
+
+	params := port.FindOrCreateCustomerParams{
+		Name:              cliente.Nome,
+		MobilePhone:       cliente.Telefone,
+		ExternalReference: cliente.ID,
+	}
+	if cliente.Email != nil {
+		params.Email = *cliente.Email
+	}
+	if cliente.CPF != nil {
+		params.CpfCnpj = *cliente.CPF
+	}
+	customer, err := uc.gateway.FindOrCreateCustomer(ctx, params)
+	if err != nil {
+		return fmt.Errorf("erro ao preparar cliente no Asaas: %w", err)
+	}
+
+	charge, err := uc.gateway.CreatePixCharge(ctx, port.CreateAsaasPixChargeParams{
+		CustomerID:        customer.AsaasCustomerID,
+		Value:             deposit.Amount.InexactFloat64(),
+		Description:       fmt.Sprintf("Sinal do agendamento de %s", a.StartTime.In(fusoBrasilia).Format("02/01/2006 15:04")),
+		ExternalReference: depositReferencePrefix + deposit.ID,
+		DueDate:           deposit.DueAt.In(fusoBrasilia).Format("2006-01-02"),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao gerar cobrança PIX do sinal: %w", err)
+	}
+
+	deposit.AsaasPaymentID = charge.AsaasPaymentID
+	deposit.InvoiceURL = charge.InvoiceURL
+	deposit.PixPayload = charge.PixPayload
+	return uc.repo.SetDepositCharge(ctx, deposit)
+}
+
+// -----------------------------------------------------------------------------
+// Consultar sinal
+// -----------------------------------------------------------------------------
+
+// GetDepositUseCase retorna o sinal de um agendamento
+type GetDepositUseCase struct {
+	repo   port.NoShowRepository
+	logger *zap.Logger
+}
+
+// NewGetDepositUseCase cria nova instância do use case
+func NewGetDepositUseCase(repo port.NoShowRepository, logger *zap.Logger) *GetDepositUseCase {
+	return &GetDepositUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute busca o sinal do agendamento
+func (uc *GetDepositUseCase) Execute(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentDeposit, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.GetDeposit")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.FindDepositByAppointment(ctx, tenantID, appointmentID)
+}
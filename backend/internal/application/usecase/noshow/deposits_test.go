@@ -0,0 +1,276 @@
+package noshow
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// fakeNoShowRepo implementa port.NoShowRepository em memória
+type fakeNoShowRepo struct {
+	policy   *entity.NoShowPolicy
+	stats    entity.AttendanceStats
+	deposits map[string]*entity.AppointmentDeposit // por appointment_id
+}
+
+func (r *fakeNoShowRepo) GetPolicy(ctx context.Context, tenantID string) (*entity.NoShowPolicy, error) {
+	if r.policy == nil {
+		return nil, domain.ErrNoShowPolicyNotFound
+	}
+	return r.policy, nil
+}
+
+func (r *fakeNoShowRepo) SavePolicy(ctx context.Context, policy *entity.NoShowPolicy) error {
+	r.policy = policy
+	return nil
+}
+
+func (r *fakeNoShowRepo) AttendanceStats(ctx context.Context, tenantID, customerID string, since time.Time) (entity.AttendanceStats, error) {
+	return r.stats, nil
+}
+
+func (r *fakeNoShowRepo) CreateDeposit(ctx context.Context, d *entity.AppointmentDeposit) error {
+	r.deposits[d.AppointmentID] = d
+	return nil
+}
+
+func (r *fakeNoShowRepo) FindDepositByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentDeposit, error) {
+	if d, ok := r.deposits[appointmentID]; ok {
+		return d, nil
+	}
+	return nil, domain.ErrAppointmentDepositNotFound
+}
+
+func (r *fakeNoShowRepo) FindDepositByAsaasPayment(ctx context.Context, asaasPaymentID string) (*entity.AppointmentDeposit, error) {
+	for _, d := range r.deposits {
+		if d.AsaasPaymentID == asaasPaymentID {
+			return d, nil
+		}
+	}
+	return nil, domain.ErrAppointmentDepositNotFound
+}
+
+func (r *fakeNoShowRepo) SetDepositCharge(ctx context.Context, d *entity.AppointmentDeposit) error {
+	return nil
+}
+
+func (r *fakeNoShowRepo) TransitionDeposit(ctx context.Context, d *entity.AppointmentDeposit, fromStatus string) (bool, error) {
+	return true, nil
+}
+
+func (r *fakeNoShowRepo) ListOverdueDeposits(ctx context.Context, limit int) ([]*entity.AppointmentDeposit, error) {
+	var overdue []*entity.AppointmentDeposit
+	for _, d := range r.deposits {
+		if d.Status == entity.DepositStatusPending {
+			overdue = append(overdue, d)
+		}
+	}
+	return overdue, nil
+}
+
+// fakeCustomerRepo só responde FindByID
+type fakeCustomerRepo struct {
+	port.CustomerRepository
+}
+
+func (r *fakeCustomerRepo) FindByID(ctx context.Context, tenantID, id string) (*entity.Customer, error) {
+	return &entity.Customer{ID: id, Nome: "Cliente", Telefone: "11999999999"}, nil
+}
+
+// fakeGateway gera, consulta e cancela cobranças PIX
+type fakeGateway struct {
+	port.AsaasGateway
+	charges  []port.CreateAsaasPixChargeParams
+	charge   port.AsaasChargeResult // retornada por GetCharge
+	getErr   error
+	canceled []string
+}
+
+func (g *fakeGateway) FindOrCreateCustomer(ctx context.Context, params port.FindOrCreateCustomerParams) (*port.AsaasCustomerResult, error) {
+	return &port.AsaasCustomerResult{AsaasCustomerID: "cus_1"}, nil
+}
+
+func (g *fakeGateway) CreatePixCharge(ctx context.Context, params port.CreateAsaasPixChargeParams) (*port.AsaasPixChargeResult, error) {
+	g.charges = append(g.charges, params)
+	return &port.AsaasPixChargeResult{AsaasPaymentID: "pay_1", PixPayload: "000201"}, nil
+}
+
+func (g *fakeGateway) GetCharge(ctx context.Context, paymentID string) (*port.AsaasChargeResult, error) {
+	if g.getErr != nil {
+		return nil, g.getErr
+	}
+	charge := g.charge
+	charge.AsaasPaymentID = paymentID
+	return &charge, nil
+}
+
+func (g *fakeGateway) CancelCharge(ctx context.Context, paymentID string) error {
+	g.canceled = append(g.canceled, paymentID)
+	return nil
+}
+
+func novoAgendamento(start time.Time) *entity.Appointment {
+	return &entity.Appointment{
+		ID:         uuid.NewString(),
+		TenantID:   uuid.New(),
+		UnitID:     uuid.New(),
+		CustomerID: uuid.NewString(),
+		StartTime:  start,
+		TotalPrice: valueobject.NewMoneyFromDecimal(decimal.NewFromInt(80)),
+	}
+}
+
+func TestRequireDeposit_PoliticaDesativada(t *testing.T) {
+	repo := &fakeNoShowRepo{stats: entity.AttendanceStats{NoShows: 5}, deposits: map[string]*entity.AppointmentDeposit{}}
+	gateway := &fakeGateway{}
+	uc := NewRequireDepositUseCase(repo, nil, &fakeCustomerRepo{}, gateway, zap.NewNop())
+
+	_, err := uc.require(context.Background(), novoAgendamento(time.Now().Add(24*time.Hour)))
+	if !errors.Is(err, domain.ErrAppointmentDepositNotNeeded) {
+		t.Fatalf("esperado ErrAppointmentDepositNotNeeded, obtido %v", err)
+	}
+	if len(gateway.charges) != 0 {
+		t.Fatalf("nenhuma cobrança deveria ser gerada")
+	}
+}
+
+func TestRequireDeposit_ClienteFaltoso(t *testing.T) {
+	a := novoAgendamento(time.Now().Add(time.Hour))
+	policy := entity.DefaultNoShowPolicy(a.TenantID)
+	policy.Enabled = true
+	repo := &fakeNoShowRepo{policy: policy, stats: entity.AttendanceStats{Attended: 3, NoShows: 2}, deposits: map[string]*entity.AppointmentDeposit{}}
+	gateway := &fakeGateway{}
+	uc := NewRequireDepositUseCase(repo, nil, &fakeCustomerRepo{}, gateway, zap.NewNop())
+
+	deposit, err := uc.require(context.Background(), a)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if !deposit.Amount.Equal(decimal.NewFromInt(24)) {
+		t.Errorf("sinal esperado 24.00 (30%% de 80), obtido %s", deposit.Amount)
+	}
+	// Prazo de 2h limitado ao início do atendimento
+	if !deposit.DueAt.Equal(a.StartTime) {
+		t.Errorf("prazo esperado %v, obtido %v", a.StartTime, deposit.DueAt)
+	}
+	if deposit.AsaasPaymentID != "pay_1" || len(gateway.charges) != 1 {
+		t.Fatalf("cobrança PIX não gerada: %+v", gateway.charges)
+	}
+	if gateway.charges[0].ExternalReference != depositReferencePrefix+deposit.ID {
+		t.Errorf("referência externa inesperada: %s", gateway.charges[0].ExternalReference)
+	}
+
+	// Refazer não gera segunda cobrança
+	if _, err := uc.require(context.Background(), a); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(gateway.charges) != 1 {
+		t.Errorf("esperada 1 cobrança, obtidas %d", len(gateway.charges))
+	}
+}
+
+func TestConfirmDepositPayment(t *testing.T) {
+	a := novoAgendamento(time.Now().Add(24 * time.Hour))
+	deposit, _ := entity.NewAppointmentDeposit(a, decimal.NewFromInt(20), time.Now().Add(time.Hour))
+	deposit.AsaasPaymentID = "pay_1"
+	repo := &fakeNoShowRepo{deposits: map[string]*entity.AppointmentDeposit{a.ID: deposit}}
+	uc := NewConfirmDepositPaymentUseCase(repo, zap.NewNop())
+
+	ok, err := uc.Execute(context.Background(), "pay_outro", time.Now())
+	if err != nil || ok {
+		t.Fatalf("cobrança que não é sinal deveria ser ignorada: ok=%v err=%v", ok, err)
+	}
+
+	ok, err = uc.Execute(context.Background(), "pay_1", time.Now())
+	if err != nil || !ok {
+		t.Fatalf("sinal deveria ser confirmado: ok=%v err=%v", ok, err)
+	}
+	if deposit.Status != entity.DepositStatusPaid || deposit.PaidAt == nil {
+		t.Errorf("sinal deveria estar pago, status %s", deposit.Status)
+	}
+}
+
+func novoSinalPendente(t *testing.T) (*entity.Appointment, *entity.AppointmentDeposit) {
+	t.Helper()
+	a := novoAgendamento(time.Now().Add(time.Hour))
+	deposit, err := entity.NewAppointmentDeposit(a, decimal.NewFromInt(20), time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	deposit.AsaasPaymentID = "pay_1"
+	return a, deposit
+}
+
+func TestExpireDeposits_CobrancaPagaConfirmaSinal(t *testing.T) {
+	a, deposit := novoSinalPendente(t)
+	repo := &fakeNoShowRepo{deposits: map[string]*entity.AppointmentDeposit{a.ID: deposit}}
+	paidAt := time.Now().Add(-30 * time.Minute)
+	gateway := &fakeGateway{charge: port.AsaasChargeResult{Status: "RECEIVED", Paid: true, PaidAt: &paidAt}}
+	uc := NewExpireDepositsUseCase(repo, gateway, NewConfirmDepositPaymentUseCase(repo, zap.NewNop()), nil, zap.NewNop())
+
+	expired, err := uc.Execute(context.Background())
+	if err != nil || expired != 0 {
+		t.Fatalf("sinal pago não deveria expirar: expired=%d err=%v", expired, err)
+	}
+	if deposit.Status != entity.DepositStatusPaid || deposit.PaidAt == nil || !deposit.PaidAt.Equal(paidAt) {
+		t.Errorf("sinal deveria ser confirmado com a data do Asaas, status %s", deposit.Status)
+	}
+	if len(gateway.canceled) != 0 {
+		t.Errorf("cobrança paga não deveria ser cancelada: %v", gateway.canceled)
+	}
+}
+
+func TestExpireDeposits_AsaasIndisponivelNaoExpira(t *testing.T) {
+	a, deposit := novoSinalPendente(t)
+	repo := &fakeNoShowRepo{deposits: map[string]*entity.AppointmentDeposit{a.ID: deposit}}
+	gateway := &fakeGateway{getErr: errors.New("timeout")}
+	uc := NewExpireDepositsUseCase(repo, gateway, NewConfirmDepositPaymentUseCase(repo, zap.NewNop()), nil, zap.NewNop())
+
+	expired, err := uc.Execute(context.Background())
+	if err != nil || expired != 0 {
+		t.Fatalf("sinal não deveria expirar sem consultar o Asaas: expired=%d err=%v", expired, err)
+	}
+	if deposit.Status != entity.DepositStatusPending {
+		t.Errorf("sinal deveria continuar pendente, status %s", deposit.Status)
+	}
+}
+
+func TestExpireDeposits_CobrancaPendenteCancelada(t *testing.T) {
+	_, deposit := novoSinalPendente(t)
+	gateway := &fakeGateway{charge: port.AsaasChargeResult{Status: "PENDING"}}
+	uc := NewExpireDepositsUseCase(&fakeNoShowRepo{}, gateway, nil, nil, zap.NewNop())
+
+	if !uc.chargeCanceled(context.Background(), deposit) {
+		t.Fatal("cobrança pendente deveria ser cancelada antes de expirar")
+	}
+	if len(gateway.canceled) != 1 || gateway.canceled[0] != "pay_1" {
+		t.Errorf("cobrança não cancelada no Asaas: %v", gateway.canceled)
+	}
+}
+
+func TestSettleDeposit_CancelamentoCancelaCobranca(t *testing.T) {
+	a, deposit := novoSinalPendente(t)
+	a.Status = valueobject.AppointmentStatusCanceled
+	repo := &fakeNoShowRepo{deposits: map[string]*entity.AppointmentDeposit{a.ID: deposit}}
+	gateway := &fakeGateway{}
+	uc := NewSettleDepositUseCase(repo, nil, nil, nil, gateway, zap.NewNop())
+
+	if err := uc.Execute(context.Background(), a); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if deposit.Status != entity.DepositStatusCanceled {
+		t.Errorf("sinal deveria estar cancelado, status %s", deposit.Status)
+	}
+	if len(gateway.canceled) != 1 || gateway.canceled[0] != "pay_1" {
+		t.Errorf("cobrança não cancelada no Asaas: %v", gateway.canceled)
+	}
+}
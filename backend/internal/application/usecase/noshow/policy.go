@@ -0,0 +1,146 @@
+// Package noshow contém os use cases da política de não comparecimento:
+// clientes que faltam com frequência pagam um sinal via PIX ao agendar,
+// creditado na comanda quando comparecem ou retido como receita quando
+// faltam, e cada cliente tem um índice de confiabilidade.
+package noshow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// loadPolicy busca a política do tenant; sem configuração, vale a padrão
+// (desativada)
+func loadPolicy(ctx context.Context, repo port.NoShowRepository, tenantID string) (*entity.NoShowPolicy, error) {
+	policy, err := repo.GetPolicy(ctx, tenantID)
+	if errors.Is(err, domain.ErrNoShowPolicyNotFound) {
+		tenantUUID, err := uuid.Parse(tenantID)
+		if err != nil {
+			return nil, domain.ErrTenantIDRequired
+		}
+		return entity.DefaultNoShowPolicy(tenantUUID), nil
+	}
+	return policy, err
+}
+
+// -----------------------------------------------------------------------------
+// Consultar política
+// -----------------------------------------------------------------------------
+
+// GetPolicyUseCase retorna a política de não comparecimento do tenant
+type GetPolicyUseCase struct {
+	repo   port.NoShowRepository
+	logger *zap.Logger
+}
+
+// NewGetPolicyUseCase cria nova instância do use case
+func NewGetPolicyUseCase(repo port.NoShowRepository, logger *zap.Logger) *GetPolicyUseCase {
+	return &GetPolicyUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute retorna a política (a padrão, desativada, se não configurada)
+func (uc *GetPolicyUseCase) Execute(ctx context.Context, tenantID string) (*entity.NoShowPolicy, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.GetPolicy")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return loadPolicy(ctx, uc.repo, tenantID)
+}
+
+// -----------------------------------------------------------------------------
+// Atualizar política
+// -----------------------------------------------------------------------------
+
+// UpdatePolicyInput dados da política
+type UpdatePolicyInput struct {
+	TenantID             string
+	Enabled              bool
+	MaxNoShows           int
+	WindowDays           int
+	DepositType          string
+	DepositValue         decimal.Decimal
+	PaymentDeadlineHours int
+	MeioPagamentoID      string
+}
+
+// UpdatePolicyUseCase cria ou atualiza a política do tenant
+type UpdatePolicyUseCase struct {
+	repo              port.NoShowRepository
+	meioPagamentoRepo port.MeioPagamentoRepository
+	logger            *zap.Logger
+}
+
+// NewUpdatePolicyUseCase cria nova instância do use case
+func NewUpdatePolicyUseCase(repo port.NoShowRepository, meioPagamentoRepo port.MeioPagamentoRepository, logger *zap.Logger) *UpdatePolicyUseCase {
+	return &UpdatePolicyUseCase{
+		repo:              repo,
+		meioPagamentoRepo: meioPagamentoRepo,
+		logger:            logger,
+	}
+}
+
+// Execute valida e grava a política
+func (uc *UpdatePolicyUseCase) Execute(ctx context.Context, input UpdatePolicyInput) (*entity.NoShowPolicy, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.UpdatePolicy")
+	defer span.End()
+
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	policy := &entity.NoShowPolicy{
+		TenantID:             tenantUUID,
+		Enabled:              input.Enabled,
+		MaxNoShows:           input.MaxNoShows,
+		WindowDays:           input.WindowDays,
+		DepositType:          input.DepositType,
+		DepositValue:         input.DepositValue,
+		PaymentDeadlineHours: input.PaymentDeadlineHours,
+		MeioPagamentoID:      input.MeioPagamentoID,
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	if policy.MeioPagamentoID != "" {
+		// O repositório não distingue "não encontrado" de falha de consulta
+		meio, err := uc.meioPagamentoRepo.FindByID(ctx, input.TenantID, policy.MeioPagamentoID)
+		if err != nil || meio == nil || !meio.Ativo {
+			common.Logger(ctx, uc.logger).Warn("Meio de pagamento inválido para a política de não comparecimento",
+				zap.String("tenant_id", input.TenantID),
+				zap.String("meio_pagamento_id", policy.MeioPagamentoID),
+				zap.Error(err),
+			)
+			return nil, fmt.Errorf("%w: meio de pagamento não encontrado ou inativo", domain.ErrNoShowPolicyInvalid)
+		}
+	}
+
+	if err := uc.repo.SavePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Política de não comparecimento atualizada",
+		zap.String("tenant_id", input.TenantID),
+		zap.Bool("enabled", policy.Enabled),
+		zap.Int("max_no_shows", policy.MaxNoShows),
+		zap.Int("window_days", policy.WindowDays),
+		zap.String("deposit_type", policy.DepositType),
+		zap.String("deposit_value", policy.DepositValue.String()),
+	)
+
+	return policy, nil
+}
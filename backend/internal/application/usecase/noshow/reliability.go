@@ -0,0 +1,66 @@
+package noshow
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// ReliabilityOutput confiabilidade do cliente exibida ao agendar
+type ReliabilityOutput struct {
+	CustomerID      string
+	Stats           entity.AttendanceStats
+	Score           int // 0 a 100
+	WindowDays      int
+	DepositRequired bool
+}
+
+// GetReliabilityUseCase calcula o índice de confiabilidade do cliente e se a
+// política exige sinal para um novo agendamento
+type GetReliabilityUseCase struct {
+	repo   port.NoShowRepository
+	logger *zap.Logger
+}
+
+// NewGetReliabilityUseCase cria nova instância do use case
+func NewGetReliabilityUseCase(repo port.NoShowRepository, logger *zap.Logger) *GetReliabilityUseCase {
+	return &GetReliabilityUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute calcula a confiabilidade na janela da política do tenant
+func (uc *GetReliabilityUseCase) Execute(ctx context.Context, tenantID, customerID string) (*ReliabilityOutput, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.GetReliability")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if customerID == "" {
+		return nil, domain.ErrAppointmentCustomerRequired
+	}
+
+	policy, err := loadPolicy(ctx, uc.repo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := uc.repo.AttendanceStats(ctx, tenantID, customerID, policy.WindowStart(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReliabilityOutput{
+		CustomerID:      customerID,
+		Stats:           stats,
+		Score:           stats.ReliabilityScore(),
+		WindowDays:      policy.WindowDays,
+		DepositRequired: policy.RequiresDeposit(stats),
+	}, nil
+}
@@ -0,0 +1,467 @@
+package noshow
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// -----------------------------------------------------------------------------
+// Pagamento confirmado (webhook do Asaas)
+// -----------------------------------------------------------------------------
+
+// ConfirmDepositPaymentUseCase marca o sinal como pago quando o Asaas avisa o
+// recebimento do PIX
+type ConfirmDepositPaymentUseCase struct {
+	repo   port.NoShowRepository
+	logger *zap.Logger
+}
+
+// NewConfirmDepositPaymentUseCase cria nova instância do use case
+func NewConfirmDepositPaymentUseCase(repo port.NoShowRepository, logger *zap.Logger) *ConfirmDepositPaymentUseCase {
+	return &ConfirmDepositPaymentUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute marca o sinal da cobrança como pago. Retorna false se a cobrança
+// não é de um sinal. Repetições do webhook são ignoradas.
+func (uc *ConfirmDepositPaymentUseCase) Execute(ctx context.Context, asaasPaymentID string, paidAt time.Time) (bool, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.ConfirmDepositPayment")
+	defer span.End()
+
+	deposit, err := uc.repo.FindDepositByAsaasPayment(ctx, asaasPaymentID)
+	if errors.Is(err, domain.ErrAppointmentDepositNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	fromStatus := deposit.Status
+	switch fromStatus {
+	case entity.DepositStatusPending:
+	case entity.DepositStatusCanceled, entity.DepositStatusExpired:
+		// Pago depois do cancelamento ou do prazo: fica como pago para a
+		// unidade decidir entre devolver ou usar em outro atendimento
+		common.Logger(ctx, uc.logger).Warn("Sinal pago após cancelamento do agendamento",
+			zap.String("tenant_id", deposit.TenantID.String()),
+			zap.String("deposit_id", deposit.ID),
+			zap.String("appointment_id", deposit.AppointmentID),
+			zap.String("status", fromStatus),
+		)
+	default:
+		return true, nil
+	}
+
+	deposit.Status = entity.DepositStatusPaid
+	deposit.PaidAt = &paidAt
+	ok, err := uc.repo.TransitionDeposit(ctx, deposit, fromStatus)
+	if err != nil {
+		return true, err
+	}
+	if ok {
+		common.Logger(ctx, uc.logger).Info("Sinal do agendamento pago",
+			zap.String("tenant_id", deposit.TenantID.String()),
+			zap.String("deposit_id", deposit.ID),
+			zap.String("appointment_id", deposit.AppointmentID),
+			zap.String("amount", deposit.Amount.String()),
+		)
+	}
+	return true, nil
+}
+
+// -----------------------------------------------------------------------------
+// Creditar ou reter
+// -----------------------------------------------------------------------------
+
+// SettleDepositUseCase dá destino ao sinal conforme o agendamento: creditado
+// na comanda quando o cliente comparece, retido como receita quando falta e
+// cancelado se o agendamento for cancelado antes do pagamento.
+// Implementa appointment.AttendanceListener.
+type SettleDepositUseCase struct {
+	repo              port.NoShowRepository
+	commandRepo       port.CommandRepository
+	meioPagamentoRepo port.MeioPagamentoRepository
+	contaReceberRepo  port.ContaReceberRepository
+	gateway           port.AsaasGateway
+	logger            *zap.Logger
+}
+
+// NewSettleDepositUseCase cria nova instância do use case
+func NewSettleDepositUseCase(
+	repo port.NoShowRepository,
+	commandRepo port.CommandRepository,
+	meioPagamentoRepo port.MeioPagamentoRepository,
+	contaReceberRepo port.ContaReceberRepository,
+	gateway port.AsaasGateway,
+	logger *zap.Logger,
+) *SettleDepositUseCase {
+	return &SettleDepositUseCase{
+		repo:              repo,
+		commandRepo:       commandRepo,
+		meioPagamentoRepo: meioPagamentoRepo,
+		contaReceberRepo:  contaReceberRepo,
+		gateway:           gateway,
+		logger:            logger,
+	}
+}
+
+// AttendanceChanged dá destino ao sinal após a mudança de status
+func (uc *SettleDepositUseCase) AttendanceChanged(ctx context.Context, a *entity.Appointment) {
+	if err := uc.Execute(ctx, a); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao dar destino ao sinal do agendamento",
+			zap.String("tenant_id", a.TenantID.String()),
+			zap.String("appointment_id", a.ID),
+			zap.String("status", a.Status.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// Execute credita, retém ou cancela o sinal do agendamento
+func (uc *SettleDepositUseCase) Execute(ctx context.Context, a *entity.Appointment) error {
+	ctx, span := common.StartSpan(ctx, "noshow.SettleDeposit")
+	defer span.End()
+
+	deposit, err := uc.repo.FindDepositByAppointment(ctx, a.TenantID.String(), a.ID)
+	if errors.Is(err, domain.ErrAppointmentDepositNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	switch a.Status {
+	case valueobject.AppointmentStatusCheckedIn,
+		valueobject.AppointmentStatusInService,
+		valueobject.AppointmentStatusAwaitingPayment,
+		valueobject.AppointmentStatusDone:
+		if deposit.Status == entity.DepositStatusPaid {
+			return uc.credit(ctx, deposit)
+		}
+	case valueobject.AppointmentStatusNoShow:
+		switch deposit.Status {
+		case entity.DepositStatusPaid:
+			return uc.retain(ctx, deposit)
+		case entity.DepositStatusPending:
+			return uc.cancel(ctx, deposit)
+		}
+	case valueobject.AppointmentStatusCanceled:
+		switch deposit.Status {
+		case entity.DepositStatusPending:
+			return uc.cancel(ctx, deposit)
+		case entity.DepositStatusPaid:
+			common.Logger(ctx, uc.logger).Warn("Agendamento com sinal pago cancelado: devolver ou usar em outro atendimento",
+				zap.String("tenant_id", deposit.TenantID.String()),
+				zap.String("deposit_id", deposit.ID),
+				zap.String("appointment_id", deposit.AppointmentID),
+			)
+		}
+	}
+	return nil
+}
+
+// credit lança o sinal como pagamento na comanda do agendamento, com o meio
+// de pagamento configurado na política
+func (uc *SettleDepositUseCase) credit(ctx context.Context, deposit *entity.AppointmentDeposit) error {
+	tenantID := deposit.TenantID.String()
+	policy, err := loadPolicy(ctx, uc.repo, tenantID)
+	if err != nil {
+		return err
+	}
+	if policy.MeioPagamentoID == "" {
+		common.Logger(ctx, uc.logger).Warn("Política sem meio de pagamento: sinal pago não creditado na comanda",
+			zap.String("tenant_id", tenantID),
+			zap.String("deposit_id", deposit.ID),
+		)
+		return nil
+	}
+
+	appointmentUUID, err := uuid.Parse(deposit.AppointmentID)
+	if err != nil {
+		return fmt.Errorf("appointment_id inválido: %w", err)
+	}
+	command, err := uc.commandRepo.FindByAppointmentID(ctx, appointmentUUID, deposit.TenantID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar comanda do agendamento: %w", err)
+	}
+	if command == nil || command.Status != entity.CommandStatusOpen {
+		common.Logger(ctx, uc.logger).Warn("Agendamento sem comanda aberta: sinal pago não creditado",
+			zap.String("tenant_id", tenantID),
+			zap.String("deposit_id", deposit.ID),
+		)
+		return nil
+	}
+	meio, err := uc.meioPagamentoRepo.FindByID(ctx, tenantID, policy.MeioPagamentoID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar meio de pagamento do sinal: %w", err)
+	}
+	taxaPercentual, _ := meio.Taxa.Float64()
+	taxaFixa, _ := meio.TaxaFixa.Float64()
+	payment, err := entity.NewCommandPayment(command.ID, meio.ID, deposit.Amount.InexactFloat64(), taxaPercentual, taxaFixa, nil)
+	if err != nil {
+		return err
+	}
+	observacoes := fmt.Sprintf("Sinal pago via PIX (Asaas %s)", deposit.AsaasPaymentID)
+	payment.Observacoes = &observacoes
+
+	// Reserva o sinal antes de lançar, para não creditar duas vezes
+	now := time.Now()
+	deposit.Status = entity.DepositStatusCredited
+	deposit.SettledAt = &now
+	ok, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusPaid)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := uc.addPayment(ctx, command, payment); err != nil {
+		deposit.Status = entity.DepositStatusPaid
+		if _, revertErr := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusCredited); revertErr != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao devolver sinal ao status pago",
+				zap.String("deposit_id", deposit.ID),
+				zap.Error(revertErr),
+			)
+		}
+		return err
+	}
+
+	deposit.CommandPaymentID = payment.ID.String()
+	if _, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusCredited); err != nil {
+		return err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Sinal creditado na comanda",
+		zap.String("tenant_id", tenantID),
+		zap.String("deposit_id", deposit.ID),
+		zap.String("command_id", command.ID.String()),
+		zap.String("amount", deposit.Amount.String()),
+	)
+	return nil
+}
+
+func (uc *SettleDepositUseCase) addPayment(ctx context.Context, command *entity.Command, payment *entity.CommandPayment) error {
+	if err := command.AddPayment(*payment); err != nil {
+		return err
+	}
+	if err := uc.commandRepo.AddPayment(ctx, payment); err != nil {
+		return fmt.Errorf("erro ao lançar sinal na comanda: %w", err)
+	}
+	if err := uc.commandRepo.Update(ctx, command); err != nil {
+		return fmt.Errorf("erro ao atualizar comanda: %w", err)
+	}
+	return nil
+}
+
+// retain registra o sinal como receita recebida (não comparecimento)
+func (uc *SettleDepositUseCase) retain(ctx context.Context, deposit *entity.AppointmentDeposit) error {
+	now := time.Now()
+	deposit.Status = entity.DepositStatusRetained
+	deposit.SettledAt = &now
+	ok, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusPaid)
+	if err != nil || !ok {
+		return err
+	}
+
+	recebidoEm := now
+	if deposit.PaidAt != nil {
+		recebidoEm = *deposit.PaidAt
+	}
+	competenciaMes := now.Format("2006-01")
+	valor := valueobject.NewMoneyFromDecimal(deposit.Amount)
+	conta := &entity.ContaReceber{
+		TenantID:        deposit.TenantID,
+		Origem:          "OUTRO",
+		AsaasPaymentID:  &deposit.AsaasPaymentID,
+		DescricaoOrigem: "Sinal retido por não comparecimento",
+		Valor:           valor,
+		ValorPago:       valor,
+		ValorAberto:     valueobject.Zero(),
+		DataVencimento:  recebidoEm,
+		DataRecebimento: &recebidoEm,
+		Status:          valueobject.StatusContaRecebido,
+		Observacoes:     fmt.Sprintf("Agendamento %s", deposit.AppointmentID),
+		CompetenciaMes:  &competenciaMes,
+		ConfirmedAt:     &now,
+		ReceivedAt:      &recebidoEm,
+		CriadoEm:        now,
+		AtualizadoEm:    now,
+	}
+	if err := uc.contaReceberRepo.UpsertByAsaasPaymentID(ctx, conta); err != nil {
+		return err
+	}
+
+	deposit.ContaReceberID = conta.ID
+	if _, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusRetained); err != nil {
+		return err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Sinal retido por não comparecimento",
+		zap.String("tenant_id", deposit.TenantID.String()),
+		zap.String("deposit_id", deposit.ID),
+		zap.String("conta_receber_id", conta.ID),
+		zap.String("amount", deposit.Amount.String()),
+	)
+	return nil
+}
+
+// cancel encerra o sinal ainda não pago e cancela a cobrança PIX no Asaas
+func (uc *SettleDepositUseCase) cancel(ctx context.Context, deposit *entity.AppointmentDeposit) error {
+	deposit.Status = entity.DepositStatusCanceled
+	ok, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusPending)
+	if err != nil || !ok {
+		return err
+	}
+	cancelCharge(ctx, uc.gateway, uc.logger, deposit)
+	return nil
+}
+
+// cancelCharge cancela a cobrança do sinal no Asaas para que o cliente não
+// pague por um agendamento que não existe mais. Se falhar, um pagamento
+// posterior ainda é registrado pelo webhook (ConfirmDepositPaymentUseCase).
+func cancelCharge(ctx context.Context, gateway port.AsaasGateway, logger *zap.Logger, deposit *entity.AppointmentDeposit) {
+	if !deposit.HasCharge() {
+		return
+	}
+	if err := gateway.CancelCharge(ctx, deposit.AsaasPaymentID); err != nil {
+		common.Logger(ctx, logger).Warn("Cobrança do sinal não foi cancelada no Asaas",
+			zap.String("tenant_id", deposit.TenantID.String()),
+			zap.String("deposit_id", deposit.ID),
+			zap.String("asaas_payment_id", deposit.AsaasPaymentID),
+			zap.Error(err),
+		)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Prazo vencido
+// -----------------------------------------------------------------------------
+
+// ExpireDepositsUseCase cancela os agendamentos cujo sinal não foi pago no
+// prazo, liberando o horário (e oferecendo-o à lista de espera). Antes de
+// expirar, consulta a cobrança no Asaas: um pagamento cujo webhook se perdeu
+// confirma o sinal em vez de cancelar o agendamento.
+type ExpireDepositsUseCase struct {
+	repo      port.NoShowRepository
+	gateway   port.AsaasGateway
+	confirmUC *ConfirmDepositPaymentUseCase
+	cancelUC  *appointment.CancelAppointmentUseCase
+	logger    *zap.Logger
+}
+
+// NewExpireDepositsUseCase cria nova instância do use case
+func NewExpireDepositsUseCase(
+	repo port.NoShowRepository,
+	gateway port.AsaasGateway,
+	confirmUC *ConfirmDepositPaymentUseCase,
+	cancelUC *appointment.CancelAppointmentUseCase,
+	logger *zap.Logger,
+) *ExpireDepositsUseCase {
+	return &ExpireDepositsUseCase{
+		repo:      repo,
+		gateway:   gateway,
+		confirmUC: confirmUC,
+		cancelUC:  cancelUC,
+		logger:    logger,
+	}
+}
+
+// Execute expira os sinais vencidos (todos os tenants) e retorna quantos
+func (uc *ExpireDepositsUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := common.StartSpan(ctx, "noshow.ExpireDeposits")
+	defer span.End()
+
+	const lote = 100
+	deposits, err := uc.repo.ListOverdueDeposits(ctx, lote)
+	if err != nil {
+		return 0, err
+	}
+
+	expired := 0
+	for _, deposit := range deposits {
+		if deposit.HasCharge() && !uc.chargeCanceled(ctx, deposit) {
+			continue
+		}
+
+		deposit.Status = entity.DepositStatusExpired
+		ok, err := uc.repo.TransitionDeposit(ctx, deposit, entity.DepositStatusPending)
+		if err != nil {
+			return expired, err
+		}
+		if !ok {
+			continue // pago ou cancelado enquanto isso
+		}
+		expired++
+
+		_, err = uc.cancelUC.Execute(ctx, appointment.CancelAppointmentInput{
+			TenantID:      deposit.TenantID.String(),
+			UnitID:        deposit.UnitID.String(),
+			AppointmentID: deposit.AppointmentID,
+			Reason:        "Sinal não pago no prazo",
+		})
+		if err != nil {
+			// Já cancelado ou em atendimento: o sinal só deixa de ser cobrado
+			common.Logger(ctx, uc.logger).Warn("Agendamento com sinal vencido não foi cancelado",
+				zap.String("tenant_id", deposit.TenantID.String()),
+				zap.String("appointment_id", deposit.AppointmentID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	if expired > 0 {
+		common.Logger(ctx, uc.logger).Info("Sinais vencidos expirados", zap.Int("total", expired))
+	}
+	return expired, nil
+}
+
+// chargeCanceled confere a cobrança no Asaas e a cancela. Retorna false quando
+// o sinal não deve expirar agora: cobrança paga (o sinal é confirmado) ou
+// Asaas indisponível (nova tentativa na próxima execução).
+func (uc *ExpireDepositsUseCase) chargeCanceled(ctx context.Context, deposit *entity.AppointmentDeposit) bool {
+	charge, err := uc.gateway.GetCharge(ctx, deposit.AsaasPaymentID)
+	if err != nil {
+		common.Logger(ctx, uc.logger).Warn("Cobrança do sinal vencido não consultada no Asaas",
+			zap.String("tenant_id", deposit.TenantID.String()),
+			zap.String("deposit_id", deposit.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+	if charge.Paid {
+		paidAt := time.Now()
+		if charge.PaidAt != nil {
+			paidAt = *charge.PaidAt
+		}
+		if _, err := uc.confirmUC.Execute(ctx, deposit.AsaasPaymentID, paidAt); err != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao confirmar sinal pago encontrado no Asaas",
+				zap.String("tenant_id", deposit.TenantID.String()),
+				zap.String("deposit_id", deposit.ID),
+				zap.Error(err),
+			)
+		}
+		return false
+	}
+	// Cancela antes de expirar: se o cliente pagar agora, o Asaas recusa o
+	// cancelamento e o sinal é confirmado pelo webhook
+	if err := uc.gateway.CancelCharge(ctx, deposit.AsaasPaymentID); err != nil {
+		common.Logger(ctx, uc.logger).Warn("Cobrança do sinal vencido não foi cancelada no Asaas",
+			zap.String("tenant_id", deposit.TenantID.String()),
+			zap.String("deposit_id", deposit.ID),
+			zap.Error(err),
+		)
+		return false
+	}
+	return true
+}
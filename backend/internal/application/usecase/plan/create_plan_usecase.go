@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -24,6 +25,9 @@ func NewCreatePlanUseCase(repo port.PlanRepository, logger *zap.Logger) *CreateP
 
 // Execute executa a criação do plano
 func (uc *CreatePlanUseCase) Execute(ctx context.Context, tenantID string, req dto.CreatePlanRequest) (*dto.PlanResponse, error) {
+	ctx, span := common.StartSpan(ctx, "plan.CreatePlan")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -31,7 +35,7 @@ func (uc *CreatePlanUseCase) Execute(ctx context.Context, tenantID string, req d
 
 	exists, err := uc.repo.CheckNameExists(ctx, tenantUUID, req.Nome, nil)
 	if err != nil {
-		uc.logger.Error("erro ao verificar nome do plano", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao verificar nome do plano", zap.Error(err))
 		return nil, err
 	}
 	if exists {
@@ -44,7 +48,7 @@ func (uc *CreatePlanUseCase) Execute(ctx context.Context, tenantID string, req d
 	}
 
 	if err := uc.repo.Create(ctx, plan); err != nil {
-		uc.logger.Error("erro ao criar plano", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao criar plano", zap.Error(err))
 		return nil, err
 	}
 
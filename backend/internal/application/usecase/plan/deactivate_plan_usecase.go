@@ -3,6 +3,7 @@ package plan
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -20,6 +21,9 @@ func NewDeactivatePlanUseCase(repo port.PlanRepository) *DeactivatePlanUseCase {
 
 // Execute desativa o plano
 func (uc *DeactivatePlanUseCase) Execute(ctx context.Context, tenantID, planID string) error {
+	ctx, span := common.StartSpan(ctx, "plan.DeactivatePlan")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return domain.ErrInvalidTenantID
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -23,6 +24,9 @@ func NewListPlansUseCase(repo port.PlanRepository) *ListPlansUseCase {
 
 // Execute lista planos (todos ou apenas ativos conforme flag)
 func (uc *ListPlansUseCase) Execute(ctx context.Context, tenantID string, onlyActive bool) ([]*dto.PlanResponse, error) {
+	ctx, span := common.StartSpan(ctx, "plan.ListPlans")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -24,6 +25,9 @@ func NewUpdatePlanUseCase(repo port.PlanRepository, logger *zap.Logger) *UpdateP
 
 // Execute atualiza o plano
 func (uc *UpdatePlanUseCase) Execute(ctx context.Context, tenantID, planID string, req dto.UpdatePlanRequest) (*dto.PlanResponse, error) {
+	ctx, span := common.StartSpan(ctx, "plan.UpdatePlan")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -54,7 +58,7 @@ func (uc *UpdatePlanUseCase) Execute(ctx context.Context, tenantID, planID strin
 	}
 
 	if err := uc.repo.Update(ctx, plan); err != nil {
-		uc.logger.Error("erro ao atualizar plano", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao atualizar plano", zap.Error(err))
 		return nil, err
 	}
 
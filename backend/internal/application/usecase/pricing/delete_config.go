@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -19,6 +20,9 @@ func NewDeletePrecificacaoConfigUseCase(repo port.PrecificacaoConfigRepository,
 }
 
 func (uc *DeletePrecificacaoConfigUseCase) Execute(ctx context.Context, tenantID string) error {
+	ctx, span := common.StartSpan(ctx, "pricing.DeletePrecificacaoConfig")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -31,7 +35,7 @@ func (uc *DeletePrecificacaoConfigUseCase) Execute(ctx context.Context, tenantID
 		return fmt.Errorf("erro ao deletar configuração: %w", err)
 	}
 
-	uc.logger.Info("Configuração de precificação deletada", zap.String("tenant_id", tenantID))
+	common.Logger(ctx, uc.logger).Info("Configuração de precificação deletada", zap.String("tenant_id", tenantID))
 
 	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -20,6 +21,9 @@ func NewGetPrecificacaoConfigUseCase(repo port.PrecificacaoConfigRepository, log
 }
 
 func (uc *GetPrecificacaoConfigUseCase) Execute(ctx context.Context, tenantID string) (*entity.PrecificacaoConfig, error) {
+	ctx, span := common.StartSpan(ctx, "pricing.GetPrecificacaoConfig")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
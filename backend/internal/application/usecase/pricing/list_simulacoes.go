@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -26,6 +27,9 @@ func NewListSimulacoesUseCase(repo port.PrecificacaoSimulacaoRepository, logger
 }
 
 func (uc *ListSimulacoesUseCase) Execute(ctx context.Context, input ListSimulacoesInput) ([]*entity.PrecificacaoSimulacao, error) {
+	ctx, span := common.StartSpan(ctx, "pricing.ListSimulacoes")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -41,6 +42,9 @@ func NewSaveConfigPrecificacaoUseCase(
 
 // Execute salva ou atualiza a configuração de precificação
 func (uc *SaveConfigPrecificacaoUseCase) Execute(ctx context.Context, input SaveConfigPrecificacaoInput) (*entity.PrecificacaoConfig, error) {
+	ctx, span := common.StartSpan(ctx, "pricing.SaveConfigPrecificacao")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -86,7 +90,7 @@ func (uc *SaveConfigPrecificacaoUseCase) Execute(ctx context.Context, input Save
 		return nil, fmt.Errorf("erro ao salvar configuração: %w", err)
 	}
 
-	uc.logger.Info("Configuração de precificação salva",
+	common.Logger(ctx, uc.logger).Info("Configuração de precificação salva",
 		zap.String("tenant_id", input.TenantID),
 	)
 
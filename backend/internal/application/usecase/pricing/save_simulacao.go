@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -35,6 +36,9 @@ func NewSaveSimulacaoUseCase(
 
 // Execute salva a simulação no histórico
 func (uc *SaveSimulacaoUseCase) Execute(ctx context.Context, input SaveSimulacaoInput) error {
+	ctx, span := common.StartSpan(ctx, "pricing.SaveSimulacao")
+	defer span.End()
+
 	if input.Simulacao == nil {
 		return fmt.Errorf("simulação é obrigatória")
 	}
@@ -47,7 +51,7 @@ func (uc *SaveSimulacaoUseCase) Execute(ctx context.Context, input SaveSimulacao
 		return fmt.Errorf("erro ao salvar simulação: %w", err)
 	}
 
-	uc.logger.Info("Simulação salva no histórico",
+	common.Logger(ctx, uc.logger).Info("Simulação salva no histórico",
 		zap.String("tenant_id", input.Simulacao.TenantID.String()),
 		zap.String("simulacao_id", input.Simulacao.ID),
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -45,6 +46,9 @@ func NewSimularPrecoUseCase(
 
 // Execute executa a simulação de preço
 func (uc *SimularPrecoUseCase) Execute(ctx context.Context, input SimularPrecoInput) (*entity.PrecificacaoSimulacao, error) {
+	ctx, span := common.StartSpan(ctx, "pricing.SimularPreco")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -81,7 +85,7 @@ func (uc *SimularPrecoUseCase) Execute(ctx context.Context, input SimularPrecoIn
 	// Calcular preço sugerido
 	simulacao.CalcularPrecoSugerido()
 
-	uc.logger.Info("Simulação de preço executada",
+	common.Logger(ctx, uc.logger).Info("Simulação de preço executada",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("item_id", input.ItemID),
 		zap.String("preco_sugerido", simulacao.PrecoSugerido.String()),
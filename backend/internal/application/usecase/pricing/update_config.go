@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -25,6 +26,9 @@ func NewUpdatePrecificacaoConfigUseCase(repo port.PrecificacaoConfigRepository,
 }
 
 func (uc *UpdatePrecificacaoConfigUseCase) Execute(ctx context.Context, input UpdatePrecificacaoConfigInput) (*entity.PrecificacaoConfig, error) {
+	ctx, span := common.StartSpan(ctx, "pricing.UpdatePrecificacaoConfig")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -40,7 +44,7 @@ func (uc *UpdatePrecificacaoConfigUseCase) Execute(ctx context.Context, input Up
 		return nil, fmt.Errorf("erro ao atualizar configuração: %w", err)
 	}
 
-	uc.logger.Info("Configuração de precificação atualizada", zap.String("tenant_id", input.TenantID))
+	common.Logger(ctx, uc.logger).Info("Configuração de precificação atualizada", zap.String("tenant_id", input.TenantID))
 
 	return input.Config, nil
 }
@@ -0,0 +1,232 @@
+// Package resource contém os use cases dos recursos da unidade (cadeiras,
+// salas, lavatório) e dos recursos exigidos por cada serviço, usados na
+// verificação de disponibilidade dos agendamentos.
+package resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// -----------------------------------------------------------------------------
+// Cadastro de recursos
+// -----------------------------------------------------------------------------
+
+// CreateResourceInput dados de entrada para cadastrar um recurso
+type CreateResourceInput struct {
+	TenantID string
+	UnitID   string
+	Nome     string
+	Capacity int
+}
+
+// CreateResourceUseCase cadastra um recurso na unidade
+type CreateResourceUseCase struct {
+	repo   port.ResourceRepository
+	logger *zap.Logger
+}
+
+// NewCreateResourceUseCase cria nova instância do use case
+func NewCreateResourceUseCase(repo port.ResourceRepository, logger *zap.Logger) *CreateResourceUseCase {
+	return &CreateResourceUseCase{repo: repo, logger: logger}
+}
+
+// Execute cadastra o recurso
+func (uc *CreateResourceUseCase) Execute(ctx context.Context, input CreateResourceInput) (*entity.Resource, error) {
+	ctx, span := common.StartSpan(ctx, "resource.CreateResource")
+	defer span.End()
+
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	unitUUID, err := uuid.Parse(input.UnitID)
+	if err != nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	resource, err := entity.NewResource(tenantUUID, unitUUID, input.Nome, input.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Create(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Recurso cadastrado",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("unit_id", input.UnitID),
+		zap.String("resource_id", resource.ID),
+		zap.Int("capacity", resource.Capacity),
+	)
+	return resource, nil
+}
+
+// ListResourcesUseCase lista os recursos da unidade
+type ListResourcesUseCase struct {
+	repo   port.ResourceRepository
+	logger *zap.Logger
+}
+
+// NewListResourcesUseCase cria nova instância do use case
+func NewListResourcesUseCase(repo port.ResourceRepository, logger *zap.Logger) *ListResourcesUseCase {
+	return &ListResourcesUseCase{repo: repo, logger: logger}
+}
+
+// Execute lista os recursos (inativos só quando pedido)
+func (uc *ListResourcesUseCase) Execute(ctx context.Context, tenantID, unitID string, includeInactive bool) ([]*entity.Resource, error) {
+	ctx, span := common.StartSpan(ctx, "resource.ListResources")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == "" {
+		return nil, domain.ErrUnitIDRequired
+	}
+	return uc.repo.List(ctx, tenantID, unitID, includeInactive)
+}
+
+// UpdateResourceInput dados de entrada para alterar um recurso
+type UpdateResourceInput struct {
+	TenantID string
+	ID       string
+	Nome     string
+	Capacity int
+	Ativo    bool
+}
+
+// UpdateResourceUseCase altera nome, capacidade e situação de um recurso.
+// Recurso desativado deixa de limitar a agenda.
+type UpdateResourceUseCase struct {
+	repo   port.ResourceRepository
+	logger *zap.Logger
+}
+
+// NewUpdateResourceUseCase cria nova instância do use case
+func NewUpdateResourceUseCase(repo port.ResourceRepository, logger *zap.Logger) *UpdateResourceUseCase {
+	return &UpdateResourceUseCase{repo: repo, logger: logger}
+}
+
+// Execute altera o recurso
+func (uc *UpdateResourceUseCase) Execute(ctx context.Context, input UpdateResourceInput) (*entity.Resource, error) {
+	ctx, span := common.StartSpan(ctx, "resource.UpdateResource")
+	defer span.End()
+
+	resource, err := uc.repo.FindByID(ctx, input.TenantID, input.ID)
+	if err != nil {
+		return nil, err
+	}
+	resource.Nome = strings.TrimSpace(input.Nome)
+	resource.Capacity = input.Capacity
+	resource.Ativo = input.Ativo
+	if err := resource.Validate(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Update(ctx, resource); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Recurso atualizado",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("resource_id", resource.ID),
+		zap.Int("capacity", resource.Capacity),
+		zap.Bool("ativo", resource.Ativo),
+	)
+	return resource, nil
+}
+
+// -----------------------------------------------------------------------------
+// Recursos exigidos pelos serviços
+// -----------------------------------------------------------------------------
+
+// GetServiceResourcesUseCase lista os recursos exigidos por um serviço
+type GetServiceResourcesUseCase struct {
+	repo   port.ResourceRepository
+	logger *zap.Logger
+}
+
+// NewGetServiceResourcesUseCase cria nova instância do use case
+func NewGetServiceResourcesUseCase(repo port.ResourceRepository, logger *zap.Logger) *GetServiceResourcesUseCase {
+	return &GetServiceResourcesUseCase{repo: repo, logger: logger}
+}
+
+// Execute lista os recursos do serviço
+func (uc *GetServiceResourcesUseCase) Execute(ctx context.Context, tenantID, serviceID string) ([]entity.ServiceResourceRequirement, error) {
+	ctx, span := common.StartSpan(ctx, "resource.GetServiceResources")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	return uc.repo.ListServiceRequirements(ctx, tenantID, serviceID)
+}
+
+// SetServiceResourcesUseCase define os recursos de que um serviço precisa e
+// por quanto tempo. Vale para os próximos agendamentos; os já feitos mantêm
+// as reservas.
+type SetServiceResourcesUseCase struct {
+	repo          port.ResourceRepository
+	serviceReader port.ServiceReader
+	logger        *zap.Logger
+}
+
+// NewSetServiceResourcesUseCase cria nova instância do use case
+func NewSetServiceResourcesUseCase(repo port.ResourceRepository, serviceReader port.ServiceReader, logger *zap.Logger) *SetServiceResourcesUseCase {
+	return &SetServiceResourcesUseCase{repo: repo, serviceReader: serviceReader, logger: logger}
+}
+
+// Execute substitui os recursos do serviço (lista vazia remove todos)
+func (uc *SetServiceResourcesUseCase) Execute(ctx context.Context, tenantID, serviceID string, requirements []entity.ServiceResourceRequirement) ([]entity.ServiceResourceRequirement, error) {
+	ctx, span := common.StartSpan(ctx, "resource.SetServiceResources")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	service, err := uc.serviceReader.FindByID(ctx, tenantID, serviceID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar serviço: %w", err)
+	}
+	if service == nil {
+		return nil, domain.ErrAppointmentServiceNotFound
+	}
+
+	seen := make(map[string]bool, len(requirements))
+	for i := range requirements {
+		req := &requirements[i]
+		req.ServiceID = serviceID
+		if err := req.Validate(); err != nil {
+			return nil, err
+		}
+		// O uso precisa começar dentro do serviço, e cada recurso aparece
+		// uma vez
+		if req.OffsetMinutes >= service.Duration || seen[req.ResourceID] {
+			return nil, domain.ErrServiceResourceInvalid
+		}
+		seen[req.ResourceID] = true
+		resource, err := uc.repo.FindByID(ctx, tenantID, req.ResourceID)
+		if err != nil {
+			return nil, err
+		}
+		req.ResourceName = resource.Nome
+	}
+
+	if err := uc.repo.ReplaceServiceRequirements(ctx, tenantID, serviceID, requirements); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Recursos do serviço atualizados",
+		zap.String("tenant_id", tenantID),
+		zap.String("service_id", serviceID),
+		zap.Int("resources", len(requirements)),
+	)
+	return requirements, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -37,6 +38,9 @@ func (uc *CreateServicoUseCase) Execute(
 	tenantID string,
 	req dto.CreateServicoRequest,
 ) (*dto.ServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.CreateServico")
+	defer span.End()
+
 	// Validar tenant_id
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
@@ -51,7 +55,7 @@ func (uc *CreateServicoUseCase) Execute(
 	// Verificar duplicidade de nome
 	exists, err := uc.repo.CheckNomeExists(ctx, tenantID, unitID, req.Nome, "")
 	if err != nil {
-		uc.logger.Error("Erro ao verificar nome duplicado",
+		common.Logger(ctx, uc.logger).Error("Erro ao verificar nome duplicado",
 			zap.String("tenant_id", tenantID),
 			zap.String("nome", req.Nome),
 			zap.Error(err),
@@ -79,7 +83,7 @@ func (uc *CreateServicoUseCase) Execute(
 
 	// Persistir
 	if err := uc.repo.Create(ctx, servico); err != nil {
-		uc.logger.Error("Erro ao criar serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao criar serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("nome", req.Nome),
 			zap.Error(err),
@@ -87,7 +91,7 @@ func (uc *CreateServicoUseCase) Execute(
 		return nil, err
 	}
 
-	uc.logger.Info("Serviço criado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Serviço criado com sucesso",
 		zap.String("servico_id", servico.ID.String()),
 		zap.String("tenant_id", tenantID),
 		zap.String("nome", servico.Nome),
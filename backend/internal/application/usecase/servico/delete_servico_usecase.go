@@ -3,6 +3,7 @@ package servico
 import (
 	"context"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -29,6 +30,9 @@ func (uc *DeleteServicoUseCase) Execute(
 	unitID string,
 	servicoID string,
 ) error {
+	ctx, span := common.StartSpan(ctx, "servico.DeleteServico")
+	defer span.End()
+
 	// Verificar se serviço existe
 	servico, err := uc.repo.FindByID(ctx, tenantID, unitID, servicoID)
 	if err != nil {
@@ -41,7 +45,7 @@ func (uc *DeleteServicoUseCase) Execute(
 
 	// Deletar
 	if err := uc.repo.Delete(ctx, tenantID, servico.UnitID.String(), servicoID); err != nil {
-		uc.logger.Error("Erro ao deletar serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao deletar serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("servico_id", servicoID),
 			zap.Error(err),
@@ -49,7 +53,7 @@ func (uc *DeleteServicoUseCase) Execute(
 		return err
 	}
 
-	uc.logger.Info("Serviço deletado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Serviço deletado com sucesso",
 		zap.String("servico_id", servicoID),
 		zap.String("tenant_id", tenantID),
 	)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -31,9 +32,12 @@ func (uc *GetServicoUseCase) Execute(
 	unitID string,
 	servicoID string,
 ) (*dto.ServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.GetServico")
+	defer span.End()
+
 	servico, err := uc.repo.FindByID(ctx, tenantID, unitID, servicoID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("unit_id", unitID),
 			zap.String("servico_id", servicoID),
@@ -65,9 +69,12 @@ func (uc *GetServicoStatsUseCase) Execute(
 	tenantID string,
 	unitID string,
 ) (*dto.ServicoStatsResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.GetServicoStats")
+	defer span.End()
+
 	stats, err := uc.repo.GetStats(ctx, tenantID, unitID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar estatísticas de serviços",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar estatísticas de serviços",
 			zap.String("tenant_id", tenantID),
 			zap.String("unit_id", unitID),
 			zap.Error(err),
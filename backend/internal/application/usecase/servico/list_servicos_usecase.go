@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
 )
@@ -29,6 +30,9 @@ func (uc *ListServicosUseCase) Execute(
 	tenantID string,
 	req dto.ListServicosRequest,
 ) (*dto.ListServicosResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.ListServicos")
+	defer span.End()
+
 	filter := port.ServicoFilter{
 		ApenasAtivos:   req.ApenasAtivos,
 		CategoriaID:    req.CategoriaID,
@@ -40,7 +44,7 @@ func (uc *ListServicosUseCase) Execute(
 
 	servicos, err := uc.repo.List(ctx, tenantID, filter.UnitID, filter)
 	if err != nil {
-		uc.logger.Error("Erro ao listar serviços",
+		common.Logger(ctx, uc.logger).Error("Erro ao listar serviços",
 			zap.String("tenant_id", tenantID),
 			zap.Error(err),
 		)
@@ -74,9 +78,12 @@ func (uc *ListServicosByCategoriaUseCase) Execute(
 	unitID string,
 	categoriaID string,
 ) (*dto.ListServicosResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.ListServicosByCategoria")
+	defer span.End()
+
 	servicos, err := uc.repo.ListByCategoria(ctx, tenantID, unitID, categoriaID)
 	if err != nil {
-		uc.logger.Error("Erro ao listar serviços por categoria",
+		common.Logger(ctx, uc.logger).Error("Erro ao listar serviços por categoria",
 			zap.String("tenant_id", tenantID),
 			zap.String("unit_id", unitID),
 			zap.String("categoria_id", categoriaID),
@@ -112,9 +119,12 @@ func (uc *ListServicosByProfissionalUseCase) Execute(
 	unitID string,
 	profissionalID string,
 ) (*dto.ListServicosResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.ListServicosByProfissional")
+	defer span.End()
+
 	servicos, err := uc.repo.ListByProfissional(ctx, tenantID, unitID, profissionalID)
 	if err != nil {
-		uc.logger.Error("Erro ao listar serviços por profissional",
+		common.Logger(ctx, uc.logger).Error("Erro ao listar serviços por profissional",
 			zap.String("tenant_id", tenantID),
 			zap.String("unit_id", unitID),
 			zap.String("profissional_id", profissionalID),
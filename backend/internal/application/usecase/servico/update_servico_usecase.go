@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -38,6 +39,9 @@ func (uc *UpdateServicoUseCase) Execute(
 	servicoID string,
 	req dto.UpdateServicoRequest,
 ) (*dto.ServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.UpdateServico")
+	defer span.End()
+
 	// Buscar serviço existente
 	servico, err := uc.repo.FindByID(ctx, tenantID, unitID, servicoID)
 	if err != nil {
@@ -47,7 +51,7 @@ func (uc *UpdateServicoUseCase) Execute(
 	// Verificar duplicidade de nome (excluindo o próprio serviço)
 	exists, err := uc.repo.CheckNomeExists(ctx, tenantID, unitID, req.Nome, servicoID)
 	if err != nil {
-		uc.logger.Error("Erro ao verificar nome duplicado",
+		common.Logger(ctx, uc.logger).Error("Erro ao verificar nome duplicado",
 			zap.String("tenant_id", tenantID),
 			zap.String("unit_id", unitID),
 			zap.String("servico_id", servicoID),
@@ -76,7 +80,7 @@ func (uc *UpdateServicoUseCase) Execute(
 
 	// Persistir
 	if err := uc.repo.Update(ctx, servico); err != nil {
-		uc.logger.Error("Erro ao atualizar serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao atualizar serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("servico_id", servicoID),
 			zap.Error(err),
@@ -84,7 +88,7 @@ func (uc *UpdateServicoUseCase) Execute(
 		return nil, err
 	}
 
-	uc.logger.Info("Serviço atualizado com sucesso",
+	common.Logger(ctx, uc.logger).Info("Serviço atualizado com sucesso",
 		zap.String("servico_id", servico.ID.String()),
 		zap.String("tenant_id", tenantID),
 		zap.String("nome", servico.Nome),
@@ -114,6 +118,9 @@ func (uc *ToggleServicoStatusUseCase) Execute(
 	unitID string,
 	servicoID string,
 ) (*dto.ServicoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "servico.ToggleServicoStatus")
+	defer span.End()
+
 	// Verificar se serviço existe
 	servico, err := uc.repo.FindByID(ctx, tenantID, unitID, servicoID)
 	if err != nil {
@@ -125,7 +132,7 @@ func (uc *ToggleServicoStatusUseCase) Execute(
 
 	// Alterar status no repositório
 	if err := uc.repo.ToggleStatus(ctx, tenantID, servico.UnitID.String(), servicoID, novoStatus); err != nil {
-		uc.logger.Error("Erro ao alterar status do serviço",
+		common.Logger(ctx, uc.logger).Error("Erro ao alterar status do serviço",
 			zap.String("tenant_id", tenantID),
 			zap.String("servico_id", servicoID),
 			zap.Bool("novo_status", novoStatus),
@@ -141,7 +148,7 @@ func (uc *ToggleServicoStatusUseCase) Execute(
 		servico.Desativar()
 	}
 
-	uc.logger.Info("Status do serviço alterado",
+	common.Logger(ctx, uc.logger).Info("Status do serviço alterado",
 		zap.String("servico_id", servicoID),
 		zap.String("tenant_id", tenantID),
 		zap.Bool("novo_status", novoStatus),
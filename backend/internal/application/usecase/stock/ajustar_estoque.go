@@ -5,26 +5,34 @@ import (
 	"fmt"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
 )
 
 // AjustarEstoqueUseCase implementa lógica de negócio para ajuste manual de estoque
 type AjustarEstoqueUseCase struct {
 	produtoRepo      port.ProdutoRepository
 	movimentacaoRepo port.MovimentacaoEstoqueRepository
+	events           port.EventPublisher
+	logger           *zap.Logger
 }
 
 // NewAjustarEstoqueUseCase cria nova instância do use case
 func NewAjustarEstoqueUseCase(
 	produtoRepo port.ProdutoRepository,
 	movimentacaoRepo port.MovimentacaoEstoqueRepository,
+	events port.EventPublisher,
+	logger *zap.Logger,
 ) *AjustarEstoqueUseCase {
 	return &AjustarEstoqueUseCase{
 		produtoRepo:      produtoRepo,
 		movimentacaoRepo: movimentacaoRepo,
+		events:           events,
+		logger:           logger,
 	}
 }
 
@@ -35,6 +43,9 @@ func (uc *AjustarEstoqueUseCase) Execute(
 	usuarioID uuid.UUID,
 	input dto.AjustarEstoqueRequest,
 ) (*dto.MovimentacaoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "stock.AjustarEstoque")
+	defer span.End()
+
 	// 1. Buscar produto
 	produtoID, err := uuid.Parse(input.ProdutoID)
 	if err != nil {
@@ -97,6 +108,8 @@ func (uc *AjustarEstoqueUseCase) Execute(
 		return nil, fmt.Errorf("erro ao registrar movimentação: %w", err)
 	}
 
+	common.PublishStockBelowMinimum(ctx, uc.events, uc.logger, produto, quantidadeAnterior)
+
 	// 10. Retornar resposta
 	return &dto.MovimentacaoResponse{
 		ID:            movimentacao.ID.String(),
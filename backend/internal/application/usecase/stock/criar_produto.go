@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 )
@@ -74,6 +75,9 @@ func NewCriarProdutoUseCase(
 
 // Execute executa o caso de uso de criação de produto
 func (uc *CriarProdutoUseCase) Execute(ctx context.Context, input CriarProdutoInput) (*CriarProdutoOutput, error) {
+	ctx, span := common.StartSpan(ctx, "stock.CriarProduto")
+	defer span.End()
+
 	// 1. Validar se SKU já existe para o tenant (se informado)
 	if input.SKU != "" {
 		existingProduto, err := uc.produtoRepo.FindBySKU(ctx, input.TenantID, input.SKU)
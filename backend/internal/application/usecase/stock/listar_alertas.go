@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
@@ -24,6 +25,9 @@ func NewListarAlertasEstoqueBaixoUseCase(produtoRepo port.ProdutoRepository) *Li
 
 // Execute executa a lógica de listar alertas de estoque baixo
 func (uc *ListarAlertasEstoqueBaixoUseCase) Execute(ctx context.Context, tenantIDStr string) (*dto.ListAlertasEstoqueBaixoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "stock.ListarAlertasEstoqueBaixo")
+	defer span.End()
+
 	// 1. Validar tenant_id
 	tenantID, err := uuid.Parse(tenantIDStr)
 	if err != nil {
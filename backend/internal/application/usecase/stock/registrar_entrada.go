@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 )
@@ -62,6 +63,9 @@ func (uc *RegistrarEntradaUseCase) Execute(
 	ctx context.Context,
 	input RegistrarEntradaInput,
 ) (*RegistrarEntradaOutput, error) {
+	ctx, span := common.StartSpan(ctx, "stock.RegistrarEntrada")
+	defer span.End()
+
 	// 1. Validar fornecedor existe e pertence ao tenant
 	fornecedor, err := uc.fornecedorRepo.FindByID(ctx, input.TenantID, input.FornecedorID)
 	if err != nil {
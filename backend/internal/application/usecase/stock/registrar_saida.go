@@ -5,26 +5,34 @@ import (
 	"fmt"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
 )
 
 // RegistrarSaidaUseCase implementa lógica de negócio para saída de estoque
 type RegistrarSaidaUseCase struct {
 	produtoRepo      port.ProdutoRepository
 	movimentacaoRepo port.MovimentacaoEstoqueRepository
+	events           port.EventPublisher
+	logger           *zap.Logger
 }
 
 // NewRegistrarSaidaUseCase cria nova instância do use case
 func NewRegistrarSaidaUseCase(
 	produtoRepo port.ProdutoRepository,
 	movimentacaoRepo port.MovimentacaoEstoqueRepository,
+	events port.EventPublisher,
+	logger *zap.Logger,
 ) *RegistrarSaidaUseCase {
 	return &RegistrarSaidaUseCase{
 		produtoRepo:      produtoRepo,
 		movimentacaoRepo: movimentacaoRepo,
+		events:           events,
+		logger:           logger,
 	}
 }
 
@@ -35,6 +43,9 @@ func (uc *RegistrarSaidaUseCase) Execute(
 	usuarioID uuid.UUID,
 	input dto.RegistrarSaidaRequest,
 ) (*dto.MovimentacaoResponse, error) {
+	ctx, span := common.StartSpan(ctx, "stock.RegistrarSaida")
+	defer span.End()
+
 	// 1. Buscar produto
 	produtoID, err := uuid.Parse(input.ProdutoID)
 	if err != nil {
@@ -58,6 +69,7 @@ func (uc *RegistrarSaidaUseCase) Execute(
 	}
 
 	// 4. Remover estoque (validação de quantidade é feita na entidade)
+	quantidadeAnterior := produto.QuantidadeAtual
 	if err := produto.RemoverEstoque(quantidade); err != nil {
 		return nil, fmt.Errorf("erro ao remover estoque: %w", err)
 	}
@@ -100,6 +112,8 @@ func (uc *RegistrarSaidaUseCase) Execute(
 		return nil, fmt.Errorf("erro ao registrar movimentação: %w", err)
 	}
 
+	common.PublishStockBelowMinimum(ctx, uc.events, uc.logger, produto, quantidadeAnterior)
+
 	// 9. Retornar resposta
 	return &dto.MovimentacaoResponse{
 		ID:            movimentacao.ID.String(),
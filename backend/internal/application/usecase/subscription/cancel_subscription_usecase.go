@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -36,6 +37,9 @@ func NewCancelSubscriptionUseCase(
 
 // Execute cancela assinatura
 func (uc *CancelSubscriptionUseCase) Execute(ctx context.Context, tenantID, subscriptionID, userID string) (*dto.SubscriptionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.CancelSubscription")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -65,13 +69,13 @@ func (uc *CancelSubscriptionUseCase) Execute(ctx context.Context, tenantID, subs
 	if sub.FormaPagamento == entity.PaymentMethodCartao && sub.AsaasSubscriptionID != nil && uc.asaasGateway != nil {
 		if err := uc.asaasGateway.CancelSubscription(ctx, *sub.AsaasSubscriptionID); err != nil {
 			// Log error but continue with local cancellation (soft fail)
-			uc.logger.Warn("failed to cancel subscription in Asaas, continuing with local cancellation",
+			common.Logger(ctx, uc.logger).Warn("failed to cancel subscription in Asaas, continuing with local cancellation",
 				zap.String("subscription_id", sub.ID.String()),
 				zap.String("asaas_subscription_id", *sub.AsaasSubscriptionID),
 				zap.Error(err),
 			)
 		} else {
-			uc.logger.Info("subscription canceled in Asaas",
+			common.Logger(ctx, uc.logger).Info("subscription canceled in Asaas",
 				zap.String("subscription_id", sub.ID.String()),
 				zap.String("asaas_subscription_id", *sub.AsaasSubscriptionID),
 			)
@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -42,6 +43,9 @@ func NewCreateSubscriptionUseCase(
 
 // Execute cria a assinatura
 func (uc *CreateSubscriptionUseCase) Execute(ctx context.Context, tenantID string, req dto.CreateSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.CreateSubscription")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -108,7 +112,7 @@ func (uc *CreateSubscriptionUseCase) Execute(ctx context.Context, tenantID strin
 			asaasResult, err := uc.integrateWithAsaas(ctx, sub, cliente, plano)
 			if err != nil {
 				// Log error but allow fallback to manual (AS-013)
-				uc.logger.Warn("asaas integration failed, subscription created without Asaas",
+				common.Logger(ctx, uc.logger).Warn("asaas integration failed, subscription created without Asaas",
 					zap.String("cliente_id", clienteUUID.String()),
 					zap.Error(err),
 				)
@@ -136,7 +140,7 @@ func (uc *CreateSubscriptionUseCase) Execute(ctx context.Context, tenantID strin
 
 	// Salvar
 	if err := uc.subRepo.Create(ctx, sub); err != nil {
-		uc.logger.Error("erro ao criar assinatura", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao criar assinatura", zap.Error(err))
 		return nil, err
 	}
 
@@ -184,14 +188,14 @@ func (uc *CreateSubscriptionUseCase) integrateWithAsaas(
 
 	customerResult, err := uc.asaasGateway.FindOrCreateCustomer(ctx, customerParams)
 	if err != nil {
-		uc.logger.Error("failed to find or create customer in Asaas",
+		common.Logger(ctx, uc.logger).Error("failed to find or create customer in Asaas",
 			zap.String("cliente_id", cliente.ID),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	uc.logger.Info("asaas customer ready",
+	common.Logger(ctx, uc.logger).Info("asaas customer ready",
 		zap.String("asaas_customer_id", customerResult.AsaasCustomerID),
 		zap.Bool("was_created", customerResult.WasCreated),
 	)
@@ -208,14 +212,14 @@ func (uc *CreateSubscriptionUseCase) integrateWithAsaas(
 
 	subscriptionResult, err := uc.asaasGateway.CreateSubscription(ctx, subscriptionParams)
 	if err != nil {
-		uc.logger.Error("failed to create subscription in Asaas",
+		common.Logger(ctx, uc.logger).Error("failed to create subscription in Asaas",
 			zap.String("asaas_customer_id", customerResult.AsaasCustomerID),
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
-	uc.logger.Info("asaas subscription created",
+	common.Logger(ctx, uc.logger).Info("asaas subscription created",
 		zap.String("asaas_subscription_id", subscriptionResult.AsaasSubscriptionID),
 		zap.String("payment_link", subscriptionResult.PaymentLink),
 	)
@@ -0,0 +1,31 @@
+package subscription
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// publishSubscriptionOverdue publica subscription.overdue. Origem indica quem
+// detectou: "vencimento" (job diário) ou "asaas" (webhook PAYMENT_OVERDUE).
+func publishSubscriptionOverdue(ctx context.Context, events port.EventPublisher, logger *zap.Logger, sub *entity.Subscription, origem string) {
+	data := map[string]any{
+		"subscription_id": sub.ID.String(),
+		"cliente_id":      sub.ClienteID.String(),
+		"plano_id":        sub.PlanoID.String(),
+		"valor":           sub.Valor.String(),
+		"forma_pagamento": string(sub.FormaPagamento),
+		"origem":          origem,
+	}
+	if sub.DataVencimento != nil {
+		data["data_vencimento"] = sub.DataVencimento.Format("2006-01-02")
+	}
+	common.PublishEvent(ctx, events, logger, port.DomainEvent{
+		Type:     port.EventSubscriptionOverdue,
+		TenantID: sub.TenantID.String(),
+		Data:     data,
+	})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -22,6 +23,9 @@ func NewGetSubscriptionMetricsUseCase(subRepo port.SubscriptionRepository) *GetS
 
 // Execute obtém métricas
 func (uc *GetSubscriptionMetricsUseCase) Execute(ctx context.Context, tenantID string) (*dto.SubscriptionMetricsResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.GetSubscriptionMetrics")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
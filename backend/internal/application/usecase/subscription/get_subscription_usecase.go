@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -22,6 +23,9 @@ func NewGetSubscriptionUseCase(subRepo port.SubscriptionRepository) *GetSubscrip
 
 // Execute retorna assinatura
 func (uc *GetSubscriptionUseCase) Execute(ctx context.Context, tenantID, subscriptionID string) (*dto.SubscriptionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.GetSubscription")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
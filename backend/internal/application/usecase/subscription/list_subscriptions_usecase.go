@@ -5,6 +5,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -23,6 +24,9 @@ func NewListSubscriptionsUseCase(subRepo port.SubscriptionRepository) *ListSubsc
 
 // Execute lista assinaturas, opcionalmente filtrando por status
 func (uc *ListSubscriptionsUseCase) Execute(ctx context.Context, tenantID string, status *string) ([]*dto.SubscriptionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.ListSubscriptions")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
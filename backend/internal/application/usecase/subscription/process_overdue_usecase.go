@@ -4,24 +4,31 @@ import (
 	"context"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 )
 
 // ProcessOverdueSubscriptionsUseCase marca assinaturas vencidas como inadimplentes
 type ProcessOverdueSubscriptionsUseCase struct {
 	subRepo port.SubscriptionRepository
+	events  port.EventPublisher
+	logger  *zap.Logger
 }
 
 // NewProcessOverdueSubscriptionsUseCase cria instância
-func NewProcessOverdueSubscriptionsUseCase(subRepo port.SubscriptionRepository) *ProcessOverdueSubscriptionsUseCase {
-	return &ProcessOverdueSubscriptionsUseCase{subRepo: subRepo}
+func NewProcessOverdueSubscriptionsUseCase(subRepo port.SubscriptionRepository, events port.EventPublisher, logger *zap.Logger) *ProcessOverdueSubscriptionsUseCase {
+	return &ProcessOverdueSubscriptionsUseCase{subRepo: subRepo, events: events, logger: logger}
 }
 
 // Execute processa assinaturas vencidas para um tenant, retorna quantidade atualizada
 func (uc *ProcessOverdueSubscriptionsUseCase) Execute(ctx context.Context, tenantID string) (int, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.ProcessOverdueSubscriptions")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return 0, domain.ErrInvalidTenantID
@@ -38,6 +45,7 @@ func (uc *ProcessOverdueSubscriptionsUseCase) Execute(ctx context.Context, tenan
 		if sub.ShouldBecomeInadimplente(now) {
 			if err := uc.subRepo.UpdateStatus(ctx, sub.ID, tenantUUID, entity.StatusInadimplente); err == nil {
 				updated++
+				publishSubscriptionOverdue(ctx, uc.events, uc.logger, sub, "vencimento")
 			}
 		}
 	}
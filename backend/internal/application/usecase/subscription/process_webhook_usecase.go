@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/gateway/asaas"
@@ -35,7 +36,10 @@ func NewProcessWebhookUseCase(
 
 // Execute processes the webhook event
 func (uc *ProcessWebhookUseCase) Execute(ctx context.Context, event asaas.WebhookEvent) error {
-	uc.logger.Info("processing webhook event",
+	ctx, span := common.StartSpan(ctx, "subscription.ProcessWebhook")
+	defer span.End()
+
+	common.Logger(ctx, uc.logger).Info("processing webhook event",
 		zap.String("event", event.Event),
 	)
 
@@ -56,7 +60,7 @@ func (uc *ProcessWebhookUseCase) Execute(ctx context.Context, event asaas.Webhoo
 		return uc.handlePaymentRefunded(ctx, event)
 
 	default:
-		uc.logger.Debug("ignoring webhook event",
+		common.Logger(ctx, uc.logger).Debug("ignoring webhook event",
 			zap.String("event", event.Event),
 		)
 		return nil
@@ -71,7 +75,7 @@ func (uc *ProcessWebhookUseCase) Execute(ctx context.Context, event asaas.Webhoo
 // - Mark client as is_subscriber = true (RN-CLI-003)
 func (uc *ProcessWebhookUseCase) handlePaymentConfirmed(ctx context.Context, event asaas.WebhookEvent) error {
 	if event.Payment == nil {
-		uc.logger.Warn("payment confirmed event without payment data")
+		common.Logger(ctx, uc.logger).Warn("payment confirmed event without payment data")
 		return nil
 	}
 
@@ -81,7 +85,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentConfirmed(ctx context.Context, eve
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for payment",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for payment",
 			zap.String("asaas_subscription_id", event.Payment.Subscription),
 			zap.String("payment_id", event.Payment.ID),
 		)
@@ -107,7 +111,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentConfirmed(ctx context.Context, eve
 	sub.ServicosUtilizados = 0 // Reset usage counter
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription after payment confirmed",
+		common.Logger(ctx, uc.logger).Error("failed to update subscription after payment confirmed",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -129,7 +133,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentConfirmed(ctx context.Context, eve
 	}
 
 	if err := uc.paymentRepo.Create(ctx, payment); err != nil {
-		uc.logger.Error("failed to register payment",
+		common.Logger(ctx, uc.logger).Error("failed to register payment",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -138,13 +142,13 @@ func (uc *ProcessWebhookUseCase) handlePaymentConfirmed(ctx context.Context, eve
 
 	// Mark client as subscriber (RN-CLI-003)
 	if err := uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, true); err != nil {
-		uc.logger.Error("failed to mark client as subscriber",
+		common.Logger(ctx, uc.logger).Error("failed to mark client as subscriber",
 			zap.String("cliente_id", sub.ClienteID.String()),
 			zap.Error(err),
 		)
 	}
 
-	uc.logger.Info("subscription activated via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription activated via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("status", string(sub.Status)),
 		zap.Time("activation_date", activationDate),
@@ -169,7 +173,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentOverdue(ctx context.Context, event
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for overdue payment",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for overdue payment",
 			zap.String("asaas_subscription_id", event.Payment.Subscription),
 		)
 		return nil
@@ -179,7 +183,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentOverdue(ctx context.Context, event
 	sub.Status = entity.StatusInadimplente
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription to inadimplente",
+		common.Logger(ctx, uc.logger).Error("failed to update subscription to inadimplente",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -190,14 +194,14 @@ func (uc *ProcessWebhookUseCase) handlePaymentOverdue(ctx context.Context, event
 	count, err := uc.subRepo.CountActiveSubscriptionsByCliente(ctx, sub.ClienteID, sub.TenantID)
 	if err == nil && count == 0 {
 		if err := uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false); err != nil {
-			uc.logger.Error("failed to remove subscriber flag",
+			common.Logger(ctx, uc.logger).Error("failed to remove subscriber flag",
 				zap.String("cliente_id", sub.ClienteID.String()),
 				zap.Error(err),
 			)
 		}
 	}
 
-	uc.logger.Info("subscription marked as inadimplente via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription marked as inadimplente via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 	)
 
@@ -219,7 +223,7 @@ func (uc *ProcessWebhookUseCase) handleSubscriptionCanceled(ctx context.Context,
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for cancel event",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for cancel event",
 			zap.String("asaas_subscription_id", event.Payment.Subscription),
 		)
 		return nil
@@ -231,7 +235,7 @@ func (uc *ProcessWebhookUseCase) handleSubscriptionCanceled(ctx context.Context,
 	sub.DataCancelamento = &now
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription to cancelado",
+		common.Logger(ctx, uc.logger).Error("failed to update subscription to cancelado",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -242,14 +246,14 @@ func (uc *ProcessWebhookUseCase) handleSubscriptionCanceled(ctx context.Context,
 	count, err := uc.subRepo.CountActiveSubscriptionsByCliente(ctx, sub.ClienteID, sub.TenantID)
 	if err == nil && count == 0 {
 		if err := uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false); err != nil {
-			uc.logger.Error("failed to remove subscriber flag",
+			common.Logger(ctx, uc.logger).Error("failed to remove subscriber flag",
 				zap.String("cliente_id", sub.ClienteID.String()),
 				zap.Error(err),
 			)
 		}
 	}
 
-	uc.logger.Info("subscription canceled via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription canceled via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 	)
 
@@ -270,7 +274,7 @@ func (uc *ProcessWebhookUseCase) handleSubscriptionRenewed(ctx context.Context,
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for renewal event",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for renewal event",
 			zap.String("asaas_subscription_id", event.Payment.Subscription),
 		)
 		return nil
@@ -296,14 +300,14 @@ func (uc *ProcessWebhookUseCase) handleSubscriptionRenewed(ctx context.Context,
 	sub.Status = entity.StatusAtivo
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription after renewal",
+		common.Logger(ctx, uc.logger).Error("failed to update subscription after renewal",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
 		return err
 	}
 
-	uc.logger.Info("subscription renewed via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription renewed via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.Time("new_due_date", newDueDate),
 	)
@@ -325,7 +329,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentRefunded(ctx context.Context, even
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for refund event",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for refund event",
 			zap.String("asaas_subscription_id", event.Payment.Subscription),
 		)
 		return nil
@@ -335,7 +339,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentRefunded(ctx context.Context, even
 	sub.Status = entity.StatusInativo
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription after refund",
+		common.Logger(ctx, uc.logger).Error("failed to update subscription after refund",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -357,7 +361,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentRefunded(ctx context.Context, even
 	}
 
 	if err := uc.paymentRepo.Create(ctx, refundPayment); err != nil {
-		uc.logger.Error("failed to register refund payment",
+		common.Logger(ctx, uc.logger).Error("failed to register refund payment",
 			zap.String("subscription_id", sub.ID.String()),
 			zap.Error(err),
 		)
@@ -369,7 +373,7 @@ func (uc *ProcessWebhookUseCase) handlePaymentRefunded(ctx context.Context, even
 		_ = uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false)
 	}
 
-	uc.logger.Info("subscription refund processed via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription refund processed via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 	)
 
@@ -7,6 +7,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
@@ -29,6 +30,7 @@ type ProcessWebhookUseCaseV2 struct {
 	contaReceberRepo port.ContaReceberRepository
 	webhookLogRepo   port.AsaasWebhookLogRepository
 	caixaRepo        port.CaixaDiarioRepository // T-ASAAS-001: Lançar no caixa
+	events           port.EventPublisher
 	logger           *zap.Logger
 }
 
@@ -39,6 +41,7 @@ func NewProcessWebhookUseCaseV2(
 	contaReceberRepo port.ContaReceberRepository,
 	webhookLogRepo port.AsaasWebhookLogRepository,
 	caixaRepo port.CaixaDiarioRepository,
+	events port.EventPublisher,
 	logger *zap.Logger,
 ) *ProcessWebhookUseCaseV2 {
 	return &ProcessWebhookUseCaseV2{
@@ -47,13 +50,17 @@ func NewProcessWebhookUseCaseV2(
 		contaReceberRepo: contaReceberRepo,
 		webhookLogRepo:   webhookLogRepo,
 		caixaRepo:        caixaRepo,
+		events:           events,
 		logger:           logger,
 	}
 }
 
 // Execute processa o webhook com logging e idempotência
 func (uc *ProcessWebhookUseCaseV2) Execute(ctx context.Context, event asaas.WebhookEvent, rawPayload []byte, clientIP net.IP) error {
-	uc.logger.Info("processing webhook event v2",
+	ctx, span := common.StartSpan(ctx, "subscription.ProcessWebhookV2")
+	defer span.End()
+
+	common.Logger(ctx, uc.logger).Info("processing webhook event v2",
 		zap.String("event", event.Event),
 	)
 
@@ -63,7 +70,7 @@ func (uc *ProcessWebhookUseCaseV2) Execute(ctx context.Context, event asaas.Webh
 		return err
 	}
 	if sub == nil {
-		uc.logger.Warn("subscription not found for webhook",
+		common.Logger(ctx, uc.logger).Warn("subscription not found for webhook",
 			zap.String("event", event.Event),
 			zap.String("asaas_subscription_id", getSubscriptionIDFromEvent(event)),
 		)
@@ -74,7 +81,7 @@ func (uc *ProcessWebhookUseCaseV2) Execute(ctx context.Context, event asaas.Webh
 	webhookLog := uc.createWebhookLog(sub.TenantID, event, rawPayload, clientIP)
 	if uc.webhookLogRepo != nil {
 		if err := uc.webhookLogRepo.Create(ctx, webhookLog); err != nil {
-			uc.logger.Error("failed to log webhook", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("failed to log webhook", zap.Error(err))
 			// Não falha - log é auxiliar
 		}
 	}
@@ -104,7 +111,7 @@ func (uc *ProcessWebhookUseCaseV2) Execute(ctx context.Context, event asaas.Webh
 		processErr = uc.handleSubscriptionRenewed(ctx, sub, event)
 
 	default:
-		uc.logger.Debug("ignoring webhook event", zap.String("event", event.Event))
+		common.Logger(ctx, uc.logger).Debug("ignoring webhook event", zap.String("event", event.Event))
 	}
 
 	// 4. Atualizar status do log
@@ -155,11 +162,11 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentCreated(ctx context.Context, sub
 
 	// Upsert para idempotência
 	if err := uc.paymentRepo.UpsertByAsaasID(ctx, payment); err != nil {
-		uc.logger.Error("failed to create payment record", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to create payment record", zap.Error(err))
 		return err
 	}
 
-	uc.logger.Info("payment created via webhook",
+	common.Logger(ctx, uc.logger).Info("payment created via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("payment_id", event.Payment.ID),
 	)
@@ -222,7 +229,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentConfirmed(ctx context.Context, s
 	}
 
 	if err := uc.paymentRepo.UpsertByAsaasID(ctx, payment); err != nil {
-		uc.logger.Error("failed to update payment to confirmed", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update payment to confirmed", zap.Error(err))
 		return err
 	}
 
@@ -253,7 +260,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentConfirmed(ctx context.Context, s
 		}
 
 		if err := uc.contaReceberRepo.UpsertByAsaasPaymentID(ctx, conta); err != nil {
-			uc.logger.Error("failed to create conta a receber", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("failed to create conta a receber", zap.Error(err))
 			// Não falha - conta a receber é complementar
 		}
 	}
@@ -267,16 +274,16 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentConfirmed(ctx context.Context, s
 	sub.ServicosUtilizados = 0
 
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription after confirmed", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update subscription after confirmed", zap.Error(err))
 		return err
 	}
 
 	// 4. Marcar cliente como assinante
 	if err := uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, true); err != nil {
-		uc.logger.Error("failed to mark client as subscriber", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to mark client as subscriber", zap.Error(err))
 	}
 
-	uc.logger.Info("payment confirmed via webhook",
+	common.Logger(ctx, uc.logger).Info("payment confirmed via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("payment_id", event.Payment.ID),
 		zap.Time("confirmed_date", confirmedDate),
@@ -339,7 +346,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 	}
 
 	if err := uc.paymentRepo.UpsertByAsaasID(ctx, payment); err != nil {
-		uc.logger.Error("failed to update payment to received", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update payment to received", zap.Error(err))
 		return err
 	}
 
@@ -347,7 +354,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 	if uc.contaReceberRepo != nil {
 		conta, err := uc.contaReceberRepo.GetByAsaasPaymentID(ctx, sub.TenantID.String(), event.Payment.ID)
 		if err != nil {
-			uc.logger.Warn("conta a receber not found for payment", zap.String("payment_id", event.Payment.ID))
+			common.Logger(ctx, uc.logger).Warn("conta a receber not found for payment", zap.String("payment_id", event.Payment.ID))
 		} else if conta != nil {
 			conta.Status = valueobject.StatusContaRecebido
 			conta.ValorPago = conta.Valor
@@ -357,7 +364,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 			conta.AtualizadoEm = time.Now()
 
 			if err := uc.contaReceberRepo.Update(ctx, conta); err != nil {
-				uc.logger.Error("failed to update conta a receber", zap.Error(err))
+				common.Logger(ctx, uc.logger).Error("failed to update conta a receber", zap.Error(err))
 			}
 		}
 	}
@@ -367,7 +374,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 		// Buscar caixa aberto do dia
 		caixaAberto, err := uc.caixaRepo.FindAberto(ctx, sub.TenantID)
 		if err != nil {
-			uc.logger.Warn("erro ao buscar caixa aberto para pagamento Asaas",
+			common.Logger(ctx, uc.logger).Warn("erro ao buscar caixa aberto para pagamento Asaas",
 				zap.String("tenant_id", sub.TenantID.String()),
 				zap.Error(err))
 		} else if caixaAberto != nil {
@@ -377,15 +384,20 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 
 			// Identificar tipo de pagamento
 			var tipoOperacao string
+			var formaPagamento entity.TipoPagamento
 			switch event.Payment.BillingType {
 			case "PIX":
 				tipoOperacao = "PIX"
+				formaPagamento = entity.TipoPagamentoPIX
 			case "BOLETO":
 				tipoOperacao = "BOLETO"
+				formaPagamento = entity.TipoPagamentoBoleto
 			case "CREDIT_CARD":
 				tipoOperacao = "CARTAO_CREDITO"
+				formaPagamento = entity.TipoPagamentoCredito
 			default:
 				tipoOperacao = "ASAAS"
+				formaPagamento = entity.TipoPagamentoOutro
 			}
 
 			operacao, err := entity.NewOperacaoVenda(
@@ -394,34 +406,35 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentReceived(ctx context.Context, su
 				uuid.Nil, // UserID do sistema (webhook)
 				valor,
 				fmt.Sprintf("%s - %s", tipoOperacao, descricao),
+				formaPagamento,
 			)
 			if err != nil {
-				uc.logger.Error("erro ao criar operação de caixa para webhook Asaas", zap.Error(err))
+				common.Logger(ctx, uc.logger).Error("erro ao criar operação de caixa para webhook Asaas", zap.Error(err))
 			} else {
 				if err := uc.caixaRepo.CreateOperacao(ctx, operacao); err != nil {
-					uc.logger.Error("erro ao registrar operação no caixa", zap.Error(err))
+					common.Logger(ctx, uc.logger).Error("erro ao registrar operação no caixa", zap.Error(err))
 				} else {
 					// Atualizar totais do caixa
 					novoTotal := caixaAberto.TotalEntradas.Add(valor)
 					caixaAberto.TotalEntradas = novoTotal
 					if err := uc.caixaRepo.UpdateTotais(ctx, caixaAberto.ID, sub.TenantID, caixaAberto.TotalSangrias, caixaAberto.TotalReforcos, novoTotal); err != nil {
-						uc.logger.Error("erro ao atualizar totais do caixa", zap.Error(err))
+						common.Logger(ctx, uc.logger).Error("erro ao atualizar totais do caixa", zap.Error(err))
 					}
 
-					uc.logger.Info("pagamento Asaas lançado no caixa",
+					common.Logger(ctx, uc.logger).Info("pagamento Asaas lançado no caixa",
 						zap.String("operacao_id", operacao.ID.String()),
 						zap.String("valor", valor.String()),
 						zap.String("tipo", tipoOperacao))
 				}
 			}
 		} else {
-			uc.logger.Warn("nenhum caixa aberto para receber pagamento Asaas",
+			common.Logger(ctx, uc.logger).Warn("nenhum caixa aberto para receber pagamento Asaas",
 				zap.String("tenant_id", sub.TenantID.String()),
 				zap.String("payment_id", event.Payment.ID))
 		}
 	}
 
-	uc.logger.Info("payment received via webhook",
+	common.Logger(ctx, uc.logger).Info("payment received via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("payment_id", event.Payment.ID),
 		zap.Time("credit_date", creditDate),
@@ -455,25 +468,29 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentOverdue(ctx context.Context, sub
 	}
 
 	if err := uc.paymentRepo.UpsertByAsaasID(ctx, payment); err != nil {
-		uc.logger.Error("failed to update payment to overdue", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update payment to overdue", zap.Error(err))
 	}
 
 	// 2. Atualizar subscription para INADIMPLENTE
+	jaInadimplente := sub.Status == entity.StatusInadimplente
 	sub.Status = entity.StatusInadimplente
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription to inadimplente", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update subscription to inadimplente", zap.Error(err))
 		return err
 	}
+	if !jaInadimplente {
+		publishSubscriptionOverdue(ctx, uc.events, uc.logger, sub, "asaas")
+	}
 
 	// 3. Verificar se cliente tem outras assinaturas ativas
 	count, err := uc.subRepo.CountActiveSubscriptionsByCliente(ctx, sub.ClienteID, sub.TenantID)
 	if err == nil && count == 0 {
 		if err := uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false); err != nil {
-			uc.logger.Error("failed to remove subscriber flag", zap.Error(err))
+			common.Logger(ctx, uc.logger).Error("failed to remove subscriber flag", zap.Error(err))
 		}
 	}
 
-	uc.logger.Info("payment marked as overdue via webhook",
+	common.Logger(ctx, uc.logger).Info("payment marked as overdue via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("payment_id", event.Payment.ID),
 	)
@@ -508,13 +525,13 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentRefunded(ctx context.Context, su
 	}
 
 	if err := uc.paymentRepo.UpsertByAsaasID(ctx, payment); err != nil {
-		uc.logger.Error("failed to update payment to refunded", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update payment to refunded", zap.Error(err))
 	}
 
 	// 2. Atualizar subscription para INATIVO
 	sub.Status = entity.StatusInativo
 	if err := uc.subRepo.Update(ctx, sub); err != nil {
-		uc.logger.Error("failed to update subscription after refund", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("failed to update subscription after refund", zap.Error(err))
 		return err
 	}
 
@@ -534,7 +551,7 @@ func (uc *ProcessWebhookUseCaseV2) handlePaymentRefunded(ctx context.Context, su
 		_ = uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false)
 	}
 
-	uc.logger.Info("payment refunded via webhook",
+	common.Logger(ctx, uc.logger).Info("payment refunded via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.String("payment_id", event.Payment.ID),
 	)
@@ -558,7 +575,7 @@ func (uc *ProcessWebhookUseCaseV2) handleSubscriptionCanceled(ctx context.Contex
 		_ = uc.subRepo.SetClienteAsSubscriber(ctx, sub.ClienteID, sub.TenantID, false)
 	}
 
-	uc.logger.Info("subscription canceled via webhook", zap.String("subscription_id", sub.ID.String()))
+	common.Logger(ctx, uc.logger).Info("subscription canceled via webhook", zap.String("subscription_id", sub.ID.String()))
 	return nil
 }
 
@@ -586,7 +603,7 @@ func (uc *ProcessWebhookUseCaseV2) handleSubscriptionRenewed(ctx context.Context
 		return err
 	}
 
-	uc.logger.Info("subscription renewed via webhook",
+	common.Logger(ctx, uc.logger).Info("subscription renewed via webhook",
 		zap.String("subscription_id", sub.ID.String()),
 		zap.Time("new_due_date", newDueDate),
 	)
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -67,6 +68,9 @@ func NewReconcileAsaasUseCase(
 
 // Execute executa a conciliação entre Asaas e NEXO
 func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsaasInput) (*ReconcileAsaasOutput, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.ReconcileAsaas")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -89,11 +93,11 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 	unprocessedWebhooks, err := uc.webhookLogRepo.ListUnprocessed(ctx, input.TenantID, 1000)
 	if err != nil {
 		output.Errors = append(output.Errors, fmt.Sprintf("erro ao listar webhooks não processados: %v", err))
-		uc.logger.Error("erro ao listar webhooks não processados", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar webhooks não processados", zap.Error(err))
 	} else {
 		for _, wh := range unprocessedWebhooks {
 			output.TotalProcessed++
-			uc.logger.Warn("webhook não processado encontrado",
+			common.Logger(ctx, uc.logger).Warn("webhook não processado encontrado",
 				zap.String("webhook_id", wh.ID.String()),
 				zap.String("event_type", wh.EventType),
 			)
@@ -105,7 +109,7 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 	paymentsToReconcile, err := uc.subscriptionRepo.ListNeedingReconciliation(ctx, input.TenantID)
 	if err != nil {
 		output.Errors = append(output.Errors, fmt.Sprintf("erro ao listar pagamentos para conciliação: %v", err))
-		uc.logger.Error("erro ao listar pagamentos para conciliação", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar pagamentos para conciliação", zap.Error(err))
 	} else {
 		for _, payment := range paymentsToReconcile {
 			output.TotalProcessed++
@@ -124,20 +128,20 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 				if input.AutoFix {
 					if createErr := uc.createContaReceberFromPayment(ctx, input.TenantID, payment); createErr != nil {
 						output.Errors = append(output.Errors, fmt.Sprintf("erro ao criar conta para payment %s: %v", *payment.AsaasPaymentID, createErr))
-						uc.logger.Error("erro ao criar conta a receber automaticamente",
+						common.Logger(ctx, uc.logger).Error("erro ao criar conta a receber automaticamente",
 							zap.String("payment_id", *payment.AsaasPaymentID),
 							zap.Error(createErr),
 						)
 					} else {
 						output.TotalAutoFixed++
-						uc.logger.Info("conta a receber criada automaticamente",
+						common.Logger(ctx, uc.logger).Info("conta a receber criada automaticamente",
 							zap.String("payment_id", *payment.AsaasPaymentID),
 							zap.String("valor", payment.Valor.String()),
 						)
 					}
 				} else {
 					output.Errors = append(output.Errors, fmt.Sprintf("conta não encontrada para payment %s", *payment.AsaasPaymentID))
-					uc.logger.Warn("conta a receber não encontrada para payment",
+					common.Logger(ctx, uc.logger).Warn("conta a receber não encontrada para payment",
 						zap.String("payment_id", *payment.AsaasPaymentID),
 						zap.String("status", string(payment.Status)),
 					)
@@ -165,7 +169,7 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 	contasOrfas, err := uc.listContasAssinaturaOrfas(ctx, input.TenantID)
 	if err != nil {
 		output.Errors = append(output.Errors, fmt.Sprintf("erro ao listar contas órfãs: %v", err))
-		uc.logger.Error("erro ao listar contas órfãs", zap.Error(err))
+		common.Logger(ctx, uc.logger).Error("erro ao listar contas órfãs", zap.Error(err))
 	} else {
 		output.TotalMissingAsaas = len(contasOrfas)
 		for _, conta := range contasOrfas {
@@ -173,7 +177,7 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 			if conta.AsaasPaymentID != nil {
 				asaasID = *conta.AsaasPaymentID
 			}
-			uc.logger.Warn("conta a receber sem payment correspondente",
+			common.Logger(ctx, uc.logger).Warn("conta a receber sem payment correspondente",
 				zap.String("conta_id", conta.ID),
 				zap.String("asaas_payment_id", asaasID),
 			)
@@ -184,7 +188,7 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 	if uc.reconciliationRepo != nil {
 		tenantUUID, err := uuid.Parse(input.TenantID)
 		if err != nil {
-			uc.logger.Warn("tenant_id inválido, não foi possível criar log de conciliação", zap.Error(err))
+			common.Logger(ctx, uc.logger).Warn("tenant_id inválido, não foi possível criar log de conciliação", zap.Error(err))
 		} else {
 			reconciliationLog := entity.NewAsaasReconciliationLog(tenantUUID, periodStart, periodEnd)
 
@@ -205,14 +209,14 @@ func (uc *ReconcileAsaasUseCase) Execute(ctx context.Context, input ReconcileAsa
 			)
 
 			if err := uc.reconciliationRepo.Create(ctx, reconciliationLog); err != nil {
-				uc.logger.Warn("erro ao criar log de conciliação", zap.Error(err))
+				common.Logger(ctx, uc.logger).Warn("erro ao criar log de conciliação", zap.Error(err))
 			} else {
 				output.ReconciliationID = reconciliationLog.ID
 			}
 		}
 	}
 
-	uc.logger.Info("Conciliação Asaas concluída",
+	common.Logger(ctx, uc.logger).Info("Conciliação Asaas concluída",
 		zap.String("tenant_id", input.TenantID),
 		zap.Int("total_processed", output.TotalProcessed),
 		zap.Int("total_matched", output.TotalMatched),
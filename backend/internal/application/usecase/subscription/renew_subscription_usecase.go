@@ -6,6 +6,7 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -24,6 +25,9 @@ func NewRenewSubscriptionUseCase(subRepo port.SubscriptionRepository) *RenewSubs
 
 // Execute renova assinatura manual
 func (uc *RenewSubscriptionUseCase) Execute(ctx context.Context, tenantID, subscriptionID string, req dto.RenewSubscriptionRequest) (*dto.SubscriptionResponse, error) {
+	ctx, span := common.StartSpan(ctx, "subscription.RenewSubscription")
+	defer span.End()
+
 	tenantUUID, err := uuid.Parse(tenantID)
 	if err != nil {
 		return nil, domain.ErrInvalidTenantID
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -78,6 +79,9 @@ func NewCreateUnitUseCase(unitRepo port.UnitRepository, userUnitRepo port.UserUn
 
 // Execute executa o caso de uso
 func (uc *CreateUnitUseCase) Execute(ctx context.Context, input CreateUnitInput) (*UnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.CreateUnit")
+	defer span.End()
+
 	// Verificar se já existe unidade com mesmo nome
 	existing, err := uc.unitRepo.FindByName(ctx, input.TenantID, input.Nome)
 	if err != nil {
@@ -148,6 +152,9 @@ func NewListUnitsUseCase(unitRepo port.UnitRepository) *ListUnitsUseCase {
 
 // Execute executa o caso de uso
 func (uc *ListUnitsUseCase) Execute(ctx context.Context, tenantID uuid.UUID, onlyActive bool) ([]UnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.ListUnits")
+	defer span.End()
+
 	var units []*entity.Unit
 	var err error
 
@@ -185,6 +192,9 @@ func NewGetUnitUseCase(unitRepo port.UnitRepository) *GetUnitUseCase {
 
 // Execute executa o caso de uso
 func (uc *GetUnitUseCase) Execute(ctx context.Context, unitID, tenantID uuid.UUID) (*UnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.GetUnit")
+	defer span.End()
+
 	unit, err := uc.unitRepo.FindByID(ctx, tenantID, unitID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao buscar unidade: %w", err)
@@ -212,6 +222,9 @@ func NewUpdateUnitUseCase(unitRepo port.UnitRepository) *UpdateUnitUseCase {
 
 // Execute executa o caso de uso
 func (uc *UpdateUnitUseCase) Execute(ctx context.Context, input UpdateUnitInput) (*UnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.UpdateUnit")
+	defer span.End()
+
 	// Buscar unidade existente
 	unit, err := uc.unitRepo.FindByID(ctx, input.TenantID, input.ID)
 	if err != nil {
@@ -291,6 +304,9 @@ func NewToggleUnitUseCase(unitRepo port.UnitRepository) *ToggleUnitUseCase {
 
 // Execute executa o caso de uso
 func (uc *ToggleUnitUseCase) Execute(ctx context.Context, unitID, tenantID uuid.UUID) (*UnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.ToggleUnit")
+	defer span.End()
+
 	unit, err := uc.unitRepo.ToggleStatus(ctx, tenantID, unitID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao alternar status: %w", err)
@@ -319,6 +335,9 @@ func NewDeleteUnitUseCase(unitRepo port.UnitRepository, userUnitRepo port.UserUn
 
 // Execute executa o caso de uso
 func (uc *DeleteUnitUseCase) Execute(ctx context.Context, unitID, tenantID uuid.UUID) error {
+	ctx, span := common.StartSpan(ctx, "unit.DeleteUnit")
+	defer span.End()
+
 	// Buscar unidade
 	unit, err := uc.unitRepo.FindByID(ctx, tenantID, unitID)
 	if err != nil {
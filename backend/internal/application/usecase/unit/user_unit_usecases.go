@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"github.com/google/uuid"
@@ -50,6 +51,9 @@ func NewListUserUnitsUseCase(userUnitRepo port.UserUnitRepository) *ListUserUnit
 
 // Execute executa o caso de uso
 func (uc *ListUserUnitsUseCase) Execute(ctx context.Context, userID uuid.UUID) ([]entity.UserUnitWithDetails, error) {
+	ctx, span := common.StartSpan(ctx, "unit.ListUserUnits")
+	defer span.End()
+
 	units, err := uc.userUnitRepo.ListByUser(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao listar unidades: %w", err)
@@ -79,6 +83,9 @@ func NewGetUserDefaultUnitUseCase(userUnitRepo port.UserUnitRepository) *GetUser
 
 // Execute executa o caso de uso
 func (uc *GetUserDefaultUnitUseCase) Execute(ctx context.Context, userID uuid.UUID) (*UserUnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.GetUserDefaultUnit")
+	defer span.End()
+
 	uu, err := uc.userUnitRepo.FindUserDefaultUnit(ctx, userID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao buscar unidade padrão: %w", err)
@@ -110,6 +117,9 @@ func NewSwitchUnitUseCase(userUnitRepo port.UserUnitRepository, unitRepo port.Un
 
 // Execute executa o caso de uso - retorna dados para novo token
 func (uc *SwitchUnitUseCase) Execute(ctx context.Context, input SwitchUnitInput) (*UserUnitOutput, error) {
+	ctx, span := common.StartSpan(ctx, "unit.SwitchUnit")
+	defer span.End()
+
 	// Verificar se usuário tem acesso à unidade
 	hasAccess, err := uc.userUnitRepo.CheckAccess(ctx, input.UserID, input.UnitID)
 	if err != nil {
@@ -164,6 +174,9 @@ func NewSetDefaultUnitUseCase(userUnitRepo port.UserUnitRepository) *SetDefaultU
 
 // Execute executa o caso de uso
 func (uc *SetDefaultUnitUseCase) Execute(ctx context.Context, userID, unitID uuid.UUID) error {
+	ctx, span := common.StartSpan(ctx, "unit.SetDefaultUnit")
+	defer span.End()
+
 	// Verificar se usuário tem acesso à unidade
 	hasAccess, err := uc.userUnitRepo.CheckAccess(ctx, userID, unitID)
 	if err != nil {
@@ -210,6 +223,9 @@ func NewAddUserToUnitUseCase(userUnitRepo port.UserUnitRepository, unitRepo port
 
 // Execute executa o caso de uso
 func (uc *AddUserToUnitUseCase) Execute(ctx context.Context, input AddUserToUnitInput) (*entity.UserUnit, error) {
+	ctx, span := common.StartSpan(ctx, "unit.AddUserToUnit")
+	defer span.End()
+
 	// Verificar se unidade existe e pertence ao tenant
 	unit, err := uc.unitRepo.FindByID(ctx, input.TenantID, input.UnitID)
 	if err != nil {
@@ -257,6 +273,9 @@ func NewUnlinkUserFromUnitUseCase(userUnitRepo port.UserUnitRepository) *UnlinkU
 
 // Execute executa o caso de uso
 func (uc *UnlinkUserFromUnitUseCase) Execute(ctx context.Context, userID, unitID uuid.UUID) error {
+	ctx, span := common.StartSpan(ctx, "unit.UnlinkUserFromUnit")
+	defer span.End()
+
 	if err := uc.userUnitRepo.Delete(ctx, userID, unitID); err != nil {
 		return fmt.Errorf("erro ao remover vínculo: %w", err)
 	}
@@ -279,6 +298,9 @@ func NewCheckUserAccessToUnitUseCase(userUnitRepo port.UserUnitRepository) *Chec
 
 // Execute executa o caso de uso
 func (uc *CheckUserAccessToUnitUseCase) Execute(ctx context.Context, userID, unitID uuid.UUID) (bool, error) {
+	ctx, span := common.StartSpan(ctx, "unit.CheckUserAccessToUnit")
+	defer span.End()
+
 	return uc.userUnitRepo.CheckAccess(ctx, userID, unitID)
 }
 
@@ -298,6 +320,9 @@ func NewGetDefaultUnitUseCase(userUnitRepo port.UserUnitRepository) *GetDefaultU
 
 // Execute executa o caso de uso
 func (uc *GetDefaultUnitUseCase) Execute(ctx context.Context, userID uuid.UUID) (*entity.UserUnitWithDetails, error) {
+	ctx, span := common.StartSpan(ctx, "unit.GetDefaultUnit")
+	defer span.End()
+
 	return uc.userUnitRepo.FindUserDefaultUnit(ctx, userID)
 }
 
@@ -317,6 +342,9 @@ func NewListUnitUsersUseCase(userUnitRepo port.UserUnitRepository) *ListUnitUser
 
 // Execute executa o caso de uso
 func (uc *ListUnitUsersUseCase) Execute(ctx context.Context, unitID uuid.UUID) ([]entity.UserUnitWithDetails, error) {
+	ctx, span := common.StartSpan(ctx, "unit.ListUnitUsers")
+	defer span.End()
+
 	users, err := uc.userUnitRepo.ListByUnit(ctx, unitID)
 	if err != nil {
 		return nil, fmt.Errorf("erro ao listar usuários: %w", err)
@@ -354,6 +382,9 @@ func NewLinkUserToUnitUseCase(userUnitRepo port.UserUnitRepository) *LinkUserToU
 
 // Execute executa o caso de uso
 func (uc *LinkUserToUnitUseCase) Execute(ctx context.Context, input LinkUserToUnitInput) (*entity.UserUnit, error) {
+	ctx, span := common.StartSpan(ctx, "unit.LinkUserToUnit")
+	defer span.End()
+
 	// Verificar se vínculo já existe
 	existing, err := uc.userUnitRepo.FindByUserAndUnit(ctx, input.UserID, input.UnitID)
 	if err != nil {
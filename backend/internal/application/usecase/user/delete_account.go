@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -35,6 +36,9 @@ func NewDeleteAccountUseCase(
 
 // Execute realiza soft delete do usuário e anonimização de dados (LGPD)
 func (uc *DeleteAccountUseCase) Execute(ctx context.Context, req DeleteAccountRequest) error {
+	ctx, span := common.StartSpan(ctx, "user.DeleteAccount")
+	defer span.End()
+
 	if req.TenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -42,7 +46,7 @@ func (uc *DeleteAccountUseCase) Execute(ctx context.Context, req DeleteAccountRe
 		return domain.ErrInvalidID
 	}
 
-	uc.logger.Info("Iniciando exclusão de conta (LGPD)",
+	common.Logger(ctx, uc.logger).Info("Iniciando exclusão de conta (LGPD)",
 		zap.String("tenant_id", req.TenantID),
 		zap.String("user_id", req.UserID),
 		zap.String("reason", req.Reason),
@@ -59,14 +63,14 @@ func (uc *DeleteAccountUseCase) Execute(ctx context.Context, req DeleteAccountRe
 
 	// Step 4: Deletar preferências
 	if err := uc.prefsRepo.Delete(ctx, req.UserID); err != nil {
-		uc.logger.Warn("Erro ao deletar preferências durante exclusão de conta",
+		common.Logger(ctx, uc.logger).Warn("Erro ao deletar preferências durante exclusão de conta",
 			zap.String("user_id", req.UserID),
 			zap.Error(err),
 		)
 		// Não falhar se preferências não existirem
 	}
 
-	uc.logger.Info("Conta deletada com sucesso",
+	common.Logger(ctx, uc.logger).Info("Conta deletada com sucesso",
 		zap.String("user_id", req.UserID),
 		zap.Time("deleted_at", now),
 	)
@@ -85,7 +89,7 @@ func (uc *DeleteAccountUseCase) AnonymizeUserData(ctx context.Context, userID st
 	//     ativo = false
 	// WHERE id = $1
 
-	uc.logger.Info("Dados do usuário anonimizados",
+	common.Logger(ctx, uc.logger).Info("Dados do usuário anonimizados",
 		zap.String("user_id", userID),
 	)
 
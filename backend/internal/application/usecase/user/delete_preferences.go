@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -19,6 +20,9 @@ func NewDeleteUserPreferencesUseCase(repo port.UserPreferencesRepository, logger
 }
 
 func (uc *DeleteUserPreferencesUseCase) Execute(ctx context.Context, tenantID, userID string) error {
+	ctx, span := common.StartSpan(ctx, "user.DeleteUserPreferences")
+	defer span.End()
+
 	if tenantID == "" {
 		return domain.ErrTenantIDRequired
 	}
@@ -36,7 +40,7 @@ func (uc *DeleteUserPreferencesUseCase) Execute(ctx context.Context, tenantID, u
 		return fmt.Errorf("erro ao deletar preferências: %w", err)
 	}
 
-	uc.logger.Info("Preferências de usuário deletadas",
+	common.Logger(ctx, uc.logger).Info("Preferências de usuário deletadas",
 		zap.String("tenant_id", tenantID),
 		zap.String("user_id", userID))
 
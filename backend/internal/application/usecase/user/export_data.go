@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
 	"go.uber.org/zap"
@@ -71,6 +72,9 @@ func NewExportDataUseCase(
 
 // Execute exporta todos os dados pessoais do usuário
 func (uc *ExportDataUseCase) Execute(ctx context.Context, tenantID, userID string) (*ExportDataResponse, error) {
+	ctx, span := common.StartSpan(ctx, "user.ExportData")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -78,7 +82,7 @@ func (uc *ExportDataUseCase) Execute(ctx context.Context, tenantID, userID strin
 		return nil, domain.ErrInvalidID
 	}
 
-	uc.logger.Info("Exportando dados do usuário (LGPD)",
+	common.Logger(ctx, uc.logger).Info("Exportando dados do usuário (LGPD)",
 		zap.String("tenant_id", tenantID),
 		zap.String("user_id", userID),
 	)
@@ -86,7 +90,7 @@ func (uc *ExportDataUseCase) Execute(ctx context.Context, tenantID, userID strin
 	// Buscar preferências
 	prefs, err := uc.prefsRepo.FindByUserID(ctx, userID)
 	if err != nil {
-		uc.logger.Error("Erro ao buscar preferências para export",
+		common.Logger(ctx, uc.logger).Error("Erro ao buscar preferências para export",
 			zap.String("user_id", userID),
 			zap.Error(err),
 		)
@@ -127,7 +131,7 @@ func (uc *ExportDataUseCase) Execute(ctx context.Context, tenantID, userID strin
 	// TODO: Buscar dados do tenant (tenants table)
 	// TODO: Buscar audit logs (últimos 90 dias)
 
-	uc.logger.Info("Exportação de dados concluída",
+	common.Logger(ctx, uc.logger).Info("Exportação de dados concluída",
 		zap.String("user_id", userID),
 		zap.Time("exported_at", response.ExportedAt),
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -20,6 +21,9 @@ func NewGetUserPreferencesUseCase(repo port.UserPreferencesRepository, logger *z
 }
 
 func (uc *GetUserPreferencesUseCase) Execute(ctx context.Context, tenantID, userID string) (*entity.UserPreferences, error) {
+	ctx, span := common.StartSpan(ctx, "user.GetUserPreferences")
+	defer span.End()
+
 	if tenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
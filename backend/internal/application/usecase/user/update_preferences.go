@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
@@ -26,6 +27,9 @@ func NewUpdateUserPreferencesUseCase(repo port.UserPreferencesRepository, logger
 }
 
 func (uc *UpdateUserPreferencesUseCase) Execute(ctx context.Context, input UpdateUserPreferencesInput) (*entity.UserPreferences, error) {
+	ctx, span := common.StartSpan(ctx, "user.UpdateUserPreferences")
+	defer span.End()
+
 	if input.TenantID == "" {
 		return nil, domain.ErrTenantIDRequired
 	}
@@ -44,7 +48,7 @@ func (uc *UpdateUserPreferencesUseCase) Execute(ctx context.Context, input Updat
 		return nil, fmt.Errorf("erro ao atualizar preferências: %w", err)
 	}
 
-	uc.logger.Info("Preferências de usuário atualizadas",
+	common.Logger(ctx, uc.logger).Info("Preferências de usuário atualizadas",
 		zap.String("tenant_id", input.TenantID),
 		zap.String("user_id", input.UserID))
 
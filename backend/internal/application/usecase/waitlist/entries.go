@@ -0,0 +1,230 @@
+// Package waitlist contém os use cases da lista de espera: clientes que
+// aguardam horário recebem, por prioridade, os horários liberados por
+// cancelamento, remarcação ou não comparecimento, com prazo para confirmar.
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// -----------------------------------------------------------------------------
+// Incluir na lista
+// -----------------------------------------------------------------------------
+
+// CreateEntryInput dados de entrada para incluir o cliente na lista de espera
+type CreateEntryInput struct {
+	TenantID        string
+	UnitID          string
+	CustomerID      string
+	ServiceIDs      []string
+	ProfessionalIDs []string // vazio = qualquer profissional
+	WindowStart     time.Time
+	WindowEnd       time.Time
+	Priority        int
+	Notes           string
+	CreatedBy       string
+}
+
+// CreateEntryUseCase inclui o cliente na lista de espera
+type CreateEntryUseCase struct {
+	repo               port.WaitlistRepository
+	customerReader     port.CustomerReader
+	serviceReader      port.ServiceReader
+	professionalReader port.ProfessionalReader
+	logger             *zap.Logger
+}
+
+// NewCreateEntryUseCase cria nova instância do use case
+func NewCreateEntryUseCase(
+	repo port.WaitlistRepository,
+	customerReader port.CustomerReader,
+	serviceReader port.ServiceReader,
+	professionalReader port.ProfessionalReader,
+	logger *zap.Logger,
+) *CreateEntryUseCase {
+	return &CreateEntryUseCase{
+		repo:               repo,
+		customerReader:     customerReader,
+		serviceReader:      serviceReader,
+		professionalReader: professionalReader,
+		logger:             logger,
+	}
+}
+
+// Execute inclui o cliente na lista
+func (uc *CreateEntryUseCase) Execute(ctx context.Context, input CreateEntryInput) (*entity.WaitlistEntry, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.CreateEntry")
+	defer span.End()
+
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	unitUUID, err := uuid.Parse(input.UnitID)
+	if err != nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	entry, err := entity.NewWaitlistEntry(
+		tenantUUID,
+		unitUUID,
+		input.CustomerID,
+		input.ServiceIDs,
+		input.ProfessionalIDs,
+		input.WindowStart,
+		input.WindowEnd,
+	)
+	if err != nil {
+		return nil, err
+	}
+	entry.Priority = input.Priority
+	entry.Notes = input.Notes
+	entry.CreatedBy = input.CreatedBy
+
+	customerExists, err := uc.customerReader.Exists(ctx, input.TenantID, input.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar cliente: %w", err)
+	}
+	if !customerExists {
+		return nil, domain.ErrAppointmentCustomerNotFound
+	}
+	services, err := uc.serviceReader.FindByIDs(ctx, input.TenantID, input.ServiceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar serviços: %w", err)
+	}
+	if len(services) != len(input.ServiceIDs) {
+		return nil, domain.ErrAppointmentServiceNotFound
+	}
+	for _, professionalID := range input.ProfessionalIDs {
+		exists, err := uc.professionalReader.Exists(ctx, input.TenantID, professionalID)
+		if err != nil {
+			return nil, fmt.Errorf("erro ao verificar profissional: %w", err)
+		}
+		if !exists {
+			return nil, domain.ErrAppointmentProfessionalNotFound
+		}
+	}
+
+	if err := uc.repo.CreateEntry(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Cliente incluído na lista de espera",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("entry_id", entry.ID),
+		zap.String("customer_id", input.CustomerID),
+		zap.Time("window_start", input.WindowStart),
+		zap.Time("window_end", input.WindowEnd),
+	)
+
+	return entry, nil
+}
+
+// -----------------------------------------------------------------------------
+// Listar
+// -----------------------------------------------------------------------------
+
+// ListEntriesUseCase lista a lista de espera da unidade
+type ListEntriesUseCase struct {
+	repo   port.WaitlistRepository
+	logger *zap.Logger
+}
+
+// NewListEntriesUseCase cria nova instância do use case
+func NewListEntriesUseCase(repo port.WaitlistRepository, logger *zap.Logger) *ListEntriesUseCase {
+	return &ListEntriesUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute lista as entradas da unidade (status vazio = todas), por prioridade
+func (uc *ListEntriesUseCase) Execute(ctx context.Context, tenantID, unitID, status string) ([]*entity.WaitlistEntry, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.ListEntries")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == "" {
+		return nil, domain.ErrUnitIDRequired
+	}
+	return uc.repo.ListEntries(ctx, tenantID, unitID, status)
+}
+
+// -----------------------------------------------------------------------------
+// Retirar da lista
+// -----------------------------------------------------------------------------
+
+// CancelEntryUseCase retira o cliente da lista de espera. Uma oferta pendente
+// é cancelada e o horário passa ao próximo da fila.
+type CancelEntryUseCase struct {
+	repo    port.WaitlistRepository
+	offerUC *OfferSlotUseCase
+	logger  *zap.Logger
+}
+
+// NewCancelEntryUseCase cria nova instância do use case
+func NewCancelEntryUseCase(repo port.WaitlistRepository, offerUC *OfferSlotUseCase, logger *zap.Logger) *CancelEntryUseCase {
+	return &CancelEntryUseCase{
+		repo:    repo,
+		offerUC: offerUC,
+		logger:  logger,
+	}
+}
+
+// Execute retira o cliente da lista
+func (uc *CancelEntryUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.WaitlistEntry, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.CancelEntry")
+	defer span.End()
+
+	entry, err := uc.repo.FindEntryByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	ofertada := entry.Status == entity.WaitlistStatusOffered
+	if err := entry.Cancel(); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.UpdateEntryStatus(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Cliente retirado da lista de espera",
+		zap.String("tenant_id", tenantID),
+		zap.String("entry_id", entry.ID),
+	)
+
+	if !ofertada {
+		return entry, nil
+	}
+	offer, err := uc.repo.FindPendingOfferByEntry(ctx, tenantID, entry.ID)
+	if errors.Is(err, domain.ErrWaitlistOfferNotFound) {
+		return entry, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	ok, err := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferPending, entity.WaitlistOfferCanceled, "")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if _, err := uc.offerUC.Execute(ctx, slotDaOferta(offer)); err != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao passar horário ao próximo da lista de espera",
+				zap.String("offer_id", offer.ID),
+				zap.Error(err),
+			)
+		}
+	}
+	return entry, nil
+}
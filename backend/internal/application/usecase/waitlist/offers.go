@@ -0,0 +1,532 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultOfferHold prazo padrão para o cliente confirmar a oferta
+	DefaultOfferHold = 30 * time.Minute
+
+	// antecedenciaMinima entre a oferta e o início do atendimento
+	antecedenciaMinima = 15 * time.Minute
+	// candidatosPorHorario limita as entradas avaliadas por horário liberado
+	candidatosPorHorario = 50
+	// loteOfertasExpiradas ofertas vencidas tratadas por execução do job
+	loteOfertasExpiradas = 200
+)
+
+// -----------------------------------------------------------------------------
+// Oferecer horário liberado
+// -----------------------------------------------------------------------------
+
+// OfferSlotUseCase oferece um horário liberado ao primeiro cliente compatível
+// da lista de espera. Só há uma oferta pendente por horário: se ela expirar
+// ou for recusada, o horário passa para o próximo da fila.
+type OfferSlotUseCase struct {
+	repo               port.WaitlistRepository
+	appointmentRepo    port.AppointmentRepository
+	serviceReader      port.ServiceReader
+	professionalReader port.ProfessionalReader
+	customerReader     port.CustomerReader
+	notifier           port.WaitlistNotifier
+	hold               time.Duration
+	logger             *zap.Logger
+}
+
+// NewOfferSlotUseCase cria nova instância do use case. hold <= 0 usa
+// DefaultOfferHold.
+func NewOfferSlotUseCase(
+	repo port.WaitlistRepository,
+	appointmentRepo port.AppointmentRepository,
+	serviceReader port.ServiceReader,
+	professionalReader port.ProfessionalReader,
+	customerReader port.CustomerReader,
+	notifier port.WaitlistNotifier,
+	hold time.Duration,
+	logger *zap.Logger,
+) *OfferSlotUseCase {
+	if hold <= 0 {
+		hold = DefaultOfferHold
+	}
+	return &OfferSlotUseCase{
+		repo:               repo,
+		appointmentRepo:    appointmentRepo,
+		serviceReader:      serviceReader,
+		professionalReader: professionalReader,
+		customerReader:     customerReader,
+		notifier:           notifier,
+		hold:               hold,
+		logger:             logger,
+	}
+}
+
+// SlotReleased implementa appointment.SlotReleaseListener. O cancelamento já
+// foi concluído: erros da lista de espera só vão para o log.
+func (uc *OfferSlotUseCase) SlotReleased(ctx context.Context, slot appointment.ReleasedSlot) {
+	if _, err := uc.Execute(ctx, slot); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao oferecer horário liberado à lista de espera",
+			zap.String("tenant_id", slot.TenantID),
+			zap.String("professional_id", slot.ProfessionalID),
+			zap.Time("start_time", slot.StartTime),
+			zap.String("reason", slot.Reason),
+			zap.Error(err),
+		)
+	}
+}
+
+// Execute oferece o horário ao primeiro candidato em que os serviços cabem.
+// Retorna nil sem erro quando ninguém da lista pode usar o horário.
+func (uc *OfferSlotUseCase) Execute(ctx context.Context, slot appointment.ReleasedSlot) (*entity.WaitlistOffer, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.OfferSlot")
+	defer span.End()
+
+	now := time.Now()
+	inicio := slot.StartTime
+	if minimo := arredondarCinco(now.Add(antecedenciaMinima)); inicio.Before(minimo) {
+		inicio = minimo // não comparecimento ou cancelamento em cima da hora
+	}
+	if !inicio.Before(slot.EndTime) {
+		return nil, nil
+	}
+
+	candidates, err := uc.repo.ListCandidates(ctx, slot.TenantID, slot.UnitID, slot.ProfessionalID, slot.StartTime, slot.EndTime, candidatosPorHorario)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range candidates {
+		start := inicio
+		if entry.WindowStart.After(start) {
+			start = entry.WindowStart
+		}
+		duracao, ok, err := uc.duracaoServicos(ctx, slot.TenantID, entry.ServiceIDs)
+		if err != nil {
+			return nil, err
+		}
+		end := start.Add(duracao)
+		if !ok || end.After(slot.EndTime) || !entry.Fits(start, end) {
+			continue
+		}
+
+		// O horário pode ter sido ocupado desde a liberação
+		ocupado, err := uc.appointmentRepo.CheckConflict(ctx, slot.TenantID, slot.UnitID, slot.ProfessionalID, start, end, "")
+		if err != nil {
+			return nil, fmt.Errorf("erro ao verificar conflito: %w", err)
+		}
+		if ocupado {
+			continue
+		}
+
+		return uc.ofertar(ctx, entry, slot, start, end)
+	}
+
+	return nil, nil
+}
+
+// ofertar registra a oferta, marca a entrada e avisa o cliente
+func (uc *OfferSlotUseCase) ofertar(ctx context.Context, entry *entity.WaitlistEntry, slot appointment.ReleasedSlot, start, end time.Time) (*entity.WaitlistOffer, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(uc.hold)
+	if start.Before(expiresAt) {
+		expiresAt = start
+	}
+	offer := &entity.WaitlistOffer{
+		ID:             uuid.NewString(),
+		TenantID:       entry.TenantID,
+		UnitID:         entry.UnitID,
+		EntryID:        entry.ID,
+		ProfessionalID: slot.ProfessionalID,
+		SlotStart:      slot.StartTime,
+		SlotEnd:        slot.EndTime,
+		StartTime:      start,
+		EndTime:        end,
+		TokenHash:      auth.HashRefreshToken(token),
+		ExpiresAt:      expiresAt,
+		Status:         entity.WaitlistOfferPending,
+		CreatedAt:      now,
+	}
+
+	created, err := uc.repo.CreateOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+	if !created {
+		// Outra liberação do mesmo horário já gerou a oferta
+		return nil, nil
+	}
+
+	entry.Status = entity.WaitlistStatusOffered
+	if err := uc.repo.UpdateEntryStatus(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Horário oferecido à lista de espera",
+		zap.String("tenant_id", slot.TenantID),
+		zap.String("offer_id", offer.ID),
+		zap.String("entry_id", entry.ID),
+		zap.String("professional_id", slot.ProfessionalID),
+		zap.Time("start_time", start),
+		zap.Time("expires_at", expiresAt),
+	)
+
+	// Falha no aviso não desfaz a oferta: ela expira e o horário segue a fila
+	if err := uc.notificar(ctx, entry, offer, token); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao avisar cliente da lista de espera",
+			zap.String("offer_id", offer.ID),
+			zap.String("customer_id", entry.CustomerID),
+			zap.Error(err),
+		)
+	}
+
+	return offer, nil
+}
+
+func (uc *OfferSlotUseCase) notificar(ctx context.Context, entry *entity.WaitlistEntry, offer *entity.WaitlistOffer, token string) error {
+	if uc.notifier == nil {
+		return nil
+	}
+	tenantID := entry.TenantID.String()
+
+	customer, err := uc.customerReader.FindByID(ctx, tenantID, entry.CustomerID)
+	if err != nil {
+		return fmt.Errorf("erro ao buscar cliente: %w", err)
+	}
+	msg := port.WaitlistSlotOffer{
+		TenantID:      tenantID,
+		CustomerName:  customer.Name,
+		CustomerEmail: customer.Email,
+		CustomerPhone: customer.Phone,
+		StartTime:     offer.StartTime,
+		EndTime:       offer.EndTime,
+		ExpiresAt:     offer.ExpiresAt,
+		Token:         token,
+	}
+	if professional, err := uc.professionalReader.FindByID(ctx, tenantID, offer.ProfessionalID); err == nil && professional != nil {
+		msg.ProfessionalName = professional.Name
+	}
+
+	return uc.notifier.NotifySlotOffer(ctx, msg)
+}
+
+// duracaoServicos soma a duração dos serviços desejados; ok = false quando
+// algum serviço não existe mais ou foi desativado
+func (uc *OfferSlotUseCase) duracaoServicos(ctx context.Context, tenantID string, serviceIDs []string) (time.Duration, bool, error) {
+	services, err := uc.serviceReader.FindByIDs(ctx, tenantID, serviceIDs)
+	if err != nil {
+		return 0, false, fmt.Errorf("erro ao buscar serviços: %w", err)
+	}
+	if len(services) != len(serviceIDs) {
+		return 0, false, nil
+	}
+	var total time.Duration
+	for _, s := range services {
+		if !s.Active {
+			return 0, false, nil
+		}
+		total += time.Duration(s.Duration) * time.Minute
+	}
+	return total, true, nil
+}
+
+// arredondarCinco arredonda para cima em múltiplos de 5 minutos
+func arredondarCinco(t time.Time) time.Time {
+	r := t.Truncate(5 * time.Minute)
+	if r.Before(t) {
+		r = r.Add(5 * time.Minute)
+	}
+	return r
+}
+
+// slotDaOferta reconstrói o horário liberado de uma oferta, para passá-lo ao
+// próximo da fila
+func slotDaOferta(offer *entity.WaitlistOffer) appointment.ReleasedSlot {
+	return appointment.ReleasedSlot{
+		TenantID:       offer.TenantID.String(),
+		UnitID:         offer.UnitID.String(),
+		ProfessionalID: offer.ProfessionalID,
+		StartTime:      offer.SlotStart,
+		EndTime:        offer.SlotEnd,
+	}
+}
+
+// reabrirEntrada devolve a entrada à fila após oferta expirada, recusada ou
+// que não pôde ser confirmada
+func reabrirEntrada(ctx context.Context, repo port.WaitlistRepository, tenantID, entryID string) error {
+	entry, err := repo.FindEntryByID(ctx, tenantID, entryID)
+	if err != nil {
+		return err
+	}
+	if entry.Status != entity.WaitlistStatusOffered {
+		return nil
+	}
+	entry.Status = entity.WaitlistStatusWaiting
+	return repo.UpdateEntryStatus(ctx, entry)
+}
+
+// -----------------------------------------------------------------------------
+// Expirar ofertas (job)
+// -----------------------------------------------------------------------------
+
+// ExpireOffersUseCase expira as ofertas sem resposta no prazo, passa o
+// horário ao próximo da fila e encerra entradas com período vencido
+type ExpireOffersUseCase struct {
+	repo    port.WaitlistRepository
+	offerUC *OfferSlotUseCase
+	logger  *zap.Logger
+}
+
+// NewExpireOffersUseCase cria nova instância do use case
+func NewExpireOffersUseCase(repo port.WaitlistRepository, offerUC *OfferSlotUseCase, logger *zap.Logger) *ExpireOffersUseCase {
+	return &ExpireOffersUseCase{
+		repo:    repo,
+		offerUC: offerUC,
+		logger:  logger,
+	}
+}
+
+// Execute retorna quantas ofertas expiraram
+func (uc *ExpireOffersUseCase) Execute(ctx context.Context) (int, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.ExpireOffers")
+	defer span.End()
+
+	offers, err := uc.repo.ListExpiredOffers(ctx, loteOfertasExpiradas)
+	if err != nil {
+		return 0, err
+	}
+
+	expiradas := 0
+	for _, offer := range offers {
+		ok, err := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferPending, entity.WaitlistOfferExpired, "")
+		if err != nil {
+			return expiradas, err
+		}
+		if !ok {
+			continue // confirmada ou recusada nesse meio tempo
+		}
+		expiradas++
+
+		if err := reabrirEntrada(ctx, uc.repo, offer.TenantID.String(), offer.EntryID); err != nil {
+			return expiradas, err
+		}
+		if _, err := uc.offerUC.Execute(ctx, slotDaOferta(offer)); err != nil {
+			common.Logger(ctx, uc.logger).Error("Erro ao passar horário ao próximo da lista de espera",
+				zap.String("offer_id", offer.ID),
+				zap.Error(err),
+			)
+		}
+	}
+
+	encerradas, err := uc.repo.ExpireEntries(ctx)
+	if err != nil {
+		return expiradas, err
+	}
+
+	if expiradas > 0 || encerradas > 0 {
+		common.Logger(ctx, uc.logger).Info("Lista de espera atualizada",
+			zap.Int("offers_expired", expiradas),
+			zap.Int64("entries_expired", encerradas),
+		)
+	}
+	return expiradas, nil
+}
+
+// -----------------------------------------------------------------------------
+// Link da oferta (público, validado pelo token)
+// -----------------------------------------------------------------------------
+
+// OfferOutput oferta com a entrada da lista de espera
+type OfferOutput struct {
+	Offer *entity.WaitlistOffer
+	Entry *entity.WaitlistEntry
+}
+
+// GetOfferUseCase busca a oferta pelo token do link
+type GetOfferUseCase struct {
+	repo   port.WaitlistRepository
+	logger *zap.Logger
+}
+
+// NewGetOfferUseCase cria nova instância do use case
+func NewGetOfferUseCase(repo port.WaitlistRepository, logger *zap.Logger) *GetOfferUseCase {
+	return &GetOfferUseCase{
+		repo:   repo,
+		logger: logger,
+	}
+}
+
+// Execute busca a oferta
+func (uc *GetOfferUseCase) Execute(ctx context.Context, token string) (*OfferOutput, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.GetOffer")
+	defer span.End()
+
+	offer, err := uc.repo.FindOfferByTokenHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+	entry, err := uc.repo.FindEntryByID(ctx, offer.TenantID.String(), offer.EntryID)
+	if err != nil {
+		return nil, err
+	}
+	return &OfferOutput{Offer: offer, Entry: entry}, nil
+}
+
+// ClaimOfferUseCase confirma a oferta em um clique, criando o agendamento
+type ClaimOfferUseCase struct {
+	repo     port.WaitlistRepository
+	createUC *appointment.CreateAppointmentUseCase
+	logger   *zap.Logger
+}
+
+// NewClaimOfferUseCase cria nova instância do use case
+func NewClaimOfferUseCase(repo port.WaitlistRepository, createUC *appointment.CreateAppointmentUseCase, logger *zap.Logger) *ClaimOfferUseCase {
+	return &ClaimOfferUseCase{
+		repo:     repo,
+		createUC: createUC,
+		logger:   logger,
+	}
+}
+
+// Execute confirma a oferta. A oferta é reservada antes de criar o
+// agendamento, o que impede confirmação dupla; se o horário já foi ocupado,
+// a oferta é cancelada e o cliente volta à fila.
+func (uc *ClaimOfferUseCase) Execute(ctx context.Context, token string) (*entity.Appointment, error) {
+	ctx, span := common.StartSpan(ctx, "waitlist.ClaimOffer")
+	defer span.End()
+
+	offer, err := uc.repo.FindOfferByTokenHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if offer.Status != entity.WaitlistOfferPending {
+		return nil, domain.ErrWaitlistOfferUnavailable
+	}
+	if !offer.IsPending(time.Now()) {
+		return nil, domain.ErrWaitlistOfferExpired
+	}
+	ok, err := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferPending, entity.WaitlistOfferClaimed, "")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrWaitlistOfferUnavailable
+	}
+
+	tenantID := offer.TenantID.String()
+	entry, err := uc.repo.FindEntryByID(ctx, tenantID, offer.EntryID)
+	if err != nil {
+		return nil, err
+	}
+
+	appt, err := uc.createUC.Execute(ctx, appointment.CreateAppointmentInput{
+		TenantID:       tenantID,
+		UnitID:         offer.UnitID.String(),
+		ProfessionalID: offer.ProfessionalID,
+		CustomerID:     entry.CustomerID,
+		StartTime:      offer.StartTime,
+		ServiceIDs:     entry.ServiceIDs,
+		Notes:          entry.Notes,
+	})
+	if err != nil {
+		if _, terr := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferClaimed, entity.WaitlistOfferCanceled, ""); terr != nil {
+			return nil, terr
+		}
+		if rerr := reabrirEntrada(ctx, uc.repo, tenantID, entry.ID); rerr != nil {
+			return nil, rerr
+		}
+		if errors.Is(err, domain.ErrAppointmentConflict) ||
+			errors.Is(err, domain.ErrAppointmentBlockedTimeConflict) ||
+			errors.Is(err, domain.ErrAppointmentMinimumInterval) ||
+			errors.Is(err, domain.ErrResourceUnavailable) {
+			return nil, domain.ErrWaitlistOfferUnavailable
+		}
+		return nil, err
+	}
+
+	if _, err := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferClaimed, entity.WaitlistOfferClaimed, appt.ID); err != nil {
+		return nil, err
+	}
+	entry.Status = entity.WaitlistStatusFulfilled
+	entry.AppointmentID = appt.ID
+	if err := uc.repo.UpdateEntryStatus(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Oferta da lista de espera confirmada",
+		zap.String("tenant_id", tenantID),
+		zap.String("offer_id", offer.ID),
+		zap.String("entry_id", entry.ID),
+		zap.String("appointment_id", appt.ID),
+	)
+
+	return appt, nil
+}
+
+// DeclineOfferUseCase recusa a oferta e passa o horário ao próximo da fila.
+// O cliente continua na lista de espera.
+type DeclineOfferUseCase struct {
+	repo    port.WaitlistRepository
+	offerUC *OfferSlotUseCase
+	logger  *zap.Logger
+}
+
+// NewDeclineOfferUseCase cria nova instância do use case
+func NewDeclineOfferUseCase(repo port.WaitlistRepository, offerUC *OfferSlotUseCase, logger *zap.Logger) *DeclineOfferUseCase {
+	return &DeclineOfferUseCase{
+		repo:    repo,
+		offerUC: offerUC,
+		logger:  logger,
+	}
+}
+
+// Execute recusa a oferta
+func (uc *DeclineOfferUseCase) Execute(ctx context.Context, token string) error {
+	ctx, span := common.StartSpan(ctx, "waitlist.DeclineOffer")
+	defer span.End()
+
+	offer, err := uc.repo.FindOfferByTokenHash(ctx, auth.HashRefreshToken(token))
+	if err != nil {
+		return err
+	}
+	ok, err := uc.repo.TransitionOffer(ctx, offer.ID, entity.WaitlistOfferPending, entity.WaitlistOfferDeclined, "")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrWaitlistOfferUnavailable
+	}
+
+	if err := reabrirEntrada(ctx, uc.repo, offer.TenantID.String(), offer.EntryID); err != nil {
+		return err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Oferta da lista de espera recusada",
+		zap.String("tenant_id", offer.TenantID.String()),
+		zap.String("offer_id", offer.ID),
+	)
+
+	if _, err := uc.offerUC.Execute(ctx, slotDaOferta(offer)); err != nil {
+		common.Logger(ctx, uc.logger).Error("Erro ao passar horário ao próximo da lista de espera",
+			zap.String("offer_id", offer.ID),
+			zap.Error(err),
+		)
+	}
+	return nil
+}
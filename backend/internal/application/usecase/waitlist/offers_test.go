@@ -0,0 +1,290 @@
+package waitlist
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// fakeWaitlistRepo implementa port.WaitlistRepository em memória
+type fakeWaitlistRepo struct {
+	entries map[string]*entity.WaitlistEntry
+	offers  []*entity.WaitlistOffer
+}
+
+func (r *fakeWaitlistRepo) CreateEntry(ctx context.Context, e *entity.WaitlistEntry) error {
+	r.entries[e.ID] = e
+	return nil
+}
+
+func (r *fakeWaitlistRepo) FindEntryByID(ctx context.Context, tenantID, id string) (*entity.WaitlistEntry, error) {
+	if e, ok := r.entries[id]; ok {
+		return e, nil
+	}
+	return nil, domain.ErrWaitlistEntryNotFound
+}
+
+func (r *fakeWaitlistRepo) UpdateEntryStatus(ctx context.Context, e *entity.WaitlistEntry) error {
+	r.entries[e.ID] = e
+	return nil
+}
+
+func (r *fakeWaitlistRepo) ListEntries(ctx context.Context, tenantID, unitID, status string) ([]*entity.WaitlistEntry, error) {
+	return nil, nil
+}
+
+func (r *fakeWaitlistRepo) ListCandidates(ctx context.Context, tenantID, unitID, professionalID string, slotStart, slotEnd time.Time, limit int) ([]*entity.WaitlistEntry, error) {
+	var out []*entity.WaitlistEntry
+	for _, e := range r.entries {
+		if e.Status != entity.WaitlistStatusWaiting || !e.AcceptsProfessional(professionalID) {
+			continue
+		}
+		if !e.WindowStart.Before(slotEnd) || !e.WindowEnd.After(slotStart) {
+			continue
+		}
+		ofertado := false
+		for _, o := range r.offers {
+			if o.EntryID == e.ID && o.ProfessionalID == professionalID && o.SlotStart.Equal(slotStart) {
+				ofertado = true
+			}
+		}
+		if !ofertado {
+			out = append(out, e)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Priority != out[j].Priority {
+			return out[i].Priority > out[j].Priority
+		}
+		return out[i].CreatedAt.Before(out[j].CreatedAt)
+	})
+	return out, nil
+}
+
+func (r *fakeWaitlistRepo) ExpireEntries(ctx context.Context) (int64, error) { return 0, nil }
+
+func (r *fakeWaitlistRepo) CreateOffer(ctx context.Context, o *entity.WaitlistOffer) (bool, error) {
+	for _, existing := range r.offers {
+		if existing.Status == entity.WaitlistOfferPending && existing.ProfessionalID == o.ProfessionalID && existing.SlotStart.Equal(o.SlotStart) {
+			return false, nil
+		}
+	}
+	r.offers = append(r.offers, o)
+	return true, nil
+}
+
+func (r *fakeWaitlistRepo) FindOfferByTokenHash(ctx context.Context, tokenHash string) (*entity.WaitlistOffer, error) {
+	for _, o := range r.offers {
+		if o.TokenHash == tokenHash {
+			return o, nil
+		}
+	}
+	return nil, domain.ErrWaitlistOfferNotFound
+}
+
+func (r *fakeWaitlistRepo) FindPendingOfferByEntry(ctx context.Context, tenantID, entryID string) (*entity.WaitlistOffer, error) {
+	for _, o := range r.offers {
+		if o.EntryID == entryID && o.Status == entity.WaitlistOfferPending {
+			return o, nil
+		}
+	}
+	return nil, domain.ErrWaitlistOfferNotFound
+}
+
+func (r *fakeWaitlistRepo) TransitionOffer(ctx context.Context, offerID, fromStatus, status, appointmentID string) (bool, error) {
+	for _, o := range r.offers {
+		if o.ID == offerID && o.Status == fromStatus {
+			o.Status = status
+			if appointmentID != "" {
+				o.AppointmentID = appointmentID
+			}
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeWaitlistRepo) ListExpiredOffers(ctx context.Context, limit int) ([]*entity.WaitlistOffer, error) {
+	return nil, nil
+}
+
+// fakeAppointmentRepo só responde à verificação de conflito
+type fakeAppointmentRepo struct {
+	port.AppointmentRepository
+}
+
+func (fakeAppointmentRepo) CheckConflict(ctx context.Context, tenantID, unitID, professionalID string, startTime, endTime time.Time, excludeAppointmentID string) (bool, error) {
+	return false, nil
+}
+
+// fakeReaders implementa os readers de serviço, profissional e cliente
+type fakeReaders struct {
+	durations map[string]int
+}
+
+func (f fakeReaders) Exists(ctx context.Context, tenantID, id string) (bool, error) { return true, nil }
+
+func (f fakeReaders) FindByIDs(ctx context.Context, tenantID string, serviceIDs []string) ([]*port.ServiceInfo, error) {
+	var out []*port.ServiceInfo
+	for _, id := range serviceIDs {
+		out = append(out, &port.ServiceInfo{ID: id, Duration: f.durations[id], Active: true})
+	}
+	return out, nil
+}
+
+type fakeServiceReader struct{ fakeReaders }
+
+func (fakeServiceReader) FindByID(ctx context.Context, tenantID, id string) (*port.ServiceInfo, error) {
+	return nil, nil
+}
+
+type fakeProfessionalReader struct{ fakeReaders }
+
+func (fakeProfessionalReader) FindByID(ctx context.Context, tenantID, id string) (*port.ProfessionalInfo, error) {
+	return &port.ProfessionalInfo{ID: id, Name: "Carlos"}, nil
+}
+
+func (fakeProfessionalReader) ListActive(ctx context.Context, tenantID string) ([]*port.ProfessionalInfo, error) {
+	return nil, nil
+}
+
+func (fakeProfessionalReader) GetCategoryCommission(ctx context.Context, tenantID, professionalID, categoriaID string) (*string, error) {
+	return nil, nil
+}
+
+type fakeCustomerReader struct{ fakeReaders }
+
+func (fakeCustomerReader) FindByID(ctx context.Context, tenantID, id string) (*port.CustomerInfo, error) {
+	return &port.CustomerInfo{ID: id, Name: "Cliente " + id, Email: id + "@example.com"}, nil
+}
+
+// fakeNotifier guarda os avisos enviados
+type fakeNotifier struct {
+	sent []port.WaitlistSlotOffer
+}
+
+func (n *fakeNotifier) NotifySlotOffer(ctx context.Context, offer port.WaitlistSlotOffer) error {
+	n.sent = append(n.sent, offer)
+	return nil
+}
+
+func TestOfferSlotUseCase_Execute(t *testing.T) {
+	logger := zap.NewNop()
+	tenantID := uuid.New()
+	unitID := uuid.New()
+	professionalID := uuid.NewString()
+
+	slotStart := time.Now().Add(3 * time.Hour).Truncate(time.Hour)
+	slot := appointment.ReleasedSlot{
+		TenantID:       tenantID.String(),
+		UnitID:         unitID.String(),
+		ProfessionalID: professionalID,
+		StartTime:      slotStart,
+		EndTime:        slotStart.Add(time.Hour),
+		Reason:         appointment.SlotReleasedCanceled,
+	}
+
+	novaEntrada := func(id, serviceID string, priority int, createdAt time.Time) *entity.WaitlistEntry {
+		return &entity.WaitlistEntry{
+			ID:          id,
+			TenantID:    tenantID,
+			UnitID:      unitID,
+			CustomerID:  id,
+			ServiceIDs:  []string{serviceID},
+			WindowStart: slotStart.Add(-24 * time.Hour),
+			WindowEnd:   slotStart.Add(24 * time.Hour),
+			Priority:    priority,
+			Status:      entity.WaitlistStatusWaiting,
+			CreatedAt:   createdAt,
+		}
+	}
+
+	setup := func() (*fakeWaitlistRepo, *fakeNotifier, *OfferSlotUseCase) {
+		base := time.Now().Add(-time.Hour)
+		repo := &fakeWaitlistRepo{entries: map[string]*entity.WaitlistEntry{
+			// Maior prioridade, mas o serviço (90 min) não cabe no horário
+			"longo": novaEntrada("longo", "svc-90", 5, base),
+			"ana":   novaEntrada("ana", "svc-30", 1, base.Add(time.Minute)),
+			"bruno": novaEntrada("bruno", "svc-30", 1, base.Add(2*time.Minute)),
+		}}
+		readers := fakeReaders{durations: map[string]int{"svc-30": 30, "svc-90": 90}}
+		notifier := &fakeNotifier{}
+		uc := NewOfferSlotUseCase(repo, fakeAppointmentRepo{}, fakeServiceReader{readers}, fakeProfessionalReader{readers}, fakeCustomerReader{readers}, notifier, 20*time.Minute, logger)
+		return repo, notifier, uc
+	}
+
+	t.Run("should offer the slot to the first candidate whose services fit", func(t *testing.T) {
+		repo, notifier, uc := setup()
+
+		offer, err := uc.Execute(context.Background(), slot)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if offer == nil || offer.EntryID != "ana" {
+			t.Fatalf("expected offer to ana, got %+v", offer)
+		}
+		if !offer.EndTime.Equal(slotStart.Add(30 * time.Minute)) {
+			t.Errorf("expected offer to last 30 minutes, got %s", offer.EndTime.Sub(offer.StartTime))
+		}
+		if repo.entries["ana"].Status != entity.WaitlistStatusOffered {
+			t.Errorf("expected entry status OFFERED, got %s", repo.entries["ana"].Status)
+		}
+		if len(notifier.sent) != 1 || notifier.sent[0].CustomerEmail != "ana@example.com" || notifier.sent[0].Token == "" {
+			t.Errorf("expected one notification with token to ana, got %+v", notifier.sent)
+		}
+
+		// Só uma oferta pendente por horário
+		again, err := uc.Execute(context.Background(), slot)
+		if err != nil || again != nil {
+			t.Errorf("expected no second offer for the same slot, got %+v, %v", again, err)
+		}
+	})
+
+	t.Run("should pass the slot to the next candidate when declined", func(t *testing.T) {
+		repo, notifier, uc := setup()
+		if _, err := uc.Execute(context.Background(), slot); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		decline := NewDeclineOfferUseCase(repo, uc, logger)
+		if err := decline.Execute(context.Background(), notifier.sent[0].Token); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+
+		if repo.entries["ana"].Status != entity.WaitlistStatusWaiting {
+			t.Errorf("expected declined entry back to WAITING, got %s", repo.entries["ana"].Status)
+		}
+		if len(notifier.sent) != 2 || notifier.sent[1].CustomerEmail != "bruno@example.com" {
+			t.Fatalf("expected second offer to bruno, got %+v", notifier.sent)
+		}
+
+		// O mesmo link não pode ser usado de novo
+		err := decline.Execute(context.Background(), notifier.sent[0].Token)
+		if !errors.Is(err, domain.ErrWaitlistOfferUnavailable) {
+			t.Errorf("expected ErrWaitlistOfferUnavailable, got %v", err)
+		}
+	})
+
+	t.Run("should reject claim of an expired offer", func(t *testing.T) {
+		repo, notifier, uc := setup()
+		offer, err := uc.Execute(context.Background(), slot)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		offer.ExpiresAt = time.Now().Add(-time.Minute)
+
+		_, err = NewClaimOfferUseCase(repo, nil, logger).Execute(context.Background(), notifier.sent[0].Token)
+		if !errors.Is(err, domain.ErrWaitlistOfferExpired) {
+			t.Errorf("expected ErrWaitlistOfferExpired, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,139 @@
+package walkin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+)
+
+// AppointmentMargin folga exigida entre o fim do encaixe e o próximo
+// agendamento do barbeiro: quem tem cliente marcado começando antes disso
+// não recebe encaixe.
+const AppointmentMargin = 10 * time.Minute
+
+// agendaWindow período olhado na agenda dos barbeiros ao redor de agora
+const agendaWindow = 12 * time.Hour
+
+// barberAgenda agenda de um barbeiro da lista da vez, do ponto de vista do
+// encaixe
+type barberAgenda struct {
+	turn     *entity.BarberTurn
+	busy     bool                      // em atendimento ou com cliente aguardando
+	freeAt   time.Time                 // fim previsto do atendimento em curso
+	upcoming []entity.ProfessionalSlot // agendamentos que ainda vão começar
+}
+
+// nextStart primeiro horário, a partir de from, em que o barbeiro pode
+// atender um encaixe de duração d sem invadir os agendamentos marcados
+func (a *barberAgenda) nextStart(from time.Time, d time.Duration) time.Time {
+	start := from
+	if a.freeAt.After(start) {
+		start = a.freeAt
+	}
+	for _, s := range a.upcoming {
+		if s.StartTime.Before(start.Add(d+AppointmentMargin)) && s.EndTime.After(start) {
+			start = s.EndTime
+		}
+	}
+	return start
+}
+
+// availableAt indica se o barbeiro pode começar o encaixe agora
+func (a *barberAgenda) availableAt(now time.Time, d time.Duration) bool {
+	return !a.busy && !a.nextStart(now, d).After(now)
+}
+
+// loadAgendas monta, na ordem da lista da vez, a agenda dos barbeiros ativos
+// da unidade. Agendamentos de todas as unidades contam: o barbeiro não atende
+// em dois lugares ao mesmo tempo.
+func loadAgendas(ctx context.Context, turns port.BarberTurnRepository, appointments port.AppointmentRepository, tenantID, unitID string, now time.Time) ([]*barberAgenda, error) {
+	list, err := turns.ListActiveByUnit(ctx, tenantID, unitID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar lista da vez: %w", err)
+	}
+
+	agendas := make([]*barberAgenda, 0, len(list))
+	for _, turn := range list {
+		if !turn.CanBeSelected() {
+			continue
+		}
+		appts, err := appointments.ListByProfessionalAndDateRange(ctx, tenantID, "", turn.ProfessionalID, now.Add(-agendaWindow), now.Add(agendaWindow))
+		if err != nil {
+			return nil, fmt.Errorf("erro ao buscar agenda do barbeiro: %w", err)
+		}
+		agendas = append(agendas, buildAgenda(turn, appts, now))
+	}
+	return agendas, nil
+}
+
+// buildAgenda separa, nos agendamentos do barbeiro, o atendimento em curso
+// dos que ainda vão começar
+func buildAgenda(turn *entity.BarberTurn, appts []*entity.Appointment, now time.Time) *barberAgenda {
+	agenda := &barberAgenda{turn: turn, freeAt: now}
+	for _, appt := range appts {
+		// Atendimento finalizado aguardando pagamento já liberou o barbeiro
+		if !appt.IsActive() || appt.Status == valueobject.AppointmentStatusAwaitingPayment {
+			continue
+		}
+		emCurso := appt.Status == valueobject.AppointmentStatusCheckedIn ||
+			appt.Status == valueobject.AppointmentStatusInService
+		for _, slot := range appt.ProfessionalSlots() {
+			if slot.ProfessionalID != turn.ProfessionalID {
+				continue
+			}
+			switch {
+			case emCurso && !slot.StartTime.After(now):
+				// Atendimento atrasado termina "agora" na estimativa
+				agenda.busy = true
+				if slot.EndTime.After(agenda.freeAt) {
+					agenda.freeAt = slot.EndTime
+				}
+			case slot.EndTime.After(now):
+				agenda.upcoming = append(agenda.upcoming, slot)
+			}
+		}
+	}
+	sort.Slice(agenda.upcoming, func(i, j int) bool {
+		return agenda.upcoming[i].StartTime.Before(agenda.upcoming[j].StartTime)
+	})
+	return agenda
+}
+
+// estimateWaits estima, na ordem de chegada, quando cada cliente aguardando
+// começa a ser atendido: cada um vai para o barbeiro que fica livre primeiro
+// (empate pela lista da vez) e o ocupa pelo tempo dos seus serviços. Sem
+// barbeiros na lista, não há estimativa.
+func estimateWaits(agendas []*barberAgenda, waiting []*entity.WalkIn, now time.Time) []*time.Time {
+	out := make([]*time.Time, len(waiting))
+	if len(agendas) == 0 {
+		return out
+	}
+	freeAt := make([]time.Time, len(agendas))
+	for i, a := range agendas {
+		freeAt[i] = a.freeAt
+		if a.busy && !freeAt[i].After(now) {
+			// Atendimento já passou do fim previsto: libera em instantes
+			freeAt[i] = now.Add(time.Minute)
+		}
+	}
+	for i, w := range waiting {
+		best := -1
+		var bestStart time.Time
+		for j, a := range agendas {
+			sim := barberAgenda{freeAt: freeAt[j], upcoming: a.upcoming}
+			start := sim.nextStart(now, w.Duration())
+			if best < 0 || start.Before(bestStart) {
+				best, bestStart = j, start
+			}
+		}
+		freeAt[best] = bestStart.Add(w.Duration())
+		start := bestStart
+		out[i] = &start
+	}
+	return out
+}
@@ -0,0 +1,123 @@
+package walkin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+func agendamento(professionalID string, status valueobject.AppointmentStatus, start time.Time, minutos int) *entity.Appointment {
+	return &entity.Appointment{
+		ProfessionalID: professionalID,
+		Status:         status,
+		StartTime:      start,
+		EndTime:        start.Add(time.Duration(minutos) * time.Minute),
+		Services: []entity.AppointmentService{{
+			ProfessionalID: professionalID,
+			StartTime:      start,
+			EndTime:        start.Add(time.Duration(minutos) * time.Minute),
+		}},
+	}
+}
+
+func chegada(minutos int) *entity.WalkIn {
+	return &entity.WalkIn{ID: uuid.NewString(), DurationMinutes: minutos, Status: entity.WalkInStatusWaiting}
+}
+
+func TestAgendaEstimativaDeEspera(t *testing.T) {
+	now := time.Date(2026, 5, 4, 14, 0, 0, 0, time.UTC)
+
+	// João (topo da lista) atende até 14:20; Pedro está livre mas tem
+	// cliente marcado às 14:25
+	joao := buildAgenda(&entity.BarberTurn{ProfessionalID: "joao"}, []*entity.Appointment{
+		agendamento("joao", valueobject.AppointmentStatusInService, now.Add(-10*time.Minute), 30),
+	}, now)
+	pedro := buildAgenda(&entity.BarberTurn{ProfessionalID: "pedro"}, []*entity.Appointment{
+		agendamento("pedro", valueobject.AppointmentStatusConfirmed, now.Add(25*time.Minute), 30),
+		agendamento("pedro", valueobject.AppointmentStatusAwaitingPayment, now.Add(-40*time.Minute), 45),
+	}, now)
+
+	if joao.availableAt(now, 30*time.Minute) {
+		t.Error("barbeiro em atendimento não deveria receber encaixe")
+	}
+	if pedro.availableAt(now, 30*time.Minute) {
+		t.Error("barbeiro com cliente marcado antes do fim do encaixe não deveria recebê-lo")
+	}
+	if !pedro.availableAt(now, 10*time.Minute) {
+		t.Error("encaixe curto cabe antes do próximo cliente marcado")
+	}
+
+	starts := estimateWaits([]*barberAgenda{joao, pedro}, []*entity.WalkIn{chegada(30), chegada(20)}, now)
+	if starts[0] == nil || !starts[0].Equal(now.Add(20*time.Minute)) {
+		t.Errorf("primeiro da fila deveria começar 14:20, obtido %v", starts[0])
+	}
+	// Pedro só fica livre às 14:55; João termina o primeiro encaixe às 14:50
+	if starts[1] == nil || !starts[1].Equal(now.Add(50*time.Minute)) {
+		t.Errorf("segundo da fila deveria começar 14:50, obtido %v", starts[1])
+	}
+
+	if got := estimateWaits(nil, []*entity.WalkIn{chegada(30)}, now); got[0] != nil {
+		t.Errorf("sem barbeiros na lista não há estimativa, obtido %v", got[0])
+	}
+}
+
+// turnsPorUnidade lista da vez com os barbeiros separados pela unidade em que
+// atendem
+type turnsPorUnidade struct {
+	port.BarberTurnRepository
+	porUnidade map[string][]*entity.BarberTurn
+}
+
+func (f *turnsPorUnidade) ListActiveByUnit(_ context.Context, _, unitID string) ([]*entity.BarberTurn, error) {
+	return f.porUnidade[unitID], nil
+}
+
+// agendaPorBarbeiro agendamentos de cada barbeiro, de todas as unidades
+type agendaPorBarbeiro struct {
+	port.AppointmentRepository
+	porBarbeiro map[string][]*entity.Appointment
+}
+
+func (f *agendaPorBarbeiro) ListByProfessionalAndDateRange(_ context.Context, _, _, professionalID string, _, _ time.Time) ([]*entity.Appointment, error) {
+	return f.porBarbeiro[professionalID], nil
+}
+
+func TestAgendaSoConsideraBarbeirosDaUnidade(t *testing.T) {
+	now := time.Date(2026, 5, 4, 14, 0, 0, 0, time.UTC)
+
+	// João atende no Centro até 14:20; Pedro, livre, atende em Alphaville
+	turns := &turnsPorUnidade{porUnidade: map[string][]*entity.BarberTurn{
+		"centro":     {{ProfessionalID: "joao", IsActive: true, ProfessionalStatus: "ATIVO"}},
+		"alphaville": {{ProfessionalID: "pedro", IsActive: true, ProfessionalStatus: "ATIVO"}},
+	}}
+	appointments := &agendaPorBarbeiro{porBarbeiro: map[string][]*entity.Appointment{
+		"joao": {agendamento("joao", valueobject.AppointmentStatusInService, now.Add(-10*time.Minute), 30)},
+	}}
+
+	agendas, err := loadAgendas(context.Background(), turns, appointments, "tenant", "centro", now)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(agendas) != 1 || agendas[0].turn.ProfessionalID != "joao" {
+		t.Fatalf("só barbeiros do Centro deveriam entrar na agenda, obtido %d", len(agendas))
+	}
+
+	// Barbeiro livre de outra unidade não encurta a espera do Centro
+	starts := estimateWaits(agendas, []*entity.WalkIn{chegada(30)}, now)
+	if starts[0] == nil || !starts[0].Equal(now.Add(20*time.Minute)) {
+		t.Errorf("cliente do Centro deveria começar 14:20, obtido %v", starts[0])
+	}
+
+	agendas, err = loadAgendas(context.Background(), turns, appointments, "tenant", "alphaville", now)
+	if err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if len(agendas) != 1 || !agendas[0].availableAt(now, 30*time.Minute) {
+		t.Error("barbeiro livre de Alphaville deveria receber o encaixe da própria unidade")
+	}
+}
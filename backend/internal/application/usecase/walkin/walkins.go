@@ -0,0 +1,443 @@
+// Package walkin contém os use cases da fila de encaixe: a recepção registra
+// a chegada do cliente sem horário marcado e o sistema escolhe, pela lista da
+// vez, o próximo barbeiro livre. O agendamento nasce em CHECKED_IN e a vez do
+// barbeiro é registrada automaticamente.
+package walkin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// QueueItem cliente aguardando na fila de encaixe com a posição e a previsão
+// de início do atendimento
+type QueueItem struct {
+	WalkIn         *entity.WalkIn
+	Position       int        // 1 = próximo a ser atendido; 0 = já encaixado
+	EstimatedStart *time.Time // nil sem barbeiros ativos na lista da vez
+}
+
+// -----------------------------------------------------------------------------
+// Encaixe (compartilhado)
+// -----------------------------------------------------------------------------
+
+// dispatcher encaixa os clientes aguardando nos barbeiros livres, na ordem da
+// lista da vez
+type dispatcher struct {
+	repo         port.WalkInRepository
+	turns        port.BarberTurnRepository
+	appointments port.AppointmentRepository
+	createUC     *appointment.CreateAppointmentUseCase
+	statusUC     *appointment.UpdateAppointmentStatusUseCase
+	logger       *zap.Logger
+}
+
+// horarioIndisponivel erros do agendamento que só dizem que o barbeiro não
+// pode atender agora (tenta o próximo da lista)
+func horarioIndisponivel(err error) bool {
+	return errors.Is(err, domain.ErrAppointmentConflict) ||
+		errors.Is(err, domain.ErrAppointmentBlockedTimeConflict) ||
+		errors.Is(err, domain.ErrAppointmentMinimumInterval) ||
+		errors.Is(err, domain.ErrResourceUnavailable)
+}
+
+// dispatch encaixa, por ordem de chegada, cada cliente aguardando no primeiro
+// barbeiro da lista da vez que está livre e não tem cliente marcado antes do
+// fim do atendimento. Cliente que não cabe em nenhum barbeiro continua na
+// fila sem impedir o encaixe dos seguintes.
+func (d *dispatcher) dispatch(ctx context.Context, tenantID, unitID string) ([]*entity.WalkIn, error) {
+	now := time.Now()
+	waiting, err := d.repo.List(ctx, tenantID, unitID, now.Add(-agendaWindow), entity.WalkInStatusWaiting)
+	if err != nil || len(waiting) == 0 {
+		return nil, err
+	}
+	agendas, err := loadAgendas(ctx, d.turns, d.appointments, tenantID, unitID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var assigned []*entity.WalkIn
+	for _, w := range waiting {
+		for _, agenda := range agendas {
+			if !agenda.availableAt(now, w.Duration()) {
+				continue
+			}
+			ok, err := d.assign(ctx, w, agenda.turn.ProfessionalID)
+			if errors.Is(err, domain.ErrWalkInNotWaiting) {
+				break // encaixado ou cancelado por outra operação
+			}
+			if err != nil {
+				return assigned, err
+			}
+			if ok {
+				agenda.busy = true
+				assigned = append(assigned, w)
+				break
+			}
+		}
+	}
+	return assigned, nil
+}
+
+// assign cria o agendamento do cliente com o barbeiro, faz o check-in e
+// registra a vez. Retorna false se a agenda do barbeiro recusou o horário.
+func (d *dispatcher) assign(ctx context.Context, w *entity.WalkIn, professionalID string) (bool, error) {
+	tenantID := w.TenantID.String()
+	unitID := w.UnitID.String()
+
+	// Reservar o cliente antes de criar o agendamento: outra recepção não
+	// encaixa o mesmo cliente
+	if err := w.Assign(professionalID); err != nil {
+		return false, err
+	}
+	ok, err := d.repo.Transition(ctx, w, entity.WalkInStatusWaiting)
+	if err != nil || !ok {
+		w.Release()
+		if err == nil {
+			err = domain.ErrWalkInNotWaiting
+		}
+		return false, err
+	}
+
+	appt, err := d.createUC.Execute(ctx, appointment.CreateAppointmentInput{
+		TenantID:       tenantID,
+		UnitID:         unitID,
+		ProfessionalID: professionalID,
+		CustomerID:     w.CustomerID,
+		StartTime:      time.Now().Truncate(time.Minute),
+		ServiceIDs:     w.ServiceIDs,
+		Notes:          w.Notes,
+	})
+	if err != nil {
+		w.Release()
+		if _, rerr := d.repo.Transition(ctx, w, entity.WalkInStatusAssigned); rerr != nil {
+			common.Logger(ctx, d.logger).Error("Falha ao devolver cliente à fila de encaixe",
+				zap.String("walk_in_id", w.ID),
+				zap.Error(rerr))
+		}
+		if horarioIndisponivel(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// O cliente já está na unidade: o agendamento nasce com check-in feito
+	if _, err := d.statusUC.Execute(ctx, appointment.UpdateAppointmentStatusInput{
+		TenantID:      tenantID,
+		UnitID:        unitID,
+		AppointmentID: appt.ID,
+		NewStatus:     valueobject.AppointmentStatusCheckedIn,
+		Reason:        "Encaixe",
+		ActorID:       w.CreatedBy,
+	}); err != nil {
+		common.Logger(ctx, d.logger).Warn("Falha ao fazer check-in do encaixe",
+			zap.String("appointment_id", appt.ID),
+			zap.Error(err))
+	}
+	if _, err := d.turns.RecordTurn(ctx, tenantID, professionalID); err != nil {
+		common.Logger(ctx, d.logger).Warn("Falha ao registrar vez do barbeiro",
+			zap.String("professional_id", professionalID),
+			zap.Error(err))
+	}
+
+	w.AppointmentID = appt.ID
+	if _, err := d.repo.Transition(ctx, w, entity.WalkInStatusAssigned); err != nil {
+		common.Logger(ctx, d.logger).Warn("Falha ao vincular agendamento ao encaixe",
+			zap.String("walk_in_id", w.ID),
+			zap.String("appointment_id", appt.ID),
+			zap.Error(err))
+	}
+
+	common.Logger(ctx, d.logger).Info("Cliente encaixado pela lista da vez",
+		zap.String("tenant_id", tenantID),
+		zap.String("walk_in_id", w.ID),
+		zap.String("professional_id", professionalID),
+		zap.String("appointment_id", appt.ID),
+	)
+	return true, nil
+}
+
+// queue monta a fila de espera da unidade com as previsões de início
+func queue(ctx context.Context, repo port.WalkInRepository, turns port.BarberTurnRepository, appointments port.AppointmentRepository, tenantID, unitID string) ([]QueueItem, error) {
+	now := time.Now()
+	waiting, err := repo.List(ctx, tenantID, unitID, now.Add(-agendaWindow), entity.WalkInStatusWaiting)
+	if err != nil {
+		return nil, err
+	}
+	if len(waiting) == 0 {
+		return []QueueItem{}, nil
+	}
+	agendas, err := loadAgendas(ctx, turns, appointments, tenantID, unitID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	starts := estimateWaits(agendas, waiting, now)
+	items := make([]QueueItem, len(waiting))
+	for i, w := range waiting {
+		items[i] = QueueItem{WalkIn: w, Position: i + 1, EstimatedStart: starts[i]}
+	}
+	return items, nil
+}
+
+// -----------------------------------------------------------------------------
+// Registrar chegada
+// -----------------------------------------------------------------------------
+
+// CheckInInput dados de entrada para registrar a chegada do cliente
+type CheckInInput struct {
+	TenantID   string
+	UnitID     string
+	CustomerID string
+	ServiceIDs []string
+	Notes      string
+	CreatedBy  string
+}
+
+// CheckInUseCase registra a chegada do cliente sem horário marcado e tenta
+// encaixá-lo na hora
+type CheckInUseCase struct {
+	dispatcher
+	customerReader port.CustomerReader
+	serviceReader  port.ServiceReader
+}
+
+// NewCheckInUseCase cria nova instância do use case
+func NewCheckInUseCase(
+	repo port.WalkInRepository,
+	turns port.BarberTurnRepository,
+	appointments port.AppointmentRepository,
+	customerReader port.CustomerReader,
+	serviceReader port.ServiceReader,
+	createUC *appointment.CreateAppointmentUseCase,
+	statusUC *appointment.UpdateAppointmentStatusUseCase,
+	logger *zap.Logger,
+) *CheckInUseCase {
+	return &CheckInUseCase{
+		dispatcher: dispatcher{
+			repo:         repo,
+			turns:        turns,
+			appointments: appointments,
+			createUC:     createUC,
+			statusUC:     statusUC,
+			logger:       logger,
+		},
+		customerReader: customerReader,
+		serviceReader:  serviceReader,
+	}
+}
+
+// Execute registra a chegada; o cliente é encaixado se houver barbeiro livre,
+// senão aguarda na fila com a previsão de início
+func (uc *CheckInUseCase) Execute(ctx context.Context, input CheckInInput) (*QueueItem, error) {
+	ctx, span := common.StartSpan(ctx, "walkin.CheckIn")
+	defer span.End()
+
+	tenantUUID, err := uuid.Parse(input.TenantID)
+	if err != nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	unitUUID, err := uuid.Parse(input.UnitID)
+	if err != nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+
+	customerExists, err := uc.customerReader.Exists(ctx, input.TenantID, input.CustomerID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao verificar cliente: %w", err)
+	}
+	if !customerExists {
+		return nil, domain.ErrAppointmentCustomerNotFound
+	}
+	services, err := uc.serviceReader.FindByIDs(ctx, input.TenantID, input.ServiceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar serviços: %w", err)
+	}
+	if len(services) != len(input.ServiceIDs) {
+		return nil, domain.ErrAppointmentServiceNotFound
+	}
+	duration := 0
+	for _, svc := range services {
+		if !svc.Active {
+			return nil, fmt.Errorf("serviço %s está inativo", svc.Name)
+		}
+		duration += svc.Duration
+	}
+
+	walkIn, err := entity.NewWalkIn(tenantUUID, unitUUID, input.CustomerID, input.ServiceIDs, duration)
+	if err != nil {
+		return nil, err
+	}
+	walkIn.Notes = input.Notes
+	walkIn.CreatedBy = input.CreatedBy
+	if err := uc.repo.Create(ctx, walkIn); err != nil {
+		return nil, err
+	}
+
+	common.Logger(ctx, uc.logger).Info("Chegada registrada na fila de encaixe",
+		zap.String("tenant_id", input.TenantID),
+		zap.String("walk_in_id", walkIn.ID),
+		zap.String("customer_id", input.CustomerID),
+		zap.Int("duration_minutes", duration),
+	)
+
+	// A chegada já está registrada: falha no encaixe deixa o cliente na fila
+	if _, err := uc.dispatch(ctx, input.TenantID, input.UnitID); err != nil {
+		common.Logger(ctx, uc.logger).Error("Falha ao encaixar clientes da fila",
+			zap.String("tenant_id", input.TenantID),
+			zap.Error(err))
+	}
+
+	walkIn, err = uc.repo.FindByID(ctx, input.TenantID, walkIn.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !walkIn.IsWaiting() {
+		return &QueueItem{WalkIn: walkIn}, nil
+	}
+	items, err := queue(ctx, uc.repo, uc.turns, uc.appointments, input.TenantID, input.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		if items[i].WalkIn.ID == walkIn.ID {
+			return &items[i], nil
+		}
+	}
+	return &QueueItem{WalkIn: walkIn}, nil
+}
+
+// -----------------------------------------------------------------------------
+// Fila e chamada do próximo
+// -----------------------------------------------------------------------------
+
+// ListQueueUseCase lista os clientes aguardando com a previsão de início
+type ListQueueUseCase struct {
+	repo         port.WalkInRepository
+	turns        port.BarberTurnRepository
+	appointments port.AppointmentRepository
+	logger       *zap.Logger
+}
+
+// NewListQueueUseCase cria nova instância do use case
+func NewListQueueUseCase(
+	repo port.WalkInRepository,
+	turns port.BarberTurnRepository,
+	appointments port.AppointmentRepository,
+	logger *zap.Logger,
+) *ListQueueUseCase {
+	return &ListQueueUseCase{repo: repo, turns: turns, appointments: appointments, logger: logger}
+}
+
+// Execute lista a fila de encaixe da unidade, na ordem de chegada
+func (uc *ListQueueUseCase) Execute(ctx context.Context, tenantID, unitID string) ([]QueueItem, error) {
+	ctx, span := common.StartSpan(ctx, "walkin.ListQueue")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == "" {
+		return nil, domain.ErrUnitIDRequired
+	}
+	return queue(ctx, uc.repo, uc.turns, uc.appointments, tenantID, unitID)
+}
+
+// AssignNextUseCase chama os próximos da fila quando barbeiros ficam livres
+type AssignNextUseCase struct {
+	dispatcher
+}
+
+// NewAssignNextUseCase cria nova instância do use case
+func NewAssignNextUseCase(
+	repo port.WalkInRepository,
+	turns port.BarberTurnRepository,
+	appointments port.AppointmentRepository,
+	createUC *appointment.CreateAppointmentUseCase,
+	statusUC *appointment.UpdateAppointmentStatusUseCase,
+	logger *zap.Logger,
+) *AssignNextUseCase {
+	return &AssignNextUseCase{dispatcher: dispatcher{
+		repo:         repo,
+		turns:        turns,
+		appointments: appointments,
+		createUC:     createUC,
+		statusUC:     statusUC,
+		logger:       logger,
+	}}
+}
+
+// Execute encaixa os clientes aguardando nos barbeiros livres e retorna os
+// encaixados
+func (uc *AssignNextUseCase) Execute(ctx context.Context, tenantID, unitID string) ([]*entity.WalkIn, error) {
+	ctx, span := common.StartSpan(ctx, "walkin.AssignNext")
+	defer span.End()
+
+	if tenantID == "" {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == "" {
+		return nil, domain.ErrUnitIDRequired
+	}
+	assigned, err := uc.dispatch(ctx, tenantID, unitID)
+	if err != nil {
+		return nil, err
+	}
+	if assigned == nil {
+		assigned = []*entity.WalkIn{}
+	}
+	return assigned, nil
+}
+
+// -----------------------------------------------------------------------------
+// Desistência
+// -----------------------------------------------------------------------------
+
+// CancelUseCase retira da fila o cliente que desistiu
+type CancelUseCase struct {
+	repo   port.WalkInRepository
+	logger *zap.Logger
+}
+
+// NewCancelUseCase cria nova instância do use case
+func NewCancelUseCase(repo port.WalkInRepository, logger *zap.Logger) *CancelUseCase {
+	return &CancelUseCase{repo: repo, logger: logger}
+}
+
+// Execute retira o cliente da fila
+func (uc *CancelUseCase) Execute(ctx context.Context, tenantID, id string) (*entity.WalkIn, error) {
+	ctx, span := common.StartSpan(ctx, "walkin.Cancel")
+	defer span.End()
+
+	walkIn, err := uc.repo.FindByID(ctx, tenantID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := walkIn.Cancel(); err != nil {
+		return nil, err
+	}
+	ok, err := uc.repo.Transition(ctx, walkIn, entity.WalkInStatusWaiting)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrWalkInNotWaiting
+	}
+
+	common.Logger(ctx, uc.logger).Info("Cliente saiu da fila de encaixe",
+		zap.String("tenant_id", tenantID),
+		zap.String("walk_in_id", id),
+	)
+	return walkIn, nil
+}
@@ -0,0 +1,509 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/common"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/outboundwebhook"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// WEBHOOKS DE SAÍDA
+// Endpoints HTTPS cadastrados pelo dono para receber eventos do tenant. O
+// segredo HMAC é gravado cifrado e aparece apenas na criação e na rotação.
+// =============================================================================
+
+// eventDescriptions descreve os eventos exibidos no catálogo
+var eventDescriptions = map[port.EventType]string{
+	port.EventAppointmentCreated:       "Agendamento criado",
+	port.EventAppointmentStatusChanged: "Status do agendamento alterado (confirmado, atendido, cancelado, falta...)",
+	port.EventCommandClosed:            "Comanda fechada",
+	port.EventCaixaClosed:              "Caixa diário fechado",
+	port.EventSubscriptionOverdue:      "Assinatura inadimplente",
+	port.EventStockBelowMinimum:        "Produto atingiu o estoque mínimo",
+}
+
+// WebhookEventCatalog monta o catálogo de eventos exibido ao dono
+func WebhookEventCatalog() []dto.WebhookEventResponse {
+	out := make([]dto.WebhookEventResponse, len(port.EventTypes))
+	for i, e := range port.EventTypes {
+		out[i] = dto.WebhookEventResponse{Code: string(e), Descricao: eventDescriptions[e]}
+	}
+	return out
+}
+
+// validarEventos confere os eventos contra o catálogo, remove duplicados e ordena
+func validarEventos(codes []string) ([]string, error) {
+	vistos := map[string]bool{}
+	out := make([]string, 0, len(codes))
+	for _, c := range codes {
+		c = strings.TrimSpace(c)
+		if !port.EventType(c).IsValid() {
+			return nil, domain.ErrWebhookEventoInvalido
+		}
+		if !vistos[c] {
+			vistos[c] = true
+			out = append(out, c)
+		}
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+// validarURL exige https e recusa hosts internos informados diretamente. O
+// dispatcher repete a checagem no dial, após a resolução de DNS.
+func validarURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" || u.User != nil {
+		return "", domain.ErrWebhookURLInvalida
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".internal") {
+		return "", domain.ErrWebhookURLInvalida
+	}
+	if ip := net.ParseIP(host); ip != nil && (ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast()) {
+		return "", domain.ErrWebhookURLInvalida
+	}
+	return raw, nil
+}
+
+func formatTimestamptz(t pgtype.Timestamptz) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.Time.Format(time.RFC3339)
+	return &s
+}
+
+func toEndpointResponse(e db.WebhookEndpoint) dto.WebhookEndpointResponse {
+	eventos := e.Eventos
+	if eventos == nil {
+		eventos = []string{}
+	}
+	return dto.WebhookEndpointResponse{
+		ID:           e.ID.String(),
+		URL:          e.Url,
+		Descricao:    e.Descricao,
+		Eventos:      eventos,
+		Ativo:        e.Ativo,
+		CriadoEm:     e.CriadoEm.Time.Format(time.RFC3339),
+		AtualizadoEm: e.AtualizadoEm.Time.Format(time.RFC3339),
+	}
+}
+
+func toDeliveryResponse(d db.WebhookDelivery) dto.WebhookDeliveryResponse {
+	out := dto.WebhookDeliveryResponse{
+		ID:               d.ID.String(),
+		EndpointID:       d.EndpointID.String(),
+		Evento:           d.Evento,
+		EventID:          d.EventID.String(),
+		Status:           d.Status,
+		Tentativas:       d.Tentativas,
+		UltimoStatusHTTP: d.UltimoStatusHttp,
+		UltimoErro:       d.UltimoErro,
+		EntregueEm:       formatTimestamptz(d.EntregueEm),
+		CriadoEm:         d.CriadoEm.Time.Format(time.RFC3339),
+	}
+	if d.Status == outboundwebhook.StatusPendente {
+		out.ProximaTentativaEm = formatTimestamptz(d.ProximaTentativaEm)
+	}
+	return out
+}
+
+// scanIDs converte tenant e id da rota
+func scanIDs(tenantID, id string, notFound error) (pgtype.UUID, pgtype.UUID, error) {
+	var tenantUUID, idUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return tenantUUID, idUUID, domain.ErrInvalidTenantID
+	}
+	if err := idUUID.Scan(id); err != nil {
+		return tenantUUID, idUUID, notFound
+	}
+	return tenantUUID, idUUID, nil
+}
+
+// -----------------------------------------------------------------------------
+// Endpoints
+// -----------------------------------------------------------------------------
+
+type ListWebhookEndpointsUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewListWebhookEndpointsUseCase(queries *db.Queries, logger *zap.Logger) *ListWebhookEndpointsUseCase {
+	return &ListWebhookEndpointsUseCase{queries: queries, logger: logger}
+}
+
+// Execute lista os endpoints do tenant
+func (uc *ListWebhookEndpointsUseCase) Execute(ctx context.Context, tenantID string) ([]dto.WebhookEndpointResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.ListWebhookEndpoints")
+	defer span.End()
+
+	var tenantUUID pgtype.UUID
+	if err := tenantUUID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+
+	rows, err := uc.queries.ListWebhookEndpoints(ctx, tenantUUID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar endpoints de webhook: %w", err)
+	}
+
+	out := make([]dto.WebhookEndpointResponse, len(rows))
+	for i, e := range rows {
+		out[i] = toEndpointResponse(e)
+	}
+	return out, nil
+}
+
+type CreateWebhookEndpointUseCase struct {
+	queries *db.Queries
+	cipher  *auth.SecretCipher
+	logger  *zap.Logger
+}
+
+func NewCreateWebhookEndpointUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *CreateWebhookEndpointUseCase {
+	return &CreateWebhookEndpointUseCase{queries: queries, cipher: cipher, logger: logger}
+}
+
+// Execute cadastra o endpoint e devolve o segredo de assinatura uma única vez
+func (uc *CreateWebhookEndpointUseCase) Execute(ctx context.Context, tenantID, userID string, req dto.CreateWebhookEndpointRequest) (*dto.WebhookEndpointSecretResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.CreateWebhookEndpoint")
+	defer span.End()
+
+	params := db.CreateWebhookEndpointParams{Descricao: req.Descricao}
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	if err := params.CriadoPor.Scan(userID); err != nil {
+		return nil, domain.ErrInvalidID
+	}
+
+	u, err := validarURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	params.Url = u
+
+	eventos, err := validarEventos(req.Eventos)
+	if err != nil {
+		return nil, err
+	}
+	params.Eventos = eventos
+
+	secret, err := outboundwebhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	if params.SecretCifrado, err = uc.cipher.Encrypt(secret); err != nil {
+		return nil, fmt.Errorf("erro ao cifrar segredo do webhook: %w", err)
+	}
+
+	endpoint, err := uc.queries.CreateWebhookEndpoint(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar endpoint de webhook: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Endpoint de webhook criado",
+		zap.String("tenant_id", tenantID),
+		zap.String("endpoint_id", endpoint.ID.String()),
+		zap.Strings("eventos", eventos),
+		zap.String("criado_por", userID),
+	)
+
+	return &dto.WebhookEndpointSecretResponse{
+		WebhookEndpointResponse: toEndpointResponse(endpoint),
+		Secret:                  secret,
+	}, nil
+}
+
+type UpdateWebhookEndpointUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewUpdateWebhookEndpointUseCase(queries *db.Queries, logger *zap.Logger) *UpdateWebhookEndpointUseCase {
+	return &UpdateWebhookEndpointUseCase{queries: queries, logger: logger}
+}
+
+// Execute altera URL, eventos e ativação. Entregas pendentes de um endpoint
+// desativado aguardam a reativação.
+func (uc *UpdateWebhookEndpointUseCase) Execute(ctx context.Context, tenantID, id string, req dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.UpdateWebhookEndpoint")
+	defer span.End()
+
+	tenantUUID, idUUID, err := scanIDs(tenantID, id, domain.ErrWebhookEndpointNaoEncontrado)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := validarURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	eventos, err := validarEventos(req.Eventos)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := uc.queries.UpdateWebhookEndpoint(ctx, db.UpdateWebhookEndpointParams{
+		ID:        idUUID,
+		TenantID:  tenantUUID,
+		Url:       u,
+		Descricao: req.Descricao,
+		Eventos:   eventos,
+		Ativo:     req.Ativo,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookEndpointNaoEncontrado
+		}
+		return nil, fmt.Errorf("erro ao atualizar endpoint de webhook: %w", err)
+	}
+
+	resp := toEndpointResponse(endpoint)
+	return &resp, nil
+}
+
+type RotateWebhookSecretUseCase struct {
+	queries *db.Queries
+	cipher  *auth.SecretCipher
+	logger  *zap.Logger
+}
+
+func NewRotateWebhookSecretUseCase(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *RotateWebhookSecretUseCase {
+	return &RotateWebhookSecretUseCase{queries: queries, cipher: cipher, logger: logger}
+}
+
+// Execute gera um novo segredo; as próximas tentativas já usam o novo valor
+func (uc *RotateWebhookSecretUseCase) Execute(ctx context.Context, tenantID, id string) (*dto.WebhookEndpointSecretResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.RotateWebhookSecret")
+	defer span.End()
+
+	tenantUUID, idUUID, err := scanIDs(tenantID, id, domain.ErrWebhookEndpointNaoEncontrado)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := outboundwebhook.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	enc, err := uc.cipher.Encrypt(secret)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao cifrar segredo do webhook: %w", err)
+	}
+
+	n, err := uc.queries.RotateWebhookEndpointSecret(ctx, db.RotateWebhookEndpointSecretParams{
+		ID:            idUUID,
+		TenantID:      tenantUUID,
+		SecretCifrado: enc,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao rotacionar segredo do webhook: %w", err)
+	}
+	if n == 0 {
+		return nil, domain.ErrWebhookEndpointNaoEncontrado
+	}
+
+	endpoint, err := uc.queries.GetWebhookEndpoint(ctx, db.GetWebhookEndpointParams{ID: idUUID, TenantID: tenantUUID})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar endpoint de webhook: %w", err)
+	}
+
+	common.Logger(ctx, uc.logger).Info("Segredo de webhook rotacionado",
+		zap.String("tenant_id", tenantID),
+		zap.String("endpoint_id", id),
+	)
+
+	return &dto.WebhookEndpointSecretResponse{
+		WebhookEndpointResponse: toEndpointResponse(endpoint),
+		Secret:                  secret,
+	}, nil
+}
+
+type DeleteWebhookEndpointUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewDeleteWebhookEndpointUseCase(queries *db.Queries, logger *zap.Logger) *DeleteWebhookEndpointUseCase {
+	return &DeleteWebhookEndpointUseCase{queries: queries, logger: logger}
+}
+
+// Execute remove o endpoint junto com as entregas e o histórico
+func (uc *DeleteWebhookEndpointUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "webhook.DeleteWebhookEndpoint")
+	defer span.End()
+
+	tenantUUID, idUUID, err := scanIDs(tenantID, id, domain.ErrWebhookEndpointNaoEncontrado)
+	if err != nil {
+		return err
+	}
+
+	n, err := uc.queries.DeleteWebhookEndpoint(ctx, db.DeleteWebhookEndpointParams{ID: idUUID, TenantID: tenantUUID})
+	if err != nil {
+		return fmt.Errorf("erro ao remover endpoint de webhook: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrWebhookEndpointNaoEncontrado
+	}
+
+	common.Logger(ctx, uc.logger).Info("Endpoint de webhook removido",
+		zap.String("tenant_id", tenantID),
+		zap.String("endpoint_id", id),
+	)
+	return nil
+}
+
+// -----------------------------------------------------------------------------
+// Entregas
+// -----------------------------------------------------------------------------
+
+type ListWebhookDeliveriesUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewListWebhookDeliveriesUseCase(queries *db.Queries, logger *zap.Logger) *ListWebhookDeliveriesUseCase {
+	return &ListWebhookDeliveriesUseCase{queries: queries, logger: logger}
+}
+
+// Execute lista as entregas mais recentes (padrão 50, máximo 200)
+func (uc *ListWebhookDeliveriesUseCase) Execute(ctx context.Context, tenantID string, filter dto.ListWebhookDeliveriesFilter) ([]dto.WebhookDeliveryResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.ListWebhookDeliveries")
+	defer span.End()
+
+	params := db.ListWebhookDeliveriesParams{Limite: 50}
+	if err := params.TenantID.Scan(tenantID); err != nil {
+		return nil, domain.ErrInvalidTenantID
+	}
+	if filter.EndpointID != "" {
+		if err := params.EndpointID.Scan(filter.EndpointID); err != nil {
+			return nil, domain.ErrWebhookEndpointNaoEncontrado
+		}
+	}
+	if filter.Status != "" {
+		status := strings.ToUpper(filter.Status)
+		params.Status = &status
+	}
+	if filter.Limit > 0 {
+		params.Limite = int32(min(filter.Limit, 200))
+	}
+
+	rows, err := uc.queries.ListWebhookDeliveries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar entregas de webhook: %w", err)
+	}
+
+	out := make([]dto.WebhookDeliveryResponse, len(rows))
+	for i, d := range rows {
+		out[i] = toDeliveryResponse(d)
+	}
+	return out, nil
+}
+
+type GetWebhookDeliveryUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewGetWebhookDeliveryUseCase(queries *db.Queries, logger *zap.Logger) *GetWebhookDeliveryUseCase {
+	return &GetWebhookDeliveryUseCase{queries: queries, logger: logger}
+}
+
+// Execute retorna a entrega com payload e histórico de tentativas
+func (uc *GetWebhookDeliveryUseCase) Execute(ctx context.Context, tenantID, id string) (*dto.WebhookDeliveryDetailResponse, error) {
+	ctx, span := common.StartSpan(ctx, "webhook.GetWebhookDelivery")
+	defer span.End()
+
+	tenantUUID, idUUID, err := scanIDs(tenantID, id, domain.ErrWebhookEntregaNaoEncontrada)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := uc.queries.GetWebhookDelivery(ctx, db.GetWebhookDeliveryParams{ID: idUUID, TenantID: tenantUUID})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWebhookEntregaNaoEncontrada
+		}
+		return nil, fmt.Errorf("erro ao buscar entrega de webhook: %w", err)
+	}
+
+	attempts, err := uc.queries.ListWebhookDeliveryAttempts(ctx, d.ID)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar tentativas do webhook: %w", err)
+	}
+
+	out := &dto.WebhookDeliveryDetailResponse{
+		WebhookDeliveryResponse: toDeliveryResponse(d),
+		Historico:               make([]dto.WebhookDeliveryAttemptResponse, len(attempts)),
+	}
+	if err := json.Unmarshal(d.Payload, &out.Payload); err != nil {
+		return nil, fmt.Errorf("payload do webhook inválido: %w", err)
+	}
+	for i, a := range attempts {
+		out.Historico[i] = dto.WebhookDeliveryAttemptResponse{
+			Tentativa:  a.Tentativa,
+			StatusHTTP: a.StatusHttp,
+			Erro:       a.Erro,
+			Resposta:   a.Resposta,
+			DuracaoMs:  a.DuracaoMs,
+			CriadoEm:   a.CriadoEm.Time.Format(time.RFC3339),
+		}
+	}
+	return out, nil
+}
+
+type RedeliverWebhookUseCase struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+func NewRedeliverWebhookUseCase(queries *db.Queries, logger *zap.Logger) *RedeliverWebhookUseCase {
+	return &RedeliverWebhookUseCase{queries: queries, logger: logger}
+}
+
+// Execute recoloca a entrega na fila para envio imediato, com o mesmo
+// payload e event_id (o receptor deve tratar como idempotente)
+func (uc *RedeliverWebhookUseCase) Execute(ctx context.Context, tenantID, id string) error {
+	ctx, span := common.StartSpan(ctx, "webhook.RedeliverWebhook")
+	defer span.End()
+
+	tenantUUID, idUUID, err := scanIDs(tenantID, id, domain.ErrWebhookEntregaNaoEncontrada)
+	if err != nil {
+		return err
+	}
+
+	n, err := uc.queries.RedeliverWebhookDelivery(ctx, db.RedeliverWebhookDeliveryParams{ID: idUUID, TenantID: tenantUUID})
+	if err != nil {
+		return fmt.Errorf("erro ao reenviar webhook: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrWebhookEntregaNaoEncontrada
+	}
+
+	common.Logger(ctx, uc.logger).Info("Reenvio de webhook solicitado",
+		zap.String("tenant_id", tenantID),
+		zap.String("delivery_id", id),
+	)
+	return nil
+}
@@ -13,7 +13,7 @@ type Appointment struct {
 	ID             string
 	TenantID       uuid.UUID
 	UnitID         uuid.UUID
-	ProfessionalID string
+	ProfessionalID string // Profissional responsável (o do primeiro serviço)
 	CustomerID     string
 
 	StartTime time.Time
@@ -31,7 +31,8 @@ type Appointment struct {
 	CommandID             string // Comanda vinculada ao agendamento
 
 	// Relacionamentos (carregados via join)
-	Services []AppointmentService
+	Services  []AppointmentService
+	Resources []ResourceAllocation // Recursos da unidade reservados pelos serviços
 
 	// Dados denormalizados (read-only, vêm do JOIN)
 	ProfessionalName string
@@ -42,19 +43,37 @@ type Appointment struct {
 	UpdatedAt time.Time
 }
 
-// AppointmentService representa um serviço vinculado a um agendamento
+// AppointmentService representa um serviço vinculado a um agendamento.
+// Cada serviço tem o seu profissional e o seu trecho de horário dentro do
+// agendamento (ex.: corte com João e em seguida barba com Pedro).
 type AppointmentService struct {
 	AppointmentID     string
 	ServiceID         string
+	ProfessionalID    string
 	PriceAtBooking    valueobject.Money
 	DurationAtBooking int // em minutos
+	Sequence          int // ordem de execução dentro do agendamento
+	StartTime         time.Time
+	EndTime           time.Time
 	CreatedAt         time.Time
 
 	// Dados do serviço (carregados via join)
-	ServiceName string
+	ServiceName      string
+	ProfessionalName string
+}
+
+// ProfessionalSlot trecho contínuo da agenda de um profissional ocupado pelo
+// agendamento
+type ProfessionalSlot struct {
+	ProfessionalID string
+	StartTime      time.Time
+	EndTime        time.Time
 }
 
-// NewAppointment cria um novo agendamento validado
+// NewAppointment cria um novo agendamento validado. Os serviços são
+// executados na ordem recebida, um após o outro; serviços sem profissional
+// ficam com o profissional informado, e na falta dele o responsável passa a
+// ser o profissional do primeiro serviço.
 func NewAppointment(
 	tenantID uuid.UUID,
 	unitID uuid.UUID,
@@ -69,6 +88,9 @@ func NewAppointment(
 	if unitID == uuid.Nil {
 		return nil, domain.ErrUnitIDRequired
 	}
+	if len(services) > 0 && professionalID == "" {
+		professionalID = services[0].ProfessionalID
+	}
 	if professionalID == "" {
 		return nil, domain.ErrAppointmentProfessionalRequired
 	}
@@ -82,19 +104,29 @@ func NewAppointment(
 		return nil, domain.ErrAppointmentServicesRequired
 	}
 
-	// Calcula duração total e preço total
-	var totalDuration int
+	// Monta a sequência dos serviços e calcula horário final e preço total
+	id := uuid.NewString()
+	scheduled := make([]AppointmentService, len(services))
+	cursor := startTime
 	totalPrice := valueobject.Zero()
-	for _, s := range services {
-		totalDuration += s.DurationAtBooking
+	for i, s := range services {
+		if s.ProfessionalID == "" {
+			s.ProfessionalID = professionalID
+		}
+		s.AppointmentID = id
+		s.Sequence = i + 1
+		s.StartTime = cursor
+		s.EndTime = cursor.Add(time.Duration(s.DurationAtBooking) * time.Minute)
+		cursor = s.EndTime
 		totalPrice = totalPrice.Add(s.PriceAtBooking)
+		scheduled[i] = s
 	}
 
-	endTime := startTime.Add(time.Duration(totalDuration) * time.Minute)
+	endTime := cursor
 
 	now := time.Now()
 	return &Appointment{
-		ID:             uuid.NewString(),
+		ID:             id,
 		TenantID:       tenantID,
 		UnitID:         unitID,
 		ProfessionalID: professionalID,
@@ -103,7 +135,7 @@ func NewAppointment(
 		EndTime:        endTime,
 		Status:         valueobject.AppointmentStatusCreated,
 		TotalPrice:     totalPrice,
-		Services:       services,
+		Services:       scheduled,
 		CreatedAt:      now,
 		UpdatedAt:      now,
 	}, nil
@@ -200,14 +232,137 @@ func (a *Appointment) Reschedule(newStartTime time.Time) error {
 		return domain.ErrAppointmentCannotReschedule
 	}
 
-	// Mantém a duração original
+	// Mantém a duração original e desloca junto o horário de cada serviço
+	delta := newStartTime.Sub(a.StartTime)
 	duration := a.EndTime.Sub(a.StartTime)
 	a.StartTime = newStartTime
 	a.EndTime = newStartTime.Add(duration)
+	services := make([]AppointmentService, len(a.Services))
+	for i, s := range a.Services {
+		s.StartTime = s.StartTime.Add(delta)
+		s.EndTime = s.EndTime.Add(delta)
+		services[i] = s
+	}
+	a.Services = services
 	a.UpdatedAt = time.Now()
 	return nil
 }
 
+// ReassignProfessional passa os serviços de um profissional para outro. Se
+// o profissional substituído era o responsável, o novo assume o agendamento.
+func (a *Appointment) ReassignProfessional(from, to string) {
+	if from == to || to == "" {
+		return
+	}
+	services := make([]AppointmentService, len(a.Services))
+	for i, s := range a.Services {
+		if s.ProfessionalID == from {
+			s.ProfessionalID = to
+			s.ProfessionalName = ""
+		}
+		services[i] = s
+	}
+	a.Services = services
+	if a.ProfessionalID == from {
+		a.ProfessionalID = to
+		a.ProfessionalName = ""
+	}
+	a.UpdatedAt = time.Now()
+}
+
+// ProfessionalSlots retorna os trechos de agenda ocupados por cada
+// profissional, juntando serviços consecutivos do mesmo profissional.
+// Agendamentos sem serviços carregados ocupam o profissional responsável no
+// horário inteiro.
+func (a *Appointment) ProfessionalSlots() []ProfessionalSlot {
+	if len(a.Services) == 0 {
+		return []ProfessionalSlot{{ProfessionalID: a.ProfessionalID, StartTime: a.StartTime, EndTime: a.EndTime}}
+	}
+	slots := make([]ProfessionalSlot, 0, len(a.Services))
+	for _, s := range a.Services {
+		if n := len(slots); n > 0 && slots[n-1].ProfessionalID == s.ProfessionalID && !s.StartTime.After(slots[n-1].EndTime) {
+			if s.EndTime.After(slots[n-1].EndTime) {
+				slots[n-1].EndTime = s.EndTime
+			}
+			continue
+		}
+		slots = append(slots, ProfessionalSlot{ProfessionalID: s.ProfessionalID, StartTime: s.StartTime, EndTime: s.EndTime})
+	}
+	return slots
+}
+
+// InvolvesProfessional verifica se o profissional é o responsável ou
+// executa algum serviço do agendamento
+func (a *Appointment) InvolvesProfessional(professionalID string) bool {
+	if a.ProfessionalID == professionalID {
+		return true
+	}
+	for _, s := range a.Services {
+		if s.ProfessionalID == professionalID {
+			return true
+		}
+	}
+	return false
+}
+
+// AllocateResources reserva para cada serviço do agendamento os recursos
+// que ele exige
+func (a *Appointment) AllocateResources(requirements []ServiceResourceRequirement) {
+	a.Resources = nil
+	for _, svc := range a.Services {
+		for _, req := range requirements {
+			if req.ServiceID != svc.ServiceID {
+				continue
+			}
+			a.Resources = append(a.Resources, ResourceAllocation{
+				ServiceID:       req.ServiceID,
+				ResourceID:      req.ResourceID,
+				OffsetMinutes:   req.OffsetMinutes,
+				DurationMinutes: req.DurationMinutes,
+				ResourceName:    req.ResourceName,
+			})
+		}
+	}
+}
+
+// ResourceWindows retorna os períodos em que o agendamento ocupa cada
+// recurso, no horário atual dos serviços
+func (a *Appointment) ResourceWindows() []ResourceWindow {
+	windows := make([]ResourceWindow, 0, len(a.Resources))
+	for _, al := range a.Resources {
+		for _, svc := range a.Services {
+			if svc.ServiceID != al.ServiceID {
+				continue
+			}
+			if w, ok := al.window(svc); ok {
+				windows = append(windows, w)
+			}
+			break
+		}
+	}
+	return windows
+}
+
+// ServiceProfessional retorna o profissional que executa a occurrence-ésima
+// linha (0 = primeira) do serviço: o mesmo serviço pode ser marcado mais de
+// uma vez com profissionais diferentes. Linhas que não fazem parte do
+// agendamento ficam com o responsável.
+func (a *Appointment) ServiceProfessional(serviceID string, occurrence int) string {
+	for _, s := range a.Services {
+		if s.ServiceID != serviceID {
+			continue
+		}
+		if occurrence == 0 {
+			if s.ProfessionalID != "" {
+				return s.ProfessionalID
+			}
+			break
+		}
+		occurrence--
+	}
+	return a.ProfessionalID
+}
+
 // SetNotes define observações
 func (a *Appointment) SetNotes(notes string) {
 	a.Notes = notes
@@ -251,18 +406,22 @@ func (a *Appointment) IsToday() bool {
 		a.StartTime.YearDay() == now.YearDay()
 }
 
-// ConflictsWith verifica se há conflito de horário com outro agendamento
+// ConflictsWith verifica se há conflito de horário com outro agendamento:
+// o mesmo profissional ocupado em trechos sobrepostos
 func (a *Appointment) ConflictsWith(other *Appointment) bool {
-	// Mesmo profissional
-	if a.ProfessionalID != other.ProfessionalID {
-		return false
-	}
 	// Ignora se for o mesmo agendamento
 	if a.ID == other.ID {
 		return false
 	}
-	// Verifica sobreposição de horários
-	return a.StartTime.Before(other.EndTime) && a.EndTime.After(other.StartTime)
+	for _, mine := range a.ProfessionalSlots() {
+		for _, theirs := range other.ProfessionalSlots() {
+			if mine.ProfessionalID == theirs.ProfessionalID &&
+				mine.StartTime.Before(theirs.EndTime) && mine.EndTime.After(theirs.StartTime) {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 // Validate valida as regras de negócio
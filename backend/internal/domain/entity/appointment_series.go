@@ -0,0 +1,172 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Status da série de agendamentos
+const (
+	AppointmentSeriesStatusActive = "ACTIVE"
+	AppointmentSeriesStatusEnded  = "ENDED"
+)
+
+// Status de uma ocorrência gerada da série
+const (
+	SeriesOccurrenceBooked   = "BOOKED"   // virou agendamento
+	SeriesOccurrenceConflict = "CONFLICT" // horário ocupado ou bloqueado
+	SeriesOccurrenceSkipped  = "SKIPPED"  // horário já havia passado
+)
+
+// MaxSeriesIntervalWeeks limita o intervalo entre ocorrências
+const MaxSeriesIntervalWeeks = 8
+
+// AppointmentSeries representa um agendamento recorrente: mesmo cliente,
+// profissional, serviços e horário a cada IntervalWeeks semanas, a partir de
+// StartTime. As ocorrências viram agendamentos comuns, gerados com
+// antecedência até GeneratedUntil.
+type AppointmentSeries struct {
+	ID             string
+	TenantID       uuid.UUID
+	UnitID         uuid.UUID
+	ProfessionalID string
+	CustomerID     string
+	ServiceIDs     []string
+
+	StartTime     time.Time  // primeira ocorrência (dia da semana e horário)
+	IntervalWeeks int        // 1 = semanal, 2 = quinzenal ...
+	EndsOn        *time.Time // último dia com ocorrência; nil = sem fim
+	Notes         string
+
+	Status         string
+	EndedReason    string
+	EndedAt        *time.Time
+	GeneratedUntil *time.Time
+	CreatedBy      string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AppointmentSeriesOccurrence é o resultado da geração de uma ocorrência
+type AppointmentSeriesOccurrence struct {
+	SeriesID       string
+	Index          int
+	TenantID       uuid.UUID
+	ScheduledStart time.Time
+	AppointmentID  string // vazio quando não virou agendamento
+	Status         string
+	Detail         string // motivo do conflito
+}
+
+// NewAppointmentSeries cria uma série validada
+func NewAppointmentSeries(
+	tenantID uuid.UUID,
+	unitID uuid.UUID,
+	professionalID string,
+	customerID string,
+	serviceIDs []string,
+	startTime time.Time,
+	intervalWeeks int,
+	endsOn *time.Time,
+) (*AppointmentSeries, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == uuid.Nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	if professionalID == "" {
+		return nil, domain.ErrAppointmentProfessionalRequired
+	}
+	if customerID == "" {
+		return nil, domain.ErrAppointmentCustomerRequired
+	}
+	if startTime.IsZero() {
+		return nil, domain.ErrAppointmentStartTimeRequired
+	}
+	if len(serviceIDs) == 0 {
+		return nil, domain.ErrAppointmentServicesRequired
+	}
+	if intervalWeeks < 1 || intervalWeeks > MaxSeriesIntervalWeeks {
+		return nil, domain.ErrAppointmentSeriesIntervalInvalid
+	}
+	if endsOn != nil && endsOn.Before(startTime) {
+		return nil, domain.ErrAppointmentSeriesEndInvalid
+	}
+
+	now := time.Now()
+	return &AppointmentSeries{
+		ID:             uuid.NewString(),
+		TenantID:       tenantID,
+		UnitID:         unitID,
+		ProfessionalID: professionalID,
+		CustomerID:     customerID,
+		ServiceIDs:     serviceIDs,
+		StartTime:      startTime,
+		IntervalWeeks:  intervalWeeks,
+		EndsOn:         endsOn,
+		Status:         AppointmentSeriesStatusActive,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// IsActive indica se a série ainda gera ocorrências
+func (s *AppointmentSeries) IsActive() bool {
+	return s.Status == AppointmentSeriesStatusActive
+}
+
+// OccurrenceStart retorna o início da ocorrência de índice informado. AddDate
+// preserva o horário de parede no fuso de StartTime.
+func (s *AppointmentSeries) OccurrenceStart(index int) time.Time {
+	return s.StartTime.AddDate(0, 0, 7*s.IntervalWeeks*index)
+}
+
+// HasOccurrence indica se a ocorrência cabe no período da série
+func (s *AppointmentSeries) HasOccurrence(index int) bool {
+	if index < 0 {
+		return false
+	}
+	if s.EndsOn == nil {
+		return true
+	}
+	// EndsOn é um dia: a ocorrência vale até o fim dele
+	limite := time.Date(s.EndsOn.Year(), s.EndsOn.Month(), s.EndsOn.Day(), 0, 0, 0, 0, s.StartTime.Location()).AddDate(0, 0, 1)
+	return s.OccurrenceStart(index).Before(limite)
+}
+
+// End encerra a série; nenhuma nova ocorrência é gerada
+func (s *AppointmentSeries) End(reason string) error {
+	if !s.IsActive() {
+		return domain.ErrAppointmentSeriesEnded
+	}
+	now := time.Now()
+	s.Status = AppointmentSeriesStatusEnded
+	s.EndedReason = reason
+	s.EndedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// EndBefore encerra a série antes da ocorrência informada (usado em "esta e
+// as seguintes"): o último dia passa a ser o da ocorrência anterior.
+func (s *AppointmentSeries) EndBefore(index int, reason string) error {
+	if index > 0 {
+		ultimo := s.OccurrenceStart(index - 1)
+		s.EndsOn = &ultimo
+	}
+	return s.End(reason)
+}
+
+// Shift desloca as ocorrências da série (novo horário e/ou profissional a
+// partir de uma ocorrência, em "esta e as seguintes")
+func (s *AppointmentSeries) Shift(delta time.Duration, professionalID string) {
+	s.StartTime = s.StartTime.Add(delta)
+	if professionalID != "" {
+		s.ProfessionalID = professionalID
+	}
+	s.UpdatedAt = time.Now()
+}
@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// AppointmentStatusChange registro do histórico de status do agendamento.
+// O histórico é somente inclusão: cada transição gera um registro novo.
+type AppointmentStatusChange struct {
+	ID            string
+	TenantID      uuid.UUID
+	AppointmentID string
+	FromStatus    valueobject.AppointmentStatus
+	ToStatus      valueobject.AppointmentStatus
+	ActorID       string // usuário; vazio para jobs e webhooks
+	ActorName     string // preenchido na listagem
+	Reason        string
+	ChangedAt     time.Time
+}
+
+// NewAppointmentStatusChange registra a transição do agendamento a partir do
+// status anterior; o status novo é o atual do agendamento
+func NewAppointmentStatusChange(a *Appointment, from valueobject.AppointmentStatus, actorID, reason string) *AppointmentStatusChange {
+	return &AppointmentStatusChange{
+		TenantID:      a.TenantID,
+		AppointmentID: a.ID,
+		FromStatus:    from,
+		ToStatus:      a.Status,
+		ActorID:       actorID,
+		Reason:        reason,
+		ChangedAt:     time.Now(),
+	}
+}
+
+const (
+	// DefaultPunctualityTolerance atraso aceito na chegada do cliente e no
+	// início do atendimento antes de contar como atraso
+	DefaultPunctualityTolerance = 5 * time.Minute
+
+	// LateCancellationWindow cancelamentos com menos antecedência que isso
+	// contam como cancelamento em cima da hora
+	LateCancellationWindow = 24 * time.Hour
+)
+
+// AppointmentTimingStats somas e contagens dos tempos da operação de um
+// profissional (ou de uma unidade, somando os profissionais). Os tempos são
+// somados em segundos para que grupos possam ser agregados sem distorcer as
+// médias. Check-in, início e fim são registrados por agendamento, não por
+// serviço: espera, duração e pontualidade contam só para o responsável
+// (appointments.professional_id). Os profissionais dos demais serviços contam
+// o agendamento e o cancelamento, medido a partir do seu primeiro serviço.
+type AppointmentTimingStats struct {
+	UnitID           string
+	UnitName         string
+	ProfessionalID   string
+	ProfessionalName string
+	Responsible      bool // linha do responsável; as demais não têm tempos de atendimento
+
+	Appointments int
+
+	// Espera: do check-in ao início do atendimento
+	WaitCount   int
+	WaitSeconds float64
+
+	// Duração real (início ao fim) comparada à soma de Servico.Duracao
+	ServiceCount    int
+	ServiceSeconds  float64
+	ExpectedSeconds float64
+	OverrunCount    int // atendimentos que passaram da duração prevista
+
+	// Pontualidade do cliente (check-in) e do atendimento (início) em relação
+	// ao horário marcado; atrasos até a tolerância não contam
+	CheckInCount        int
+	LateArrivals        int
+	ArrivalDelaySeconds float64
+	StartCount          int
+	LateStarts          int
+	StartDelaySeconds   float64
+
+	// Antecedência dos cancelamentos em relação ao horário marcado
+	CancelCount       int
+	CancelLeadSeconds float64
+	LateCancellations int
+}
+
+// Add soma os números de outro grupo (ex.: profissionais de uma unidade)
+func (s *AppointmentTimingStats) Add(o AppointmentTimingStats) {
+	s.Appointments += o.Appointments
+	s.WaitCount += o.WaitCount
+	s.WaitSeconds += o.WaitSeconds
+	s.ServiceCount += o.ServiceCount
+	s.ServiceSeconds += o.ServiceSeconds
+	s.ExpectedSeconds += o.ExpectedSeconds
+	s.OverrunCount += o.OverrunCount
+	s.CheckInCount += o.CheckInCount
+	s.LateArrivals += o.LateArrivals
+	s.ArrivalDelaySeconds += o.ArrivalDelaySeconds
+	s.StartCount += o.StartCount
+	s.LateStarts += o.LateStarts
+	s.StartDelaySeconds += o.StartDelaySeconds
+	s.CancelCount += o.CancelCount
+	s.CancelLeadSeconds += o.CancelLeadSeconds
+	s.LateCancellations += o.LateCancellations
+}
+
+// AvgWaitMinutes espera média entre o check-in e o início do atendimento
+func (s AppointmentTimingStats) AvgWaitMinutes() float64 {
+	return timingAverage(s.WaitSeconds/60, s.WaitCount)
+}
+
+// AvgServiceMinutes duração média real dos atendimentos
+func (s AppointmentTimingStats) AvgServiceMinutes() float64 {
+	return timingAverage(s.ServiceSeconds/60, s.ServiceCount)
+}
+
+// AvgExpectedMinutes duração média prevista pelos serviços dos atendimentos
+func (s AppointmentTimingStats) AvgExpectedMinutes() float64 {
+	return timingAverage(s.ExpectedSeconds/60, s.ServiceCount)
+}
+
+// AvgOverrunMinutes diferença média entre a duração real e a prevista
+// (negativa quando os atendimentos terminam antes)
+func (s AppointmentTimingStats) AvgOverrunMinutes() float64 {
+	return timingAverage((s.ServiceSeconds-s.ExpectedSeconds)/60, s.ServiceCount)
+}
+
+// AvgArrivalDelayMinutes atraso médio do cliente (chegadas adiantadas contam
+// como zero)
+func (s AppointmentTimingStats) AvgArrivalDelayMinutes() float64 {
+	return timingAverage(s.ArrivalDelaySeconds/60, s.CheckInCount)
+}
+
+// OnTimeArrivalRate percentual de check-ins dentro da tolerância
+func (s AppointmentTimingStats) OnTimeArrivalRate() float64 {
+	return timingRate(s.CheckInCount-s.LateArrivals, s.CheckInCount)
+}
+
+// AvgStartDelayMinutes atraso médio no início do atendimento
+func (s AppointmentTimingStats) AvgStartDelayMinutes() float64 {
+	return timingAverage(s.StartDelaySeconds/60, s.StartCount)
+}
+
+// OnTimeStartRate percentual de atendimentos iniciados dentro da tolerância
+func (s AppointmentTimingStats) OnTimeStartRate() float64 {
+	return timingRate(s.StartCount-s.LateStarts, s.StartCount)
+}
+
+// AvgCancelLeadHours antecedência média dos cancelamentos
+func (s AppointmentTimingStats) AvgCancelLeadHours() float64 {
+	return timingAverage(s.CancelLeadSeconds/3600, s.CancelCount)
+}
+
+// LateCancellationRate percentual dos cancelamentos feitos com menos de
+// LateCancellationWindow de antecedência
+func (s AppointmentTimingStats) LateCancellationRate() float64 {
+	return timingRate(s.LateCancellations, s.CancelCount)
+}
+
+// timingAverage média com uma casa decimal; zero sem amostras
+func timingAverage(total float64, n int) float64 {
+	if n == 0 {
+		return 0
+	}
+	return math.Round(total/float64(n)*10) / 10
+}
+
+// timingRate percentual com uma casa decimal; zero sem amostras
+func timingRate(part, n int) float64 {
+	if n == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*1000/float64(n)) / 10
+}
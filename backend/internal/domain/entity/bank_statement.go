@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// FormatoExtrato representa o formato do arquivo de extrato bancário
+type FormatoExtrato string
+
+const (
+	FormatoExtratoOFX FormatoExtrato = "OFX"
+	FormatoExtratoCSV FormatoExtrato = "CSV"
+)
+
+// TipoLancamentoExtrato indica se o lançamento é entrada ou saída na conta
+type TipoLancamentoExtrato string
+
+const (
+	TipoLancamentoCredito TipoLancamentoExtrato = "CREDITO"
+	TipoLancamentoDebito  TipoLancamentoExtrato = "DEBITO"
+)
+
+// StatusLinhaExtrato representa o status de conciliação de um lançamento
+type StatusLinhaExtrato string
+
+const (
+	StatusLinhaPendente   StatusLinhaExtrato = "PENDENTE"   // Sem correspondência (fila de revisão)
+	StatusLinhaConciliada StatusLinhaExtrato = "CONCILIADA" // Conciliada e lançamento baixado
+	StatusLinhaDivergente StatusLinhaExtrato = "DIVERGENTE" // Correspondência com diferença de valor (taxa)
+	StatusLinhaIgnorada   StatusLinhaExtrato = "IGNORADA"   // Descartada manualmente (tarifa, transferência, etc.)
+)
+
+// MatchTipoExtrato identifica o tipo de lançamento interno conciliado
+type MatchTipoExtrato string
+
+const (
+	MatchTipoCompensacao  MatchTipoExtrato = "COMPENSACAO"
+	MatchTipoContaReceber MatchTipoExtrato = "CONTA_RECEBER"
+	MatchTipoContaPagar   MatchTipoExtrato = "CONTA_PAGAR"
+)
+
+// ValidarMatchTipoExtrato verifica se o tipo de conciliação é válido
+func ValidarMatchTipoExtrato(s string) bool {
+	switch MatchTipoExtrato(s) {
+	case MatchTipoCompensacao, MatchTipoContaReceber, MatchTipoContaPagar:
+		return true
+	}
+	return false
+}
+
+// BankStatementImport representa um arquivo de extrato importado
+type BankStatementImport struct {
+	ID               uuid.UUID
+	TenantID         uuid.UUID
+	ArquivoNome      string
+	Formato          FormatoExtrato
+	ContaBancaria    *string
+	PeriodoInicio    *time.Time
+	PeriodoFim       *time.Time
+	TotalLinhas      int
+	TotalConciliadas int
+	TotalPendentes   int
+	ImportadoPor     *uuid.UUID
+	CreatedAt        time.Time
+}
+
+// BankStatementLine representa um lançamento do extrato bancário
+type BankStatementLine struct {
+	ID             uuid.UUID
+	TenantID       uuid.UUID
+	ImportID       uuid.UUID
+	DataLancamento time.Time
+	Descricao      string
+	Valor          decimal.Decimal // Sempre positivo; o sentido vem de Tipo
+	Tipo           TipoLancamentoExtrato
+	Identificador  string // FITID (OFX) ou hash da linha (CSV)
+	Status         StatusLinhaExtrato
+	MatchTipo      *MatchTipoExtrato
+	MatchID        *uuid.UUID
+	Diferenca      *decimal.Decimal // Valor do extrato - valor do lançamento interno
+	Observacao     *string
+	ConciliadoEm   *time.Time
+	ConciliadoPor  *uuid.UUID
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ExtratoParseado é o resultado da leitura de um arquivo de extrato
+type ExtratoParseado struct {
+	ContaBancaria *string
+	PeriodoInicio *time.Time
+	PeriodoFim    *time.Time
+	Linhas        []BankStatementLine
+}
+
+// NewBankStatementImport cria um novo registro de importação
+func NewBankStatementImport(tenantID uuid.UUID, arquivoNome string, formato FormatoExtrato, importadoPor *uuid.UUID) (*BankStatementImport, error) {
+	if tenantID == uuid.Nil {
+		return nil, errors.New("tenant_id é obrigatório")
+	}
+	if formato != FormatoExtratoOFX && formato != FormatoExtratoCSV {
+		return nil, errors.New("formato de extrato inválido (OFX, CSV)")
+	}
+	if arquivoNome == "" {
+		arquivoNome = "extrato." + string(formato)
+	}
+
+	return &BankStatementImport{
+		ID:           uuid.New(),
+		TenantID:     tenantID,
+		ArquivoNome:  arquivoNome,
+		Formato:      formato,
+		ImportadoPor: importadoPor,
+		CreatedAt:    time.Now(),
+	}, nil
+}
+
+// Conciliar vincula o lançamento a um registro interno.
+// Se houver diferença de valor, o lançamento vai para revisão como DIVERGENTE.
+func (l *BankStatementLine) Conciliar(tipo MatchTipoExtrato, matchID uuid.UUID, valorInterno decimal.Decimal, usuarioID *uuid.UUID) {
+	now := time.Now()
+	diferenca := l.Valor.Sub(valorInterno)
+
+	l.MatchTipo = &tipo
+	l.MatchID = &matchID
+	l.Diferenca = &diferenca
+	l.ConciliadoEm = &now
+	l.ConciliadoPor = usuarioID
+	l.UpdatedAt = now
+
+	if diferenca.IsZero() {
+		l.Status = StatusLinhaConciliada
+	} else {
+		l.Status = StatusLinhaDivergente
+	}
+}
+
+// AceitarDivergencia confirma uma conciliação com diferença de valor (ex.: taxa da adquirente)
+func (l *BankStatementLine) AceitarDivergencia(usuarioID *uuid.UUID, observacao *string) error {
+	if l.Status != StatusLinhaDivergente {
+		return errors.New("lançamento não está divergente")
+	}
+	now := time.Now()
+	l.Status = StatusLinhaConciliada
+	l.ConciliadoEm = &now
+	l.ConciliadoPor = usuarioID
+	l.UpdatedAt = now
+	if observacao != nil {
+		l.Observacao = observacao
+	}
+	return nil
+}
+
+// Ignorar descarta o lançamento da fila de revisão
+func (l *BankStatementLine) Ignorar(usuarioID *uuid.UUID, observacao *string) error {
+	if l.Status == StatusLinhaConciliada {
+		return errors.New("lançamento já conciliado")
+	}
+	now := time.Now()
+	l.Status = StatusLinhaIgnorada
+	l.MatchTipo = nil
+	l.MatchID = nil
+	l.Diferenca = nil
+	l.ConciliadoEm = &now
+	l.ConciliadoPor = usuarioID
+	l.Observacao = observacao
+	l.UpdatedAt = now
+	return nil
+}
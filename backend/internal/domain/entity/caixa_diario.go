@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 )
@@ -12,14 +13,18 @@ import (
 type StatusCaixa string
 
 const (
-	StatusCaixaAberto  StatusCaixa = "ABERTO"
-	StatusCaixaFechado StatusCaixa = "FECHADO"
+	StatusCaixaAberto              StatusCaixa = "ABERTO"
+	StatusCaixaAguardandoAprovacao StatusCaixa = "AGUARDANDO_APROVACAO" // contado, divergência acima do limite
+	StatusCaixaFechado             StatusCaixa = "FECHADO"
 )
 
+// LimiteAprovacaoDivergencia divergência (em reais) que exige aprovação gerencial
+const LimiteAprovacaoDivergencia = 20
+
 // ValidarStatusCaixa verifica se o status é válido
 func ValidarStatusCaixa(s string) bool {
 	switch StatusCaixa(s) {
-	case StatusCaixaAberto, StatusCaixaFechado:
+	case StatusCaixaAberto, StatusCaixaAguardandoAprovacao, StatusCaixaFechado:
 		return true
 	}
 	return false
@@ -46,6 +51,12 @@ type CaixaDiario struct {
 	CreatedAt                time.Time
 	UpdatedAt                time.Time
 
+	// Fechamento cego: o saldo esperado só é exibido ao operador após a contagem
+	FechamentoCego     bool
+	Contagem           *ContagemCaixa
+	UsuarioAprovacaoID *uuid.UUID
+	DataAprovacao      *time.Time
+
 	// Relacionamentos (carregados quando necessário)
 	Operacoes             []OperacaoCaixa
 	UsuarioAberturaNome   string
@@ -133,6 +144,104 @@ func (c *CaixaDiario) Fechar(usuarioID uuid.UUID, saldoReal decimal.Decimal, jus
 	return nil
 }
 
+// FecharComContagem fecha o caixa a partir da contagem de cédulas e moedas.
+// A gaveta só tem dinheiro: as vendas em PIX/cartão entram no saldo real como
+// conferidas, de modo que a divergência reflete apenas o dinheiro contado.
+func (c *CaixaDiario) FecharComContagem(usuarioID uuid.UUID, contagem *ContagemCaixa, justificativa *string) error {
+	saldoReal := contagem.Total().Add(c.TotalVendasNaoDinheiro())
+	if err := c.Fechar(usuarioID, saldoReal, justificativa); err != nil {
+		return err
+	}
+	c.Contagem = contagem
+	return nil
+}
+
+// TotaisPorFormaPagamento soma as vendas do caixa por forma de pagamento (requer Operacoes)
+func (c *CaixaDiario) TotaisPorFormaPagamento() map[TipoPagamento]decimal.Decimal {
+	totais := make(map[TipoPagamento]decimal.Decimal)
+	for i := range c.Operacoes {
+		op := &c.Operacoes[i]
+		if op.Tipo != TipoOperacaoVenda {
+			continue
+		}
+		forma := op.FormaPagamentoOuDinheiro()
+		totais[forma] = totais[forma].Add(op.Valor)
+	}
+	return totais
+}
+
+// TotalVendasNaoDinheiro soma as vendas que não passam pela gaveta (PIX, cartões, etc.)
+func (c *CaixaDiario) TotalVendasNaoDinheiro() decimal.Decimal {
+	total := decimal.Zero
+	for forma, valor := range c.TotaisPorFormaPagamento() {
+		if forma != TipoPagamentoDinheiro {
+			total = total.Add(valor)
+		}
+	}
+	return total
+}
+
+// SaldoEsperadoDinheiro retorna o valor que deveria estar fisicamente na gaveta
+func (c *CaixaDiario) SaldoEsperadoDinheiro() decimal.Decimal {
+	return c.SaldoEsperado.Sub(c.TotalVendasNaoDinheiro())
+}
+
+// OcultaValoresEsperados indica se o saldo esperado deve ser escondido do operador
+func (c *CaixaDiario) OcultaValoresEsperados() bool {
+	return c.FechamentoCego && c.Status == StatusCaixaAberto
+}
+
+// ExigeAprovacao indica se a divergência do fechamento passa do limite gerencial
+func (c *CaixaDiario) ExigeAprovacao() bool {
+	return c.Divergencia != nil && c.Divergencia.Abs().GreaterThan(decimal.NewFromInt(LimiteAprovacaoDivergencia))
+}
+
+// ResolverAprovacao é chamado após o fechamento: divergências acima do limite
+// deixam o caixa aguardando aprovação, exceto quando quem fecha já é gerente
+func (c *CaixaDiario) ResolverAprovacao(usuarioID uuid.UUID, podeAprovar bool) {
+	if !c.ExigeAprovacao() {
+		return
+	}
+	if podeAprovar {
+		c.registrarAprovacao(usuarioID)
+		return
+	}
+	c.Status = StatusCaixaAguardandoAprovacao
+}
+
+// Aprovar conclui o fechamento aguardando aprovação
+func (c *CaixaDiario) Aprovar(aprovadorID uuid.UUID) error {
+	if c.Status != StatusCaixaAguardandoAprovacao {
+		return domain.ErrCaixaNaoAguardaAprovacao
+	}
+	c.registrarAprovacao(aprovadorID)
+	return nil
+}
+
+func (c *CaixaDiario) registrarAprovacao(aprovadorID uuid.UUID) {
+	now := time.Now()
+	c.Status = StatusCaixaFechado
+	c.UsuarioAprovacaoID = &aprovadorID
+	c.DataAprovacao = &now
+	c.UpdatedAt = now
+}
+
+// Reabrir devolve o caixa aguardando aprovação ao operador para nova contagem
+func (c *CaixaDiario) Reabrir() error {
+	if c.Status != StatusCaixaAguardandoAprovacao {
+		return domain.ErrCaixaNaoAguardaAprovacao
+	}
+	c.Status = StatusCaixaAberto
+	c.UsuarioFechamentoID = nil
+	c.DataFechamento = nil
+	c.SaldoReal = nil
+	c.Divergencia = nil
+	c.JustificativaDivergencia = nil
+	c.Contagem = nil
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
 // RegistrarSangria atualiza os totais após uma sangria
 func (c *CaixaDiario) RegistrarSangria(valor decimal.Decimal) error {
 	if c.Status != StatusCaixaAberto {
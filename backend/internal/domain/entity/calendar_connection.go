@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Provedores de calendário externo
+const (
+	CalendarProviderGoogle = "GOOGLE"
+)
+
+// Status da conexão com o calendário externo
+const (
+	CalendarConnectionActive = "ACTIVE"
+	CalendarConnectionError  = "ERROR" // acesso revogado na conta externa: reconectar
+)
+
+// CalendarFullSyncInterval é o intervalo entre leituras completas do
+// calendário externo. Entre elas só as mudanças são lidas (sync token); a
+// leitura completa estende o horizonte dos eventos importados.
+const CalendarFullSyncInterval = 7 * 24 * time.Hour
+
+// CalendarToken credenciais OAuth da conta externa
+type CalendarToken struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    time.Time
+}
+
+// CalendarConnection representa a conta de calendário externo conectada por
+// um profissional. Os agendamentos dele são enviados ao calendário e os
+// eventos ocupados do calendário viram bloqueios de horário.
+type CalendarConnection struct {
+	ID             string
+	TenantID       uuid.UUID
+	ProfessionalID string
+	Provider       string
+	CalendarID     string
+	AccountEmail   string
+	Token          CalendarToken
+
+	SyncToken    string // leitura incremental; vazio força leitura completa
+	Status       string
+	LastError    string
+	LastSyncedAt *time.Time
+	FullSyncedAt *time.Time
+
+	CreatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Dados do profissional (carregados via join)
+	ProfessionalName string
+}
+
+// NewCalendarConnection cria a conexão do profissional com a conta externa
+func NewCalendarConnection(tenantID uuid.UUID, professionalID, provider, calendarID string, token CalendarToken) (*CalendarConnection, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if _, err := uuid.Parse(professionalID); err != nil {
+		return nil, domain.ErrAppointmentProfessionalRequired
+	}
+	if token.AccessToken == "" || token.RefreshToken == "" {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+	if calendarID == "" {
+		calendarID = "primary"
+	}
+
+	now := time.Now()
+	return &CalendarConnection{
+		ID:             uuid.NewString(),
+		TenantID:       tenantID,
+		ProfessionalID: professionalID,
+		Provider:       provider,
+		CalendarID:     calendarID,
+		Token:          token,
+		Status:         CalendarConnectionActive,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// IsActive indica se a conexão está sincronizando
+func (c *CalendarConnection) IsActive() bool {
+	return c.Status == CalendarConnectionActive
+}
+
+// TokenExpired indica se o access token expira no próximo minuto
+func (c *CalendarConnection) TokenExpired(now time.Time) bool {
+	return !c.Token.ExpiresAt.After(now.Add(time.Minute))
+}
+
+// NeedsFullSync indica se a próxima leitura deve ser completa
+func (c *CalendarConnection) NeedsFullSync(now time.Time) bool {
+	return c.SyncToken == "" || c.FullSyncedAt == nil || now.Sub(*c.FullSyncedAt) >= CalendarFullSyncInterval
+}
+
+// Synced registra uma leitura bem-sucedida
+func (c *CalendarConnection) Synced(syncToken string, full bool, now time.Time) {
+	c.SyncToken = syncToken
+	c.Status = CalendarConnectionActive
+	c.LastError = ""
+	c.LastSyncedAt = &now
+	if full {
+		c.FullSyncedAt = &now
+	}
+}
+
+// Fail registra o erro da sincronização. Acesso revogado desativa a conexão
+// até o profissional conectar a conta novamente.
+func (c *CalendarConnection) Fail(err error, revoked bool) {
+	c.LastError = err.Error()
+	if revoked {
+		c.Status = CalendarConnectionError
+	}
+}
+
+// CalendarEventLink evento criado no calendário externo para o trecho de um
+// agendamento (SlotIndex = posição em Appointment.ProfessionalSlots)
+type CalendarEventLink struct {
+	ConnectionID    string
+	AppointmentID   string
+	SlotIndex       int
+	ExternalEventID string
+}
+
+// CalendarImportedEvent evento ocupado do calendário externo importado como
+// bloqueio de horário
+type CalendarImportedEvent struct {
+	ExternalEventID string
+	BlockedTimeID   string
+	EndTime         time.Time
+}
+
+// ExternalCalendarEvent evento lido do calendário externo
+type ExternalCalendarEvent struct {
+	ID      string
+	Start   time.Time
+	End     time.Time
+	Busy    bool // false: cancelado, removido ou marcado como "disponível"
+	Managed bool // criado pela própria sincronização (agendamento enviado)
+}
+
+// CalendarSyncItem agendamento na fila de envio aos calendários externos
+type CalendarSyncItem struct {
+	AppointmentID string
+	TenantID      string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	EnqueuedAt    time.Time
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// CalendarFeed representa um link iCalendar (.ics) somente leitura da agenda.
+// Com ProfessionalID o feed traz a agenda do profissional em todas as
+// unidades; sem ele, a agenda da unidade inteira. O link é protegido por um
+// token aleatório do qual só o hash é guardado.
+type CalendarFeed struct {
+	ID             string
+	TenantID       uuid.UUID
+	UnitID         uuid.UUID
+	ProfessionalID string // vazio = feed da unidade
+	TokenHash      string
+	CreatedBy      string
+
+	LastAccessedAt *time.Time
+	RevokedAt      *time.Time
+	CreatedAt      time.Time
+
+	// Dados do profissional (carregados via join)
+	ProfessionalName string
+}
+
+// NewCalendarFeed cria um feed da agenda do profissional (ou da unidade, com
+// professionalID vazio)
+func NewCalendarFeed(tenantID, unitID uuid.UUID, professionalID, tokenHash string) (*CalendarFeed, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == uuid.Nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	if professionalID != "" {
+		if _, err := uuid.Parse(professionalID); err != nil {
+			return nil, domain.ErrInvalidID
+		}
+	}
+
+	return &CalendarFeed{
+		ID:             uuid.NewString(),
+		TenantID:       tenantID,
+		UnitID:         unitID,
+		ProfessionalID: professionalID,
+		TokenHash:      tokenHash,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+// IsUnitFeed indica se o feed traz a agenda da unidade inteira
+func (f *CalendarFeed) IsUnitFeed() bool {
+	return f.ProfessionalID == ""
+}
+
+// Status do evento no calendário (RFC 5545)
+const (
+	CalendarEventConfirmed = "CONFIRMED"
+	CalendarEventTentative = "TENTATIVE"
+)
+
+// CalendarEvent é um evento do feed iCalendar. UID é estável: o mesmo
+// agendamento gera sempre o mesmo UID, e o aplicativo de calendário atualiza o
+// evento em vez de duplicá-lo.
+type CalendarEvent struct {
+	UID         string
+	Summary     string
+	Description string
+	Start       time.Time
+	End         time.Time
+	Status      string
+	RRule       string // regra de recorrência (RRULE), vazia para eventos avulsos
+	UpdatedAt   time.Time
+}
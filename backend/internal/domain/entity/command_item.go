@@ -114,6 +114,16 @@ func (ci *CommandItem) CalculatePrecoFinal() {
 	ci.PrecoFinal = subtotal
 }
 
+// PercentualDescontoTotal retorna o desconto efetivo do item (percentual e
+// valor somados) em relação ao preço bruto
+func (ci *CommandItem) PercentualDescontoTotal() float64 {
+	bruto := ci.PrecoUnitario * float64(ci.Quantidade)
+	if bruto <= 0 {
+		return 0
+	}
+	return (bruto - ci.PrecoFinal) / bruto * 100
+}
+
 // UpdateQuantity atualiza a quantidade do item
 func (ci *CommandItem) UpdateQuantity(quantidade int) error {
 	if quantidade <= 0 {
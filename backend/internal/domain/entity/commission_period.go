@@ -15,6 +15,7 @@ type CommissionPeriod struct {
 	UnitID           *string
 	ReferenceMonth   string // formato: YYYY-MM
 	ProfessionalID   *string
+	ProfessionalName *string // preenchido nas listagens
 	TotalGross       decimal.Decimal
 	TotalCommission  decimal.Decimal
 	TotalAdvances    decimal.Decimal
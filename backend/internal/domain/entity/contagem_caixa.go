@@ -0,0 +1,112 @@
+package entity
+
+import (
+	"sort"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/shopspring/decimal"
+)
+
+// DenominacoesBRL são as cédulas e moedas aceitas na contagem da gaveta (maior → menor)
+var DenominacoesBRL = []decimal.Decimal{
+	decimal.NewFromInt(200),
+	decimal.NewFromInt(100),
+	decimal.NewFromInt(50),
+	decimal.NewFromInt(20),
+	decimal.NewFromInt(10),
+	decimal.NewFromInt(5),
+	decimal.NewFromInt(2),
+	decimal.NewFromInt(1),
+	decimal.RequireFromString("0.50"),
+	decimal.RequireFromString("0.25"),
+	decimal.RequireFromString("0.10"),
+	decimal.RequireFromString("0.05"),
+	decimal.RequireFromString("0.01"),
+}
+
+// menorCedula separa cédulas (R$ 2,00 em diante) de moedas
+var menorCedula = decimal.NewFromInt(2)
+
+// ValidarDenominacao verifica se o valor é uma cédula ou moeda em circulação
+func ValidarDenominacao(d decimal.Decimal) bool {
+	for _, v := range DenominacoesBRL {
+		if v.Equal(d) {
+			return true
+		}
+	}
+	return false
+}
+
+// ItemContagem é a quantidade contada de uma cédula ou moeda
+type ItemContagem struct {
+	Denominacao decimal.Decimal `json:"denominacao"`
+	Quantidade  int             `json:"quantidade"`
+}
+
+// Cedula indica se a denominação é cédula (senão, moeda)
+func (i ItemContagem) Cedula() bool {
+	return i.Denominacao.GreaterThanOrEqual(menorCedula)
+}
+
+// Subtotal retorna denominação × quantidade
+func (i ItemContagem) Subtotal() decimal.Decimal {
+	return i.Denominacao.Mul(decimal.NewFromInt(int64(i.Quantidade)))
+}
+
+// ContagemCaixa é a contagem física da gaveta no fechamento, por denominação
+type ContagemCaixa struct {
+	Itens []ItemContagem
+}
+
+// NewContagemCaixa valida a contagem, soma denominações repetidas, descarta
+// quantidades zeradas e ordena da maior para a menor denominação
+func NewContagemCaixa(itens []ItemContagem) (*ContagemCaixa, error) {
+	quantidades := make(map[string]int, len(itens))
+	for _, item := range itens {
+		if item.Quantidade < 0 || !ValidarDenominacao(item.Denominacao) {
+			return nil, domain.ErrCaixaContagemInvalida
+		}
+		quantidades[item.Denominacao.StringFixed(2)] += item.Quantidade
+	}
+
+	contagem := &ContagemCaixa{Itens: make([]ItemContagem, 0, len(quantidades))}
+	for chave, qtd := range quantidades {
+		if qtd == 0 {
+			continue
+		}
+		contagem.Itens = append(contagem.Itens, ItemContagem{
+			Denominacao: decimal.RequireFromString(chave),
+			Quantidade:  qtd,
+		})
+	}
+	sort.Slice(contagem.Itens, func(i, j int) bool {
+		return contagem.Itens[i].Denominacao.GreaterThan(contagem.Itens[j].Denominacao)
+	})
+
+	return contagem, nil
+}
+
+// Total soma o valor contado
+func (c *ContagemCaixa) Total() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range c.Itens {
+		total = total.Add(item.Subtotal())
+	}
+	return total
+}
+
+// TotalCedulas soma apenas as cédulas
+func (c *ContagemCaixa) TotalCedulas() decimal.Decimal {
+	total := decimal.Zero
+	for _, item := range c.Itens {
+		if item.Cedula() {
+			total = total.Add(item.Subtotal())
+		}
+	}
+	return total
+}
+
+// TotalMoedas soma apenas as moedas
+func (c *ContagemCaixa) TotalMoedas() decimal.Decimal {
+	return c.Total().Sub(c.TotalCedulas())
+}
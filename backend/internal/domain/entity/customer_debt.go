@@ -0,0 +1,144 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// StatusCustomerDebt representa os possíveis status de uma dívida de cliente (fiado)
+type StatusCustomerDebt string
+
+const (
+	StatusCustomerDebtAberta    StatusCustomerDebt = "ABERTA"
+	StatusCustomerDebtParcial   StatusCustomerDebt = "PARCIAL"
+	StatusCustomerDebtQuitada   StatusCustomerDebt = "QUITADA"
+	StatusCustomerDebtCancelada StatusCustomerDebt = "CANCELADA"
+)
+
+// CustomerDebt representa o saldo devedor de uma comanda fechada com DeixarSaldoDivida
+type CustomerDebt struct {
+	ID            uuid.UUID
+	TenantID      uuid.UUID
+	CustomerID    uuid.UUID
+	CommandID     uuid.UUID
+	ValorOriginal decimal.Decimal
+	ValorPago     decimal.Decimal
+	Saldo         decimal.Decimal
+	Status        StatusCustomerDebt
+	DataAbertura  time.Time
+	DataQuitacao  *time.Time
+	Observacoes   *string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+
+	// Relacionamentos (carregados quando necessário)
+	Pagamentos []CustomerDebtPayment
+}
+
+// CustomerDebtPayment representa um pagamento (parcial ou total) de uma dívida
+type CustomerDebtPayment struct {
+	ID              uuid.UUID
+	TenantID        uuid.UUID
+	DebtID          uuid.UUID
+	CommandID       *uuid.UUID // Comanda em que o saldo foi pago (opcional)
+	MeioPagamentoID uuid.UUID
+	Valor           decimal.Decimal
+	ContaReceberID  *uuid.UUID
+	OperacaoCaixaID *uuid.UUID
+	RecebidoPor     *uuid.UUID
+	Observacoes     *string
+	CreatedAt       time.Time
+}
+
+// CustomerDebtAging representa a posição consolidada de fiado de um cliente
+type CustomerDebtAging struct {
+	CustomerID        uuid.UUID
+	CustomerNome      string
+	CustomerTelefone  string
+	QuantidadeDividas int64
+	SaldoTotal        decimal.Decimal
+	DividaMaisAntiga  time.Time
+	DiasEmAberto      int
+}
+
+// NewCustomerDebt cria uma nova dívida a partir do saldo devedor de uma comanda
+func NewCustomerDebt(tenantID, customerID, commandID uuid.UUID, valor decimal.Decimal) (*CustomerDebt, error) {
+	if tenantID == uuid.Nil {
+		return nil, errors.New("tenant_id é obrigatório")
+	}
+	if customerID == uuid.Nil {
+		return nil, errors.New("customer_id é obrigatório")
+	}
+	if commandID == uuid.Nil {
+		return nil, errors.New("command_id é obrigatório")
+	}
+	if !valor.IsPositive() {
+		return nil, errors.New("valor da dívida deve ser positivo")
+	}
+
+	now := time.Now()
+	return &CustomerDebt{
+		ID:            uuid.New(),
+		TenantID:      tenantID,
+		CustomerID:    customerID,
+		CommandID:     commandID,
+		ValorOriginal: valor,
+		ValorPago:     decimal.Zero,
+		Saldo:         valor,
+		Status:        StatusCustomerDebtAberta,
+		DataAbertura:  now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// IsAberta indica se a dívida ainda possui saldo a receber
+func (d *CustomerDebt) IsAberta() bool {
+	return d.Status == StatusCustomerDebtAberta || d.Status == StatusCustomerDebtParcial
+}
+
+// RegistrarPagamento abate um valor do saldo e atualiza o status da dívida
+func (d *CustomerDebt) RegistrarPagamento(valor decimal.Decimal) error {
+	if !d.IsAberta() {
+		return domain.ErrCustomerDebtJaQuitada
+	}
+	if !valor.IsPositive() {
+		return domain.ErrCustomerDebtValorInvalido
+	}
+	if valor.GreaterThan(d.Saldo) {
+		return domain.ErrCustomerDebtValorExcedente
+	}
+
+	now := time.Now()
+	d.ValorPago = d.ValorPago.Add(valor)
+	d.Saldo = d.ValorOriginal.Sub(d.ValorPago)
+	d.UpdatedAt = now
+
+	if d.Saldo.IsZero() {
+		d.Status = StatusCustomerDebtQuitada
+		d.DataQuitacao = &now
+	} else {
+		d.Status = StatusCustomerDebtParcial
+	}
+
+	return nil
+}
+
+// Cancelar cancela a dívida (perdão do saldo)
+func (d *CustomerDebt) Cancelar() error {
+	if !d.IsAberta() {
+		return domain.ErrCustomerDebtJaQuitada
+	}
+	d.Status = StatusCustomerDebtCancelada
+	d.UpdatedAt = time.Now()
+	return nil
+}
+
+// DiasEmAberto retorna há quantos dias a dívida foi aberta
+func (d *CustomerDebt) DiasEmAberto(ref time.Time) int {
+	return int(ref.Sub(d.DataAbertura).Hours() / 24)
+}
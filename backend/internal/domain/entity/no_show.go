@@ -0,0 +1,180 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Tipo de cálculo do sinal
+const (
+	DepositTypeFixed      = "FIXED"      // valor fixo em R$
+	DepositTypePercentage = "PERCENTAGE" // % do valor do agendamento
+)
+
+// Status do sinal do agendamento
+const (
+	DepositStatusPending  = "PENDING"  // aguardando pagamento do PIX
+	DepositStatusPaid     = "PAID"     // pago, ainda não usado
+	DepositStatusCredited = "CREDITED" // creditado na comanda (cliente compareceu)
+	DepositStatusRetained = "RETAINED" // retido como receita (não compareceu)
+	DepositStatusCanceled = "CANCELED" // agendamento cancelado antes do pagamento
+	DepositStatusExpired  = "EXPIRED"  // prazo de pagamento vencido
+)
+
+// NoShowPolicy é a política de não comparecimento do tenant: quem faltou
+// MaxNoShows vezes nos últimos WindowDays dias paga sinal ao agendar.
+type NoShowPolicy struct {
+	TenantID             uuid.UUID
+	Enabled              bool
+	MaxNoShows           int
+	WindowDays           int
+	DepositType          string
+	DepositValue         decimal.Decimal
+	PaymentDeadlineHours int
+	MeioPagamentoID      string // meio usado para creditar o sinal na comanda
+
+	UpdatedAt time.Time
+}
+
+// DefaultNoShowPolicy política usada enquanto o tenant não configura a sua
+func DefaultNoShowPolicy(tenantID uuid.UUID) *NoShowPolicy {
+	return &NoShowPolicy{
+		TenantID:             tenantID,
+		Enabled:              false,
+		MaxNoShows:           2,
+		WindowDays:           90,
+		DepositType:          DepositTypePercentage,
+		DepositValue:         decimal.NewFromInt(30),
+		PaymentDeadlineHours: 2,
+	}
+}
+
+// Validate valida os parâmetros da política
+func (p *NoShowPolicy) Validate() error {
+	if p.TenantID == uuid.Nil {
+		return domain.ErrTenantIDRequired
+	}
+	if p.MaxNoShows <= 0 || p.WindowDays <= 0 || p.PaymentDeadlineHours <= 0 {
+		return domain.ErrNoShowPolicyInvalid
+	}
+	if !p.DepositValue.IsPositive() {
+		return domain.ErrNoShowPolicyInvalid
+	}
+	switch p.DepositType {
+	case DepositTypeFixed:
+	case DepositTypePercentage:
+		if p.DepositValue.GreaterThan(decimal.NewFromInt(100)) {
+			return domain.ErrNoShowPolicyInvalid
+		}
+	default:
+		return domain.ErrNoShowPolicyInvalid
+	}
+	return nil
+}
+
+// WindowStart início da janela em que as faltas são contadas
+func (p *NoShowPolicy) WindowStart(now time.Time) time.Time {
+	return now.AddDate(0, 0, -p.WindowDays)
+}
+
+// RequiresDeposit indica se o histórico do cliente exige sinal
+func (p *NoShowPolicy) RequiresDeposit(stats AttendanceStats) bool {
+	return p.Enabled && stats.NoShows >= p.MaxNoShows
+}
+
+// DepositAmount valor do sinal para um agendamento, nunca acima do total
+func (p *NoShowPolicy) DepositAmount(total decimal.Decimal) decimal.Decimal {
+	amount := p.DepositValue
+	if p.DepositType == DepositTypePercentage {
+		amount = total.Mul(p.DepositValue).Div(decimal.NewFromInt(100)).Round(2)
+	}
+	if amount.GreaterThan(total) {
+		return total
+	}
+	return amount
+}
+
+// PaymentDueAt prazo para pagar o sinal: PaymentDeadlineHours após o
+// agendamento, sem passar do início do atendimento.
+func (p *NoShowPolicy) PaymentDueAt(now, startTime time.Time) time.Time {
+	due := now.Add(time.Duration(p.PaymentDeadlineHours) * time.Hour)
+	if due.After(startTime) {
+		return startTime
+	}
+	return due
+}
+
+// AttendanceStats histórico de agendamentos já ocorridos do cliente
+type AttendanceStats struct {
+	Attended int // compareceu (check-in em diante)
+	NoShows  int
+	Canceled int
+}
+
+// ReliabilityScore índice de confiabilidade de 0 a 100: percentual de
+// comparecimento entre os agendamentos em que o cliente compareceu ou faltou.
+// Cancelamentos não contam. Sem histórico, o cliente começa com 100.
+func (s AttendanceStats) ReliabilityScore() int {
+	total := s.Attended + s.NoShows
+	if total == 0 {
+		return 100
+	}
+	return int(math.Round(float64(s.Attended) * 100 / float64(total)))
+}
+
+// AppointmentDeposit sinal pago via PIX para garantir um agendamento
+type AppointmentDeposit struct {
+	ID            string
+	TenantID      uuid.UUID
+	UnitID        uuid.UUID
+	AppointmentID string
+	CustomerID    string
+	Amount        decimal.Decimal
+	Status        string
+
+	AsaasPaymentID string
+	InvoiceURL     string
+	PixPayload     string // PIX copia e cola
+
+	DueAt     time.Time
+	PaidAt    *time.Time
+	SettledAt *time.Time // creditado na comanda ou retido
+
+	CommandPaymentID string
+	ContaReceberID   string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewAppointmentDeposit cria um sinal pendente para o agendamento
+func NewAppointmentDeposit(appointment *Appointment, amount decimal.Decimal, dueAt time.Time) (*AppointmentDeposit, error) {
+	if appointment == nil || appointment.ID == "" {
+		return nil, domain.ErrInvalidID
+	}
+	if !amount.IsPositive() {
+		return nil, domain.ErrValorDeveSerPositivo
+	}
+	now := time.Now()
+	return &AppointmentDeposit{
+		ID:            uuid.NewString(),
+		TenantID:      appointment.TenantID,
+		UnitID:        appointment.UnitID,
+		AppointmentID: appointment.ID,
+		CustomerID:    appointment.CustomerID,
+		Amount:        amount,
+		Status:        DepositStatusPending,
+		DueAt:         dueAt,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// HasCharge indica se a cobrança PIX já foi gerada no Asaas
+func (d *AppointmentDeposit) HasCharge() bool {
+	return d.AsaasPaymentID != ""
+}
@@ -78,6 +78,9 @@ type OperacaoCaixa struct {
 	UsuarioID uuid.UUID
 	CreatedAt time.Time
 
+	// FormaPagamento é o tipo do meio de pagamento da venda (nil em registros antigos = dinheiro)
+	FormaPagamento *string
+
 	// Relacionamento (carregado quando necessário)
 	UsuarioNome  string
 	ContaPagarID *uuid.UUID
@@ -152,8 +155,8 @@ func NewOperacaoReforco(caixaID, tenantID, usuarioID uuid.UUID, valor decimal.De
 	}, nil
 }
 
-// NewOperacaoVenda cria uma nova operação de venda em dinheiro
-func NewOperacaoVenda(caixaID, tenantID, usuarioID uuid.UUID, valor decimal.Decimal, descricao string) (*OperacaoCaixa, error) {
+// NewOperacaoVenda cria uma nova operação de venda com a forma de pagamento recebida
+func NewOperacaoVenda(caixaID, tenantID, usuarioID uuid.UUID, valor decimal.Decimal, descricao string, forma TipoPagamento) (*OperacaoCaixa, error) {
 	if caixaID == uuid.Nil {
 		return nil, errors.New("caixa_id é obrigatório")
 	}
@@ -169,19 +172,33 @@ func NewOperacaoVenda(caixaID, tenantID, usuarioID uuid.UUID, valor decimal.Deci
 	if descricao == "" {
 		return nil, errors.New("descrição é obrigatória")
 	}
+	if forma == "" {
+		forma = TipoPagamentoDinheiro
+	}
+	formaPagamento := string(forma)
 
 	return &OperacaoCaixa{
-		ID:        uuid.New(),
-		CaixaID:   caixaID,
-		TenantID:  tenantID,
-		Tipo:      TipoOperacaoVenda,
-		Valor:     valor,
-		Descricao: descricao,
-		UsuarioID: usuarioID,
-		CreatedAt: time.Now(),
+		ID:             uuid.New(),
+		CaixaID:        caixaID,
+		TenantID:       tenantID,
+		Tipo:           TipoOperacaoVenda,
+		Valor:          valor,
+		Descricao:      descricao,
+		UsuarioID:      usuarioID,
+		CreatedAt:      time.Now(),
+		FormaPagamento: &formaPagamento,
 	}, nil
 }
 
+// FormaPagamentoOuDinheiro retorna a forma de pagamento da venda; registros
+// anteriores à gravação da forma são tratados como dinheiro
+func (o *OperacaoCaixa) FormaPagamentoOuDinheiro() TipoPagamento {
+	if o.FormaPagamento == nil || *o.FormaPagamento == "" {
+		return TipoPagamentoDinheiro
+	}
+	return TipoPagamento(*o.FormaPagamento)
+}
+
 // IsEntrada verifica se a operação é uma entrada de dinheiro
 func (o *OperacaoCaixa) IsEntrada() bool {
 	return o.Tipo == TipoOperacaoVenda || o.Tipo == TipoOperacaoReforco
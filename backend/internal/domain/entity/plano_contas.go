@@ -0,0 +1,280 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrigemContaContabil identifica o que está sendo mapeado para o plano de contas
+type OrigemContaContabil string
+
+const (
+	OrigemContabilCategoria     OrigemContaContabil = "CATEGORIA"      // categorias (receita/despesa)
+	OrigemContabilMeioPagamento OrigemContaContabil = "MEIO_PAGAMENTO" // meios_pagamento
+	OrigemContabilPadrao        OrigemContaContabil = "PADRAO"         // contas fixas do sistema
+)
+
+// ValidarOrigemContaContabil verifica se a origem é válida
+func ValidarOrigemContaContabil(s string) bool {
+	switch OrigemContaContabil(s) {
+	case OrigemContabilCategoria, OrigemContabilMeioPagamento, OrigemContabilPadrao:
+		return true
+	}
+	return false
+}
+
+// ContaPadrao identifica as contas fixas usadas na geração dos lançamentos
+type ContaPadrao string
+
+const (
+	ContaPadraoCaixa              ContaPadrao = "CAIXA"
+	ContaPadraoBancos             ContaPadrao = "BANCOS"
+	ContaPadraoClientes           ContaPadrao = "CLIENTES"
+	ContaPadraoCartoesReceber     ContaPadrao = "CARTOES_A_RECEBER"
+	ContaPadraoFornecedores       ContaPadrao = "FORNECEDORES"
+	ContaPadraoReceitaServicos    ContaPadrao = "RECEITA_SERVICOS"
+	ContaPadraoReceitaProdutos    ContaPadrao = "RECEITA_PRODUTOS"
+	ContaPadraoReceitaAssinaturas ContaPadrao = "RECEITA_ASSINATURAS"
+	ContaPadraoReceitaOutras      ContaPadrao = "RECEITA_OUTRAS"
+	ContaPadraoDespesasGerais     ContaPadrao = "DESPESAS_GERAIS"
+	ContaPadraoTaxasCartao        ContaPadrao = "TAXAS_CARTAO"
+)
+
+// ContaContabil é uma conta do plano de contas do contador
+type ContaContabil struct {
+	Codigo string
+	Nome   string
+}
+
+// ContasPadrao é a ordem de exibição das contas fixas
+var ContasPadrao = []ContaPadrao{
+	ContaPadraoCaixa,
+	ContaPadraoBancos,
+	ContaPadraoClientes,
+	ContaPadraoCartoesReceber,
+	ContaPadraoFornecedores,
+	ContaPadraoReceitaServicos,
+	ContaPadraoReceitaProdutos,
+	ContaPadraoReceitaAssinaturas,
+	ContaPadraoReceitaOutras,
+	ContaPadraoDespesasGerais,
+	ContaPadraoTaxasCartao,
+}
+
+// PlanoContasPadrao é o plano referencial usado quando o tenant não personalizou a conta
+var PlanoContasPadrao = map[ContaPadrao]ContaContabil{
+	ContaPadraoCaixa:              {Codigo: "1.1.1.01", Nome: "Caixa"},
+	ContaPadraoBancos:             {Codigo: "1.1.1.02", Nome: "Bancos conta movimento"},
+	ContaPadraoClientes:           {Codigo: "1.1.2.01", Nome: "Clientes a receber"},
+	ContaPadraoCartoesReceber:     {Codigo: "1.1.2.02", Nome: "Cartões a receber"},
+	ContaPadraoFornecedores:       {Codigo: "2.1.1.01", Nome: "Fornecedores"},
+	ContaPadraoReceitaServicos:    {Codigo: "3.1.1.01", Nome: "Receita de serviços"},
+	ContaPadraoReceitaProdutos:    {Codigo: "3.1.1.02", Nome: "Receita de venda de produtos"},
+	ContaPadraoReceitaAssinaturas: {Codigo: "3.1.1.03", Nome: "Receita de assinaturas"},
+	ContaPadraoReceitaOutras:      {Codigo: "3.1.1.09", Nome: "Outras receitas"},
+	ContaPadraoDespesasGerais:     {Codigo: "4.1.1.01", Nome: "Despesas gerais"},
+	ContaPadraoTaxasCartao:        {Codigo: "4.1.2.01", Nome: "Taxas de cartão e meios de pagamento"},
+}
+
+// PlanoContasMapeamento associa uma categoria, meio de pagamento ou conta padrão a uma conta contábil
+type PlanoContasMapeamento struct {
+	ID          uuid.UUID
+	TenantID    uuid.UUID
+	OrigemTipo  OrigemContaContabil
+	OrigemChave string // ID da categoria/meio de pagamento ou chave da conta padrão
+	ContaCodigo string
+	ContaNome   string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// NewPlanoContasMapeamento cria um mapeamento validando origem e conta
+func NewPlanoContasMapeamento(tenantID uuid.UUID, origemTipo, origemChave, contaCodigo, contaNome string) (*PlanoContasMapeamento, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if !ValidarOrigemContaContabil(origemTipo) {
+		return nil, domain.ErrPlanoContasOrigemInvalida
+	}
+
+	origemChave = strings.TrimSpace(origemChave)
+	switch OrigemContaContabil(origemTipo) {
+	case OrigemContabilPadrao:
+		origemChave = strings.ToUpper(origemChave)
+		if _, ok := PlanoContasPadrao[ContaPadrao(origemChave)]; !ok {
+			return nil, domain.ErrPlanoContasChaveInvalida
+		}
+	default:
+		id, err := uuid.Parse(origemChave)
+		if err != nil {
+			return nil, domain.ErrPlanoContasChaveInvalida
+		}
+		origemChave = id.String()
+	}
+
+	contaCodigo = strings.TrimSpace(contaCodigo)
+	if contaCodigo == "" {
+		return nil, domain.ErrPlanoContasContaObrigatoria
+	}
+
+	now := time.Now()
+	return &PlanoContasMapeamento{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		OrigemTipo:  OrigemContaContabil(origemTipo),
+		OrigemChave: origemChave,
+		ContaCodigo: contaCodigo,
+		ContaNome:   strings.TrimSpace(contaNome),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}, nil
+}
+
+// PlanoContas resolve a conta contábil de cada origem, usando o plano padrão como fallback
+type PlanoContas struct {
+	padrao     map[ContaPadrao]ContaContabil
+	categorias map[string]ContaContabil
+	meios      map[string]ContaContabil
+}
+
+// NewPlanoContas monta o plano do tenant a partir dos mapeamentos cadastrados
+func NewPlanoContas(mapeamentos []*PlanoContasMapeamento) *PlanoContas {
+	p := &PlanoContas{
+		padrao:     make(map[ContaPadrao]ContaContabil, len(PlanoContasPadrao)),
+		categorias: make(map[string]ContaContabil),
+		meios:      make(map[string]ContaContabil),
+	}
+	for k, v := range PlanoContasPadrao {
+		p.padrao[k] = v
+	}
+
+	for _, m := range mapeamentos {
+		conta := ContaContabil{Codigo: m.ContaCodigo, Nome: m.ContaNome}
+		switch m.OrigemTipo {
+		case OrigemContabilPadrao:
+			if conta.Nome == "" {
+				conta.Nome = PlanoContasPadrao[ContaPadrao(m.OrigemChave)].Nome
+			}
+			p.padrao[ContaPadrao(m.OrigemChave)] = conta
+		case OrigemContabilCategoria:
+			p.categorias[m.OrigemChave] = conta
+		case OrigemContabilMeioPagamento:
+			p.meios[m.OrigemChave] = conta
+		}
+	}
+	return p
+}
+
+// Padrao retorna a conta fixa (personalizada ou do plano referencial)
+func (p *PlanoContas) Padrao(chave ContaPadrao) ContaContabil {
+	return p.padrao[chave]
+}
+
+// Categoria retorna a conta da categoria; sem mapeamento cai em despesas gerais
+func (p *PlanoContas) Categoria(categoriaID string) ContaContabil {
+	if conta, ok := p.categorias[categoriaID]; ok {
+		return conta
+	}
+	return p.padrao[ContaPadraoDespesasGerais]
+}
+
+// MeioPagamento retorna a conta de liquidação do meio de pagamento.
+// Sem mapeamento: dinheiro no caixa, cartões em "cartões a receber" e demais em bancos.
+func (p *PlanoContas) MeioPagamento(meio *MeioPagamento) ContaContabil {
+	if meio == nil {
+		return p.padrao[ContaPadraoBancos]
+	}
+	if conta, ok := p.meios[meio.ID.String()]; ok {
+		return conta
+	}
+	switch meio.Tipo {
+	case TipoPagamentoDinheiro:
+		return p.padrao[ContaPadraoCaixa]
+	case TipoPagamentoCredito, TipoPagamentoDebito:
+		return p.padrao[ContaPadraoCartoesReceber]
+	}
+	return p.padrao[ContaPadraoBancos]
+}
+
+// NaturezaPartida indica se a partida é débito ou crédito
+type NaturezaPartida string
+
+const (
+	NaturezaDebito  NaturezaPartida = "D"
+	NaturezaCredito NaturezaPartida = "C"
+)
+
+// PartidaContabil é uma perna (débito ou crédito) de um lançamento
+type PartidaContabil struct {
+	Conta    ContaContabil
+	Natureza NaturezaPartida
+	Valor    decimal.Decimal
+}
+
+// OrigemLancamentoContabil identifica o registro que gerou o lançamento
+type OrigemLancamentoContabil string
+
+const (
+	OrigemLancamentoContaPagar    OrigemLancamentoContabil = "CONTA_PAGAR"
+	OrigemLancamentoPagamento     OrigemLancamentoContabil = "PAGAMENTO"
+	OrigemLancamentoContaReceber  OrigemLancamentoContabil = "CONTA_RECEBER"
+	OrigemLancamentoOperacaoCaixa OrigemLancamentoContabil = "OPERACAO_CAIXA"
+	OrigemLancamentoCompensacao   OrigemLancamentoContabil = "COMPENSACAO"
+)
+
+// LancamentoContabil é um lançamento de partidas dobradas (um lote na exportação)
+type LancamentoContabil struct {
+	Lote      int
+	Data      time.Time
+	Historico string
+	Documento string
+	Origem    OrigemLancamentoContabil
+	Partidas  []PartidaContabil
+}
+
+// Debitar adiciona uma partida de débito (valores zerados são ignorados)
+func (l *LancamentoContabil) Debitar(conta ContaContabil, valor decimal.Decimal) {
+	l.adicionar(conta, NaturezaDebito, valor)
+}
+
+// Creditar adiciona uma partida de crédito (valores zerados são ignorados)
+func (l *LancamentoContabil) Creditar(conta ContaContabil, valor decimal.Decimal) {
+	l.adicionar(conta, NaturezaCredito, valor)
+}
+
+func (l *LancamentoContabil) adicionar(conta ContaContabil, natureza NaturezaPartida, valor decimal.Decimal) {
+	valor = valor.Round(2)
+	if valor.IsZero() {
+		return
+	}
+	l.Partidas = append(l.Partidas, PartidaContabil{Conta: conta, Natureza: natureza, Valor: valor})
+}
+
+// TotalDebitos soma as partidas de débito
+func (l *LancamentoContabil) TotalDebitos() decimal.Decimal {
+	return l.total(NaturezaDebito)
+}
+
+// TotalCreditos soma as partidas de crédito
+func (l *LancamentoContabil) TotalCreditos() decimal.Decimal {
+	return l.total(NaturezaCredito)
+}
+
+func (l *LancamentoContabil) total(natureza NaturezaPartida) decimal.Decimal {
+	total := decimal.Zero
+	for _, p := range l.Partidas {
+		if p.Natureza == natureza {
+			total = total.Add(p.Valor)
+		}
+	}
+	return total
+}
+
+// Balanceado indica se débitos e créditos do lançamento são iguais
+func (l *LancamentoContabil) Balanceado() bool {
+	return len(l.Partidas) >= 2 && l.TotalDebitos().Equal(l.TotalCreditos())
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Resource representa um recurso físico da unidade (cadeira, sala,
+// lavatório). Capacity é quantos atendimentos o recurso comporta ao mesmo
+// tempo (ex.: 3 cadeiras iguais podem ser um único recurso com capacidade 3).
+type Resource struct {
+	ID       string
+	TenantID uuid.UUID
+	UnitID   uuid.UUID
+	Nome     string
+	Capacity int
+	Ativo    bool
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ServiceResourceRequirement recurso de que um serviço precisa e por quanto
+// tempo: de OffsetMinutes após o início do serviço, por DurationMinutes
+// (0 = até o fim do serviço).
+type ServiceResourceRequirement struct {
+	ServiceID       string
+	ResourceID      string
+	OffsetMinutes   int
+	DurationMinutes int
+
+	// Dados do recurso (carregados via join)
+	ResourceName string
+}
+
+// ResourceAllocation recurso reservado para um serviço do agendamento. Guarda
+// a exigência do serviço no momento da reserva; o horário de uso acompanha o
+// horário do serviço.
+type ResourceAllocation struct {
+	ServiceID       string
+	ResourceID      string
+	OffsetMinutes   int
+	DurationMinutes int
+
+	// Dados do recurso (carregados via join)
+	ResourceName string
+}
+
+// ResourceWindow período em que o agendamento ocupa um recurso
+type ResourceWindow struct {
+	ResourceID string
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+// NewResource cria um recurso validado
+func NewResource(tenantID, unitID uuid.UUID, nome string, capacity int) (*Resource, error) {
+	r := &Resource{
+		ID:       uuid.NewString(),
+		TenantID: tenantID,
+		UnitID:   unitID,
+		Nome:     strings.TrimSpace(nome),
+		Capacity: capacity,
+		Ativo:    true,
+	}
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	r.CreatedAt = now
+	r.UpdatedAt = now
+	return r, nil
+}
+
+// Validate valida as regras do recurso
+func (r *Resource) Validate() error {
+	if r.TenantID == uuid.Nil {
+		return domain.ErrTenantIDRequired
+	}
+	if r.UnitID == uuid.Nil {
+		return domain.ErrUnitIDRequired
+	}
+	if r.Nome == "" {
+		return domain.ErrResourceNameRequired
+	}
+	if r.Capacity < 1 {
+		return domain.ErrResourceCapacityInvalid
+	}
+	return nil
+}
+
+// Validate valida a exigência de recurso do serviço
+func (req ServiceResourceRequirement) Validate() error {
+	if req.ResourceID == "" || req.OffsetMinutes < 0 || req.DurationMinutes < 0 {
+		return domain.ErrServiceResourceInvalid
+	}
+	return nil
+}
+
+// window calcula o período de uso do recurso dentro do serviço. O uso nunca
+// passa do fim do serviço.
+func (al ResourceAllocation) window(svc AppointmentService) (ResourceWindow, bool) {
+	start := svc.StartTime.Add(time.Duration(al.OffsetMinutes) * time.Minute)
+	end := svc.EndTime
+	if al.DurationMinutes > 0 {
+		if e := start.Add(time.Duration(al.DurationMinutes) * time.Minute); e.Before(end) {
+			end = e
+		}
+	}
+	if !start.Before(end) {
+		return ResourceWindow{}, false
+	}
+	return ResourceWindow{ResourceID: al.ResourceID, StartTime: start, EndTime: end}, true
+}
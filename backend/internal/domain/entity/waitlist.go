@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Status da entrada na lista de espera
+const (
+	WaitlistStatusWaiting   = "WAITING"
+	WaitlistStatusOffered   = "OFFERED"   // oferta pendente de resposta
+	WaitlistStatusFulfilled = "FULFILLED" // confirmou um horário
+	WaitlistStatusCanceled  = "CANCELED"
+	WaitlistStatusExpired   = "EXPIRED" // período terminou sem horário
+)
+
+// Status da oferta de horário
+const (
+	WaitlistOfferPending  = "PENDING"
+	WaitlistOfferClaimed  = "CLAIMED"
+	WaitlistOfferDeclined = "DECLINED"
+	WaitlistOfferExpired  = "EXPIRED"
+	WaitlistOfferCanceled = "CANCELED" // horário ocupado ou entrada cancelada
+)
+
+// WaitlistEntry representa um cliente aguardando horário: serviços desejados,
+// profissionais aceitos (vazio = qualquer um) e o período em que pode ser
+// atendido. Maior Priority é atendida primeiro; empate pela ordem de entrada.
+type WaitlistEntry struct {
+	ID              string
+	TenantID        uuid.UUID
+	UnitID          uuid.UUID
+	CustomerID      string
+	ServiceIDs      []string
+	ProfessionalIDs []string
+
+	WindowStart time.Time
+	WindowEnd   time.Time
+	Priority    int
+	Notes       string
+
+	Status        string
+	AppointmentID string // agendamento criado pela oferta confirmada
+	CreatedBy     string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WaitlistOffer é a oferta de um horário liberado a uma entrada da lista.
+// SlotStart/SlotEnd guardam o horário liberado inteiro, para oferecê-lo ao
+// próximo da fila; StartTime/EndTime são o agendamento oferecido.
+type WaitlistOffer struct {
+	ID             string
+	TenantID       uuid.UUID
+	UnitID         uuid.UUID
+	EntryID        string
+	ProfessionalID string
+
+	SlotStart time.Time
+	SlotEnd   time.Time
+	StartTime time.Time
+	EndTime   time.Time
+
+	TokenHash     string
+	ExpiresAt     time.Time
+	Status        string
+	AppointmentID string
+	RespondedAt   *time.Time
+	CreatedAt     time.Time
+}
+
+// NewWaitlistEntry cria uma entrada validada
+func NewWaitlistEntry(
+	tenantID uuid.UUID,
+	unitID uuid.UUID,
+	customerID string,
+	serviceIDs []string,
+	professionalIDs []string,
+	windowStart time.Time,
+	windowEnd time.Time,
+) (*WaitlistEntry, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == uuid.Nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	if customerID == "" {
+		return nil, domain.ErrAppointmentCustomerRequired
+	}
+	if len(serviceIDs) == 0 {
+		return nil, domain.ErrAppointmentServicesRequired
+	}
+	if windowStart.IsZero() || !windowEnd.After(windowStart) || !windowEnd.After(time.Now()) {
+		return nil, domain.ErrWaitlistWindowInvalid
+	}
+
+	now := time.Now()
+	return &WaitlistEntry{
+		ID:              uuid.NewString(),
+		TenantID:        tenantID,
+		UnitID:          unitID,
+		CustomerID:      customerID,
+		ServiceIDs:      serviceIDs,
+		ProfessionalIDs: professionalIDs,
+		WindowStart:     windowStart,
+		WindowEnd:       windowEnd,
+		Status:          WaitlistStatusWaiting,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// IsOpen indica se a entrada ainda aguarda horário
+func (e *WaitlistEntry) IsOpen() bool {
+	return e.Status == WaitlistStatusWaiting || e.Status == WaitlistStatusOffered
+}
+
+// AcceptsProfessional indica se o cliente aceita ser atendido pelo profissional
+func (e *WaitlistEntry) AcceptsProfessional(professionalID string) bool {
+	if len(e.ProfessionalIDs) == 0 {
+		return true
+	}
+	for _, id := range e.ProfessionalIDs {
+		if id == professionalID {
+			return true
+		}
+	}
+	return false
+}
+
+// Fits indica se o atendimento cabe no período da entrada
+func (e *WaitlistEntry) Fits(start, end time.Time) bool {
+	return !start.Before(e.WindowStart) && !end.After(e.WindowEnd)
+}
+
+// Cancel retira o cliente da lista de espera
+func (e *WaitlistEntry) Cancel() error {
+	if !e.IsOpen() {
+		return domain.ErrWaitlistEntryClosed
+	}
+	e.Status = WaitlistStatusCanceled
+	e.UpdatedAt = time.Now()
+	return nil
+}
+
+// IsPending indica se a oferta ainda pode ser confirmada
+func (o *WaitlistOffer) IsPending(now time.Time) bool {
+	return o.Status == WaitlistOfferPending && now.Before(o.ExpiresAt)
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// Status da chegada na fila de encaixe
+const (
+	WalkInStatusWaiting  = "WAITING"  // aguardando barbeiro livre
+	WalkInStatusAssigned = "ASSIGNED" // encaixado: agendamento criado em CHECKED_IN
+	WalkInStatusCanceled = "CANCELED" // desistiu antes de ser atendido
+)
+
+// WalkIn representa um cliente sem horário marcado que chegou à unidade e
+// aguarda o próximo barbeiro livre da lista da vez. Os serviços são
+// executados na ordem informada; DurationMinutes (soma das durações) é usado
+// na escolha do barbeiro e na estimativa de espera.
+type WalkIn struct {
+	ID              string
+	TenantID        uuid.UUID
+	UnitID          uuid.UUID
+	CustomerID      string
+	ServiceIDs      []string
+	DurationMinutes int
+	Notes           string
+
+	Status         string
+	ProfessionalID string // barbeiro escolhido pela lista da vez
+	AppointmentID  string // agendamento criado no encaixe
+	CreatedBy      string
+
+	CheckedInAt time.Time
+	AssignedAt  *time.Time
+	CanceledAt  *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Dados do cliente (carregados via join)
+	CustomerName string
+}
+
+// NewWalkIn registra a chegada de um cliente sem horário marcado
+func NewWalkIn(tenantID, unitID uuid.UUID, customerID string, serviceIDs []string, durationMinutes int) (*WalkIn, error) {
+	if tenantID == uuid.Nil {
+		return nil, domain.ErrTenantIDRequired
+	}
+	if unitID == uuid.Nil {
+		return nil, domain.ErrUnitIDRequired
+	}
+	if customerID == "" {
+		return nil, domain.ErrAppointmentCustomerRequired
+	}
+	if len(serviceIDs) == 0 || durationMinutes <= 0 {
+		return nil, domain.ErrAppointmentServicesRequired
+	}
+
+	now := time.Now()
+	return &WalkIn{
+		ID:              uuid.NewString(),
+		TenantID:        tenantID,
+		UnitID:          unitID,
+		CustomerID:      customerID,
+		ServiceIDs:      serviceIDs,
+		DurationMinutes: durationMinutes,
+		Status:          WalkInStatusWaiting,
+		CheckedInAt:     now,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}, nil
+}
+
+// Duration tempo de atendimento previsto
+func (w *WalkIn) Duration() time.Duration {
+	return time.Duration(w.DurationMinutes) * time.Minute
+}
+
+// IsWaiting indica se o cliente ainda aguarda barbeiro
+func (w *WalkIn) IsWaiting() bool {
+	return w.Status == WalkInStatusWaiting
+}
+
+// Assign reserva o cliente para o barbeiro escolhido
+func (w *WalkIn) Assign(professionalID string) error {
+	if !w.IsWaiting() {
+		return domain.ErrWalkInNotWaiting
+	}
+	now := time.Now()
+	w.Status = WalkInStatusAssigned
+	w.ProfessionalID = professionalID
+	w.AssignedAt = &now
+	w.UpdatedAt = now
+	return nil
+}
+
+// Release devolve o cliente à fila quando o encaixe não pôde ser concluído
+func (w *WalkIn) Release() {
+	w.Status = WalkInStatusWaiting
+	w.ProfessionalID = ""
+	w.AppointmentID = ""
+	w.AssignedAt = nil
+	w.UpdatedAt = time.Now()
+}
+
+// Cancel retira da fila o cliente que desistiu
+func (w *WalkIn) Cancel() error {
+	if !w.IsWaiting() {
+		return domain.ErrWalkInNotWaiting
+	}
+	now := time.Now()
+	w.Status = WalkInStatusCanceled
+	w.CanceledAt = &now
+	w.UpdatedAt = now
+	return nil
+}
@@ -43,12 +43,53 @@ var (
 	ErrMarkupInvalido = errors.New("markup inválido (deve ser >= 1)")
 
 	// Erros de autenticação
-	ErrEmailNaoEncontrado   = errors.New("Email não encontrado")
-	ErrSenhaIncorreta       = errors.New("Senha incorreta")
-	ErrContaDesativada      = errors.New("Conta desativada")
-	ErrRefreshTokenInvalido = errors.New("Refresh token inválido ou expirado")
-	ErrUsuarioNaoEncontrado = errors.New("Usuário não encontrado")
-	ErrTokenInvalido        = errors.New("Token inválido")
+	// Email inexistente e senha errada respondem igual para não revelar contas cadastradas
+	ErrCredenciaisInvalidas    = errors.New("Email ou senha inválidos")
+	ErrLoginBloqueado          = errors.New("Muitas tentativas de login. Tente novamente mais tarde")
+	ErrContaDesativada         = errors.New("Conta desativada")
+	ErrRefreshTokenInvalido    = errors.New("Refresh token inválido ou expirado")
+	ErrRefreshTokenReutilizado = errors.New("Refresh token reutilizado: sessão encerrada por segurança")
+	ErrSessaoNaoEncontrada     = errors.New("Sessão não encontrada")
+	ErrUsuarioNaoEncontrado    = errors.New("Usuário não encontrado")
+	ErrTokenInvalido           = errors.New("Token inválido")
+
+	// Erros de recuperação de senha e convites
+	ErrLinkSenhaInvalido     = errors.New("link de redefinição de senha inválido ou expirado")
+	ErrConviteInvalido       = errors.New("convite inválido, expirado ou já utilizado")
+	ErrConviteNaoEncontrado  = errors.New("convite não encontrado")
+	ErrConviteEmailExistente = errors.New("já existe um usuário com este email")
+	ErrConviteRoleInvalida   = errors.New("papel inválido para convite")
+	ErrConviteSemPermissao   = errors.New("apenas o proprietário pode convidar gerentes")
+
+	// Erros de autenticação em dois fatores
+	ErrCodigo2FAInvalido         = errors.New("código de verificação inválido")
+	ErrDesafio2FAInvalido        = errors.New("verificação expirada ou inválida. Faça login novamente")
+	ErrDoisFatoresJaAtivo        = errors.New("autenticação em dois fatores já está ativa")
+	ErrDoisFatoresInativo        = errors.New("autenticação em dois fatores não está ativa")
+	ErrDoisFatoresNaoIniciado    = errors.New("cadastro da autenticação em dois fatores não iniciado")
+	ErrDoisFatoresObrigatorio    = errors.New("a política do tenant exige autenticação em dois fatores para este perfil")
+	ErrDoisFatoresPoliticaSem2FA = errors.New("ative a autenticação em dois fatores na sua conta antes de exigi-la da equipe")
+
+	// Erros de permissões e papéis personalizados
+	ErrPermissaoNegada         = errors.New("permissão insuficiente para esta operação")
+	ErrDescontoAcimaDoLimite   = errors.New("desconto acima de 10% exige permissão de gerente")
+	ErrPapelNaoEncontrado      = errors.New("papel não encontrado")
+	ErrPapelNomeDuplicado      = errors.New("já existe um papel com este nome")
+	ErrPapelPermissaoInvalida  = errors.New("permissão desconhecida ou não atribuível a papel personalizado")
+	ErrPapelEmUso              = errors.New("papel atribuído a usuários; remova as atribuições antes de excluir")
+	ErrVinculoUnidadeNaoExiste = errors.New("usuário não está vinculado a esta unidade")
+
+	// Erros de chaves de API
+	ErrChaveAPIInvalida       = errors.New("chave de API inválida, revogada ou expirada")
+	ErrChaveAPINaoEncontrada  = errors.New("chave de API não encontrada")
+	ErrChaveAPIEscopoInvalido = errors.New("escopo de chave de API desconhecido")
+	ErrChaveAPIExpiracao      = errors.New("expiração da chave de API inválida: informe uma data futura (RFC3339)")
+
+	// Erros de webhooks de saída
+	ErrWebhookEndpointNaoEncontrado = errors.New("endpoint de webhook não encontrado")
+	ErrWebhookURLInvalida           = errors.New("URL do webhook inválida: informe um endereço https:// público")
+	ErrWebhookEventoInvalido        = errors.New("evento de webhook desconhecido")
+	ErrWebhookEntregaNaoEncontrada  = errors.New("entrega de webhook não encontrada")
 
 	// Erros de agendamento
 	ErrAppointmentProfessionalRequired    = errors.New("profissional é obrigatório")
@@ -67,6 +108,55 @@ var (
 	ErrAppointmentCustomerNotFound        = errors.New("cliente não encontrado")
 	ErrAppointmentServiceNotFound         = errors.New("serviço não encontrado")
 
+	// Erros de séries de agendamentos recorrentes
+	ErrAppointmentSeriesNotFound        = errors.New("série de agendamentos não encontrada")
+	ErrAppointmentSeriesIntervalInvalid = errors.New("intervalo da série deve ser de 1 a 8 semanas")
+	ErrAppointmentSeriesEndInvalid      = errors.New("data final da série deve ser posterior à primeira ocorrência")
+	ErrAppointmentSeriesEnded           = errors.New("série de agendamentos já encerrada")
+	ErrAppointmentNotInSeries           = errors.New("agendamento não pertence a uma série")
+
+	// Erros da lista de espera
+	ErrWaitlistEntryNotFound    = errors.New("entrada da lista de espera não encontrada")
+	ErrWaitlistWindowInvalid    = errors.New("período da lista de espera inválido")
+	ErrWaitlistEntryClosed      = errors.New("entrada da lista de espera já encerrada")
+	ErrWaitlistOfferNotFound    = errors.New("oferta de horário não encontrada")
+	ErrWaitlistOfferExpired     = errors.New("oferta de horário expirada")
+	ErrWaitlistOfferUnavailable = errors.New("oferta de horário não está mais disponível")
+
+	// Erros da política de não comparecimento
+	ErrNoShowPolicyNotFound        = errors.New("política de não comparecimento não configurada")
+	ErrNoShowPolicyInvalid         = errors.New("política de não comparecimento inválida")
+	ErrAppointmentDepositNotFound  = errors.New("sinal do agendamento não encontrado")
+	ErrAppointmentDepositNotNeeded = errors.New("cliente não precisa pagar sinal pela política atual")
+
+	// Erros de recursos da unidade
+	ErrResourceNotFound        = errors.New("recurso não encontrado")
+	ErrResourceNameRequired    = errors.New("nome do recurso é obrigatório")
+	ErrResourceCapacityInvalid = errors.New("capacidade do recurso deve ser maior que zero")
+	ErrServiceResourceInvalid  = errors.New("recurso do serviço inválido")
+	ErrResourceUnavailable     = errors.New("recurso indisponível no horário: capacidade esgotada")
+
+	// Erros da fila de encaixe
+	ErrWalkInNotFound   = errors.New("cliente não encontrado na fila de encaixe")
+	ErrWalkInNotWaiting = errors.New("cliente não está mais aguardando na fila de encaixe")
+
+	// Erros dos feeds iCalendar
+	ErrCalendarFeedNotFound = errors.New("feed de agenda não encontrado ou revogado")
+
+	// Erros da sincronização com calendários externos
+	ErrCalendarProviderNotConfigured = errors.New("integração com calendário externo não configurada")
+	ErrCalendarConnectionNotFound    = errors.New("conexão com calendário externo não encontrada")
+	ErrCalendarAuthorizationInvalid  = errors.New("autorização do calendário externo inválida ou expirada")
+	ErrCalendarAccessRevoked         = errors.New("acesso ao calendário externo revogado: conecte a conta novamente")
+	ErrCalendarUnauthorized          = errors.New("credencial do calendário externo recusada")
+	ErrCalendarSyncTokenExpired      = errors.New("token de sincronização do calendário externo expirado")
+	ErrCalendarEventNotFound         = errors.New("evento não encontrado no calendário externo")
+
+	// Erros dos indicadores de tempo dos agendamentos
+	ErrAppointmentTimingPeriodInvalid  = errors.New("período inválido: a data final deve ser posterior à inicial")
+	ErrAppointmentTimingPeriodTooLong  = errors.New("período dos indicadores não pode passar de 366 dias")
+	ErrAppointmentTimingToleranceRange = errors.New("tolerância deve estar entre 0 e 120 minutos")
+
 	// Erros de cliente
 	ErrCustomerNameRequired        = errors.New("nome do cliente é obrigatório")
 	ErrCustomerNameTooShort        = errors.New("nome do cliente deve ter pelo menos 3 caracteres")
@@ -157,6 +247,12 @@ var (
 	ErrCaixaSaldoRealObrigatorio     = errors.New("saldo real é obrigatório para fechamento")
 	ErrCaixaJustificativaObrigatoria = errors.New("justificativa obrigatória para divergência maior que R$ 5,00")
 	ErrCaixaOperacaoNaoPermitida     = errors.New("operação não permitida em caixa fechado")
+	ErrCaixaContagemInvalida         = errors.New("contagem inválida: denominação desconhecida ou quantidade negativa")
+	ErrCaixaContagemObrigatoria      = errors.New("contagem de cédulas e moedas obrigatória no fechamento cego")
+	ErrCaixaAguardandoAprovacao      = errors.New("existe caixa aguardando aprovação gerencial da divergência")
+	ErrCaixaNaoAguardaAprovacao      = errors.New("caixa não está aguardando aprovação")
+	ErrCaixaValoresOcultos           = errors.New("valores do caixa ocultos até a contagem (fechamento cego)")
+	ErrCaixaMotivoRejeicaoCurto      = errors.New("motivo da rejeição deve ter pelo menos 5 caracteres")
 
 	// Erros de Operações de Caixa
 	ErrOperacaoValorInvalido        = errors.New("valor da operação deve ser positivo")
@@ -168,6 +264,31 @@ var (
 	ErrReforcoOrigemObrigatoria     = errors.New("origem é obrigatória para reforço")
 	ErrReforcoOrigemInvalida        = errors.New("origem inválida (TROCO, CAPITAL_GIRO, TRANSFERENCIA, OUTROS)")
 
+	// Erros de Fiado (dívidas de clientes)
+	ErrCustomerDebtNotFound       = errors.New("dívida do cliente não encontrada")
+	ErrCustomerDebtJaQuitada      = errors.New("dívida já está quitada ou cancelada")
+	ErrCustomerDebtValorInvalido  = errors.New("valor do pagamento deve ser positivo")
+	ErrCustomerDebtValorExcedente = errors.New("valor do pagamento excede o saldo da dívida")
+	ErrMeioPagamentoNotFound      = errors.New("meio de pagamento não encontrado")
+
+	// Erros de Conciliação Bancária
+	ErrExtratoFormatoInvalido    = errors.New("formato de extrato inválido (OFX, CSV)")
+	ErrExtratoVazio              = errors.New("extrato sem lançamentos")
+	ErrExtratoInvalido           = errors.New("arquivo de extrato inválido")
+	ErrExtratoImportNotFound     = errors.New("importação de extrato não encontrada")
+	ErrExtratoLinhaNotFound      = errors.New("lançamento de extrato não encontrado")
+	ErrExtratoLinhaJaConciliada  = errors.New("lançamento de extrato já conciliado")
+	ErrExtratoLinhaNaoDivergente = errors.New("lançamento de extrato não está divergente")
+	ErrExtratoMatchTipoInvalido  = errors.New("tipo de conciliação inválido (COMPENSACAO, CONTA_RECEBER, CONTA_PAGAR)")
+	ErrExtratoMatchIncompativel  = errors.New("lançamento interno incompatível com o tipo do extrato (crédito/débito)")
+
+	// Erros de Exportação Contábil
+	ErrPlanoContasOrigemInvalida     = errors.New("origem do mapeamento contábil inválida (CATEGORIA, MEIO_PAGAMENTO, PADRAO)")
+	ErrPlanoContasChaveInvalida      = errors.New("chave do mapeamento contábil inválida")
+	ErrPlanoContasContaObrigatoria   = errors.New("código da conta contábil é obrigatório")
+	ErrPlanoContasMapeamentoNotFound = errors.New("mapeamento contábil não encontrado")
+	ErrLancamentosDesbalanceados     = errors.New("lançamentos contábeis desbalanceados: débitos diferentes dos créditos")
+
 	// Erros de Comissão
 	ErrCommissionRuleNameRequired     = errors.New("nome da regra de comissão é obrigatório")
 	ErrCommissionRuleNameTooShort     = errors.New("nome da regra deve ter pelo menos 3 caracteres")
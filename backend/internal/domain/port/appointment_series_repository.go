@@ -0,0 +1,44 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// AppointmentSeriesRepository define operações para séries de agendamentos
+// recorrentes e o registro das ocorrências geradas
+type AppointmentSeriesRepository interface {
+	// Create cria a série
+	Create(ctx context.Context, series *entity.AppointmentSeries) error
+
+	// FindByID busca uma série do tenant
+	FindByID(ctx context.Context, tenantID, id string) (*entity.AppointmentSeries, error)
+
+	// Update atualiza horário, profissional, término, status e geração
+	Update(ctx context.Context, series *entity.AppointmentSeries) error
+
+	// ListByCustomer lista as séries de um cliente
+	ListByCustomer(ctx context.Context, tenantID, customerID string) ([]*entity.AppointmentSeries, error)
+
+	// ListToGenerate lista séries ativas (todos os tenants) ainda não geradas
+	// até o horizonte
+	ListToGenerate(ctx context.Context, horizon time.Time, limit int) ([]*entity.AppointmentSeries, error)
+
+	// NextOccurrenceIndex retorna o índice da próxima ocorrência a gerar
+	NextOccurrenceIndex(ctx context.Context, seriesID string) (int, error)
+
+	// CreateOccurrence registra uma ocorrência; retorna false se ela já
+	// havia sido registrada por outra geração
+	CreateOccurrence(ctx context.Context, occurrence *entity.AppointmentSeriesOccurrence) (bool, error)
+
+	// UpdateOccurrence atualiza horário, agendamento e status da ocorrência
+	UpdateOccurrence(ctx context.Context, occurrence *entity.AppointmentSeriesOccurrence) error
+
+	// ListOccurrences lista as ocorrências geradas da série
+	ListOccurrences(ctx context.Context, tenantID, seriesID string) ([]*entity.AppointmentSeriesOccurrence, error)
+
+	// FindOccurrenceByAppointment busca a ocorrência que gerou o agendamento
+	FindOccurrenceByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentSeriesOccurrence, error)
+}
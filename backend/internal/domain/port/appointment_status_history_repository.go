@@ -0,0 +1,34 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// AppointmentTimingFilter período e recorte dos indicadores de tempo
+type AppointmentTimingFilter struct {
+	UnitID           string // opcional
+	ProfessionalID   string // opcional
+	StartFrom        time.Time
+	StartTo          time.Time // exclusivo
+	Tolerance        time.Duration
+	LateCancelWindow time.Duration
+}
+
+// AppointmentStatusHistoryRepository define operações do histórico de status
+// dos agendamentos. O histórico é somente inclusão: não há alteração nem
+// exclusão.
+type AppointmentStatusHistoryRepository interface {
+	// Append grava uma mudança de status
+	Append(ctx context.Context, change *entity.AppointmentStatusChange) error
+
+	// ListByAppointment lista as mudanças do agendamento em ordem cronológica,
+	// com o nome de quem fez cada uma
+	ListByAppointment(ctx context.Context, tenantID, appointmentID string) ([]*entity.AppointmentStatusChange, error)
+
+	// TimingStats soma os tempos da operação por unidade e profissional
+	// principal dos agendamentos com início no período
+	TimingStats(ctx context.Context, tenantID string, filter AppointmentTimingFilter) ([]entity.AppointmentTimingStats, error)
+}
@@ -1,6 +1,9 @@
 package port
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // AsaasGateway define a interface para integração com o Asaas
 // Referência: FLUXO_ASSINATURA.md — Seção 4
@@ -18,6 +21,11 @@ type AsaasGateway interface {
 
 	// Reference: REGRA AS-004
 	GetPaymentLink(ctx context.Context, subscriptionID string) (string, error)
+
+	// Single charge operations (sinal de agendamento via PIX)
+	CreatePixCharge(ctx context.Context, params CreateAsaasPixChargeParams) (*AsaasPixChargeResult, error)
+	GetCharge(ctx context.Context, paymentID string) (*AsaasChargeResult, error)
+	CancelCharge(ctx context.Context, paymentID string) error
 }
 
 // FindOrCreateCustomerParams holds the parameters for finding or creating a customer
@@ -51,3 +59,28 @@ type AsaasSubscriptionResult struct {
 	PaymentLink         string
 	Status              string
 }
+
+// CreateAsaasPixChargeParams holds the parameters for creating a single PIX charge
+type CreateAsaasPixChargeParams struct {
+	CustomerID        string // Asaas customer ID
+	Value             float64
+	Description       string
+	ExternalReference string // Our internal reference
+	DueDate           string // YYYY-MM-DD format
+}
+
+// AsaasPixChargeResult holds the result of creating a PIX charge
+type AsaasPixChargeResult struct {
+	AsaasPaymentID string
+	InvoiceURL     string
+	PixPayload     string // PIX copy-and-paste code (empty if unavailable)
+	Status         string
+}
+
+// AsaasChargeResult holds the current state of a single charge
+type AsaasChargeResult struct {
+	AsaasPaymentID string
+	Status         string
+	Paid           bool       // RECEIVED, CONFIRMED or RECEIVED_IN_CASH
+	PaidAt         *time.Time // payment date reported by Asaas, when paid
+}
@@ -0,0 +1,49 @@
+package port
+
+import (
+	"context"
+	"io"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// BankStatementRepository define operações de persistência para extratos bancários
+type BankStatementRepository interface {
+	// CreateImport registra um novo arquivo de extrato importado
+	CreateImport(ctx context.Context, imp *entity.BankStatementImport) error
+
+	// FindImportByID busca uma importação por ID
+	FindImportByID(ctx context.Context, importID, tenantID uuid.UUID) (*entity.BankStatementImport, error)
+
+	// ListImports lista importações do tenant (mais recentes primeiro)
+	ListImports(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entity.BankStatementImport, error)
+
+	// RefreshImportTotais recalcula os totais da importação a partir das linhas
+	RefreshImportTotais(ctx context.Context, importID, tenantID uuid.UUID) error
+
+	// CreateLine insere um lançamento. Retorna false se o lançamento já havia
+	// sido importado (mesmo identificador).
+	CreateLine(ctx context.Context, line *entity.BankStatementLine) (bool, error)
+
+	// FindLineByID busca um lançamento por ID
+	FindLineByID(ctx context.Context, lineID, tenantID uuid.UUID) (*entity.BankStatementLine, error)
+
+	// ListLinesByImport lista lançamentos de uma importação
+	ListLinesByImport(ctx context.Context, importID, tenantID uuid.UUID) ([]*entity.BankStatementLine, error)
+
+	// ListLinesRevisao lista lançamentos pendentes ou divergentes (fila de revisão)
+	ListLinesRevisao(ctx context.Context, tenantID uuid.UUID) ([]*entity.BankStatementLine, error)
+
+	// UpdateLineConciliacao persiste o resultado da conciliação de um lançamento
+	UpdateLineConciliacao(ctx context.Context, line *entity.BankStatementLine) error
+
+	// ExistsMatch verifica se um lançamento interno já foi conciliado
+	ExistsMatch(ctx context.Context, tenantID, matchID uuid.UUID) (bool, error)
+}
+
+// BankStatementParser lê arquivos de extrato bancário
+type BankStatementParser interface {
+	// Parse lê o arquivo no formato informado e retorna os lançamentos
+	Parse(formato entity.FormatoExtrato, r io.Reader) (*entity.ExtratoParseado, error)
+}
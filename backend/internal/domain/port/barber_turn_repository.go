@@ -32,6 +32,9 @@ type BarberTurnRepository interface {
 	// ListActive lista apenas barbeiros ativos na fila
 	ListActive(ctx context.Context, tenantID string) ([]*entity.BarberTurn, error)
 
+	// ListActiveByUnit lista os barbeiros ativos na fila que atendem na unidade
+	ListActiveByUnit(ctx context.Context, tenantID, unitID string) ([]*entity.BarberTurn, error)
+
 	// GetNextBarber retorna o próximo barbeiro da fila
 	GetNextBarber(ctx context.Context, tenantID string) (*entity.BarberTurn, error)
 
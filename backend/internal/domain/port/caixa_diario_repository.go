@@ -26,9 +26,18 @@ type CaixaDiarioRepository interface {
 	// UpdateTotais atualiza apenas os totais do caixa (após sangria, reforço, venda)
 	UpdateTotais(ctx context.Context, caixaID, tenantID uuid.UUID, sangrias, reforcos, entradas decimal.Decimal) error
 
-	// Fechar fecha o caixa com os valores de fechamento
+	// Fechar fecha o caixa com os valores de fechamento (FECHADO ou AGUARDANDO_APROVACAO)
 	Fechar(ctx context.Context, caixa *entity.CaixaDiario) error
 
+	// Aprovar conclui um fechamento que aguardava aprovação gerencial
+	Aprovar(ctx context.Context, caixa *entity.CaixaDiario) error
+
+	// Reabrir devolve ao operador um fechamento que aguardava aprovação
+	Reabrir(ctx context.Context, caixa *entity.CaixaDiario) error
+
+	// ListAguardandoAprovacao lista os caixas com divergência aguardando aprovação
+	ListAguardandoAprovacao(ctx context.Context, tenantID uuid.UUID) ([]*entity.CaixaDiario, error)
+
 	// ListHistorico lista caixas fechados com paginação
 	ListHistorico(ctx context.Context, tenantID uuid.UUID, filters CaixaFilters) ([]*entity.CaixaDiario, error)
 
@@ -46,6 +55,9 @@ type CaixaDiarioRepository interface {
 	// ListOperacoesByTipo lista operações filtradas por tipo
 	ListOperacoesByTipo(ctx context.Context, caixaID, tenantID uuid.UUID, tipo entity.TipoOperacaoCaixa) ([]entity.OperacaoCaixa, error)
 
+	// ListOperacoesByPeriodo lista as operações de todos os caixas do tenant no período [inicio, fim)
+	ListOperacoesByPeriodo(ctx context.Context, tenantID uuid.UUID, inicio, fim time.Time) ([]entity.OperacaoCaixa, error)
+
 	// SumOperacoesByTipo soma os valores de operações por tipo
 	SumOperacoesByTipo(ctx context.Context, caixaID, tenantID uuid.UUID) (map[entity.TipoOperacaoCaixa]decimal.Decimal, error)
 }
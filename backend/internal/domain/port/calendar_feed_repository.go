@@ -0,0 +1,37 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// CalendarFeedRepository define operações dos feeds iCalendar da agenda
+type CalendarFeedRepository interface {
+	// Create grava o feed (só o hash do token)
+	Create(ctx context.Context, feed *entity.CalendarFeed) error
+
+	// FindByTokenHash busca o feed ativo do link
+	FindByTokenHash(ctx context.Context, tokenHash string) (*entity.CalendarFeed, error)
+
+	// List lista os feeds ativos da unidade
+	List(ctx context.Context, tenantID, unitID string) ([]*entity.CalendarFeed, error)
+
+	// Revoke revoga o feed; o link deixa de funcionar imediatamente.
+	// professionalID não vazio restringe aos feeds do profissional.
+	Revoke(ctx context.Context, tenantID, id, professionalID string) error
+
+	// Touch registra o último acesso ao feed
+	Touch(ctx context.Context, id string) error
+
+	// ListBlockedTimes lista os bloqueios exibidos no feed: recorrentes
+	// sempre, avulsos que terminam a partir de since
+	ListBlockedTimes(ctx context.Context, feed *entity.CalendarFeed, since time.Time) ([]*CalendarFeedBlockedTime, error)
+}
+
+// CalendarFeedBlockedTime bloqueio com o nome do profissional
+type CalendarFeedBlockedTime struct {
+	entity.BlockedTime
+	ProfessionalName string
+}
@@ -0,0 +1,56 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// CalendarProvider define a integração com um provedor de calendário externo
+// (Google Agenda). Erros esperados: domain.ErrCalendarAccessRevoked quando o
+// usuário revogou o acesso, domain.ErrCalendarUnauthorized quando o access
+// token foi recusado, domain.ErrCalendarSyncTokenExpired quando a leitura
+// incremental precisa recomeçar e domain.ErrCalendarEventNotFound.
+type CalendarProvider interface {
+	// Name identifica o provedor (entity.CalendarProviderGoogle)
+	Name() string
+
+	// AuthCodeURL monta o link de consentimento; state volta no callback
+	AuthCodeURL(state string) string
+
+	// Exchange troca o código do callback pelas credenciais da conta
+	Exchange(ctx context.Context, code string) (*CalendarAuthorization, error)
+
+	// RefreshToken renova o access token
+	RefreshToken(ctx context.Context, token entity.CalendarToken) (entity.CalendarToken, error)
+
+	// RevokeToken revoga o acesso concedido
+	RevokeToken(ctx context.Context, token entity.CalendarToken) error
+
+	// CreateEvent cria o evento e retorna o ID externo
+	CreateEvent(ctx context.Context, conn *entity.CalendarConnection, ev entity.CalendarEvent) (string, error)
+
+	// UpdateEvent atualiza o evento
+	UpdateEvent(ctx context.Context, conn *entity.CalendarConnection, eventID string, ev entity.CalendarEvent) error
+
+	// DeleteEvent remove o evento; evento já removido não é erro
+	DeleteEvent(ctx context.Context, conn *entity.CalendarConnection, eventID string) error
+
+	// ListEvents lê os eventos alterados desde syncToken ou, com syncToken
+	// vazio, todos os eventos entre from e to
+	ListEvents(ctx context.Context, conn *entity.CalendarConnection, syncToken string, from, to time.Time) (*CalendarChanges, error)
+}
+
+// CalendarAuthorization credenciais da conta conectada
+type CalendarAuthorization struct {
+	Token        entity.CalendarToken
+	AccountEmail string
+	CalendarID   string
+}
+
+// CalendarChanges resultado da leitura do calendário externo
+type CalendarChanges struct {
+	Events        []entity.ExternalCalendarEvent
+	NextSyncToken string
+}
@@ -0,0 +1,71 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// CalendarSyncRepository define operações da sincronização com calendários
+// externos: conexões, eventos enviados, eventos importados e fila de envio
+type CalendarSyncRepository interface {
+	// SaveConnection grava a conexão; conectar de novo o mesmo profissional
+	// troca as credenciais e reinicia a leitura
+	SaveConnection(ctx context.Context, conn *entity.CalendarConnection) error
+
+	// FindConnection busca a conexão do tenant
+	FindConnection(ctx context.Context, tenantID, id string) (*entity.CalendarConnection, error)
+
+	// ListConnections lista as conexões do tenant; professionalID não vazio
+	// restringe ao profissional
+	ListConnections(ctx context.Context, tenantID, professionalID string) ([]*entity.CalendarConnection, error)
+
+	// ListActiveConnections lista as conexões ativas de todos os tenants
+	ListActiveConnections(ctx context.Context) ([]*entity.CalendarConnection, error)
+
+	// ListConnectionsByProfessionals lista as conexões dos profissionais
+	ListConnectionsByProfessionals(ctx context.Context, tenantID string, professionalIDs []string) ([]*entity.CalendarConnection, error)
+
+	// HasConnections indica se algum dos profissionais tem conexão ativa
+	HasConnections(ctx context.Context, tenantID string, professionalIDs []string) (bool, error)
+
+	// UpdateTokens grava as credenciais renovadas
+	UpdateTokens(ctx context.Context, conn *entity.CalendarConnection) error
+
+	// UpdateSyncState grava o resultado da última leitura
+	UpdateSyncState(ctx context.Context, conn *entity.CalendarConnection) error
+
+	// DeleteConnection remove a conexão e os bloqueios importados por ela
+	DeleteConnection(ctx context.Context, conn *entity.CalendarConnection) error
+
+	// ListEventLinks lista os eventos externos do agendamento
+	ListEventLinks(ctx context.Context, appointmentID string) ([]entity.CalendarEventLink, error)
+
+	// SaveEventLink grava o evento externo do trecho do agendamento
+	SaveEventLink(ctx context.Context, link entity.CalendarEventLink) error
+
+	// DeleteEventLink remove o vínculo com o evento externo
+	DeleteEventLink(ctx context.Context, link entity.CalendarEventLink) error
+
+	// ListImportedEvents lista os eventos importados da conexão
+	ListImportedEvents(ctx context.Context, connectionID string) ([]entity.CalendarImportedEvent, error)
+
+	// SaveImportedEvent vincula o evento externo ao bloqueio criado
+	SaveImportedEvent(ctx context.Context, connectionID string, ev entity.CalendarImportedEvent) error
+
+	// SetAppointmentEventID grava no agendamento o evento externo principal
+	SetAppointmentEventID(ctx context.Context, tenantID, appointmentID, eventID string) error
+
+	// Enqueue coloca o agendamento na fila de envio
+	Enqueue(ctx context.Context, tenantID, appointmentID string) error
+
+	// ListDue lista os agendamentos da fila prontos para envio
+	ListDue(ctx context.Context, limit int) ([]entity.CalendarSyncItem, error)
+
+	// Dequeue remove o item da fila, a menos que tenha sido enfileirado de
+	// novo durante o envio
+	Dequeue(ctx context.Context, item entity.CalendarSyncItem) error
+
+	// Retry agenda nova tentativa (Attempts, NextAttemptAt e LastError do item)
+	Retry(ctx context.Context, item entity.CalendarSyncItem) error
+}
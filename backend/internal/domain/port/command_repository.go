@@ -21,6 +21,11 @@ type CommandRepository interface {
 	// Update atualiza uma comanda existente
 	Update(ctx context.Context, command *entity.Command) error
 
+	// Close grava a comanda fechada e, quando debt não é nil, a dívida do saldo
+	// devedor (fiado) na mesma transação. Idempotente por comanda: retorna a
+	// dívida gravada ou a já existente.
+	Close(ctx context.Context, command *entity.Command, debt *entity.CustomerDebt) (*entity.CustomerDebt, error)
+
 	// Delete remove uma comanda (soft delete via status)
 	Delete(ctx context.Context, commandID, tenantID uuid.UUID) error
 
@@ -37,6 +37,9 @@ type ContaPagarRepository interface {
 	// ListByDateRange lista contas em um período (data vencimento)
 	ListByDateRange(ctx context.Context, tenantID string, inicio, fim time.Time) ([]*entity.ContaPagar, error)
 
+	// ListPagasByPeriod lista contas pagas em um período (data pagamento)
+	ListPagasByPeriod(ctx context.Context, tenantID string, inicio, fim time.Time) ([]*entity.ContaPagar, error)
+
 	// SumByPeriod soma valores de contas em um período
 	SumByPeriod(ctx context.Context, tenantID string, inicio, fim time.Time, status *valueobject.StatusConta) (valueobject.Money, error)
 
@@ -0,0 +1,51 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerDebtRepository define operações de persistência para o fiado (dívidas de clientes)
+type CustomerDebtRepository interface {
+	// Create registra uma nova dívida. Idempotente por comanda: se já existir
+	// dívida para a comanda, retorna a existente.
+	Create(ctx context.Context, debt *entity.CustomerDebt) (*entity.CustomerDebt, error)
+
+	// FindByID busca uma dívida por ID
+	FindByID(ctx context.Context, debtID, tenantID uuid.UUID) (*entity.CustomerDebt, error)
+
+	// FindByCommandID busca a dívida gerada por uma comanda (nil se não houver)
+	FindByCommandID(ctx context.Context, commandID, tenantID uuid.UUID) (*entity.CustomerDebt, error)
+
+	// ListByCustomer lista dívidas de um cliente
+	ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, somenteAbertas bool) ([]*entity.CustomerDebt, error)
+
+	// GetSaldoCliente retorna o saldo devedor total do cliente
+	GetSaldoCliente(ctx context.Context, tenantID, customerID uuid.UUID) (decimal.Decimal, error)
+
+	// ListAging retorna clientes com saldo devedor, ordenados por saldo e idade
+	ListAging(ctx context.Context, tenantID uuid.UUID) ([]*entity.CustomerDebtAging, error)
+
+	// ======== Pagamentos ========
+
+	// RegistrarPagamento abate payment.Valor do saldo de forma atômica e grava,
+	// na mesma transação, a operação de caixa, as contas a receber (com as
+	// compensações) e o pagamento. Falha com ErrCustomerDebtValorExcedente se
+	// o saldo atual não cobre o valor e com ErrCustomerDebtJaQuitada se a
+	// dívida não está mais aberta. Retorna a dívida atualizada.
+	RegistrarPagamento(ctx context.Context, payment *entity.CustomerDebtPayment, operacao *entity.OperacaoCaixa, contas []CustomerDebtReceivable) (*entity.CustomerDebt, error)
+
+	// ListPayments lista pagamentos de uma dívida
+	ListPayments(ctx context.Context, debtID, tenantID uuid.UUID) ([]entity.CustomerDebtPayment, error)
+}
+
+// CustomerDebtReceivable conta a receber gerada pelo pagamento do fiado e,
+// nos meios D+, a compensação bancária dela (ReceitaID recebe o ID gravado
+// da conta)
+type CustomerDebtReceivable struct {
+	Conta       *entity.ContaReceber
+	Compensacao *entity.CompensacaoBancaria
+}
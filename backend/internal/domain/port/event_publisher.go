@@ -0,0 +1,54 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// EventType identifica um evento de domínio publicado para integrações
+type EventType string
+
+const (
+	EventAppointmentCreated       EventType = "appointment.created"
+	EventAppointmentStatusChanged EventType = "appointment.status_changed"
+	EventCommandClosed            EventType = "command.closed"
+	EventCaixaClosed              EventType = "caixa.closed"
+	EventSubscriptionOverdue      EventType = "subscription.overdue"
+	EventStockBelowMinimum        EventType = "stock.below_minimum"
+)
+
+// EventTypes lista os eventos que podem ser assinados pelos webhooks de saída
+var EventTypes = []EventType{
+	EventAppointmentCreated,
+	EventAppointmentStatusChanged,
+	EventCommandClosed,
+	EventCaixaClosed,
+	EventSubscriptionOverdue,
+	EventStockBelowMinimum,
+}
+
+// IsValid verifica se o evento existe no catálogo
+func (t EventType) IsValid() bool {
+	for _, e := range EventTypes {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// DomainEvent é um fato ocorrido em um use case (agendamento criado, caixa
+// fechado...). Data carrega o payload já serializável em JSON.
+type DomainEvent struct {
+	Type       EventType
+	TenantID   string
+	UnitID     string
+	Data       map[string]any
+	OccurredAt time.Time
+}
+
+// EventPublisher publica eventos de domínio. A implementação grava as
+// entregas dos webhooks de saída; o envio acontece fora da requisição.
+type EventPublisher interface {
+	Publish(ctx context.Context, evt DomainEvent) error
+}
@@ -0,0 +1,18 @@
+package port
+
+import "context"
+
+// MailSender define a interface para envio de emails transacionais
+// (recuperação de senha, convites). Em desenvolvimento os emails são gravados
+// em arquivo; em produção enviados por SMTP.
+type MailSender interface {
+	Send(ctx context.Context, msg EmailMessage) error
+}
+
+// EmailMessage representa um email em texto puro
+type EmailMessage struct {
+	To      string
+	ToName  string
+	Subject string
+	Body    string
+}
@@ -0,0 +1,44 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// NoShowRepository define operações da política de não comparecimento e dos
+// sinais de agendamento
+type NoShowRepository interface {
+	// GetPolicy busca a política do tenant (ErrNoShowPolicyNotFound se não
+	// configurada)
+	GetPolicy(ctx context.Context, tenantID string) (*entity.NoShowPolicy, error)
+
+	// SavePolicy cria ou atualiza a política do tenant
+	SavePolicy(ctx context.Context, policy *entity.NoShowPolicy) error
+
+	// AttendanceStats conta comparecimentos, faltas e cancelamentos dos
+	// agendamentos já ocorridos do cliente desde a data informada
+	AttendanceStats(ctx context.Context, tenantID, customerID string, since time.Time) (entity.AttendanceStats, error)
+
+	// CreateDeposit registra o sinal pendente do agendamento
+	CreateDeposit(ctx context.Context, deposit *entity.AppointmentDeposit) error
+
+	// FindDepositByAppointment busca o sinal do agendamento
+	FindDepositByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentDeposit, error)
+
+	// FindDepositByAsaasPayment busca o sinal pela cobrança do Asaas (webhook,
+	// todos os tenants)
+	FindDepositByAsaasPayment(ctx context.Context, asaasPaymentID string) (*entity.AppointmentDeposit, error)
+
+	// SetDepositCharge grava a cobrança PIX gerada no Asaas
+	SetDepositCharge(ctx context.Context, deposit *entity.AppointmentDeposit) error
+
+	// TransitionDeposit grava status, datas e vínculos do sinal se ele ainda
+	// estiver em fromStatus; retorna false caso contrário
+	TransitionDeposit(ctx context.Context, deposit *entity.AppointmentDeposit, fromStatus string) (bool, error)
+
+	// ListOverdueDeposits lista sinais pendentes com prazo vencido (todos os
+	// tenants)
+	ListOverdueDeposits(ctx context.Context, limit int) ([]*entity.AppointmentDeposit, error)
+}
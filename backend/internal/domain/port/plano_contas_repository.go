@@ -0,0 +1,20 @@
+package port
+
+import (
+	"context"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// PlanoContasRepository define operações de persistência para o mapeamento do plano de contas
+type PlanoContasRepository interface {
+	// Upsert cria ou substitui o mapeamento da origem (tenant, tipo, chave)
+	Upsert(ctx context.Context, m *entity.PlanoContasMapeamento) error
+
+	// List lista os mapeamentos cadastrados do tenant
+	List(ctx context.Context, tenantID uuid.UUID) ([]*entity.PlanoContasMapeamento, error)
+
+	// Delete remove um mapeamento (a origem volta a usar a conta padrão)
+	Delete(ctx context.Context, id, tenantID uuid.UUID) error
+}
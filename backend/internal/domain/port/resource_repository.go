@@ -0,0 +1,38 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// ResourceRepository define operações dos recursos da unidade (cadeiras,
+// salas, lavatório) e dos recursos exigidos pelos serviços
+type ResourceRepository interface {
+	// Create cadastra um recurso
+	Create(ctx context.Context, resource *entity.Resource) error
+
+	// FindByID busca um recurso do tenant
+	FindByID(ctx context.Context, tenantID, id string) (*entity.Resource, error)
+
+	// List lista os recursos da unidade
+	List(ctx context.Context, tenantID, unitID string, includeInactive bool) ([]*entity.Resource, error)
+
+	// Update grava nome, capacidade e situação do recurso
+	Update(ctx context.Context, resource *entity.Resource) error
+
+	// ListServiceRequirements lista os recursos exigidos pelo serviço
+	ListServiceRequirements(ctx context.Context, tenantID, serviceID string) ([]entity.ServiceResourceRequirement, error)
+
+	// ReplaceServiceRequirements substitui os recursos exigidos pelo serviço
+	ReplaceServiceRequirements(ctx context.Context, tenantID, serviceID string, requirements []entity.ServiceResourceRequirement) error
+
+	// ListRequirementsForServices lista os recursos ativos da unidade
+	// exigidos pelos serviços informados
+	ListRequirementsForServices(ctx context.Context, tenantID, unitID string, serviceIDs []string) ([]entity.ServiceResourceRequirement, error)
+
+	// PeakUsage retorna o maior número de reservas simultâneas do recurso no
+	// período, ignorando o agendamento informado
+	PeakUsage(ctx context.Context, tenantID, resourceID string, start, end time.Time, excludeAppointmentID string) (int, error)
+}
@@ -0,0 +1,70 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// WaitlistRepository define operações da lista de espera e das ofertas de
+// horários liberados
+type WaitlistRepository interface {
+	// CreateEntry inclui o cliente na lista de espera
+	CreateEntry(ctx context.Context, entry *entity.WaitlistEntry) error
+
+	// FindEntryByID busca uma entrada do tenant
+	FindEntryByID(ctx context.Context, tenantID, id string) (*entity.WaitlistEntry, error)
+
+	// UpdateEntryStatus grava status e agendamento da entrada
+	UpdateEntryStatus(ctx context.Context, entry *entity.WaitlistEntry) error
+
+	// ListEntries lista as entradas da unidade (status vazio = todas)
+	ListEntries(ctx context.Context, tenantID, unitID, status string) ([]*entity.WaitlistEntry, error)
+
+	// ListCandidates lista, por prioridade, as entradas aguardando que aceitam
+	// o profissional e cujo período cruza o horário liberado, exceto as que
+	// já receberam oferta dele
+	ListCandidates(ctx context.Context, tenantID, unitID, professionalID string, slotStart, slotEnd time.Time, limit int) ([]*entity.WaitlistEntry, error)
+
+	// ExpireEntries encerra (todos os tenants) as entradas com período vencido
+	ExpireEntries(ctx context.Context) (int64, error)
+
+	// CreateOffer registra a oferta; retorna false se o horário já tem
+	// oferta pendente
+	CreateOffer(ctx context.Context, offer *entity.WaitlistOffer) (bool, error)
+
+	// FindOfferByTokenHash busca a oferta pelo hash do token do link
+	FindOfferByTokenHash(ctx context.Context, tokenHash string) (*entity.WaitlistOffer, error)
+
+	// FindPendingOfferByEntry busca a oferta pendente da entrada
+	FindPendingOfferByEntry(ctx context.Context, tenantID, entryID string) (*entity.WaitlistOffer, error)
+
+	// TransitionOffer muda o status da oferta se ela ainda estiver em
+	// fromStatus; retorna false caso contrário
+	TransitionOffer(ctx context.Context, offerID, fromStatus, status, appointmentID string) (bool, error)
+
+	// ListExpiredOffers lista ofertas pendentes com prazo vencido (todos os
+	// tenants)
+	ListExpiredOffers(ctx context.Context, limit int) ([]*entity.WaitlistOffer, error)
+}
+
+// WaitlistNotifier avisa o cliente da lista de espera de um horário
+// oferecido. A implementação padrão envia email; outros canais (WhatsApp,
+// SMS) implementam a mesma interface.
+type WaitlistNotifier interface {
+	NotifySlotOffer(ctx context.Context, offer WaitlistSlotOffer) error
+}
+
+// WaitlistSlotOffer dados do aviso de horário oferecido
+type WaitlistSlotOffer struct {
+	TenantID         string
+	CustomerName     string
+	CustomerEmail    string
+	CustomerPhone    string
+	ProfessionalName string
+	StartTime        time.Time
+	EndTime          time.Time
+	ExpiresAt        time.Time
+	Token            string // token do link de confirmação em um clique
+}
@@ -0,0 +1,26 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// WalkInRepository define operações da fila de encaixe (clientes sem horário
+// marcado aguardando barbeiro)
+type WalkInRepository interface {
+	// Create registra a chegada do cliente
+	Create(ctx context.Context, walkIn *entity.WalkIn) error
+
+	// FindByID busca uma chegada do tenant
+	FindByID(ctx context.Context, tenantID, id string) (*entity.WalkIn, error)
+
+	// List lista, na ordem de chegada, as chegadas da unidade desde since
+	// (status vazio = todos)
+	List(ctx context.Context, tenantID, unitID string, since time.Time, status string) ([]*entity.WalkIn, error)
+
+	// Transition grava status, barbeiro e agendamento se a chegada ainda
+	// estiver em fromStatus; retorna false se outra operação chegou antes
+	Transition(ctx context.Context, walkIn *entity.WalkIn, fromStatus string) (bool, error)
+}
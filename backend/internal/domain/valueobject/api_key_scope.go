@@ -0,0 +1,75 @@
+package valueobject
+
+// APIKeyScope limita o que uma chave de API de integração pode acessar
+// (recurso:ação). Chaves não têm papel: as rotas aceitas e as permissões
+// efetivas derivam apenas dos escopos.
+type APIKeyScope string
+
+const (
+	ScopeFinancialRead     APIKeyScope = "financial:read"
+	ScopeAppointmentsRead  APIKeyScope = "appointments:read"
+	ScopeAppointmentsWrite APIKeyScope = "appointments:write"
+	ScopeCustomersRead     APIKeyScope = "customers:read"
+)
+
+// APIKeyScopeInfo descreve um escopo do catálogo
+type APIKeyScopeInfo struct {
+	Code      APIKeyScope
+	Descricao string
+}
+
+// APIKeyScopeCatalog lista os escopos disponíveis para chaves de API
+var APIKeyScopeCatalog = []APIKeyScopeInfo{
+	{ScopeFinancialRead, "Consultar o financeiro (contas, fluxo de caixa, DRE, painéis)"},
+	{ScopeAppointmentsRead, "Consultar agendamentos"},
+	{ScopeAppointmentsWrite, "Criar, remarcar e alterar o status de agendamentos (inclui leitura)"},
+	{ScopeCustomersRead, "Consultar clientes"},
+}
+
+// scopePermissions mapeia escopos para as permissões nomeadas exigidas pelas
+// rotas protegidas por RequirePermission
+var scopePermissions = map[APIKeyScope][]Permission{
+	ScopeFinancialRead: {
+		PermPayablesRead,
+		PermReceivablesRead,
+		PermCompensationsRead,
+		PermCashflowRead,
+		PermDRERead,
+		PermFinancialDashboardRead,
+	},
+}
+
+// IsValid verifica se o escopo existe no catálogo
+func (s APIKeyScope) IsValid() bool {
+	for _, info := range APIKeyScopeCatalog {
+		if info.Code == s {
+			return true
+		}
+	}
+	return false
+}
+
+// String retorna a string do escopo
+func (s APIKeyScope) String() string {
+	return string(s)
+}
+
+// Includes verifica se o escopo concede o informado (appointments:write
+// inclui appointments:read)
+func (s APIKeyScope) Includes(other APIKeyScope) bool {
+	if s == other {
+		return true
+	}
+	return s == ScopeAppointmentsWrite && other == ScopeAppointmentsRead
+}
+
+// ScopePermissions retorna as permissões efetivas de um conjunto de escopos
+func ScopePermissions(scopes ...APIKeyScope) PermissionSet {
+	s := PermissionSet{}
+	for _, scope := range scopes {
+		for _, p := range scopePermissions[scope] {
+			s[p] = struct{}{}
+		}
+	}
+	return s
+}
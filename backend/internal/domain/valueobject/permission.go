@@ -0,0 +1,232 @@
+package valueobject
+
+import (
+	"sort"
+	"strings"
+)
+
+// Permission representa uma permissão nomeada (módulo.ação), verificada pelo
+// middleware RequirePermission e por use cases com limites de valor
+type Permission string
+
+const (
+	// Caixa diário
+	PermCaixaRead      Permission = "caixa.read"
+	PermCaixaRelatorio Permission = "caixa.relatorio"
+	PermCaixaAbrir     Permission = "caixa.abrir"
+	PermCaixaSangria   Permission = "caixa.sangria"
+	PermCaixaReforco   Permission = "caixa.reforco"
+	PermCaixaFechar    Permission = "caixa.fechar"
+	PermCaixaAprovar   Permission = "caixa.aprovar"
+
+	// Financeiro
+	PermPayablesRead           Permission = "financial.payables.read"
+	PermPayablesCreate         Permission = "financial.payables.create"
+	PermPayablesManage         Permission = "financial.payables.manage"
+	PermReceivablesRead        Permission = "financial.receivables.read"
+	PermReceivablesCreate      Permission = "financial.receivables.create"
+	PermReceivablesManage      Permission = "financial.receivables.manage"
+	PermCompensationsRead      Permission = "financial.compensations.read"
+	PermCompensationsManage    Permission = "financial.compensations.manage"
+	PermCashflowRead           Permission = "financial.cashflow.read"
+	PermDRERead                Permission = "financial.dre.read"
+	PermFinancialDashboardRead Permission = "financial.dashboard.read"
+	PermContabilExport         Permission = "financial.contabil.export"
+
+	// Comandas
+	PermCommandOperate       Permission = "command.operate"
+	PermCommandItemRemove    Permission = "command.item.remove"
+	PermCommandPaymentRemove Permission = "command.payment.remove"
+	PermCommandClose         Permission = "command.close"
+	PermCommandCancel        Permission = "command.cancel"
+	PermCommandDiscountAbove Permission = "command.discount.above_10pct"
+
+	// Administração
+	PermRolesManage Permission = "roles.manage"
+)
+
+// LimiteDescontoSemPermissao é o desconto máximo (%) por item sem PermCommandDiscountAbove
+const LimiteDescontoSemPermissao = 10.0
+
+// PermissionInfo descreve uma permissão do catálogo
+type PermissionInfo struct {
+	Code      Permission
+	Descricao string
+}
+
+// PermissionCatalog lista todas as permissões conhecidas, na ordem exibida ao dono
+var PermissionCatalog = []PermissionInfo{
+	{PermCaixaRead, "Consultar caixa e histórico"},
+	{PermCaixaRelatorio, "Relatório de fechamento de caixa"},
+	{PermCaixaAbrir, "Abrir caixa"},
+	{PermCaixaSangria, "Registrar sangria"},
+	{PermCaixaReforco, "Registrar reforço"},
+	{PermCaixaFechar, "Fechar caixa (contagem cega)"},
+	{PermCaixaAprovar, "Aprovar ou rejeitar fechamentos com divergência"},
+	{PermPayablesRead, "Consultar contas a pagar"},
+	{PermPayablesCreate, "Lançar contas a pagar"},
+	{PermPayablesManage, "Alterar, excluir e baixar contas a pagar"},
+	{PermReceivablesRead, "Consultar contas a receber"},
+	{PermReceivablesCreate, "Lançar contas a receber"},
+	{PermReceivablesManage, "Alterar, excluir e baixar contas a receber"},
+	{PermCompensationsRead, "Consultar compensações bancárias"},
+	{PermCompensationsManage, "Excluir compensações bancárias"},
+	{PermCashflowRead, "Consultar fluxo de caixa"},
+	{PermDRERead, "Consultar DRE"},
+	{PermFinancialDashboardRead, "Dashboard financeiro e projeções"},
+	{PermContabilExport, "Consultar plano de contas e exportar lançamentos contábeis"},
+	{PermCommandOperate, "Abrir comandas, lançar itens e pagamentos"},
+	{PermCommandItemRemove, "Remover itens de comanda"},
+	{PermCommandPaymentRemove, "Remover pagamentos de comanda"},
+	{PermCommandClose, "Fechar comandas"},
+	{PermCommandCancel, "Cancelar comandas"},
+	{PermCommandDiscountAbove, "Conceder desconto acima de 10% por item"},
+	{PermRolesManage, "Gerenciar papéis personalizados"},
+}
+
+// IsValid verifica se a permissão existe no catálogo
+func (p Permission) IsValid() bool {
+	for _, info := range PermissionCatalog {
+		if info.Code == p {
+			return true
+		}
+	}
+	return false
+}
+
+// String retorna a string da permissão
+func (p Permission) String() string {
+	return string(p)
+}
+
+// roleMapping mapeia roles do banco (português/minúsculo) para roles RBAC (inglês/maiúsculo)
+var roleMapping = map[string]string{
+	"owner":         "OWNER",
+	"manager":       "MANAGER",
+	"barbeiro":      "BARBER",
+	"barber":        "BARBER",
+	"recepcionista": "RECEPTIONIST",
+	"receptionist":  "RECEPTIONIST",
+	"contador":      "ACCOUNTANT",
+	"accountant":    "ACCOUNTANT",
+}
+
+// NormalizeRole converte role do banco para o formato RBAC padronizado
+func NormalizeRole(role string) string {
+	if normalized, ok := roleMapping[strings.ToLower(role)]; ok {
+		return normalized
+	}
+	// Se não encontrar mapeamento, retorna uppercase como fallback
+	return strings.ToUpper(role)
+}
+
+// Grupos usados para montar os modelos de papel (espelham RequireAnyRole,
+// RequireAdminAccess e RequireOwnerOrManager)
+var (
+	permsTodos = []Permission{
+		PermCaixaRead,
+		PermCommandOperate,
+	}
+	permsAdministrativo = []Permission{
+		PermCaixaRelatorio,
+		PermCaixaFechar,
+		PermPayablesRead,
+		PermPayablesCreate,
+		PermReceivablesRead,
+		PermReceivablesCreate,
+		PermCompensationsRead,
+		PermCashflowRead,
+		PermFinancialDashboardRead,
+		PermCommandItemRemove,
+		PermCommandPaymentRemove,
+		PermCommandClose,
+		PermCommandCancel,
+	}
+	permsGestao = []Permission{
+		PermCaixaAbrir,
+		PermCaixaSangria,
+		PermCaixaReforco,
+		PermCaixaAprovar,
+		PermPayablesManage,
+		PermReceivablesManage,
+		PermCompensationsManage,
+		PermDRERead,
+		PermContabilExport,
+		PermCommandDiscountAbove,
+	}
+)
+
+// RoleTemplates mapeia cada papel fixo ao seu conjunto padrão de permissões.
+// OWNER tem todas; papéis personalizados do tenant partem de um destes modelos.
+// ACCOUNTANT não passa em nenhum grupo do RBAC e começa sem permissões: o
+// acesso do contador ao financeiro é concedido pelo dono num papel personalizado.
+var RoleTemplates = map[string][]Permission{
+	"MANAGER":      concatPerms(permsTodos, permsAdministrativo, permsGestao),
+	"RECEPTIONIST": concatPerms(permsTodos, permsAdministrativo),
+	"BARBER":       concatPerms(permsTodos),
+	"ACCOUNTANT":   nil,
+}
+
+func concatPerms(grupos ...[]Permission) []Permission {
+	var out []Permission
+	for _, g := range grupos {
+		out = append(out, g...)
+	}
+	return out
+}
+
+// PermissionSet é um conjunto de permissões efetivas
+type PermissionSet map[Permission]struct{}
+
+// NewPermissionSet cria o conjunto a partir dos códigos (desconhecidos são ignorados)
+func NewPermissionSet(codes ...string) PermissionSet {
+	s := PermissionSet{}
+	for _, c := range codes {
+		if p := Permission(c); p.IsValid() {
+			s[p] = struct{}{}
+		}
+	}
+	return s
+}
+
+// TemplatePermissions retorna as permissões do modelo do papel. Papel
+// desconhecido não recebe permissão alguma.
+func TemplatePermissions(role string) PermissionSet {
+	role = NormalizeRole(role)
+	s := PermissionSet{}
+	if role == "OWNER" {
+		for _, info := range PermissionCatalog {
+			s[info.Code] = struct{}{}
+		}
+		return s
+	}
+	for _, p := range RoleTemplates[role] {
+		s[p] = struct{}{}
+	}
+	return s
+}
+
+// Has verifica se o conjunto contém todas as permissões informadas
+func (s PermissionSet) Has(perms ...Permission) bool {
+	for _, p := range perms {
+		if _, ok := s[p]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// List retorna os códigos em ordem alfabética (claim do token e respostas)
+func (s PermissionSet) List() []string {
+	out := make([]string, 0, len(s))
+	for p := range s {
+		out = append(out, string(p))
+	}
+	sort.Strings(out)
+	return out
+}
+
+// PodeConcederDesconto verifica se o percentual de desconto do item é permitido
+func (s PermissionSet) PodeConcederDesconto(percentual float64) bool {
+	return percentual <= LimiteDescontoSemPermissao || s.Has(PermCommandDiscountAbove)
+}
@@ -0,0 +1,72 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPermissionSet(t *testing.T) {
+	s := NewPermissionSet("caixa.sangria", "financial.dre.read", "modulo.inexistente", "caixa.sangria")
+
+	assert.Equal(t, []string{"caixa.sangria", "financial.dre.read"}, s.List())
+	assert.True(t, s.Has(PermCaixaSangria, PermDRERead))
+	assert.False(t, s.Has(PermCaixaSangria, PermCaixaAprovar), "Has exige todas as permissões")
+	assert.True(t, s.Has(), "lista vazia é sempre satisfeita")
+}
+
+func TestTemplatePermissions(t *testing.T) {
+	casos := []struct {
+		role    string
+		tem     []Permission
+		naoTem  []Permission
+		quantas int
+	}{
+		{"owner", []Permission{PermRolesManage, PermCaixaAprovar, PermDRERead}, nil, len(PermissionCatalog)},
+		{"MANAGER", []Permission{PermCaixaAprovar, PermDRERead, PermContabilExport, PermCommandDiscountAbove}, []Permission{PermRolesManage}, -1},
+		{"recepcionista", []Permission{PermCaixaFechar, PermPayablesCreate, PermCommandCancel}, []Permission{PermCaixaSangria, PermDRERead, PermContabilExport, PermCommandDiscountAbove}, -1},
+		{"barbeiro", []Permission{PermCaixaRead, PermCommandOperate}, []Permission{PermCaixaFechar, PermPayablesRead}, 2},
+		{"contador", nil, []Permission{PermPayablesRead, PermDRERead}, 0},
+		{"desconhecido", nil, []Permission{PermCaixaRead}, 0},
+	}
+	for _, c := range casos {
+		s := TemplatePermissions(c.role)
+		assert.True(t, s.Has(c.tem...), "%s deveria ter %v", c.role, c.tem)
+		for _, p := range c.naoTem {
+			assert.False(t, s.Has(p), "%s não deveria ter %s", c.role, p)
+		}
+		if c.quantas >= 0 {
+			assert.Len(t, s, c.quantas, c.role)
+		}
+	}
+}
+
+func TestRoleTemplates_SoUsamPermissoesDoCatalogo(t *testing.T) {
+	for role, perms := range RoleTemplates {
+		for _, p := range perms {
+			assert.True(t, p.IsValid(), "%s: permissão fora do catálogo %s", role, p)
+			assert.NotEqual(t, PermRolesManage, p, "%s: roles.manage é exclusiva do dono", role)
+		}
+	}
+}
+
+func TestPodeConcederDesconto(t *testing.T) {
+	comPermissao := NewPermissionSet(string(PermCommandDiscountAbove))
+	semPermissao := NewPermissionSet(string(PermCommandOperate))
+
+	casos := []struct {
+		percentual float64
+		s          PermissionSet
+		want       bool
+	}{
+		{0, semPermissao, true},
+		{10, semPermissao, true},
+		{10.01, semPermissao, false},
+		{50, semPermissao, false},
+		{50, comPermissao, true},
+		{100, comPermissao, true},
+	}
+	for _, c := range casos {
+		assert.Equal(t, c.want, c.s.PodeConcederDesconto(c.percentual), "percentual=%v", c.percentual)
+	}
+}
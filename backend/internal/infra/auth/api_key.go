@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// APIKeyPrefix identifica as chaves de API de integração ("bap_..."), o que
+// permite distingui-las de um JWT no header Authorization
+const APIKeyPrefix = "bap_"
+
+// apiKeyDisplayLen é o tamanho do prefixo exibido na listagem (bap_ + 8)
+const apiKeyDisplayLen = len(APIKeyPrefix) + 8
+
+// GenerateAPIKey gera uma chave de API aleatória. Retorna a chave (mostrada
+// uma única vez) e o prefixo exibido na listagem.
+func GenerateAPIKey() (key, displayPrefix string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", fmt.Errorf("erro ao gerar chave de API: %w", err)
+	}
+	key = APIKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return key, key[:apiKeyDisplayLen], nil
+}
+
+// IsAPIKey verifica se o valor tem o formato de chave de API
+func IsAPIKey(value string) bool {
+	return strings.HasPrefix(value, APIKeyPrefix)
+}
+
+// HashAPIKey retorna o SHA-256 (hex) da chave. A chave tem 256 bits de
+// entropia, então um hash rápido basta; só ele vai para o banco.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,7 +2,9 @@ package auth
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"time"
@@ -40,7 +42,9 @@ func NewJWTManager() *JWTManager {
 
 // GenerateAccessToken gera novo access token JWT (15 minutos)
 // unitID é opcional e representa a unidade atualmente selecionada pelo usuário.
-func (jm *JWTManager) GenerateAccessToken(userID, tenantID, unitID, email, role string) (string, error) {
+// sessionID identifica a sessão (dispositivo) que emitiu o token.
+// permissions são as permissões efetivas do papel na unidade ativa.
+func (jm *JWTManager) GenerateAccessToken(userID, tenantID, unitID, email, role, sessionID string, permissions []string) (string, error) {
 	now := time.Now()
 	expiresAt := now.Add(AccessTokenDuration)
 
@@ -50,6 +54,8 @@ func (jm *JWTManager) GenerateAccessToken(userID, tenantID, unitID, email, role
 		"unit_id":   unitID,
 		"email":     email,
 		"role":      role,
+		"sid":       sessionID,
+		"perms":     permissions,
 		"iat":       now.Unix(),
 		"exp":       expiresAt.Unix(),
 	}
@@ -68,6 +74,23 @@ func (jm *JWTManager) GenerateRefreshToken() (string, error) {
 	return base64.URLEncoding.EncodeToString(b), nil
 }
 
+// GenerateOpaqueToken gera token aleatório de uso único (links de recuperação
+// de senha e convite). Assim como o refresh token, só o hash vai para o banco.
+func GenerateOpaqueToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("erro ao gerar token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashRefreshToken retorna o SHA-256 (hex) do refresh token. Só o hash é
+// gravado no banco: um vazamento da tabela não permite renovar sessões.
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 // ValidateAccessToken valida e extrai claims do access token
 func (jm *JWTManager) ValidateAccessToken(tokenString string) (*dto.JWTClaims, error) {
 	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
@@ -96,16 +119,29 @@ func (jm *JWTManager) ValidateAccessToken(tokenString string) (*dto.JWTClaims, e
 	email, _ := claims["email"].(string)
 	role, _ := claims["role"].(string)
 	unitID, _ := claims["unit_id"].(string)
+	sessionID, _ := claims["sid"].(string)
+	// perms ausente (token emitido antes das permissões nomeadas) fica nil
+	var permissions []string
+	if raw, ok := claims["perms"].([]interface{}); ok {
+		permissions = make([]string, 0, len(raw))
+		for _, p := range raw {
+			if code, ok := p.(string); ok {
+				permissions = append(permissions, code)
+			}
+		}
+	}
 	iat, _ := claims["iat"].(float64)
 	exp, _ := claims["exp"].(float64)
 
 	return &dto.JWTClaims{
-		UserID:    userID,
-		TenantID:  tenantID,
-		UnitID:    unitID,
-		Email:     email,
-		Role:      role,
-		IssuedAt:  int64(iat),
-		ExpiresAt: int64(exp),
+		UserID:      userID,
+		TenantID:    tenantID,
+		UnitID:      unitID,
+		Email:       email,
+		Role:        role,
+		SessionID:   sessionID,
+		Permissions: permissions,
+		IssuedAt:    int64(iat),
+		ExpiresAt:   int64(exp),
 	}, nil
 }
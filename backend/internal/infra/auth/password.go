@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"sync"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -24,3 +26,18 @@ func CheckPassword(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+)
+
+// CheckPasswordDummy executa a comparação bcrypt contra um hash fictício.
+// Usado quando o email não existe, para que o tempo de resposta não revele
+// quais contas estão cadastradas.
+func CheckPasswordDummy(password string) {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("valtaris-dummy-password"), bcryptCost)
+	})
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+}
@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// =============================================================================
+// TOTP (RFC 6238) - segundo fator de autenticação
+// HMAC-SHA1, 6 dígitos, passo de 30 segundos: o padrão aceito por Google
+// Authenticator, Authy, 1Password etc.
+// =============================================================================
+
+const (
+	TOTPDigits = 6
+	TOTPPeriod = 30 * time.Second
+	// totpSkew aceita o passo anterior e o seguinte (relógio do celular adiantado/atrasado)
+	totpSkew = 1
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret gera segredo aleatório de 160 bits em base32 (sem padding)
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("erro ao gerar segredo TOTP: %w", err)
+	}
+	return totpEncoding.EncodeToString(b), nil
+}
+
+// TOTPProvisioningURI monta a URI otpauth:// usada no QR code do aplicativo autenticador
+func TOTPProvisioningURI(secret, issuer, account string) string {
+	v := url.Values{}
+	v.Set("secret", secret)
+	v.Set("issuer", issuer)
+	v.Set("algorithm", "SHA1")
+	v.Set("digits", fmt.Sprint(TOTPDigits))
+	v.Set("period", fmt.Sprint(int(TOTPPeriod.Seconds())))
+	label := url.PathEscape(issuer + ":" + account)
+	return "otpauth://totp/" + label + "?" + v.Encode()
+}
+
+// TOTPStep retorna o contador de passos (janelas de 30s) do instante t
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod.Seconds())
+}
+
+// ValidateTOTP verifica o código contra os passos vizinhos de now. Passos
+// menores ou iguais a lastStep são recusados (o mesmo código não vale duas
+// vezes). Retorna o passo aceito para ser gravado como o novo lastStep.
+func ValidateTOTP(secret, code string, now time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return 0, false
+	}
+
+	atual := TOTPStep(now)
+	for step := atual - totpSkew; step <= atual+totpSkew; step++ {
+		if step <= lastStep {
+			continue
+		}
+		if subtle.ConstantTimeCompare([]byte(hotp(key, uint64(step), TOTPDigits)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// hotp calcula o código HOTP (RFC 4226) do contador
+func hotp(key []byte, counter uint64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	bin := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, bin%mod)
+}
+
+// GenerateRecoveryCodes gera n códigos de recuperação no formato xxxxx-xxxxx.
+// Cada código tem 50 bits aleatórios; o banco guarda apenas o SHA-256.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	enc := base32.StdEncoding.WithPadding(base32.NoPadding)
+	for i := range codes {
+		b := make([]byte, 7)
+		if _, err := rand.Read(b); err != nil {
+			return nil, fmt.Errorf("erro ao gerar código de recuperação: %w", err)
+		}
+		s := strings.ToLower(enc.EncodeToString(b))[:10]
+		codes[i] = s[:5] + "-" + s[5:]
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode normaliza (minúsculas, sem hífen/espaços) e retorna o SHA-256 hex
+func HashRecoveryCode(code string) string {
+	normalizado := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+	return HashRefreshToken(normalizado)
+}
+
+// =============================================================================
+// CIFRA DO SEGREDO TOTP
+// O segredo precisa ser lido de volta para validar códigos, então não pode ser
+// hash: é gravado cifrado com AES-256-GCM.
+// =============================================================================
+
+// SecretCipher cifra e decifra segredos TOTP
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher cria a cifra a partir de TOTP_ENCRYPTION_KEY (ou JWT_SECRET
+// em desenvolvimento). Trocar a chave invalida os segredos já cadastrados.
+func NewSecretCipher() (*SecretCipher, error) {
+	key := os.Getenv("TOTP_ENCRYPTION_KEY")
+	if key == "" {
+		key = os.Getenv("JWT_SECRET")
+	}
+	if key == "" {
+		// ATENÇÃO: em produção, DEVE estar no .env
+		key = "valtaris-dev-secret-change-in-production"
+	}
+	return newSecretCipher(key)
+}
+
+func newSecretCipher(key string) (*SecretCipher, error) {
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt retorna base64(nonce || texto cifrado)
+func (c *SecretCipher) Encrypt(plain string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("erro ao gerar nonce: %w", err)
+	}
+	out := c.aead.Seal(nonce, nonce, []byte(plain), nil)
+	return base64.StdEncoding.EncodeToString(out), nil
+}
+
+// Decrypt reverte Encrypt
+func (c *SecretCipher) Decrypt(enc string) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		return "", fmt.Errorf("segredo cifrado inválido: %w", err)
+	}
+	ns := c.aead.NonceSize()
+	if len(raw) < ns {
+		return "", errors.New("segredo cifrado inválido")
+	}
+	plain, err := c.aead.Open(nil, raw[:ns], raw[ns:], nil)
+	if err != nil {
+		return "", fmt.Errorf("erro ao decifrar segredo: %w", err)
+	}
+	return string(plain), nil
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"encoding/base32"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Vetores do apêndice B da RFC 6238 (SHA-1), truncados para 6 dígitos
+func TestValidateTOTPVetoresRFC6238(t *testing.T) {
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+	casos := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, c := range casos {
+		step, ok := ValidateTOTP(secret, c.code, time.Unix(c.unix, 0), 0)
+		assert.True(t, ok, "t=%d", c.unix)
+		assert.Equal(t, TOTPStep(time.Unix(c.unix, 0)), step)
+	}
+}
+
+func TestValidateTOTPRecusaReuso(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+	key, err := totpEncoding.DecodeString(secret)
+	require.NoError(t, err)
+
+	now := time.Now()
+	code := hotp(key, uint64(TOTPStep(now)), TOTPDigits)
+
+	step, ok := ValidateTOTP(secret, code, now, 0)
+	require.True(t, ok)
+
+	_, ok = ValidateTOTP(secret, code, now, step)
+	assert.False(t, ok, "mesmo passo não pode ser aceito duas vezes")
+
+	_, ok = ValidateTOTP(secret, "12345", now, 0)
+	assert.False(t, ok)
+}
+
+func TestSecretCipherIdaEVolta(t *testing.T) {
+	c, err := newSecretCipher("chave-de-teste")
+	require.NoError(t, err)
+
+	enc, err := c.Encrypt("JBSWY3DPEHPK3PXP")
+	require.NoError(t, err)
+	plain, err := c.Decrypt(enc)
+	require.NoError(t, err)
+	assert.Equal(t, "JBSWY3DPEHPK3PXP", plain)
+
+	outra, err := newSecretCipher("outra-chave")
+	require.NoError(t, err)
+	_, err = outra.Decrypt(enc)
+	assert.Error(t, err)
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	require.Len(t, codes, 10)
+	assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+	assert.Equal(t, HashRecoveryCode(codes[0]), HashRecoveryCode(" "+strings.ToUpper(strings.ReplaceAll(codes[0], "-", ""))))
+}
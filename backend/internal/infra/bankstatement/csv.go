@@ -0,0 +1,179 @@
+package bankstatement
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/shopspring/decimal"
+)
+
+// Nomes de coluna aceitos (normalizados em minúsculas, sem acento)
+var (
+	csvColunasData      = []string{"data", "data lancamento", "data movimento", "dt lancamento", "date"}
+	csvColunasDescricao = []string{"descricao", "historico", "lancamento", "memo", "description", "detalhe"}
+	csvColunasValor     = []string{"valor", "valor (r$)", "amount", "montante"}
+	csvColunasCredito   = []string{"credito", "entrada", "credit"}
+	csvColunasDebito    = []string{"debito", "saida", "debit"}
+	csvColunasTipo      = []string{"tipo", "d/c", "c/d", "natureza"}
+	csvColunasID        = []string{"id", "identificador", "documento", "nr documento", "fitid"}
+)
+
+// csvLayout guarda as posições das colunas identificadas no cabeçalho
+type csvLayout struct {
+	data, descricao, valor, credito, debito, tipo, id int
+}
+
+// parseCSV lê um extrato CSV com cabeçalho (separador ";" ou ",")
+func parseCSV(content string) (*entity.ExtratoParseado, error) {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	primeiraLinha := content
+	if i := strings.Index(content, "\n"); i >= 0 {
+		primeiraLinha = content[:i]
+	}
+
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.Comma = ','
+	if strings.Count(primeiraLinha, ";") >= strings.Count(primeiraLinha, ",") {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler CSV: %w", err)
+	}
+	if len(records) < 2 {
+		return &entity.ExtratoParseado{}, nil
+	}
+
+	layout := detectarLayout(records[0])
+	if layout.data < 0 || (layout.valor < 0 && layout.credito < 0 && layout.debito < 0) {
+		return nil, fmt.Errorf("cabeçalho do CSV deve conter as colunas data e valor (ou crédito/débito)")
+	}
+
+	extrato := &entity.ExtratoParseado{}
+	ocorrencias := make(map[string]int)
+
+	for i, rec := range records[1:] {
+		linhaNum := i + 2
+		campo := func(idx int) string {
+			if idx < 0 || idx >= len(rec) {
+				return ""
+			}
+			return strings.TrimSpace(rec[idx])
+		}
+
+		dataStr := campo(layout.data)
+		if dataStr == "" {
+			continue // linhas de saldo/rodapé
+		}
+		data, err := parseData(dataStr)
+		if err != nil {
+			// Bancos costumam incluir linhas de "SALDO" sem data válida
+			continue
+		}
+
+		valor, err := valorCSV(campo, layout)
+		if err != nil {
+			return nil, fmt.Errorf("linha %d: %w", linhaNum, err)
+		}
+		if valor.IsZero() {
+			continue
+		}
+
+		descricao := campo(layout.descricao)
+		if strings.HasPrefix(strings.ToUpper(descricao), "SALDO") {
+			continue
+		}
+
+		id := campo(layout.id)
+		if id == "" {
+			base := data.Format("2006-01-02") + "|" + descricao + "|" + valor.String()
+			ocorrencias[base]++
+			id = hashIdentificador(base, fmt.Sprint(ocorrencias[base]))
+		}
+
+		extrato.Linhas = append(extrato.Linhas, novaLinha(data, descricao, valor, "csv:"+id))
+	}
+
+	return extrato, nil
+}
+
+// valorCSV obtém o valor com sinal a partir das colunas disponíveis
+func valorCSV(campo func(int) string, layout csvLayout) (decimal.Decimal, error) {
+	if layout.valor >= 0 && campo(layout.valor) != "" {
+		valor, err := parseValor(campo(layout.valor))
+		if err != nil {
+			return decimal.Zero, err
+		}
+		// Coluna de tipo (C/D) define o sinal quando o valor vem sem sinal
+		tipo := strings.ToUpper(campo(layout.tipo))
+		if strings.HasPrefix(tipo, "D") && valor.IsPositive() {
+			valor = valor.Neg()
+		}
+		return valor, nil
+	}
+
+	if s := campo(layout.credito); s != "" {
+		v, err := parseValor(s)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		if !v.IsZero() {
+			return v.Abs(), nil
+		}
+	}
+	if s := campo(layout.debito); s != "" {
+		v, err := parseValor(s)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		return v.Abs().Neg(), nil
+	}
+	return decimal.Zero, nil
+}
+
+// detectarLayout identifica as colunas pelo cabeçalho
+func detectarLayout(header []string) csvLayout {
+	layout := csvLayout{data: -1, descricao: -1, valor: -1, credito: -1, debito: -1, tipo: -1, id: -1}
+	for i, h := range header {
+		nome := normalizarColuna(h)
+		switch {
+		case layout.data < 0 && contem(csvColunasData, nome):
+			layout.data = i
+		case layout.descricao < 0 && contem(csvColunasDescricao, nome):
+			layout.descricao = i
+		case layout.valor < 0 && contem(csvColunasValor, nome):
+			layout.valor = i
+		case layout.credito < 0 && contem(csvColunasCredito, nome):
+			layout.credito = i
+		case layout.debito < 0 && contem(csvColunasDebito, nome):
+			layout.debito = i
+		case layout.tipo < 0 && contem(csvColunasTipo, nome):
+			layout.tipo = i
+		case layout.id < 0 && contem(csvColunasID, nome):
+			layout.id = i
+		}
+	}
+	return layout
+}
+
+// normalizarColuna remove acentos e espaços extras do nome da coluna
+func normalizarColuna(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	r := strings.NewReplacer("á", "a", "à", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i", "ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c")
+	return strings.Join(strings.Fields(r.Replace(s)), " ")
+}
+
+func contem(lista []string, s string) bool {
+	for _, item := range lista {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
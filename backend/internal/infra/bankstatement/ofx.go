@@ -0,0 +1,94 @@
+package bankstatement
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+var (
+	// OFX 1.x (SGML) não fecha as tags de valor; OFX 2.x (XML) fecha.
+	// Os dois casos são tratados lendo o texto até o próximo "<" ou quebra de linha.
+	ofxTrnRe = regexp.MustCompile(`(?is)<STMTTRN>(.*?)</STMTTRN>`)
+)
+
+// ofxTag extrai o valor de uma tag OFX dentro de um bloco
+func ofxTag(block, tag string) string {
+	re := regexp.MustCompile(`(?i)<` + tag + `>([^<\r\n]*)`)
+	m := re.FindStringSubmatch(block)
+	if m == nil {
+		return ""
+	}
+	return strings.TrimSpace(m[1])
+}
+
+// parseOFX lê um extrato OFX (1.x SGML ou 2.x XML)
+func parseOFX(content string) (*entity.ExtratoParseado, error) {
+	extrato := &entity.ExtratoParseado{}
+
+	if acct := ofxTag(content, "ACCTID"); acct != "" {
+		if bank := ofxTag(content, "BANKID"); bank != "" {
+			acct = bank + "/" + acct
+		}
+		extrato.ContaBancaria = &acct
+	}
+	if s := ofxTag(content, "DTSTART"); s != "" {
+		if t, err := parseData(s); err == nil {
+			extrato.PeriodoInicio = &t
+		}
+	}
+	if s := ofxTag(content, "DTEND"); s != "" {
+		if t, err := parseData(s); err == nil {
+			extrato.PeriodoFim = &t
+		}
+	}
+
+	conta := ""
+	if extrato.ContaBancaria != nil {
+		conta = *extrato.ContaBancaria
+	}
+
+	blocks := ofxTrnRe.FindAllStringSubmatch(content, -1)
+	if len(blocks) == 0 {
+		// OFX 1.x sem fechamento de STMTTRN: separar pelos inícios de bloco
+		parts := regexp.MustCompile(`(?i)<STMTTRN>`).Split(content, -1)
+		for _, p := range parts[1:] {
+			if end := strings.Index(strings.ToUpper(p), "</BANKTRANLIST>"); end >= 0 {
+				p = p[:end]
+			}
+			blocks = append(blocks, []string{"", p})
+		}
+	}
+
+	for i, b := range blocks {
+		block := b[1]
+
+		data, err := parseData(ofxTag(block, "DTPOSTED"))
+		if err != nil {
+			return nil, fmt.Errorf("lançamento %d: %w", i+1, err)
+		}
+		valor, err := parseValor(ofxTag(block, "TRNAMT"))
+		if err != nil {
+			return nil, fmt.Errorf("lançamento %d: %w", i+1, err)
+		}
+		if valor.IsZero() {
+			continue
+		}
+
+		descricao := ofxTag(block, "MEMO")
+		if name := ofxTag(block, "NAME"); name != "" && !strings.Contains(descricao, name) {
+			descricao = strings.TrimSpace(name + " " + descricao)
+		}
+
+		fitid := ofxTag(block, "FITID")
+		if fitid == "" {
+			fitid = hashIdentificador(data.Format("2006-01-02"), descricao, valor.String(), fmt.Sprint(i))
+		}
+
+		extrato.Linhas = append(extrato.Linhas, novaLinha(data, descricao, valor, "ofx:"+conta+":"+fitid))
+	}
+
+	return extrato, nil
+}
@@ -0,0 +1,176 @@
+// Package bankstatement implementa a leitura de extratos bancários (OFX e CSV)
+package bankstatement
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/shopspring/decimal"
+)
+
+// maxArquivoBytes limita o tamanho do extrato lido em memória (10 MB)
+const maxArquivoBytes = 10 << 20
+
+// Parser implementa port.BankStatementParser para OFX e CSV
+type Parser struct{}
+
+// Compile-time check: garante que Parser implementa port.BankStatementParser
+var _ port.BankStatementParser = (*Parser)(nil)
+
+// NewParser cria um novo leitor de extratos
+func NewParser() *Parser {
+	return &Parser{}
+}
+
+// Parse lê o arquivo no formato informado
+func (p *Parser) Parse(formato entity.FormatoExtrato, r io.Reader) (*entity.ExtratoParseado, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxArquivoBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler extrato: %w", err)
+	}
+	if len(data) > maxArquivoBytes {
+		return nil, fmt.Errorf("%w: excede o tamanho máximo de %d MB", domain.ErrExtratoInvalido, maxArquivoBytes>>20)
+	}
+
+	content := toUTF8(data)
+
+	var extrato *entity.ExtratoParseado
+	switch formato {
+	case entity.FormatoExtratoOFX:
+		extrato, err = parseOFX(content)
+	case entity.FormatoExtratoCSV:
+		extrato, err = parseCSV(content)
+	default:
+		return nil, domain.ErrExtratoFormatoInvalido
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrExtratoInvalido, err)
+	}
+	if len(extrato.Linhas) == 0 {
+		return nil, domain.ErrExtratoVazio
+	}
+
+	preencherPeriodo(extrato)
+	return extrato, nil
+}
+
+// toUTF8 converte arquivos em Latin-1/Windows-1252 (comum em bancos brasileiros) para UTF-8
+func toUTF8(data []byte) string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if utf8.Valid(data) {
+		return string(data)
+	}
+	var sb strings.Builder
+	sb.Grow(len(data))
+	for _, b := range data {
+		sb.WriteRune(rune(b))
+	}
+	return sb.String()
+}
+
+// parseValor interpreta valores nos formatos "1.234,56", "1234.56", "-12,50" e "R$ 10,00"
+func parseValor(s string) (decimal.Decimal, error) {
+	s = strings.TrimSpace(s)
+	s = strings.ReplaceAll(s, "R$", "")
+	s = strings.ReplaceAll(s, " ", "")
+	negativo := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		negativo = true
+		s = strings.Trim(s, "()")
+	}
+	if strings.HasSuffix(s, "-") {
+		negativo = true
+		s = strings.TrimSuffix(s, "-")
+	}
+	if s == "" {
+		return decimal.Zero, fmt.Errorf("valor vazio")
+	}
+
+	lastComma := strings.LastIndex(s, ",")
+	lastDot := strings.LastIndex(s, ".")
+	switch {
+	case lastComma > lastDot:
+		// Formato brasileiro: ponto como milhar, vírgula como decimal
+		s = strings.ReplaceAll(s, ".", "")
+		s = strings.Replace(s, ",", ".", 1)
+	case lastDot > lastComma && lastComma >= 0:
+		// Formato americano com separador de milhar
+		s = strings.ReplaceAll(s, ",", "")
+	}
+
+	v, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("valor inválido %q", s)
+	}
+	if negativo {
+		v = v.Neg()
+	}
+	return v, nil
+}
+
+// parseData interpreta datas dd/mm/aaaa, aaaa-mm-dd e aaaammdd
+func parseData(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	layouts := []string{"02/01/2006", "2006-01-02", "20060102", "02-01-2006", "02/01/06"}
+	for _, layout := range layouts {
+		if len(s) >= len(layout) {
+			if t, err := time.ParseInLocation(layout, s[:len(layout)], time.Local); err == nil {
+				return t, nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("data inválida %q", s)
+}
+
+// novaLinha monta o lançamento a partir do valor com sinal
+func novaLinha(data time.Time, descricao string, valor decimal.Decimal, identificador string) entity.BankStatementLine {
+	tipo := entity.TipoLancamentoCredito
+	if valor.IsNegative() {
+		tipo = entity.TipoLancamentoDebito
+	}
+	return entity.BankStatementLine{
+		DataLancamento: data,
+		Descricao:      strings.TrimSpace(descricao),
+		Valor:          valor.Abs().Round(2),
+		Tipo:           tipo,
+		Identificador:  identificador,
+		Status:         entity.StatusLinhaPendente,
+	}
+}
+
+// hashIdentificador gera um identificador estável para linhas sem ID do banco
+func hashIdentificador(parts ...string) string {
+	h := sha1.Sum([]byte(strings.Join(parts, "|")))
+	return hex.EncodeToString(h[:])
+}
+
+// preencherPeriodo completa o período do extrato com as datas dos lançamentos
+func preencherPeriodo(e *entity.ExtratoParseado) {
+	if e.PeriodoInicio != nil && e.PeriodoFim != nil {
+		return
+	}
+	var inicio, fim time.Time
+	for i, l := range e.Linhas {
+		if i == 0 || l.DataLancamento.Before(inicio) {
+			inicio = l.DataLancamento
+		}
+		if i == 0 || l.DataLancamento.After(fim) {
+			fim = l.DataLancamento
+		}
+	}
+	if e.PeriodoInicio == nil {
+		e.PeriodoInicio = &inicio
+	}
+	if e.PeriodoFim == nil {
+		e.PeriodoFim = &fim
+	}
+}
@@ -0,0 +1,101 @@
+package bankstatement_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/bankstatement"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ofxSGML = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+CHARSET:1252
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<BANKACCTFROM><BANKID>0341<ACCTID>12345-6</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>20240101
+<DTEND>20240131
+<STMTTRN>
+<TRNTYPE>CREDIT
+<DTPOSTED>20240105120000[-3:BRT]
+<TRNAMT>1.234,56
+<FITID>A001
+<MEMO>REDE CREDITO
+</STMTTRN>
+<STMTTRN>
+<TRNTYPE>DEBIT
+<DTPOSTED>20240110
+<TRNAMT>-89.90
+<FITID>A002
+<NAME>ENERGIA
+</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+func TestParseOFX(t *testing.T) {
+	extrato, err := bankstatement.NewParser().Parse(entity.FormatoExtratoOFX, strings.NewReader(ofxSGML))
+	require.NoError(t, err)
+	require.Len(t, extrato.Linhas, 2)
+
+	assert.Equal(t, "0341/12345-6", *extrato.ContaBancaria)
+	assert.Equal(t, "2024-01-01", extrato.PeriodoInicio.Format("2006-01-02"))
+
+	credito := extrato.Linhas[0]
+	assert.Equal(t, entity.TipoLancamentoCredito, credito.Tipo)
+	assert.Equal(t, "1234.56", credito.Valor.StringFixed(2))
+	assert.Equal(t, "2024-01-05", credito.DataLancamento.Format("2006-01-02"))
+	assert.Equal(t, "ofx:0341/12345-6:A001", credito.Identificador)
+
+	debito := extrato.Linhas[1]
+	assert.Equal(t, entity.TipoLancamentoDebito, debito.Tipo)
+	assert.Equal(t, "89.90", debito.Valor.StringFixed(2))
+	assert.Equal(t, "ENERGIA", debito.Descricao)
+}
+
+func TestParseCSV(t *testing.T) {
+	csv := "Data;Histórico;Valor\n" +
+		"05/01/2024;PIX RECEBIDO JOAO;150,00\n" +
+		"06/01/2024;PAGTO BOLETO FORNECEDOR;-1.200,00\n" +
+		"06/01/2024;PIX RECEBIDO JOAO;150,00\n" +
+		"05/01/2024;PIX RECEBIDO JOAO;150,00\n" +
+		";SALDO DO DIA;2.000,00\n"
+
+	extrato, err := bankstatement.NewParser().Parse(entity.FormatoExtratoCSV, strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, extrato.Linhas, 4)
+
+	assert.Equal(t, entity.TipoLancamentoDebito, extrato.Linhas[1].Tipo)
+	assert.Equal(t, "1200.00", extrato.Linhas[1].Valor.StringFixed(2))
+
+	// Lançamentos idênticos no mesmo dia recebem identificadores distintos
+	assert.NotEqual(t, extrato.Linhas[0].Identificador, extrato.Linhas[3].Identificador)
+	assert.Equal(t, "2024-01-06", extrato.PeriodoFim.Format("2006-01-02"))
+}
+
+func TestParseCSVCreditoDebito(t *testing.T) {
+	csv := "date,description,credit,debit\n" +
+		"2024-02-01,CARD SETTLEMENT,\"1,050.00\",\n" +
+		"2024-02-02,BANK FEE,,12.00\n"
+
+	extrato, err := bankstatement.NewParser().Parse(entity.FormatoExtratoCSV, strings.NewReader(csv))
+	require.NoError(t, err)
+	require.Len(t, extrato.Linhas, 2)
+	assert.Equal(t, "1050.00", extrato.Linhas[0].Valor.StringFixed(2))
+	assert.Equal(t, entity.TipoLancamentoDebito, extrato.Linhas[1].Tipo)
+}
+
+func TestParseVazio(t *testing.T) {
+	_, err := bankstatement.NewParser().Parse(entity.FormatoExtratoCSV, strings.NewReader("Data;Valor\n"))
+	assert.ErrorIs(t, err, domain.ErrExtratoVazio)
+
+	_, err = bankstatement.NewParser().Parse("PDF", strings.NewReader("x"))
+	assert.ErrorIs(t, err, domain.ErrExtratoFormatoInvalido)
+}
@@ -0,0 +1,30 @@
+-- name: ListAPIKeys :many
+-- Chaves do tenant (inclusive revogadas e expiradas, para auditoria)
+SELECT * FROM api_keys
+WHERE tenant_id = $1
+ORDER BY criado_em DESC;
+
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (tenant_id, unit_id, nome, prefixo, key_hash, escopos, criado_por, expira_em)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revogada_em = NOW()
+WHERE id = $1 AND tenant_id = $2 AND revogada_em IS NULL;
+
+-- name: GetActiveAPIKeyByHash :one
+-- Chave válida: não revogada e não expirada
+SELECT * FROM api_keys
+WHERE key_hash = $1
+  AND revogada_em IS NULL
+  AND (expira_em IS NULL OR expira_em > NOW());
+
+-- name: TouchAPIKeyLastUsed :exec
+-- Atualiza o último uso no máximo uma vez por minuto por chave, para não
+-- gerar uma escrita por requisição
+UPDATE api_keys
+SET ultimo_uso_em = NOW(), ultimo_uso_ip = $2
+WHERE id = $1
+  AND (ultimo_uso_em IS NULL OR ultimo_uso_em < NOW() - INTERVAL '1 minute');
@@ -0,0 +1,69 @@
+-- name: CreateAppointmentSeries :one
+INSERT INTO appointment_series (
+    id, tenant_id, unit_id, professional_id, customer_id, service_ids,
+    start_time, interval_weeks, ends_on, notes, created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetAppointmentSeries :one
+SELECT * FROM appointment_series
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: UpdateAppointmentSeries :one
+UPDATE appointment_series
+SET professional_id = $3,
+    start_time = $4,
+    ends_on = $5,
+    notes = $6,
+    status = $7,
+    ended_reason = $8,
+    ended_at = $9,
+    generated_until = $10,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: ListAppointmentSeriesByCustomer :many
+SELECT * FROM appointment_series
+WHERE tenant_id = $1 AND customer_id = $2
+ORDER BY created_at DESC;
+
+-- name: ListAppointmentSeriesToGenerate :many
+-- Séries ativas (de todos os tenants) com ocorrências a gerar até o horizonte
+SELECT * FROM appointment_series
+WHERE status = 'ACTIVE'
+  AND (generated_until IS NULL OR generated_until < sqlc.arg(horizon)::timestamptz)
+ORDER BY generated_until NULLS FIRST
+LIMIT sqlc.arg(limite);
+
+-- name: CreateAppointmentSeriesOccurrence :execrows
+-- Registra a ocorrência; se outra geração já a registrou, não altera nada
+INSERT INTO appointment_series_occurrences (
+    series_id, occurrence_index, tenant_id, scheduled_start, appointment_id, status, detail
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (series_id, occurrence_index) DO NOTHING;
+
+-- name: UpdateAppointmentSeriesOccurrence :exec
+UPDATE appointment_series_occurrences
+SET scheduled_start = $3,
+    appointment_id = $4,
+    status = $5,
+    detail = $6,
+    updated_at = NOW()
+WHERE series_id = $1 AND occurrence_index = $2;
+
+-- name: ListAppointmentSeriesOccurrences :many
+SELECT * FROM appointment_series_occurrences
+WHERE series_id = $1 AND tenant_id = $2
+ORDER BY occurrence_index;
+
+-- name: GetAppointmentSeriesOccurrenceByAppointment :one
+SELECT * FROM appointment_series_occurrences
+WHERE appointment_id = $1 AND tenant_id = $2;
+
+-- name: GetAppointmentSeriesNextIndex :one
+SELECT COALESCE(MAX(occurrence_index) + 1, 0)::int AS next_index
+FROM appointment_series_occurrences
+WHERE series_id = $1;
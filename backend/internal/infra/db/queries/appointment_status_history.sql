@@ -0,0 +1,84 @@
+-- name: CreateAppointmentStatusHistory :one
+INSERT INTO appointment_status_history (
+    tenant_id, appointment_id, from_status, to_status, actor_id, reason, changed_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id;
+
+-- name: ListAppointmentStatusHistory :many
+-- Mudanças de status do agendamento, da mais antiga para a mais recente
+SELECT
+    h.id,
+    h.tenant_id,
+    h.appointment_id,
+    h.from_status,
+    h.to_status,
+    h.actor_id,
+    h.reason,
+    h.changed_at,
+    COALESCE(u.nome, '')::text AS actor_name
+FROM appointment_status_history h
+LEFT JOIN users u ON u.id = h.actor_id
+WHERE h.tenant_id = $1 AND h.appointment_id = $2
+ORDER BY h.changed_at, h.id;
+
+-- name: AppointmentTimingStats :many
+-- Somas (em segundos) e contagens dos tempos da operação por unidade e
+-- profissional, para agendamentos com início no período. O agendamento conta
+-- para o responsável e para cada profissional dos seus serviços; responsible
+-- marca a linha do responsável, a única somada nos totais. Check-in, início e
+-- fim são registrados por agendamento: espera, duração e pontualidade ficam só
+-- na linha do responsável. Os demais contam o agendamento e o cancelamento,
+-- medido a partir do horário do seu primeiro serviço. As médias são
+-- calculadas na aplicação para permitir somar grupos.
+-- Cancelamento sem histórico (anterior à migration 082) usa updated_at.
+SELECT
+    COALESCE(a.unit_id::text, '')::text AS unit_id,
+    COALESCE(un.nome, '')::text AS unit_name,
+    ap.professional_id,
+    COALESCE(p.nome, '')::text AS professional_name,
+    ap.responsible::bool AS responsible,
+    COUNT(*)::bigint AS appointments,
+    COUNT(*) FILTER (WHERE ap.responsible AND a.started_at >= a.checked_in_at)::bigint AS wait_count,
+    COALESCE(SUM(EXTRACT(EPOCH FROM (a.started_at - a.checked_in_at))) FILTER (WHERE ap.responsible AND a.started_at >= a.checked_in_at), 0)::float8 AS wait_seconds,
+    COUNT(*) FILTER (WHERE ap.responsible AND a.finished_at >= a.started_at AND sd.minutes IS NOT NULL)::bigint AS service_count,
+    COALESCE(SUM(EXTRACT(EPOCH FROM (a.finished_at - a.started_at))) FILTER (WHERE ap.responsible AND a.finished_at >= a.started_at AND sd.minutes IS NOT NULL), 0)::float8 AS service_seconds,
+    COALESCE(SUM(sd.minutes * 60) FILTER (WHERE ap.responsible AND a.finished_at >= a.started_at), 0)::float8 AS expected_seconds,
+    COUNT(*) FILTER (WHERE ap.responsible AND a.finished_at - a.started_at > make_interval(mins => sd.minutes))::bigint AS overrun_count,
+    COUNT(a.checked_in_at) FILTER (WHERE ap.responsible)::bigint AS check_in_count,
+    COUNT(*) FILTER (WHERE ap.responsible AND a.checked_in_at > a.start_time + make_interval(secs => sqlc.arg(tolerance_seconds)::float8))::bigint AS late_arrivals,
+    COALESCE(SUM(GREATEST(EXTRACT(EPOCH FROM (a.checked_in_at - a.start_time)), 0)) FILTER (WHERE ap.responsible AND a.checked_in_at IS NOT NULL), 0)::float8 AS arrival_delay_seconds,
+    COUNT(a.started_at) FILTER (WHERE ap.responsible)::bigint AS start_count,
+    COUNT(*) FILTER (WHERE ap.responsible AND a.started_at > a.start_time + make_interval(secs => sqlc.arg(tolerance_seconds)::float8))::bigint AS late_starts,
+    COALESCE(SUM(GREATEST(EXTRACT(EPOCH FROM (a.started_at - a.start_time)), 0)) FILTER (WHERE ap.responsible AND a.started_at IS NOT NULL), 0)::float8 AS start_delay_seconds,
+    COUNT(*) FILTER (WHERE a.status = 'CANCELED')::bigint AS cancel_count,
+    COALESCE(SUM(GREATEST(EXTRACT(EPOCH FROM (ap.start_time - c.canceled_at)), 0)) FILTER (WHERE a.status = 'CANCELED'), 0)::float8 AS cancel_lead_seconds,
+    COUNT(*) FILTER (WHERE a.status = 'CANCELED' AND c.canceled_at > ap.start_time - make_interval(secs => sqlc.arg(late_cancel_seconds)::float8))::bigint AS late_cancellations
+FROM appointments a
+CROSS JOIN LATERAL (
+    SELECT a.professional_id, a.start_time, TRUE AS responsible
+    UNION ALL
+    SELECT aps.professional_id, MIN(aps.start_time), FALSE
+    FROM appointment_services aps
+    WHERE aps.appointment_id = a.id AND aps.professional_id <> a.professional_id
+    GROUP BY aps.professional_id
+) ap
+LEFT JOIN units un ON un.id = a.unit_id
+LEFT JOIN profissionais p ON p.id = ap.professional_id
+LEFT JOIN LATERAL (
+    SELECT SUM(s.duracao)::int AS minutes
+    FROM appointment_services aps
+    INNER JOIN servicos s ON s.id = aps.service_id
+    WHERE aps.appointment_id = a.id
+) sd ON TRUE
+LEFT JOIN LATERAL (
+    SELECT COALESCE(MAX(h.changed_at), a.updated_at) AS canceled_at
+    FROM appointment_status_history h
+    WHERE h.appointment_id = a.id AND h.to_status = 'CANCELED'
+) c ON TRUE
+WHERE a.tenant_id = sqlc.arg(tenant_id)
+  AND a.start_time >= sqlc.arg(start_from)
+  AND a.start_time < sqlc.arg(start_to)
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
+  AND (sqlc.narg(professional_id)::uuid IS NULL OR ap.professional_id = sqlc.narg(professional_id))
+GROUP BY 1, 2, 3, 4, 5
+ORDER BY unit_name, professional_name;
@@ -27,9 +27,14 @@ INSERT INTO appointment_services (
     appointment_id,
     service_id,
     price_at_booking,
-    duration_at_booking
+    duration_at_booking,
+    professional_id,
+    sequence,
+    start_time,
+    end_time,
+    active
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 );
 
 -- name: GetAppointmentByID :one
@@ -47,10 +52,13 @@ WHERE a.id = $1 AND a.tenant_id = $2
 -- name: GetAppointmentServices :many
 SELECT 
     aps.*,
-    s.nome as service_name
+    s.nome as service_name,
+    p.nome as professional_name
 FROM appointment_services aps
 JOIN servicos s ON s.id = aps.service_id
-WHERE aps.appointment_id = $1;
+JOIN profissionais p ON p.id = aps.professional_id
+WHERE aps.appointment_id = $1
+ORDER BY aps.sequence;
 
 -- name: UpdateAppointment :one
 UPDATE appointments
@@ -147,7 +155,10 @@ JOIN profissionais p ON p.id = a.professional_id
 JOIN clientes c ON c.id = a.customer_id
 WHERE a.tenant_id = $1
   AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
-  AND ($2::uuid IS NULL OR a.professional_id = $2)
+  AND ($2::uuid IS NULL OR a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND ($3::uuid IS NULL OR a.customer_id = $3)
   AND (COALESCE(array_length($4::text[], 1), 0) = 0 OR a.status = ANY($4::text[]))
   AND ($5::timestamptz IS NULL OR a.start_time >= $5)
@@ -160,7 +171,10 @@ SELECT COUNT(*)
 FROM appointments a
 WHERE a.tenant_id = $1
   AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
-  AND ($2::uuid IS NULL OR a.professional_id = $2)
+  AND ($2::uuid IS NULL OR a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND ($3::uuid IS NULL OR a.customer_id = $3)
   AND (COALESCE(array_length($4::text[], 1), 0) = 0 OR a.status = ANY($4::text[]))
   AND ($5::timestamptz IS NULL OR a.start_time >= $5)
@@ -177,7 +191,10 @@ JOIN profissionais p ON p.id = a.professional_id
 JOIN clientes c ON c.id = a.customer_id
 WHERE a.tenant_id = $1
   AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
-  AND a.professional_id = $2
+  AND (a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND a.start_time >= $3
   AND a.start_time < $4
   AND a.status NOT IN ('CANCELED')
@@ -199,25 +216,28 @@ ORDER BY a.start_time DESC
 LIMIT 50;
 
 -- name: CheckAppointmentConflict :one
--- Verifica conflito com agendamentos existentes
--- Parâmetros: tenant_id, professional_id, exclude_id, start_time, end_time
+-- Verifica conflito com agendamentos existentes: o profissional só fica
+-- ocupado no horário dos serviços que executa
 SELECT EXISTS (
-    SELECT 1 FROM appointments
-    WHERE tenant_id = $1
-      AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id))
-      AND professional_id = $2
-      AND id != $3
-      AND status NOT IN ('CANCELED', 'NO_SHOW')
-      AND start_time < $5
-      AND end_time > $4
+    SELECT 1 FROM appointment_services aps
+    JOIN appointments a ON a.id = aps.appointment_id
+    WHERE a.tenant_id = sqlc.arg(tenant_id)::uuid
+      AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
+      AND aps.professional_id = sqlc.arg(professional_id)::uuid
+      AND aps.appointment_id IS DISTINCT FROM sqlc.narg(exclude_id)::uuid
+      AND aps.active
+      AND aps.start_time < sqlc.arg(end_time)::timestamptz
+      AND aps.end_time > sqlc.arg(start_time)::timestamptz
 ) as has_conflict;
 
 -- name: CheckBlockedTimeConflictForAppointment :one
--- Verifica se há conflito com horários bloqueados (blocked_times)
+-- Verifica se há conflito com horários bloqueados (blocked_times).
+-- Bloqueio sem unidade (criado pela agenda ou importado do calendário externo)
+-- vale para todas as unidades do profissional.
 SELECT EXISTS (
     SELECT 1 FROM blocked_times
     WHERE tenant_id = sqlc.arg(tenant_id)::uuid
-      AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id))
+      AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id IS NULL OR unit_id = sqlc.narg(unit_id))
       AND professional_id = sqlc.arg(professional_id)::uuid
       AND start_time < sqlc.arg(end_time)::timestamptz
       AND end_time > sqlc.arg(start_time)::timestamptz
@@ -228,18 +248,19 @@ SELECT EXISTS (
 -- Um agendamento que termina exatamente quando outro começa não é conflito,
 -- mas se o intervalo for menor que 10 minutos, é conflito.
 SELECT EXISTS (
-    SELECT 1 FROM appointments
-    WHERE tenant_id = sqlc.arg(tenant_id)::uuid
-      AND (sqlc.narg(unit_id)::uuid IS NULL OR unit_id = sqlc.narg(unit_id))
-      AND professional_id = sqlc.arg(professional_id)::uuid
-      AND id != sqlc.arg(exclude_id)::uuid
-      AND status NOT IN ('CANCELED', 'NO_SHOW')
+    SELECT 1 FROM appointment_services aps
+    JOIN appointments a ON a.id = aps.appointment_id
+    WHERE a.tenant_id = sqlc.arg(tenant_id)::uuid
+      AND (sqlc.narg(unit_id)::uuid IS NULL OR a.unit_id = sqlc.narg(unit_id))
+      AND aps.professional_id = sqlc.arg(professional_id)::uuid
+      AND aps.appointment_id IS DISTINCT FROM sqlc.narg(exclude_id)::uuid
+      AND aps.active
       AND (
-          -- Agendamento existente termina menos de X minutos antes do novo início
-          (end_time > sqlc.arg(start_time)::timestamptz - (sqlc.arg(interval_minutes)::int * interval '1 minute') AND end_time <= sqlc.arg(start_time)::timestamptz)
+          -- Serviço existente termina menos de X minutos antes do novo início
+          (aps.end_time > sqlc.arg(start_time)::timestamptz - (sqlc.arg(interval_minutes)::int * interval '1 minute') AND aps.end_time <= sqlc.arg(start_time)::timestamptz)
           OR
-          -- Novo agendamento termina menos de X minutos antes do início existente
-          (sqlc.arg(end_time)::timestamptz > start_time - (sqlc.arg(interval_minutes)::int * interval '1 minute') AND sqlc.arg(end_time)::timestamptz <= start_time)
+          -- Novo trecho termina menos de X minutos antes do início existente
+          (sqlc.arg(end_time)::timestamptz > aps.start_time - (sqlc.arg(interval_minutes)::int * interval '1 minute') AND sqlc.arg(end_time)::timestamptz <= aps.start_time)
       )
 ) as has_interval_conflict;
 
@@ -320,8 +341,14 @@ SELECT
     aps.price_at_booking,
     aps.duration_at_booking,
     aps.created_at,
-    s.nome as service_name
+    aps.professional_id,
+    aps.sequence,
+    aps.start_time,
+    aps.end_time,
+    s.nome as service_name,
+    p.nome as professional_name
 FROM appointment_services aps
 JOIN servicos s ON s.id = aps.service_id
+JOIN profissionais p ON p.id = aps.professional_id
 WHERE aps.appointment_id = ANY($1::uuid[])
-ORDER BY aps.appointment_id, s.nome;
+ORDER BY aps.appointment_id, aps.sequence;
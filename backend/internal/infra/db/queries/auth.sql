@@ -20,20 +20,241 @@ UPDATE users
 SET ultimo_login = NOW(), atualizado_em = NOW()
 WHERE id = $1;
 
+-- ============================================================================
+-- REFRESH TOKENS (armazenados como hash SHA-256)
+-- ============================================================================
+
 -- name: SaveRefreshToken :exec
-INSERT INTO refresh_tokens (user_id, token, expires_at)
-VALUES ($1, $2, $3);
+INSERT INTO refresh_tokens (user_id, session_id, token_hash, expires_at)
+VALUES ($1, $2, $3, $4);
 
--- name: GetRefreshToken :one
-SELECT id, user_id, token, expires_at, created_at
-FROM refresh_tokens
-WHERE token = $1 AND expires_at > NOW()
+-- name: GetRefreshTokenByHash :one
+-- Retorna também tokens usados/expirados: o use case decide (reuso x expiração)
+SELECT
+    rt.id,
+    rt.user_id,
+    rt.session_id,
+    rt.expires_at,
+    rt.used_at,
+    s.revoked_at AS session_revoked_at,
+    s.unit_id AS session_unit_id
+FROM refresh_tokens rt
+JOIN auth_sessions s ON s.id = rt.session_id
+WHERE rt.token_hash = $1
 LIMIT 1;
 
--- name: DeleteRefreshToken :exec
-DELETE FROM refresh_tokens
-WHERE token = $1;
+-- name: MarkRefreshTokenUsed :execrows
+UPDATE refresh_tokens
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL;
 
 -- name: DeleteExpiredRefreshTokens :exec
 DELETE FROM refresh_tokens
 WHERE expires_at < NOW();
+
+-- ============================================================================
+-- SESSÕES
+-- ============================================================================
+
+-- name: CreateAuthSession :one
+INSERT INTO auth_sessions (user_id, tenant_id, user_agent, ip_address, expires_at, unit_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetAuthSession :one
+SELECT * FROM auth_sessions
+WHERE id = $1 AND tenant_id = $2
+LIMIT 1;
+
+-- name: TouchAuthSession :exec
+UPDATE auth_sessions
+SET last_used_at = NOW(), expires_at = $2, ip_address = $3
+WHERE id = $1;
+
+-- name: SetAuthSessionUnit :exec
+-- Unidade ativa da sessão (login, troca de unidade e refresh)
+UPDATE auth_sessions
+SET unit_id = $2
+WHERE id = $1;
+
+-- name: ListActiveAuthSessions :many
+-- Sessões ativas do tenant; user_id NULL lista todos os usuários
+SELECT
+    s.id,
+    s.user_id,
+    s.user_agent,
+    s.ip_address,
+    s.created_at,
+    s.last_used_at,
+    s.expires_at,
+    u.nome AS user_nome,
+    u.email AS user_email
+FROM auth_sessions s
+JOIN users u ON u.id = s.user_id
+WHERE s.tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(user_id)::uuid IS NULL OR s.user_id = sqlc.narg(user_id))
+  AND s.revoked_at IS NULL
+  AND s.expires_at > NOW()
+ORDER BY s.last_used_at DESC;
+
+-- name: RevokeAuthSession :execrows
+UPDATE auth_sessions
+SET revoked_at = NOW(), revoked_reason = $2
+WHERE id = $1 AND revoked_at IS NULL;
+
+-- name: RevokeOtherAuthSessions :execrows
+UPDATE auth_sessions
+SET revoked_at = NOW(), revoked_reason = 'REVOGADA'
+WHERE user_id = $1 AND id IS DISTINCT FROM $2 AND revoked_at IS NULL;
+
+-- name: ResolveUserUnit :one
+-- Unidade e papel efetivo do usuário nela. unit_id NULL escolhe a unidade padrão
+-- (ou a primeira ativa). O papel personalizado (custom_role_id) e depois o papel
+-- da unidade (role_override) prevalecem sobre users.role, exceto para o dono,
+-- que mantém acesso total em todas as unidades.
+SELECT
+    uu.unit_id,
+    (CASE
+        WHEN UPPER(u.role) = 'OWNER' THEN u.role
+        WHEN tr.id IS NOT NULL THEN tr.base_role
+        ELSE COALESCE(NULLIF(uu.role_override, ''), u.role)
+    END)::text AS role,
+    (CASE WHEN UPPER(u.role) = 'OWNER' THEN NULL ELSE tr.id END)::uuid AS custom_role_id,
+    tr.permissoes AS custom_permissoes
+FROM user_units uu
+JOIN units un ON un.id = uu.unit_id
+JOIN users u ON u.id = uu.user_id
+LEFT JOIN tenant_roles tr ON tr.id = uu.custom_role_id AND tr.tenant_id = u.tenant_id
+WHERE uu.user_id = sqlc.arg(user_id)
+  AND un.tenant_id = u.tenant_id
+  AND un.ativa = true
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR uu.unit_id = sqlc.narg(unit_id))
+ORDER BY uu.is_default DESC, un.is_matriz DESC, un.nome
+LIMIT 1;
+
+-- name: ListUserUnitRoles :many
+-- Papel efetivo do usuário em cada unidade ativa (mesma regra de ResolveUserUnit)
+SELECT
+    (CASE
+        WHEN UPPER(u.role) = 'OWNER' THEN u.role
+        WHEN tr.id IS NOT NULL THEN tr.base_role
+        ELSE COALESCE(NULLIF(uu.role_override, ''), u.role)
+    END)::text AS role
+FROM user_units uu
+JOIN units un ON un.id = uu.unit_id
+JOIN users u ON u.id = uu.user_id
+LEFT JOIN tenant_roles tr ON tr.id = uu.custom_role_id AND tr.tenant_id = u.tenant_id
+WHERE uu.user_id = sqlc.arg(user_id)
+  AND un.tenant_id = u.tenant_id
+  AND un.ativa = true;
+
+-- ============================================================================
+-- BLOQUEIO DE TENTATIVAS DE LOGIN
+-- ============================================================================
+
+-- name: GetLoginThrottle :one
+SELECT * FROM auth_login_throttle
+WHERE chave = $1;
+
+-- name: RegistrarFalhaLogin :one
+-- Falhas anteriores a janela_inicio não contam: o contador recomeça
+INSERT INTO auth_login_throttle (chave, falhas, ultima_falha)
+VALUES (sqlc.arg(chave), 1, NOW())
+ON CONFLICT (chave) DO UPDATE
+SET falhas = CASE
+        WHEN auth_login_throttle.ultima_falha < sqlc.arg(janela_inicio) THEN 1
+        ELSE auth_login_throttle.falhas + 1
+    END,
+    ultima_falha = NOW()
+RETURNING falhas;
+
+-- name: DefinirBloqueioLogin :exec
+UPDATE auth_login_throttle
+SET bloqueado_ate = $2
+WHERE chave = $1;
+
+-- name: LimparLoginThrottle :exec
+DELETE FROM auth_login_throttle
+WHERE chave = $1;
+
+-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, atualizado_em = NOW()
+WHERE id = $1;
+
+-- ============================================================================
+-- RECUPERAÇÃO DE SENHA (tokens armazenados como hash SHA-256)
+-- ============================================================================
+
+-- name: CountRecentPasswordResets :one
+SELECT COUNT(*) FROM password_reset_tokens
+WHERE user_id = $1 AND created_at >= sqlc.arg(desde);
+
+-- name: InvalidatePasswordResetTokens :exec
+-- Um novo pedido (ou a troca de senha) invalida os links anteriores
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (user_id, token_hash, ip_address, expires_at)
+VALUES ($1, $2, $3, $4);
+
+-- name: ConsumePasswordResetToken :one
+-- Marca o token como usado e retorna o usuário; sem linha = inválido, usado ou expirado
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING user_id;
+
+-- ============================================================================
+-- CONVITES DE COLABORADORES
+-- ============================================================================
+
+-- name: RevokePendingInvitationsByEmail :exec
+UPDATE user_invitations
+SET revoked_at = NOW()
+WHERE tenant_id = $1 AND LOWER(email) = LOWER(sqlc.arg(email))
+  AND accepted_at IS NULL AND revoked_at IS NULL;
+
+-- name: CreateUserInvitation :one
+INSERT INTO user_invitations (tenant_id, unit_id, email, nome, role, token_hash, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: ListUserInvitations :many
+SELECT * FROM user_invitations
+WHERE tenant_id = $1
+ORDER BY created_at DESC
+LIMIT 200;
+
+-- name: RevokeUserInvitation :execrows
+UPDATE user_invitations
+SET revoked_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL;
+
+-- name: GetPendingInvitationByHash :one
+-- Convite ainda aceitável, com os nomes do tenant e da unidade para exibição
+SELECT
+    i.id,
+    i.tenant_id,
+    i.unit_id,
+    i.email,
+    i.nome,
+    i.role,
+    i.expires_at,
+    t.nome AS tenant_nome,
+    un.nome AS unit_nome
+FROM user_invitations i
+JOIN tenants t ON t.id = i.tenant_id
+JOIN units un ON un.id = i.unit_id
+WHERE i.token_hash = $1
+  AND i.accepted_at IS NULL
+  AND i.revoked_at IS NULL
+  AND i.expires_at > NOW()
+LIMIT 1;
+
+-- name: AcceptUserInvitation :execrows
+UPDATE user_invitations
+SET accepted_at = NOW(), accepted_user_id = $2
+WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW();
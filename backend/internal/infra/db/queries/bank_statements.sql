@@ -0,0 +1,102 @@
+-- ============================================================================
+-- BANK_STATEMENTS QUERIES (sqlc)
+-- Importação de extratos bancários (OFX/CSV) e conciliação
+-- ============================================================================
+
+-- name: CreateBankStatementImport :one
+INSERT INTO bank_statement_imports (
+    id,
+    tenant_id,
+    arquivo_nome,
+    formato,
+    conta_bancaria,
+    periodo_inicio,
+    periodo_fim,
+    importado_por
+) VALUES (
+    sqlc.arg(id), sqlc.arg(tenant_id), sqlc.arg(arquivo_nome), sqlc.arg(formato),
+    sqlc.narg(conta_bancaria), sqlc.narg(periodo_inicio), sqlc.narg(periodo_fim), sqlc.narg(importado_por)
+) RETURNING *;
+
+-- name: GetBankStatementImportByID :one
+SELECT * FROM bank_statement_imports
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: ListBankStatementImports :many
+SELECT * FROM bank_statement_imports
+WHERE tenant_id = sqlc.arg(tenant_id)
+ORDER BY created_at DESC
+LIMIT sqlc.arg(limit_count) OFFSET sqlc.arg(offset_count);
+
+-- name: UpdateBankStatementImportTotais :exec
+-- Recalcula os totais do import a partir das linhas
+UPDATE bank_statement_imports i
+SET
+    total_linhas = t.total,
+    total_conciliadas = t.conciliadas,
+    total_pendentes = t.pendentes
+FROM (
+    SELECT
+        COUNT(*)::int AS total,
+        COUNT(*) FILTER (WHERE status = 'CONCILIADA')::int AS conciliadas,
+        COUNT(*) FILTER (WHERE status IN ('PENDENTE', 'DIVERGENTE'))::int AS pendentes
+    FROM bank_statement_lines
+    WHERE import_id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+) t
+WHERE i.id = sqlc.arg(id) AND i.tenant_id = sqlc.arg(tenant_id);
+
+-- name: CreateBankStatementLine :one
+-- Ignora lançamentos já importados (mesmo identificador)
+INSERT INTO bank_statement_lines (
+    id,
+    tenant_id,
+    import_id,
+    data_lancamento,
+    descricao,
+    valor,
+    tipo,
+    identificador
+) VALUES (
+    sqlc.arg(id), sqlc.arg(tenant_id), sqlc.arg(import_id), sqlc.arg(data_lancamento),
+    sqlc.arg(descricao), sqlc.arg(valor), sqlc.arg(tipo), sqlc.arg(identificador)
+)
+ON CONFLICT (tenant_id, identificador) DO NOTHING
+RETURNING *;
+
+-- name: GetBankStatementLineByID :one
+SELECT * FROM bank_statement_lines
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: ListBankStatementLinesByImport :many
+SELECT * FROM bank_statement_lines
+WHERE import_id = sqlc.arg(import_id) AND tenant_id = sqlc.arg(tenant_id)
+ORDER BY data_lancamento ASC, created_at ASC;
+
+-- name: ListBankStatementLinesRevisao :many
+-- Fila de revisão: lançamentos não conciliados ou com divergência de valor
+SELECT * FROM bank_statement_lines
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND status IN ('PENDENTE', 'DIVERGENTE')
+ORDER BY data_lancamento ASC, created_at ASC;
+
+-- name: UpdateBankStatementLineConciliacao :exec
+UPDATE bank_statement_lines
+SET
+    status = sqlc.arg(status),
+    match_tipo = sqlc.narg(match_tipo),
+    match_id = sqlc.narg(match_id),
+    diferenca = sqlc.narg(diferenca),
+    observacao = sqlc.narg(observacao),
+    conciliado_em = sqlc.narg(conciliado_em),
+    conciliado_por = sqlc.narg(conciliado_por),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: ExistsBankStatementMatch :one
+-- Verifica se um lançamento interno já foi conciliado com alguma linha de extrato
+SELECT EXISTS (
+    SELECT 1 FROM bank_statement_lines
+    WHERE tenant_id = sqlc.arg(tenant_id)
+      AND match_id = sqlc.arg(match_id)
+      AND status IN ('CONCILIADA', 'DIVERGENTE')
+) AS exists_match;
@@ -97,6 +97,31 @@ ORDER BY
     btl.last_turn_at ASC NULLS FIRST,
     btl.created_at ASC;
 
+-- name: ListActiveBarbersTurnListByUnit :many
+-- Lista os barbeiros ativos na fila que atendem na unidade
+SELECT 
+    btl.*,
+    p.nome as professional_name,
+    p.tipo as professional_type,
+    p.status as professional_status,
+    p.foto as professional_photo,
+    ROW_NUMBER() OVER (
+        ORDER BY 
+            btl.current_points ASC,
+            btl.last_turn_at ASC NULLS FIRST,
+            btl.created_at ASC
+    ) as position
+FROM barbers_turn_list btl
+JOIN profissionais p ON p.id = btl.professional_id
+WHERE btl.tenant_id = $1
+  AND p.unit_id = $2
+  AND btl.is_active = true
+  AND p.status = 'ATIVO'
+ORDER BY 
+    btl.current_points ASC,
+    btl.last_turn_at ASC NULLS FIRST,
+    btl.created_at ASC;
+
 -- name: GetNextBarber :one
 -- Retorna o próximo barbeiro da vez (topo da fila ativa)
 SELECT 
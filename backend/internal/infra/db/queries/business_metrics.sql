@@ -0,0 +1,68 @@
+-- name: MetricsComandasFechadasHoje :many
+-- Comandas fechadas hoje (fuso America/Sao_Paulo); unidade vem do agendamento
+SELECT
+    c.tenant_id,
+    COALESCE(a.unit_id::text, '')::text AS unit_id,
+    COUNT(*)::bigint AS total
+FROM commands c
+LEFT JOIN appointments a ON a.id = c.appointment_id
+WHERE c.status = 'CLOSED'
+  AND c.fechado_em >= date_trunc('day', NOW() AT TIME ZONE 'America/Sao_Paulo') AT TIME ZONE 'America/Sao_Paulo'
+GROUP BY 1, 2;
+
+-- name: MetricsReceitaHojePorFormaPagamento :many
+-- Receita de hoje por tipo de meio de pagamento (conjunto fixo: PIX, DINHEIRO...)
+SELECT
+    c.tenant_id,
+    COALESCE(a.unit_id::text, '')::text AS unit_id,
+    COALESCE(mp.tipo, 'OUTRO')::text AS forma_pagamento,
+    COALESCE(SUM(cp.valor_recebido), 0)::float8 AS total
+FROM command_payments cp
+INNER JOIN commands c ON c.id = cp.command_id
+LEFT JOIN appointments a ON a.id = c.appointment_id
+LEFT JOIN meios_pagamento mp ON mp.id = cp.meio_pagamento_id
+WHERE c.status = 'CLOSED'
+  AND c.fechado_em >= date_trunc('day', NOW() AT TIME ZONE 'America/Sao_Paulo') AT TIME ZONE 'America/Sao_Paulo'
+GROUP BY 1, 2, 3;
+
+-- name: MetricsCaixasAbertos :many
+-- Há quanto tempo cada caixa aberto está aberto
+SELECT
+    tenant_id,
+    EXTRACT(EPOCH FROM (NOW() - data_abertura))::float8 AS aberto_segundos
+FROM caixa_diario
+WHERE status = 'ABERTO';
+
+-- name: MetricsDivergenciaCaixaHoje :many
+-- Fechamentos de hoje com divergência e a soma absoluta das diferenças
+SELECT
+    tenant_id,
+    COUNT(*)::bigint AS fechamentos,
+    COALESCE(SUM(ABS(divergencia)), 0)::float8 AS divergencia_total
+FROM caixa_diario
+WHERE data_fechamento >= date_trunc('day', NOW() AT TIME ZONE 'America/Sao_Paulo') AT TIME ZONE 'America/Sao_Paulo'
+  AND divergencia IS NOT NULL
+  AND divergencia <> 0
+GROUP BY tenant_id;
+
+-- name: MetricsNoShowUltimos7Dias :many
+-- Agendamentos concluídos e faltas nos últimos 7 dias
+SELECT
+    tenant_id,
+    COALESCE(unit_id::text, '')::text AS unit_id,
+    COUNT(*) FILTER (WHERE status = 'NO_SHOW')::bigint AS no_show,
+    COUNT(*)::bigint AS total
+FROM appointments
+WHERE start_time >= NOW() - INTERVAL '7 days'
+  AND start_time < NOW()
+  AND status IN ('DONE', 'NO_SHOW')
+GROUP BY 1, 2;
+
+-- name: MetricsAssinaturasInadimplentes :many
+-- Assinaturas de clientes inadimplentes
+SELECT
+    tenant_id,
+    COUNT(*)::bigint AS total
+FROM subscriptions
+WHERE status = 'INADIMPLENTE'
+GROUP BY tenant_id;
@@ -17,9 +17,10 @@ INSERT INTO caixa_diario (
     total_sangrias,
     total_reforcos,
     saldo_esperado,
-    status
+    status,
+    fechamento_cego
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
 ) RETURNING *;
 
 -- ========== READ ==========
@@ -81,12 +82,39 @@ SET
     data_fechamento = $4,
     saldo_real = $5,
     divergencia = $6,
-    status = 'FECHADO',
-    justificativa_divergencia = $7,
+    status = $7,
+    justificativa_divergencia = $8,
+    contagem = $9,
+    usuario_aprovacao_id = $10,
+    data_aprovacao = $11,
     updated_at = NOW()
 WHERE id = $1 AND tenant_id = $2 AND status = 'ABERTO'
 RETURNING *;
 
+-- name: AprovarCaixaDiario :one
+UPDATE caixa_diario
+SET
+    status = 'FECHADO',
+    usuario_aprovacao_id = $3,
+    data_aprovacao = $4,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND status = 'AGUARDANDO_APROVACAO'
+RETURNING *;
+
+-- name: ReabrirCaixaDiario :one
+UPDATE caixa_diario
+SET
+    status = 'ABERTO',
+    usuario_fechamento_id = NULL,
+    data_fechamento = NULL,
+    saldo_real = NULL,
+    divergencia = NULL,
+    justificativa_divergencia = NULL,
+    contagem = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND status = 'AGUARDANDO_APROVACAO'
+RETURNING *;
+
 -- ========== LIST ==========
 
 -- name: ListCaixaDiarioHistorico :many
@@ -105,6 +133,17 @@ WHERE c.tenant_id = $1
 ORDER BY c.data_abertura DESC
 LIMIT $5 OFFSET $6;
 
+-- name: ListCaixaDiarioAguardandoAprovacao :many
+SELECT 
+    c.*,
+    ua.nome as usuario_abertura_nome,
+    COALESCE(uf.nome, '') as usuario_fechamento_nome
+FROM caixa_diario c
+LEFT JOIN users ua ON ua.id = c.usuario_abertura_id
+LEFT JOIN users uf ON uf.id = c.usuario_fechamento_id
+WHERE c.tenant_id = $1 AND c.status = 'AGUARDANDO_APROVACAO'
+ORDER BY c.data_fechamento ASC;
+
 -- name: CountCaixaDiarioHistorico :one
 SELECT COUNT(*) 
 FROM caixa_diario
@@ -128,9 +167,10 @@ INSERT INTO operacoes_caixa (
     descricao,
     destino,
     origem,
-    usuario_id
+    usuario_id,
+    forma_pagamento
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING *;
 
 -- name: ListOperacoesByCaixa :many
@@ -151,6 +191,15 @@ LEFT JOIN users u ON u.id = o.usuario_id
 WHERE o.caixa_id = $1 AND o.tenant_id = $2 AND o.tipo = $3
 ORDER BY o.created_at ASC;
 
+-- name: ListOperacoesByPeriodo :many
+SELECT 
+    o.*,
+    u.nome as usuario_nome
+FROM operacoes_caixa o
+LEFT JOIN users u ON u.id = o.usuario_id
+WHERE o.tenant_id = $1 AND o.created_at >= $2 AND o.created_at < $3
+ORDER BY o.created_at ASC;
+
 -- name: SumOperacoesByTipo :many
 SELECT 
     tipo,
@@ -0,0 +1,54 @@
+-- ============================================================================
+-- FEEDS ICALENDAR
+-- ============================================================================
+
+-- name: CreateCalendarFeed :one
+INSERT INTO calendar_feeds (id, tenant_id, unit_id, professional_id, token_hash, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetCalendarFeedByTokenHash :one
+-- Feed ativo do link (revogados não são encontrados)
+SELECT * FROM calendar_feeds
+WHERE token_hash = $1 AND revoked_at IS NULL;
+
+-- name: ListCalendarFeeds :many
+-- Feeds ativos da unidade, com o nome do profissional
+SELECT f.id, f.tenant_id, f.unit_id, f.professional_id, f.token_hash, f.created_by,
+       f.last_accessed_at, f.revoked_at, f.created_at,
+       COALESCE(p.nome, '')::varchar AS professional_name
+FROM calendar_feeds f
+LEFT JOIN profissionais p ON p.id = f.professional_id
+WHERE f.tenant_id = $1
+  AND f.unit_id = $2
+  AND f.revoked_at IS NULL
+ORDER BY f.created_at DESC;
+
+-- name: RevokeCalendarFeed :execrows
+-- professional_id restringe aos feeds do próprio barbeiro
+UPDATE calendar_feeds
+SET revoked_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(professional_id)::uuid IS NULL OR professional_id = sqlc.narg(professional_id))
+  AND revoked_at IS NULL;
+
+-- name: TouchCalendarFeed :exec
+-- Registra o último acesso do aplicativo de calendário
+UPDATE calendar_feeds
+SET last_accessed_at = NOW()
+WHERE id = $1;
+
+-- name: ListCalendarFeedBlockedTimes :many
+-- Bloqueios do profissional (ou dos profissionais da unidade) que ainda
+-- aparecem no feed: recorrentes sempre, avulsos a partir de since
+SELECT b.id, b.tenant_id, b.professional_id, b.start_time, b.end_time, b.reason,
+       b.is_recurring, b.recurrence_rule, b.created_at, b.updated_at, b.created_by,
+       p.nome AS professional_name
+FROM blocked_times b
+JOIN profissionais p ON p.id = b.professional_id
+WHERE b.tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(professional_id)::uuid IS NULL OR b.professional_id = sqlc.narg(professional_id))
+  AND (sqlc.narg(unit_id)::uuid IS NULL OR p.unit_id = sqlc.narg(unit_id))
+  AND (b.is_recurring OR b.end_time >= sqlc.arg(since))
+ORDER BY b.start_time;
@@ -0,0 +1,149 @@
+-- ============================================================================
+-- SINCRONIZAÇÃO COM CALENDÁRIOS EXTERNOS
+-- ============================================================================
+
+-- name: UpsertCalendarConnection :one
+-- Conectar de novo a mesma conta troca as credenciais e reinicia a leitura
+INSERT INTO calendar_connections (
+    id, tenant_id, professional_id, provider, calendar_id, account_email,
+    access_token, refresh_token, token_expires_at, created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT (tenant_id, professional_id, provider) DO UPDATE
+SET calendar_id = EXCLUDED.calendar_id,
+    account_email = EXCLUDED.account_email,
+    access_token = EXCLUDED.access_token,
+    refresh_token = EXCLUDED.refresh_token,
+    token_expires_at = EXCLUDED.token_expires_at,
+    created_by = EXCLUDED.created_by,
+    sync_token = NULL,
+    status = 'ACTIVE',
+    last_error = NULL,
+    full_synced_at = NULL,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetCalendarConnection :one
+SELECT * FROM calendar_connections
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListCalendarConnections :many
+-- Conexões do tenant (professional_id restringe ao profissional)
+SELECT cc.*, p.nome AS professional_name
+FROM calendar_connections cc
+JOIN profissionais p ON p.id = cc.professional_id
+WHERE cc.tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(professional_id)::uuid IS NULL OR cc.professional_id = sqlc.narg(professional_id))
+ORDER BY p.nome;
+
+-- name: ListActiveCalendarConnections :many
+-- Conexões ativas de todos os tenants (sincronização periódica)
+SELECT * FROM calendar_connections
+WHERE status = 'ACTIVE'
+ORDER BY last_synced_at NULLS FIRST;
+
+-- name: ListCalendarConnectionsByProfessionals :many
+SELECT * FROM calendar_connections
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND professional_id = ANY(sqlc.arg(professional_ids)::uuid[]);
+
+-- name: UpdateCalendarConnectionTokens :exec
+UPDATE calendar_connections
+SET access_token = $2,
+    refresh_token = $3,
+    token_expires_at = $4,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: UpdateCalendarConnectionSync :exec
+-- Resultado da leitura do calendário externo
+UPDATE calendar_connections
+SET sync_token = sqlc.narg(sync_token),
+    status = sqlc.arg(status),
+    last_error = sqlc.narg(last_error),
+    last_synced_at = sqlc.narg(last_synced_at),
+    full_synced_at = sqlc.narg(full_synced_at),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: DeleteCalendarConnectionBlockedTimes :exec
+-- Remove os bloqueios importados da conexão
+DELETE FROM blocked_times
+WHERE id IN (
+    SELECT blocked_time_id FROM calendar_imported_events
+    WHERE connection_id = $1
+);
+
+-- name: DeleteCalendarConnection :exec
+DELETE FROM calendar_connections
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListCalendarEventLinks :many
+SELECT * FROM calendar_event_links
+WHERE appointment_id = $1
+ORDER BY slot_index;
+
+-- name: UpsertCalendarEventLink :exec
+INSERT INTO calendar_event_links (connection_id, appointment_id, slot_index, external_event_id)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (connection_id, appointment_id, slot_index) DO UPDATE
+SET external_event_id = EXCLUDED.external_event_id,
+    updated_at = NOW();
+
+-- name: DeleteCalendarEventLink :exec
+DELETE FROM calendar_event_links
+WHERE connection_id = $1 AND appointment_id = $2 AND slot_index = $3;
+
+-- name: ListCalendarImportedEvents :many
+-- Eventos importados da conexão com o fim do bloqueio
+SELECT ie.external_event_id, ie.blocked_time_id, b.end_time
+FROM calendar_imported_events ie
+JOIN blocked_times b ON b.id = ie.blocked_time_id
+WHERE ie.connection_id = $1;
+
+-- name: CreateCalendarImportedEvent :exec
+INSERT INTO calendar_imported_events (connection_id, external_event_id, blocked_time_id)
+VALUES ($1, $2, $3)
+ON CONFLICT (connection_id, external_event_id) DO UPDATE
+SET blocked_time_id = EXCLUDED.blocked_time_id;
+
+-- name: EnqueueCalendarSync :exec
+-- Marca o agendamento para envio; se já estava na fila, envia o quanto antes
+INSERT INTO calendar_sync_queue (appointment_id, tenant_id)
+VALUES ($1, $2)
+ON CONFLICT (appointment_id) DO UPDATE
+SET attempts = 0,
+    next_attempt_at = NOW(),
+    last_error = NULL,
+    created_at = NOW();
+
+-- name: ListDueCalendarSync :many
+SELECT * FROM calendar_sync_queue
+WHERE next_attempt_at <= NOW()
+ORDER BY next_attempt_at
+LIMIT $1;
+
+-- name: DeleteCalendarSync :exec
+-- Só remove se o agendamento não voltou para a fila durante o envio
+DELETE FROM calendar_sync_queue
+WHERE appointment_id = $1 AND created_at = $2;
+
+-- name: RetryCalendarSync :exec
+UPDATE calendar_sync_queue
+SET attempts = $2,
+    next_attempt_at = $3,
+    last_error = $4
+WHERE appointment_id = $1;
+
+-- name: HasCalendarConnections :one
+SELECT EXISTS (
+    SELECT 1 FROM calendar_connections
+    WHERE tenant_id = sqlc.arg(tenant_id)
+      AND professional_id = ANY(sqlc.arg(professional_ids)::uuid[])
+      AND status = 'ACTIVE'
+) AS has_connections;
+
+-- name: SetAppointmentGoogleCalendarEventID :exec
+UPDATE appointments
+SET google_calendar_event_id = $3
+WHERE id = $1 AND tenant_id = $2;
@@ -58,8 +58,10 @@ ORDER BY p.nome ASC;
 
 -- name: ListCommissionPeriodsByProfessional :many
 SELECT cp.*,
+       p.nome as professional_name,
        u.nome as unit_name
 FROM commission_periods cp
+LEFT JOIN profissionais p ON cp.professional_id = p.id
 LEFT JOIN units u ON cp.unit_id = u.id
 WHERE cp.tenant_id = $1
   AND cp.professional_id = $2
@@ -46,6 +46,14 @@ WHERE tenant_id = $1
   AND data_vencimento <= $3
 ORDER BY data_vencimento ASC;
 
+-- name: ListContasPagasByPeriod :many
+SELECT * FROM contas_a_pagar
+WHERE tenant_id = $1
+  AND data_pagamento >= $2
+  AND data_pagamento <= $3
+  AND status = 'PAGO'
+ORDER BY data_pagamento ASC;
+
 -- name: ListContasPagarFiltered :many
 SELECT * FROM contas_a_pagar
 WHERE tenant_id = $1
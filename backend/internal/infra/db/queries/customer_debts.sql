@@ -0,0 +1,103 @@
+-- ============================================================================
+-- CUSTOMER_DEBTS QUERIES (sqlc)
+-- Fiado: dívidas de clientes geradas por comandas com saldo devedor
+-- ============================================================================
+
+-- name: CreateCustomerDebt :one
+INSERT INTO customer_debts (
+    id,
+    tenant_id,
+    customer_id,
+    command_id,
+    valor_original,
+    valor_pago,
+    saldo,
+    status,
+    data_abertura,
+    observacoes
+) VALUES (
+    sqlc.arg(id), sqlc.arg(tenant_id), sqlc.arg(customer_id), sqlc.arg(command_id),
+    sqlc.arg(valor_original), sqlc.arg(valor_pago), sqlc.arg(saldo), sqlc.arg(status),
+    sqlc.arg(data_abertura), sqlc.narg(observacoes)
+)
+ON CONFLICT (tenant_id, command_id) DO NOTHING
+RETURNING *;
+
+-- name: GetCustomerDebtByID :one
+SELECT * FROM customer_debts
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: GetCustomerDebtByCommandID :one
+SELECT * FROM customer_debts
+WHERE command_id = sqlc.arg(command_id) AND tenant_id = sqlc.arg(tenant_id);
+
+-- name: ListCustomerDebtsByCustomer :many
+-- Lista dívidas do cliente; somente_abertas filtra ABERTA/PARCIAL
+SELECT * FROM customer_debts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND customer_id = sqlc.arg(customer_id)
+  AND (NOT sqlc.arg(somente_abertas)::boolean OR status IN ('ABERTA', 'PARCIAL'))
+ORDER BY data_abertura ASC;
+
+-- name: GetCustomerDebtSaldo :one
+-- Saldo devedor total do cliente (dívidas ABERTA/PARCIAL)
+SELECT COALESCE(SUM(saldo), 0)::numeric AS saldo_total
+FROM customer_debts
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND customer_id = sqlc.arg(customer_id)
+  AND status IN ('ABERTA', 'PARCIAL');
+
+-- name: AbaterCustomerDebtSaldo :one
+-- Abate o pagamento do saldo de forma atômica. Não retorna linha se a dívida
+-- não está mais aberta ou se o saldo atual não cobre o valor (pagamento
+-- concorrente), evitando pagamento perdido ou dívida paga a mais.
+UPDATE customer_debts
+SET
+    valor_pago = valor_pago + sqlc.arg(valor),
+    saldo = saldo - sqlc.arg(valor),
+    status = CASE WHEN saldo - sqlc.arg(valor) = 0 THEN 'QUITADA' ELSE 'PARCIAL' END,
+    data_quitacao = CASE WHEN saldo - sqlc.arg(valor) = 0 THEN NOW() ELSE data_quitacao END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id) AND tenant_id = sqlc.arg(tenant_id)
+  AND status IN ('ABERTA', 'PARCIAL')
+  AND saldo >= sqlc.arg(valor)
+RETURNING *;
+
+-- name: ListCustomerDebtAging :many
+-- Relatório de fiado: clientes por saldo devedor e idade da dívida mais antiga
+SELECT
+    d.customer_id,
+    c.nome AS customer_nome,
+    c.telefone AS customer_telefone,
+    COUNT(*) AS quantidade_dividas,
+    COALESCE(SUM(d.saldo), 0)::numeric AS saldo_total,
+    MIN(d.data_abertura)::timestamptz AS divida_mais_antiga
+FROM customer_debts d
+JOIN clientes c ON c.id = d.customer_id AND c.tenant_id = d.tenant_id
+WHERE d.tenant_id = sqlc.arg(tenant_id)
+  AND d.status IN ('ABERTA', 'PARCIAL')
+GROUP BY d.customer_id, c.nome, c.telefone
+ORDER BY saldo_total DESC, divida_mais_antiga ASC;
+
+-- name: CreateCustomerDebtPayment :one
+INSERT INTO customer_debt_payments (
+    id,
+    tenant_id,
+    debt_id,
+    command_id,
+    meio_pagamento_id,
+    valor,
+    conta_receber_id,
+    operacao_caixa_id,
+    recebido_por,
+    observacoes
+) VALUES (
+    sqlc.arg(id), sqlc.arg(tenant_id), sqlc.arg(debt_id), sqlc.narg(command_id),
+    sqlc.arg(meio_pagamento_id), sqlc.arg(valor), sqlc.narg(conta_receber_id),
+    sqlc.narg(operacao_caixa_id), sqlc.narg(recebido_por), sqlc.narg(observacoes)
+) RETURNING *;
+
+-- name: ListCustomerDebtPayments :many
+SELECT * FROM customer_debt_payments
+WHERE debt_id = sqlc.arg(debt_id) AND tenant_id = sqlc.arg(tenant_id)
+ORDER BY created_at ASC;
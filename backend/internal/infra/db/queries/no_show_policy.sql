@@ -0,0 +1,84 @@
+-- ============================================================================
+-- POLÍTICA DE NÃO COMPARECIMENTO
+-- ============================================================================
+
+-- name: GetNoShowPolicy :one
+SELECT * FROM no_show_policies
+WHERE tenant_id = $1;
+
+-- name: UpsertNoShowPolicy :one
+INSERT INTO no_show_policies (
+    tenant_id, enabled, max_no_shows, window_days, deposit_type,
+    deposit_value, payment_deadline_hours, meio_pagamento_id
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (tenant_id) DO UPDATE SET
+    enabled = EXCLUDED.enabled,
+    max_no_shows = EXCLUDED.max_no_shows,
+    window_days = EXCLUDED.window_days,
+    deposit_type = EXCLUDED.deposit_type,
+    deposit_value = EXCLUDED.deposit_value,
+    payment_deadline_hours = EXCLUDED.payment_deadline_hours,
+    meio_pagamento_id = EXCLUDED.meio_pagamento_id,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: GetCustomerAttendanceStats :one
+-- Agendamentos já ocorridos do cliente desde a data informada
+SELECT
+    COUNT(*) FILTER (WHERE status IN ('CHECKED_IN', 'IN_SERVICE', 'AWAITING_PAYMENT', 'DONE'))::bigint AS attended,
+    COUNT(*) FILTER (WHERE status = 'NO_SHOW')::bigint AS no_shows,
+    COUNT(*) FILTER (WHERE status = 'CANCELED')::bigint AS canceled
+FROM appointments
+WHERE tenant_id = $1
+  AND customer_id = $2
+  AND start_time >= $3
+  AND start_time < NOW();
+
+-- ============================================================================
+-- SINAIS DE AGENDAMENTO
+-- ============================================================================
+
+-- name: CreateAppointmentDeposit :one
+INSERT INTO appointment_deposits (
+    id, tenant_id, unit_id, appointment_id, customer_id, amount, due_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetAppointmentDepositByAppointment :one
+SELECT * FROM appointment_deposits
+WHERE appointment_id = $1 AND tenant_id = $2;
+
+-- name: GetAppointmentDepositByAsaasPayment :one
+SELECT * FROM appointment_deposits
+WHERE asaas_payment_id = $1;
+
+-- name: SetAppointmentDepositCharge :one
+UPDATE appointment_deposits
+SET asaas_payment_id = $3,
+    invoice_url = $4,
+    pix_payload = $5,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: TransitionAppointmentDeposit :execrows
+-- Só muda o status se o sinal ainda estiver em from_status
+UPDATE appointment_deposits
+SET status = sqlc.arg(status),
+    paid_at = COALESCE(sqlc.narg(paid_at), paid_at),
+    settled_at = COALESCE(sqlc.narg(settled_at), settled_at),
+    command_payment_id = COALESCE(sqlc.narg(command_payment_id), command_payment_id),
+    conta_receber_id = COALESCE(sqlc.narg(conta_receber_id), conta_receber_id),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND tenant_id = sqlc.arg(tenant_id)
+  AND status = sqlc.arg(from_status);
+
+-- name: ListOverdueAppointmentDeposits :many
+SELECT * FROM appointment_deposits
+WHERE status = 'PENDING'
+  AND due_at < NOW()
+ORDER BY due_at
+LIMIT $1;
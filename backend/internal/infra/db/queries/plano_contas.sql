@@ -0,0 +1,24 @@
+-- ============================================================================
+-- PLANO DE CONTAS (mapeamento contábil)
+-- ============================================================================
+
+-- name: UpsertPlanoContasMapeamento :one
+INSERT INTO plano_contas_mapeamento (
+    id, tenant_id, origem_tipo, origem_chave, conta_codigo, conta_nome
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+ON CONFLICT (tenant_id, origem_tipo, origem_chave) DO UPDATE SET
+    conta_codigo = EXCLUDED.conta_codigo,
+    conta_nome = EXCLUDED.conta_nome,
+    updated_at = NOW()
+RETURNING *;
+
+-- name: ListPlanoContasMapeamentos :many
+SELECT * FROM plano_contas_mapeamento
+WHERE tenant_id = $1
+ORDER BY origem_tipo, origem_chave;
+
+-- name: DeletePlanoContasMapeamento :execrows
+DELETE FROM plano_contas_mapeamento
+WHERE id = $1 AND tenant_id = $2;
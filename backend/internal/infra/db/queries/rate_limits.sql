@@ -0,0 +1,30 @@
+-- name: TakeRateLimitToken :one
+-- Token bucket atômico: repõe os tokens pelo tempo decorrido (relógio do banco,
+-- igual para todas as réplicas) e consome um, se houver.
+INSERT INTO rate_limit_buckets (chave, tokens, permitido, atualizado_em, expira_em)
+VALUES (
+    sqlc.arg(chave),
+    sqlc.arg(capacidade)::float8 - 1,
+    TRUE,
+    NOW(),
+    NOW() + make_interval(secs => sqlc.arg(ttl_segundos)::float8)
+)
+ON CONFLICT (chave) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST(sqlc.arg(capacidade)::float8, rate_limit_buckets.tokens
+            + GREATEST(EXTRACT(EPOCH FROM (NOW() - rate_limit_buckets.atualizado_em)), 0) * sqlc.arg(reposicao_por_segundo)::float8) >= 1
+        THEN LEAST(sqlc.arg(capacidade)::float8, rate_limit_buckets.tokens
+            + GREATEST(EXTRACT(EPOCH FROM (NOW() - rate_limit_buckets.atualizado_em)), 0) * sqlc.arg(reposicao_por_segundo)::float8) - 1
+        ELSE LEAST(sqlc.arg(capacidade)::float8, rate_limit_buckets.tokens
+            + GREATEST(EXTRACT(EPOCH FROM (NOW() - rate_limit_buckets.atualizado_em)), 0) * sqlc.arg(reposicao_por_segundo)::float8)
+    END,
+    permitido = LEAST(sqlc.arg(capacidade)::float8, rate_limit_buckets.tokens
+        + GREATEST(EXTRACT(EPOCH FROM (NOW() - rate_limit_buckets.atualizado_em)), 0) * sqlc.arg(reposicao_por_segundo)::float8) >= 1,
+    atualizado_em = NOW(),
+    expira_em = NOW() + make_interval(secs => sqlc.arg(ttl_segundos)::float8)
+RETURNING tokens, permitido;
+
+-- name: DeleteExpiredRateLimitBuckets :execrows
+-- Remove buckets sem uso (já cheios de novo) para manter a tabela pequena
+DELETE FROM rate_limit_buckets
+WHERE expira_em < NOW();
@@ -0,0 +1,118 @@
+-- ============================================================================
+-- RECURSOS DA UNIDADE (cadeiras, salas, lavatório)
+-- ============================================================================
+
+-- name: CreateResource :one
+INSERT INTO resources (id, tenant_id, unit_id, nome, capacity)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetResource :one
+SELECT * FROM resources
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListResources :many
+SELECT * FROM resources
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND unit_id = sqlc.arg(unit_id)
+  AND (sqlc.arg(include_inactive)::boolean OR ativo)
+ORDER BY nome;
+
+-- name: UpdateResource :one
+UPDATE resources
+SET nome = $3,
+    capacity = $4,
+    ativo = $5,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- Trava o recurso até o fim da transação: reservas concorrentes do mesmo
+-- recurso são verificadas uma de cada vez
+-- name: LockResourceCapacity :one
+SELECT capacity FROM resources
+WHERE id = $1 AND tenant_id = $2
+FOR UPDATE;
+
+-- ============================================================================
+-- RECURSOS EXIGIDOS PELOS SERVIÇOS
+-- ============================================================================
+
+-- name: DeleteServiceResources :exec
+DELETE FROM service_resources
+WHERE servico_id = $1 AND tenant_id = $2;
+
+-- name: CreateServiceResource :exec
+INSERT INTO service_resources (tenant_id, servico_id, resource_id, offset_minutes, duration_minutes)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListServiceResources :many
+SELECT sr.servico_id, sr.resource_id, sr.offset_minutes, sr.duration_minutes, r.nome as resource_name
+FROM service_resources sr
+JOIN resources r ON r.id = sr.resource_id
+WHERE sr.servico_id = $1 AND sr.tenant_id = $2
+ORDER BY r.nome;
+
+-- Recursos ativos da unidade exigidos pelos serviços informados
+-- name: ListResourceRequirementsForServices :many
+SELECT sr.servico_id, sr.resource_id, sr.offset_minutes, sr.duration_minutes, r.nome as resource_name
+FROM service_resources sr
+JOIN resources r ON r.id = sr.resource_id
+WHERE sr.tenant_id = sqlc.arg(tenant_id)
+  AND r.unit_id = sqlc.arg(unit_id)
+  AND r.ativo
+  AND sr.servico_id = ANY(sqlc.arg(service_ids)::uuid[])
+ORDER BY sr.servico_id, r.nome;
+
+-- ============================================================================
+-- RESERVAS DOS AGENDAMENTOS
+-- ============================================================================
+
+-- name: CreateAppointmentResource :exec
+INSERT INTO appointment_resources (tenant_id, appointment_id, service_id, resource_id, offset_minutes, duration_minutes)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT DO NOTHING;
+
+-- name: ListAppointmentResources :many
+SELECT ar.service_id, ar.resource_id, ar.offset_minutes, ar.duration_minutes, r.nome as resource_name
+FROM appointment_resources ar
+JOIN resources r ON r.id = ar.resource_id
+WHERE ar.appointment_id = $1
+ORDER BY ar.service_id, r.nome;
+
+-- Pico de uso simultâneo do recurso no período: o uso de cada reserva vai do
+-- início do serviço + offset até offset + duração (limitado ao fim do
+-- serviço). Agendamentos cancelados ou com falta não contam.
+-- name: GetResourcePeakUsage :one
+WITH uso AS (
+    SELECT
+        aps.start_time + make_interval(mins => ar.offset_minutes) AS inicio,
+        CASE
+            WHEN ar.duration_minutes IS NULL THEN aps.end_time
+            ELSE LEAST(aps.end_time, aps.start_time + make_interval(mins => ar.offset_minutes + ar.duration_minutes))
+        END AS fim
+    FROM appointment_resources ar
+    JOIN appointment_services aps
+      ON aps.appointment_id = ar.appointment_id AND aps.service_id = ar.service_id
+    WHERE ar.tenant_id = sqlc.arg(tenant_id)
+      AND ar.resource_id = sqlc.arg(resource_id)
+      AND ar.appointment_id IS DISTINCT FROM sqlc.narg(exclude_id)::uuid
+      AND aps.active
+      AND aps.start_time < sqlc.arg(end_time)::timestamptz
+      AND aps.end_time > sqlc.arg(start_time)::timestamptz
+),
+sobrepostos AS (
+    SELECT inicio, fim FROM uso
+    WHERE inicio < fim
+      AND inicio < sqlc.arg(end_time)::timestamptz
+      AND fim > sqlc.arg(start_time)::timestamptz
+)
+SELECT COALESCE(MAX((
+    SELECT COUNT(*) FROM sobrepostos s
+    WHERE s.inicio <= p.instante AND s.fim > p.instante
+)), 0)::int AS peak
+FROM (
+    SELECT sqlc.arg(start_time)::timestamptz AS instante
+    UNION
+    SELECT inicio FROM sobrepostos WHERE inicio > sqlc.arg(start_time)::timestamptz
+) p;
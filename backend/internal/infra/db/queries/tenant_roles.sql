@@ -0,0 +1,42 @@
+-- ============================================================================
+-- PAPÉIS PERSONALIZADOS (tenant_roles)
+-- ============================================================================
+
+-- name: ListTenantRoles :many
+SELECT * FROM tenant_roles
+WHERE tenant_id = $1
+ORDER BY nome;
+
+-- name: GetTenantRole :one
+SELECT * FROM tenant_roles
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: CreateTenantRole :one
+INSERT INTO tenant_roles (tenant_id, nome, descricao, base_role, permissoes)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: UpdateTenantRole :one
+UPDATE tenant_roles
+SET nome = $3, descricao = $4, base_role = $5, permissoes = $6, atualizado_em = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: DeleteTenantRole :execrows
+DELETE FROM tenant_roles
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: CountTenantRoleAssignments :one
+SELECT COUNT(*) FROM user_units
+WHERE custom_role_id = $1;
+
+-- name: AssignUserUnitCustomRole :execrows
+-- Define (ou remove, com custom_role_id NULL) o papel personalizado do usuário
+-- na unidade; a unidade precisa ser do tenant
+UPDATE user_units uu
+SET custom_role_id = sqlc.narg(custom_role_id), atualizado_em = NOW()
+FROM units un
+WHERE un.id = uu.unit_id
+  AND un.tenant_id = sqlc.arg(tenant_id)
+  AND uu.user_id = sqlc.arg(user_id)
+  AND uu.unit_id = sqlc.arg(unit_id);
@@ -0,0 +1,97 @@
+-- ============================================================================
+-- SQLC Queries: Autenticação em dois fatores (TOTP)
+-- ============================================================================
+
+-- name: GetUserTOTP :one
+SELECT * FROM user_totp
+WHERE user_id = $1;
+
+-- name: UpsertPendingUserTOTP :execrows
+-- Inicia (ou reinicia) o cadastro; não sobrescreve um 2FA já confirmado
+INSERT INTO user_totp (user_id, secret_enc)
+VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_enc = EXCLUDED.secret_enc,
+    last_used_step = 0,
+    created_at = NOW()
+WHERE user_totp.confirmed_at IS NULL;
+
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totp
+SET confirmed_at = NOW(), last_used_step = $2
+WHERE user_id = $1 AND confirmed_at IS NULL;
+
+-- name: AdvanceUserTOTPStep :execrows
+-- Grava o passo aceito; 0 linhas = código já usado (corrida entre dois logins)
+UPDATE user_totp
+SET last_used_step = $2
+WHERE user_id = $1 AND last_used_step < $2;
+
+-- name: DeleteUserTOTP :exec
+DELETE FROM user_totp
+WHERE user_id = $1;
+
+-- ============================================================================
+-- CÓDIGOS DE RECUPERAÇÃO
+-- ============================================================================
+
+-- name: DeleteRecoveryCodes :exec
+DELETE FROM user_recovery_codes
+WHERE user_id = $1;
+
+-- name: CreateRecoveryCode :exec
+INSERT INTO user_recovery_codes (user_id, code_hash)
+VALUES ($1, $2);
+
+-- name: UseRecoveryCode :execrows
+UPDATE user_recovery_codes
+SET used_at = NOW()
+WHERE user_id = $1 AND code_hash = $2 AND used_at IS NULL;
+
+-- name: CountAvailableRecoveryCodes :one
+SELECT COUNT(*) FROM user_recovery_codes
+WHERE user_id = $1 AND used_at IS NULL;
+
+-- ============================================================================
+-- DESAFIOS DO SEGUNDO PASSO DO LOGIN
+-- ============================================================================
+
+-- name: CreateMFAChallenge :exec
+INSERT INTO auth_mfa_challenges (user_id, token_hash, expires_at)
+VALUES ($1, $2, $3);
+
+-- name: GetMFAChallenge :one
+SELECT * FROM auth_mfa_challenges
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW();
+
+-- name: IncrementMFAChallengeTentativas :one
+UPDATE auth_mfa_challenges
+SET tentativas = tentativas + 1
+WHERE id = $1
+RETURNING tentativas;
+
+-- name: ConsumeMFAChallenge :execrows
+UPDATE auth_mfa_challenges
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL;
+
+-- name: DeleteExpiredMFAChallenges :exec
+DELETE FROM auth_mfa_challenges
+WHERE expires_at < NOW();
+
+-- ============================================================================
+-- POLÍTICA DO TENANT
+-- ============================================================================
+
+-- name: GetTenantAuthPolicy :one
+SELECT * FROM tenant_auth_policies
+WHERE tenant_id = $1;
+
+-- name: UpsertTenantAuthPolicy :one
+INSERT INTO tenant_auth_policies (tenant_id, exigir_2fa_admin, atualizado_por)
+VALUES ($1, $2, $3)
+ON CONFLICT (tenant_id) DO UPDATE
+SET exigir_2fa_admin = EXCLUDED.exigir_2fa_admin,
+    atualizado_por = EXCLUDED.atualizado_por,
+    atualizado_em = NOW()
+RETURNING *;
@@ -0,0 +1,94 @@
+-- ============================================================================
+-- LISTA DE ESPERA
+-- ============================================================================
+
+-- name: CreateWaitlistEntry :one
+INSERT INTO waitlist_entries (
+    id, tenant_id, unit_id, customer_id, service_ids, professional_ids,
+    window_start, window_end, priority, notes, created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING *;
+
+-- name: GetWaitlistEntry :one
+SELECT * FROM waitlist_entries
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: UpdateWaitlistEntryStatus :one
+UPDATE waitlist_entries
+SET status = $3,
+    appointment_id = $4,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: ListWaitlistEntries :many
+SELECT * FROM waitlist_entries
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND unit_id = sqlc.arg(unit_id)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+ORDER BY priority DESC, created_at;
+
+-- name: ListWaitlistCandidates :many
+-- Clientes aguardando cujo período cruza o horário liberado e que aceitam o
+-- profissional, por prioridade. Quem já recebeu oferta deste horário fica de
+-- fora (recusou ou deixou expirar).
+SELECT * FROM waitlist_entries e
+WHERE e.tenant_id = sqlc.arg(tenant_id)
+  AND e.unit_id = sqlc.arg(unit_id)
+  AND e.status = 'WAITING'
+  AND e.window_start < sqlc.arg(slot_end)
+  AND e.window_end > sqlc.arg(slot_start)
+  AND (cardinality(e.professional_ids) = 0 OR sqlc.arg(professional_id)::uuid = ANY(e.professional_ids))
+  AND NOT EXISTS (
+      SELECT 1 FROM waitlist_offers o
+      WHERE o.entry_id = e.id
+        AND o.professional_id = sqlc.arg(professional_id)
+        AND o.slot_start = sqlc.arg(slot_start)
+  )
+ORDER BY e.priority DESC, e.created_at
+LIMIT sqlc.arg(limite);
+
+-- name: ExpireWaitlistEntries :execrows
+-- Encerra (todos os tenants) as entradas cujo período já passou
+UPDATE waitlist_entries
+SET status = 'EXPIRED',
+    updated_at = NOW()
+WHERE status = 'WAITING' AND window_end <= NOW();
+
+-- ============================================================================
+-- OFERTAS
+-- ============================================================================
+
+-- name: CreateWaitlistOffer :execrows
+-- Não cria se o horário já tem oferta pendente (índice único parcial)
+INSERT INTO waitlist_offers (
+    id, tenant_id, unit_id, entry_id, professional_id,
+    slot_start, slot_end, start_time, end_time, token_hash, expires_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+ON CONFLICT DO NOTHING;
+
+-- name: GetWaitlistOfferByTokenHash :one
+SELECT * FROM waitlist_offers
+WHERE token_hash = $1;
+
+-- name: GetPendingWaitlistOfferByEntry :one
+SELECT * FROM waitlist_offers
+WHERE entry_id = $1 AND tenant_id = $2 AND status = 'PENDING';
+
+-- name: TransitionWaitlistOffer :execrows
+-- Muda o status só se a oferta ainda estiver no status esperado (evita
+-- confirmação dupla e corrida com a expiração)
+UPDATE waitlist_offers
+SET status = sqlc.arg(status),
+    appointment_id = COALESCE(sqlc.narg(appointment_id), appointment_id),
+    responded_at = COALESCE(responded_at, NOW())
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status);
+
+-- name: ListExpiredWaitlistOffers :many
+-- Ofertas pendentes com prazo vencido (todos os tenants)
+SELECT * FROM waitlist_offers
+WHERE status = 'PENDING' AND expires_at <= NOW()
+ORDER BY expires_at
+LIMIT sqlc.arg(limite);
@@ -0,0 +1,42 @@
+-- ============================================================================
+-- FILA DE ENCAIXE
+-- ============================================================================
+
+-- name: CreateWalkIn :one
+INSERT INTO walk_ins (
+    id, tenant_id, unit_id, customer_id, service_ids, duration_minutes,
+    notes, created_by
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING *;
+
+-- name: GetWalkIn :one
+SELECT w.*, c.nome AS customer_name
+FROM walk_ins w
+JOIN clientes c ON c.id = w.customer_id
+WHERE w.id = $1 AND w.tenant_id = $2;
+
+-- name: ListWalkIns :many
+-- Chegadas da unidade a partir de since, na ordem de chegada
+SELECT w.*, c.nome AS customer_name
+FROM walk_ins w
+JOIN clientes c ON c.id = w.customer_id
+WHERE w.tenant_id = sqlc.arg(tenant_id)
+  AND w.unit_id = sqlc.arg(unit_id)
+  AND w.checked_in_at >= sqlc.arg(since)
+  AND (sqlc.narg(status)::varchar IS NULL OR w.status = sqlc.narg(status))
+ORDER BY w.checked_in_at, w.created_at;
+
+-- name: TransitionWalkIn :execrows
+-- Muda o status se a chegada ainda estiver em from_status (evita que duas
+-- recepções encaixem o mesmo cliente)
+UPDATE walk_ins
+SET status = sqlc.arg(status),
+    professional_id = sqlc.arg(professional_id),
+    appointment_id = sqlc.arg(appointment_id),
+    assigned_at = CASE WHEN sqlc.arg(status)::varchar = 'ASSIGNED' THEN COALESCE(assigned_at, NOW()) END,
+    canceled_at = CASE WHEN sqlc.arg(status)::varchar = 'CANCELED' THEN NOW() END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND tenant_id = sqlc.arg(tenant_id)
+  AND status = sqlc.arg(from_status);
@@ -0,0 +1,99 @@
+-- name: ListWebhookEndpoints :many
+SELECT * FROM webhook_endpoints
+WHERE tenant_id = $1
+ORDER BY criado_em DESC;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoints
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoints (tenant_id, url, descricao, eventos, secret_cifrado, criado_por)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoints
+SET url = $3, descricao = $4, eventos = $5, ativo = $6, atualizado_em = NOW()
+WHERE id = $1 AND tenant_id = $2
+RETURNING *;
+
+-- name: RotateWebhookEndpointSecret :execrows
+UPDATE webhook_endpoints
+SET secret_cifrado = $3, atualizado_em = NOW()
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: DeleteWebhookEndpoint :execrows
+DELETE FROM webhook_endpoints
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListWebhookEndpointsForEvent :many
+-- Endpoints ativos do tenant inscritos no evento
+SELECT * FROM webhook_endpoints
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND ativo
+  AND sqlc.arg(evento)::text = ANY(eventos);
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (tenant_id, endpoint_id, evento, event_id, payload)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ClaimWebhookDeliveries :many
+-- Reserva entregas vencidas para envio. O lease adia a próxima tentativa, de
+-- modo que outra réplica não pegue a mesma entrega enquanto ela está em voo;
+-- se o processo cair, a entrega volta a ficar disponível ao fim do lease.
+WITH proximas AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhook_endpoints e ON e.id = d.endpoint_id AND e.ativo
+    WHERE d.status = 'PENDENTE' AND d.proxima_tentativa_em <= NOW()
+    ORDER BY d.proxima_tentativa_em
+    LIMIT sqlc.arg(limite)
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET proxima_tentativa_em = NOW() + make_interval(secs => sqlc.arg(lease_segundos)::float8),
+    atualizado_em = NOW()
+FROM proximas, webhook_endpoints e
+WHERE d.id = proximas.id AND e.id = d.endpoint_id
+RETURNING d.id, d.tenant_id, d.endpoint_id, d.evento, d.event_id, d.payload, d.tentativas, e.url, e.secret_cifrado;
+
+-- name: CreateWebhookDeliveryAttempt :exec
+INSERT INTO webhook_delivery_attempts (delivery_id, tentativa, status_http, erro, resposta, duracao_ms)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: UpdateWebhookDeliveryResult :exec
+-- Resultado da tentativa: ENTREGUE, PENDENTE (nova tentativa agendada) ou FALHOU
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status),
+    tentativas = sqlc.arg(tentativas),
+    proxima_tentativa_em = sqlc.arg(proxima_tentativa_em),
+    ultimo_status_http = sqlc.narg(ultimo_status_http),
+    ultimo_erro = sqlc.narg(ultimo_erro),
+    entregue_em = CASE WHEN sqlc.arg(status) = 'ENTREGUE' THEN NOW() ELSE entregue_em END,
+    atualizado_em = NOW()
+WHERE id = sqlc.arg(id);
+
+-- name: ListWebhookDeliveries :many
+-- Entregas do tenant, mais recentes primeiro, com filtros opcionais
+SELECT * FROM webhook_deliveries
+WHERE tenant_id = sqlc.arg(tenant_id)
+  AND (sqlc.narg(endpoint_id)::uuid IS NULL OR endpoint_id = sqlc.narg(endpoint_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+ORDER BY criado_em DESC
+LIMIT sqlc.arg(limite);
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_deliveries
+WHERE id = $1 AND tenant_id = $2;
+
+-- name: ListWebhookDeliveryAttempts :many
+SELECT * FROM webhook_delivery_attempts
+WHERE delivery_id = $1
+ORDER BY criado_em;
+
+-- name: RedeliverWebhookDelivery :execrows
+-- Reenvio manual: volta a entrega para a fila com o ciclo de retentativas zerado
+UPDATE webhook_deliveries
+SET status = 'PENDENTE', tentativas = 0, proxima_tentativa_em = NOW(), atualizado_em = NOW()
+WHERE id = $1 AND tenant_id = $2;
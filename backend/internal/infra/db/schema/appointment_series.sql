@@ -0,0 +1,35 @@
+-- Tabela: appointment_series (séries de agendamentos recorrentes)
+CREATE TABLE IF NOT EXISTS appointment_series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    professional_id UUID NOT NULL REFERENCES profissionais(id) ON DELETE RESTRICT,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE RESTRICT,
+    service_ids UUID[] NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    interval_weeks INTEGER NOT NULL CHECK (interval_weeks BETWEEN 1 AND 8),
+    ends_on DATE,
+    notes TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'ENDED')),
+    ended_reason TEXT,
+    ended_at TIMESTAMPTZ,
+    generated_until TIMESTAMPTZ,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_appointment_series_services CHECK (cardinality(service_ids) > 0)
+);
+
+-- Tabela: appointment_series_occurrences (resultado de cada ocorrência gerada)
+CREATE TABLE IF NOT EXISTS appointment_series_occurrences (
+    series_id UUID NOT NULL REFERENCES appointment_series(id) ON DELETE CASCADE,
+    occurrence_index INTEGER NOT NULL CHECK (occurrence_index >= 0),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    scheduled_start TIMESTAMPTZ NOT NULL,
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('BOOKED', 'CONFLICT', 'SKIPPED')),
+    detail TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (series_id, occurrence_index)
+);
@@ -31,12 +31,14 @@ CREATE TABLE IF NOT EXISTS appointments (
 CREATE INDEX IF NOT EXISTS idx_appointments_tenant_start ON appointments(tenant_id, start_time);
 CREATE INDEX IF NOT EXISTS idx_appointments_professional ON appointments(professional_id);
 CREATE INDEX IF NOT EXISTS idx_appointments_customer ON appointments(customer_id);
+CREATE INDEX IF NOT EXISTS idx_appointments_customer_start ON appointments(tenant_id, customer_id, start_time);
 CREATE INDEX IF NOT EXISTS idx_appointments_status ON appointments(status);
 CREATE INDEX IF NOT EXISTS idx_appointments_command_id ON appointments(command_id) WHERE command_id IS NOT NULL;
 
 -- =============================================================================
 -- Schema: appointment_services (para sqlc)
--- Relacionamento N:N entre agendamentos e serviços
+-- Relacionamento N:N entre agendamentos e serviços. Cada serviço tem
+-- profissional e horário próprios, em sequência dentro do agendamento.
 -- =============================================================================
 
 CREATE TABLE IF NOT EXISTS appointment_services (
@@ -45,8 +47,19 @@ CREATE TABLE IF NOT EXISTS appointment_services (
     price_at_booking NUMERIC(10,2) NOT NULL CHECK (price_at_booking >= 0),
     duration_at_booking INTEGER NOT NULL CHECK (duration_at_booking > 0),
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    professional_id UUID NOT NULL REFERENCES profissionais(id) ON DELETE RESTRICT,
+    sequence INTEGER NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE, -- espelha o status do agendamento (trigger)
     
-    PRIMARY KEY (appointment_id, service_id)
+    PRIMARY KEY (appointment_id, service_id),
+    CONSTRAINT appointment_services_time_check CHECK (end_time >= start_time),
+    -- Sem sobreposição de serviços ativos do mesmo profissional (btree_gist)
+    CONSTRAINT appointment_services_no_overlap EXCLUDE USING gist (
+        professional_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (active)
 );
 
 -- Índice para busca por serviço
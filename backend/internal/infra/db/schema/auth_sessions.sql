@@ -0,0 +1,30 @@
+-- Tabela: auth_sessions (sessões de login por dispositivo)
+-- Todos os refresh tokens da rotação pertencem à mesma sessão (família)
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    user_agent VARCHAR(255),
+    ip_address VARCHAR(45),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    revoked_reason VARCHAR(20)
+        CHECK (revoked_reason IN ('LOGOUT', 'REVOGADA', 'REUTILIZACAO')),
+    -- Unidade ativa do dispositivo (login, /units/switch)
+    unit_id UUID REFERENCES units(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_user_ativas
+    ON auth_sessions(user_id) WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_tenant_ativas
+    ON auth_sessions(tenant_id) WHERE revoked_at IS NULL;
+
+-- Tabela: auth_login_throttle (falhas de login por conta/IP para bloqueio progressivo)
+CREATE TABLE IF NOT EXISTS auth_login_throttle (
+    chave VARCHAR(320) PRIMARY KEY,
+    falhas INTEGER NOT NULL DEFAULT 0,
+    ultima_falha TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    bloqueado_ate TIMESTAMPTZ
+);
@@ -0,0 +1,38 @@
+-- Schema: bank_statement_imports + bank_statement_lines
+-- Extratos bancários importados (OFX/CSV) e conciliação
+
+CREATE TABLE IF NOT EXISTS bank_statement_imports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    arquivo_nome VARCHAR(255) NOT NULL,
+    formato VARCHAR(10) NOT NULL CHECK (formato IN ('OFX', 'CSV')),
+    conta_bancaria VARCHAR(100),
+    periodo_inicio DATE,
+    periodo_fim DATE,
+    total_linhas INTEGER NOT NULL DEFAULT 0,
+    total_conciliadas INTEGER NOT NULL DEFAULT 0,
+    total_pendentes INTEGER NOT NULL DEFAULT 0,
+    importado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS bank_statement_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    import_id UUID NOT NULL REFERENCES bank_statement_imports(id) ON DELETE CASCADE,
+    data_lancamento DATE NOT NULL,
+    descricao TEXT NOT NULL DEFAULT '',
+    valor NUMERIC(15,2) NOT NULL CHECK (valor > 0),
+    tipo VARCHAR(10) NOT NULL CHECK (tipo IN ('CREDITO', 'DEBITO')),
+    identificador VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDENTE' CHECK (status IN ('PENDENTE', 'CONCILIADA', 'DIVERGENTE', 'IGNORADA')),
+    match_tipo VARCHAR(20) CHECK (match_tipo IN ('COMPENSACAO', 'CONTA_RECEBER', 'CONTA_PAGAR')),
+    match_id UUID,
+    diferenca NUMERIC(15,2),
+    observacao TEXT,
+    conciliado_em TIMESTAMPTZ,
+    conciliado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_bank_statement_lines_identificador UNIQUE (tenant_id, identificador)
+);
@@ -41,7 +41,13 @@ CREATE TABLE IF NOT EXISTS caixa_diario (
     
     -- Auditoria
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
-    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Fechamento cego, contagem por denominação e aprovação (064)
+    fechamento_cego BOOLEAN NOT NULL DEFAULT false,
+    contagem JSONB,
+    usuario_aprovacao_id UUID,
+    data_aprovacao TIMESTAMPTZ
 );
 
 -- ============================================================
@@ -74,5 +80,8 @@ CREATE TABLE IF NOT EXISTS operacoes_caixa (
     usuario_id UUID NOT NULL,
     
     -- Auditoria
-    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Para VENDA: tipo do meio de pagamento (064)
+    forma_pagamento VARCHAR(20)
 );
@@ -0,0 +1,33 @@
+-- Schema: customer_debts + customer_debt_payments
+-- Fiado: saldo devedor de comandas fechadas com DeixarSaldoDivida
+
+CREATE TABLE IF NOT EXISTS customer_debts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE RESTRICT,
+    command_id UUID NOT NULL REFERENCES commands(id) ON DELETE RESTRICT,
+    valor_original NUMERIC(10,2) NOT NULL CHECK (valor_original > 0),
+    valor_pago NUMERIC(10,2) NOT NULL DEFAULT 0,
+    saldo NUMERIC(10,2) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'ABERTA' CHECK (status IN ('ABERTA', 'PARCIAL', 'QUITADA', 'CANCELADA')),
+    data_abertura TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    data_quitacao TIMESTAMPTZ,
+    observacoes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_customer_debts_command UNIQUE (tenant_id, command_id)
+);
+
+CREATE TABLE IF NOT EXISTS customer_debt_payments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    debt_id UUID NOT NULL REFERENCES customer_debts(id) ON DELETE CASCADE,
+    command_id UUID REFERENCES commands(id) ON DELETE SET NULL,
+    meio_pagamento_id UUID NOT NULL REFERENCES meios_pagamento(id),
+    valor NUMERIC(10,2) NOT NULL CHECK (valor > 0),
+    conta_receber_id UUID REFERENCES contas_a_receber(id) ON DELETE SET NULL,
+    operacao_caixa_id UUID REFERENCES operacoes_caixa(id) ON DELETE SET NULL,
+    recebido_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    observacoes TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
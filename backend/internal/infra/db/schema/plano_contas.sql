@@ -0,0 +1,18 @@
+-- Schema: plano_contas_mapeamento
+-- Mapeamento de categorias, meios de pagamento e contas padrão para o plano de contas contábil
+
+CREATE TABLE IF NOT EXISTS plano_contas_mapeamento (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    origem_tipo VARCHAR(20) NOT NULL CHECK (origem_tipo IN ('CATEGORIA', 'MEIO_PAGAMENTO', 'PADRAO')),
+    origem_chave VARCHAR(64) NOT NULL,
+    conta_codigo VARCHAR(30) NOT NULL,
+    conta_nome VARCHAR(150) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_plano_contas_mapeamento_origem UNIQUE (tenant_id, origem_tipo, origem_chave)
+);
+
+CREATE INDEX IF NOT EXISTS idx_plano_contas_mapeamento_tenant
+    ON plano_contas_mapeamento(tenant_id);
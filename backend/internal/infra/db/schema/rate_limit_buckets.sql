@@ -0,0 +1,11 @@
+-- Tabela: rate_limit_buckets (token bucket compartilhado do rate limiting)
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    chave VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    permitido BOOLEAN NOT NULL DEFAULT TRUE,
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expira_em TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_expira
+    ON rate_limit_buckets(expira_em);
@@ -0,0 +1,40 @@
+-- Tabelas: resources, service_resources e appointment_resources (recursos da unidade)
+CREATE TABLE IF NOT EXISTS resources (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    nome VARCHAR(100) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    ativo BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_resources_unit_nome
+    ON resources(tenant_id, unit_id, lower(nome))
+    WHERE ativo;
+
+CREATE TABLE IF NOT EXISTS service_resources (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    servico_id UUID NOT NULL REFERENCES servicos(id) ON DELETE CASCADE,
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL DEFAULT 0 CHECK (offset_minutes >= 0),
+    duration_minutes INTEGER CHECK (duration_minutes > 0),
+    PRIMARY KEY (servico_id, resource_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_service_resources_resource
+    ON service_resources(resource_id);
+
+CREATE TABLE IF NOT EXISTS appointment_resources (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    service_id UUID NOT NULL REFERENCES servicos(id) ON DELETE RESTRICT,
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE RESTRICT,
+    offset_minutes INTEGER NOT NULL DEFAULT 0 CHECK (offset_minutes >= 0),
+    duration_minutes INTEGER CHECK (duration_minutes > 0),
+    PRIMARY KEY (appointment_id, service_id, resource_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_resources_resource
+    ON appointment_resources(tenant_id, resource_id);
@@ -0,0 +1,15 @@
+-- Tabela: tenant_roles (papéis personalizados por tenant)
+CREATE TABLE IF NOT EXISTS tenant_roles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    nome VARCHAR(60) NOT NULL,
+    descricao VARCHAR(255),
+    base_role VARCHAR(20) NOT NULL
+        CHECK (base_role IN ('MANAGER', 'RECEPTIONIST', 'BARBER', 'ACCOUNTANT')),
+    permissoes TEXT[] NOT NULL DEFAULT '{}',
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_tenant_roles_nome
+    ON tenant_roles(tenant_id, LOWER(nome));
@@ -0,0 +1,40 @@
+-- Tabela: user_totp (segredo TOTP cifrado; confirmed_at nulo = cadastro pendente)
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_enc TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Tabela: user_recovery_codes (códigos de recuperação de uso único, apenas hash)
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_recovery_codes_unique UNIQUE (user_id, code_hash)
+);
+
+-- Tabela: auth_mfa_challenges (login aguardando o segundo fator)
+CREATE TABLE IF NOT EXISTS auth_mfa_challenges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    tentativas INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_mfa_challenges_expires
+    ON auth_mfa_challenges(expires_at);
+
+-- Tabela: tenant_auth_policies (políticas de autenticação do tenant)
+CREATE TABLE IF NOT EXISTS tenant_auth_policies (
+    tenant_id UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    exigir_2fa_admin BOOLEAN NOT NULL DEFAULT false,
+    atualizado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
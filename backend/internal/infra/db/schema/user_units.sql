@@ -10,6 +10,7 @@ CREATE TABLE IF NOT EXISTS user_units (
     -- Configurações do vínculo
     is_default BOOLEAN DEFAULT false NOT NULL,
     role_override VARCHAR(50),
+    custom_role_id UUID, -- tenant_roles(id): papel personalizado na unidade
     
     -- Timestamps
     criado_em TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
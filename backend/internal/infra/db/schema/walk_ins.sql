@@ -0,0 +1,28 @@
+-- Tabela: walk_ins (fila de encaixe)
+CREATE TABLE IF NOT EXISTS walk_ins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE CASCADE,
+    service_ids UUID[] NOT NULL,
+    duration_minutes INTEGER NOT NULL CHECK (duration_minutes > 0),
+    notes TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'WAITING'
+        CHECK (status IN ('WAITING', 'ASSIGNED', 'CANCELED')),
+    professional_id UUID REFERENCES profissionais(id) ON DELETE SET NULL,
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    checked_in_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    assigned_at TIMESTAMPTZ,
+    canceled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_walk_ins_services CHECK (cardinality(service_ids) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_walk_ins_waiting
+    ON walk_ins(tenant_id, unit_id, checked_in_at)
+    WHERE status = 'WAITING';
+
+CREATE INDEX IF NOT EXISTS idx_walk_ins_unit_day
+    ON walk_ins(tenant_id, unit_id, checked_in_at DESC);
@@ -14,36 +14,37 @@ import (
 
 const checkAppointmentConflict = `-- name: CheckAppointmentConflict :one
 SELECT EXISTS (
-    SELECT 1 FROM appointments
-    WHERE tenant_id = $1
-      AND ($6::uuid IS NULL OR unit_id = $6)
-      AND professional_id = $2
-      AND id != $3
-      AND status NOT IN ('CANCELED', 'NO_SHOW')
-      AND start_time < $5
-      AND end_time > $4
+    SELECT 1 FROM appointment_services aps
+    JOIN appointments a ON a.id = aps.appointment_id
+    WHERE a.tenant_id = $1::uuid
+      AND ($2::uuid IS NULL OR a.unit_id = $2)
+      AND aps.professional_id = $3::uuid
+      AND aps.appointment_id IS DISTINCT FROM $4::uuid
+      AND aps.active
+      AND aps.start_time < $5::timestamptz
+      AND aps.end_time > $6::timestamptz
 ) as has_conflict
 `
 
 type CheckAppointmentConflictParams struct {
 	TenantID       pgtype.UUID        `json:"tenant_id"`
+	UnitID         pgtype.UUID        `json:"unit_id"`
 	ProfessionalID pgtype.UUID        `json:"professional_id"`
-	ID             pgtype.UUID        `json:"id"`
+	ExcludeID      pgtype.UUID        `json:"exclude_id"`
 	EndTime        pgtype.Timestamptz `json:"end_time"`
 	StartTime      pgtype.Timestamptz `json:"start_time"`
-	UnitID         pgtype.UUID        `json:"unit_id"`
 }
 
-// Verifica conflito com agendamentos existentes
-// Parâmetros: tenant_id, professional_id, exclude_id, start_time, end_time
+// Verifica conflito com agendamentos existentes: o profissional só fica
+// ocupado no horário dos serviços que executa
 func (q *Queries) CheckAppointmentConflict(ctx context.Context, arg CheckAppointmentConflictParams) (bool, error) {
 	row := q.db.QueryRow(ctx, checkAppointmentConflict,
 		arg.TenantID,
+		arg.UnitID,
 		arg.ProfessionalID,
-		arg.ID,
+		arg.ExcludeID,
 		arg.EndTime,
 		arg.StartTime,
-		arg.UnitID,
 	)
 	var has_conflict bool
 	err := row.Scan(&has_conflict)
@@ -54,7 +55,7 @@ const checkBlockedTimeConflictForAppointment = `-- name: CheckBlockedTimeConflic
 SELECT EXISTS (
     SELECT 1 FROM blocked_times
     WHERE tenant_id = $1::uuid
-      AND ($2::uuid IS NULL OR unit_id = $2)
+      AND ($2::uuid IS NULL OR unit_id IS NULL OR unit_id = $2)
       AND professional_id = $3::uuid
       AND start_time < $4::timestamptz
       AND end_time > $5::timestamptz
@@ -69,7 +70,9 @@ type CheckBlockedTimeConflictForAppointmentParams struct {
 	StartTime      pgtype.Timestamptz `json:"start_time"`
 }
 
-// Verifica se há conflito com horários bloqueados (blocked_times)
+// Verifica se há conflito com horários bloqueados (blocked_times).
+// Bloqueio sem unidade (criado pela agenda ou importado do calendário externo)
+// vale para todas as unidades do profissional.
 func (q *Queries) CheckBlockedTimeConflictForAppointment(ctx context.Context, arg CheckBlockedTimeConflictForAppointmentParams) (bool, error) {
 	row := q.db.QueryRow(ctx, checkBlockedTimeConflictForAppointment,
 		arg.TenantID,
@@ -128,18 +131,19 @@ func (q *Queries) CheckInAppointment(ctx context.Context, arg CheckInAppointment
 
 const checkMinimumIntervalConflict = `-- name: CheckMinimumIntervalConflict :one
 SELECT EXISTS (
-    SELECT 1 FROM appointments
-    WHERE tenant_id = $1::uuid
-      AND ($2::uuid IS NULL OR unit_id = $2)
-      AND professional_id = $3::uuid
-      AND id != $4::uuid
-      AND status NOT IN ('CANCELED', 'NO_SHOW')
+    SELECT 1 FROM appointment_services aps
+    JOIN appointments a ON a.id = aps.appointment_id
+    WHERE a.tenant_id = $1::uuid
+      AND ($2::uuid IS NULL OR a.unit_id = $2)
+      AND aps.professional_id = $3::uuid
+      AND aps.appointment_id IS DISTINCT FROM $4::uuid
+      AND aps.active
       AND (
-          -- Agendamento existente termina menos de X minutos antes do novo início
-          (end_time > $5::timestamptz - ($6::int * interval '1 minute') AND end_time <= $5::timestamptz)
+          -- Serviço existente termina menos de X minutos antes do novo início
+          (aps.end_time > $5::timestamptz - ($6::int * interval '1 minute') AND aps.end_time <= $5::timestamptz)
           OR
-          -- Novo agendamento termina menos de X minutos antes do início existente
-          ($7::timestamptz > start_time - ($6::int * interval '1 minute') AND $7::timestamptz <= start_time)
+          -- Novo trecho termina menos de X minutos antes do início existente
+          ($7::timestamptz > aps.start_time - ($6::int * interval '1 minute') AND $7::timestamptz <= aps.start_time)
       )
 ) as has_interval_conflict
 `
@@ -219,7 +223,10 @@ SELECT COUNT(*)
 FROM appointments a
 WHERE a.tenant_id = $1
   AND ($7::uuid IS NULL OR a.unit_id = $7)
-  AND ($2::uuid IS NULL OR a.professional_id = $2)
+  AND ($2::uuid IS NULL OR a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND ($3::uuid IS NULL OR a.customer_id = $3)
   AND (COALESCE(array_length($4::text[], 1), 0) = 0 OR a.status = ANY($4::text[]))
   AND ($5::timestamptz IS NULL OR a.start_time >= $5)
@@ -358,17 +365,27 @@ INSERT INTO appointment_services (
     appointment_id,
     service_id,
     price_at_booking,
-    duration_at_booking
+    duration_at_booking,
+    professional_id,
+    sequence,
+    start_time,
+    end_time,
+    active
 ) VALUES (
-    $1, $2, $3, $4
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
 `
 
 type CreateAppointmentServiceParams struct {
-	AppointmentID     pgtype.UUID     `json:"appointment_id"`
-	ServiceID         pgtype.UUID     `json:"service_id"`
-	PriceAtBooking    decimal.Decimal `json:"price_at_booking"`
-	DurationAtBooking int32           `json:"duration_at_booking"`
+	AppointmentID     pgtype.UUID        `json:"appointment_id"`
+	ServiceID         pgtype.UUID        `json:"service_id"`
+	PriceAtBooking    decimal.Decimal    `json:"price_at_booking"`
+	DurationAtBooking int32              `json:"duration_at_booking"`
+	ProfessionalID    pgtype.UUID        `json:"professional_id"`
+	Sequence          int32              `json:"sequence"`
+	StartTime         pgtype.Timestamptz `json:"start_time"`
+	EndTime           pgtype.Timestamptz `json:"end_time"`
+	Active            bool               `json:"active"`
 }
 
 func (q *Queries) CreateAppointmentService(ctx context.Context, arg CreateAppointmentServiceParams) error {
@@ -377,6 +394,11 @@ func (q *Queries) CreateAppointmentService(ctx context.Context, arg CreateAppoin
 		arg.ServiceID,
 		arg.PriceAtBooking,
 		arg.DurationAtBooking,
+		arg.ProfessionalID,
+		arg.Sequence,
+		arg.StartTime,
+		arg.EndTime,
+		arg.Active,
 	)
 	return err
 }
@@ -548,11 +570,14 @@ func (q *Queries) GetAppointmentByID(ctx context.Context, arg GetAppointmentByID
 
 const getAppointmentServices = `-- name: GetAppointmentServices :many
 SELECT 
-    aps.appointment_id, aps.service_id, aps.price_at_booking, aps.duration_at_booking, aps.created_at,
-    s.nome as service_name
+    aps.appointment_id, aps.service_id, aps.price_at_booking, aps.duration_at_booking, aps.created_at, aps.professional_id, aps.sequence, aps.start_time, aps.end_time, aps.active,
+    s.nome as service_name,
+    p.nome as professional_name
 FROM appointment_services aps
 JOIN servicos s ON s.id = aps.service_id
+JOIN profissionais p ON p.id = aps.professional_id
 WHERE aps.appointment_id = $1
+ORDER BY aps.sequence
 `
 
 type GetAppointmentServicesRow struct {
@@ -561,7 +586,13 @@ type GetAppointmentServicesRow struct {
 	PriceAtBooking    decimal.Decimal    `json:"price_at_booking"`
 	DurationAtBooking int32              `json:"duration_at_booking"`
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	ProfessionalID    pgtype.UUID        `json:"professional_id"`
+	Sequence          int32              `json:"sequence"`
+	StartTime         pgtype.Timestamptz `json:"start_time"`
+	EndTime           pgtype.Timestamptz `json:"end_time"`
+	Active            bool               `json:"active"`
 	ServiceName       string             `json:"service_name"`
+	ProfessionalName  string             `json:"professional_name"`
 }
 
 func (q *Queries) GetAppointmentServices(ctx context.Context, appointmentID pgtype.UUID) ([]GetAppointmentServicesRow, error) {
@@ -579,7 +610,13 @@ func (q *Queries) GetAppointmentServices(ctx context.Context, appointmentID pgty
 			&i.PriceAtBooking,
 			&i.DurationAtBooking,
 			&i.CreatedAt,
+			&i.ProfessionalID,
+			&i.Sequence,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Active,
 			&i.ServiceName,
+			&i.ProfessionalName,
 		); err != nil {
 			return nil, err
 		}
@@ -793,11 +830,17 @@ SELECT
     aps.price_at_booking,
     aps.duration_at_booking,
     aps.created_at,
-    s.nome as service_name
+    aps.professional_id,
+    aps.sequence,
+    aps.start_time,
+    aps.end_time,
+    s.nome as service_name,
+    p.nome as professional_name
 FROM appointment_services aps
 JOIN servicos s ON s.id = aps.service_id
+JOIN profissionais p ON p.id = aps.professional_id
 WHERE aps.appointment_id = ANY($1::uuid[])
-ORDER BY aps.appointment_id, s.nome
+ORDER BY aps.appointment_id, aps.sequence
 `
 
 type GetServicesForAppointmentsRow struct {
@@ -806,7 +849,12 @@ type GetServicesForAppointmentsRow struct {
 	PriceAtBooking    decimal.Decimal    `json:"price_at_booking"`
 	DurationAtBooking int32              `json:"duration_at_booking"`
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	ProfessionalID    pgtype.UUID        `json:"professional_id"`
+	Sequence          int32              `json:"sequence"`
+	StartTime         pgtype.Timestamptz `json:"start_time"`
+	EndTime           pgtype.Timestamptz `json:"end_time"`
 	ServiceName       string             `json:"service_name"`
+	ProfessionalName  string             `json:"professional_name"`
 }
 
 // Busca todos os serviços de múltiplos agendamentos de uma vez (evita N+1)
@@ -825,7 +873,12 @@ func (q *Queries) GetServicesForAppointments(ctx context.Context, dollar_1 []pgt
 			&i.PriceAtBooking,
 			&i.DurationAtBooking,
 			&i.CreatedAt,
+			&i.ProfessionalID,
+			&i.Sequence,
+			&i.StartTime,
+			&i.EndTime,
 			&i.ServiceName,
+			&i.ProfessionalName,
 		); err != nil {
 			return nil, err
 		}
@@ -887,7 +940,10 @@ JOIN profissionais p ON p.id = a.professional_id
 JOIN clientes c ON c.id = a.customer_id
 WHERE a.tenant_id = $1
   AND ($9::uuid IS NULL OR a.unit_id = $9)
-  AND ($2::uuid IS NULL OR a.professional_id = $2)
+  AND ($2::uuid IS NULL OR a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND ($3::uuid IS NULL OR a.customer_id = $3)
   AND (COALESCE(array_length($4::text[], 1), 0) = 0 OR a.status = ANY($4::text[]))
   AND ($5::timestamptz IS NULL OR a.start_time >= $5)
@@ -1083,7 +1139,10 @@ JOIN profissionais p ON p.id = a.professional_id
 JOIN clientes c ON c.id = a.customer_id
 WHERE a.tenant_id = $1
   AND ($5::uuid IS NULL OR a.unit_id = $5)
-  AND a.professional_id = $2
+  AND (a.professional_id = $2 OR EXISTS (
+      SELECT 1 FROM appointment_services aps
+      WHERE aps.appointment_id = a.id AND aps.professional_id = $2
+  ))
   AND a.start_time >= $3
   AND a.start_time < $4
   AND a.status NOT IN ('CANCELED')
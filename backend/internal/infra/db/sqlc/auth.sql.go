@@ -11,6 +11,128 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const acceptUserInvitation = `-- name: AcceptUserInvitation :execrows
+UPDATE user_invitations
+SET accepted_at = NOW(), accepted_user_id = $2
+WHERE id = $1 AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > NOW()
+`
+
+type AcceptUserInvitationParams struct {
+	ID             pgtype.UUID `json:"id"`
+	AcceptedUserID pgtype.UUID `json:"accepted_user_id"`
+}
+
+func (q *Queries) AcceptUserInvitation(ctx context.Context, arg AcceptUserInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, acceptUserInvitation, arg.ID, arg.AcceptedUserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const consumePasswordResetToken = `-- name: ConsumePasswordResetToken :one
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE token_hash = $1 AND used_at IS NULL AND expires_at > NOW()
+RETURNING user_id
+`
+
+// Marca o token como usado e retorna o usuário; sem linha = inválido, usado ou expirado
+func (q *Queries) ConsumePasswordResetToken(ctx context.Context, tokenHash string) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, consumePasswordResetToken, tokenHash)
+	var user_id pgtype.UUID
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const countRecentPasswordResets = `-- name: CountRecentPasswordResets :one
+
+SELECT COUNT(*) FROM password_reset_tokens
+WHERE user_id = $1 AND created_at >= $1
+`
+
+type CountRecentPasswordResetsParams struct {
+	UserID pgtype.UUID        `json:"user_id"`
+	Desde  pgtype.Timestamptz `json:"desde"`
+}
+
+// ============================================================================
+// RECUPERAÇÃO DE SENHA (tokens armazenados como hash SHA-256)
+// ============================================================================
+func (q *Queries) CountRecentPasswordResets(ctx context.Context, arg CountRecentPasswordResetsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecentPasswordResets, arg.UserID, arg.Desde)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createAuthSession = `-- name: CreateAuthSession :one
+
+INSERT INTO auth_sessions (user_id, tenant_id, user_agent, ip_address, expires_at, unit_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, user_id, tenant_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at, revoked_reason, unit_id
+`
+
+type CreateAuthSessionParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	TenantID  pgtype.UUID        `json:"tenant_id"`
+	UserAgent *string            `json:"user_agent"`
+	IpAddress *string            `json:"ip_address"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UnitID    pgtype.UUID        `json:"unit_id"`
+}
+
+// ============================================================================
+// SESSÕES
+// ============================================================================
+func (q *Queries) CreateAuthSession(ctx context.Context, arg CreateAuthSessionParams) (AuthSession, error) {
+	row := q.db.QueryRow(ctx, createAuthSession,
+		arg.UserID,
+		arg.TenantID,
+		arg.UserAgent,
+		arg.IpAddress,
+		arg.ExpiresAt,
+		arg.UnitID,
+	)
+	var i AuthSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TenantID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.RevokedReason,
+		&i.UnitID,
+	)
+	return i, err
+}
+
+const createPasswordResetToken = `-- name: CreatePasswordResetToken :exec
+INSERT INTO password_reset_tokens (user_id, token_hash, ip_address, expires_at)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreatePasswordResetTokenParams struct {
+	UserID    pgtype.UUID        `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	IpAddress *string            `json:"ip_address"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error {
+	_, err := q.db.Exec(ctx, createPasswordResetToken,
+		arg.UserID,
+		arg.TokenHash,
+		arg.IpAddress,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (tenant_id, nome, email, password_hash, role, ativo)
 VALUES ($1, $2, $3, $4, $5, $6)
@@ -64,6 +186,69 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (CreateU
 	return i, err
 }
 
+const createUserInvitation = `-- name: CreateUserInvitation :one
+INSERT INTO user_invitations (tenant_id, unit_id, email, nome, role, token_hash, invited_by, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id, tenant_id, unit_id, email, nome, role, token_hash, invited_by, created_at, expires_at, accepted_at, accepted_user_id, revoked_at
+`
+
+type CreateUserInvitationParams struct {
+	TenantID  pgtype.UUID        `json:"tenant_id"`
+	UnitID    pgtype.UUID        `json:"unit_id"`
+	Email     string             `json:"email"`
+	Nome      string             `json:"nome"`
+	Role      string             `json:"role"`
+	TokenHash string             `json:"token_hash"`
+	InvitedBy pgtype.UUID        `json:"invited_by"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+}
+
+func (q *Queries) CreateUserInvitation(ctx context.Context, arg CreateUserInvitationParams) (UserInvitation, error) {
+	row := q.db.QueryRow(ctx, createUserInvitation,
+		arg.TenantID,
+		arg.UnitID,
+		arg.Email,
+		arg.Nome,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i UserInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UnitID,
+		&i.Email,
+		&i.Nome,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+		&i.AcceptedAt,
+		&i.AcceptedUserID,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const definirBloqueioLogin = `-- name: DefinirBloqueioLogin :exec
+UPDATE auth_login_throttle
+SET bloqueado_ate = $2
+WHERE chave = $1
+`
+
+type DefinirBloqueioLoginParams struct {
+	Chave        string             `json:"chave"`
+	BloqueadoAte pgtype.Timestamptz `json:"bloqueado_ate"`
+}
+
+func (q *Queries) DefinirBloqueioLogin(ctx context.Context, arg DefinirBloqueioLoginParams) error {
+	_, err := q.db.Exec(ctx, definirBloqueioLogin, arg.Chave, arg.BloqueadoAte)
+	return err
+}
+
 const deleteExpiredRefreshTokens = `-- name: DeleteExpiredRefreshTokens :exec
 DELETE FROM refresh_tokens
 WHERE expires_at < NOW()
@@ -74,32 +259,145 @@ func (q *Queries) DeleteExpiredRefreshTokens(ctx context.Context) error {
 	return err
 }
 
-const deleteRefreshToken = `-- name: DeleteRefreshToken :exec
-DELETE FROM refresh_tokens
-WHERE token = $1
+const getAuthSession = `-- name: GetAuthSession :one
+SELECT id, user_id, tenant_id, user_agent, ip_address, created_at, last_used_at, expires_at, revoked_at, revoked_reason, unit_id FROM auth_sessions
+WHERE id = $1 AND tenant_id = $2
+LIMIT 1
 `
 
-func (q *Queries) DeleteRefreshToken(ctx context.Context, token string) error {
-	_, err := q.db.Exec(ctx, deleteRefreshToken, token)
-	return err
+type GetAuthSessionParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID pgtype.UUID `json:"tenant_id"`
 }
 
-const getRefreshToken = `-- name: GetRefreshToken :one
-SELECT id, user_id, token, expires_at, created_at
-FROM refresh_tokens
-WHERE token = $1 AND expires_at > NOW()
+func (q *Queries) GetAuthSession(ctx context.Context, arg GetAuthSessionParams) (AuthSession, error) {
+	row := q.db.QueryRow(ctx, getAuthSession, arg.ID, arg.TenantID)
+	var i AuthSession
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.TenantID,
+		&i.UserAgent,
+		&i.IpAddress,
+		&i.CreatedAt,
+		&i.LastUsedAt,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.RevokedReason,
+		&i.UnitID,
+	)
+	return i, err
+}
+
+const getLoginThrottle = `-- name: GetLoginThrottle :one
+
+SELECT chave, falhas, ultima_falha, bloqueado_ate FROM auth_login_throttle
+WHERE chave = $1
+`
+
+// ============================================================================
+// BLOQUEIO DE TENTATIVAS DE LOGIN
+// ============================================================================
+func (q *Queries) GetLoginThrottle(ctx context.Context, chave string) (AuthLoginThrottle, error) {
+	row := q.db.QueryRow(ctx, getLoginThrottle, chave)
+	var i AuthLoginThrottle
+	err := row.Scan(
+		&i.Chave,
+		&i.Falhas,
+		&i.UltimaFalha,
+		&i.BloqueadoAte,
+	)
+	return i, err
+}
+
+const getPendingInvitationByHash = `-- name: GetPendingInvitationByHash :one
+SELECT
+    i.id,
+    i.tenant_id,
+    i.unit_id,
+    i.email,
+    i.nome,
+    i.role,
+    i.expires_at,
+    t.nome AS tenant_nome,
+    un.nome AS unit_nome
+FROM user_invitations i
+JOIN tenants t ON t.id = i.tenant_id
+JOIN units un ON un.id = i.unit_id
+WHERE i.token_hash = $1
+  AND i.accepted_at IS NULL
+  AND i.revoked_at IS NULL
+  AND i.expires_at > NOW()
 LIMIT 1
 `
 
-func (q *Queries) GetRefreshToken(ctx context.Context, token string) (RefreshToken, error) {
-	row := q.db.QueryRow(ctx, getRefreshToken, token)
-	var i RefreshToken
+type GetPendingInvitationByHashRow struct {
+	ID         pgtype.UUID        `json:"id"`
+	TenantID   pgtype.UUID        `json:"tenant_id"`
+	UnitID     pgtype.UUID        `json:"unit_id"`
+	Email      string             `json:"email"`
+	Nome       string             `json:"nome"`
+	Role       string             `json:"role"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	TenantNome string             `json:"tenant_nome"`
+	UnitNome   string             `json:"unit_nome"`
+}
+
+// Convite ainda aceitável, com os nomes do tenant e da unidade para exibição
+func (q *Queries) GetPendingInvitationByHash(ctx context.Context, tokenHash string) (GetPendingInvitationByHashRow, error) {
+	row := q.db.QueryRow(ctx, getPendingInvitationByHash, tokenHash)
+	var i GetPendingInvitationByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UnitID,
+		&i.Email,
+		&i.Nome,
+		&i.Role,
+		&i.ExpiresAt,
+		&i.TenantNome,
+		&i.UnitNome,
+	)
+	return i, err
+}
+
+const getRefreshTokenByHash = `-- name: GetRefreshTokenByHash :one
+SELECT
+    rt.id,
+    rt.user_id,
+    rt.session_id,
+    rt.expires_at,
+    rt.used_at,
+    s.revoked_at AS session_revoked_at,
+    s.unit_id AS session_unit_id
+FROM refresh_tokens rt
+JOIN auth_sessions s ON s.id = rt.session_id
+WHERE rt.token_hash = $1
+LIMIT 1
+`
+
+type GetRefreshTokenByHashRow struct {
+	ID               pgtype.UUID        `json:"id"`
+	UserID           pgtype.UUID        `json:"user_id"`
+	SessionID        pgtype.UUID        `json:"session_id"`
+	ExpiresAt        pgtype.Timestamptz `json:"expires_at"`
+	UsedAt           pgtype.Timestamptz `json:"used_at"`
+	SessionRevokedAt pgtype.Timestamptz `json:"session_revoked_at"`
+	SessionUnitID    pgtype.UUID        `json:"session_unit_id"`
+}
+
+// Retorna também tokens usados/expirados: o use case decide (reuso x expiração)
+func (q *Queries) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (GetRefreshTokenByHashRow, error) {
+	row := q.db.QueryRow(ctx, getRefreshTokenByHash, tokenHash)
+	var i GetRefreshTokenByHashRow
 	err := row.Scan(
 		&i.ID,
 		&i.UserID,
-		&i.Token,
+		&i.SessionID,
 		&i.ExpiresAt,
-		&i.CreatedAt,
+		&i.UsedAt,
+		&i.SessionRevokedAt,
+		&i.SessionUnitID,
 	)
 	return i, err
 }
@@ -180,19 +478,402 @@ func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (GetUserByIDR
 	return i, err
 }
 
+const invalidatePasswordResetTokens = `-- name: InvalidatePasswordResetTokens :exec
+UPDATE password_reset_tokens
+SET used_at = NOW()
+WHERE user_id = $1 AND used_at IS NULL
+`
+
+// Um novo pedido (ou a troca de senha) invalida os links anteriores
+func (q *Queries) InvalidatePasswordResetTokens(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, invalidatePasswordResetTokens, userID)
+	return err
+}
+
+const limparLoginThrottle = `-- name: LimparLoginThrottle :exec
+DELETE FROM auth_login_throttle
+WHERE chave = $1
+`
+
+func (q *Queries) LimparLoginThrottle(ctx context.Context, chave string) error {
+	_, err := q.db.Exec(ctx, limparLoginThrottle, chave)
+	return err
+}
+
+const listActiveAuthSessions = `-- name: ListActiveAuthSessions :many
+SELECT
+    s.id,
+    s.user_id,
+    s.user_agent,
+    s.ip_address,
+    s.created_at,
+    s.last_used_at,
+    s.expires_at,
+    u.nome AS user_nome,
+    u.email AS user_email
+FROM auth_sessions s
+JOIN users u ON u.id = s.user_id
+WHERE s.tenant_id = $1
+  AND ($2::uuid IS NULL OR s.user_id = $2)
+  AND s.revoked_at IS NULL
+  AND s.expires_at > NOW()
+ORDER BY s.last_used_at DESC
+`
+
+type ListActiveAuthSessionsParams struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	UserID   pgtype.UUID `json:"user_id"`
+}
+
+type ListActiveAuthSessionsRow struct {
+	ID         pgtype.UUID        `json:"id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	UserAgent  *string            `json:"user_agent"`
+	IpAddress  *string            `json:"ip_address"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt pgtype.Timestamptz `json:"last_used_at"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	UserNome   string             `json:"user_nome"`
+	UserEmail  string             `json:"user_email"`
+}
+
+// Sessões ativas do tenant; user_id NULL lista todos os usuários
+func (q *Queries) ListActiveAuthSessions(ctx context.Context, arg ListActiveAuthSessionsParams) ([]ListActiveAuthSessionsRow, error) {
+	rows, err := q.db.Query(ctx, listActiveAuthSessions, arg.TenantID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveAuthSessionsRow{}
+	for rows.Next() {
+		var i ListActiveAuthSessionsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.UserAgent,
+			&i.IpAddress,
+			&i.CreatedAt,
+			&i.LastUsedAt,
+			&i.ExpiresAt,
+			&i.UserNome,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserInvitations = `-- name: ListUserInvitations :many
+SELECT id, tenant_id, unit_id, email, nome, role, token_hash, invited_by, created_at, expires_at, accepted_at, accepted_user_id, revoked_at FROM user_invitations
+WHERE tenant_id = $1
+ORDER BY created_at DESC
+LIMIT 200
+`
+
+func (q *Queries) ListUserInvitations(ctx context.Context, tenantID pgtype.UUID) ([]UserInvitation, error) {
+	rows, err := q.db.Query(ctx, listUserInvitations, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserInvitation{}
+	for rows.Next() {
+		var i UserInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UnitID,
+			&i.Email,
+			&i.Nome,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.CreatedAt,
+			&i.ExpiresAt,
+			&i.AcceptedAt,
+			&i.AcceptedUserID,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserUnitRoles = `-- name: ListUserUnitRoles :many
+SELECT
+    (CASE
+        WHEN UPPER(u.role) = 'OWNER' THEN u.role
+        WHEN tr.id IS NOT NULL THEN tr.base_role
+        ELSE COALESCE(NULLIF(uu.role_override, ''), u.role)
+    END)::text AS role
+FROM user_units uu
+JOIN units un ON un.id = uu.unit_id
+JOIN users u ON u.id = uu.user_id
+LEFT JOIN tenant_roles tr ON tr.id = uu.custom_role_id AND tr.tenant_id = u.tenant_id
+WHERE uu.user_id = $1
+  AND un.tenant_id = u.tenant_id
+  AND un.ativa = true
+`
+
+// Papel efetivo do usuário em cada unidade ativa (mesma regra de ResolveUserUnit)
+func (q *Queries) ListUserUnitRoles(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUserUnitRoles, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markRefreshTokenUsed = `-- name: MarkRefreshTokenUsed :execrows
+UPDATE refresh_tokens
+SET used_at = NOW()
+WHERE id = $1 AND used_at IS NULL
+`
+
+func (q *Queries) MarkRefreshTokenUsed(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, markRefreshTokenUsed, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const registrarFalhaLogin = `-- name: RegistrarFalhaLogin :one
+INSERT INTO auth_login_throttle (chave, falhas, ultima_falha)
+VALUES ($1, 1, NOW())
+ON CONFLICT (chave) DO UPDATE
+SET falhas = CASE
+        WHEN auth_login_throttle.ultima_falha < $2 THEN 1
+        ELSE auth_login_throttle.falhas + 1
+    END,
+    ultima_falha = NOW()
+RETURNING falhas
+`
+
+type RegistrarFalhaLoginParams struct {
+	Chave        string             `json:"chave"`
+	JanelaInicio pgtype.Timestamptz `json:"janela_inicio"`
+}
+
+// Falhas anteriores a janela_inicio não contam: o contador recomeça
+func (q *Queries) RegistrarFalhaLogin(ctx context.Context, arg RegistrarFalhaLoginParams) (int32, error) {
+	row := q.db.QueryRow(ctx, registrarFalhaLogin, arg.Chave, arg.JanelaInicio)
+	var falhas int32
+	err := row.Scan(&falhas)
+	return falhas, err
+}
+
+const resolveUserUnit = `-- name: ResolveUserUnit :one
+SELECT
+    uu.unit_id,
+    (CASE
+        WHEN UPPER(u.role) = 'OWNER' THEN u.role
+        WHEN tr.id IS NOT NULL THEN tr.base_role
+        ELSE COALESCE(NULLIF(uu.role_override, ''), u.role)
+    END)::text AS role,
+    (CASE WHEN UPPER(u.role) = 'OWNER' THEN NULL ELSE tr.id END)::uuid AS custom_role_id,
+    tr.permissoes AS custom_permissoes
+FROM user_units uu
+JOIN units un ON un.id = uu.unit_id
+JOIN users u ON u.id = uu.user_id
+LEFT JOIN tenant_roles tr ON tr.id = uu.custom_role_id AND tr.tenant_id = u.tenant_id
+WHERE uu.user_id = $1
+  AND un.tenant_id = u.tenant_id
+  AND un.ativa = true
+  AND ($2::uuid IS NULL OR uu.unit_id = $2)
+ORDER BY uu.is_default DESC, un.is_matriz DESC, un.nome
+LIMIT 1
+`
+
+type ResolveUserUnitParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	UnitID pgtype.UUID `json:"unit_id"`
+}
+
+type ResolveUserUnitRow struct {
+	UnitID           pgtype.UUID `json:"unit_id"`
+	Role             string      `json:"role"`
+	CustomRoleID     pgtype.UUID `json:"custom_role_id"`
+	CustomPermissoes []string    `json:"custom_permissoes"`
+}
+
+// Unidade e papel efetivo do usuário nela. unit_id NULL escolhe a unidade padrão
+// (ou a primeira ativa). O papel personalizado (custom_role_id) e depois o papel
+// da unidade (role_override) prevalecem sobre users.role, exceto para o dono,
+// que mantém acesso total em todas as unidades.
+func (q *Queries) ResolveUserUnit(ctx context.Context, arg ResolveUserUnitParams) (ResolveUserUnitRow, error) {
+	row := q.db.QueryRow(ctx, resolveUserUnit, arg.UserID, arg.UnitID)
+	var i ResolveUserUnitRow
+	err := row.Scan(
+		&i.UnitID,
+		&i.Role,
+		&i.CustomRoleID,
+		&i.CustomPermissoes,
+	)
+	return i, err
+}
+
+const revokeAuthSession = `-- name: RevokeAuthSession :execrows
+UPDATE auth_sessions
+SET revoked_at = NOW(), revoked_reason = $2
+WHERE id = $1 AND revoked_at IS NULL
+`
+
+type RevokeAuthSessionParams struct {
+	ID            pgtype.UUID `json:"id"`
+	RevokedReason *string     `json:"revoked_reason"`
+}
+
+func (q *Queries) RevokeAuthSession(ctx context.Context, arg RevokeAuthSessionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAuthSession, arg.ID, arg.RevokedReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokeOtherAuthSessions = `-- name: RevokeOtherAuthSessions :execrows
+UPDATE auth_sessions
+SET revoked_at = NOW(), revoked_reason = 'REVOGADA'
+WHERE user_id = $1 AND id IS DISTINCT FROM $2 AND revoked_at IS NULL
+`
+
+type RevokeOtherAuthSessionsParams struct {
+	UserID pgtype.UUID `json:"user_id"`
+	ID     pgtype.UUID `json:"id"`
+}
+
+func (q *Queries) RevokeOtherAuthSessions(ctx context.Context, arg RevokeOtherAuthSessionsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeOtherAuthSessions, arg.UserID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const revokePendingInvitationsByEmail = `-- name: RevokePendingInvitationsByEmail :exec
+
+UPDATE user_invitations
+SET revoked_at = NOW()
+WHERE tenant_id = $1 AND LOWER(email) = LOWER($1)
+  AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokePendingInvitationsByEmailParams struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	Email    string      `json:"email"`
+}
+
+// ============================================================================
+// CONVITES DE COLABORADORES
+// ============================================================================
+func (q *Queries) RevokePendingInvitationsByEmail(ctx context.Context, arg RevokePendingInvitationsByEmailParams) error {
+	_, err := q.db.Exec(ctx, revokePendingInvitationsByEmail, arg.TenantID, arg.Email)
+	return err
+}
+
+const revokeUserInvitation = `-- name: RevokeUserInvitation :execrows
+UPDATE user_invitations
+SET revoked_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND accepted_at IS NULL AND revoked_at IS NULL
+`
+
+type RevokeUserInvitationParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID pgtype.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) RevokeUserInvitation(ctx context.Context, arg RevokeUserInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeUserInvitation, arg.ID, arg.TenantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const saveRefreshToken = `-- name: SaveRefreshToken :exec
-INSERT INTO refresh_tokens (user_id, token, expires_at)
-VALUES ($1, $2, $3)
+
+INSERT INTO refresh_tokens (user_id, session_id, token_hash, expires_at)
+VALUES ($1, $2, $3, $4)
 `
 
 type SaveRefreshTokenParams struct {
 	UserID    pgtype.UUID        `json:"user_id"`
-	Token     string             `json:"token"`
+	SessionID pgtype.UUID        `json:"session_id"`
+	TokenHash string             `json:"token_hash"`
 	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
 }
 
+// ============================================================================
+// REFRESH TOKENS (armazenados como hash SHA-256)
+// ============================================================================
 func (q *Queries) SaveRefreshToken(ctx context.Context, arg SaveRefreshTokenParams) error {
-	_, err := q.db.Exec(ctx, saveRefreshToken, arg.UserID, arg.Token, arg.ExpiresAt)
+	_, err := q.db.Exec(ctx, saveRefreshToken,
+		arg.UserID,
+		arg.SessionID,
+		arg.TokenHash,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const setAuthSessionUnit = `-- name: SetAuthSessionUnit :exec
+UPDATE auth_sessions
+SET unit_id = $2
+WHERE id = $1
+`
+
+type SetAuthSessionUnitParams struct {
+	ID     pgtype.UUID `json:"id"`
+	UnitID pgtype.UUID `json:"unit_id"`
+}
+
+// Unidade ativa da sessão (login, troca de unidade e refresh)
+func (q *Queries) SetAuthSessionUnit(ctx context.Context, arg SetAuthSessionUnitParams) error {
+	_, err := q.db.Exec(ctx, setAuthSessionUnit, arg.ID, arg.UnitID)
+	return err
+}
+
+const touchAuthSession = `-- name: TouchAuthSession :exec
+UPDATE auth_sessions
+SET last_used_at = NOW(), expires_at = $2, ip_address = $3
+WHERE id = $1
+`
+
+type TouchAuthSessionParams struct {
+	ID        pgtype.UUID        `json:"id"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	IpAddress *string            `json:"ip_address"`
+}
+
+func (q *Queries) TouchAuthSession(ctx context.Context, arg TouchAuthSessionParams) error {
+	_, err := q.db.Exec(ctx, touchAuthSession,
+		arg.ID,
+		arg.ExpiresAt,
+		arg.IpAddress,
+	)
 	return err
 }
 
@@ -206,3 +887,19 @@ func (q *Queries) UpdateLastLogin(ctx context.Context, id pgtype.UUID) error {
 	_, err := q.db.Exec(ctx, updateLastLogin, id)
 	return err
 }
+
+const updateUserPassword = `-- name: UpdateUserPassword :exec
+UPDATE users
+SET password_hash = $2, atualizado_em = NOW()
+WHERE id = $1
+`
+
+type UpdateUserPasswordParams struct {
+	ID           pgtype.UUID `json:"id"`
+	PasswordHash string      `json:"password_hash"`
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, arg.ID, arg.PasswordHash)
+	return err
+}
@@ -620,6 +620,87 @@ func (q *Queries) ListActiveBarbersTurnList(ctx context.Context, tenantID pgtype
 	return items, nil
 }
 
+const listActiveBarbersTurnListByUnit = `-- name: ListActiveBarbersTurnListByUnit :many
+SELECT 
+    btl.id, btl.tenant_id, btl.professional_id, btl.current_points, btl.last_turn_at, btl.is_active, btl.created_at, btl.updated_at,
+    p.nome as professional_name,
+    p.tipo as professional_type,
+    p.status as professional_status,
+    p.foto as professional_photo,
+    ROW_NUMBER() OVER (
+        ORDER BY 
+            btl.current_points ASC,
+            btl.last_turn_at ASC NULLS FIRST,
+            btl.created_at ASC
+    ) as position
+FROM barbers_turn_list btl
+JOIN profissionais p ON p.id = btl.professional_id
+WHERE btl.tenant_id = $1
+  AND p.unit_id = $2
+  AND btl.is_active = true
+  AND p.status = 'ATIVO'
+ORDER BY 
+    btl.current_points ASC,
+    btl.last_turn_at ASC NULLS FIRST,
+    btl.created_at ASC
+`
+
+type ListActiveBarbersTurnListByUnitParams struct {
+	TenantID pgtype.UUID `json:"tenant_id"`
+	UnitID   pgtype.UUID `json:"unit_id"`
+}
+
+type ListActiveBarbersTurnListByUnitRow struct {
+	ID                 pgtype.UUID        `json:"id"`
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	ProfessionalID     pgtype.UUID        `json:"professional_id"`
+	CurrentPoints      int32              `json:"current_points"`
+	LastTurnAt         pgtype.Timestamptz `json:"last_turn_at"`
+	IsActive           bool               `json:"is_active"`
+	CreatedAt          pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt          pgtype.Timestamptz `json:"updated_at"`
+	ProfessionalName   string             `json:"professional_name"`
+	ProfessionalType   string             `json:"professional_type"`
+	ProfessionalStatus *string            `json:"professional_status"`
+	ProfessionalPhoto  *string            `json:"professional_photo"`
+	Position           int64              `json:"position"`
+}
+
+// Lista os barbeiros ativos na fila que atendem na unidade
+func (q *Queries) ListActiveBarbersTurnListByUnit(ctx context.Context, arg ListActiveBarbersTurnListByUnitParams) ([]ListActiveBarbersTurnListByUnitRow, error) {
+	rows, err := q.db.Query(ctx, listActiveBarbersTurnListByUnit, arg.TenantID, arg.UnitID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActiveBarbersTurnListByUnitRow{}
+	for rows.Next() {
+		var i ListActiveBarbersTurnListByUnitRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.ProfessionalID,
+			&i.CurrentPoints,
+			&i.LastTurnAt,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ProfessionalName,
+			&i.ProfessionalType,
+			&i.ProfessionalStatus,
+			&i.ProfessionalPhoto,
+			&i.Position,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listBarbersTurnList = `-- name: ListBarbersTurnList :many
 SELECT 
     btl.id, btl.tenant_id, btl.professional_id, btl.current_points, btl.last_turn_at, btl.is_active, btl.created_at, btl.updated_at,
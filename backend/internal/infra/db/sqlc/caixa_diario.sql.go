@@ -12,6 +12,59 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const aprovarCaixaDiario = `-- name: AprovarCaixaDiario :one
+UPDATE caixa_diario
+SET
+    status = 'FECHADO',
+    usuario_aprovacao_id = $3,
+    data_aprovacao = $4,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND status = 'AGUARDANDO_APROVACAO'
+RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at, fechamento_cego, contagem, usuario_aprovacao_id, data_aprovacao
+`
+
+type AprovarCaixaDiarioParams struct {
+	ID                 pgtype.UUID        `json:"id"`
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	UsuarioAprovacaoID pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao      pgtype.Timestamptz `json:"data_aprovacao"`
+}
+
+func (q *Queries) AprovarCaixaDiario(ctx context.Context, arg AprovarCaixaDiarioParams) (CaixaDiario, error) {
+	row := q.db.QueryRow(ctx, aprovarCaixaDiario,
+		arg.ID,
+		arg.TenantID,
+		arg.UsuarioAprovacaoID,
+		arg.DataAprovacao,
+	)
+	var i CaixaDiario
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UsuarioAberturaID,
+		&i.UsuarioFechamentoID,
+		&i.DataAbertura,
+		&i.DataFechamento,
+		&i.SaldoInicial,
+		&i.TotalEntradas,
+		&i.TotalSaidas,
+		&i.TotalSangrias,
+		&i.TotalReforcos,
+		&i.SaldoEsperado,
+		&i.SaldoReal,
+		&i.Divergencia,
+		&i.Status,
+		&i.JustificativaDivergencia,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
+	)
+	return i, err
+}
+
 const countCaixaDiarioHistorico = `-- name: CountCaixaDiarioHistorico :one
 SELECT COUNT(*) 
 FROM caixa_diario
@@ -55,10 +108,11 @@ INSERT INTO caixa_diario (
     total_sangrias,
     total_reforcos,
     saldo_esperado,
-    status
+    status,
+    fechamento_cego
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
-) RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at, fechamento_cego, contagem, usuario_aprovacao_id, data_aprovacao
 `
 
 type CreateCaixaDiarioParams struct {
@@ -73,6 +127,7 @@ type CreateCaixaDiarioParams struct {
 	TotalReforcos     decimal.Decimal    `json:"total_reforcos"`
 	SaldoEsperado     decimal.Decimal    `json:"saldo_esperado"`
 	Status            string             `json:"status"`
+	FechamentoCego    bool               `json:"fechamento_cego"`
 }
 
 // =============================================
@@ -93,6 +148,7 @@ func (q *Queries) CreateCaixaDiario(ctx context.Context, arg CreateCaixaDiarioPa
 		arg.TotalReforcos,
 		arg.SaldoEsperado,
 		arg.Status,
+		arg.FechamentoCego,
 	)
 	var i CaixaDiario
 	err := row.Scan(
@@ -114,6 +170,10 @@ func (q *Queries) CreateCaixaDiario(ctx context.Context, arg CreateCaixaDiarioPa
 		&i.JustificativaDivergencia,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
 	)
 	return i, err
 }
@@ -129,22 +189,24 @@ INSERT INTO operacoes_caixa (
     descricao,
     destino,
     origem,
-    usuario_id
+    usuario_id,
+    forma_pagamento
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9
-) RETURNING id, caixa_id, tenant_id, tipo, valor, descricao, destino, origem, usuario_id, created_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, caixa_id, tenant_id, tipo, valor, descricao, destino, origem, usuario_id, created_at, forma_pagamento
 `
 
 type CreateOperacaoCaixaParams struct {
-	ID        pgtype.UUID     `json:"id"`
-	CaixaID   pgtype.UUID     `json:"caixa_id"`
-	TenantID  pgtype.UUID     `json:"tenant_id"`
-	Tipo      string          `json:"tipo"`
-	Valor     decimal.Decimal `json:"valor"`
-	Descricao string          `json:"descricao"`
-	Destino   *string         `json:"destino"`
-	Origem    *string         `json:"origem"`
-	UsuarioID pgtype.UUID     `json:"usuario_id"`
+	ID             pgtype.UUID     `json:"id"`
+	CaixaID        pgtype.UUID     `json:"caixa_id"`
+	TenantID       pgtype.UUID     `json:"tenant_id"`
+	Tipo           string          `json:"tipo"`
+	Valor          decimal.Decimal `json:"valor"`
+	Descricao      string          `json:"descricao"`
+	Destino        *string         `json:"destino"`
+	Origem         *string         `json:"origem"`
+	UsuarioID      pgtype.UUID     `json:"usuario_id"`
+	FormaPagamento *string         `json:"forma_pagamento"`
 }
 
 // =============================================
@@ -161,6 +223,7 @@ func (q *Queries) CreateOperacaoCaixa(ctx context.Context, arg CreateOperacaoCai
 		arg.Destino,
 		arg.Origem,
 		arg.UsuarioID,
+		arg.FormaPagamento,
 	)
 	var i OperacoesCaixa
 	err := row.Scan(
@@ -174,6 +237,7 @@ func (q *Queries) CreateOperacaoCaixa(ctx context.Context, arg CreateOperacaoCai
 		&i.Origem,
 		&i.UsuarioID,
 		&i.CreatedAt,
+		&i.FormaPagamento,
 	)
 	return i, err
 }
@@ -199,11 +263,14 @@ SET
     data_fechamento = $4,
     saldo_real = $5,
     divergencia = $6,
-    status = 'FECHADO',
-    justificativa_divergencia = $7,
+    status = $7,
+    justificativa_divergencia = $8,
+    contagem = $9,
+    usuario_aprovacao_id = $10,
+    data_aprovacao = $11,
     updated_at = NOW()
 WHERE id = $1 AND tenant_id = $2 AND status = 'ABERTO'
-RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at
+RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at, fechamento_cego, contagem, usuario_aprovacao_id, data_aprovacao
 `
 
 type FecharCaixaDiarioParams struct {
@@ -213,7 +280,11 @@ type FecharCaixaDiarioParams struct {
 	DataFechamento           pgtype.Timestamptz `json:"data_fechamento"`
 	SaldoReal                pgtype.Numeric     `json:"saldo_real"`
 	Divergencia              pgtype.Numeric     `json:"divergencia"`
+	Status                   string             `json:"status"`
 	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
 }
 
 func (q *Queries) FecharCaixaDiario(ctx context.Context, arg FecharCaixaDiarioParams) (CaixaDiario, error) {
@@ -224,7 +295,11 @@ func (q *Queries) FecharCaixaDiario(ctx context.Context, arg FecharCaixaDiarioPa
 		arg.DataFechamento,
 		arg.SaldoReal,
 		arg.Divergencia,
+		arg.Status,
 		arg.JustificativaDivergencia,
+		arg.Contagem,
+		arg.UsuarioAprovacaoID,
+		arg.DataAprovacao,
 	)
 	var i CaixaDiario
 	err := row.Scan(
@@ -246,13 +321,17 @@ func (q *Queries) FecharCaixaDiario(ctx context.Context, arg FecharCaixaDiarioPa
 		&i.JustificativaDivergencia,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
 	)
 	return i, err
 }
 
 const getCaixaDiarioAberto = `-- name: GetCaixaDiarioAberto :one
 SELECT 
-    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at,
+    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at, c.fechamento_cego, c.contagem, c.usuario_aprovacao_id, c.data_aprovacao,
     ua.nome as usuario_abertura_nome,
     '' as usuario_fechamento_nome
 FROM caixa_diario c
@@ -280,6 +359,10 @@ type GetCaixaDiarioAbertoRow struct {
 	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
 	CreatedAt                pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	FechamentoCego           bool               `json:"fechamento_cego"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
 	UsuarioAberturaNome      *string            `json:"usuario_abertura_nome"`
 	UsuarioFechamentoNome    string             `json:"usuario_fechamento_nome"`
 }
@@ -306,6 +389,10 @@ func (q *Queries) GetCaixaDiarioAberto(ctx context.Context, tenantID pgtype.UUID
 		&i.JustificativaDivergencia,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
 		&i.UsuarioAberturaNome,
 		&i.UsuarioFechamentoNome,
 	)
@@ -315,7 +402,7 @@ func (q *Queries) GetCaixaDiarioAberto(ctx context.Context, tenantID pgtype.UUID
 const getCaixaDiarioByID = `-- name: GetCaixaDiarioByID :one
 
 SELECT 
-    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at,
+    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at, c.fechamento_cego, c.contagem, c.usuario_aprovacao_id, c.data_aprovacao,
     ua.nome as usuario_abertura_nome,
     COALESCE(uf.nome, '') as usuario_fechamento_nome
 FROM caixa_diario c
@@ -348,6 +435,10 @@ type GetCaixaDiarioByIDRow struct {
 	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
 	CreatedAt                pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	FechamentoCego           bool               `json:"fechamento_cego"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
 	UsuarioAberturaNome      *string            `json:"usuario_abertura_nome"`
 	UsuarioFechamentoNome    string             `json:"usuario_fechamento_nome"`
 }
@@ -375,6 +466,10 @@ func (q *Queries) GetCaixaDiarioByID(ctx context.Context, arg GetCaixaDiarioByID
 		&i.JustificativaDivergencia,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
 		&i.UsuarioAberturaNome,
 		&i.UsuarioFechamentoNome,
 	)
@@ -383,7 +478,7 @@ func (q *Queries) GetCaixaDiarioByID(ctx context.Context, arg GetCaixaDiarioByID
 
 const getLastOperacao = `-- name: GetLastOperacao :one
 SELECT 
-    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at,
+    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at, o.forma_pagamento,
     u.nome as usuario_nome
 FROM operacoes_caixa o
 LEFT JOIN users u ON u.id = o.usuario_id
@@ -398,17 +493,18 @@ type GetLastOperacaoParams struct {
 }
 
 type GetLastOperacaoRow struct {
-	ID          pgtype.UUID        `json:"id"`
-	CaixaID     pgtype.UUID        `json:"caixa_id"`
-	TenantID    pgtype.UUID        `json:"tenant_id"`
-	Tipo        string             `json:"tipo"`
-	Valor       decimal.Decimal    `json:"valor"`
-	Descricao   string             `json:"descricao"`
-	Destino     *string            `json:"destino"`
-	Origem      *string            `json:"origem"`
-	UsuarioID   pgtype.UUID        `json:"usuario_id"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UsuarioNome *string            `json:"usuario_nome"`
+	ID             pgtype.UUID        `json:"id"`
+	CaixaID        pgtype.UUID        `json:"caixa_id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Tipo           string             `json:"tipo"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Descricao      string             `json:"descricao"`
+	Destino        *string            `json:"destino"`
+	Origem         *string            `json:"origem"`
+	UsuarioID      pgtype.UUID        `json:"usuario_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	FormaPagamento *string            `json:"forma_pagamento"`
+	UsuarioNome    *string            `json:"usuario_nome"`
 }
 
 func (q *Queries) GetLastOperacao(ctx context.Context, arg GetLastOperacaoParams) (GetLastOperacaoRow, error) {
@@ -425,15 +521,100 @@ func (q *Queries) GetLastOperacao(ctx context.Context, arg GetLastOperacaoParams
 		&i.Origem,
 		&i.UsuarioID,
 		&i.CreatedAt,
+		&i.FormaPagamento,
 		&i.UsuarioNome,
 	)
 	return i, err
 }
 
+const listCaixaDiarioAguardandoAprovacao = `-- name: ListCaixaDiarioAguardandoAprovacao :many
+SELECT 
+    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at, c.fechamento_cego, c.contagem, c.usuario_aprovacao_id, c.data_aprovacao,
+    ua.nome as usuario_abertura_nome,
+    COALESCE(uf.nome, '') as usuario_fechamento_nome
+FROM caixa_diario c
+LEFT JOIN users ua ON ua.id = c.usuario_abertura_id
+LEFT JOIN users uf ON uf.id = c.usuario_fechamento_id
+WHERE c.tenant_id = $1 AND c.status = 'AGUARDANDO_APROVACAO'
+ORDER BY c.data_fechamento ASC
+`
+
+type ListCaixaDiarioAguardandoAprovacaoRow struct {
+	ID                       pgtype.UUID        `json:"id"`
+	TenantID                 pgtype.UUID        `json:"tenant_id"`
+	UsuarioAberturaID        pgtype.UUID        `json:"usuario_abertura_id"`
+	UsuarioFechamentoID      pgtype.UUID        `json:"usuario_fechamento_id"`
+	DataAbertura             pgtype.Timestamptz `json:"data_abertura"`
+	DataFechamento           pgtype.Timestamptz `json:"data_fechamento"`
+	SaldoInicial             decimal.Decimal    `json:"saldo_inicial"`
+	TotalEntradas            decimal.Decimal    `json:"total_entradas"`
+	TotalSaidas              decimal.Decimal    `json:"total_saidas"`
+	TotalSangrias            decimal.Decimal    `json:"total_sangrias"`
+	TotalReforcos            decimal.Decimal    `json:"total_reforcos"`
+	SaldoEsperado            decimal.Decimal    `json:"saldo_esperado"`
+	SaldoReal                pgtype.Numeric     `json:"saldo_real"`
+	Divergencia              pgtype.Numeric     `json:"divergencia"`
+	Status                   string             `json:"status"`
+	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
+	CreatedAt                pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	FechamentoCego           bool               `json:"fechamento_cego"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
+	UsuarioAberturaNome      *string            `json:"usuario_abertura_nome"`
+	UsuarioFechamentoNome    string             `json:"usuario_fechamento_nome"`
+}
+
+func (q *Queries) ListCaixaDiarioAguardandoAprovacao(ctx context.Context, tenantID pgtype.UUID) ([]ListCaixaDiarioAguardandoAprovacaoRow, error) {
+	rows, err := q.db.Query(ctx, listCaixaDiarioAguardandoAprovacao, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListCaixaDiarioAguardandoAprovacaoRow{}
+	for rows.Next() {
+		var i ListCaixaDiarioAguardandoAprovacaoRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.UsuarioAberturaID,
+			&i.UsuarioFechamentoID,
+			&i.DataAbertura,
+			&i.DataFechamento,
+			&i.SaldoInicial,
+			&i.TotalEntradas,
+			&i.TotalSaidas,
+			&i.TotalSangrias,
+			&i.TotalReforcos,
+			&i.SaldoEsperado,
+			&i.SaldoReal,
+			&i.Divergencia,
+			&i.Status,
+			&i.JustificativaDivergencia,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FechamentoCego,
+			&i.Contagem,
+			&i.UsuarioAprovacaoID,
+			&i.DataAprovacao,
+			&i.UsuarioAberturaNome,
+			&i.UsuarioFechamentoNome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listCaixaDiarioHistorico = `-- name: ListCaixaDiarioHistorico :many
 
 SELECT 
-    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at,
+    c.id, c.tenant_id, c.usuario_abertura_id, c.usuario_fechamento_id, c.data_abertura, c.data_fechamento, c.saldo_inicial, c.total_entradas, c.total_saidas, c.total_sangrias, c.total_reforcos, c.saldo_esperado, c.saldo_real, c.divergencia, c.status, c.justificativa_divergencia, c.created_at, c.updated_at, c.fechamento_cego, c.contagem, c.usuario_aprovacao_id, c.data_aprovacao,
     ua.nome as usuario_abertura_nome,
     COALESCE(uf.nome, '') as usuario_fechamento_nome
 FROM caixa_diario c
@@ -476,6 +657,10 @@ type ListCaixaDiarioHistoricoRow struct {
 	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
 	CreatedAt                pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	FechamentoCego           bool               `json:"fechamento_cego"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
 	UsuarioAberturaNome      *string            `json:"usuario_abertura_nome"`
 	UsuarioFechamentoNome    string             `json:"usuario_fechamento_nome"`
 }
@@ -516,6 +701,10 @@ func (q *Queries) ListCaixaDiarioHistorico(ctx context.Context, arg ListCaixaDia
 			&i.JustificativaDivergencia,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.FechamentoCego,
+			&i.Contagem,
+			&i.UsuarioAprovacaoID,
+			&i.DataAprovacao,
 			&i.UsuarioAberturaNome,
 			&i.UsuarioFechamentoNome,
 		); err != nil {
@@ -531,7 +720,7 @@ func (q *Queries) ListCaixaDiarioHistorico(ctx context.Context, arg ListCaixaDia
 
 const listOperacoesByCaixa = `-- name: ListOperacoesByCaixa :many
 SELECT 
-    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at,
+    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at, o.forma_pagamento,
     u.nome as usuario_nome
 FROM operacoes_caixa o
 LEFT JOIN users u ON u.id = o.usuario_id
@@ -545,17 +734,18 @@ type ListOperacoesByCaixaParams struct {
 }
 
 type ListOperacoesByCaixaRow struct {
-	ID          pgtype.UUID        `json:"id"`
-	CaixaID     pgtype.UUID        `json:"caixa_id"`
-	TenantID    pgtype.UUID        `json:"tenant_id"`
-	Tipo        string             `json:"tipo"`
-	Valor       decimal.Decimal    `json:"valor"`
-	Descricao   string             `json:"descricao"`
-	Destino     *string            `json:"destino"`
-	Origem      *string            `json:"origem"`
-	UsuarioID   pgtype.UUID        `json:"usuario_id"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UsuarioNome *string            `json:"usuario_nome"`
+	ID             pgtype.UUID        `json:"id"`
+	CaixaID        pgtype.UUID        `json:"caixa_id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Tipo           string             `json:"tipo"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Descricao      string             `json:"descricao"`
+	Destino        *string            `json:"destino"`
+	Origem         *string            `json:"origem"`
+	UsuarioID      pgtype.UUID        `json:"usuario_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	FormaPagamento *string            `json:"forma_pagamento"`
+	UsuarioNome    *string            `json:"usuario_nome"`
 }
 
 func (q *Queries) ListOperacoesByCaixa(ctx context.Context, arg ListOperacoesByCaixaParams) ([]ListOperacoesByCaixaRow, error) {
@@ -578,6 +768,7 @@ func (q *Queries) ListOperacoesByCaixa(ctx context.Context, arg ListOperacoesByC
 			&i.Origem,
 			&i.UsuarioID,
 			&i.CreatedAt,
+			&i.FormaPagamento,
 			&i.UsuarioNome,
 		); err != nil {
 			return nil, err
@@ -592,7 +783,7 @@ func (q *Queries) ListOperacoesByCaixa(ctx context.Context, arg ListOperacoesByC
 
 const listOperacoesByCaixaAndTipo = `-- name: ListOperacoesByCaixaAndTipo :many
 SELECT 
-    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at,
+    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at, o.forma_pagamento,
     u.nome as usuario_nome
 FROM operacoes_caixa o
 LEFT JOIN users u ON u.id = o.usuario_id
@@ -607,17 +798,18 @@ type ListOperacoesByCaixaAndTipoParams struct {
 }
 
 type ListOperacoesByCaixaAndTipoRow struct {
-	ID          pgtype.UUID        `json:"id"`
-	CaixaID     pgtype.UUID        `json:"caixa_id"`
-	TenantID    pgtype.UUID        `json:"tenant_id"`
-	Tipo        string             `json:"tipo"`
-	Valor       decimal.Decimal    `json:"valor"`
-	Descricao   string             `json:"descricao"`
-	Destino     *string            `json:"destino"`
-	Origem      *string            `json:"origem"`
-	UsuarioID   pgtype.UUID        `json:"usuario_id"`
-	CreatedAt   pgtype.Timestamptz `json:"created_at"`
-	UsuarioNome *string            `json:"usuario_nome"`
+	ID             pgtype.UUID        `json:"id"`
+	CaixaID        pgtype.UUID        `json:"caixa_id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Tipo           string             `json:"tipo"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Descricao      string             `json:"descricao"`
+	Destino        *string            `json:"destino"`
+	Origem         *string            `json:"origem"`
+	UsuarioID      pgtype.UUID        `json:"usuario_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	FormaPagamento *string            `json:"forma_pagamento"`
+	UsuarioNome    *string            `json:"usuario_nome"`
 }
 
 func (q *Queries) ListOperacoesByCaixaAndTipo(ctx context.Context, arg ListOperacoesByCaixaAndTipoParams) ([]ListOperacoesByCaixaAndTipoRow, error) {
@@ -640,6 +832,71 @@ func (q *Queries) ListOperacoesByCaixaAndTipo(ctx context.Context, arg ListOpera
 			&i.Origem,
 			&i.UsuarioID,
 			&i.CreatedAt,
+			&i.FormaPagamento,
+			&i.UsuarioNome,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOperacoesByPeriodo = `-- name: ListOperacoesByPeriodo :many
+SELECT 
+    o.id, o.caixa_id, o.tenant_id, o.tipo, o.valor, o.descricao, o.destino, o.origem, o.usuario_id, o.created_at, o.forma_pagamento,
+    u.nome as usuario_nome
+FROM operacoes_caixa o
+LEFT JOIN users u ON u.id = o.usuario_id
+WHERE o.tenant_id = $1 AND o.created_at >= $2 AND o.created_at < $3
+ORDER BY o.created_at ASC
+`
+
+type ListOperacoesByPeriodoParams struct {
+	TenantID    pgtype.UUID        `json:"tenant_id"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	CreatedAt_2 pgtype.Timestamptz `json:"created_at_2"`
+}
+
+type ListOperacoesByPeriodoRow struct {
+	ID             pgtype.UUID        `json:"id"`
+	CaixaID        pgtype.UUID        `json:"caixa_id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Tipo           string             `json:"tipo"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Descricao      string             `json:"descricao"`
+	Destino        *string            `json:"destino"`
+	Origem         *string            `json:"origem"`
+	UsuarioID      pgtype.UUID        `json:"usuario_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	FormaPagamento *string            `json:"forma_pagamento"`
+	UsuarioNome    *string            `json:"usuario_nome"`
+}
+
+func (q *Queries) ListOperacoesByPeriodo(ctx context.Context, arg ListOperacoesByPeriodoParams) ([]ListOperacoesByPeriodoRow, error) {
+	rows, err := q.db.Query(ctx, listOperacoesByPeriodo, arg.TenantID, arg.CreatedAt, arg.CreatedAt_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOperacoesByPeriodoRow{}
+	for rows.Next() {
+		var i ListOperacoesByPeriodoRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CaixaID,
+			&i.TenantID,
+			&i.Tipo,
+			&i.Valor,
+			&i.Descricao,
+			&i.Destino,
+			&i.Origem,
+			&i.UsuarioID,
+			&i.CreatedAt,
+			&i.FormaPagamento,
 			&i.UsuarioNome,
 		); err != nil {
 			return nil, err
@@ -652,6 +909,56 @@ func (q *Queries) ListOperacoesByCaixaAndTipo(ctx context.Context, arg ListOpera
 	return items, nil
 }
 
+const reabrirCaixaDiario = `-- name: ReabrirCaixaDiario :one
+UPDATE caixa_diario
+SET
+    status = 'ABERTO',
+    usuario_fechamento_id = NULL,
+    data_fechamento = NULL,
+    saldo_real = NULL,
+    divergencia = NULL,
+    justificativa_divergencia = NULL,
+    contagem = NULL,
+    updated_at = NOW()
+WHERE id = $1 AND tenant_id = $2 AND status = 'AGUARDANDO_APROVACAO'
+RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at, fechamento_cego, contagem, usuario_aprovacao_id, data_aprovacao
+`
+
+type ReabrirCaixaDiarioParams struct {
+	ID       pgtype.UUID `json:"id"`
+	TenantID pgtype.UUID `json:"tenant_id"`
+}
+
+func (q *Queries) ReabrirCaixaDiario(ctx context.Context, arg ReabrirCaixaDiarioParams) (CaixaDiario, error) {
+	row := q.db.QueryRow(ctx, reabrirCaixaDiario, arg.ID, arg.TenantID)
+	var i CaixaDiario
+	err := row.Scan(
+		&i.ID,
+		&i.TenantID,
+		&i.UsuarioAberturaID,
+		&i.UsuarioFechamentoID,
+		&i.DataAbertura,
+		&i.DataFechamento,
+		&i.SaldoInicial,
+		&i.TotalEntradas,
+		&i.TotalSaidas,
+		&i.TotalSangrias,
+		&i.TotalReforcos,
+		&i.SaldoEsperado,
+		&i.SaldoReal,
+		&i.Divergencia,
+		&i.Status,
+		&i.JustificativaDivergencia,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
+	)
+	return i, err
+}
+
 const sumOperacoesByTipo = `-- name: SumOperacoesByTipo :many
 SELECT 
     tipo,
@@ -702,7 +1009,7 @@ SET
     saldo_esperado = $7,
     updated_at = NOW()
 WHERE id = $1 AND tenant_id = $2
-RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at
+RETURNING id, tenant_id, usuario_abertura_id, usuario_fechamento_id, data_abertura, data_fechamento, saldo_inicial, total_entradas, total_saidas, total_sangrias, total_reforcos, saldo_esperado, saldo_real, divergencia, status, justificativa_divergencia, created_at, updated_at, fechamento_cego, contagem, usuario_aprovacao_id, data_aprovacao
 `
 
 type UpdateCaixaDiarioParams struct {
@@ -746,6 +1053,10 @@ func (q *Queries) UpdateCaixaDiario(ctx context.Context, arg UpdateCaixaDiarioPa
 		&i.JustificativaDivergencia,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.FechamentoCego,
+		&i.Contagem,
+		&i.UsuarioAprovacaoID,
+		&i.DataAprovacao,
 	)
 	return i, err
 }
@@ -461,8 +461,10 @@ func (q *Queries) ListCommissionPeriodsByMonth(ctx context.Context, arg ListComm
 
 const listCommissionPeriodsByProfessional = `-- name: ListCommissionPeriodsByProfessional :many
 SELECT cp.id, cp.tenant_id, cp.unit_id, cp.reference_month, cp.professional_id, cp.total_gross, cp.total_commission, cp.total_advances, cp.total_adjustments, cp.total_net, cp.items_count, cp.status, cp.period_start, cp.period_end, cp.closed_at, cp.paid_at, cp.conta_pagar_id, cp.closed_by, cp.paid_by, cp.notes, cp.created_at, cp.updated_at,
+       p.nome as professional_name,
        u.nome as unit_name
 FROM commission_periods cp
+LEFT JOIN profissionais p ON cp.professional_id = p.id
 LEFT JOIN units u ON cp.unit_id = u.id
 WHERE cp.tenant_id = $1
   AND cp.professional_id = $2
@@ -500,6 +502,7 @@ type ListCommissionPeriodsByProfessionalRow struct {
 	Notes            *string            `json:"notes"`
 	CreatedAt        pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+	ProfessionalName *string            `json:"professional_name"`
 	UnitName         *string            `json:"unit_name"`
 }
 
@@ -540,6 +543,7 @@ func (q *Queries) ListCommissionPeriodsByProfessional(ctx context.Context, arg L
 			&i.Notes,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.ProfessionalName,
 			&i.UnitName,
 		); err != nil {
 			return nil, err
@@ -528,6 +528,60 @@ func (q *Queries) ListContasPagarVencidas(ctx context.Context, arg ListContasPag
 	return items, nil
 }
 
+const listContasPagasByPeriod = `-- name: ListContasPagasByPeriod :many
+SELECT id, tenant_id, descricao, categoria_id, fornecedor, valor, tipo, recorrente, periodicidade, data_vencimento, data_pagamento, status, unit_id, comprovante_url, pix_code, observacoes, criado_em, atualizado_em FROM contas_a_pagar
+WHERE tenant_id = $1
+  AND data_pagamento >= $2
+  AND data_pagamento <= $3
+  AND status = 'PAGO'
+ORDER BY data_pagamento ASC
+`
+
+type ListContasPagasByPeriodParams struct {
+	TenantID        pgtype.UUID `json:"tenant_id"`
+	DataPagamento   pgtype.Date `json:"data_pagamento"`
+	DataPagamento_2 pgtype.Date `json:"data_pagamento_2"`
+}
+
+func (q *Queries) ListContasPagasByPeriod(ctx context.Context, arg ListContasPagasByPeriodParams) ([]ContasAPagar, error) {
+	rows, err := q.db.Query(ctx, listContasPagasByPeriod, arg.TenantID, arg.DataPagamento, arg.DataPagamento_2)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ContasAPagar{}
+	for rows.Next() {
+		var i ContasAPagar
+		if err := rows.Scan(
+			&i.ID,
+			&i.TenantID,
+			&i.Descricao,
+			&i.CategoriaID,
+			&i.Fornecedor,
+			&i.Valor,
+			&i.Tipo,
+			&i.Recorrente,
+			&i.Periodicidade,
+			&i.DataVencimento,
+			&i.DataPagamento,
+			&i.Status,
+			&i.UnitID,
+			&i.ComprovanteUrl,
+			&i.PixCode,
+			&i.Observacoes,
+			&i.CriadoEm,
+			&i.AtualizadoEm,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const marcarContaPagarComoAtrasada = `-- name: MarcarContaPagarComoAtrasada :exec
 UPDATE contas_a_pagar
 SET
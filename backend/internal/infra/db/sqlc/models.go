@@ -9,6 +9,36 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// Log de execuções de conciliação Asaas x NEXO
+
+// Log de webhooks recebidos do Asaas para auditoria e retry
+
+// Categorias de serviços (Corte, Barba, Tratamentos, etc) - separadas das categorias financeiras
+
+// Compensações bancárias com D+ para fluxo de caixa compensado
+
+// Contas a pagar com suporte a recorrência e notificações
+
+// Contas a receber de assinaturas e serviços com alertas de inadimplência
+
+// Despesas fixas recorrentes que geram contas a pagar mensalmente
+
+// Demonstrativo de Resultado do Exercício mensal por tenant
+
+// Fluxo de caixa diário com previsões e compensações bancárias
+
+// Formas de pagamento aceitas - isolamento por tenant_id
+
+// Metas individuais por barbeiro (serviços gerais, extras, produtos)
+
+// Metas de faturamento mensal por tenant
+
+// Metas de ticket médio (geral ou por barbeiro)
+
+// Configurações de precificação padrão por tenant (margem, markup, impostos, comissões)
+
+// Histórico de simulações de precificação com parâmetros e resultados
+
 type Advance struct {
 	ID                pgtype.UUID        `json:"id"`
 	TenantID          pgtype.UUID        `json:"tenant_id"`
@@ -30,6 +60,22 @@ type Advance struct {
 	CreatedBy         pgtype.UUID        `json:"created_by"`
 }
 
+type ApiKey struct {
+	ID          pgtype.UUID        `json:"id"`
+	TenantID    pgtype.UUID        `json:"tenant_id"`
+	UnitID      pgtype.UUID        `json:"unit_id"`
+	Nome        string             `json:"nome"`
+	Prefixo     string             `json:"prefixo"`
+	KeyHash     string             `json:"key_hash"`
+	Escopos     []string           `json:"escopos"`
+	CriadoPor   pgtype.UUID        `json:"criado_por"`
+	ExpiraEm    pgtype.Timestamptz `json:"expira_em"`
+	UltimoUsoEm pgtype.Timestamptz `json:"ultimo_uso_em"`
+	UltimoUsoIp *string            `json:"ultimo_uso_ip"`
+	RevogadaEm  pgtype.Timestamptz `json:"revogada_em"`
+	CriadoEm    pgtype.Timestamptz `json:"criado_em"`
+}
+
 type Appointment struct {
 	ID                    pgtype.UUID        `json:"id"`
 	TenantID              pgtype.UUID        `json:"tenant_id"`
@@ -51,15 +97,91 @@ type Appointment struct {
 	UpdatedAt             pgtype.Timestamptz `json:"updated_at"`
 }
 
+type AppointmentDeposit struct {
+	ID               pgtype.UUID        `json:"id"`
+	TenantID         pgtype.UUID        `json:"tenant_id"`
+	UnitID           pgtype.UUID        `json:"unit_id"`
+	AppointmentID    pgtype.UUID        `json:"appointment_id"`
+	CustomerID       pgtype.UUID        `json:"customer_id"`
+	Amount           decimal.Decimal    `json:"amount"`
+	Status           string             `json:"status"`
+	AsaasPaymentID   *string            `json:"asaas_payment_id"`
+	InvoiceUrl       *string            `json:"invoice_url"`
+	PixPayload       *string            `json:"pix_payload"`
+	DueAt            pgtype.Timestamptz `json:"due_at"`
+	PaidAt           pgtype.Timestamptz `json:"paid_at"`
+	SettledAt        pgtype.Timestamptz `json:"settled_at"`
+	CommandPaymentID pgtype.UUID        `json:"command_payment_id"`
+	ContaReceberID   pgtype.UUID        `json:"conta_receber_id"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt        pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AppointmentResource struct {
+	TenantID        pgtype.UUID `json:"tenant_id"`
+	AppointmentID   pgtype.UUID `json:"appointment_id"`
+	ServiceID       pgtype.UUID `json:"service_id"`
+	ResourceID      pgtype.UUID `json:"resource_id"`
+	OffsetMinutes   int32       `json:"offset_minutes"`
+	DurationMinutes *int32      `json:"duration_minutes"`
+}
+
+type AppointmentSeries struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	UnitID         pgtype.UUID        `json:"unit_id"`
+	ProfessionalID pgtype.UUID        `json:"professional_id"`
+	CustomerID     pgtype.UUID        `json:"customer_id"`
+	ServiceIds     []pgtype.UUID      `json:"service_ids"`
+	StartTime      pgtype.Timestamptz `json:"start_time"`
+	IntervalWeeks  int32              `json:"interval_weeks"`
+	EndsOn         pgtype.Date        `json:"ends_on"`
+	Notes          *string            `json:"notes"`
+	Status         string             `json:"status"`
+	EndedReason    *string            `json:"ended_reason"`
+	EndedAt        pgtype.Timestamptz `json:"ended_at"`
+	GeneratedUntil pgtype.Timestamptz `json:"generated_until"`
+	CreatedBy      pgtype.UUID        `json:"created_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type AppointmentSeriesOccurrence struct {
+	SeriesID        pgtype.UUID        `json:"series_id"`
+	OccurrenceIndex int32              `json:"occurrence_index"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	ScheduledStart  pgtype.Timestamptz `json:"scheduled_start"`
+	AppointmentID   pgtype.UUID        `json:"appointment_id"`
+	Status          string             `json:"status"`
+	Detail          *string            `json:"detail"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
 type AppointmentService struct {
 	AppointmentID     pgtype.UUID        `json:"appointment_id"`
 	ServiceID         pgtype.UUID        `json:"service_id"`
 	PriceAtBooking    decimal.Decimal    `json:"price_at_booking"`
 	DurationAtBooking int32              `json:"duration_at_booking"`
 	CreatedAt         pgtype.Timestamptz `json:"created_at"`
+	ProfessionalID    pgtype.UUID        `json:"professional_id"`
+	Sequence          int32              `json:"sequence"`
+	StartTime         pgtype.Timestamptz `json:"start_time"`
+	EndTime           pgtype.Timestamptz `json:"end_time"`
+	Active            bool               `json:"active"`
+}
+
+type AppointmentStatusHistory struct {
+	ID            pgtype.UUID        `json:"id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	AppointmentID pgtype.UUID        `json:"appointment_id"`
+	FromStatus    string             `json:"from_status"`
+	ToStatus      string             `json:"to_status"`
+	ActorID       pgtype.UUID        `json:"actor_id"`
+	Reason        *string            `json:"reason"`
+	ChangedAt     pgtype.Timestamptz `json:"changed_at"`
 }
 
-// Log de execuções de conciliação Asaas x NEXO
 type AsaasReconciliationLog struct {
 	ID            pgtype.UUID        `json:"id"`
 	TenantID      pgtype.UUID        `json:"tenant_id"`
@@ -74,7 +196,6 @@ type AsaasReconciliationLog struct {
 	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
-// Log de webhooks recebidos do Asaas para auditoria e retry
 type AsaasWebhookLog struct {
 	ID                  pgtype.UUID        `json:"id"`
 	TenantID            pgtype.UUID        `json:"tenant_id"`
@@ -97,6 +218,72 @@ type Auditoria struct {
 	DataFim       pgtype.Timestamp `json:"data_fim"`
 }
 
+type AuthLoginThrottle struct {
+	Chave        string             `json:"chave"`
+	Falhas       int32              `json:"falhas"`
+	UltimaFalha  pgtype.Timestamptz `json:"ultima_falha"`
+	BloqueadoAte pgtype.Timestamptz `json:"bloqueado_ate"`
+}
+
+type AuthMfaChallenge struct {
+	ID         pgtype.UUID        `json:"id"`
+	UserID     pgtype.UUID        `json:"user_id"`
+	TokenHash  string             `json:"token_hash"`
+	Tentativas int32              `json:"tentativas"`
+	CreatedAt  pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt  pgtype.Timestamptz `json:"expires_at"`
+	UsedAt     pgtype.Timestamptz `json:"used_at"`
+}
+
+type AuthSession struct {
+	ID            pgtype.UUID        `json:"id"`
+	UserID        pgtype.UUID        `json:"user_id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	UserAgent     *string            `json:"user_agent"`
+	IpAddress     *string            `json:"ip_address"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	LastUsedAt    pgtype.Timestamptz `json:"last_used_at"`
+	ExpiresAt     pgtype.Timestamptz `json:"expires_at"`
+	RevokedAt     pgtype.Timestamptz `json:"revoked_at"`
+	RevokedReason *string            `json:"revoked_reason"`
+	UnitID        pgtype.UUID        `json:"unit_id"`
+}
+
+type BankStatementImport struct {
+	ID               pgtype.UUID        `json:"id"`
+	TenantID         pgtype.UUID        `json:"tenant_id"`
+	ArquivoNome      string             `json:"arquivo_nome"`
+	Formato          string             `json:"formato"`
+	ContaBancaria    *string            `json:"conta_bancaria"`
+	PeriodoInicio    pgtype.Date        `json:"periodo_inicio"`
+	PeriodoFim       pgtype.Date        `json:"periodo_fim"`
+	TotalLinhas      int32              `json:"total_linhas"`
+	TotalConciliadas int32              `json:"total_conciliadas"`
+	TotalPendentes   int32              `json:"total_pendentes"`
+	ImportadoPor     pgtype.UUID        `json:"importado_por"`
+	CreatedAt        pgtype.Timestamptz `json:"created_at"`
+}
+
+type BankStatementLine struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	ImportID       pgtype.UUID        `json:"import_id"`
+	DataLancamento pgtype.Date        `json:"data_lancamento"`
+	Descricao      string             `json:"descricao"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Tipo           string             `json:"tipo"`
+	Identificador  string             `json:"identificador"`
+	Status         string             `json:"status"`
+	MatchTipo      *string            `json:"match_tipo"`
+	MatchID        pgtype.UUID        `json:"match_id"`
+	Diferenca      pgtype.Numeric     `json:"diferenca"`
+	Observacao     *string            `json:"observacao"`
+	ConciliadoEm   pgtype.Timestamptz `json:"conciliado_em"`
+	ConciliadoPor  pgtype.UUID        `json:"conciliado_por"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
 type BarberTurnHistory struct {
 	ID             pgtype.UUID        `json:"id"`
 	TenantID       pgtype.UUID        `json:"tenant_id"`
@@ -151,6 +338,67 @@ type CaixaDiario struct {
 	JustificativaDivergencia *string            `json:"justificativa_divergencia"`
 	CreatedAt                pgtype.Timestamptz `json:"created_at"`
 	UpdatedAt                pgtype.Timestamptz `json:"updated_at"`
+	FechamentoCego           bool               `json:"fechamento_cego"`
+	Contagem                 []byte             `json:"contagem"`
+	UsuarioAprovacaoID       pgtype.UUID        `json:"usuario_aprovacao_id"`
+	DataAprovacao            pgtype.Timestamptz `json:"data_aprovacao"`
+}
+
+type CalendarConnection struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	ProfessionalID pgtype.UUID        `json:"professional_id"`
+	Provider       string             `json:"provider"`
+	CalendarID     string             `json:"calendar_id"`
+	AccountEmail   *string            `json:"account_email"`
+	AccessToken    string             `json:"access_token"`
+	RefreshToken   string             `json:"refresh_token"`
+	TokenExpiresAt pgtype.Timestamptz `json:"token_expires_at"`
+	SyncToken      *string            `json:"sync_token"`
+	Status         string             `json:"status"`
+	LastError      *string            `json:"last_error"`
+	LastSyncedAt   pgtype.Timestamptz `json:"last_synced_at"`
+	FullSyncedAt   pgtype.Timestamptz `json:"full_synced_at"`
+	CreatedBy      pgtype.UUID        `json:"created_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt      pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CalendarEventLink struct {
+	ConnectionID    pgtype.UUID        `json:"connection_id"`
+	AppointmentID   pgtype.UUID        `json:"appointment_id"`
+	SlotIndex       int32              `json:"slot_index"`
+	ExternalEventID string             `json:"external_event_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CalendarFeed struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	UnitID         pgtype.UUID        `json:"unit_id"`
+	ProfessionalID pgtype.UUID        `json:"professional_id"`
+	TokenHash      string             `json:"token_hash"`
+	CreatedBy      pgtype.UUID        `json:"created_by"`
+	LastAccessedAt pgtype.Timestamptz `json:"last_accessed_at"`
+	RevokedAt      pgtype.Timestamptz `json:"revoked_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type CalendarImportedEvent struct {
+	ConnectionID    pgtype.UUID        `json:"connection_id"`
+	ExternalEventID string             `json:"external_event_id"`
+	BlockedTimeID   pgtype.UUID        `json:"blocked_time_id"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
+type CalendarSyncQueue struct {
+	AppointmentID pgtype.UUID        `json:"appointment_id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	Attempts      int32              `json:"attempts"`
+	NextAttemptAt pgtype.Timestamptz `json:"next_attempt_at"`
+	LastError     *string            `json:"last_error"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
 }
 
 type Categoria struct {
@@ -178,7 +426,6 @@ type CategoriasProduto struct {
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Categorias de serviços (Corte, Barba, Tratamentos, etc) - separadas das categorias financeiras
 type CategoriasServico struct {
 	ID pgtype.UUID `json:"id"`
 	// Isolamento multi-tenant - OBRIGATÓRIO em todas as queries
@@ -351,7 +598,6 @@ type CommissionRule struct {
 	CreatedBy       pgtype.UUID        `json:"created_by"`
 }
 
-// Compensações bancárias com D+ para fluxo de caixa compensado
 type CompensacoesBancaria struct {
 	ID              pgtype.UUID        `json:"id"`
 	TenantID        pgtype.UUID        `json:"tenant_id"`
@@ -370,7 +616,6 @@ type CompensacoesBancaria struct {
 	AtualizadoEm    pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Contas a pagar com suporte a recorrência e notificações
 type ContasAPagar struct {
 	ID             pgtype.UUID        `json:"id"`
 	TenantID       pgtype.UUID        `json:"tenant_id"`
@@ -392,7 +637,6 @@ type ContasAPagar struct {
 	AtualizadoEm   pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Contas a receber de assinaturas e serviços com alertas de inadimplência
 type ContasAReceber struct {
 	ID               pgtype.UUID        `json:"id"`
 	TenantID         pgtype.UUID        `json:"tenant_id"`
@@ -417,7 +661,36 @@ type ContasAReceber struct {
 	AtualizadoEm     pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Despesas fixas recorrentes que geram contas a pagar mensalmente
+type CustomerDebt struct {
+	ID            pgtype.UUID        `json:"id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	CustomerID    pgtype.UUID        `json:"customer_id"`
+	CommandID     pgtype.UUID        `json:"command_id"`
+	ValorOriginal decimal.Decimal    `json:"valor_original"`
+	ValorPago     decimal.Decimal    `json:"valor_pago"`
+	Saldo         decimal.Decimal    `json:"saldo"`
+	Status        string             `json:"status"`
+	DataAbertura  pgtype.Timestamptz `json:"data_abertura"`
+	DataQuitacao  pgtype.Timestamptz `json:"data_quitacao"`
+	Observacoes   *string            `json:"observacoes"`
+	CreatedAt     pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt     pgtype.Timestamptz `json:"updated_at"`
+}
+
+type CustomerDebtPayment struct {
+	ID              pgtype.UUID        `json:"id"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	DebtID          pgtype.UUID        `json:"debt_id"`
+	CommandID       pgtype.UUID        `json:"command_id"`
+	MeioPagamentoID pgtype.UUID        `json:"meio_pagamento_id"`
+	Valor           decimal.Decimal    `json:"valor"`
+	ContaReceberID  pgtype.UUID        `json:"conta_receber_id"`
+	OperacaoCaixaID pgtype.UUID        `json:"operacao_caixa_id"`
+	RecebidoPor     pgtype.UUID        `json:"recebido_por"`
+	Observacoes     *string            `json:"observacoes"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+}
+
 type DespesasFixa struct {
 	ID          pgtype.UUID     `json:"id"`
 	TenantID    pgtype.UUID     `json:"tenant_id"`
@@ -435,7 +708,6 @@ type DespesasFixa struct {
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Demonstrativo de Resultado do Exercício mensal por tenant
 type DreMensal struct {
 	ID                   pgtype.UUID        `json:"id"`
 	TenantID             pgtype.UUID        `json:"tenant_id"`
@@ -460,7 +732,6 @@ type DreMensal struct {
 	AtualizadoEm         pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Fluxo de caixa diário com previsões e compensações bancárias
 type FluxoCaixaDiario struct {
 	ID                  pgtype.UUID        `json:"id"`
 	TenantID            pgtype.UUID        `json:"tenant_id"`
@@ -532,7 +803,6 @@ type Lote struct {
 	CreatedAt         pgtype.Timestamp `json:"created_at"`
 }
 
-// Formas de pagamento aceitas - isolamento por tenant_id
 type MeiosPagamento struct {
 	ID       pgtype.UUID `json:"id"`
 	TenantID pgtype.UUID `json:"tenant_id"`
@@ -556,7 +826,6 @@ type MeiosPagamento struct {
 	AtualizadoEm  pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Metas individuais por barbeiro (serviços gerais, extras, produtos)
 type MetasBarbeiro struct {
 	ID                 pgtype.UUID        `json:"id"`
 	TenantID           pgtype.UUID        `json:"tenant_id"`
@@ -569,7 +838,6 @@ type MetasBarbeiro struct {
 	AtualizadoEm       pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Metas de faturamento mensal por tenant
 type MetasMensai struct {
 	ID              pgtype.UUID        `json:"id"`
 	TenantID        pgtype.UUID        `json:"tenant_id"`
@@ -582,7 +850,6 @@ type MetasMensai struct {
 	AtualizadoEm    pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Metas de ticket médio (geral ou por barbeiro)
 type MetasTicketMedio struct {
 	ID           pgtype.UUID        `json:"id"`
 	TenantID     pgtype.UUID        `json:"tenant_id"`
@@ -611,17 +878,41 @@ type MovimentacoesEstoque struct {
 	AtualizadoEm     pgtype.Timestamptz `json:"atualizado_em"`
 }
 
+type NoShowPolicy struct {
+	TenantID             pgtype.UUID        `json:"tenant_id"`
+	Enabled              bool               `json:"enabled"`
+	MaxNoShows           int32              `json:"max_no_shows"`
+	WindowDays           int32              `json:"window_days"`
+	DepositType          string             `json:"deposit_type"`
+	DepositValue         decimal.Decimal    `json:"deposit_value"`
+	PaymentDeadlineHours int32              `json:"payment_deadline_hours"`
+	MeioPagamentoID      pgtype.UUID        `json:"meio_pagamento_id"`
+	CreatedAt            pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
+}
+
 type OperacoesCaixa struct {
+	ID             pgtype.UUID        `json:"id"`
+	CaixaID        pgtype.UUID        `json:"caixa_id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Tipo           string             `json:"tipo"`
+	Valor          decimal.Decimal    `json:"valor"`
+	Descricao      string             `json:"descricao"`
+	Destino        *string            `json:"destino"`
+	Origem         *string            `json:"origem"`
+	UsuarioID      pgtype.UUID        `json:"usuario_id"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	FormaPagamento *string            `json:"forma_pagamento"`
+}
+
+type PasswordResetToken struct {
 	ID        pgtype.UUID        `json:"id"`
-	CaixaID   pgtype.UUID        `json:"caixa_id"`
-	TenantID  pgtype.UUID        `json:"tenant_id"`
-	Tipo      string             `json:"tipo"`
-	Valor     decimal.Decimal    `json:"valor"`
-	Descricao string             `json:"descricao"`
-	Destino   *string            `json:"destino"`
-	Origem    *string            `json:"origem"`
-	UsuarioID pgtype.UUID        `json:"usuario_id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	TokenHash string             `json:"token_hash"`
+	IpAddress *string            `json:"ip_address"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
 }
 
 type Plan struct {
@@ -638,7 +929,17 @@ type Plan struct {
 	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
 }
 
-// Configurações de precificação padrão por tenant (margem, markup, impostos, comissões)
+type PlanoContasMapeamento struct {
+	ID          pgtype.UUID        `json:"id"`
+	TenantID    pgtype.UUID        `json:"tenant_id"`
+	OrigemTipo  string             `json:"origem_tipo"`
+	OrigemChave string             `json:"origem_chave"`
+	ContaCodigo string             `json:"conta_codigo"`
+	ContaNome   string             `json:"conta_nome"`
+	CreatedAt   pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt   pgtype.Timestamptz `json:"updated_at"`
+}
+
 type PrecificacaoConfig struct {
 	ID                        pgtype.UUID        `json:"id"`
 	TenantID                  pgtype.UUID        `json:"tenant_id"`
@@ -650,7 +951,6 @@ type PrecificacaoConfig struct {
 	AtualizadoEm              pgtype.Timestamptz `json:"atualizado_em"`
 }
 
-// Histórico de simulações de precificação com parâmetros e resultados
 type PrecificacaoSimulaco struct {
 	ID                  pgtype.UUID        `json:"id"`
 	TenantID            pgtype.UUID        `json:"tenant_id"`
@@ -755,9 +1055,11 @@ type Profissionai struct {
 type RefreshToken struct {
 	ID        pgtype.UUID        `json:"id"`
 	UserID    pgtype.UUID        `json:"user_id"`
-	Token     string             `json:"token"`
 	ExpiresAt pgtype.Timestamptz `json:"expires_at"`
 	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	SessionID pgtype.UUID        `json:"session_id"`
+	TokenHash string             `json:"token_hash"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
 }
 
 type RequisicoesCompra struct {
@@ -770,6 +1072,25 @@ type RequisicoesCompra struct {
 	CreatedAt     pgtype.Timestamp `json:"created_at"`
 }
 
+type Resource struct {
+	ID        pgtype.UUID        `json:"id"`
+	TenantID  pgtype.UUID        `json:"tenant_id"`
+	UnitID    pgtype.UUID        `json:"unit_id"`
+	Nome      string             `json:"nome"`
+	Capacity  int32              `json:"capacity"`
+	Ativo     bool               `json:"ativo"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt pgtype.Timestamptz `json:"updated_at"`
+}
+
+type ServiceResource struct {
+	TenantID        pgtype.UUID `json:"tenant_id"`
+	ServicoID       pgtype.UUID `json:"servico_id"`
+	ResourceID      pgtype.UUID `json:"resource_id"`
+	OffsetMinutes   int32       `json:"offset_minutes"`
+	DurationMinutes *int32      `json:"duration_minutes"`
+}
+
 type Servico struct {
 	ID               pgtype.UUID        `json:"id"`
 	TenantID         pgtype.UUID        `json:"tenant_id"`
@@ -855,6 +1176,24 @@ type Tenant struct {
 	OnboardingStep      *int32             `json:"onboarding_step"`
 }
 
+type TenantAuthPolicy struct {
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	Exigir2faAdmin bool               `json:"exigir_2fa_admin"`
+	AtualizadoPor  pgtype.UUID        `json:"atualizado_por"`
+	AtualizadoEm   pgtype.Timestamptz `json:"atualizado_em"`
+}
+
+type TenantRole struct {
+	ID           pgtype.UUID        `json:"id"`
+	TenantID     pgtype.UUID        `json:"tenant_id"`
+	Nome         string             `json:"nome"`
+	Descricao    *string            `json:"descricao"`
+	BaseRole     string             `json:"base_role"`
+	Permissoes   []string           `json:"permissoes"`
+	CriadoEm     pgtype.Timestamptz `json:"criado_em"`
+	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
+}
+
 type Unit struct {
 	ID             pgtype.UUID        `json:"id"`
 	TenantID       pgtype.UUID        `json:"tenant_id"`
@@ -885,6 +1224,22 @@ type User struct {
 	DeletedAt    pgtype.Timestamptz `json:"deleted_at"`
 }
 
+type UserInvitation struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	UnitID         pgtype.UUID        `json:"unit_id"`
+	Email          string             `json:"email"`
+	Nome           string             `json:"nome"`
+	Role           string             `json:"role"`
+	TokenHash      string             `json:"token_hash"`
+	InvitedBy      pgtype.UUID        `json:"invited_by"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	AcceptedAt     pgtype.Timestamptz `json:"accepted_at"`
+	AcceptedUserID pgtype.UUID        `json:"accepted_user_id"`
+	RevokedAt      pgtype.Timestamptz `json:"revoked_at"`
+}
+
 type UserPreference struct {
 	ID                   pgtype.UUID        `json:"id"`
 	UserID               pgtype.UUID        `json:"user_id"`
@@ -895,6 +1250,22 @@ type UserPreference struct {
 	UpdatedAt            pgtype.Timestamptz `json:"updated_at"`
 }
 
+type UserRecoveryCode struct {
+	ID        pgtype.UUID        `json:"id"`
+	UserID    pgtype.UUID        `json:"user_id"`
+	CodeHash  string             `json:"code_hash"`
+	UsedAt    pgtype.Timestamptz `json:"used_at"`
+	CreatedAt pgtype.Timestamptz `json:"created_at"`
+}
+
+type UserTotp struct {
+	UserID       pgtype.UUID        `json:"user_id"`
+	SecretEnc    string             `json:"secret_enc"`
+	ConfirmedAt  pgtype.Timestamptz `json:"confirmed_at"`
+	LastUsedStep int64              `json:"last_used_step"`
+	CreatedAt    pgtype.Timestamptz `json:"created_at"`
+}
+
 type UserUnit struct {
 	ID           pgtype.UUID        `json:"id"`
 	UserID       pgtype.UUID        `json:"user_id"`
@@ -903,4 +1274,101 @@ type UserUnit struct {
 	RoleOverride *string            `json:"role_override"`
 	CriadoEm     pgtype.Timestamptz `json:"criado_em"`
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
+	CustomRoleID pgtype.UUID        `json:"custom_role_id"`
+}
+
+type WaitlistEntry struct {
+	ID              pgtype.UUID        `json:"id"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	UnitID          pgtype.UUID        `json:"unit_id"`
+	CustomerID      pgtype.UUID        `json:"customer_id"`
+	ServiceIds      []pgtype.UUID      `json:"service_ids"`
+	ProfessionalIds []pgtype.UUID      `json:"professional_ids"`
+	WindowStart     pgtype.Timestamptz `json:"window_start"`
+	WindowEnd       pgtype.Timestamptz `json:"window_end"`
+	Priority        int32              `json:"priority"`
+	Notes           *string            `json:"notes"`
+	Status          string             `json:"status"`
+	AppointmentID   pgtype.UUID        `json:"appointment_id"`
+	CreatedBy       pgtype.UUID        `json:"created_by"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WaitlistOffer struct {
+	ID             pgtype.UUID        `json:"id"`
+	TenantID       pgtype.UUID        `json:"tenant_id"`
+	UnitID         pgtype.UUID        `json:"unit_id"`
+	EntryID        pgtype.UUID        `json:"entry_id"`
+	ProfessionalID pgtype.UUID        `json:"professional_id"`
+	SlotStart      pgtype.Timestamptz `json:"slot_start"`
+	SlotEnd        pgtype.Timestamptz `json:"slot_end"`
+	StartTime      pgtype.Timestamptz `json:"start_time"`
+	EndTime        pgtype.Timestamptz `json:"end_time"`
+	TokenHash      string             `json:"token_hash"`
+	ExpiresAt      pgtype.Timestamptz `json:"expires_at"`
+	Status         string             `json:"status"`
+	AppointmentID  pgtype.UUID        `json:"appointment_id"`
+	RespondedAt    pgtype.Timestamptz `json:"responded_at"`
+	CreatedAt      pgtype.Timestamptz `json:"created_at"`
+}
+
+type WalkIn struct {
+	ID              pgtype.UUID        `json:"id"`
+	TenantID        pgtype.UUID        `json:"tenant_id"`
+	UnitID          pgtype.UUID        `json:"unit_id"`
+	CustomerID      pgtype.UUID        `json:"customer_id"`
+	ServiceIds      []pgtype.UUID      `json:"service_ids"`
+	DurationMinutes int32              `json:"duration_minutes"`
+	Notes           *string            `json:"notes"`
+	Status          string             `json:"status"`
+	ProfessionalID  pgtype.UUID        `json:"professional_id"`
+	AppointmentID   pgtype.UUID        `json:"appointment_id"`
+	CreatedBy       pgtype.UUID        `json:"created_by"`
+	CheckedInAt     pgtype.Timestamptz `json:"checked_in_at"`
+	AssignedAt      pgtype.Timestamptz `json:"assigned_at"`
+	CanceledAt      pgtype.Timestamptz `json:"canceled_at"`
+	CreatedAt       pgtype.Timestamptz `json:"created_at"`
+	UpdatedAt       pgtype.Timestamptz `json:"updated_at"`
+}
+
+type WebhookDelivery struct {
+	ID                 pgtype.UUID        `json:"id"`
+	TenantID           pgtype.UUID        `json:"tenant_id"`
+	EndpointID         pgtype.UUID        `json:"endpoint_id"`
+	Evento             string             `json:"evento"`
+	EventID            pgtype.UUID        `json:"event_id"`
+	Payload            []byte             `json:"payload"`
+	Status             string             `json:"status"`
+	Tentativas         int32              `json:"tentativas"`
+	ProximaTentativaEm pgtype.Timestamptz `json:"proxima_tentativa_em"`
+	UltimoStatusHttp   *int32             `json:"ultimo_status_http"`
+	UltimoErro         *string            `json:"ultimo_erro"`
+	EntregueEm         pgtype.Timestamptz `json:"entregue_em"`
+	CriadoEm           pgtype.Timestamptz `json:"criado_em"`
+	AtualizadoEm       pgtype.Timestamptz `json:"atualizado_em"`
+}
+
+type WebhookDeliveryAttempt struct {
+	ID         pgtype.UUID        `json:"id"`
+	DeliveryID pgtype.UUID        `json:"delivery_id"`
+	Tentativa  int32              `json:"tentativa"`
+	StatusHttp *int32             `json:"status_http"`
+	Erro       *string            `json:"erro"`
+	Resposta   *string            `json:"resposta"`
+	DuracaoMs  int32              `json:"duracao_ms"`
+	CriadoEm   pgtype.Timestamptz `json:"criado_em"`
+}
+
+type WebhookEndpoint struct {
+	ID            pgtype.UUID        `json:"id"`
+	TenantID      pgtype.UUID        `json:"tenant_id"`
+	Url           string             `json:"url"`
+	Descricao     *string            `json:"descricao"`
+	Eventos       []string           `json:"eventos"`
+	SecretCifrado string             `json:"secret_cifrado"`
+	Ativo         bool               `json:"ativo"`
+	CriadoPor     pgtype.UUID        `json:"criado_por"`
+	CriadoEm      pgtype.Timestamptz `json:"criado_em"`
+	AtualizadoEm  pgtype.Timestamptz `json:"atualizado_em"`
 }
@@ -12,6 +12,11 @@ import (
 )
 
 type Querier interface {
+	// Abate o pagamento do saldo de forma atômica. Não retorna linha se a dívida
+	// não está mais aberta ou se o saldo atual não cobre o valor (pagamento
+	// concorrente), evitando pagamento perdido ou dívida paga a mais.
+	AbaterCustomerDebtSaldo(ctx context.Context, arg AbaterCustomerDebtSaldoParams) (CustomerDebt, error)
+	AcceptUserInvitation(ctx context.Context, arg AcceptUserInvitationParams) (int64, error)
 	// Ativa uma despesa fixa
 	ActivateDespesaFixa(ctx context.Context, arg ActivateDespesaFixaParams) (DespesasFixa, error)
 	ActivateMeioPagamento(ctx context.Context, arg ActivateMeioPagamentoParams) error
@@ -27,8 +32,24 @@ type Querier interface {
 	// ============================================================================
 	// Adiciona um barbeiro à lista da vez
 	AddBarberToTurnList(ctx context.Context, arg AddBarberToTurnListParams) (BarbersTurnList, error)
+	// Grava o passo aceito; 0 linhas = código já usado (corrida entre dois logins)
+	AdvanceUserTOTPStep(ctx context.Context, arg AdvanceUserTOTPStepParams) (int64, error)
+	// Somas (em segundos) e contagens dos tempos da operação por unidade e
+	// profissional, para agendamentos com início no período. O agendamento conta
+	// para o responsável e para cada profissional dos seus serviços; responsible
+	// marca a linha do responsável, a única somada nos totais. Check-in, início e
+	// fim são registrados por agendamento: espera, duração e pontualidade ficam só
+	// na linha do responsável. Os demais contam o agendamento e o cancelamento,
+	// medido a partir do horário do seu primeiro serviço. As médias são
+	// calculadas na aplicação para permitir somar grupos.
+	// Cancelamento sem histórico (anterior à migration 082) usa updated_at.
+	AppointmentTimingStats(ctx context.Context, arg AppointmentTimingStatsParams) ([]AppointmentTimingStatsRow, error)
 	ApproveAdvance(ctx context.Context, arg ApproveAdvanceParams) (Advance, error)
+	AprovarCaixaDiario(ctx context.Context, arg AprovarCaixaDiarioParams) (CaixaDiario, error)
 	AprovarMetaMensal(ctx context.Context, arg AprovarMetaMensalParams) (MetasMensai, error)
+	// Define (ou remove, com custom_role_id NULL) o papel personalizado do usuário
+	// na unidade; a unidade precisa ser do tenant
+	AssignUserUnitCustomRole(ctx context.Context, arg AssignUserUnitCustomRoleParams) (int64, error)
 	AtualizarQuantidadeProduto(ctx context.Context, arg AtualizarQuantidadeProdutoParams) (Produto, error)
 	AvgMargemBrutaByPeriod(ctx context.Context, arg AvgMargemBrutaByPeriodParams) (interface{}, error)
 	AvgMargemOperacionalByPeriod(ctx context.Context, arg AvgMargemOperacionalByPeriodParams) (interface{}, error)
@@ -37,6 +58,7 @@ type Querier interface {
 	CancelAdvance(ctx context.Context, arg CancelAdvanceParams) (Advance, error)
 	CancelCommissionItem(ctx context.Context, arg CancelCommissionItemParams) (CommissionItem, error)
 	CancelCommissionPeriod(ctx context.Context, arg CancelCommissionPeriodParams) (CommissionPeriod, error)
+	// Cancela os agendamentos futuros ainda não iniciados gerados pelas séries
 	// Cancelar assinatura (RN-CANC-003)
 	CancelSubscription(ctx context.Context, arg CancelSubscriptionParams) error
 	// Verificar se já existe assinatura ativa do mesmo plano (RN-SUB-004)
@@ -75,6 +97,10 @@ type Querier interface {
 	CheckProfessionalIsBarber(ctx context.Context, arg CheckProfessionalIsBarberParams) (bool, error)
 	CheckServicoNomeExists(ctx context.Context, arg CheckServicoNomeExistsParams) (bool, error)
 	CheckUserUnitAccess(ctx context.Context, arg CheckUserUnitAccessParams) (bool, error)
+	// Reserva entregas vencidas para envio. O lease adia a próxima tentativa, de
+	// modo que outra réplica não pegue a mesma entrega enquanto ela está em voo;
+	// se o processo cair, a entrega volta a ficar disponível ao fim do lease.
+	ClaimWebhookDeliveries(ctx context.Context, arg ClaimWebhookDeliveriesParams) ([]ClaimWebhookDeliveriesRow, error)
 	// Limpar logs antigos (manter últimos 90 dias)
 	CleanupOldWebhookLogs(ctx context.Context) error
 	CloseCommissionPeriod(ctx context.Context, arg CloseCommissionPeriodParams) (CommissionPeriod, error)
@@ -82,6 +108,10 @@ type Querier interface {
 	CompleteAppointment(ctx context.Context, arg CompleteAppointmentParams) (Appointment, error)
 	// Confirmar pagamento (atualizar status e data)
 	ConfirmPayment(ctx context.Context, arg ConfirmPaymentParams) error
+	ConfirmUserTOTP(ctx context.Context, arg ConfirmUserTOTPParams) (int64, error)
+	ConsumeMFAChallenge(ctx context.Context, id pgtype.UUID) (int64, error)
+	// Marca o token como usado e retorna o usuário; sem linha = inválido, usado ou expirado
+	ConsumePasswordResetToken(ctx context.Context, tokenHash string) (pgtype.UUID, error)
 	// Contar assinaturas ativas de um cliente (para RN-CLI-004)
 	CountActiveSubscriptionsByCliente(ctx context.Context, arg CountActiveSubscriptionsByClienteParams) (int32, error)
 	// Contar assinaturas ativas de um plano (para validar REGRA PL-003)
@@ -89,6 +119,7 @@ type Querier interface {
 	CountAdvancesByStatus(ctx context.Context, tenantID pgtype.UUID) (CountAdvancesByStatusRow, error)
 	CountAppointments(ctx context.Context, arg CountAppointmentsParams) (int64, error)
 	CountAppointmentsByStatus(ctx context.Context, arg CountAppointmentsByStatusParams) (int64, error)
+	CountAvailableRecoveryCodes(ctx context.Context, userID pgtype.UUID) (int64, error)
 	CountBarbersTurnList(ctx context.Context, tenantID pgtype.UUID) (CountBarbersTurnListRow, error)
 	CountCaixaDiarioHistorico(ctx context.Context, arg CountCaixaDiarioHistoricoParams) (int64, error)
 	CountCategoriasServicosByTenant(ctx context.Context, arg CountCategoriasServicosByTenantParams) (int64, error)
@@ -119,6 +150,10 @@ type Querier interface {
 	CountPaymentsByStatus(ctx context.Context, tenantID pgtype.UUID) (CountPaymentsByStatusRow, error)
 	CountProdutosByCategoria(ctx context.Context, arg CountProdutosByCategoriaParams) (int64, error)
 	CountProfessionals(ctx context.Context, arg CountProfessionalsParams) (int64, error)
+	// ============================================================================
+	// RECUPERAÇÃO DE SENHA (tokens armazenados como hash SHA-256)
+	// ============================================================================
+	CountRecentPasswordResets(ctx context.Context, arg CountRecentPasswordResetsParams) (int64, error)
 	CountServicosAtivosByTenant(ctx context.Context, arg CountServicosAtivosByTenantParams) (int64, error)
 	// ============================================================================
 	// QUERIES AUXILIARES
@@ -130,12 +165,14 @@ type Querier interface {
 	CountServicosInCategoria(ctx context.Context, arg CountServicosInCategoriaParams) (int64, error)
 	CountSimulacoesByItem(ctx context.Context, arg CountSimulacoesByItemParams) (int64, error)
 	CountSimulacoesByTenant(ctx context.Context, tenantID pgtype.UUID) (int64, error)
+	CountTenantRoleAssignments(ctx context.Context, customRoleID pgtype.UUID) (int64, error)
 	CountUnitUsers(ctx context.Context, unitID pgtype.UUID) (int64, error)
 	CountUnitsByTenant(ctx context.Context, tenantID pgtype.UUID) (int64, error)
 	CountUserPreferences(ctx context.Context) (int64, error)
 	CountUserUnits(ctx context.Context, userID pgtype.UUID) (int64, error)
 	// Estatísticas de webhooks por tipo (últimos 30 dias)
 	CountWebhooksByEventType(ctx context.Context) ([]CountWebhooksByEventTypeRow, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
 	// ============================================================================
 	// QUERIES: advances
 	// Adiantamentos de profissionais
@@ -146,7 +183,30 @@ type Querier interface {
 	// Módulo de Agendamento — NEXO v1.0
 	// ============================================================================
 	CreateAppointment(ctx context.Context, arg CreateAppointmentParams) (Appointment, error)
+	// ============================================================================
+	// SINAIS DE AGENDAMENTO
+	// ============================================================================
+	CreateAppointmentDeposit(ctx context.Context, arg CreateAppointmentDepositParams) (AppointmentDeposit, error)
+	// ============================================================================
+	// RESERVAS DOS AGENDAMENTOS
+	// ============================================================================
+	CreateAppointmentResource(ctx context.Context, arg CreateAppointmentResourceParams) error
+	CreateAppointmentSeries(ctx context.Context, arg CreateAppointmentSeriesParams) (AppointmentSeries, error)
+	// Registra a ocorrência; se outra geração já a registrou, não altera nada
+	CreateAppointmentSeriesOccurrence(ctx context.Context, arg CreateAppointmentSeriesOccurrenceParams) (int64, error)
 	CreateAppointmentService(ctx context.Context, arg CreateAppointmentServiceParams) error
+	CreateAppointmentStatusHistory(ctx context.Context, arg CreateAppointmentStatusHistoryParams) (pgtype.UUID, error)
+	// ============================================================================
+	// SESSÕES
+	// ============================================================================
+	CreateAuthSession(ctx context.Context, arg CreateAuthSessionParams) (AuthSession, error)
+	// ============================================================================
+	// BANK_STATEMENTS QUERIES (sqlc)
+	// Importação de extratos bancários (OFX/CSV) e conciliação
+	// ============================================================================
+	CreateBankStatementImport(ctx context.Context, arg CreateBankStatementImportParams) (BankStatementImport, error)
+	// Ignora lançamentos já importados (mesmo identificador)
+	CreateBankStatementLine(ctx context.Context, arg CreateBankStatementLineParams) (BankStatementLine, error)
 	// ============================================================================
 	// BLOCKED_TIMES QUERIES (sqlc)
 	// Bloqueios de horário na agenda
@@ -159,6 +219,11 @@ type Querier interface {
 	// ========== CREATE ==========
 	CreateCaixaDiario(ctx context.Context, arg CreateCaixaDiarioParams) (CaixaDiario, error)
 	// ============================================================================
+	// FEEDS ICALENDAR
+	// ============================================================================
+	CreateCalendarFeed(ctx context.Context, arg CreateCalendarFeedParams) (CalendarFeed, error)
+	CreateCalendarImportedEvent(ctx context.Context, arg CreateCalendarImportedEventParams) error
+	// ============================================================================
 	// CATEGORIAS DE PRODUTOS QUERIES (sqlc)
 	// Módulo de Estoque — NEXO v1.0
 	// Tabela: categorias_produtos (customizáveis por tenant)
@@ -215,6 +280,12 @@ type Querier interface {
 	// CREATE
 	// ============================================================================
 	CreateCustomer(ctx context.Context, arg CreateCustomerParams) (Cliente, error)
+	// ============================================================================
+	// CUSTOMER_DEBTS QUERIES (sqlc)
+	// Fiado: dívidas de clientes geradas por comandas com saldo devedor
+	// ============================================================================
+	CreateCustomerDebt(ctx context.Context, arg CreateCustomerDebtParams) (CustomerDebt, error)
+	CreateCustomerDebtPayment(ctx context.Context, arg CreateCustomerDebtPaymentParams) (CustomerDebtPayment, error)
 	CreateDREMensal(ctx context.Context, arg CreateDREMensalParams) (DreMensal, error)
 	// ============================================================================
 	// Queries sqlc: despesas_fixas
@@ -233,6 +304,10 @@ type Querier interface {
 	// ========================================
 	CreateFornecedor(ctx context.Context, arg CreateFornecedorParams) (Fornecedore, error)
 	// ============================================================================
+	// DESAFIOS DO SEGUNDO PASSO DO LOGIN
+	// ============================================================================
+	CreateMFAChallenge(ctx context.Context, arg CreateMFAChallengeParams) error
+	// ============================================================================
 	// MEIOS DE PAGAMENTO QUERIES (sqlc)
 	// Módulo de Cadastro de Tipos de Recebimento — NEXO v1.0
 	// Tabela: meios_pagamento
@@ -252,6 +327,7 @@ type Querier interface {
 	// OPERAÇÕES DO CAIXA
 	// =============================================
 	CreateOperacaoCaixa(ctx context.Context, arg CreateOperacaoCaixaParams) (OperacoesCaixa, error)
+	CreatePasswordResetToken(ctx context.Context, arg CreatePasswordResetTokenParams) error
 	// ============================================================
 	// QUERIES SQLC — MÓDULO ASSINATURAS DE CLIENTES
 	// Referência: FLUXO_ASSINATURA.md
@@ -279,6 +355,12 @@ type Querier interface {
 	// ============================================================
 	// Registrar execução de conciliação
 	CreateReconciliationLog(ctx context.Context, arg CreateReconciliationLogParams) (AsaasReconciliationLog, error)
+	CreateRecoveryCode(ctx context.Context, arg CreateRecoveryCodeParams) error
+	// ============================================================================
+	// RECURSOS DA UNIDADE (cadeiras, salas, lavatório)
+	// ============================================================================
+	CreateResource(ctx context.Context, arg CreateResourceParams) (Resource, error)
+	CreateServiceResource(ctx context.Context, arg CreateServiceResourceParams) error
 	// ============================================================================
 	// SERVIÇOS QUERIES (sqlc)
 	// Módulo de Cadastro de Serviços — NEXO v1.0
@@ -298,16 +380,34 @@ type Querier interface {
 	// ============================================================
 	// Registrar novo pagamento
 	CreateSubscriptionPayment(ctx context.Context, arg CreateSubscriptionPaymentParams) (SubscriptionPayment, error)
+	CreateTenantRole(ctx context.Context, arg CreateTenantRoleParams) (TenantRole, error)
 	// ============================================================================
 	// SQLC Queries: Units (Unidades)
 	// ============================================================================
 	CreateUnit(ctx context.Context, arg CreateUnitParams) (Unit, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (CreateUserRow, error)
+	CreateUserInvitation(ctx context.Context, arg CreateUserInvitationParams) (UserInvitation, error)
 	CreateUserPreferences(ctx context.Context, arg CreateUserPreferencesParams) (UserPreference, error)
 	// ============================================================================
 	// SQLC Queries: User Units (Vínculo Usuário-Unidade)
 	// ============================================================================
 	CreateUserUnit(ctx context.Context, arg CreateUserUnitParams) (UserUnit, error)
+	// ============================================================================
+	// LISTA DE ESPERA
+	// ============================================================================
+	CreateWaitlistEntry(ctx context.Context, arg CreateWaitlistEntryParams) (WaitlistEntry, error)
+	// ============================================================================
+	// OFERTAS
+	// ============================================================================
+	// Não cria se o horário já tem oferta pendente (índice único parcial)
+	CreateWaitlistOffer(ctx context.Context, arg CreateWaitlistOfferParams) (int64, error)
+	// ============================================================================
+	// FILA DE ENCAIXE
+	// ============================================================================
+	CreateWalkIn(ctx context.Context, arg CreateWalkInParams) (WalkIn, error)
+	CreateWebhookDelivery(ctx context.Context, arg CreateWebhookDeliveryParams) error
+	CreateWebhookDeliveryAttempt(ctx context.Context, arg CreateWebhookDeliveryAttemptParams) error
+	CreateWebhookEndpoint(ctx context.Context, arg CreateWebhookEndpointParams) (WebhookEndpoint, error)
 	// ============================================================
 	// ASAAS_WEBHOOK_LOGS (Auditoria de Webhooks)
 	// ============================================================
@@ -321,12 +421,19 @@ type Querier interface {
 	// Desativar plano (soft delete - REGRA PL-003)
 	DeactivatePlan(ctx context.Context, arg DeactivatePlanParams) error
 	DeductAdvance(ctx context.Context, arg DeductAdvanceParams) (Advance, error)
+	DefinirBloqueioLogin(ctx context.Context, arg DefinirBloqueioLoginParams) error
 	DeleteAdvance(ctx context.Context, arg DeleteAdvanceParams) error
 	DeleteAllUnitUsers(ctx context.Context, unitID pgtype.UUID) error
 	DeleteAllUserUnits(ctx context.Context, userID pgtype.UUID) error
 	DeleteAppointment(ctx context.Context, arg DeleteAppointmentParams) error
 	DeleteAppointmentServices(ctx context.Context, appointmentID pgtype.UUID) error
 	DeleteBlockedTime(ctx context.Context, arg DeleteBlockedTimeParams) error
+	DeleteCalendarConnection(ctx context.Context, arg DeleteCalendarConnectionParams) error
+	// Remove os bloqueios importados da conexão
+	DeleteCalendarConnectionBlockedTimes(ctx context.Context, connectionID pgtype.UUID) error
+	DeleteCalendarEventLink(ctx context.Context, arg DeleteCalendarEventLinkParams) error
+	// Só remove se o agendamento não voltou para a fila durante o envio
+	DeleteCalendarSync(ctx context.Context, arg DeleteCalendarSyncParams) error
 	// ============================================================================
 	// DELETE
 	// ============================================================================
@@ -347,6 +454,9 @@ type Querier interface {
 	DeleteDREMensal(ctx context.Context, arg DeleteDREMensalParams) error
 	// Remove uma despesa fixa
 	DeleteDespesaFixa(ctx context.Context, arg DeleteDespesaFixaParams) error
+	DeleteExpiredMFAChallenges(ctx context.Context) error
+	// Remove buckets sem uso (já cheios de novo) para manter a tabela pequena
+	DeleteExpiredRateLimitBuckets(ctx context.Context) (int64, error)
 	DeleteExpiredRefreshTokens(ctx context.Context) error
 	DeleteFluxoCaixaDiario(ctx context.Context, arg DeleteFluxoCaixaDiarioParams) error
 	DeleteFornecedor(ctx context.Context, arg DeleteFornecedorParams) error
@@ -358,35 +468,63 @@ type Querier interface {
 	DeleteMetaBarbeiro(ctx context.Context, arg DeleteMetaBarbeiroParams) error
 	DeleteMetaMensal(ctx context.Context, arg DeleteMetaMensalParams) error
 	DeleteMetaTicketMedio(ctx context.Context, arg DeleteMetaTicketMedioParams) error
+	DeletePlanoContasMapeamento(ctx context.Context, arg DeletePlanoContasMapeamentoParams) (int64, error)
 	DeletePrecificacaoConfig(ctx context.Context, arg DeletePrecificacaoConfigParams) error
 	DeletePrecificacaoSimulacao(ctx context.Context, arg DeletePrecificacaoSimulacaoParams) error
 	DeleteProduto(ctx context.Context, arg DeleteProdutoParams) error
 	DeleteProdutoFornecedor(ctx context.Context, arg DeleteProdutoFornecedorParams) error
 	DeleteProfessional(ctx context.Context, arg DeleteProfessionalParams) error
 	DeleteProfessionalCategoryCommissionsByProfessional(ctx context.Context, arg DeleteProfessionalCategoryCommissionsByProfessionalParams) error
-	DeleteRefreshToken(ctx context.Context, token string) error
+	// ============================================================================
+	// CÓDIGOS DE RECUPERAÇÃO
+	// ============================================================================
+	DeleteRecoveryCodes(ctx context.Context, userID pgtype.UUID) error
+	// ============================================================================
+	// RECURSOS EXIGIDOS PELOS SERVIÇOS
+	// ============================================================================
+	DeleteServiceResources(ctx context.Context, arg DeleteServiceResourcesParams) error
 	// ============================================================================
 	// DELETE
 	// ============================================================================
 	DeleteServico(ctx context.Context, arg DeleteServicoParams) error
 	DeleteServicosByCategoria(ctx context.Context, arg DeleteServicosByCategoriaParams) error
+	DeleteTenantRole(ctx context.Context, arg DeleteTenantRoleParams) (int64, error)
 	DeleteUnit(ctx context.Context, arg DeleteUnitParams) error
 	DeleteUserPreferences(ctx context.Context, userID pgtype.UUID) error
+	DeleteUserTOTP(ctx context.Context, userID pgtype.UUID) error
 	DeleteUserUnit(ctx context.Context, arg DeleteUserUnitParams) error
+	DeleteWebhookEndpoint(ctx context.Context, arg DeleteWebhookEndpointParams) (int64, error)
+	// Encerra as séries ativas do cliente (ex.: cliente inativado)
+	// Marca o agendamento para envio; se já estava na fila, envia o quanto antes
+	EnqueueCalendarSync(ctx context.Context, arg EnqueueCalendarSyncParams) error
 	// Estornar conta quando webhook REFUNDED chegar
 	EstornarContaReceberViaAsaas(ctx context.Context, arg EstornarContaReceberViaAsaasParams) (ContasAReceber, error)
+	// Verifica se um lançamento interno já foi conciliado com alguma linha de extrato
+	ExistsBankStatementMatch(ctx context.Context, arg ExistsBankStatementMatchParams) (bool, error)
 	ExistsCaixaAberto(ctx context.Context, tenantID pgtype.UUID) (bool, error)
 	// Verifica se já existe despesa fixa com mesma descrição no tenant
 	ExistsDespesaFixaByDescricao(ctx context.Context, arg ExistsDespesaFixaByDescricaoParams) (bool, error)
 	ExistsMeioPagamentoByNome(ctx context.Context, arg ExistsMeioPagamentoByNomeParams) (bool, error)
+	// Encerra (todos os tenants) as entradas cujo período já passou
+	ExpireWaitlistEntries(ctx context.Context) (int64, error)
 	FecharCaixaDiario(ctx context.Context, arg FecharCaixaDiarioParams) (CaixaDiario, error)
 	// Finaliza o atendimento (serviços concluídos, aguardando pagamento)
 	FinishAppointment(ctx context.Context, arg FinishAppointmentParams) (Appointment, error)
+	// Chave válida: não revogada e não expirada
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (ApiKey, error)
 	GetAdvanceByID(ctx context.Context, arg GetAdvanceByIDParams) (GetAdvanceByIDRow, error)
 	GetAppointmentByID(ctx context.Context, arg GetAppointmentByIDParams) (GetAppointmentByIDRow, error)
+	GetAppointmentDepositByAppointment(ctx context.Context, arg GetAppointmentDepositByAppointmentParams) (AppointmentDeposit, error)
+	GetAppointmentDepositByAsaasPayment(ctx context.Context, asaasPaymentID *string) (AppointmentDeposit, error)
+	GetAppointmentSeries(ctx context.Context, arg GetAppointmentSeriesParams) (AppointmentSeries, error)
+	GetAppointmentSeriesNextIndex(ctx context.Context, seriesID pgtype.UUID) (int32, error)
+	GetAppointmentSeriesOccurrenceByAppointment(ctx context.Context, arg GetAppointmentSeriesOccurrenceByAppointmentParams) (AppointmentSeriesOccurrence, error)
 	GetAppointmentServices(ctx context.Context, appointmentID pgtype.UUID) ([]GetAppointmentServicesRow, error)
+	GetAuthSession(ctx context.Context, arg GetAuthSessionParams) (AuthSession, error)
 	// Lista barbeiros ativos que ainda não estão na lista da vez
 	GetAvailableBarbersForTurnList(ctx context.Context, tenantID pgtype.UUID) ([]GetAvailableBarbersForTurnListRow, error)
+	GetBankStatementImportByID(ctx context.Context, arg GetBankStatementImportByIDParams) (BankStatementImport, error)
+	GetBankStatementLineByID(ctx context.Context, arg GetBankStatementLineByIDParams) (BankStatementLine, error)
 	// ============================================================================
 	// READ / LIST
 	// ============================================================================
@@ -398,6 +536,9 @@ type Querier interface {
 	GetCaixaDiarioAberto(ctx context.Context, tenantID pgtype.UUID) (GetCaixaDiarioAbertoRow, error)
 	// ========== READ ==========
 	GetCaixaDiarioByID(ctx context.Context, arg GetCaixaDiarioByIDParams) (GetCaixaDiarioByIDRow, error)
+	GetCalendarConnection(ctx context.Context, arg GetCalendarConnectionParams) (CalendarConnection, error)
+	// Feed ativo do link (revogados não são encontrados)
+	GetCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (CalendarFeed, error)
 	// ============================================================================
 	// READ
 	// ============================================================================
@@ -439,6 +580,8 @@ type Querier interface {
 	// Resumo mensal para DRE
 	GetContasReceberResumoMensal(ctx context.Context, arg GetContasReceberResumoMensalParams) (GetContasReceberResumoMensalRow, error)
 	GetCurvaABC(ctx context.Context, tenantID pgtype.UUID) ([]GetCurvaABCRow, error)
+	// Agendamentos já ocorridos do cliente desde a data informada
+	GetCustomerAttendanceStats(ctx context.Context, arg GetCustomerAttendanceStatsParams) (GetCustomerAttendanceStatsRow, error)
 	GetCustomerByCPF(ctx context.Context, arg GetCustomerByCPFParams) (Cliente, error)
 	// ============================================================================
 	// READ
@@ -449,6 +592,10 @@ type Querier interface {
 	// EXPORTAÇÃO LGPD
 	// ============================================================================
 	GetCustomerDataForExport(ctx context.Context, arg GetCustomerDataForExportParams) (GetCustomerDataForExportRow, error)
+	GetCustomerDebtByCommandID(ctx context.Context, arg GetCustomerDebtByCommandIDParams) (CustomerDebt, error)
+	GetCustomerDebtByID(ctx context.Context, arg GetCustomerDebtByIDParams) (CustomerDebt, error)
+	// Saldo devedor total do cliente (dívidas ABERTA/PARCIAL)
+	GetCustomerDebtSaldo(ctx context.Context, arg GetCustomerDebtSaldoParams) (decimal.Decimal, error)
 	GetCustomerInfo(ctx context.Context, arg GetCustomerInfoParams) (GetCustomerInfoRow, error)
 	// ============================================================================
 	// ESTATÍSTICAS E RELATÓRIOS
@@ -473,6 +620,11 @@ type Querier interface {
 	GetLastOperacao(ctx context.Context, arg GetLastOperacaoParams) (GetLastOperacaoRow, error)
 	// Última conciliação executada
 	GetLastReconciliation(ctx context.Context, tenantID pgtype.UUID) (AsaasReconciliationLog, error)
+	// ============================================================================
+	// BLOQUEIO DE TENTATIVAS DE LOGIN
+	// ============================================================================
+	GetLoginThrottle(ctx context.Context, chave string) (AuthLoginThrottle, error)
+	GetMFAChallenge(ctx context.Context, tokenHash string) (AuthMfaChallenge, error)
 	GetMatrizUnit(ctx context.Context, tenantID pgtype.UUID) (Unit, error)
 	// ============================================================================
 	// READ
@@ -492,8 +644,15 @@ type Querier interface {
 	// Retorna o próximo número sequencial para comandas do tenant no ano atual
 	// Extrai apenas o número sequencial após o último hífen (ex: CMD-2025-00001 -> 00001)
 	GetNextCommandNumber(ctx context.Context, tenantID pgtype.UUID) (int32, error)
+	// ============================================================================
+	// POLÍTICA DE NÃO COMPARECIMENTO
+	// ============================================================================
+	GetNoShowPolicy(ctx context.Context, tenantID pgtype.UUID) (NoShowPolicy, error)
 	// Buscar pagamento pelo ID do Asaas (para webhooks)
 	GetPaymentByAsaasID(ctx context.Context, asaasPaymentID *string) (SubscriptionPayment, error)
+	// Convite ainda aceitável, com os nomes do tenant e da unidade para exibição
+	GetPendingInvitationByHash(ctx context.Context, tokenHash string) (GetPendingInvitationByHashRow, error)
+	GetPendingWaitlistOfferByEntry(ctx context.Context, arg GetPendingWaitlistOfferByEntryParams) (WaitlistOffer, error)
 	// Buscar plano por ID (sempre com tenant_id)
 	GetPlanByID(ctx context.Context, arg GetPlanByIDParams) (Plan, error)
 	GetPrecificacaoConfigByID(ctx context.Context, arg GetPrecificacaoConfigByIDParams) (PrecificacaoConfig, error)
@@ -512,7 +671,13 @@ type Querier interface {
 	GetProfessionalCategoryCommission(ctx context.Context, arg GetProfessionalCategoryCommissionParams) (string, error)
 	GetProfessionalInfo(ctx context.Context, arg GetProfessionalInfoParams) (GetProfessionalInfoRow, error)
 	GetReconciliationLogByID(ctx context.Context, arg GetReconciliationLogByIDParams) (AsaasReconciliationLog, error)
-	GetRefreshToken(ctx context.Context, token string) (RefreshToken, error)
+	// Retorna também tokens usados/expirados: o use case decide (reuso x expiração)
+	GetRefreshTokenByHash(ctx context.Context, tokenHash string) (GetRefreshTokenByHashRow, error)
+	GetResource(ctx context.Context, arg GetResourceParams) (Resource, error)
+	// Pico de uso simultâneo do recurso no período: o uso de cada reserva vai do
+	// início do serviço + offset até offset + duração (limitado ao fim do
+	// serviço). Agendamentos cancelados ou com falta não contam.
+	GetResourcePeakUsage(ctx context.Context, arg GetResourcePeakUsageParams) (int32, error)
 	GetServiceInfo(ctx context.Context, arg GetServiceInfoParams) (GetServiceInfoRow, error)
 	GetServicesByIDs(ctx context.Context, arg GetServicesByIDsParams) ([]GetServicesByIDsRow, error)
 	// Busca todos os serviços de múltiplos agendamentos de uma vez (evita N+1)
@@ -534,6 +699,11 @@ type Querier interface {
 	// Breakdown por plano (Seção 5.1)
 	GetSubscriptionsByPlanBreakdown(ctx context.Context, tenantID pgtype.UUID) ([]GetSubscriptionsByPlanBreakdownRow, error)
 	// ============================================================================
+	// POLÍTICA DO TENANT
+	// ============================================================================
+	GetTenantAuthPolicy(ctx context.Context, tenantID pgtype.UUID) (TenantAuthPolicy, error)
+	GetTenantRole(ctx context.Context, arg GetTenantRoleParams) (TenantRole, error)
+	// ============================================================================
 	// ESTATÍSTICAS DIÁRIAS
 	// ============================================================================
 	// Estatísticas do dia atual
@@ -552,19 +722,41 @@ type Querier interface {
 	GetUserDefaultUnit(ctx context.Context, userID pgtype.UUID) (GetUserDefaultUnitRow, error)
 	GetUserPreferencesByID(ctx context.Context, id pgtype.UUID) (UserPreference, error)
 	GetUserPreferencesByUserID(ctx context.Context, userID pgtype.UUID) (UserPreference, error)
+	// ============================================================================
+	// SQLC Queries: Autenticação em dois fatores (TOTP)
+	// ============================================================================
+	GetUserTOTP(ctx context.Context, userID pgtype.UUID) (UserTotp, error)
 	GetUserUnit(ctx context.Context, arg GetUserUnitParams) (UserUnit, error)
 	GetValorTotalEstoque(ctx context.Context, tenantID pgtype.UUID) (GetValorTotalEstoqueRow, error)
+	GetWaitlistEntry(ctx context.Context, arg GetWaitlistEntryParams) (WaitlistEntry, error)
+	GetWaitlistOfferByTokenHash(ctx context.Context, tokenHash string) (WaitlistOffer, error)
+	GetWalkIn(ctx context.Context, arg GetWalkInParams) (GetWalkInRow, error)
+	GetWebhookDelivery(ctx context.Context, arg GetWebhookDeliveryParams) (WebhookDelivery, error)
+	GetWebhookEndpoint(ctx context.Context, arg GetWebhookEndpointParams) (WebhookEndpoint, error)
 	GetWebhookLogByID(ctx context.Context, id pgtype.UUID) (AsaasWebhookLog, error)
 	// Buscar log por payment ID (para verificar duplicatas)
 	GetWebhookLogByPaymentID(ctx context.Context, arg GetWebhookLogByPaymentIDParams) (AsaasWebhookLog, error)
+	HasCalendarConnections(ctx context.Context, arg HasCalendarConnectionsParams) (bool, error)
 	// ============================================================================
 	// DELETE (Soft Delete)
 	// ============================================================================
 	InactivateCustomer(ctx context.Context, arg InactivateCustomerParams) error
+	IncrementMFAChallengeTentativas(ctx context.Context, id pgtype.UUID) (int32, error)
 	// Incrementar contador de serviços utilizados (RN-BEN-002)
 	IncrementServicosUtilizados(ctx context.Context, arg IncrementServicosUtilizadosParams) error
+	// Um novo pedido (ou a troca de senha) invalida os links anteriores
+	InvalidatePasswordResetTokens(ctx context.Context, userID pgtype.UUID) error
+	LimparLoginThrottle(ctx context.Context, chave string) error
+	// Chaves do tenant (inclusive revogadas e expiradas, para auditoria)
+	ListAPIKeys(ctx context.Context, tenantID pgtype.UUID) ([]ApiKey, error)
+	// Sessões ativas do tenant; user_id NULL lista todos os usuários
+	ListActiveAuthSessions(ctx context.Context, arg ListActiveAuthSessionsParams) ([]ListActiveAuthSessionsRow, error)
 	// Lista apenas barbeiros ativos na fila (is_active = true)
 	ListActiveBarbersTurnList(ctx context.Context, tenantID pgtype.UUID) ([]ListActiveBarbersTurnListRow, error)
+	// Lista os barbeiros ativos na fila que atendem na unidade
+	ListActiveBarbersTurnListByUnit(ctx context.Context, arg ListActiveBarbersTurnListByUnitParams) ([]ListActiveBarbersTurnListByUnitRow, error)
+	// Conexões ativas de todos os tenants (sincronização periódica)
+	ListActiveCalendarConnections(ctx context.Context) ([]CalendarConnection, error)
 	ListActiveCustomers(ctx context.Context, tenantID pgtype.UUID) ([]ListActiveCustomersRow, error)
 	// Listar apenas planos ativos (para seleção em nova assinatura - REGRA PL-002)
 	ListActivePlansByTenant(ctx context.Context, tenantID pgtype.UUID) ([]Plan, error)
@@ -573,11 +765,22 @@ type Querier interface {
 	ListAdvancesByProfessional(ctx context.Context, arg ListAdvancesByProfessionalParams) ([]ListAdvancesByProfessionalRow, error)
 	ListAdvancesByStatus(ctx context.Context, arg ListAdvancesByStatusParams) ([]ListAdvancesByStatusRow, error)
 	ListAdvancesByTenant(ctx context.Context, arg ListAdvancesByTenantParams) ([]ListAdvancesByTenantRow, error)
+	ListAppointmentResources(ctx context.Context, appointmentID pgtype.UUID) ([]ListAppointmentResourcesRow, error)
+	ListAppointmentSeriesByCustomer(ctx context.Context, arg ListAppointmentSeriesByCustomerParams) ([]AppointmentSeries, error)
+	ListAppointmentSeriesOccurrences(ctx context.Context, arg ListAppointmentSeriesOccurrencesParams) ([]AppointmentSeriesOccurrence, error)
+	// Séries ativas (de todos os tenants) com ocorrências a gerar até o horizonte
+	ListAppointmentSeriesToGenerate(ctx context.Context, arg ListAppointmentSeriesToGenerateParams) ([]AppointmentSeries, error)
+	// Mudanças de status do agendamento, da mais antiga para a mais recente
+	ListAppointmentStatusHistory(ctx context.Context, arg ListAppointmentStatusHistoryParams) ([]ListAppointmentStatusHistoryRow, error)
 	ListAppointments(ctx context.Context, arg ListAppointmentsParams) ([]ListAppointmentsRow, error)
 	ListAppointmentsByCustomer(ctx context.Context, arg ListAppointmentsByCustomerParams) ([]ListAppointmentsByCustomerRow, error)
 	ListAppointmentsByProfessionalAndDateRange(ctx context.Context, arg ListAppointmentsByProfessionalAndDateRangeParams) ([]ListAppointmentsByProfessionalAndDateRangeRow, error)
 	ListApprovedAdvancesForProfessional(ctx context.Context, arg ListApprovedAdvancesForProfessionalParams) ([]Advance, error)
 	ListApprovedAdvancesNotDeducted(ctx context.Context, tenantID pgtype.UUID) ([]ListApprovedAdvancesNotDeductedRow, error)
+	ListBankStatementImports(ctx context.Context, arg ListBankStatementImportsParams) ([]BankStatementImport, error)
+	ListBankStatementLinesByImport(ctx context.Context, arg ListBankStatementLinesByImportParams) ([]BankStatementLine, error)
+	// Fila de revisão: lançamentos não conciliados ou com divergência de valor
+	ListBankStatementLinesRevisao(ctx context.Context, tenantID pgtype.UUID) ([]BankStatementLine, error)
 	ListBarbers(ctx context.Context, arg ListBarbersParams) ([]ListBarbersRow, error)
 	// Lista todos os barbeiros na fila ordenados por pontuação
 	// Menor pontuação = topo da fila
@@ -586,8 +789,20 @@ type Querier interface {
 	ListBarbersTurnList(ctx context.Context, arg ListBarbersTurnListParams) ([]ListBarbersTurnListRow, error)
 	// Lista bloqueios com filtros opcionais
 	ListBlockedTimes(ctx context.Context, arg ListBlockedTimesParams) ([]BlockedTime, error)
+	ListCaixaDiarioAguardandoAprovacao(ctx context.Context, tenantID pgtype.UUID) ([]ListCaixaDiarioAguardandoAprovacaoRow, error)
 	// ========== LIST ==========
 	ListCaixaDiarioHistorico(ctx context.Context, arg ListCaixaDiarioHistoricoParams) ([]ListCaixaDiarioHistoricoRow, error)
+	// Conexões do tenant (professional_id restringe ao profissional)
+	ListCalendarConnections(ctx context.Context, arg ListCalendarConnectionsParams) ([]ListCalendarConnectionsRow, error)
+	ListCalendarConnectionsByProfessionals(ctx context.Context, arg ListCalendarConnectionsByProfessionalsParams) ([]CalendarConnection, error)
+	ListCalendarEventLinks(ctx context.Context, appointmentID pgtype.UUID) ([]CalendarEventLink, error)
+	// Bloqueios do profissional (ou dos profissionais da unidade) que ainda
+	// aparecem no feed: recorrentes sempre, avulsos a partir de since
+	ListCalendarFeedBlockedTimes(ctx context.Context, arg ListCalendarFeedBlockedTimesParams) ([]ListCalendarFeedBlockedTimesRow, error)
+	// Feeds ativos da unidade, com o nome do profissional
+	ListCalendarFeeds(ctx context.Context, arg ListCalendarFeedsParams) ([]ListCalendarFeedsRow, error)
+	// Eventos importados da conexão com o fim do bloqueio
+	ListCalendarImportedEvents(ctx context.Context, connectionID pgtype.UUID) ([]ListCalendarImportedEventsRow, error)
 	ListCategoriasProdutos(ctx context.Context, arg ListCategoriasProdutosParams) ([]CategoriasProduto, error)
 	ListCategoriasProdutosAtivas(ctx context.Context, arg ListCategoriasProdutosAtivasParams) ([]CategoriasProduto, error)
 	ListCategoriasServicos(ctx context.Context, arg ListCategoriasServicosParams) ([]CategoriasServico, error)
@@ -616,6 +831,7 @@ type Querier interface {
 	ListContasPagarFiltered(ctx context.Context, arg ListContasPagarFilteredParams) ([]ContasAPagar, error)
 	ListContasPagarRecorrentes(ctx context.Context, tenantID pgtype.UUID) ([]ContasAPagar, error)
 	ListContasPagarVencidas(ctx context.Context, arg ListContasPagarVencidasParams) ([]ContasAPagar, error)
+	ListContasPagasByPeriod(ctx context.Context, arg ListContasPagasByPeriodParams) ([]ContasAPagar, error)
 	ListContasReceberByAssinatura(ctx context.Context, arg ListContasReceberByAssinaturaParams) ([]ContasAReceber, error)
 	ListContasReceberByCommandID(ctx context.Context, arg ListContasReceberByCommandIDParams) ([]ContasAReceber, error)
 	// Listar contas por competência
@@ -628,6 +844,11 @@ type Querier interface {
 	// Listar contas pendentes de assinaturas (para conciliação)
 	ListContasReceberPendentesAsaas(ctx context.Context, tenantID pgtype.UUID) ([]ListContasReceberPendentesAsaasRow, error)
 	ListContasReceberVencidas(ctx context.Context, arg ListContasReceberVencidasParams) ([]ContasAReceber, error)
+	// Relatório de fiado: clientes por saldo devedor e idade da dívida mais antiga
+	ListCustomerDebtAging(ctx context.Context, tenantID pgtype.UUID) ([]ListCustomerDebtAgingRow, error)
+	ListCustomerDebtPayments(ctx context.Context, arg ListCustomerDebtPaymentsParams) ([]CustomerDebtPayment, error)
+	// Lista dívidas do cliente; somente_abertas filtra ABERTA/PARCIAL
+	ListCustomerDebtsByCustomer(ctx context.Context, arg ListCustomerDebtsByCustomerParams) ([]CustomerDebt, error)
 	ListCustomers(ctx context.Context, arg ListCustomersParams) ([]Cliente, error)
 	ListCustomersWithoutAppointments(ctx context.Context, arg ListCustomersWithoutAppointmentsParams) ([]Cliente, error)
 	ListDREMensalByPeriod(ctx context.Context, arg ListDREMensalByPeriodParams) ([]DreMensal, error)
@@ -643,6 +864,9 @@ type Querier interface {
 	ListDespesasFixasByTenant(ctx context.Context, arg ListDespesasFixasByTenantParams) ([]DespesasFixa, error)
 	// Lista despesas fixas de uma unidade específica
 	ListDespesasFixasByUnidade(ctx context.Context, arg ListDespesasFixasByUnidadeParams) ([]DespesasFixa, error)
+	ListDueCalendarSync(ctx context.Context, limit int32) ([]CalendarSyncQueue, error)
+	// Ofertas pendentes com prazo vencido (todos os tenants)
+	ListExpiredWaitlistOffers(ctx context.Context, limite int32) ([]WaitlistOffer, error)
 	// Buscar assinaturas que vencem nos próximos N dias (para notificações)
 	ListExpiringSoon(ctx context.Context, arg ListExpiringSoonParams) ([]ListExpiringSoonRow, error)
 	ListFluxoCaixaDiarioByPeriod(ctx context.Context, arg ListFluxoCaixaDiarioByPeriodParams) ([]FluxoCaixaDiario, error)
@@ -669,6 +893,8 @@ type Querier interface {
 	ListOpenCommissionPeriods(ctx context.Context, tenantID pgtype.UUID) ([]ListOpenCommissionPeriodsRow, error)
 	ListOperacoesByCaixa(ctx context.Context, arg ListOperacoesByCaixaParams) ([]ListOperacoesByCaixaRow, error)
 	ListOperacoesByCaixaAndTipo(ctx context.Context, arg ListOperacoesByCaixaAndTipoParams) ([]ListOperacoesByCaixaAndTipoRow, error)
+	ListOperacoesByPeriodo(ctx context.Context, arg ListOperacoesByPeriodoParams) ([]ListOperacoesByPeriodoRow, error)
+	ListOverdueAppointmentDeposits(ctx context.Context, limite int32) ([]AppointmentDeposit, error)
 	// Buscar assinaturas vencidas para o cron job (RN-VENC-003, RN-VENC-004)
 	ListOverdueSubscriptions(ctx context.Context) ([]ListOverdueSubscriptionsRow, error)
 	// Listar histórico de pagamentos de uma assinatura
@@ -685,6 +911,7 @@ type Querier interface {
 	ListPendingAdvances(ctx context.Context, tenantID pgtype.UUID) ([]ListPendingAdvancesRow, error)
 	ListPendingCommissionItems(ctx context.Context, tenantID pgtype.UUID) ([]ListPendingCommissionItemsRow, error)
 	ListPendingCommissionItemsByProfessional(ctx context.Context, arg ListPendingCommissionItemsByProfessionalParams) ([]ListPendingCommissionItemsByProfessionalRow, error)
+	ListPlanoContasMapeamentos(ctx context.Context, tenantID pgtype.UUID) ([]PlanoContasMapeamento, error)
 	// Listar todos os planos de um tenant
 	ListPlansByTenant(ctx context.Context, tenantID pgtype.UUID) ([]Plan, error)
 	ListProdutos(ctx context.Context, tenantID pgtype.UUID) ([]Produto, error)
@@ -699,6 +926,10 @@ type Querier interface {
 	ListProfessionals(ctx context.Context, arg ListProfessionalsParams) ([]ListProfessionalsRow, error)
 	// Listar logs de conciliação
 	ListReconciliationLogs(ctx context.Context, arg ListReconciliationLogsParams) ([]AsaasReconciliationLog, error)
+	// Recursos ativos da unidade exigidos pelos serviços informados
+	ListResourceRequirementsForServices(ctx context.Context, arg ListResourceRequirementsForServicesParams) ([]ListResourceRequirementsForServicesRow, error)
+	ListResources(ctx context.Context, arg ListResourcesParams) ([]Resource, error)
+	ListServiceResources(ctx context.Context, arg ListServiceResourcesParams) ([]ListServiceResourcesRow, error)
 	ListServicos(ctx context.Context, arg ListServicosParams) ([]ListServicosRow, error)
 	ListServicosAtivos(ctx context.Context, arg ListServicosAtivosParams) ([]ListServicosAtivosRow, error)
 	ListServicosByCategoria(ctx context.Context, arg ListServicosByCategoriaParams) ([]ListServicosByCategoriaRow, error)
@@ -722,6 +953,10 @@ type Querier interface {
 	// Listar assinaturas que precisam de sync (última sync > 24h)
 	ListSubscriptionsNeedingSync(ctx context.Context, arg ListSubscriptionsNeedingSyncParams) ([]Subscription, error)
 	// ============================================================================
+	// PAPÉIS PERSONALIZADOS (tenant_roles)
+	// ============================================================================
+	ListTenantRoles(ctx context.Context, tenantID pgtype.UUID) ([]TenantRole, error)
+	// ============================================================================
 	// HISTÓRICO
 	// ============================================================================
 	// Lista histórico mensal de atendimentos
@@ -730,15 +965,34 @@ type Querier interface {
 	ListUnitsByTenant(ctx context.Context, tenantID pgtype.UUID) ([]Unit, error)
 	// Listar webhooks não processados (para retry)
 	ListUnprocessedWebhooks(ctx context.Context, limit int32) ([]AsaasWebhookLog, error)
+	ListUserInvitations(ctx context.Context, tenantID pgtype.UUID) ([]UserInvitation, error)
+	// Papel efetivo do usuário em cada unidade ativa (mesma regra de ResolveUserUnit)
+	ListUserUnitRoles(ctx context.Context, userID pgtype.UUID) ([]string, error)
 	ListUserUnits(ctx context.Context, userID pgtype.UUID) ([]ListUserUnitsRow, error)
 	ListUsersWithAnalyticsEnabled(ctx context.Context) ([]pgtype.UUID, error)
 	ListUsersWithMarketingEnabled(ctx context.Context) ([]pgtype.UUID, error)
+	// Clientes aguardando cujo período cruza o horário liberado e que aceitam o
+	// profissional, por prioridade. Quem já recebeu oferta deste horário fica de
+	// fora (recusou ou deixou expirar).
+	ListWaitlistCandidates(ctx context.Context, arg ListWaitlistCandidatesParams) ([]WaitlistEntry, error)
+	ListWaitlistEntries(ctx context.Context, arg ListWaitlistEntriesParams) ([]WaitlistEntry, error)
+	// Chegadas da unidade a partir de since, na ordem de chegada
+	ListWalkIns(ctx context.Context, arg ListWalkInsParams) ([]ListWalkInsRow, error)
+	// Entregas do tenant, mais recentes primeiro, com filtros opcionais
+	ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]WebhookDelivery, error)
+	ListWebhookDeliveryAttempts(ctx context.Context, deliveryID pgtype.UUID) ([]WebhookDeliveryAttempt, error)
+	ListWebhookEndpoints(ctx context.Context, tenantID pgtype.UUID) ([]WebhookEndpoint, error)
+	// Endpoints ativos do tenant inscritos no evento
+	ListWebhookEndpointsForEvent(ctx context.Context, arg ListWebhookEndpointsForEventParams) ([]WebhookEndpoint, error)
 	// Histórico de webhooks de um payment
 	ListWebhooksByPaymentID(ctx context.Context, asaasPaymentID *string) ([]AsaasWebhookLog, error)
 	// Histórico de webhooks de uma subscription
 	ListWebhooksBySubscriptionID(ctx context.Context, asaasSubscriptionID *string) ([]AsaasWebhookLog, error)
 	// Listar webhooks por tenant (para auditoria)
 	ListWebhooksByTenant(ctx context.Context, arg ListWebhooksByTenantParams) ([]AsaasWebhookLog, error)
+	// Trava o recurso até o fim da transação: reservas concorrentes do mesmo
+	// recurso são verificadas uma de cada vez
+	LockResourceCapacity(ctx context.Context, arg LockResourceCapacityParams) (int32, error)
 	MarcarComoCompensado(ctx context.Context, arg MarcarComoCompensadoParams) (CompensacoesBancaria, error)
 	MarcarContaPagarComoAtrasada(ctx context.Context, arg MarcarContaPagarComoAtrasadaParams) error
 	MarcarContaPagarComoPaga(ctx context.Context, arg MarcarContaPagarComoPagaParams) (ContasAPagar, error)
@@ -748,15 +1002,29 @@ type Querier interface {
 	MarcarContaReceberRecebidaViaAsaas(ctx context.Context, arg MarcarContaReceberRecebidaViaAsaasParams) (ContasAReceber, error)
 	MarkCommissionItemAsPaid(ctx context.Context, arg MarkCommissionItemAsPaidParams) (CommissionItem, error)
 	MarkCommissionPeriodAsPaid(ctx context.Context, arg MarkCommissionPeriodAsPaidParams) (CommissionPeriod, error)
+	MarkRefreshTokenUsed(ctx context.Context, id pgtype.UUID) (int64, error)
 	// Marcar webhook como falha
 	MarkWebhookFailed(ctx context.Context, arg MarkWebhookFailedParams) error
 	// Marcar webhook como processado com sucesso
 	MarkWebhookProcessed(ctx context.Context, id pgtype.UUID) error
+	// Assinaturas de clientes inadimplentes
+	MetricsAssinaturasInadimplentes(ctx context.Context) ([]MetricsAssinaturasInadimplentesRow, error)
+	// Há quanto tempo cada caixa aberto está aberto
+	MetricsCaixasAbertos(ctx context.Context) ([]MetricsCaixasAbertosRow, error)
+	// Comandas fechadas hoje (fuso America/Sao_Paulo); unidade vem do agendamento
+	MetricsComandasFechadasHoje(ctx context.Context) ([]MetricsComandasFechadasHojeRow, error)
+	// Fechamentos de hoje com divergência e a soma absoluta das diferenças
+	MetricsDivergenciaCaixaHoje(ctx context.Context) ([]MetricsDivergenciaCaixaHojeRow, error)
+	// Agendamentos concluídos e faltas nos últimos 7 dias
+	MetricsNoShowUltimos7Dias(ctx context.Context) ([]MetricsNoShowUltimos7DiasRow, error)
+	// Receita de hoje por tipo de meio de pagamento (conjunto fixo: PIX, DINHEIRO...)
+	MetricsReceitaHojePorFormaPagamento(ctx context.Context) ([]MetricsReceitaHojePorFormaPagamentoRow, error)
 	ProcessCommissionItem(ctx context.Context, arg ProcessCommissionItemParams) (CommissionItem, error)
 	// ============================================================================
 	// QUERIES AUXILIARES: Profissionais, Clientes, Serviços (Read-Only)
 	// ============================================================================
 	ProfessionalExists(ctx context.Context, arg ProfessionalExistsParams) (bool, error)
+	ReabrirCaixaDiario(ctx context.Context, arg ReabrirCaixaDiarioParams) (CaixaDiario, error)
 	ReactivateCustomer(ctx context.Context, arg ReactivateCustomerParams) error
 	ReativarFornecedor(ctx context.Context, arg ReativarFornecedorParams) error
 	// ============================================================================
@@ -764,6 +1032,10 @@ type Querier interface {
 	// ============================================================================
 	// Registra um atendimento: incrementa pontos (+1) e atualiza timestamp
 	RecordTurn(ctx context.Context, arg RecordTurnParams) (BarbersTurnList, error)
+	// Reenvio manual: volta a entrega para a fila com o ciclo de retentativas zerado
+	RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (int64, error)
+	// Falhas anteriores a janela_inicio não contam: o contador recomeça
+	RegistrarFalhaLogin(ctx context.Context, arg RegistrarFalhaLoginParams) (int32, error)
 	RejectAdvance(ctx context.Context, arg RejectAdvanceParams) (Advance, error)
 	RejeitarMetaMensal(ctx context.Context, arg RejeitarMetaMensalParams) (MetasMensai, error)
 	// ============================================================================
@@ -780,13 +1052,37 @@ type Querier interface {
 	ResetAllTurnPoints(ctx context.Context, tenantID pgtype.UUID) error
 	// Resetar contador de serviços na renovação (RN-BEN-004)
 	ResetServicosUtilizados(ctx context.Context, arg ResetServicosUtilizadosParams) error
+	// Unidade e papel efetivo do usuário nela. unit_id NULL escolhe a unidade padrão
+	// (ou a primeira ativa). O papel personalizado (custom_role_id) e depois o papel
+	// da unidade (role_override) prevalecem sobre users.role, exceto para o dono,
+	// que mantém acesso total em todas as unidades.
+	ResolveUserUnit(ctx context.Context, arg ResolveUserUnitParams) (ResolveUserUnitRow, error)
+	RetryCalendarSync(ctx context.Context, arg RetryCalendarSyncParams) error
 	ReverseCommissionItem(ctx context.Context, arg ReverseCommissionItemParams) (CommissionItem, error)
+	RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error)
+	RevokeAuthSession(ctx context.Context, arg RevokeAuthSessionParams) (int64, error)
+	// professional_id restringe aos feeds do próprio barbeiro
+	RevokeCalendarFeed(ctx context.Context, arg RevokeCalendarFeedParams) (int64, error)
+	RevokeOtherAuthSessions(ctx context.Context, arg RevokeOtherAuthSessionsParams) (int64, error)
+	// ============================================================================
+	// CONVITES DE COLABORADORES
+	// ============================================================================
+	RevokePendingInvitationsByEmail(ctx context.Context, arg RevokePendingInvitationsByEmailParams) error
+	RevokeUserInvitation(ctx context.Context, arg RevokeUserInvitationParams) (int64, error)
+	RotateWebhookEndpointSecret(ctx context.Context, arg RotateWebhookEndpointSecretParams) (int64, error)
+	// ============================================================================
+	// REFRESH TOKENS (armazenados como hash SHA-256)
+	// ============================================================================
 	SaveRefreshToken(ctx context.Context, arg SaveRefreshTokenParams) error
 	// Salva snapshot no histórico antes do reset
 	SaveTurnHistoryBeforeReset(ctx context.Context, arg SaveTurnHistoryBeforeResetParams) error
 	SearchCustomers(ctx context.Context, arg SearchCustomersParams) ([]SearchCustomersRow, error)
 	SearchServicos(ctx context.Context, arg SearchServicosParams) ([]SearchServicosRow, error)
 	ServiceExists(ctx context.Context, arg ServiceExistsParams) (bool, error)
+	SetAppointmentDepositCharge(ctx context.Context, arg SetAppointmentDepositChargeParams) (AppointmentDeposit, error)
+	SetAppointmentGoogleCalendarEventID(ctx context.Context, arg SetAppointmentGoogleCalendarEventIDParams) error
+	// Unidade ativa da sessão (login, troca de unidade e refresh)
+	SetAuthSessionUnit(ctx context.Context, arg SetAuthSessionUnitParams) error
 	// Ativa um barbeiro na fila
 	SetBarberTurnActive(ctx context.Context, arg SetBarberTurnActiveParams) (BarbersTurnList, error)
 	// Pausa um barbeiro na fila
@@ -834,6 +1130,9 @@ type Querier interface {
 	SumReconciliationDivergences(ctx context.Context, arg SumReconciliationDivergencesParams) (SumReconciliationDivergencesRow, error)
 	SumSaidasByPeriod(ctx context.Context, arg SumSaidasByPeriodParams) (interface{}, error)
 	SumValorLiquidoByPeriod(ctx context.Context, arg SumValorLiquidoByPeriodParams) (interface{}, error)
+	// Token bucket atômico: repõe os tokens pelo tempo decorrido (relógio do banco,
+	// igual para todas as réplicas) e consome um, se houver.
+	TakeRateLimitToken(ctx context.Context, arg TakeRateLimitTokenParams) (TakeRateLimitTokenRow, error)
 	// Alterna status ativo/inativo de um barbeiro na fila
 	ToggleBarberTurnStatus(ctx context.Context, arg ToggleBarberTurnStatusParams) (BarbersTurnList, error)
 	ToggleCategoriaProdutoAtiva(ctx context.Context, arg ToggleCategoriaProdutoAtivaParams) (CategoriasProduto, error)
@@ -843,12 +1142,34 @@ type Querier interface {
 	ToggleMeioPagamentoAtivo(ctx context.Context, arg ToggleMeioPagamentoAtivoParams) (MeiosPagamento, error)
 	ToggleServicoStatus(ctx context.Context, arg ToggleServicoStatusParams) (Servico, error)
 	ToggleUnitStatus(ctx context.Context, arg ToggleUnitStatusParams) (Unit, error)
+	// Atualiza o último uso no máximo uma vez por minuto por chave, para não
+	// gerar uma escrita por requisição
+	TouchAPIKeyLastUsed(ctx context.Context, arg TouchAPIKeyLastUsedParams) error
+	TouchAuthSession(ctx context.Context, arg TouchAuthSessionParams) error
+	// Registra o último acesso do aplicativo de calendário
+	TouchCalendarFeed(ctx context.Context, id pgtype.UUID) error
+	// Só muda o status se o sinal ainda estiver em from_status
+	TransitionAppointmentDeposit(ctx context.Context, arg TransitionAppointmentDepositParams) (int64, error)
+	// Muda o status só se a oferta ainda estiver no status esperado (evita
+	// confirmação dupla e corrida com a expiração)
+	TransitionWaitlistOffer(ctx context.Context, arg TransitionWaitlistOfferParams) (int64, error)
+	// Muda o status se a chegada ainda estiver em from_status (evita que duas
+	// recepções encaixem o mesmo cliente)
+	TransitionWalkIn(ctx context.Context, arg TransitionWalkInParams) (int64, error)
 	UpdateAppointment(ctx context.Context, arg UpdateAppointmentParams) (Appointment, error)
+	UpdateAppointmentSeries(ctx context.Context, arg UpdateAppointmentSeriesParams) (AppointmentSeries, error)
+	UpdateAppointmentSeriesOccurrence(ctx context.Context, arg UpdateAppointmentSeriesOccurrenceParams) error
 	UpdateAppointmentStatus(ctx context.Context, arg UpdateAppointmentStatusParams) (Appointment, error)
+	// Recalcula os totais do import a partir das linhas
+	UpdateBankStatementImportTotais(ctx context.Context, arg UpdateBankStatementImportTotaisParams) error
+	UpdateBankStatementLineConciliacao(ctx context.Context, arg UpdateBankStatementLineConciliacaoParams) error
 	UpdateBlockedTime(ctx context.Context, arg UpdateBlockedTimeParams) (BlockedTime, error)
 	// ========== UPDATE ==========
 	UpdateCaixaDiario(ctx context.Context, arg UpdateCaixaDiarioParams) (CaixaDiario, error)
 	UpdateCaixaDiarioTotais(ctx context.Context, arg UpdateCaixaDiarioTotaisParams) error
+	// Resultado da leitura do calendário externo
+	UpdateCalendarConnectionSync(ctx context.Context, arg UpdateCalendarConnectionSyncParams) error
+	UpdateCalendarConnectionTokens(ctx context.Context, arg UpdateCalendarConnectionTokensParams) error
 	// ============================================================================
 	// UPDATE
 	// ============================================================================
@@ -908,6 +1229,7 @@ type Querier interface {
 	UpdateProdutoFornecedor(ctx context.Context, arg UpdateProdutoFornecedorParams) (ProdutoFornecedor, error)
 	UpdateProfessional(ctx context.Context, arg UpdateProfessionalParams) (UpdateProfessionalRow, error)
 	UpdateProfessionalStatus(ctx context.Context, arg UpdateProfessionalStatusParams) (UpdateProfessionalStatusRow, error)
+	UpdateResource(ctx context.Context, arg UpdateResourceParams) (Resource, error)
 	// ============================================================================
 	// UPDATE
 	// ============================================================================
@@ -929,20 +1251,41 @@ type Querier interface {
 	UpdateSubscriptionStatus(ctx context.Context, arg UpdateSubscriptionStatusParams) error
 	// Atualizar status interno e status Asaas juntos
 	UpdateSubscriptionStatusWithAsaas(ctx context.Context, arg UpdateSubscriptionStatusWithAsaasParams) error
+	UpdateTenantRole(ctx context.Context, arg UpdateTenantRoleParams) (TenantRole, error)
 	UpdateUnit(ctx context.Context, arg UpdateUnitParams) (Unit, error)
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) error
 	UpdateUserPreferences(ctx context.Context, arg UpdateUserPreferencesParams) (UserPreference, error)
 	UpdateUserUnitRole(ctx context.Context, arg UpdateUserUnitRoleParams) (UserUnit, error)
+	UpdateWaitlistEntryStatus(ctx context.Context, arg UpdateWaitlistEntryStatusParams) (WaitlistEntry, error)
+	// Resultado da tentativa: ENTREGUE, PENDENTE (nova tentativa agendada) ou FALHOU
+	UpdateWebhookDeliveryResult(ctx context.Context, arg UpdateWebhookDeliveryResultParams) error
+	UpdateWebhookEndpoint(ctx context.Context, arg UpdateWebhookEndpointParams) (WebhookEndpoint, error)
+	// ============================================================================
+	// SINCRONIZAÇÃO COM CALENDÁRIOS EXTERNOS
+	// ============================================================================
+	// Conectar de novo a mesma conta troca as credenciais e reinicia a leitura
+	UpsertCalendarConnection(ctx context.Context, arg UpsertCalendarConnectionParams) (CalendarConnection, error)
+	UpsertCalendarEventLink(ctx context.Context, arg UpsertCalendarEventLinkParams) error
 	// ============================================================
 	// CONTAS_A_RECEBER - Queries v2 (Integração Asaas)
 	// ============================================================
 	// Criar ou atualizar conta a receber via webhook (idempotente)
 	// Nota: índice único é (tenant_id, asaas_payment_id)
 	UpsertContaReceberByAsaasPaymentID(ctx context.Context, arg UpsertContaReceberByAsaasPaymentIDParams) (ContasAReceber, error)
+	UpsertNoShowPolicy(ctx context.Context, arg UpsertNoShowPolicyParams) (NoShowPolicy, error)
 	// ============================================================
 	// SUBSCRIPTION_PAYMENTS - Queries v2 (Integração Asaas)
 	// ============================================================
 	// Criar ou atualizar pagamento via webhook (idempotente)
 	UpsertPaymentByAsaasID(ctx context.Context, arg UpsertPaymentByAsaasIDParams) (SubscriptionPayment, error)
+	// Inicia (ou reinicia) o cadastro; não sobrescreve um 2FA já confirmado
+	UpsertPendingUserTOTP(ctx context.Context, arg UpsertPendingUserTOTPParams) (int64, error)
+	// ============================================================================
+	// PLANO DE CONTAS (mapeamento contábil)
+	// ============================================================================
+	UpsertPlanoContasMapeamento(ctx context.Context, arg UpsertPlanoContasMapeamentoParams) (PlanoContasMapeamento, error)
+	UpsertTenantAuthPolicy(ctx context.Context, arg UpsertTenantAuthPolicyParams) (TenantAuthPolicy, error)
+	UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -74,7 +74,7 @@ INSERT INTO user_units (
 ) VALUES (
     $1, $2, $3, $4
 )
-RETURNING id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em
+RETURNING id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em, custom_role_id
 `
 
 type CreateUserUnitParams struct {
@@ -103,6 +103,7 @@ func (q *Queries) CreateUserUnit(ctx context.Context, arg CreateUserUnitParams)
 		&i.RoleOverride,
 		&i.CriadoEm,
 		&i.AtualizadoEm,
+		&i.CustomRoleID,
 	)
 	return i, err
 }
@@ -143,7 +144,7 @@ func (q *Queries) DeleteUserUnit(ctx context.Context, arg DeleteUserUnitParams)
 }
 
 const getUserDefaultUnit = `-- name: GetUserDefaultUnit :one
-SELECT uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em, u.nome as unit_nome, u.apelido as unit_apelido, u.tenant_id
+SELECT uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em, uu.custom_role_id, u.nome as unit_nome, u.apelido as unit_apelido, u.tenant_id
 FROM user_units uu
 JOIN units u ON u.id = uu.unit_id
 WHERE uu.user_id = $1 AND uu.is_default = true
@@ -158,6 +159,7 @@ type GetUserDefaultUnitRow struct {
 	RoleOverride *string            `json:"role_override"`
 	CriadoEm     pgtype.Timestamptz `json:"criado_em"`
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
+	CustomRoleID pgtype.UUID        `json:"custom_role_id"`
 	UnitNome     string             `json:"unit_nome"`
 	UnitApelido  *string            `json:"unit_apelido"`
 	TenantID     pgtype.UUID        `json:"tenant_id"`
@@ -174,6 +176,7 @@ func (q *Queries) GetUserDefaultUnit(ctx context.Context, userID pgtype.UUID) (G
 		&i.RoleOverride,
 		&i.CriadoEm,
 		&i.AtualizadoEm,
+		&i.CustomRoleID,
 		&i.UnitNome,
 		&i.UnitApelido,
 		&i.TenantID,
@@ -182,7 +185,7 @@ func (q *Queries) GetUserDefaultUnit(ctx context.Context, userID pgtype.UUID) (G
 }
 
 const getUserUnit = `-- name: GetUserUnit :one
-SELECT id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em FROM user_units
+SELECT id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em, custom_role_id FROM user_units
 WHERE user_id = $1 AND unit_id = $2
 `
 
@@ -202,13 +205,14 @@ func (q *Queries) GetUserUnit(ctx context.Context, arg GetUserUnitParams) (UserU
 		&i.RoleOverride,
 		&i.CriadoEm,
 		&i.AtualizadoEm,
+		&i.CustomRoleID,
 	)
 	return i, err
 }
 
 const listUnitUsers = `-- name: ListUnitUsers :many
 SELECT 
-    uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em,
+    uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em, uu.custom_role_id,
     usr.nome as user_nome,
     usr.email as user_email,
     usr.role as user_role
@@ -226,6 +230,7 @@ type ListUnitUsersRow struct {
 	RoleOverride *string            `json:"role_override"`
 	CriadoEm     pgtype.Timestamptz `json:"criado_em"`
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
+	CustomRoleID pgtype.UUID        `json:"custom_role_id"`
 	UserNome     string             `json:"user_nome"`
 	UserEmail    string             `json:"user_email"`
 	UserRole     string             `json:"user_role"`
@@ -248,6 +253,7 @@ func (q *Queries) ListUnitUsers(ctx context.Context, unitID pgtype.UUID) ([]List
 			&i.RoleOverride,
 			&i.CriadoEm,
 			&i.AtualizadoEm,
+			&i.CustomRoleID,
 			&i.UserNome,
 			&i.UserEmail,
 			&i.UserRole,
@@ -264,7 +270,7 @@ func (q *Queries) ListUnitUsers(ctx context.Context, unitID pgtype.UUID) ([]List
 
 const listUserUnits = `-- name: ListUserUnits :many
 SELECT 
-    uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em,
+    uu.id, uu.user_id, uu.unit_id, uu.is_default, uu.role_override, uu.criado_em, uu.atualizado_em, uu.custom_role_id,
     u.nome as unit_nome,
     u.apelido as unit_apelido,
     u.is_matriz,
@@ -284,6 +290,7 @@ type ListUserUnitsRow struct {
 	RoleOverride *string            `json:"role_override"`
 	CriadoEm     pgtype.Timestamptz `json:"criado_em"`
 	AtualizadoEm pgtype.Timestamptz `json:"atualizado_em"`
+	CustomRoleID pgtype.UUID        `json:"custom_role_id"`
 	UnitNome     string             `json:"unit_nome"`
 	UnitApelido  *string            `json:"unit_apelido"`
 	IsMatriz     bool               `json:"is_matriz"`
@@ -308,6 +315,7 @@ func (q *Queries) ListUserUnits(ctx context.Context, userID pgtype.UUID) ([]List
 			&i.RoleOverride,
 			&i.CriadoEm,
 			&i.AtualizadoEm,
+			&i.CustomRoleID,
 			&i.UnitNome,
 			&i.UnitApelido,
 			&i.IsMatriz,
@@ -348,7 +356,7 @@ const updateUserUnitRole = `-- name: UpdateUserUnitRole :one
 UPDATE user_units
 SET role_override = $3, atualizado_em = NOW()
 WHERE user_id = $1 AND unit_id = $2
-RETURNING id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em
+RETURNING id, user_id, unit_id, is_default, role_override, criado_em, atualizado_em, custom_role_id
 `
 
 type UpdateUserUnitRoleParams struct {
@@ -368,6 +376,7 @@ func (q *Queries) UpdateUserUnitRole(ctx context.Context, arg UpdateUserUnitRole
 		&i.RoleOverride,
 		&i.CriadoEm,
 		&i.AtualizadoEm,
+		&i.CustomRoleID,
 	)
 	return i, err
 }
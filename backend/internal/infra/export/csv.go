@@ -0,0 +1,93 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+)
+
+// csvFlushEvery define a cada quantas linhas o buffer é descarregado no destino
+const csvFlushEvery = 200
+
+// csvWriter grava CSV no padrão do Excel pt-BR: UTF-8 com BOM, separador ";"
+// e números com vírgula decimal
+type csvWriter struct {
+	w      *csv.Writer
+	cols   []Column
+	linhas int
+}
+
+func newCSVWriter(w io.Writer, doc Document) (*csvWriter, error) {
+	// BOM para o Excel reconhecer UTF-8 (acentuação)
+	if _, err := io.WriteString(w, "\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+
+	header := make([]string, len(doc.Columns))
+	for i, col := range doc.Columns {
+		header[i] = col.Title
+	}
+	if err := cw.Write(header); err != nil {
+		return nil, err
+	}
+
+	return &csvWriter{w: cw, cols: doc.Columns}, nil
+}
+
+func (c *csvWriter) WriteRow(values ...any) error {
+	record := make([]string, len(c.cols))
+	for i, col := range c.cols {
+		if i >= len(values) {
+			break
+		}
+		record[i] = csvValue(col, values[i])
+	}
+	if err := c.w.Write(record); err != nil {
+		return err
+	}
+
+	c.linhas++
+	if c.linhas%csvFlushEvery == 0 {
+		c.w.Flush()
+		return c.w.Error()
+	}
+	return nil
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func csvValue(col Column, v any) string {
+	switch col.Kind {
+	case KindMoney, KindPercent:
+		if d, ok := toDecimal(v); ok {
+			return plainDecimal(d, 2)
+		}
+	case KindNumber:
+		if d, ok := toDecimal(v); ok {
+			return plainDecimal(d, casasNumero(d))
+		}
+	case KindDate:
+		if t, ok := dateValue(v); ok {
+			return FormatDate(t)
+		}
+		return ""
+	case KindText:
+		return semFormula(toText(v))
+	}
+	return toText(v)
+}
+
+// semFormula prefixa com apóstrofo o texto que o Excel ou o Sheets
+// executariam como fórmula (nomes e observações digitados por clientes)
+func semFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,121 @@
+// Package export gera arquivos de relatório (CSV, XLSX e PDF) a partir de linhas tabulares.
+//
+// Os writers são incrementais: cada linha é gravada no destino assim que recebida
+// (CSV e XLSX de forma contínua; PDF página a página), permitindo exportar listas
+// grandes sem montar o arquivo inteiro em memória.
+package export
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format representa o formato de arquivo exportado
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+	FormatPDF  Format = "pdf"
+)
+
+// ErrFormatoInvalido indica um formato de exportação não suportado
+var ErrFormatoInvalido = errors.New("formato de exportação inválido (csv, xlsx, pdf)")
+
+// ParseFormat converte o parâmetro ?format= em Format
+func ParseFormat(s string) (Format, error) {
+	switch Format(strings.ToLower(strings.TrimSpace(s))) {
+	case FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX, "excel":
+		return FormatXLSX, nil
+	case FormatPDF:
+		return FormatPDF, nil
+	}
+	return "", ErrFormatoInvalido
+}
+
+// ContentType retorna o MIME type do formato
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case FormatPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// Filename monta o nome do arquivo com a extensão do formato
+func (f Format) Filename(base string) string {
+	return fmt.Sprintf("%s.%s", base, f)
+}
+
+// Kind define como o valor de uma coluna é formatado
+type Kind int
+
+const (
+	KindText    Kind = iota // string
+	KindMoney               // decimal.Decimal (R$)
+	KindNumber              // decimal.Decimal ou int
+	KindPercent             // decimal.Decimal em pontos percentuais (25.5 = 25,5%)
+	KindDate                // time.Time ou *time.Time
+)
+
+// Column descreve uma coluna do relatório
+type Column struct {
+	Title string
+	Kind  Kind
+	// Width é o peso relativo da coluna no PDF (0 = padrão pelo tipo)
+	Width float64
+}
+
+// Document descreve o cabeçalho do relatório
+type Document struct {
+	Title    string
+	Subtitle string // ex.: período, unidade
+	Columns  []Column
+}
+
+// Writer grava as linhas do relatório no formato escolhido.
+// Os valores de cada linha seguem a ordem de Document.Columns.
+type Writer interface {
+	WriteRow(values ...any) error
+	Close() error
+}
+
+// NewWriter cria o writer do formato informado já com o cabeçalho gravado
+func NewWriter(format Format, w io.Writer, doc Document) (Writer, error) {
+	if len(doc.Columns) == 0 {
+		return nil, errors.New("relatório sem colunas")
+	}
+
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, doc)
+	case FormatXLSX:
+		return newXLSXWriter(w, doc)
+	case FormatPDF:
+		return newPDFWriter(w, doc)
+	}
+	return nil, ErrFormatoInvalido
+}
+
+// dateValue extrai a data de time.Time/*time.Time
+func dateValue(v any) (time.Time, bool) {
+	switch t := v.(type) {
+	case time.Time:
+		return t, !t.IsZero()
+	case *time.Time:
+		if t == nil || t.IsZero() {
+			return time.Time{}, false
+		}
+		return *t, true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,127 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDoc = export.Document{
+	Title:    "Contas a Pagar",
+	Subtitle: "Período: 01/01/2024 a 31/01/2024",
+	Columns: []export.Column{
+		{Title: "Vencimento", Kind: export.KindDate},
+		{Title: "Descrição", Kind: export.KindText},
+		{Title: "Valor", Kind: export.KindMoney},
+		{Title: "Margem", Kind: export.KindPercent},
+	},
+}
+
+func writeTestRows(t *testing.T, format export.Format) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := export.NewWriter(format, &buf, testDoc)
+	require.NoError(t, err)
+
+	venc := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, w.WriteRow(venc, "Aluguel; sala (térreo)", decimal.RequireFromString("1234.5"), decimal.RequireFromString("12.5")))
+	require.NoError(t, w.WriteRow(nil, "Energia", decimal.RequireFromString("-89.9"), nil))
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestFormatacaoPtBR(t *testing.T) {
+	assert.Equal(t, "R$ 1.234.567,89", export.FormatMoney(decimal.RequireFromString("1234567.891")))
+	assert.Equal(t, "R$ -10,00", export.FormatMoney(decimal.NewFromInt(-10)))
+	assert.Equal(t, "R$ 0,00", export.FormatMoney(decimal.RequireFromString("-0.001")))
+	assert.Equal(t, "25,50%", export.FormatPercent(decimal.RequireFromString("25.5")))
+	assert.Equal(t, "05/03/2024", export.FormatDate(time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)))
+}
+
+func TestCSV(t *testing.T) {
+	out := string(writeTestRows(t, export.FormatCSV))
+
+	lines := strings.Split(strings.TrimPrefix(out, "\xef\xbb\xbf"), "\n")
+	assert.Equal(t, "Vencimento;Descrição;Valor;Margem", lines[0])
+	assert.Equal(t, `15/01/2024;"Aluguel; sala (térreo)";1234,50;12,50`, lines[1])
+	assert.Equal(t, ";Energia;-89,90;", lines[2])
+}
+
+func TestCSV_TextoNaoViraFormula(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatCSV, &buf, testDoc)
+	require.NoError(t, err)
+	for _, texto := range []string{"=HYPERLINK(\"http://x\")", "+5511", "-2+3", "@SOMA(A1)", "Corte - barba"} {
+		require.NoError(t, w.WriteRow(nil, texto, decimal.RequireFromString("-10"), nil))
+	}
+	require.NoError(t, w.Close())
+
+	lines := strings.Split(strings.TrimPrefix(buf.String(), "\xef\xbb\xbf"), "\n")
+	assert.Equal(t, `;"'=HYPERLINK(""http://x"")";-10,00;`, lines[1])
+	assert.Equal(t, ";'+5511;-10,00;", lines[2])
+	assert.Equal(t, ";'-2+3;-10,00;", lines[3], "só texto é escapado; valores negativos seguem numéricos")
+	assert.Equal(t, ";'@SOMA(A1);-10,00;", lines[4])
+	assert.Equal(t, ";Corte - barba;-10,00;", lines[5])
+}
+
+func TestXLSX(t *testing.T) {
+	out := writeTestRows(t, export.FormatXLSX)
+
+	zr, err := zip.NewReader(bytes.NewReader(out), int64(len(out)))
+	require.NoError(t, err)
+
+	var sheet string
+	for _, f := range zr.File {
+		if f.Name == "xl/worksheets/sheet1.xml" {
+			rc, err := f.Open()
+			require.NoError(t, err)
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			sheet = string(b)
+		}
+	}
+	require.NotEmpty(t, sheet)
+	assert.Contains(t, sheet, `<c r="A5" s="3"><v>45306</v></c>`) // 15/01/2024
+	assert.Contains(t, sheet, `<c r="C5" s="2"><v>1234.5</v></c>`)
+	assert.Contains(t, sheet, `<c r="D5" s="4"><v>0.125</v></c>`)
+	assert.Contains(t, sheet, "Aluguel; sala (térreo)")
+}
+
+func TestPDF(t *testing.T) {
+	out := string(writeTestRows(t, export.FormatPDF))
+
+	assert.True(t, strings.HasPrefix(out, "%PDF-1.4"))
+	assert.True(t, strings.HasSuffix(out, "%%EOF\n"))
+	assert.Contains(t, out, "(R$ 1.234,50)")
+	assert.Contains(t, out, "(15/01/2024)")
+	assert.Contains(t, out, `sala \(t`+"\xe9"+`rreo\)`) // WinAnsi + escape
+}
+
+func TestPDFPaginacao(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatPDF, &buf, testDoc)
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		require.NoError(t, w.WriteRow(time.Now(), "linha", decimal.NewFromInt(int64(i)), nil))
+	}
+	require.NoError(t, w.Close())
+	assert.Contains(t, buf.String(), "/Count 3")
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := export.ParseFormat("XLSX")
+	require.NoError(t, err)
+	assert.Equal(t, export.FormatXLSX, f)
+	assert.Equal(t, "dre.xlsx", f.Filename("dre"))
+
+	_, err = export.ParseFormat("json")
+	assert.ErrorIs(t, err, export.ErrFormatoInvalido)
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Formatação pt-BR: "1.234,56", "R$ 1.234,56", "25,50%", "31/01/2024"
+
+// FormatDecimal formata um número com separador de milhar "." e decimal ","
+func FormatDecimal(d decimal.Decimal, casas int32) string {
+	s := d.Abs().StringFixed(casas)
+	inteiro, fracao := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		inteiro, fracao = s[:i], s[i+1:]
+	}
+
+	var b strings.Builder
+	if d.IsNegative() && !d.Round(casas).IsZero() {
+		b.WriteByte('-')
+	}
+	for i, r := range inteiro {
+		if i > 0 && (len(inteiro)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(r)
+	}
+	if fracao != "" {
+		b.WriteByte(',')
+		b.WriteString(fracao)
+	}
+	return b.String()
+}
+
+// FormatMoney formata um valor monetário (ex.: "R$ 1.234,56")
+func FormatMoney(d decimal.Decimal) string {
+	return "R$ " + FormatDecimal(d, 2)
+}
+
+// FormatPercent formata pontos percentuais (ex.: 25.5 → "25,50%")
+func FormatPercent(d decimal.Decimal) string {
+	return FormatDecimal(d, 2) + "%"
+}
+
+// FormatDate formata uma data no padrão brasileiro (dd/mm/aaaa)
+func FormatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format("02/01/2006")
+}
+
+// plainDecimal formata número sem separador de milhar (ex.: "1234,56"),
+// usado no CSV para que planilhas em pt-BR reconheçam o valor como número
+func plainDecimal(d decimal.Decimal, casas int32) string {
+	return strings.Replace(d.StringFixed(casas), ".", ",", 1)
+}
+
+// toDecimal converte os tipos numéricos aceitos nas linhas
+func toDecimal(v any) (decimal.Decimal, bool) {
+	switch n := v.(type) {
+	case decimal.Decimal:
+		return n, true
+	case *decimal.Decimal:
+		if n == nil {
+			return decimal.Zero, false
+		}
+		return *n, true
+	case int:
+		return decimal.NewFromInt(int64(n)), true
+	case int32:
+		return decimal.NewFromInt(int64(n)), true
+	case int64:
+		return decimal.NewFromInt(n), true
+	case float64:
+		return decimal.NewFromFloat(n), true
+	}
+	return decimal.Zero, false
+}
+
+// toText converte qualquer valor em texto simples
+func toText(v any) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case *string:
+		if s == nil {
+			return ""
+		}
+		return *s
+	case fmt.Stringer:
+		return s.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// displayValue formata o valor para exibição (PDF)
+func displayValue(col Column, v any) string {
+	switch col.Kind {
+	case KindMoney:
+		if d, ok := toDecimal(v); ok {
+			return FormatMoney(d)
+		}
+	case KindNumber:
+		if d, ok := toDecimal(v); ok {
+			return FormatDecimal(d, casasNumero(d))
+		}
+	case KindPercent:
+		if d, ok := toDecimal(v); ok {
+			return FormatPercent(d)
+		}
+	case KindDate:
+		if t, ok := dateValue(v); ok {
+			return FormatDate(t)
+		}
+		return ""
+	}
+	return toText(v)
+}
+
+// casasNumero mantém inteiros sem casas decimais
+func casasNumero(d decimal.Decimal) int32 {
+	if d.Equal(d.Truncate(0)) {
+		return 0
+	}
+	return 2
+}
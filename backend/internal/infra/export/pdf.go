@@ -0,0 +1,289 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Layout do PDF: A4 paisagem, em pontos (1/72")
+const (
+	pdfPageWidth  = 842.0
+	pdfPageHeight = 595.0
+	pdfMargin     = 36.0
+	pdfFontSize   = 8.0
+	pdfLineHeight = 13.0
+	pdfCellPad    = 3.0
+)
+
+// Objetos fixos: 1 catálogo, 2 árvore de páginas, 3 Helvetica, 4 Helvetica-Bold
+const (
+	pdfObjCatalog  = 1
+	pdfObjPages    = 2
+	pdfObjFont     = 3
+	pdfObjFontBold = 4
+	pdfFirstObj    = 5
+)
+
+// pdfWriter renderiza uma tabela simples com as fontes padrão do PDF (sem embutir fontes).
+// Cada página é gravada no destino assim que fica cheia; catálogo e xref vão no Close.
+type pdfWriter struct {
+	out     *countingWriter
+	doc     Document
+	widths  []float64
+	offsets map[int]int64
+	pages   []int
+	nextObj int
+
+	page   bytes.Buffer
+	y      float64
+	pageNo int
+}
+
+func newPDFWriter(w io.Writer, doc Document) (*pdfWriter, error) {
+	p := &pdfWriter{
+		out:     &countingWriter{w: w},
+		doc:     doc,
+		widths:  pdfColumnWidths(doc.Columns),
+		offsets: make(map[int]int64),
+		nextObj: pdfFirstObj,
+	}
+
+	if _, err := io.WriteString(p.out, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n"); err != nil {
+		return nil, err
+	}
+	if err := p.writeObject(pdfObjFont, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>"); err != nil {
+		return nil, err
+	}
+	if err := p.writeObject(pdfObjFontBold, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>"); err != nil {
+		return nil, err
+	}
+
+	p.startPage()
+	return p, nil
+}
+
+func (p *pdfWriter) WriteRow(values ...any) error {
+	if p.y-pdfLineHeight < pdfMargin+pdfLineHeight {
+		if err := p.flushPage(); err != nil {
+			return err
+		}
+		p.startPage()
+	}
+
+	x := pdfMargin
+	for i, col := range p.doc.Columns {
+		var v any
+		if i < len(values) {
+			v = values[i]
+		}
+		p.cellText(x, p.widths[i], displayValue(col, v), col.Kind != KindText, false)
+		x += p.widths[i]
+	}
+	p.y -= pdfLineHeight
+	return nil
+}
+
+func (p *pdfWriter) Close() error {
+	if err := p.flushPage(); err != nil {
+		return err
+	}
+
+	kids := make([]string, len(p.pages))
+	for i, obj := range p.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", obj)
+	}
+	if err := p.writeObject(pdfObjPages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(p.pages))); err != nil {
+		return err
+	}
+	if err := p.writeObject(pdfObjCatalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pdfObjPages)); err != nil {
+		return err
+	}
+
+	// Tabela de referências cruzadas
+	xref := p.out.n
+	total := p.nextObj
+	var b strings.Builder
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", total)
+	for obj := 1; obj < total; obj++ {
+		fmt.Fprintf(&b, "%010d 00000 n \n", p.offsets[obj])
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", total, pdfObjCatalog, xref)
+	_, err := io.WriteString(p.out, b.String())
+	return err
+}
+
+// startPage inicia uma nova página com título (na primeira) e cabeçalho da tabela
+func (p *pdfWriter) startPage() {
+	p.page.Reset()
+	p.pageNo++
+	p.y = pdfPageHeight - pdfMargin
+
+	if p.pageNo == 1 && p.doc.Title != "" {
+		p.text(pdfMargin, p.y-14, 14, true, p.doc.Title)
+		p.y -= 22
+		if p.doc.Subtitle != "" {
+			p.text(pdfMargin, p.y-9, 9, false, p.doc.Subtitle)
+			p.y -= 16
+		}
+		p.y -= 6
+	}
+
+	x := pdfMargin
+	for i, col := range p.doc.Columns {
+		p.cellText(x, p.widths[i], col.Title, col.Kind != KindText, true)
+		x += p.widths[i]
+	}
+	p.y -= pdfLineHeight
+	fmt.Fprintf(&p.page, "0.5 w %.2f %.2f m %.2f %.2f l S\n", pdfMargin, p.y+pdfLineHeight-3, pdfPageWidth-pdfMargin, p.y+pdfLineHeight-3)
+
+	// Rodapé
+	rodape := fmt.Sprintf("Página %d · gerado em %s", p.pageNo, time.Now().Format("02/01/2006 15:04"))
+	p.text(pdfMargin, pdfMargin-12, 7, false, rodape)
+}
+
+// flushPage grava o conteúdo e o objeto da página atual
+func (p *pdfWriter) flushPage() error {
+	content := p.page.Bytes()
+	contentObj := p.allocObj()
+	if err := p.writeObject(contentObj, fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", len(content), content)); err != nil {
+		return err
+	}
+
+	pageObj := p.allocObj()
+	page := fmt.Sprintf("<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %.0f %.0f] /Contents %d 0 R "+
+		"/Resources << /Font << /F1 %d 0 R /F2 %d 0 R >> >> >>",
+		pdfObjPages, pdfPageWidth, pdfPageHeight, contentObj, pdfObjFont, pdfObjFontBold)
+	if err := p.writeObject(pageObj, page); err != nil {
+		return err
+	}
+	p.pages = append(p.pages, pageObj)
+	return nil
+}
+
+// cellText escreve o texto na célula, truncando para caber e alinhando números à direita
+func (p *pdfWriter) cellText(x, width float64, s string, right, bold bool) {
+	limite := width - 2*pdfCellPad
+	if textWidth(s, pdfFontSize, bold) > limite {
+		r := []rune(s)
+		for len(r) > 0 && textWidth(string(r)+"...", pdfFontSize, bold) > limite {
+			r = r[:len(r)-1]
+		}
+		s = string(r) + "..."
+	}
+
+	tx := x + pdfCellPad
+	if right {
+		tx = x + width - pdfCellPad - textWidth(s, pdfFontSize, bold)
+	}
+	p.text(tx, p.y-pdfFontSize-2, pdfFontSize, bold, s)
+}
+
+func (p *pdfWriter) text(x, y, size float64, bold bool, s string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&p.page, "BT /%s %.1f Tf %.2f %.2f Td (%s) Tj ET\n", font, size, x, y, pdfEscape(s))
+}
+
+func (p *pdfWriter) allocObj() int {
+	obj := p.nextObj
+	p.nextObj++
+	return obj
+}
+
+func (p *pdfWriter) writeObject(obj int, body string) error {
+	p.offsets[obj] = p.out.n
+	_, err := fmt.Fprintf(p.out, "%d 0 obj\n%s\nendobj\n", obj, body)
+	return err
+}
+
+// pdfColumnWidths distribui a largura útil da página pelos pesos das colunas
+func pdfColumnWidths(cols []Column) []float64 {
+	pesos := make([]float64, len(cols))
+	total := 0.0
+	for i, col := range cols {
+		peso := col.Width
+		if peso <= 0 {
+			switch col.Kind {
+			case KindText:
+				peso = 3
+			case KindDate:
+				peso = 1.2
+			default:
+				peso = 1.6
+			}
+		}
+		pesos[i] = peso
+		total += peso
+	}
+
+	util := pdfPageWidth - 2*pdfMargin
+	for i := range pesos {
+		pesos[i] = util * pesos[i] / total
+	}
+	return pesos
+}
+
+// pdfEscape converte para WinAnsi (Latin-1) e escapa os delimitadores de string do PDF
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '·':
+			b.WriteByte(0xB7)
+		case r >= 0x20 && r < 0x7f:
+			b.WriteRune(r)
+		case r >= 0xa0 && r <= 0xff:
+			b.WriteByte(byte(r))
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
+
+// helveticaWidths são as larguras (em 1/1000 do corpo) dos caracteres ASCII 32..126
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// textWidth estima a largura do texto em pontos (negrito ~5% mais largo)
+func textWidth(s string, size float64, bold bool) float64 {
+	total := 0
+	for _, r := range s {
+		if r >= 32 && r <= 126 {
+			total += helveticaWidths[r-32]
+		} else {
+			total += 556
+		}
+	}
+	w := float64(total) * size / 1000
+	if bold {
+		w *= 1.05
+	}
+	return w
+}
+
+// countingWriter acompanha a posição em bytes para a tabela xref
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (int, error) {
+	n, err := c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,252 @@
+package export
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Índices de estilo (cellXfs) definidos em xlsxStyles
+const (
+	xlsxStyleDefault = 0
+	xlsxStyleBold    = 1
+	xlsxStyleMoney   = 2
+	xlsxStyleDate    = 3
+	xlsxStylePercent = 4
+	xlsxStyleNumber  = 5
+)
+
+// xlsxEpoch é a data base dos números de série de datas do Excel
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// xlsxWriter grava uma planilha XLSX mínima (uma aba, strings inline).
+// As partes fixas são gravadas na criação e as linhas vão direto para a entrada
+// sheet1.xml do zip, sem acumular o conteúdo em memória.
+type xlsxWriter struct {
+	zip   *zip.Writer
+	sheet io.Writer
+	cols  []Column
+	row   int
+}
+
+func newXLSXWriter(w io.Writer, doc Document) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", fmt.Sprintf(xlsxWorkbook, xmlEscape(xlsxSheetName(doc.Title)))},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(f, p.content); err != nil {
+			return nil, err
+		}
+	}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	x := &xlsxWriter{zip: zw, sheet: sheet, cols: doc.Columns}
+
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><cols>`)
+	for i, col := range doc.Columns {
+		fmt.Fprintf(&b, `<col min="%d" max="%d" width="%d" customWidth="1"/>`, i+1, i+1, xlsxColumnWidth(col))
+	}
+	b.WriteString(`</cols><sheetData>`)
+	if _, err := io.WriteString(sheet, b.String()); err != nil {
+		return nil, err
+	}
+
+	// Título, subtítulo e cabeçalho
+	if doc.Title != "" {
+		if err := x.writeCells([]string{x.inlineCell(0, doc.Title, xlsxStyleBold)}); err != nil {
+			return nil, err
+		}
+	}
+	if doc.Subtitle != "" {
+		if err := x.writeCells([]string{x.inlineCell(0, doc.Subtitle, xlsxStyleDefault)}); err != nil {
+			return nil, err
+		}
+	}
+	if doc.Title != "" || doc.Subtitle != "" {
+		x.row++ // linha em branco
+	}
+
+	header := make([]string, len(doc.Columns))
+	for i, col := range doc.Columns {
+		header[i] = x.inlineCell(i, col.Title, xlsxStyleBold)
+	}
+	if err := x.writeCells(header); err != nil {
+		return nil, err
+	}
+
+	return x, nil
+}
+
+func (x *xlsxWriter) WriteRow(values ...any) error {
+	cells := make([]string, 0, len(x.cols))
+	for i, col := range x.cols {
+		if i >= len(values) || values[i] == nil {
+			continue
+		}
+		if cell := x.cell(i, col, values[i]); cell != "" {
+			cells = append(cells, cell)
+		}
+	}
+	return x.writeCells(cells)
+}
+
+func (x *xlsxWriter) Close() error {
+	if _, err := io.WriteString(x.sheet, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+	return x.zip.Close()
+}
+
+func (x *xlsxWriter) writeCells(cells []string) error {
+	x.row++
+	_, err := fmt.Fprintf(x.sheet, `<row r="%d">%s</row>`, x.row, strings.Join(cells, ""))
+	return err
+}
+
+func (x *xlsxWriter) cell(col int, c Column, v any) string {
+	switch c.Kind {
+	case KindMoney, KindNumber, KindPercent:
+		d, ok := toDecimal(v)
+		if !ok {
+			break
+		}
+		style := xlsxStyleMoney
+		switch c.Kind {
+		case KindNumber:
+			style = xlsxStyleNumber
+			if casasNumero(d) == 0 {
+				style = xlsxStyleDefault
+			}
+		case KindPercent:
+			style = xlsxStylePercent
+			d = d.Shift(-2) // 25,5 pontos → 0,255
+		}
+		return fmt.Sprintf(`<c r="%s" s="%d"><v>%s</v></c>`, x.ref(col), style, d.String())
+	case KindDate:
+		t, ok := dateValue(v)
+		if !ok {
+			return ""
+		}
+		y, m, d := t.Date()
+		serial := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Sub(xlsxEpoch).Hours() / 24
+		return fmt.Sprintf(`<c r="%s" s="%d"><v>%d</v></c>`, x.ref(col), xlsxStyleDate, int(serial))
+	}
+
+	s := toText(v)
+	if s == "" {
+		return ""
+	}
+	return x.inlineCell(col, s, xlsxStyleDefault)
+}
+
+func (x *xlsxWriter) inlineCell(col int, s string, style int) string {
+	return fmt.Sprintf(`<c r="%s" t="inlineStr" s="%d"><is><t xml:space="preserve">%s</t></is></c>`,
+		x.ref(col), style, xmlEscape(s))
+}
+
+// ref retorna a referência A1 da coluna na linha atual (a linha é incrementada em writeCells)
+func (x *xlsxWriter) ref(col int) string {
+	return xlsxColumnName(col) + fmt.Sprint(x.row+1)
+}
+
+// xlsxColumnName converte índice (0 = A) no nome da coluna
+func xlsxColumnName(i int) string {
+	name := ""
+	for i >= 0 {
+		name = string(rune('A'+i%26)) + name
+		i = i/26 - 1
+	}
+	return name
+}
+
+func xlsxColumnWidth(col Column) int {
+	if col.Width > 0 {
+		return int(col.Width * 10)
+	}
+	switch col.Kind {
+	case KindText:
+		return 30
+	case KindDate:
+		return 12
+	}
+	return 16
+}
+
+// xlsxSheetName remove caracteres proibidos e limita a 31 caracteres
+func xlsxSheetName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '-'
+		}
+		return r
+	}, strings.TrimSpace(title))
+	if name == "" {
+		name = "Relatório"
+	}
+	if r := []rune(name); len(r) > 31 {
+		name = string(r[:31])
+	}
+	return name
+}
+
+func xmlEscape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const xlsxContentTypes = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+	`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="%s" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+const xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles define os formatos: moeda (R$), data (dd/mm/aaaa), percentual e número
+const xlsxStyles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="2"><numFmt numFmtId="164" formatCode="&quot;R$&quot;\ #,##0.00"/><numFmt numFmtId="165" formatCode="dd/mm/yyyy"/></numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="6">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="10" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs></styleSheet>`
@@ -11,6 +11,9 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/infra/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -55,84 +58,134 @@ func NewClient(cfg Config, logger *zap.Logger) *Client {
 	}
 }
 
-// doRequest executes an HTTP request with retry logic
+// doRequest executes an HTTP request with retry logic. Each call gets a client
+// span ("asaas POST") and each attempt a child span, so retries are visible in
+// the trace.
 func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
-		reqBody = bytes.NewReader(jsonBody)
 	}
 
 	url := c.config.BaseURL + path
 
+	ctx, span := telemetry.Tracer().Start(ctx, "asaas "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.path", path),
+			attribute.String("asaas.environment", c.config.Environment),
+		),
+	)
+	defer span.End()
+	logger := telemetry.Logger(ctx, c.logger)
+
 	var lastErr error
 	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
 		if attempt > 0 {
 			// Exponential backoff
 			backoff := time.Duration(attempt*attempt) * 100 * time.Millisecond
 			time.Sleep(backoff)
-			c.logger.Debug("retrying request",
+			logger.Debug("retrying request",
 				zap.String("url", url),
 				zap.Int("attempt", attempt),
 			)
 		}
 
-		req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create request: %w", err)
-		}
-
-		req.Header.Set("Content-Type", "application/json")
-		req.Header.Set("access_token", c.config.APIKey)
-		req.Header.Set("User-Agent", "NEXO-Backend/1.0")
-
-		resp, err := c.httpClient.Do(req)
-		if err != nil {
-			lastErr = fmt.Errorf("request failed: %w", err)
-			continue
-		}
-
-		defer resp.Body.Close()
-		respBody, err := io.ReadAll(resp.Body)
+		respBody, status, err := c.attempt(ctx, method, url, jsonBody, attempt)
 		if err != nil {
-			lastErr = fmt.Errorf("failed to read response body: %w", err)
+			lastErr = err
 			continue
 		}
+		span.SetAttributes(
+			attribute.Int("http.response.status_code", status),
+			attribute.Int("asaas.attempts", attempt+1),
+		)
 
 		// Log response
-		c.logger.Debug("asaas api response",
+		logger.Debug("asaas api response",
 			zap.String("method", method),
 			zap.String("path", path),
-			zap.Int("status", resp.StatusCode),
+			zap.Int("status", status),
 		)
 
 		// Check for errors
-		if resp.StatusCode >= 400 {
+		if status >= 400 {
 			var apiErr ErrorResponse
 			if err := json.Unmarshal(respBody, &apiErr); err == nil && len(apiErr.Errors) > 0 {
-				return nil, &APIError{
-					StatusCode: resp.StatusCode,
+				err := &APIError{
+					StatusCode: status,
 					Errors:     apiErr.Errors,
 				}
+				telemetry.RecordError(span, err)
+				return nil, err
 			}
-			return nil, &APIError{
-				StatusCode: resp.StatusCode,
+			err := &APIError{
+				StatusCode: status,
 				Errors: []Error{{
 					Code:        "UNKNOWN_ERROR",
 					Description: string(respBody),
 				}},
 			}
+			telemetry.RecordError(span, err)
+			return nil, err
 		}
 
 		return respBody, nil
 	}
 
+	span.SetAttributes(attribute.Int("asaas.attempts", c.config.MaxRetries+1))
+	telemetry.RecordError(span, lastErr)
 	return nil, lastErr
 }
 
+// attempt sends a single request. The body reader is rebuilt on every attempt,
+// otherwise retries would send an empty body.
+func (c *Client) attempt(ctx context.Context, method, url string, jsonBody []byte, attempt int) ([]byte, int, error) {
+	ctx, span := telemetry.Tracer().Start(ctx, "asaas attempt",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.Int("asaas.attempt", attempt)),
+	)
+	defer span.End()
+
+	var reqBody io.Reader
+	if jsonBody != nil {
+		reqBody = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		err = fmt.Errorf("failed to create request: %w", err)
+		telemetry.RecordError(span, err)
+		return nil, 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("access_token", c.config.APIKey)
+	req.Header.Set("User-Agent", "NEXO-Backend/1.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		err = fmt.Errorf("request failed: %w", err)
+		telemetry.RecordError(span, err)
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		err = fmt.Errorf("failed to read response body: %w", err)
+		telemetry.RecordError(span, err)
+		return nil, 0, err
+	}
+	return respBody, resp.StatusCode, nil
+}
+
 // ============================================================================
 // ERROR TYPES
 // ============================================================================
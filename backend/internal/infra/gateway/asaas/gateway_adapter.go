@@ -115,3 +115,89 @@ func (g *GatewayAdapter) GetPaymentLink(ctx context.Context, subscriptionID stri
 
 	return link, nil
 }
+
+// CreatePixCharge creates a single PIX charge and fetches its copy-and-paste code
+func (g *GatewayAdapter) CreatePixCharge(ctx context.Context, params port.CreateAsaasPixChargeParams) (*port.AsaasPixChargeResult, error) {
+	dueDate := params.DueDate
+	if dueDate == "" {
+		dueDate = FormatDate(time.Now())
+	}
+
+	payment, err := g.client.CreatePayment(ctx, PaymentRequest{
+		Customer:          params.CustomerID,
+		BillingType:       BillingTypePix,
+		Value:             params.Value,
+		DueDate:           dueDate,
+		Description:       params.Description,
+		ExternalReference: params.ExternalReference,
+	})
+	if err != nil {
+		g.logger.Error("failed to create pix charge in Asaas",
+			zap.String("customer_id", params.CustomerID),
+			zap.Float64("value", params.Value),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result := &port.AsaasPixChargeResult{
+		AsaasPaymentID: payment.ID,
+		InvoiceURL:     payment.InvoiceUrl,
+		Status:         payment.Status,
+	}
+
+	// The invoice URL also shows the QR code, so a failure here is not fatal
+	qrCode, err := g.client.GetPixQrCode(ctx, payment.ID)
+	if err != nil {
+		g.logger.Warn("failed to get pix qr code",
+			zap.String("payment_id", payment.ID),
+			zap.Error(err),
+		)
+	} else {
+		result.PixPayload = qrCode.Payload
+	}
+
+	return result, nil
+}
+
+// GetCharge fetches the current status of a single charge
+func (g *GatewayAdapter) GetCharge(ctx context.Context, paymentID string) (*port.AsaasChargeResult, error) {
+	payment, err := g.client.GetPayment(ctx, paymentID)
+	if err != nil {
+		g.logger.Error("failed to get charge from Asaas",
+			zap.String("payment_id", paymentID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	result := &port.AsaasChargeResult{
+		AsaasPaymentID: payment.ID,
+		Status:         payment.Status,
+	}
+	switch payment.Status {
+	case PaymentStatusReceived, PaymentStatusConfirmed, PaymentStatusReceivedInCash:
+		result.Paid = true
+		for _, date := range []string{payment.PaymentDate, payment.ClientPaymentDate, payment.ConfirmedDate} {
+			if paidAt, err := ParseDate(date); err == nil {
+				result.PaidAt = &paidAt
+				break
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CancelCharge deletes a pending charge so the customer can no longer pay it
+func (g *GatewayAdapter) CancelCharge(ctx context.Context, paymentID string) error {
+	if err := g.client.DeletePayment(ctx, paymentID); err != nil {
+		g.logger.Error("failed to cancel charge in Asaas",
+			zap.String("payment_id", paymentID),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+// Package asaas - Payment (single charge) API methods
+// Reference: https://docs.asaas.com/reference/criar-nova-cobranca
+package asaas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// ============================================================================
+// PAYMENT METHODS
+// ============================================================================
+
+// CreatePayment creates a single (non-recurring) charge in Asaas
+func (c *Client) CreatePayment(ctx context.Context, req PaymentRequest) (*PaymentResponse, error) {
+	c.logger.Debug("creating payment in Asaas",
+		zap.String("customer", req.Customer),
+		zap.String("billingType", req.BillingType),
+		zap.Float64("value", req.Value),
+		zap.String("externalReference", req.ExternalReference),
+	)
+
+	body, err := c.doRequest(ctx, "POST", "/payments", req)
+	if err != nil {
+		return nil, fmt.Errorf("create payment: %w", err)
+	}
+
+	var resp PaymentResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal payment response: %w", err)
+	}
+
+	c.logger.Info("payment created in Asaas",
+		zap.String("asaas_payment_id", resp.ID),
+		zap.String("status", resp.Status),
+	)
+
+	return &resp, nil
+}
+
+// GetPixQrCode retrieves the PIX QR code and copy-and-paste payload of a charge
+func (c *Client) GetPixQrCode(ctx context.Context, paymentID string) (*PixQrCodeResponse, error) {
+	path := fmt.Sprintf("/payments/%s/pixQrCode", paymentID)
+
+	body, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get pix qr code: %w", err)
+	}
+
+	var resp PixQrCodeResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("unmarshal pix qr code: %w", err)
+	}
+
+	return &resp, nil
+}
+
+// DeletePayment removes a pending charge so it can no longer be paid
+func (c *Client) DeletePayment(ctx context.Context, paymentID string) error {
+	path := fmt.Sprintf("/payments/%s", paymentID)
+
+	body, err := c.doRequest(ctx, "DELETE", path, nil)
+	if err != nil {
+		// Check if it's already deleted/not found
+		if apiErr, ok := err.(*APIError); ok && apiErr.IsNotFound() {
+			c.logger.Warn("payment not found in Asaas (may already be deleted)",
+				zap.String("paymentID", paymentID),
+			)
+			return nil // Treat as success
+		}
+		return fmt.Errorf("delete payment: %w", err)
+	}
+
+	var resp PaymentResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("unmarshal delete payment response: %w", err)
+	}
+
+	c.logger.Info("payment deleted in Asaas",
+		zap.String("paymentID", paymentID),
+		zap.Bool("deleted", resp.Deleted),
+	)
+
+	return nil
+}
@@ -201,6 +201,25 @@ type PaymentResponse struct {
 	EstimatedCreditDate   string   `json:"estimatedCreditDate,omitempty"`
 }
 
+// PaymentRequest represents the request to create a single charge
+// Reference: https://docs.asaas.com/reference/criar-nova-cobranca
+type PaymentRequest struct {
+	Customer          string  `json:"customer"`                    // Asaas customer ID (required)
+	BillingType       string  `json:"billingType"`                 // BOLETO, CREDIT_CARD, PIX, UNDEFINED
+	Value             float64 `json:"value"`                       // Charge value
+	DueDate           string  `json:"dueDate"`                     // Due date (YYYY-MM-DD)
+	Description       string  `json:"description,omitempty"`       // Charge description
+	ExternalReference string  `json:"externalReference,omitempty"` // Our internal reference
+}
+
+// PixQrCodeResponse represents the PIX QR code of a charge
+// Reference: https://docs.asaas.com/reference/obter-qr-code-para-pagamentos-via-pix
+type PixQrCodeResponse struct {
+	EncodedImage   string `json:"encodedImage"`   // Base64 PNG
+	Payload        string `json:"payload"`        // PIX copy-and-paste code
+	ExpirationDate string `json:"expirationDate"` // YYYY-MM-DD HH:mm:ss
+}
+
 // PaymentListResponse represents paginated payment list
 type PaymentListResponse struct {
 	Object     string            `json:"object"`
@@ -0,0 +1,476 @@
+// Package googlecalendar implementa port.CalendarProvider com a API do Google
+// Agenda (OAuth 2.0 + Calendar API v3).
+// Documentação: https://developers.google.com/calendar/api/v3/reference
+package googlecalendar
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/telemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	authURL     = "https://accounts.google.com/o/oauth2/v2/auth"
+	tokenURL    = "https://oauth2.googleapis.com/token"
+	revokeURL   = "https://oauth2.googleapis.com/revoke"
+	userInfoURL = "https://openidconnect.googleapis.com/v1/userinfo"
+	calendarURL = "https://www.googleapis.com/calendar/v3"
+
+	// scope permite gerenciar eventos, sem acesso às configurações da agenda
+	scope = "openid email https://www.googleapis.com/auth/calendar.events"
+
+	// timeZone dos eventos enviados
+	timeZone = "America/Sao_Paulo"
+
+	// managedProperty marca (extendedProperties.private) os eventos criados
+	// pela sincronização, que a leitura ignora
+	managedProperty = "barberAnalyticsUid"
+)
+
+// Config credenciais do app OAuth no Google Cloud
+type Config struct {
+	ClientID     string // GOOGLE_CALENDAR_CLIENT_ID
+	ClientSecret string // GOOGLE_CALENDAR_CLIENT_SECRET
+	RedirectURL  string // GOOGLE_CALENDAR_REDIRECT_URL (callback público da API)
+	Timeout      time.Duration
+}
+
+// ConfigFromEnv lê a configuração das variáveis de ambiente
+func ConfigFromEnv() Config {
+	return Config{
+		ClientID:     os.Getenv("GOOGLE_CALENDAR_CLIENT_ID"),
+		ClientSecret: os.Getenv("GOOGLE_CALENDAR_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("GOOGLE_CALENDAR_REDIRECT_URL"),
+	}
+}
+
+// Enabled indica se a integração está configurada
+func (c Config) Enabled() bool {
+	return c.ClientID != "" && c.ClientSecret != "" && c.RedirectURL != ""
+}
+
+// Client cliente da API do Google Agenda
+type Client struct {
+	httpClient *http.Client
+	config     Config
+	location   *time.Location
+	logger     *zap.Logger
+}
+
+var _ port.CalendarProvider = (*Client)(nil)
+
+// NewClient cria o cliente do Google Agenda
+func NewClient(cfg Config, logger *zap.Logger) *Client {
+	if cfg.Timeout == 0 {
+		cfg.Timeout = 30 * time.Second
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		loc = time.FixedZone(timeZone, -3*60*60)
+	}
+	return &Client{
+		httpClient: &http.Client{Timeout: cfg.Timeout},
+		config:     cfg,
+		location:   loc,
+		logger:     logger,
+	}
+}
+
+// NewProvider retorna o provedor configurado ou nil (interface nula) quando
+// GOOGLE_CALENDAR_* não está definido: a sincronização fica desativada
+func NewProvider(cfg Config, logger *zap.Logger) port.CalendarProvider {
+	if !cfg.Enabled() {
+		logger.Info("Sincronização com Google Agenda desativada (GOOGLE_CALENDAR_CLIENT_ID não definido)")
+		return nil
+	}
+	return NewClient(cfg, logger)
+}
+
+// Name identifica o provedor
+func (c *Client) Name() string {
+	return entity.CalendarProviderGoogle
+}
+
+// AuthCodeURL monta o link de consentimento. access_type=offline e
+// prompt=consent garantem o refresh token mesmo em reconexões.
+func (c *Client) AuthCodeURL(state string) string {
+	q := url.Values{}
+	q.Set("client_id", c.config.ClientID)
+	q.Set("redirect_uri", c.config.RedirectURL)
+	q.Set("response_type", "code")
+	q.Set("scope", scope)
+	q.Set("access_type", "offline")
+	q.Set("prompt", "consent")
+	q.Set("include_granted_scopes", "true")
+	q.Set("state", state)
+	return authURL + "?" + q.Encode()
+}
+
+// Exchange troca o código do callback pelas credenciais e identifica a conta
+func (c *Client) Exchange(ctx context.Context, code string) (*port.CalendarAuthorization, error) {
+	form := url.Values{}
+	form.Set("code", code)
+	form.Set("client_id", c.config.ClientID)
+	form.Set("client_secret", c.config.ClientSecret)
+	form.Set("redirect_uri", c.config.RedirectURL)
+	form.Set("grant_type", "authorization_code")
+
+	tok, err := c.token(ctx, form)
+	if err != nil {
+		if errors.Is(err, domain.ErrCalendarAccessRevoked) {
+			return nil, domain.ErrCalendarAuthorizationInvalid
+		}
+		return nil, err
+	}
+	if tok.RefreshToken == "" {
+		return nil, domain.ErrCalendarAuthorizationInvalid
+	}
+
+	var info struct {
+		Email string `json:"email"`
+	}
+	if err := c.do(ctx, http.MethodGet, userInfoURL, tok.AccessToken, nil, &info); err != nil {
+		return nil, err
+	}
+
+	return &port.CalendarAuthorization{
+		Token:        tok.toDomain(""),
+		AccountEmail: info.Email,
+		CalendarID:   "primary",
+	}, nil
+}
+
+// RefreshToken renova o access token. O Google só devolve novo refresh token
+// quando o anterior é substituído.
+func (c *Client) RefreshToken(ctx context.Context, token entity.CalendarToken) (entity.CalendarToken, error) {
+	form := url.Values{}
+	form.Set("refresh_token", token.RefreshToken)
+	form.Set("client_id", c.config.ClientID)
+	form.Set("client_secret", c.config.ClientSecret)
+	form.Set("grant_type", "refresh_token")
+
+	tok, err := c.token(ctx, form)
+	if err != nil {
+		return entity.CalendarToken{}, err
+	}
+	return tok.toDomain(token.RefreshToken), nil
+}
+
+// RevokeToken revoga o acesso (o refresh token revoga também o access token)
+func (c *Client) RevokeToken(ctx context.Context, token entity.CalendarToken) error {
+	form := url.Values{}
+	form.Set("token", token.RefreshToken)
+
+	status, body, err := c.send(ctx, http.MethodPost, revokeURL, "", "application/x-www-form-urlencoded", []byte(form.Encode()))
+	if err != nil {
+		return err
+	}
+	// 400 invalid_token: acesso já revogado
+	if status >= 400 && status != http.StatusBadRequest {
+		return fmt.Errorf("google revoke: status %d: %s", status, body)
+	}
+	return nil
+}
+
+// CreateEvent cria o evento no calendário da conexão
+func (c *Client) CreateEvent(ctx context.Context, conn *entity.CalendarConnection, ev entity.CalendarEvent) (string, error) {
+	var out event
+	if err := c.do(ctx, http.MethodPost, c.eventsURL(conn, ""), conn.Token.AccessToken, c.toEvent(ev), &out); err != nil {
+		return "", err
+	}
+	return out.ID, nil
+}
+
+// UpdateEvent substitui o evento
+func (c *Client) UpdateEvent(ctx context.Context, conn *entity.CalendarConnection, eventID string, ev entity.CalendarEvent) error {
+	return c.do(ctx, http.MethodPut, c.eventsURL(conn, eventID), conn.Token.AccessToken, c.toEvent(ev), nil)
+}
+
+// DeleteEvent remove o evento; evento já removido não é erro
+func (c *Client) DeleteEvent(ctx context.Context, conn *entity.CalendarConnection, eventID string) error {
+	err := c.do(ctx, http.MethodDelete, c.eventsURL(conn, eventID), conn.Token.AccessToken, nil, nil)
+	if errors.Is(err, domain.ErrCalendarEventNotFound) {
+		return nil
+	}
+	return err
+}
+
+// ListEvents lê os eventos alterados desde syncToken ou, sem ele, os eventos
+// entre from e to. Eventos recorrentes vêm expandidos em ocorrências.
+func (c *Client) ListEvents(ctx context.Context, conn *entity.CalendarConnection, syncToken string, from, to time.Time) (*port.CalendarChanges, error) {
+	out := &port.CalendarChanges{}
+	pageToken := ""
+	for {
+		q := url.Values{}
+		q.Set("singleEvents", "true")
+		q.Set("showDeleted", "true")
+		q.Set("maxResults", "250")
+		if syncToken != "" {
+			q.Set("syncToken", syncToken)
+		} else {
+			q.Set("timeMin", from.UTC().Format(time.RFC3339))
+			q.Set("timeMax", to.UTC().Format(time.RFC3339))
+		}
+		if pageToken != "" {
+			q.Set("pageToken", pageToken)
+		}
+
+		var page struct {
+			Items         []event `json:"items"`
+			NextPageToken string  `json:"nextPageToken"`
+			NextSyncToken string  `json:"nextSyncToken"`
+		}
+		err := c.do(ctx, http.MethodGet, c.eventsURL(conn, "")+"?"+q.Encode(), conn.Token.AccessToken, nil, &page)
+		if err != nil {
+			if errors.Is(err, domain.ErrCalendarEventNotFound) && syncToken != "" {
+				// 410 Gone: o sync token expirou
+				return nil, domain.ErrCalendarSyncTokenExpired
+			}
+			return nil, err
+		}
+
+		for _, item := range page.Items {
+			if ev, ok := c.fromEvent(item); ok {
+				out.Events = append(out.Events, ev)
+			}
+		}
+		if page.NextPageToken == "" {
+			out.NextSyncToken = page.NextSyncToken
+			return out, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// ============================================================================
+// RECURSOS DA API
+// ============================================================================
+
+type eventTime struct {
+	DateTime string `json:"dateTime,omitempty"`
+	Date     string `json:"date,omitempty"` // eventos de dia inteiro
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+type event struct {
+	ID                 string    `json:"id,omitempty"`
+	Status             string    `json:"status,omitempty"`
+	Summary            string    `json:"summary,omitempty"`
+	Description        string    `json:"description,omitempty"`
+	Start              eventTime `json:"start"`
+	End                eventTime `json:"end"`
+	Transparency       string    `json:"transparency,omitempty"`
+	ExtendedProperties *struct {
+		Private map[string]string `json:"private,omitempty"`
+	} `json:"extendedProperties,omitempty"`
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+	Error        string `json:"error"`
+}
+
+func (t tokenResponse) toDomain(refreshToken string) entity.CalendarToken {
+	if t.RefreshToken != "" {
+		refreshToken = t.RefreshToken
+	}
+	return entity.CalendarToken{
+		AccessToken:  t.AccessToken,
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(time.Duration(t.ExpiresIn) * time.Second),
+	}
+}
+
+func (c *Client) eventsURL(conn *entity.CalendarConnection, eventID string) string {
+	u := calendarURL + "/calendars/" + url.PathEscape(conn.CalendarID) + "/events"
+	if eventID != "" {
+		u += "/" + url.PathEscape(eventID)
+	}
+	return u
+}
+
+func (c *Client) toEvent(ev entity.CalendarEvent) event {
+	status := "confirmed"
+	if ev.Status == entity.CalendarEventTentative {
+		status = "tentative"
+	}
+	out := event{
+		Status:      status,
+		Summary:     ev.Summary,
+		Description: ev.Description,
+		Start:       eventTime{DateTime: ev.Start.In(c.location).Format(time.RFC3339), TimeZone: timeZone},
+		End:         eventTime{DateTime: ev.End.In(c.location).Format(time.RFC3339), TimeZone: timeZone},
+	}
+	out.ExtendedProperties = &struct {
+		Private map[string]string `json:"private,omitempty"`
+	}{Private: map[string]string{managedProperty: ev.UID}}
+	return out
+}
+
+// fromEvent converte o evento lido. Eventos removidos vêm só com o ID e o
+// status "cancelled"; eventos "disponível" (transparent) não ocupam a agenda.
+func (c *Client) fromEvent(item event) (entity.ExternalCalendarEvent, bool) {
+	out := entity.ExternalCalendarEvent{ID: item.ID}
+	if item.ExtendedProperties != nil && item.ExtendedProperties.Private[managedProperty] != "" {
+		out.Managed = true
+	}
+	if item.Status == "cancelled" {
+		return out, true
+	}
+
+	start, okStart := c.parseTime(item.Start)
+	end, okEnd := c.parseTime(item.End)
+	if !okStart || !okEnd || !end.After(start) {
+		return out, false
+	}
+	out.Start = start
+	out.End = end
+	out.Busy = item.Transparency != "transparent"
+	return out, true
+}
+
+func (c *Client) parseTime(t eventTime) (time.Time, bool) {
+	if t.DateTime != "" {
+		v, err := time.Parse(time.RFC3339, t.DateTime)
+		return v, err == nil
+	}
+	if t.Date != "" {
+		v, err := time.ParseInLocation("2006-01-02", t.Date, c.location)
+		return v, err == nil
+	}
+	return time.Time{}, false
+}
+
+// ============================================================================
+// HTTP
+// ============================================================================
+
+// token chama o endpoint de token; invalid_grant indica acesso revogado
+func (c *Client) token(ctx context.Context, form url.Values) (*tokenResponse, error) {
+	status, body, err := c.send(ctx, http.MethodPost, tokenURL, "", "application/x-www-form-urlencoded", []byte(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	var tok tokenResponse
+	if err := json.Unmarshal(body, &tok); err != nil {
+		return nil, fmt.Errorf("google token: resposta inválida (status %d): %w", status, err)
+	}
+	if tok.Error == "invalid_grant" {
+		return nil, domain.ErrCalendarAccessRevoked
+	}
+	if status >= 400 || tok.AccessToken == "" {
+		return nil, fmt.Errorf("google token: status %d: %s", status, tok.Error)
+	}
+	return &tok, nil
+}
+
+// do envia uma chamada JSON autenticada e decodifica a resposta em out
+func (c *Client) do(ctx context.Context, method, u, accessToken string, in, out interface{}) error {
+	var payload []byte
+	if in != nil {
+		var err error
+		if payload, err = json.Marshal(in); err != nil {
+			return fmt.Errorf("google calendar: erro ao serializar requisição: %w", err)
+		}
+	}
+
+	status, body, err := c.send(ctx, method, u, accessToken, "application/json", payload)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case status == http.StatusUnauthorized:
+		return domain.ErrCalendarUnauthorized
+	case status == http.StatusNotFound || status == http.StatusGone:
+		return domain.ErrCalendarEventNotFound
+	case status >= 400:
+		return fmt.Errorf("google calendar: status %d: %s", status, truncate(body, 300))
+	}
+
+	if out != nil && len(body) > 0 {
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("google calendar: resposta inválida: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *Client) send(ctx context.Context, method, u, accessToken, contentType string, payload []byte) (int, []byte, error) {
+	path := u
+	if parsed, err := url.Parse(u); err == nil {
+		path = parsed.Host + parsed.Path
+	}
+	ctx, span := telemetry.Tracer().Start(ctx, "google "+method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", method),
+			attribute.String("url.path", path),
+		),
+	)
+	defer span.End()
+
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return 0, nil, fmt.Errorf("google calendar: erro ao montar requisição: %w", err)
+	}
+	if payload != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return 0, nil, fmt.Errorf("google calendar: falha na requisição: %w", err)
+	}
+	defer resp.Body.Close()
+
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		telemetry.RecordError(span, err)
+		return 0, nil, fmt.Errorf("google calendar: erro ao ler resposta: %w", err)
+	}
+
+	telemetry.Logger(ctx, c.logger).Debug("google api response",
+		zap.String("method", method),
+		zap.String("path", path),
+		zap.Int("status", resp.StatusCode),
+	)
+	return resp.StatusCode, respBody, nil
+}
+
+func truncate(b []byte, n int) string {
+	s := strings.TrimSpace(string(b))
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
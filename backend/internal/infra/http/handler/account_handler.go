@@ -0,0 +1,230 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	authUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/auth"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// ACCOUNT HANDLER
+// Recuperação de senha e convite de colaboradores (links de uso único por email)
+// =============================================================================
+
+type AccountHandler struct {
+	forgotPasswordUC    *authUC.ForgotPasswordUseCase
+	resetPasswordUC     *authUC.ResetPasswordUseCase
+	createInvitationUC  *authUC.CreateInvitationUseCase
+	listInvitationsUC   *authUC.ListInvitationsUseCase
+	revokeInvitationUC  *authUC.RevokeInvitationUseCase
+	previewInvitationUC *authUC.PreviewInvitationUseCase
+	acceptInvitationUC  *authUC.AcceptInvitationUseCase
+	validator           *validator.Validate
+	logger              *zap.Logger
+}
+
+func NewAccountHandler(
+	forgotPasswordUC *authUC.ForgotPasswordUseCase,
+	resetPasswordUC *authUC.ResetPasswordUseCase,
+	createInvitationUC *authUC.CreateInvitationUseCase,
+	listInvitationsUC *authUC.ListInvitationsUseCase,
+	revokeInvitationUC *authUC.RevokeInvitationUseCase,
+	previewInvitationUC *authUC.PreviewInvitationUseCase,
+	acceptInvitationUC *authUC.AcceptInvitationUseCase,
+	logger *zap.Logger,
+) *AccountHandler {
+	return &AccountHandler{
+		forgotPasswordUC:    forgotPasswordUC,
+		resetPasswordUC:     resetPasswordUC,
+		createInvitationUC:  createInvitationUC,
+		listInvitationsUC:   listInvitationsUC,
+		revokeInvitationUC:  revokeInvitationUC,
+		previewInvitationUC: previewInvitationUC,
+		acceptInvitationUC:  acceptInvitationUC,
+		validator:           validator.New(),
+		logger:              logger,
+	}
+}
+
+// ForgotPassword - POST /auth/forgot-password (público)
+// Responde sempre 202, exista ou não a conta.
+func (h *AccountHandler) ForgotPassword(c echo.Context) error {
+	var req dto.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Email inválido",
+		})
+	}
+
+	if err := h.forgotPasswordUC.Execute(c.Request().Context(), req.Email, clientInfo(c)); err != nil {
+		h.logger.Error("Erro ao solicitar recuperação de senha", zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "Se o email estiver cadastrado, você receberá um link para redefinir a senha",
+	})
+}
+
+// ResetPassword - POST /auth/reset-password (público)
+func (h *AccountHandler) ResetPassword(c echo.Context) error {
+	var req dto.ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "A senha deve ter entre 8 e 72 caracteres",
+		})
+	}
+
+	if err := h.resetPasswordUC.Execute(c.Request().Context(), req.Token, req.Password); err != nil {
+		return h.handleAccountError(c, err, "Erro ao redefinir senha")
+	}
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Senha redefinida com sucesso. Faça login com a nova senha",
+	})
+}
+
+// CreateInvitation - POST /auth/invitations (dono/gerente)
+func (h *AccountHandler) CreateInvitation(c echo.Context) error {
+	var req dto.CreateInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error":   "Dados inválidos",
+			"details": err.Error(),
+		})
+	}
+
+	resp, err := h.createInvitationUC.Execute(c.Request().Context(), authUC.CreateInvitationInput{
+		TenantID:       mw.GetTenantID(c),
+		InvitedBy:      mw.GetUserID(c),
+		RoleConvidante: mw.GetUserRole(c),
+		Email:          req.Email,
+		Nome:           req.Nome,
+		Role:           req.Role,
+		UnitID:         req.UnitID,
+	})
+	if err != nil {
+		return h.handleAccountError(c, err, "Erro ao criar convite")
+	}
+
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// ListInvitations - GET /auth/invitations (dono/gerente)
+func (h *AccountHandler) ListInvitations(c echo.Context) error {
+	convites, err := h.listInvitationsUC.Execute(c.Request().Context(), mw.GetTenantID(c))
+	if err != nil {
+		return h.handleAccountError(c, err, "Erro ao listar convites")
+	}
+
+	return c.JSON(http.StatusOK, convites)
+}
+
+// RevokeInvitation - DELETE /auth/invitations/:id (dono/gerente)
+func (h *AccountHandler) RevokeInvitation(c echo.Context) error {
+	if err := h.revokeInvitationUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id")); err != nil {
+		return h.handleAccountError(c, err, "Erro ao revogar convite")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// PreviewInvitation - GET /auth/invitations/preview?token= (público)
+// Dados exibidos na tela de aceite antes de o convidado definir a senha.
+func (h *AccountHandler) PreviewInvitation(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "token é obrigatório",
+		})
+	}
+
+	resp, err := h.previewInvitationUC.Execute(c.Request().Context(), token)
+	if err != nil {
+		return h.handleAccountError(c, err, "Erro ao buscar convite")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// AcceptInvitation - POST /auth/invitations/accept (público)
+func (h *AccountHandler) AcceptInvitation(c echo.Context) error {
+	var req dto.AcceptInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "A senha deve ter entre 8 e 72 caracteres",
+		})
+	}
+
+	if err := h.acceptInvitationUC.Execute(c.Request().Context(), req.Token, req.Password); err != nil {
+		return h.handleAccountError(c, err, "Erro ao aceitar convite")
+	}
+
+	return c.JSON(http.StatusCreated, map[string]string{
+		"message": "Convite aceito. Faça login com seu email e a senha definida",
+	})
+}
+
+// handleAccountError mapeia erros de recuperação de senha e convites
+func (h *AccountHandler) handleAccountError(c echo.Context, err error, msg string) error {
+	switch err {
+	case domain.ErrLinkSenhaInvalido, domain.ErrConviteInvalido:
+		return c.JSON(http.StatusGone, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrConviteNaoEncontrado, entity.ErrUnitNaoEncontrada:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrConviteEmailExistente:
+		return c.JSON(http.StatusConflict, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrConviteSemPermissao:
+		return c.JSON(http.StatusForbidden, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrContaDesativada:
+		return c.JSON(http.StatusForbidden, map[string]string{
+			"error": "Conta desativada",
+		})
+	case domain.ErrConviteRoleInvalida, domain.ErrInvalidID, domain.ErrInvalidTenantID:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	authUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/auth"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// API KEY HANDLER
+// Gestão das chaves de API das integrações do tenant (somente dono)
+// =============================================================================
+
+type APIKeyHandler struct {
+	listUC    *authUC.ListAPIKeysUseCase
+	createUC  *authUC.CreateAPIKeyUseCase
+	revokeUC  *authUC.RevokeAPIKeyUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+func NewAPIKeyHandler(
+	listUC *authUC.ListAPIKeysUseCase,
+	createUC *authUC.CreateAPIKeyUseCase,
+	revokeUC *authUC.RevokeAPIKeyUseCase,
+	logger *zap.Logger,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		listUC:    listUC,
+		createUC:  createUC,
+		revokeUC:  revokeUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// Scopes - GET /api-keys/scopes
+func (h *APIKeyHandler) Scopes(c echo.Context) error {
+	return c.JSON(http.StatusOK, authUC.APIKeyScopeCatalog())
+}
+
+// List - GET /api-keys
+func (h *APIKeyHandler) List(c echo.Context) error {
+	keys, err := h.listUC.Execute(c.Request().Context(), mw.GetTenantID(c))
+	if err != nil {
+		return h.handleAPIKeyError(c, err, "Erro ao listar chaves de API")
+	}
+
+	return c.JSON(http.StatusOK, keys)
+}
+
+// Create - POST /api-keys
+// A chave é retornada somente nesta resposta; depois disso só o prefixo.
+func (h *APIKeyHandler) Create(c echo.Context) error {
+	var req dto.CreateAPIKeyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "nome e ao menos um escopo são obrigatórios; unit_id deve ser um UUID",
+		})
+	}
+
+	key, err := h.createUC.Execute(c.Request().Context(), mw.GetTenantID(c), mw.GetUserID(c), req)
+	if err != nil {
+		return h.handleAPIKeyError(c, err, "Erro ao criar chave de API")
+	}
+
+	return c.JSON(http.StatusCreated, key)
+}
+
+// Revoke - DELETE /api-keys/:id
+func (h *APIKeyHandler) Revoke(c echo.Context) error {
+	if err := h.revokeUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id")); err != nil {
+		return h.handleAPIKeyError(c, err, "Erro ao revogar chave de API")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// handleAPIKeyError mapeia erros das chaves de API
+func (h *APIKeyHandler) handleAPIKeyError(c echo.Context, err error, msg string) error {
+	switch err {
+	case domain.ErrChaveAPINaoEncontrada, entity.ErrUnitNaoEncontrada:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrChaveAPIEscopoInvalido, domain.ErrChaveAPIExpiracao,
+		domain.ErrInvalidTenantID, domain.ErrInvalidID, domain.ErrInvalidUnitID:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
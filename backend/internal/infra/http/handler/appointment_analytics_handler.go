@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// AppointmentAnalyticsHandler agrupa os indicadores operacionais da agenda
+type AppointmentAnalyticsHandler struct {
+	timingUC *appointment.GetTimingAnalyticsUseCase
+	logger   *zap.Logger
+}
+
+// NewAppointmentAnalyticsHandler cria um novo handler dos indicadores da agenda
+func NewAppointmentAnalyticsHandler(
+	timingUC *appointment.GetTimingAnalyticsUseCase,
+	logger *zap.Logger,
+) *AppointmentAnalyticsHandler {
+	return &AppointmentAnalyticsHandler{
+		timingUC: timingUC,
+		logger:   logger,
+	}
+}
+
+// Timing godoc
+// @Summary Indicadores de tempo dos agendamentos
+// @Description Espera entre check-in e início, duração real x prevista dos serviços, pontualidade e antecedência dos cancelamentos, por unidade e por profissional. Espera, duração e pontualidade contam para o profissional responsável; agendamentos e cancelamentos contam também para os profissionais dos demais serviços. Barbeiros veem só os próprios indicadores.
+// @Tags Agendamentos
+// @Produce json
+// @Param start_date query string false "Data inicial (YYYY-MM-DD); padrão: 30 dias até end_date"
+// @Param end_date query string false "Data final (YYYY-MM-DD); padrão: hoje"
+// @Param unit_id query string false "ID da unidade"
+// @Param professional_id query string false "ID do profissional"
+// @Param tolerance_minutes query int false "Tolerância de pontualidade em minutos" default(5)
+// @Success 200 {object} dto.AppointmentTimingResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /api/v1/appointment-analytics/timing [get]
+// @Security BearerAuth
+func (h *AppointmentAnalyticsHandler) Timing(c echo.Context) error {
+	var req dto.AppointmentTimingRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Parâmetros inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	input := appointment.TimingAnalyticsInput{
+		TenantID:       middleware.GetTenantID(c),
+		UnitID:         req.UnitID,
+		ProfessionalID: req.ProfessionalID,
+	}
+
+	// Barbeiro só vê os próprios indicadores
+	if middleware.IsBarber(c) {
+		barberProfID := middleware.GetProfessionalIDForBarber(c)
+		if barberProfID == "" || (req.ProfessionalID != "" && req.ProfessionalID != barberProfID) {
+			return c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "Acesso negado: você só pode ver seus próprios indicadores",
+			})
+		}
+		input.ProfessionalID = barberProfID
+	}
+
+	if req.StartDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.StartDate)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "start_date inválida (use YYYY-MM-DD)",
+			})
+		}
+		input.StartDate = parsed
+	}
+	if req.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.EndDate)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: "end_date inválida (use YYYY-MM-DD)",
+			})
+		}
+		input.EndDate = parsed
+	}
+	if req.ToleranceMinutes != nil {
+		tolerance := time.Duration(*req.ToleranceMinutes) * time.Minute
+		input.Tolerance = &tolerance
+	}
+
+	out, err := h.timingUC.Execute(c.Request().Context(), input)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrAppointmentTimingPeriodInvalid),
+			errors.Is(err, domain.ErrAppointmentTimingPeriodTooLong),
+			errors.Is(err, domain.ErrAppointmentTimingToleranceRange):
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+		}
+		h.logger.Error("Erro ao calcular indicadores de tempo dos agendamentos", zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Erro ao calcular indicadores",
+		})
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentTimingToResponse(out))
+}
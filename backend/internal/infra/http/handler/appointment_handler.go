@@ -26,6 +26,7 @@ type AppointmentHandler struct {
 	rescheduleUC        *appointment.RescheduleAppointmentUseCase
 	cancelUC            *appointment.CancelAppointmentUseCase
 	finishWithCommandUC *appointment.FinishServiceWithCommandUseCase
+	historyUC           *appointment.ListStatusHistoryUseCase
 	logger              *zap.Logger
 }
 
@@ -38,6 +39,7 @@ func NewAppointmentHandler(
 	rescheduleUC *appointment.RescheduleAppointmentUseCase,
 	cancelUC *appointment.CancelAppointmentUseCase,
 	finishWithCommandUC *appointment.FinishServiceWithCommandUseCase,
+	historyUC *appointment.ListStatusHistoryUseCase,
 	logger *zap.Logger,
 ) *AppointmentHandler {
 	return &AppointmentHandler{
@@ -48,6 +50,7 @@ func NewAppointmentHandler(
 		rescheduleUC:        rescheduleUC,
 		cancelUC:            cancelUC,
 		finishWithCommandUC: finishWithCommandUC,
+		historyUC:           historyUC,
 		logger:              logger,
 	}
 }
@@ -92,7 +95,7 @@ func (h *AppointmentHandler) enforceBarberScope(ctx context.Context, c echo.Cont
 		})
 	}
 
-	if appt.ProfessionalID != barberProfID {
+	if !appt.InvolvesProfessional(barberProfID) {
 		return echo.NewHTTPError(http.StatusForbidden, dto.ErrorResponse{
 			Error:   "forbidden",
 			Message: "Acesso negado: você só pode agir nos seus agendamentos",
@@ -104,7 +107,7 @@ func (h *AppointmentHandler) enforceBarberScope(ctx context.Context, c echo.Cont
 
 // CreateAppointment godoc
 // @Summary Criar agendamento
-// @Description Cria um novo agendamento
+// @Description Cria um novo agendamento. Com services, cada serviço pode ter o seu profissional e é executado na ordem enviada
 // @Tags Agendamentos
 // @Accept json
 // @Produce json
@@ -149,7 +152,11 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		if barberProfID == "" {
 			return c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "forbidden", Message: "Acesso negado: profissional não associado"})
 		}
-		if req.ProfessionalID != barberProfID {
+		responsavel := req.ProfessionalID
+		if responsavel == "" && len(req.Services) > 0 {
+			responsavel = req.Services[0].ProfessionalID
+		}
+		if responsavel != barberProfID {
 			return c.JSON(http.StatusForbidden, dto.ErrorResponse{Error: "forbidden", Message: "Barbeiro só pode criar agendamento para si"})
 		}
 	}
@@ -170,6 +177,12 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		ServiceIDs:     req.ServiceIDs,
 		Notes:          req.Notes,
 	}
+	for _, svc := range req.Services {
+		input.Services = append(input.Services, appointment.ServiceAssignment{
+			ServiceID:      svc.ServiceID,
+			ProfessionalID: svc.ProfessionalID,
+		})
+	}
 
 	result, err := h.createUC.Execute(ctx, input)
 	if err != nil {
@@ -179,7 +192,8 @@ func (h *AppointmentHandler) CreateAppointment(c echo.Context) error {
 		switch {
 		case errors.Is(err, domain.ErrAppointmentConflict),
 			errors.Is(err, domain.ErrAppointmentBlockedTimeConflict),
-			errors.Is(err, domain.ErrAppointmentMinimumInterval):
+			errors.Is(err, domain.ErrAppointmentMinimumInterval),
+			errors.Is(err, domain.ErrResourceUnavailable):
 			return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
 		case errors.Is(err, domain.ErrAppointmentProfessionalNotFound),
 			errors.Is(err, domain.ErrAppointmentCustomerNotFound),
@@ -393,7 +407,7 @@ func (h *AppointmentHandler) GetAppointment(c echo.Context) error {
 	// RBAC: Barbeiro só pode ver seus próprios agendamentos
 	if middleware.IsBarber(c) {
 		barberProfID := middleware.GetProfessionalIDForBarber(c)
-		if result.ProfessionalID != barberProfID {
+		if !result.InvolvesProfessional(barberProfID) {
 			return c.JSON(http.StatusForbidden, dto.ErrorResponse{
 				Error:   "forbidden",
 				Message: "Acesso negado: você só pode ver seus próprios agendamentos",
@@ -404,6 +418,70 @@ func (h *AppointmentHandler) GetAppointment(c echo.Context) error {
 	return c.JSON(http.StatusOK, mapper.AppointmentToResponse(result))
 }
 
+// GetStatusHistory godoc
+// @Summary Histórico de status do agendamento
+// @Description Lista as mudanças de status do agendamento com quem fez, quando e o motivo
+// @Tags Agendamentos
+// @Produce json
+// @Param id path string true "ID do agendamento"
+// @Success 200 {array} dto.AppointmentStatusChangeResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/appointments/{id}/history [get]
+func (h *AppointmentHandler) GetStatusHistory(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	tenantID := middleware.GetTenantID(c)
+	if tenantID == "" {
+		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Tenant ID não encontrado",
+		})
+	}
+
+	unitID := middleware.GetUnitID(c)
+	if unitID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "unit_required",
+			Message: domain.ErrUnitIDRequired.Error(),
+		})
+	}
+
+	appointmentID := c.Param("id")
+	if appointmentID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "ID do agendamento é obrigatório",
+		})
+	}
+
+	if err := h.enforceBarberScope(ctx, c, tenantID, appointmentID); err != nil {
+		return err
+	}
+
+	changes, err := h.historyUC.Execute(ctx, appointment.GetAppointmentInput{
+		TenantID:      tenantID,
+		UnitID:        unitID,
+		AppointmentID: appointmentID,
+	})
+	if err != nil {
+		if errors.Is(err, domain.ErrAppointmentNotFound) {
+			return c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "not_found",
+				Message: "Agendamento não encontrado",
+			})
+		}
+		h.logger.Error("Erro ao listar histórico de status do agendamento", zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Erro ao listar histórico de status",
+		})
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentStatusHistoryToResponse(changes))
+}
+
 // UpdateAppointmentStatus godoc
 // @Summary Atualizar status do agendamento
 // @Description Atualiza o status de um agendamento
@@ -478,6 +556,7 @@ func (h *AppointmentHandler) UpdateAppointmentStatus(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     status,
 		Reason:        req.Reason,
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -498,7 +577,7 @@ func (h *AppointmentHandler) UpdateAppointmentStatus(c echo.Context) error {
 
 // RescheduleAppointment godoc
 // @Summary Reagendar agendamento
-// @Description Reagenda um agendamento para novo horário
+// @Description Reagenda um agendamento para novo horário. Em agendamentos de série, scope THIS_AND_FOLLOWING remarca também as ocorrências seguintes e retorna dto.RescheduleFollowingResponse, com o conflito de cada ocorrência.
 // @Tags Agendamentos
 // @Accept json
 // @Produce json
@@ -573,30 +652,49 @@ func (h *AppointmentHandler) RescheduleAppointment(c echo.Context) error {
 		ProfessionalID: req.ProfessionalID,
 	}
 
+	if req.Scope == dto.SeriesScopeThisAndFollow {
+		out, err := h.rescheduleUC.ExecuteFollowing(ctx, input)
+		if err != nil {
+			h.logger.Error("Erro ao reagendar série", zap.Error(err))
+			return h.handleRescheduleError(c, err)
+		}
+		return c.JSON(http.StatusOK, dto.RescheduleFollowingResponse{
+			Appointment: mapper.AppointmentToResponse(out.Appointment),
+			Series:      mapper.AppointmentSeriesToResponse(out.Series),
+			Occurrences: mapper.SeriesOccurrencesToResponse(out.Occurrences),
+		})
+	}
+
 	result, err := h.rescheduleUC.Execute(ctx, input)
 	if err != nil {
 		h.logger.Error("Erro ao reagendar", zap.Error(err))
-
-		switch {
-		case errors.Is(err, domain.ErrAppointmentNotFound):
-			return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: "Agendamento não encontrado"})
-		case errors.Is(err, domain.ErrAppointmentConflict),
-			errors.Is(err, domain.ErrAppointmentBlockedTimeConflict),
-			errors.Is(err, domain.ErrAppointmentMinimumInterval):
-			return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
-		case errors.Is(err, domain.ErrAppointmentInvalidStatusTransition),
-			errors.Is(err, domain.ErrAppointmentCannotReschedule):
-			return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "invalid_transition", Message: err.Error()})
-		case errors.Is(err, domain.ErrAppointmentProfessionalNotFound):
-			return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
-		default:
-			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "reschedule_error", Message: err.Error()})
-		}
+		return h.handleRescheduleError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, mapper.AppointmentToResponse(result))
 }
 
+// handleRescheduleError mapeia erros do reagendamento
+func (h *AppointmentHandler) handleRescheduleError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAppointmentNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: "Agendamento não encontrado"})
+	case errors.Is(err, domain.ErrAppointmentConflict),
+		errors.Is(err, domain.ErrAppointmentBlockedTimeConflict),
+		errors.Is(err, domain.ErrAppointmentMinimumInterval),
+		errors.Is(err, domain.ErrResourceUnavailable):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentInvalidStatusTransition),
+		errors.Is(err, domain.ErrAppointmentCannotReschedule):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "invalid_transition", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentProfessionalNotFound),
+		errors.Is(err, domain.ErrAppointmentSeriesNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "reschedule_error", Message: err.Error()})
+	}
+}
+
 // ConfirmAppointment godoc
 // @Summary Confirmar agendamento
 // @Description Confirma um agendamento (CREATED -> CONFIRMED)
@@ -646,6 +744,7 @@ func (h *AppointmentHandler) ConfirmAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusConfirmed,
 		Reason:        "Agendamento confirmado",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -666,7 +765,7 @@ func (h *AppointmentHandler) ConfirmAppointment(c echo.Context) error {
 
 // CancelAppointment godoc
 // @Summary Cancelar agendamento
-// @Description Cancela um agendamento existente
+// @Description Cancela um agendamento existente. Em agendamentos de série, scope THIS_AND_FOLLOWING cancela também as ocorrências seguintes, encerra a série e retorna dto.CancelFollowingResponse.
 // @Tags Agendamentos
 // @Accept json
 // @Produce json
@@ -720,24 +819,52 @@ func (h *AppointmentHandler) CancelAppointment(c echo.Context) error {
 		UnitID:        unitID,
 		AppointmentID: appointmentID,
 		Reason:        req.Reason,
+		ActorID:       middleware.GetUserID(c),
+	}
+
+	switch req.Scope {
+	case "", dto.SeriesScopeThis:
+	case dto.SeriesScopeThisAndFollow:
+		out, err := h.cancelUC.ExecuteFollowing(ctx, input)
+		if err != nil {
+			h.logger.Error("Erro ao cancelar série", zap.Error(err))
+			return h.handleCancelError(c, err)
+		}
+		return c.JSON(http.StatusOK, dto.CancelFollowingResponse{
+			Appointment:   mapper.AppointmentToResponse(out.Appointment),
+			Series:        mapper.AppointmentSeriesToResponse(out.Series),
+			CanceledCount: out.CanceledCount,
+		})
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "scope deve ser THIS ou THIS_AND_FOLLOWING",
+		})
 	}
 
 	result, err := h.cancelUC.Execute(ctx, input)
 	if err != nil {
 		h.logger.Error("Erro ao cancelar agendamento", zap.Error(err))
-		switch {
-		case errors.Is(err, domain.ErrAppointmentNotFound):
-			return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: "Agendamento não encontrado"})
-		case errors.Is(err, domain.ErrAppointmentInvalidStatusTransition):
-			return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "invalid_transition", Message: err.Error()})
-		default:
-			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "cancel_error", Message: err.Error()})
-		}
+		return h.handleCancelError(c, err)
 	}
 
 	return c.JSON(http.StatusOK, mapper.AppointmentToResponse(result))
 }
 
+// handleCancelError mapeia erros do cancelamento
+func (h *AppointmentHandler) handleCancelError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAppointmentNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: "Agendamento não encontrado"})
+	case errors.Is(err, domain.ErrAppointmentSeriesNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentInvalidStatusTransition):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "invalid_transition", Message: err.Error()})
+	default:
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "cancel_error", Message: err.Error()})
+	}
+}
+
 // CheckInAppointment godoc
 // @Summary Marcar cliente como chegou
 // @Description Marca que o cliente chegou na barbearia (CONFIRMED/CREATED -> CHECKED_IN)
@@ -787,6 +914,7 @@ func (h *AppointmentHandler) CheckInAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusCheckedIn,
 		Reason:        "Cliente chegou",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -854,6 +982,7 @@ func (h *AppointmentHandler) StartServiceAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusInService,
 		Reason:        "Atendimento iniciado",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -921,6 +1050,7 @@ func (h *AppointmentHandler) FinishServiceAppointment(c echo.Context) error {
 			TenantID:      tenantID,
 			UnitID:        unitID,
 			AppointmentID: appointmentID,
+			ActorID:       middleware.GetUserID(c),
 		}
 
 		result, err := h.finishWithCommandUC.Execute(ctx, input)
@@ -952,6 +1082,7 @@ func (h *AppointmentHandler) FinishServiceAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusAwaitingPayment,
 		Reason:        "Atendimento finalizado",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -1019,6 +1150,7 @@ func (h *AppointmentHandler) CompleteAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusDone,
 		Reason:        "Pagamento recebido",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -1086,6 +1218,7 @@ func (h *AppointmentHandler) NoShowAppointment(c echo.Context) error {
 		AppointmentID: appointmentID,
 		NewStatus:     valueobject.AppointmentStatusNoShow,
 		Reason:        "Cliente não compareceu",
+		ActorID:       middleware.GetUserID(c),
 	}
 
 	result, err := h.updateStatusUC.Execute(ctx, input)
@@ -4,18 +4,25 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"math/rand"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/http/handler"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/repository/postgres"
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -75,12 +82,12 @@ func setupApptHandler(t *testing.T) (*handler.AppointmentHandler, *echo.Echo, fu
 
 	// Use cases
 	// G-001: createUC agora recebe commandRepo para criar comanda automaticamente
-	createUC := appointment.NewCreateAppointmentUseCase(appointmentRepo, commandRepo, serviceReader, professionalReader, customerReader, logger)
+	createUC := appointment.NewCreateAppointmentUseCase(appointmentRepo, commandRepo, serviceReader, professionalReader, customerReader, nil, nil, nil, logger)
 	listUC := appointment.NewListAppointmentsUseCase(appointmentRepo, logger)
 	getUC := appointment.NewGetAppointmentUseCase(appointmentRepo, logger)
-	updateStatusUC := appointment.NewUpdateAppointmentStatusUseCase(appointmentRepo, commandRepo, logger)
-	rescheduleUC := appointment.NewRescheduleAppointmentUseCase(appointmentRepo, professionalReader, logger)
-	cancelUC := appointment.NewCancelAppointmentUseCase(appointmentRepo, logger)
+	updateStatusUC := appointment.NewUpdateAppointmentStatusUseCase(appointmentRepo, commandRepo, nil, nil, nil, nil, logger)
+	rescheduleUC := appointment.NewRescheduleAppointmentUseCase(appointmentRepo, professionalReader, nil, nil, nil, nil, logger)
+	cancelUC := appointment.NewCancelAppointmentUseCase(appointmentRepo, nil, nil, nil, nil, nil, logger)
 
 	// Handler
 	apptHandler := handler.NewAppointmentHandler(
@@ -91,6 +98,7 @@ func setupApptHandler(t *testing.T) (*handler.AppointmentHandler, *echo.Echo, fu
 		rescheduleUC,
 		cancelUC,
 		nil, // finishWithCommandUC - not needed for these tests
+		nil, // historyUC - not needed for these tests
 		logger,
 	)
 
@@ -351,3 +359,86 @@ func TestAppointmentHandler_PriceFormat_Integration(t *testing.T) {
 		}
 	})
 }
+
+// TestAppointmentRepository_ConcurrentBooking_Integration dispara várias
+// reservas simultâneas no mesmo horário do mesmo profissional sem a
+// verificação prévia do use case: a constraint appointment_services_no_overlap
+// (serviços ativos) deve aceitar exatamente uma e devolver
+// ErrAppointmentConflict às demais.
+func TestAppointmentRepository_ConcurrentBooking_Integration(t *testing.T) {
+	pool := getApptTestDBPool(t)
+	if pool == nil {
+		return
+	}
+	defer pool.Close()
+
+	ctx := context.Background()
+	repo := postgres.NewAppointmentRepository(db.New(pool), pool)
+	tenantUUID := uuid.MustParse(apptE2ETenantID)
+
+	// Horário distante e aleatório para não colidir com execuções anteriores
+	inicio := time.Now().Add(time.Duration(365*24+rand.Intn(24*365)) * time.Hour).Truncate(time.Minute)
+
+	const (
+		reservas       = 20
+		professionalID = "a0000000-0000-0000-0000-000000000001" // Carlos Silva (seed)
+	)
+	var (
+		wg        sync.WaitGroup
+		mu        sync.Mutex
+		criados   []string
+		conflitos int
+		outros    []error
+	)
+	for i := 0; i < reservas; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := uuid.NewString()
+			a := &entity.Appointment{
+				ID:             id,
+				TenantID:       tenantUUID,
+				ProfessionalID: professionalID,
+				CustomerID:     "c1000000-0000-0000-0000-000000000001", // João Santos (seed)
+				StartTime:      inicio,
+				EndTime:        inicio.Add(30 * time.Minute),
+				Status:         valueobject.AppointmentStatusCreated,
+				TotalPrice:     valueobject.Zero(),
+				Services: []entity.AppointmentService{{
+					AppointmentID:     id,
+					ServiceID:         "s0000000-0000-0000-0000-000000000001", // Corte Masculino (seed)
+					ProfessionalID:    professionalID,
+					PriceAtBooking:    valueobject.Zero(),
+					DurationAtBooking: 30,
+					Sequence:          1,
+					StartTime:         inicio,
+					EndTime:           inicio.Add(30 * time.Minute),
+				}},
+			}
+			err := repo.Create(ctx, a)
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case err == nil:
+				criados = append(criados, a.ID)
+			case errors.Is(err, domain.ErrAppointmentConflict):
+				conflitos++
+			default:
+				outros = append(outros, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	defer func() {
+		for _, id := range criados {
+			_, _ = pool.Exec(ctx, "DELETE FROM appointment_services WHERE appointment_id = $1", id)
+			_, _ = pool.Exec(ctx, "DELETE FROM appointments WHERE id = $1", id)
+		}
+	}()
+
+	require.Empty(t, outros)
+	assert.Len(t, criados, 1)
+	assert.Equal(t, reservas-1, conflitos)
+}
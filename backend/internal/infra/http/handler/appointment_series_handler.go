@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/appointment"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// AppointmentSeriesHandler agrupa os handlers de séries de agendamentos
+// recorrentes. Remarcar e cancelar ocorrências ficam em /appointments, com
+// scope THIS ou THIS_AND_FOLLOWING.
+type AppointmentSeriesHandler struct {
+	createUC *appointment.CreateAppointmentSeriesUseCase
+	getUC    *appointment.GetAppointmentSeriesUseCase
+	listUC   *appointment.ListAppointmentSeriesUseCase
+	endUC    *appointment.EndAppointmentSeriesUseCase
+	logger   *zap.Logger
+}
+
+// NewAppointmentSeriesHandler cria um novo handler de séries
+func NewAppointmentSeriesHandler(
+	createUC *appointment.CreateAppointmentSeriesUseCase,
+	getUC *appointment.GetAppointmentSeriesUseCase,
+	listUC *appointment.ListAppointmentSeriesUseCase,
+	endUC *appointment.EndAppointmentSeriesUseCase,
+	logger *zap.Logger,
+) *AppointmentSeriesHandler {
+	return &AppointmentSeriesHandler{
+		createUC: createUC,
+		getUC:    getUC,
+		listUC:   listUC,
+		endUC:    endUC,
+		logger:   logger,
+	}
+}
+
+// CreateSeries godoc
+// @Summary Criar série de agendamentos
+// @Description Cria um agendamento recorrente (a cada N semanas) e gera as ocorrências das próximas semanas. Conflitos são reportados por ocorrência.
+// @Tags Agendamentos
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateAppointmentSeriesRequest true "Dados da série"
+// @Success 201 {object} dto.AppointmentSeriesDetailResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/appointment-series [post]
+// @Security BearerAuth
+func (h *AppointmentSeriesHandler) CreateSeries(c echo.Context) error {
+	tenantID := middleware.GetTenantID(c)
+	unitID := middleware.GetUnitID(c)
+	if unitID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "unit_required",
+			Message: domain.ErrUnitIDRequired.Error(),
+		})
+	}
+
+	var req dto.CreateAppointmentSeriesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	input := appointment.CreateAppointmentSeriesInput{
+		TenantID:       tenantID,
+		UnitID:         unitID,
+		ProfessionalID: req.ProfessionalID,
+		CustomerID:     req.CustomerID,
+		ServiceIDs:     req.ServiceIDs,
+		StartTime:      req.StartTime,
+		IntervalWeeks:  req.IntervalWeeks,
+		Notes:          req.Notes,
+		CreatedBy:      middleware.GetUserID(c),
+	}
+	if req.EndsOn != "" {
+		endsOn, err := time.ParseInLocation("2006-01-02", req.EndsOn, req.StartTime.Location())
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: "ends_on deve estar no formato YYYY-MM-DD"})
+		}
+		input.EndsOn = &endsOn
+	}
+
+	out, err := h.createUC.Execute(c.Request().Context(), input)
+	if err != nil {
+		return h.handleSeriesError(c, err, "Erro ao criar série de agendamentos")
+	}
+
+	return c.JSON(http.StatusCreated, mapper.AppointmentSeriesOutputToResponse(out))
+}
+
+// ListSeries godoc
+// @Summary Listar séries do cliente
+// @Tags Agendamentos
+// @Produce json
+// @Param customer_id query string true "ID do cliente"
+// @Success 200 {array} dto.AppointmentSeriesResponse
+// @Router /api/v1/appointment-series [get]
+// @Security BearerAuth
+func (h *AppointmentSeriesHandler) ListSeries(c echo.Context) error {
+	series, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.QueryParam("customer_id"))
+	if err != nil {
+		return h.handleSeriesError(c, err, "Erro ao listar séries de agendamentos")
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentSeriesListToResponse(series))
+}
+
+// GetSeries godoc
+// @Summary Buscar série
+// @Description Retorna a série e o resultado de cada ocorrência gerada
+// @Tags Agendamentos
+// @Produce json
+// @Param id path string true "ID da série"
+// @Success 200 {object} dto.AppointmentSeriesDetailResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/appointment-series/{id} [get]
+// @Security BearerAuth
+func (h *AppointmentSeriesHandler) GetSeries(c echo.Context) error {
+	out, err := h.getUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleSeriesError(c, err, "Erro ao buscar série de agendamentos")
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentSeriesOutputToResponse(out))
+}
+
+// EndSeries godoc
+// @Summary Encerrar série
+// @Description Encerra a série e cancela as ocorrências futuras ainda não iniciadas
+// @Tags Agendamentos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da série"
+// @Param request body dto.EndAppointmentSeriesRequest false "Motivo"
+// @Success 200 {object} dto.EndAppointmentSeriesResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/appointment-series/{id}/end [post]
+// @Security BearerAuth
+func (h *AppointmentSeriesHandler) EndSeries(c echo.Context) error {
+	var req dto.EndAppointmentSeriesRequest
+	if err := c.Bind(&req); err != nil {
+		// Permitir body vazio
+		req = dto.EndAppointmentSeriesRequest{}
+	}
+
+	series, canceled, err := h.endUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"), middleware.GetUserID(c), req.Reason)
+	if err != nil {
+		return h.handleSeriesError(c, err, "Erro ao encerrar série de agendamentos")
+	}
+
+	return c.JSON(http.StatusOK, dto.EndAppointmentSeriesResponse{
+		Series:        mapper.AppointmentSeriesToResponse(series),
+		CanceledCount: canceled,
+	})
+}
+
+// handleSeriesError mapeia erros das séries
+func (h *AppointmentSeriesHandler) handleSeriesError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrAppointmentSeriesNotFound),
+		errors.Is(err, domain.ErrAppointmentProfessionalNotFound),
+		errors.Is(err, domain.ErrAppointmentCustomerNotFound),
+		errors.Is(err, domain.ErrAppointmentServiceNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentSeriesEnded):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "series_ended", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentSeriesIntervalInvalid),
+		errors.Is(err, domain.ErrAppointmentSeriesEndInvalid),
+		errors.Is(err, domain.ErrAppointmentInvalidTimeRange),
+		errors.Is(err, domain.ErrAppointmentCustomerRequired),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrUnitIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
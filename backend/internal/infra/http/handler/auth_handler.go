@@ -1,7 +1,9 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
@@ -19,12 +21,15 @@ import (
 // =============================================================================
 
 type AuthHandler struct {
-	loginUC   *authUC.LoginUseCase
-	refreshUC *authUC.RefreshUseCase
-	meUC      *authUC.MeUseCase
-	logoutUC  *authUC.LogoutUseCase
-	validator *validator.Validate
-	logger    *zap.Logger
+	loginUC         *authUC.LoginUseCase
+	refreshUC       *authUC.RefreshUseCase
+	meUC            *authUC.MeUseCase
+	logoutUC        *authUC.LogoutUseCase
+	listSessionsUC  *authUC.ListSessionsUseCase
+	revokeSessionUC *authUC.RevokeSessionUseCase
+	revokeOthersUC  *authUC.RevokeOtherSessionsUseCase
+	validator       *validator.Validate
+	logger          *zap.Logger
 }
 
 func NewAuthHandler(
@@ -32,15 +37,21 @@ func NewAuthHandler(
 	refreshUC *authUC.RefreshUseCase,
 	meUC *authUC.MeUseCase,
 	logoutUC *authUC.LogoutUseCase,
+	listSessionsUC *authUC.ListSessionsUseCase,
+	revokeSessionUC *authUC.RevokeSessionUseCase,
+	revokeOthersUC *authUC.RevokeOtherSessionsUseCase,
 	logger *zap.Logger,
 ) *AuthHandler {
 	return &AuthHandler{
-		loginUC:   loginUC,
-		refreshUC: refreshUC,
-		meUC:      meUC,
-		logoutUC:  logoutUC,
-		validator: validator.New(),
-		logger:    logger,
+		loginUC:         loginUC,
+		refreshUC:       refreshUC,
+		meUC:            meUC,
+		logoutUC:        logoutUC,
+		listSessionsUC:  listSessionsUC,
+		revokeSessionUC: revokeSessionUC,
+		revokeOthersUC:  revokeOthersUC,
+		validator:       validator.New(),
+		logger:          logger,
 	}
 }
 
@@ -62,17 +73,20 @@ func (h *AuthHandler) Login(c echo.Context) error {
 	}
 
 	// Executa login
-	response, refreshToken, err := h.loginUC.Execute(c.Request().Context(), req)
+	response, refreshToken, err := h.loginUC.Execute(c.Request().Context(), req, clientInfo(c))
 	if err != nil {
-		// Erros específicos do domínio (conforme FLUXO_LOGIN.md)
-		switch err {
-		case domain.ErrEmailNaoEncontrado:
-			return c.JSON(http.StatusUnauthorized, map[string]string{
-				"error": "Email não encontrado",
+		// Email inexistente e senha errada têm a mesma resposta (não revela contas)
+		var bloqueio *authUC.BloqueioLoginError
+		if errors.As(err, &bloqueio) {
+			c.Response().Header().Set("Retry-After", strconv.Itoa(int(bloqueio.RetryAfter().Seconds())))
+			return c.JSON(http.StatusTooManyRequests, map[string]string{
+				"error": domain.ErrLoginBloqueado.Error(),
 			})
-		case domain.ErrSenhaIncorreta:
+		}
+		switch err {
+		case domain.ErrCredenciaisInvalidas:
 			return c.JSON(http.StatusUnauthorized, map[string]string{
-				"error": "Senha incorreta",
+				"error": "Email ou senha inválidos",
 			})
 		case domain.ErrContaDesativada:
 			return c.JSON(http.StatusForbidden, map[string]string{
@@ -86,17 +100,12 @@ func (h *AuthHandler) Login(c echo.Context) error {
 		}
 	}
 
-	// Define cookie HttpOnly com refresh token (7 dias)
-	cookie := &http.Cookie{
-		Name:     "refresh_token",
-		Value:    refreshToken,
-		Path:     "/",
-		HttpOnly: true,
-		Secure:   true, // TODO: em produção = true (HTTPS)
-		SameSite: http.SameSiteStrictMode,
-		MaxAge:   int(7 * 24 * time.Hour.Seconds()), // 7 dias
+	// Segundo fator pendente: nenhum token ainda, só o desafio
+	if response.MFARequired {
+		return c.JSON(http.StatusOK, response)
 	}
-	c.SetCookie(cookie)
+
+	setRefreshCookie(c, refreshToken)
 
 	return c.JSON(http.StatusOK, response)
 }
@@ -113,14 +122,24 @@ func (h *AuthHandler) Refresh(c echo.Context) error {
 
 	refreshToken := cookie.Value
 
-	// Executa refresh
-	response, err := h.refreshUC.Execute(c.Request().Context(), refreshToken)
+	// Executa refresh (o token é rotacionado a cada uso)
+	response, newRefreshToken, err := h.refreshUC.Execute(c.Request().Context(), refreshToken, clientInfo(c))
 	if err != nil {
 		switch err {
+		case domain.ErrRefreshTokenReutilizado:
+			clearRefreshCookie(c)
+			return c.JSON(http.StatusUnauthorized, map[string]string{
+				"error": "Sessão encerrada por segurança. Faça login novamente",
+			})
 		case domain.ErrRefreshTokenInvalido:
 			return c.JSON(http.StatusUnauthorized, map[string]string{
 				"error": "Refresh token inválido ou expirado",
 			})
+		case domain.ErrDoisFatoresObrigatorio:
+			clearRefreshCookie(c)
+			return c.JSON(http.StatusUnauthorized, map[string]string{
+				"error": "A política da barbearia exige autenticação em dois fatores. Faça login novamente para ativá-la",
+			})
 		case domain.ErrContaDesativada:
 			return c.JSON(http.StatusForbidden, map[string]string{
 				"error": "Conta desativada",
@@ -133,6 +152,8 @@ func (h *AuthHandler) Refresh(c echo.Context) error {
 		}
 	}
 
+	setRefreshCookie(c, newRefreshToken)
+
 	return c.JSON(http.StatusOK, response)
 }
 
@@ -146,7 +167,7 @@ func (h *AuthHandler) Me(c echo.Context) error {
 		})
 	}
 
-	response, err := h.meUC.Execute(c.Request().Context(), userID)
+	response, err := h.meUC.Execute(c.Request().Context(), userID, mw.GetUnitID(c))
 	if err != nil {
 		switch err {
 		case domain.ErrUsuarioNaoEncontrado:
@@ -178,7 +199,135 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 	}
 
 	// Remove cookie
-	cookie = &http.Cookie{
+	clearRefreshCookie(c)
+
+	return c.JSON(http.StatusOK, map[string]string{
+		"message": "Logout realizado com sucesso",
+	})
+}
+
+// ListSessions - GET /auth/sessions
+// Sessões ativas do usuário. O dono pode informar ?user_id= para ver as de
+// outro usuário ou ?todos=true para ver todas as sessões do tenant.
+func (h *AuthHandler) ListSessions(c echo.Context) error {
+	userID := mw.GetUserID(c)
+	if userID == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "Não autenticado",
+		})
+	}
+
+	input := authUC.ListSessionsInput{
+		TenantID:         mw.GetTenantID(c),
+		UserID:           userID,
+		CurrentSessionID: mw.GetSessionID(c),
+	}
+
+	alvo := c.QueryParam("user_id")
+	todos := c.QueryParam("todos") == "true"
+	if (alvo != "" && alvo != userID) || todos {
+		if mw.GetUserRole(c) != string(mw.RoleOwner) {
+			return c.JSON(http.StatusForbidden, map[string]string{
+				"error": "Apenas o proprietário pode ver sessões de outros usuários",
+			})
+		}
+		input.UserID = alvo
+	}
+
+	sessions, err := h.listSessionsUC.Execute(c.Request().Context(), input)
+	if err != nil {
+		return h.handleSessionError(c, err, "Erro ao listar sessões")
+	}
+
+	return c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession - DELETE /auth/sessions/:id
+// Encerra uma sessão (dispositivo). O dono pode encerrar sessões de qualquer usuário do tenant.
+func (h *AuthHandler) RevokeSession(c echo.Context) error {
+	userID := mw.GetUserID(c)
+	if userID == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "Não autenticado",
+		})
+	}
+
+	err := h.revokeSessionUC.Execute(c.Request().Context(), authUC.RevokeSessionInput{
+		TenantID:          mw.GetTenantID(c),
+		UserID:            userID,
+		SessionID:         c.Param("id"),
+		PodeRevogarOutros: mw.GetUserRole(c) == string(mw.RoleOwner),
+	})
+	if err != nil {
+		return h.handleSessionError(c, err, "Erro ao revogar sessão")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeOtherSessions - DELETE /auth/sessions
+// Encerra todas as sessões do usuário, exceto a atual ("sair dos outros dispositivos").
+func (h *AuthHandler) RevokeOtherSessions(c echo.Context) error {
+	userID := mw.GetUserID(c)
+	if userID == "" {
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": "Não autenticado",
+		})
+	}
+
+	total, err := h.revokeOthersUC.Execute(c.Request().Context(), userID, mw.GetSessionID(c))
+	if err != nil {
+		return h.handleSessionError(c, err, "Erro ao revogar sessões")
+	}
+
+	return c.JSON(http.StatusOK, map[string]int64{
+		"revogadas": total,
+	})
+}
+
+// handleSessionError mapeia erros da gestão de sessões
+func (h *AuthHandler) handleSessionError(c echo.Context, err error, msg string) error {
+	switch err {
+	case domain.ErrSessaoNaoEncontrada:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": "Sessão não encontrada",
+		})
+	case domain.ErrInvalidID, domain.ErrInvalidTenantID, domain.ErrUsuarioNaoEncontrado:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
+
+// clientInfo extrai IP e User-Agent da requisição para a sessão e o bloqueio de login
+func clientInfo(c echo.Context) authUC.ClientInfo {
+	return authUC.ClientInfo{
+		IP:        c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+}
+
+// setRefreshCookie define cookie HttpOnly com refresh token (7 dias)
+func setRefreshCookie(c echo.Context, refreshToken string) {
+	c.SetCookie(&http.Cookie{
+		Name:     "refresh_token",
+		Value:    refreshToken,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true, // TODO: em produção = true (HTTPS)
+		SameSite: http.SameSiteStrictMode,
+		MaxAge:   int(7 * 24 * time.Hour.Seconds()), // 7 dias
+	})
+}
+
+// clearRefreshCookie remove o cookie do refresh token
+func clearRefreshCookie(c echo.Context) {
+	c.SetCookie(&http.Cookie{
 		Name:     "refresh_token",
 		Value:    "",
 		Path:     "/",
@@ -186,10 +335,5 @@ func (h *AuthHandler) Logout(c echo.Context) error {
 		Secure:   true,
 		SameSite: http.SameSiteStrictMode,
 		MaxAge:   -1, // Deleta cookie
-	}
-	c.SetCookie(cookie)
-
-	return c.JSON(http.StatusOK, map[string]string{
-		"message": "Logout realizado com sucesso",
 	})
 }
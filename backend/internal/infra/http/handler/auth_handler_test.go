@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestClientInfo_XFFForjadoNaoTrocaIP garante que a chave ip: do bloqueio de
+// login não muda quando o cliente inventa X-Forwarded-For a cada tentativa
+func TestClientInfo_XFFForjadoNaoTrocaIP(t *testing.T) {
+	cases := []struct {
+		nome     string
+		proxies  string
+		remote   string
+		xff      []string
+		esperado string
+	}{
+		{"sem proxy", "", "203.0.113.7:51000", []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"}, "203.0.113.7"},
+		{"atrás de proxy confiável", "10.0.0.0/8", "10.0.0.5:51000", []string{"1.1.1.1, 198.51.100.9", "2.2.2.2, 198.51.100.9"}, "198.51.100.9"},
+	}
+	for _, tc := range cases {
+		e := echo.New()
+		extractor, err := middleware.IPExtractor(tc.proxies)
+		require.NoError(t, err)
+		e.IPExtractor = extractor
+
+		for _, xff := range tc.xff {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+			req.RemoteAddr = tc.remote
+			req.Header.Set(echo.HeaderXForwardedFor, xff)
+			req.Header.Set(echo.HeaderXRealIP, xff)
+			c := e.NewContext(req, httptest.NewRecorder())
+
+			assert.Equal(t, tc.esperado, clientInfo(c).IP, "%s: xff=%s", tc.nome, xff)
+		}
+	}
+}
@@ -3,13 +3,19 @@ package handler
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/caixa"
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
 	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
@@ -27,6 +33,9 @@ type CaixaHandler struct {
 	getCaixaByIDUC   *caixa.GetCaixaByIDUseCase
 	listHistoricoUC  *caixa.ListHistoricoUseCase
 	getTotaisUC      *caixa.GetTotaisCaixaUseCase
+	pendentesUC      *caixa.ListFechamentosPendentesUseCase
+	aprovarUC        *caixa.AprovarFechamentoUseCase
+	rejeitarUC       *caixa.RejeitarFechamentoUseCase
 	logger           *zap.Logger
 }
 
@@ -40,6 +49,9 @@ func NewCaixaHandler(
 	getCaixaByIDUC *caixa.GetCaixaByIDUseCase,
 	listHistoricoUC *caixa.ListHistoricoUseCase,
 	getTotaisUC *caixa.GetTotaisCaixaUseCase,
+	pendentesUC *caixa.ListFechamentosPendentesUseCase,
+	aprovarUC *caixa.AprovarFechamentoUseCase,
+	rejeitarUC *caixa.RejeitarFechamentoUseCase,
 	logger *zap.Logger,
 ) *CaixaHandler {
 	return &CaixaHandler{
@@ -51,6 +63,9 @@ func NewCaixaHandler(
 		getCaixaByIDUC:   getCaixaByIDUC,
 		listHistoricoUC:  listHistoricoUC,
 		getTotaisUC:      getTotaisUC,
+		pendentesUC:      pendentesUC,
+		aprovarUC:        aprovarUC,
+		rejeitarUC:       rejeitarUC,
 		logger:           logger,
 	}
 }
@@ -59,18 +74,24 @@ func NewCaixaHandler(
 func (h *CaixaHandler) RegisterRoutes(g *echo.Group) {
 	caixaGroup := g.Group("/caixa")
 
-	// Status e consultas - Qualquer role autenticada pode visualizar
-	caixaGroup.GET("/status", h.GetStatus, mw.RequireAnyRole(h.logger))
-	caixaGroup.GET("/aberto", h.GetCaixaAberto, mw.RequireAnyRole(h.logger))
-	caixaGroup.GET("/historico", h.ListHistorico, mw.RequireAnyRole(h.logger))
-	caixaGroup.GET("/totais", h.GetTotais, mw.RequireAnyRole(h.logger))
-	caixaGroup.GET("/:id", h.GetCaixaByID, mw.RequireAnyRole(h.logger))
-
-	// Operações críticas - Apenas OWNER/MANAGER podem executar
-	caixaGroup.POST("/abrir", h.AbrirCaixa, mw.RequireOwnerOrManager(h.logger))
-	caixaGroup.POST("/sangria", h.Sangria, mw.RequireOwnerOrManager(h.logger))
-	caixaGroup.POST("/reforco", h.Reforco, mw.RequireOwnerOrManager(h.logger))
-	caixaGroup.POST("/fechar", h.FecharCaixa, mw.RequireOwnerOrManager(h.logger))
+	// Status e consultas
+	caixaGroup.GET("/status", h.GetStatus, mw.RequirePermission(h.logger, valueobject.PermCaixaRead))
+	caixaGroup.GET("/aberto", h.GetCaixaAberto, mw.RequirePermission(h.logger, valueobject.PermCaixaRead))
+	caixaGroup.GET("/historico", h.ListHistorico, mw.RequirePermission(h.logger, valueobject.PermCaixaRead))
+	caixaGroup.GET("/totais", h.GetTotais, mw.RequirePermission(h.logger, valueobject.PermCaixaRead))
+	caixaGroup.GET("/pendentes", h.ListPendentes, mw.RequirePermission(h.logger, valueobject.PermCaixaAprovar))
+	caixaGroup.GET("/:id", h.GetCaixaByID, mw.RequirePermission(h.logger, valueobject.PermCaixaRead))
+	caixaGroup.GET("/:id/relatorio", h.GetRelatorioFechamento, mw.RequirePermission(h.logger, valueobject.PermCaixaRelatorio))
+
+	// Operações críticas
+	caixaGroup.POST("/abrir", h.AbrirCaixa, mw.RequirePermission(h.logger, valueobject.PermCaixaAbrir))
+	caixaGroup.POST("/sangria", h.Sangria, mw.RequirePermission(h.logger, valueobject.PermCaixaSangria))
+	caixaGroup.POST("/reforco", h.Reforco, mw.RequirePermission(h.logger, valueobject.PermCaixaReforco))
+	caixaGroup.POST("/:id/aprovar", h.AprovarFechamento, mw.RequirePermission(h.logger, valueobject.PermCaixaAprovar))
+	caixaGroup.POST("/:id/rejeitar", h.RejeitarFechamento, mw.RequirePermission(h.logger, valueobject.PermCaixaAprovar))
+
+	// Fechamento - recepção também conta a gaveta; divergência alta aguarda gerente
+	caixaGroup.POST("/fechar", h.FecharCaixa, mw.RequirePermission(h.logger, valueobject.PermCaixaFechar))
 }
 
 // AbrirCaixa abre um novo caixa diário
@@ -106,9 +127,10 @@ func (h *CaixaHandler) AbrirCaixa(c echo.Context) error {
 	}
 
 	result, err := h.abrirCaixaUC.Execute(c.Request().Context(), caixa.AbrirCaixaInput{
-		TenantID:     tenantID,
-		UsuarioID:    userID,
-		SaldoInicial: saldoInicial,
+		TenantID:       tenantID,
+		UsuarioID:      userID,
+		SaldoInicial:   saldoInicial,
+		FechamentoCego: req.FechamentoCego,
 	})
 	if err != nil {
 		h.logger.Error("Erro ao abrir caixa", zap.Error(err))
@@ -214,7 +236,9 @@ func (h *CaixaHandler) Reforco(c echo.Context) error {
 
 // FecharCaixa fecha o caixa diário atual
 // @Summary Fechar caixa
-// @Description Fecha o caixa com conferência de saldo real
+// @Description Fecha o caixa com conferência de saldo real ou contagem por cédula/moeda.
+// @Description No fechamento cego a contagem é obrigatória. Divergência acima de R$ 20,00
+// @Description informada por quem não é gerente deixa o caixa AGUARDANDO_APROVACAO.
 // @Tags Caixa
 // @Accept json
 // @Produce json
@@ -239,17 +263,38 @@ func (h *CaixaHandler) FecharCaixa(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "dados inválidos"})
 	}
 
-	saldoReal, err := decimal.NewFromString(req.SaldoReal)
-	if err != nil {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "saldo_real inválido"})
-	}
-
-	result, err := h.fecharCaixaUC.Execute(c.Request().Context(), caixa.FecharCaixaInput{
+	input := caixa.FecharCaixaInput{
 		TenantID:      tenantID,
 		UsuarioID:     userID,
-		SaldoReal:     saldoReal,
 		Justificativa: req.Justificativa,
-	})
+		PodeAprovar:   podeVerValoresCaixa(c),
+	}
+
+	if len(req.Contagem) > 0 {
+		itens := make([]entity.ItemContagem, len(req.Contagem))
+		for i, item := range req.Contagem {
+			denominacao, err := decimal.NewFromString(item.Denominacao)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, map[string]string{"error": "denominação inválida: " + item.Denominacao})
+			}
+			itens[i] = entity.ItemContagem{Denominacao: denominacao, Quantidade: item.Quantidade}
+		}
+		contagem, err := entity.NewContagemCaixa(itens)
+		if err != nil {
+			return handleCaixaError(c, err)
+		}
+		input.Contagem = contagem
+	} else if req.SaldoReal != "" {
+		saldoReal, err := decimal.NewFromString(req.SaldoReal)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "saldo_real inválido"})
+		}
+		input.SaldoReal = saldoReal
+	} else {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "informe a contagem ou o saldo_real"})
+	}
+
+	result, err := h.fecharCaixaUC.Execute(c.Request().Context(), input)
 	if err != nil {
 		h.logger.Error("Erro ao fechar caixa", zap.Error(err))
 		return handleCaixaError(c, err)
@@ -258,6 +303,87 @@ func (h *CaixaHandler) FecharCaixa(c echo.Context) error {
 	return c.JSON(http.StatusOK, mapper.ToCaixaDiarioResponse(result))
 }
 
+// ListPendentes lista os fechamentos aguardando aprovação do gerente
+// @Summary Fechamentos pendentes
+// @Description Caixas fechados com divergência acima do limite, aguardando aprovação
+// @Tags Caixa
+// @Produce json
+// @Success 200 {array} dto.CaixaDiarioResumoResponse
+// @Router /api/v1/caixa/pendentes [get]
+func (h *CaixaHandler) ListPendentes(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	caixas, err := h.pendentesUC.Execute(c.Request().Context(), tenantID)
+	if err != nil {
+		h.logger.Error("Erro ao listar fechamentos pendentes", zap.Error(err))
+		return handleCaixaError(c, err)
+	}
+
+	items := make([]dto.CaixaDiarioResumoResponse, len(caixas))
+	for i, cx := range caixas {
+		items[i] = mapper.ToCaixaDiarioResumoResponse(cx)
+	}
+	return c.JSON(http.StatusOK, items)
+}
+
+// AprovarFechamento aprova a divergência e conclui o fechamento
+// @Summary Aprovar fechamento
+// @Tags Caixa
+// @Produce json
+// @Param id path string true "ID do caixa"
+// @Success 200 {object} dto.CaixaDiarioResponse
+// @Failure 409 {object} map[string]string "Caixa não aguarda aprovação"
+// @Router /api/v1/caixa/{id}/aprovar [post]
+func (h *CaixaHandler) AprovarFechamento(c echo.Context) error {
+	input, err := decisaoFechamentoInput(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	result, err := h.aprovarUC.Execute(c.Request().Context(), input)
+	if err != nil {
+		h.logger.Error("Erro ao aprovar fechamento", zap.Error(err))
+		return handleCaixaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToCaixaDiarioResponse(result))
+}
+
+// RejeitarFechamento devolve o caixa para nova contagem
+// @Summary Rejeitar fechamento
+// @Description Reabre o caixa descartando a contagem; o operador deve contar novamente
+// @Tags Caixa
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do caixa"
+// @Param request body dto.RejeitarFechamentoRequest true "Motivo"
+// @Success 200 {object} dto.CaixaDiarioResponse
+// @Failure 409 {object} map[string]string "Caixa não aguarda aprovação"
+// @Router /api/v1/caixa/{id}/rejeitar [post]
+func (h *CaixaHandler) RejeitarFechamento(c echo.Context) error {
+	input, err := decisaoFechamentoInput(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var req dto.RejeitarFechamentoRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "dados inválidos"})
+	}
+	input.Motivo = strings.TrimSpace(req.Motivo)
+
+	result, err := h.rejeitarUC.Execute(c.Request().Context(), input)
+	if err != nil {
+		h.logger.Error("Erro ao rejeitar fechamento", zap.Error(err))
+		return handleCaixaError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToCaixaDiarioResponse(result))
+}
+
 // GetStatus retorna o status do caixa (aberto/fechado)
 // @Summary Status do caixa
 // @Description Retorna se há caixa aberto e informações básicas
@@ -277,7 +403,11 @@ func (h *CaixaHandler) GetStatus(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "erro interno"})
 	}
 
-	return c.JSON(http.StatusOK, mapper.ToCaixaStatusResponse(caixaAberto, nil))
+	resp := mapper.ToCaixaStatusResponse(caixaAberto, nil)
+	if resp.CaixaAtual != nil && caixaAberto.OcultaValoresEsperados() && !podeVerValoresCaixa(c) {
+		mapper.OcultarValoresEsperados(resp.CaixaAtual)
+	}
+	return c.JSON(http.StatusOK, resp)
 }
 
 // GetCaixaAberto retorna o caixa aberto com operações
@@ -304,7 +434,7 @@ func (h *CaixaHandler) GetCaixaAberto(c echo.Context) error {
 		return c.JSON(http.StatusNotFound, map[string]string{"error": "nenhum caixa aberto"})
 	}
 
-	return c.JSON(http.StatusOK, mapper.ToCaixaDiarioResponse(result))
+	return c.JSON(http.StatusOK, h.caixaResponse(c, result))
 }
 
 // GetCaixaByID retorna um caixa específico
@@ -333,7 +463,52 @@ func (h *CaixaHandler) GetCaixaByID(c echo.Context) error {
 		return handleCaixaError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, mapper.ToCaixaDiarioResponse(result))
+	return c.JSON(http.StatusOK, h.caixaResponse(c, result))
+}
+
+// GetRelatorioFechamento gera o relatório de fechamento para impressão
+// @Summary Relatório de fechamento do caixa
+// @Description Resumo do caixa (vendas por forma de pagamento, sangrias, reforços, contagem e divergência)
+// @Tags Caixa
+// @Produce application/pdf
+// @Param id path string true "ID do caixa"
+// @Param format query string false "pdf (padrão), csv ou xlsx"
+// @Success 200 {file} binary
+// @Failure 403 {object} map[string]string "Valores ocultos até o fechamento"
+// @Router /api/v1/caixa/{id}/relatorio [get]
+func (h *CaixaHandler) GetRelatorioFechamento(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	caixaID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "id inválido"})
+	}
+
+	format := export.FormatPDF
+	if c.QueryParam("format") != "" {
+		format, err = export.ParseFormat(c.QueryParam("format"))
+		if err != nil {
+			return badExportFormat(c, err)
+		}
+	}
+
+	result, err := h.getCaixaByIDUC.Execute(c.Request().Context(), caixaID, tenantID)
+	if err != nil {
+		h.logger.Error("Erro ao buscar caixa para relatório", zap.Error(err))
+		return handleCaixaError(c, err)
+	}
+	if result.OcultaValoresEsperados() && !podeVerValoresCaixa(c) {
+		return handleCaixaError(c, domain.ErrCaixaValoresOcultos)
+	}
+
+	if err := exportRelatorioFechamento(c, format, result); err != nil {
+		h.logger.Error("Erro ao exportar relatório de fechamento", zap.Error(err))
+		return err
+	}
+	return nil
 }
 
 // ListHistorico retorna o histórico de caixas fechados
@@ -432,13 +607,18 @@ func (h *CaixaHandler) GetTotais(c echo.Context) error {
 		h.logger.Error("Erro ao buscar totais", zap.Error(err))
 		return handleCaixaError(c, err)
 	}
+	if result.FechamentoCego && !podeVerValoresCaixa(c) {
+		return handleCaixaError(c, domain.ErrCaixaValoresOcultos)
+	}
 
 	return c.JSON(http.StatusOK, dto.TotaisCaixaResponse{
-		TotalVendas:   result.TotalVendas.String(),
-		TotalSangrias: result.TotalSangrias.String(),
-		TotalReforcos: result.TotalReforcos.String(),
-		TotalDespesas: result.TotalDespesas.String(),
-		SaldoAtual:    result.SaldoAtual.String(),
+		TotalVendas:       result.TotalVendas.String(),
+		TotalSangrias:     result.TotalSangrias.String(),
+		TotalReforcos:     result.TotalReforcos.String(),
+		TotalDespesas:     result.TotalDespesas.String(),
+		SaldoAtual:        result.SaldoAtual.String(),
+		PorFormaPagamento: mapper.ToTotaisPorFormaPagamento(result.PorFormaPagamento),
+		SaldoDinheiro:     result.SaldoDinheiro.String(),
 	})
 }
 
@@ -446,10 +626,130 @@ func (h *CaixaHandler) GetTotais(c echo.Context) error {
 // HELPERS
 // ============================================================
 
+// podeVerValoresCaixa indica se o usuário vê o saldo esperado antes da contagem
+// e aprova divergências (permissão caixa.aprovar, a mesma das rotas de aprovação)
+func podeVerValoresCaixa(c echo.Context) bool {
+	return mw.GetPermissions(c).Has(valueobject.PermCaixaAprovar)
+}
+
+// caixaResponse monta a resposta ocultando os valores esperados do operador no fechamento cego
+func (h *CaixaHandler) caixaResponse(c echo.Context, cx *entity.CaixaDiario) dto.CaixaDiarioResponse {
+	resp := mapper.ToCaixaDiarioResponse(cx)
+	if cx.OcultaValoresEsperados() && !podeVerValoresCaixa(c) {
+		mapper.OcultarValoresEsperados(&resp)
+	}
+	return resp
+}
+
+// decisaoFechamentoInput extrai caixa, tenant e gerente da requisição de aprovação/rejeição
+func decisaoFechamentoInput(c echo.Context) (caixa.DecisaoFechamentoInput, error) {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return caixa.DecisaoFechamentoInput{}, errors.New("tenant não identificado")
+	}
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return caixa.DecisaoFechamentoInput{}, errors.New("usuário não identificado")
+	}
+	caixaID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return caixa.DecisaoFechamentoInput{}, errors.New("id inválido")
+	}
+	return caixa.DecisaoFechamentoInput{TenantID: tenantID, CaixaID: caixaID, UsuarioID: userID}, nil
+}
+
+// exportRelatorioFechamento grava o relatório de fechamento em seções
+// (resumo, vendas por forma de pagamento, contagem e conferência)
+func exportRelatorioFechamento(c echo.Context, format export.Format, cx *entity.CaixaDiario) error {
+	subtitle := fmt.Sprintf("Abertura: %s por %s", cx.DataAbertura.Format("02/01/2006 15:04"), cx.UsuarioAberturaNome)
+	if cx.DataFechamento != nil {
+		subtitle += fmt.Sprintf(" | Fechamento: %s", cx.DataFechamento.Format("02/01/2006 15:04"))
+		if cx.UsuarioFechamentoNome != "" {
+			subtitle += " por " + cx.UsuarioFechamentoNome
+		}
+	}
+	subtitle += " | Status: " + string(cx.Status)
+
+	doc := export.Document{
+		Title:    "Fechamento de Caixa",
+		Subtitle: subtitle,
+		Columns: []export.Column{
+			{Title: "Seção", Kind: export.KindText, Width: 1.2},
+			{Title: "Descrição", Kind: export.KindText, Width: 3},
+			{Title: "Quantidade", Kind: export.KindNumber, Width: 0.8},
+			{Title: "Valor", Kind: export.KindMoney},
+		},
+	}
+
+	baseName := "fechamento_caixa_" + cx.DataAbertura.Format("20060102")
+	return streamExport(c, format, baseName, doc, func(w export.Writer) error {
+		rows := [][]any{
+			{"Resumo", "Saldo inicial", nil, cx.SaldoInicial},
+		}
+
+		porForma := cx.TotaisPorFormaPagamento()
+		formas := make([]string, 0, len(porForma))
+		for forma := range porForma {
+			formas = append(formas, string(forma))
+		}
+		sort.Strings(formas)
+		for _, forma := range formas {
+			rows = append(rows, []any{"Vendas", forma, nil, porForma[entity.TipoPagamento(forma)]})
+		}
+
+		rows = append(rows,
+			[]any{"Resumo", "Reforços", nil, cx.TotalReforcos},
+			[]any{"Resumo", "Sangrias", nil, cx.TotalSangrias.Neg()},
+			[]any{"Resumo", "Saldo esperado (total)", nil, cx.SaldoEsperado},
+			[]any{"Resumo", "Saldo esperado em dinheiro", nil, cx.SaldoEsperadoDinheiro()},
+		)
+
+		if cx.Contagem != nil {
+			for _, item := range cx.Contagem.Itens {
+				tipo := "Moeda"
+				if item.Cedula() {
+					tipo = "Cédula"
+				}
+				rows = append(rows, []any{"Contagem", tipo + " de R$ " + export.FormatMoney(item.Denominacao), item.Quantidade, item.Subtotal()})
+			}
+			rows = append(rows, []any{"Contagem", "Total contado", nil, cx.Contagem.Total()})
+		}
+
+		if cx.SaldoReal != nil {
+			rows = append(rows, []any{"Conferência", "Saldo real", nil, *cx.SaldoReal})
+		}
+		if cx.Divergencia != nil {
+			rows = append(rows, []any{"Conferência", "Divergência", nil, *cx.Divergencia})
+		}
+		if cx.JustificativaDivergencia != nil {
+			rows = append(rows, []any{"Conferência", "Justificativa: " + *cx.JustificativaDivergencia, nil, nil})
+		}
+		if cx.DataAprovacao != nil {
+			rows = append(rows, []any{"Conferência", "Aprovado em " + cx.DataAprovacao.Format("02/01/2006 15:04"), nil, nil})
+		}
+
+		for _, row := range rows {
+			if err := w.WriteRow(row...); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // handleCaixaError trata erros específicos do módulo caixa
 func handleCaixaError(c echo.Context, err error) error {
 	// Preferir errors.Is para não depender de mensagens string (mais robusto)
 	switch {
+	case errors.Is(err, domain.ErrCaixaContagemInvalida),
+		errors.Is(err, domain.ErrCaixaContagemObrigatoria),
+		errors.Is(err, domain.ErrCaixaMotivoRejeicaoCurto):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCaixaAguardandoAprovacao),
+		errors.Is(err, domain.ErrCaixaNaoAguardaAprovacao):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCaixaValoresOcultos):
+		return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
 	case errors.Is(err, domain.ErrCaixaJaAberto):
 		return c.JSON(http.StatusConflict, map[string]string{"error": "já existe um caixa aberto"})
 	case errors.Is(err, domain.ErrCaixaNaoAberto):
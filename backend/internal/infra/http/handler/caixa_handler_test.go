@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodeVerValoresCaixa(t *testing.T) {
+	e := echo.New()
+	cases := []struct {
+		nome  string
+		role  middleware.Role
+		perms valueobject.PermissionSet // nil: modelo do papel
+		want  bool
+	}{
+		{"dono", middleware.RoleOwner, nil, true},
+		{"gerente", middleware.RoleManager, nil, true},
+		{"recepcionista", middleware.RoleReceptionist, nil, false},
+		{"personalizado com caixa.aprovar", middleware.RoleBarber, valueobject.NewPermissionSet("caixa.aprovar"), true},
+		{"personalizado de gerente sem caixa.aprovar", middleware.RoleManager, valueobject.NewPermissionSet("caixa.read", "caixa.fechar"), false},
+	}
+	for _, tc := range cases {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.Set("role", string(tc.role))
+		if tc.perms != nil {
+			c.Set("permissions", tc.perms)
+		}
+		assert.Equal(t, tc.want, podeVerValoresCaixa(c), tc.nome)
+	}
+}
+
+func TestCaixaResponse_FechamentoCego(t *testing.T) {
+	e := echo.New()
+	h := &CaixaHandler{}
+	cx := &entity.CaixaDiario{Status: entity.StatusCaixaAberto, FechamentoCego: true}
+
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+	c.Set("role", string(middleware.RoleReceptionist))
+	assert.True(t, h.caixaResponse(c, cx).ValoresOcultos)
+
+	c.Set("permissions", valueobject.NewPermissionSet("caixa.read", "caixa.aprovar"))
+	assert.False(t, h.caixaResponse(c, cx).ValoresOcultos)
+}
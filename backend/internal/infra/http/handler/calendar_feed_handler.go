@@ -0,0 +1,213 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarfeed"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/ical"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// calendarFeedPath caminho público do feed; o token vai no lugar de :token
+const calendarFeedPath = "/api/v1/public/calendar/"
+
+// CalendarFeedHandler agrupa os handlers dos feeds iCalendar (.ics) da agenda.
+type CalendarFeedHandler struct {
+	createUC *calendarfeed.CreateFeedUseCase
+	listUC   *calendarfeed.ListFeedsUseCase
+	revokeUC *calendarfeed.RevokeFeedUseCase
+	getUC    *calendarfeed.GetFeedUseCase
+	logger   *zap.Logger
+}
+
+// NewCalendarFeedHandler cria um novo handler dos feeds da agenda
+func NewCalendarFeedHandler(
+	createUC *calendarfeed.CreateFeedUseCase,
+	listUC *calendarfeed.ListFeedsUseCase,
+	revokeUC *calendarfeed.RevokeFeedUseCase,
+	getUC *calendarfeed.GetFeedUseCase,
+	logger *zap.Logger,
+) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		createUC: createUC,
+		listUC:   listUC,
+		revokeUC: revokeUC,
+		getUC:    getUC,
+		logger:   logger,
+	}
+}
+
+// Create godoc
+// @Summary Criar feed .ics da agenda
+// @Description Gera o link para assinar a agenda no celular. Com professional_id, agenda do profissional em todas as unidades; sem ele, agenda da unidade. Só proprietário e gerente criam feeds da unidade ou de outros profissionais; os demais só assinam a própria agenda. O link é exibido uma única vez.
+// @Tags Feeds de Agenda
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateCalendarFeedRequest true "Profissional (opcional)"
+// @Success 201 {object} dto.CalendarFeedResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 403 {object} dto.ErrorResponse
+// @Router /api/v1/calendar-feeds [post]
+// @Security BearerAuth
+func (h *CalendarFeedHandler) Create(c echo.Context) error {
+	unitID := middleware.GetUnitID(c)
+	if unitID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "unit_required",
+			Message: domain.ErrUnitIDRequired.Error(),
+		})
+	}
+
+	var req dto.CreateCalendarFeedRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	// Fora proprietário e gerente, só se assina a própria agenda
+	if ownProfID := ownFeedScope(c); ownProfID != "" {
+		if req.ProfessionalID != "" && req.ProfessionalID != ownProfID {
+			return c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "Você só pode criar o feed da sua própria agenda",
+			})
+		}
+		req.ProfessionalID = ownProfID
+	}
+
+	out, err := h.createUC.Execute(c.Request().Context(), calendarfeed.CreateFeedInput{
+		TenantID:       middleware.GetTenantID(c),
+		UnitID:         unitID,
+		ProfessionalID: req.ProfessionalID,
+		CreatedBy:      middleware.GetUserID(c),
+	})
+	if err != nil {
+		return h.handleCalendarFeedError(c, err, "Erro ao criar feed de agenda")
+	}
+
+	resp := mapper.CalendarFeedToResponse(out.Feed)
+	resp.URL = c.Scheme() + "://" + c.Request().Host + calendarFeedPath + out.Token + ".ics"
+	return c.JSON(http.StatusCreated, resp)
+}
+
+// List godoc
+// @Summary Listar feeds .ics da agenda
+// @Description Feeds ativos da unidade (fora proprietário e gerente, só os da própria agenda). O link não é exibido novamente.
+// @Tags Feeds de Agenda
+// @Produce json
+// @Success 200 {array} dto.CalendarFeedResponse
+// @Router /api/v1/calendar-feeds [get]
+// @Security BearerAuth
+func (h *CalendarFeedHandler) List(c echo.Context) error {
+	feeds, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c))
+	if err != nil {
+		return h.handleCalendarFeedError(c, err, "Erro ao listar feeds de agenda")
+	}
+
+	if ownProfID := ownFeedScope(c); ownProfID != "" {
+		own := make([]*entity.CalendarFeed, 0, len(feeds))
+		for _, f := range feeds {
+			if f.ProfessionalID == ownProfID {
+				own = append(own, f)
+			}
+		}
+		feeds = own
+	}
+
+	return c.JSON(http.StatusOK, mapper.CalendarFeedsToResponse(feeds))
+}
+
+// Revoke godoc
+// @Summary Revogar feed .ics da agenda
+// @Description O link deixa de funcionar; o aplicativo de calendário para de receber atualizações
+// @Tags Feeds de Agenda
+// @Param id path string true "ID do feed"
+// @Success 204
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/calendar-feeds/{id} [delete]
+// @Security BearerAuth
+func (h *CalendarFeedHandler) Revoke(c echo.Context) error {
+	err := h.revokeUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"), ownFeedScope(c))
+	if err != nil {
+		return h.handleCalendarFeedError(c, err, "Erro ao revogar feed de agenda")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Feed godoc
+// @Summary Feed .ics da agenda (público)
+// @Description Calendário iCalendar somente leitura, validado pelo token do link: agendamentos (primeiro nome do cliente e serviços) e bloqueios de horário
+// @Tags Feeds de Agenda
+// @Produce text/calendar
+// @Param token path string true "Token do feed (com ou sem .ics)"
+// @Success 200 {string} string "text/calendar"
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/public/calendar/{token} [get]
+func (h *CalendarFeedHandler) Feed(c echo.Context) error {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+	if token == "" {
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "not_found",
+			Message: domain.ErrCalendarFeedNotFound.Error(),
+		})
+	}
+
+	out, err := h.getUC.Execute(c.Request().Context(), token)
+	if err != nil {
+		return h.handleCalendarFeedError(c, err, "Erro ao gerar feed de agenda")
+	}
+
+	var buf bytes.Buffer
+	if err := ical.Write(&buf, out.Name, out.Events); err != nil {
+		return h.handleCalendarFeedError(c, err, "Erro ao gerar feed de agenda")
+	}
+
+	c.Response().Header().Set("Content-Disposition", `inline; filename="agenda.ics"`)
+	c.Response().Header().Set("Cache-Control", "private, no-cache")
+	return c.Blob(http.StatusOK, ical.ContentType, buf.Bytes())
+}
+
+// ownFeedScope retorna o profissional a que o usuário fica restrito nos
+// feeds: proprietário e gerente gerenciam os feeds da unidade e de qualquer
+// profissional (vazio); os demais, só o da própria agenda.
+func ownFeedScope(c echo.Context) string {
+	role := middleware.GetUserRole(c)
+	if role == string(middleware.RoleOwner) || role == string(middleware.RoleManager) {
+		return ""
+	}
+	return middleware.GetUserID(c)
+}
+
+// handleCalendarFeedError mapeia erros dos feeds da agenda
+func (h *CalendarFeedHandler) handleCalendarFeedError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrCalendarFeedNotFound),
+		errors.Is(err, domain.ErrAppointmentProfessionalNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidID),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrUnitIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
@@ -0,0 +1,30 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOwnFeedScope(t *testing.T) {
+	e := echo.New()
+	cases := []struct {
+		role middleware.Role
+		want string
+	}{
+		{middleware.RoleOwner, ""},
+		{middleware.RoleManager, ""},
+		{middleware.RoleBarber, "user-1"},
+		{middleware.RoleReceptionist, "user-1"},
+	}
+	for _, tc := range cases {
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		c.Set("role", string(tc.role))
+		c.Set("user_id", "user-1")
+		assert.Equal(t, tc.want, ownFeedScope(c), "role %s", tc.role)
+	}
+}
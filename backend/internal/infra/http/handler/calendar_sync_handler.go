@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/calendarsync"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// calendarConnectRedirectPath página do frontend que recebe o resultado da
+// conexão (?google_calendar=connected|error)
+const calendarConnectRedirectPath = "/agendamentos"
+
+// CalendarSyncHandler agrupa os handlers da sincronização com o Google Agenda.
+type CalendarSyncHandler struct {
+	startUC      *calendarsync.StartConnectUseCase
+	completeUC   *calendarsync.CompleteConnectUseCase
+	listUC       *calendarsync.ListConnectionsUseCase
+	disconnectUC *calendarsync.DisconnectUseCase
+	pullUC       *calendarsync.PullUseCase
+	appURL       string
+	logger       *zap.Logger
+}
+
+// NewCalendarSyncHandler cria um novo handler da sincronização de calendários
+func NewCalendarSyncHandler(
+	startUC *calendarsync.StartConnectUseCase,
+	completeUC *calendarsync.CompleteConnectUseCase,
+	listUC *calendarsync.ListConnectionsUseCase,
+	disconnectUC *calendarsync.DisconnectUseCase,
+	pullUC *calendarsync.PullUseCase,
+	appURL string,
+	logger *zap.Logger,
+) *CalendarSyncHandler {
+	return &CalendarSyncHandler{
+		startUC:      startUC,
+		completeUC:   completeUC,
+		listUC:       listUC,
+		disconnectUC: disconnectUC,
+		pullUC:       pullUC,
+		appURL:       appURL,
+		logger:       logger,
+	}
+}
+
+// Authorize godoc
+// @Summary Conectar Google Agenda
+// @Description Gera o link de consentimento do Google para o profissional. Depois da autorização os agendamentos dele são enviados ao Google Agenda e os eventos ocupados do Google viram bloqueios de horário. Barbeiros só conectam a própria agenda.
+// @Tags Sincronização de Calendário
+// @Accept json
+// @Produce json
+// @Param request body dto.AuthorizeCalendarConnectionRequest true "Profissional"
+// @Success 200 {object} dto.AuthorizeCalendarConnectionResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 503 {object} dto.ErrorResponse
+// @Router /api/v1/calendar-connections/google/authorize [post]
+// @Security BearerAuth
+func (h *CalendarSyncHandler) Authorize(c echo.Context) error {
+	var req dto.AuthorizeCalendarConnectionRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	// Barbeiro só conecta a própria agenda
+	if barberProfID := middleware.GetProfessionalIDForBarber(c); barberProfID != "" {
+		req.ProfessionalID = barberProfID
+	}
+
+	authURL, err := h.startUC.Execute(c.Request().Context(), calendarsync.StartConnectInput{
+		TenantID:       middleware.GetTenantID(c),
+		ProfessionalID: req.ProfessionalID,
+		UserID:         middleware.GetUserID(c),
+	})
+	if err != nil {
+		return h.handleCalendarSyncError(c, err, "Erro ao iniciar conexão com Google Agenda")
+	}
+
+	return c.JSON(http.StatusOK, dto.AuthorizeCalendarConnectionResponse{URL: authURL})
+}
+
+// Callback godoc
+// @Summary Retorno da autorização do Google (público)
+// @Description Chamado pelo Google após o consentimento; grava a conexão e redireciona para a agenda com google_calendar=connected ou google_calendar=error
+// @Tags Sincronização de Calendário
+// @Param state query string true "Estado cifrado gerado no authorize"
+// @Param code query string false "Código de autorização"
+// @Param error query string false "Erro retornado pelo Google (ex.: access_denied)"
+// @Success 302
+// @Router /api/v1/public/calendar-connections/google/callback [get]
+func (h *CalendarSyncHandler) Callback(c echo.Context) error {
+	result := "connected"
+	if providerErr := c.QueryParam("error"); providerErr != "" {
+		result = "error"
+		h.logger.Info("Conexão com Google Agenda recusada", zap.String("error", providerErr))
+	} else if _, err := h.completeUC.Execute(c.Request().Context(), c.QueryParam("state"), c.QueryParam("code")); err != nil {
+		result = "error"
+		if errors.Is(err, domain.ErrCalendarAuthorizationInvalid) {
+			h.logger.Warn("Retorno inválido da autorização do Google Agenda", zap.Error(err))
+		} else {
+			h.logger.Error("Erro ao concluir conexão com Google Agenda", zap.Error(err))
+		}
+	}
+
+	q := url.Values{}
+	q.Set("google_calendar", result)
+	return c.Redirect(http.StatusFound, h.appURL+calendarConnectRedirectPath+"?"+q.Encode())
+}
+
+// List godoc
+// @Summary Listar conexões com Google Agenda
+// @Description Conexões dos profissionais do tenant (barbeiros veem só a sua), com status e última sincronização
+// @Tags Sincronização de Calendário
+// @Produce json
+// @Success 200 {array} dto.CalendarConnectionResponse
+// @Router /api/v1/calendar-connections [get]
+// @Security BearerAuth
+func (h *CalendarSyncHandler) List(c echo.Context) error {
+	conns, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetProfessionalIDForBarber(c))
+	if err != nil {
+		return h.handleCalendarSyncError(c, err, "Erro ao listar conexões com Google Agenda")
+	}
+
+	return c.JSON(http.StatusOK, mapper.CalendarConnectionsToResponse(conns))
+}
+
+// Disconnect godoc
+// @Summary Desconectar Google Agenda
+// @Description Revoga o acesso e remove os bloqueios importados do Google. Os eventos já enviados permanecem no Google Agenda.
+// @Tags Sincronização de Calendário
+// @Param id path string true "ID da conexão"
+// @Success 204
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/calendar-connections/{id} [delete]
+// @Security BearerAuth
+func (h *CalendarSyncHandler) Disconnect(c echo.Context) error {
+	err := h.disconnectUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"), middleware.GetProfessionalIDForBarber(c))
+	if err != nil {
+		return h.handleCalendarSyncError(c, err, "Erro ao desconectar Google Agenda")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Sync godoc
+// @Summary Sincronizar Google Agenda agora
+// @Description Lê o Google Agenda da conexão imediatamente (normalmente feito a cada 5 minutos) e informa os eventos em conflito com agendamentos
+// @Tags Sincronização de Calendário
+// @Produce json
+// @Param id path string true "ID da conexão"
+// @Success 200 {object} dto.CalendarSyncResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/calendar-connections/{id}/sync [post]
+// @Security BearerAuth
+func (h *CalendarSyncHandler) Sync(c echo.Context) error {
+	result, err := h.pullUC.SyncConnection(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"), middleware.GetProfessionalIDForBarber(c))
+	if err != nil {
+		return h.handleCalendarSyncError(c, err, "Erro ao sincronizar Google Agenda")
+	}
+
+	return c.JSON(http.StatusOK, mapper.CalendarSyncResultToResponse(result))
+}
+
+// handleCalendarSyncError mapeia erros da sincronização de calendários
+func (h *CalendarSyncHandler) handleCalendarSyncError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrCalendarConnectionNotFound),
+		errors.Is(err, domain.ErrAppointmentProfessionalNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrCalendarAccessRevoked):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "calendar_access_revoked", Message: err.Error()})
+	case errors.Is(err, domain.ErrCalendarProviderNotConfigured):
+		return c.JSON(http.StatusServiceUnavailable, dto.ErrorResponse{Error: "calendar_not_configured", Message: err.Error()})
+	case errors.Is(err, domain.ErrInvalidID),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrAppointmentProfessionalRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
@@ -1,12 +1,15 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/command"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -94,9 +97,12 @@ func (h *CommandHandler) CreateCommand(c echo.Context) error {
 	}
 
 	// Executar use case
-	response, err := h.createUC.Execute(ctx, tenantID, &req)
+	response, err := h.createUC.Execute(ctx, tenantID, &req, mw.GetPermissions(c))
 	if err != nil {
 		h.logger.Error("failed to create command", zap.Error(err))
+		if errors.Is(err, domain.ErrDescontoAcimaDoLimite) {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
@@ -291,9 +297,12 @@ func (h *CommandHandler) AddCommandItem(c echo.Context) error {
 	}
 
 	// T-EST-001: Execute agora valida estoque para itens PRODUTO
-	response, err := h.addItemUC.Execute(ctx, commandID, tenantID, userID, &req)
+	response, err := h.addItemUC.Execute(ctx, commandID, tenantID, userID, &req, mw.GetPermissions(c))
 	if err != nil {
 		h.logger.Error("failed to add item", zap.Error(err))
+		if errors.Is(err, domain.ErrDescontoAcimaDoLimite) {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": err.Error()})
+		}
 		// Retorna erro apropriado baseado no tipo
 		if strings.Contains(err.Error(), "estoque") || strings.Contains(err.Error(), "inativo") {
 			return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
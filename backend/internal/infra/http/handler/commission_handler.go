@@ -693,6 +693,7 @@ func (h *CommissionHandler) periodToResponse(period *entity.CommissionPeriod) dt
 		UnitID:           period.UnitID,
 		ReferenceMonth:   period.ReferenceMonth,
 		ProfessionalID:   period.ProfessionalID,
+		ProfessionalName: period.ProfessionalName,
 		TotalGross:       period.TotalGross.String(),
 		TotalCommission:  period.TotalCommission.String(),
 		TotalAdvances:    period.TotalAdvances.String(),
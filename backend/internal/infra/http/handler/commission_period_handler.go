@@ -6,6 +6,8 @@ import (
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/commission"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -263,6 +265,7 @@ func (h *CommissionHandler) GetOpenCommissionPeriod(c echo.Context) error {
 // @Param status query string false "Status do período"
 // @Param limit query int false "Limite de resultados"
 // @Param offset query int false "Offset para paginação"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {object} dto.ListCommissionPeriodsResponse
 // @Router /api/v1/commissions/periods [get]
 // @Security BearerAuth
@@ -277,6 +280,11 @@ func (h *CommissionHandler) ListCommissionPeriods(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	var req dto.ListCommissionPeriodsRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -298,12 +306,17 @@ func (h *CommissionHandler) ListCommissionPeriods(c echo.Context) error {
 		professionalID = &barberProfID
 	}
 
+	limit, offset := req.Limit, req.Offset
+	if exportar {
+		limit, offset = exportMaxRows, 0
+	}
+
 	output, err := h.listPeriodsUC.Execute(ctx, commission.ListCommissionPeriodsInput{
 		TenantID:       tenantID,
 		ProfessionalID: professionalID,
 		Status:         req.Status,
-		Limit:          req.Limit,
-		Offset:         req.Offset,
+		Limit:          limit,
+		Offset:         offset,
 	})
 
 	if err != nil {
@@ -311,6 +324,10 @@ func (h *CommissionHandler) ListCommissionPeriods(c echo.Context) error {
 		return h.handleDomainError(c, err)
 	}
 
+	if exportar {
+		return h.exportCommissionPeriods(c, format, output.CommissionPeriods)
+	}
+
 	periods := make([]dto.CommissionPeriodResponse, 0, len(output.CommissionPeriods))
 	for _, period := range output.CommissionPeriods {
 		periods = append(periods, h.periodToResponse(period))
@@ -468,3 +485,48 @@ func (h *CommissionHandler) DeleteCommissionPeriod(c echo.Context) error {
 
 	return c.NoContent(http.StatusNoContent)
 }
+
+// exportCommissionPeriods exporta os períodos de comissão (?format=csv|xlsx|pdf)
+func (h *CommissionHandler) exportCommissionPeriods(c echo.Context, format export.Format, periods []*entity.CommissionPeriod) error {
+	doc := export.Document{
+		Title: "Períodos de Comissão",
+		Columns: []export.Column{
+			{Title: "Referência", Kind: export.KindText, Width: 1},
+			{Title: "Profissional", Kind: export.KindText, Width: 2.6},
+			{Title: "Início", Kind: export.KindDate},
+			{Title: "Fim", Kind: export.KindDate},
+			{Title: "Faturamento", Kind: export.KindMoney},
+			{Title: "Comissão", Kind: export.KindMoney},
+			{Title: "Adiantamentos", Kind: export.KindMoney},
+			{Title: "Ajustes", Kind: export.KindMoney},
+			{Title: "Líquido", Kind: export.KindMoney},
+			{Title: "Itens", Kind: export.KindNumber, Width: 0.7},
+			{Title: "Status", Kind: export.KindText, Width: 1.1},
+		},
+	}
+
+	err := streamExport(c, format, "periodos_comissao", doc, func(w export.Writer) error {
+		for _, p := range periods {
+			if err := w.WriteRow(
+				p.ReferenceMonth,
+				p.ProfessionalName,
+				p.PeriodStart,
+				p.PeriodEnd,
+				p.TotalGross,
+				p.TotalCommission,
+				p.TotalAdvances,
+				p.TotalAdjustments,
+				p.TotalNet,
+				p.ItemsCount,
+				p.Status,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		h.logger.Error("Erro ao exportar períodos de comissão", zap.Error(err))
+	}
+	return err
+}
@@ -0,0 +1,323 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/financial"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// ConciliacaoHandler agrupa os handlers de conciliação bancária (importação de extratos)
+type ConciliacaoHandler struct {
+	importarUC    *financial.ImportarExtratoUseCase
+	listImportsUC *financial.ListImportacoesExtratoUseCase
+	getImportUC   *financial.GetImportacaoExtratoUseCase
+	listRevisaoUC *financial.ListRevisaoConciliacaoUseCase
+	conciliarUC   *financial.ConciliarLinhaManualUseCase
+	aceitarUC     *financial.AceitarDivergenciaUseCase
+	ignorarUC     *financial.IgnorarLinhaExtratoUseCase
+	logger        *zap.Logger
+}
+
+// NewConciliacaoHandler cria um novo handler de conciliação bancária
+func NewConciliacaoHandler(
+	importarUC *financial.ImportarExtratoUseCase,
+	listImportsUC *financial.ListImportacoesExtratoUseCase,
+	getImportUC *financial.GetImportacaoExtratoUseCase,
+	listRevisaoUC *financial.ListRevisaoConciliacaoUseCase,
+	conciliarUC *financial.ConciliarLinhaManualUseCase,
+	aceitarUC *financial.AceitarDivergenciaUseCase,
+	ignorarUC *financial.IgnorarLinhaExtratoUseCase,
+	logger *zap.Logger,
+) *ConciliacaoHandler {
+	return &ConciliacaoHandler{
+		importarUC:    importarUC,
+		listImportsUC: listImportsUC,
+		getImportUC:   getImportUC,
+		listRevisaoUC: listRevisaoUC,
+		conciliarUC:   conciliarUC,
+		aceitarUC:     aceitarUC,
+		ignorarUC:     ignorarUC,
+		logger:        logger,
+	}
+}
+
+// RegisterRoutes registra as rotas de conciliação bancária
+func (h *ConciliacaoHandler) RegisterRoutes(g *echo.Group) {
+	g.POST("/conciliacao/extratos", h.Importar, mw.RequireOwnerOrManager(h.logger))
+	g.GET("/conciliacao/extratos", h.ListImports, mw.RequireAdminAccess(h.logger))
+	g.GET("/conciliacao/extratos/:id", h.GetImport, mw.RequireAdminAccess(h.logger))
+	g.GET("/conciliacao/revisao", h.ListRevisao, mw.RequireAdminAccess(h.logger))
+	g.POST("/conciliacao/linhas/:id/conciliar", h.Conciliar, mw.RequireOwnerOrManager(h.logger))
+	g.POST("/conciliacao/linhas/:id/aceitar", h.AceitarDivergencia, mw.RequireOwnerOrManager(h.logger))
+	g.POST("/conciliacao/linhas/:id/ignorar", h.Ignorar, mw.RequireOwnerOrManager(h.logger))
+}
+
+// Importar importa um extrato bancário e concilia automaticamente
+// @Summary Importar extrato bancário
+// @Description Importa extrato OFX/CSV, concilia créditos com compensações/contas a receber e débitos com contas a pagar
+// @Tags Conciliação Bancária
+// @Accept multipart/form-data
+// @Produce json
+// @Param arquivo formData file true "Arquivo do extrato (.ofx, .csv)"
+// @Param formato formData string false "OFX ou CSV (padrão: pela extensão)"
+// @Success 201 {object} dto.ImportarExtratoResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/financial/conciliacao/extratos [post]
+func (h *ConciliacaoHandler) Importar(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+	userID, _ := getUserIDFromContext(c)
+
+	fileHeader, err := c.FormFile("arquivo")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "arquivo do extrato é obrigatório"})
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "não foi possível ler o arquivo"})
+	}
+	defer file.Close()
+
+	out, err := h.importarUC.Execute(c.Request().Context(), financial.ImportarExtratoInput{
+		TenantID:    tenantID,
+		UsuarioID:   userID,
+		ArquivoNome: fileHeader.Filename,
+		Formato:     c.FormValue("formato"),
+		Arquivo:     file,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao importar extrato bancário", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, dto.ImportarExtratoResponse{
+		Import:      mapper.ToBankStatementImportResponse(out.Import),
+		Novas:       out.Novas,
+		Duplicadas:  out.Duplicadas,
+		Conciliadas: out.Conciliadas,
+		Divergentes: out.Divergentes,
+		Linhas:      mapper.ToBankStatementLineListResponse(out.Linhas),
+	})
+}
+
+// ListImports lista os extratos importados
+// @Summary Listar extratos importados
+// @Tags Conciliação Bancária
+// @Produce json
+// @Param page query int false "Página (default: 1)"
+// @Param page_size query int false "Itens por página (default: 20)"
+// @Success 200 {array} dto.BankStatementImportResponse
+// @Router /api/v1/financial/conciliacao/extratos [get]
+func (h *ConciliacaoHandler) ListImports(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	pageSize, _ := strconv.Atoi(c.QueryParam("page_size"))
+
+	imports, err := h.listImportsUC.Execute(c.Request().Context(), tenantID, page, pageSize)
+	if err != nil {
+		h.logger.Error("Erro ao listar extratos importados", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToBankStatementImportListResponse(imports))
+}
+
+// GetImport retorna um extrato importado com seus lançamentos
+// @Summary Detalhar extrato importado
+// @Tags Conciliação Bancária
+// @Produce json
+// @Param id path string true "ID da importação"
+// @Success 200 {object} dto.BankStatementImportDetailResponse
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/financial/conciliacao/extratos/{id} [get]
+func (h *ConciliacaoHandler) GetImport(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	importID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID da importação inválido"})
+	}
+
+	imp, linhas, err := h.getImportUC.Execute(c.Request().Context(), tenantID, importID)
+	if err != nil {
+		h.logger.Error("Erro ao buscar extrato importado", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, dto.BankStatementImportDetailResponse{
+		Import: mapper.ToBankStatementImportResponse(imp),
+		Linhas: mapper.ToBankStatementLineListResponse(linhas),
+	})
+}
+
+// ListRevisao lista a fila de revisão da conciliação
+// @Summary Fila de revisão da conciliação
+// @Description Lançamentos sem correspondência (PENDENTE) ou com diferença de valor (DIVERGENTE)
+// @Tags Conciliação Bancária
+// @Produce json
+// @Success 200 {array} dto.BankStatementLineResponse
+// @Router /api/v1/financial/conciliacao/revisao [get]
+func (h *ConciliacaoHandler) ListRevisao(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	linhas, err := h.listRevisaoUC.Execute(c.Request().Context(), tenantID)
+	if err != nil {
+		h.logger.Error("Erro ao listar fila de revisão", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToBankStatementLineListResponse(linhas))
+}
+
+// Conciliar vincula manualmente um lançamento do extrato
+// @Summary Conciliar lançamento manualmente
+// @Tags Conciliação Bancária
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lançamento"
+// @Param request body dto.ConciliarLinhaRequest true "Registro interno"
+// @Success 200 {object} dto.BankStatementLineResponse
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Router /api/v1/financial/conciliacao/linhas/{id}/conciliar [post]
+func (h *ConciliacaoHandler) Conciliar(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+	userID, _ := getUserIDFromContext(c)
+
+	linhaID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID do lançamento inválido"})
+	}
+
+	var req dto.ConciliarLinhaRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "dados inválidos"})
+	}
+
+	matchID, err := uuid.Parse(req.MatchID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "match_id inválido"})
+	}
+
+	linha, err := h.conciliarUC.Execute(c.Request().Context(), financial.ConciliarLinhaManualInput{
+		TenantID:  tenantID,
+		UsuarioID: userID,
+		LinhaID:   linhaID,
+		MatchTipo: req.MatchTipo,
+		MatchID:   matchID,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao conciliar lançamento", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToBankStatementLineResponse(linha))
+}
+
+// AceitarDivergencia confirma uma conciliação com diferença de valor
+// @Summary Aceitar divergência
+// @Description Confirma a correspondência (ex.: taxa diferente) e baixa o registro interno
+// @Tags Conciliação Bancária
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lançamento"
+// @Param request body dto.RevisarLinhaRequest false "Observação"
+// @Success 200 {object} dto.BankStatementLineResponse
+// @Router /api/v1/financial/conciliacao/linhas/{id}/aceitar [post]
+func (h *ConciliacaoHandler) AceitarDivergencia(c echo.Context) error {
+	return h.revisar(c, h.aceitarUC.Execute)
+}
+
+// Ignorar descarta um lançamento da fila de revisão
+// @Summary Ignorar lançamento
+// @Description Descarta lançamentos sem correspondência interna (tarifas, transferências entre contas)
+// @Tags Conciliação Bancária
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do lançamento"
+// @Param request body dto.RevisarLinhaRequest false "Observação"
+// @Success 200 {object} dto.BankStatementLineResponse
+// @Router /api/v1/financial/conciliacao/linhas/{id}/ignorar [post]
+func (h *ConciliacaoHandler) Ignorar(c echo.Context) error {
+	return h.revisar(c, h.ignorarUC.Execute)
+}
+
+func (h *ConciliacaoHandler) revisar(
+	c echo.Context,
+	execute func(ctx context.Context, input financial.RevisarLinhaInput) (*entity.BankStatementLine, error),
+) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+	userID, _ := getUserIDFromContext(c)
+
+	linhaID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID do lançamento inválido"})
+	}
+
+	var req dto.RevisarLinhaRequest
+	_ = c.Bind(&req) // corpo opcional
+
+	linha, err := execute(c.Request().Context(), financial.RevisarLinhaInput{
+		TenantID:   tenantID,
+		UsuarioID:  userID,
+		LinhaID:    linhaID,
+		Observacao: req.Observacao,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao revisar lançamento", zap.Error(err))
+		return handleConciliacaoError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToBankStatementLineResponse(linha))
+}
+
+// handleConciliacaoError mapeia erros de domínio da conciliação para respostas HTTP
+func handleConciliacaoError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrExtratoImportNotFound),
+		errors.Is(err, domain.ErrExtratoLinhaNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrExtratoLinhaJaConciliada),
+		errors.Is(err, domain.ErrExtratoLinhaNaoDivergente):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrExtratoFormatoInvalido),
+		errors.Is(err, domain.ErrExtratoVazio),
+		errors.Is(err, domain.ErrExtratoInvalido),
+		errors.Is(err, domain.ErrExtratoMatchTipoInvalido),
+		errors.Is(err, domain.ErrExtratoMatchIncompativel):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "lançamento interno não encontrado"})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "erro interno"})
+	}
+}
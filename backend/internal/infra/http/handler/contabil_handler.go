@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/financial"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// ContabilHandler agrupa os handlers da exportação contábil (plano de contas e lançamentos)
+type ContabilHandler struct {
+	listPlanoUC   *financial.ListPlanoContasUseCase
+	salvarUC      *financial.SalvarMapeamentoContabilUseCase
+	removerUC     *financial.RemoverMapeamentoContabilUseCase
+	lancamentosUC *financial.GerarLancamentosContabeisUseCase
+	logger        *zap.Logger
+}
+
+// NewContabilHandler cria um novo handler de exportação contábil
+func NewContabilHandler(
+	listPlanoUC *financial.ListPlanoContasUseCase,
+	salvarUC *financial.SalvarMapeamentoContabilUseCase,
+	removerUC *financial.RemoverMapeamentoContabilUseCase,
+	lancamentosUC *financial.GerarLancamentosContabeisUseCase,
+	logger *zap.Logger,
+) *ContabilHandler {
+	return &ContabilHandler{
+		listPlanoUC:   listPlanoUC,
+		salvarUC:      salvarUC,
+		removerUC:     removerUC,
+		lancamentosUC: lancamentosUC,
+		logger:        logger,
+	}
+}
+
+// RegisterRoutes registra as rotas da exportação contábil
+func (h *ContabilHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/contabil/plano-contas", h.GetPlanoContas, mw.RequirePermission(h.logger, valueobject.PermContabilExport))
+	g.PUT("/contabil/plano-contas", h.SalvarMapeamento, mw.RequireOwnerOrManager(h.logger))
+	g.DELETE("/contabil/plano-contas/:id", h.RemoverMapeamento, mw.RequireOwnerOrManager(h.logger))
+	g.GET("/contabil/lancamentos", h.GetLancamentos, mw.RequirePermission(h.logger, valueobject.PermContabilExport))
+}
+
+// GetPlanoContas retorna o plano de contas contábil do tenant
+// @Summary Plano de contas contábil
+// @Description Contas padrão (com personalizações) e mapeamentos de categorias e meios de pagamento
+// @Tags Contábil
+// @Produce json
+// @Success 200 {object} dto.PlanoContasResponse
+// @Router /api/v1/financial/contabil/plano-contas [get]
+func (h *ContabilHandler) GetPlanoContas(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	out, err := h.listPlanoUC.Execute(c.Request().Context(), tenantID)
+	if err != nil {
+		h.logger.Error("Erro ao listar plano de contas", zap.Error(err))
+		return handleContabilError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToPlanoContasResponse(out))
+}
+
+// SalvarMapeamento cria ou substitui o mapeamento de uma origem
+// @Summary Salvar mapeamento contábil
+// @Description Associa uma categoria, meio de pagamento ou conta padrão a uma conta do contador
+// @Tags Contábil
+// @Accept json
+// @Produce json
+// @Param request body dto.SalvarMapeamentoContabilRequest true "Mapeamento"
+// @Success 200 {object} dto.MapeamentoContabilResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/financial/contabil/plano-contas [put]
+func (h *ContabilHandler) SalvarMapeamento(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	var req dto.SalvarMapeamentoContabilRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "dados inválidos"})
+	}
+
+	m, err := h.salvarUC.Execute(c.Request().Context(), financial.SalvarMapeamentoContabilInput{
+		TenantID:    tenantID,
+		OrigemTipo:  req.OrigemTipo,
+		OrigemChave: req.OrigemChave,
+		ContaCodigo: req.ContaCodigo,
+		ContaNome:   req.ContaNome,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao salvar mapeamento contábil", zap.Error(err))
+		return handleContabilError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToMapeamentoContabilResponse(m))
+}
+
+// RemoverMapeamento remove um mapeamento (a origem volta à conta padrão)
+// @Summary Remover mapeamento contábil
+// @Tags Contábil
+// @Param id path string true "ID do mapeamento"
+// @Success 204
+// @Failure 404 {object} map[string]string
+// @Router /api/v1/financial/contabil/plano-contas/{id} [delete]
+func (h *ContabilHandler) RemoverMapeamento(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID do mapeamento inválido"})
+	}
+
+	if err := h.removerUC.Execute(c.Request().Context(), tenantID, id); err != nil {
+		h.logger.Error("Erro ao remover mapeamento contábil", zap.Error(err))
+		return handleContabilError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetLancamentos gera os lançamentos contábeis do mês
+// @Summary Lançamentos contábeis do mês
+// @Description Partidas dobradas de contas a pagar/receber, operações de caixa e compensações.
+// @Description Com format=csv gera o arquivo de importação (Data;Lote;Conta Débito;Conta Crédito;Valor;Histórico;Documento).
+// @Tags Contábil
+// @Produce json
+// @Param mes_ano query string true "Competência (YYYY-MM)"
+// @Param format query string false "Exportação: csv, xlsx ou pdf"
+// @Success 200 {object} dto.LancamentosContabeisResponse
+// @Failure 400 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Router /api/v1/financial/contabil/lancamentos [get]
+func (h *ContabilHandler) GetLancamentos(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	mesAno, err := valueobject.NewMesAno(c.QueryParam("mes_ano"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "mes_ano inválido (use YYYY-MM)"})
+	}
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	out, err := h.lancamentosUC.Execute(c.Request().Context(), financial.GerarLancamentosContabeisInput{
+		TenantID: tenantID,
+		MesAno:   mesAno,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao gerar lançamentos contábeis", zap.Error(err))
+		return handleContabilError(c, err)
+	}
+
+	if exportar {
+		return h.exportLancamentos(c, format, out)
+	}
+	return c.JSON(http.StatusOK, mapper.ToLancamentosContabeisResponse(out))
+}
+
+// exportLancamentos grava o arquivo de importação contábil. Lançamentos simples
+// (um débito e um crédito) ocupam uma linha; lançamentos múltiplos ocupam uma linha
+// por partida, ligadas pelo número do lote.
+func (h *ContabilHandler) exportLancamentos(c echo.Context, format export.Format, out *financial.GerarLancamentosContabeisOutput) error {
+	doc := export.Document{
+		Title:    "Lançamentos Contábeis",
+		Subtitle: "Competência: " + out.MesAno.String(),
+		Columns: []export.Column{
+			{Title: "Data", Kind: export.KindDate},
+			{Title: "Lote", Kind: export.KindNumber, Width: 0.6},
+			{Title: "Conta Débito", Kind: export.KindText, Width: 1.2},
+			{Title: "Conta Crédito", Kind: export.KindText, Width: 1.2},
+			{Title: "Valor", Kind: export.KindMoney},
+			{Title: "Histórico", Kind: export.KindText, Width: 4},
+			{Title: "Documento", Kind: export.KindText, Width: 1},
+		},
+	}
+
+	// Prova de fechamento para conferência sem abrir o arquivo
+	c.Response().Header().Set("X-Total-Debitos", out.TotalDebitos.StringFixed(2))
+	c.Response().Header().Set("X-Total-Creditos", out.TotalCreditos.StringFixed(2))
+
+	err := streamExport(c, format, "lancamentos_contabeis_"+out.MesAno.String(), doc, func(w export.Writer) error {
+		for _, l := range out.Lancamentos {
+			if len(l.Partidas) == 2 && l.Partidas[0].Natureza != l.Partidas[1].Natureza {
+				debito, credito := l.Partidas[0], l.Partidas[1]
+				if debito.Natureza == entity.NaturezaCredito {
+					debito, credito = credito, debito
+				}
+				if err := w.WriteRow(l.Data, l.Lote, debito.Conta.Codigo, credito.Conta.Codigo, debito.Valor, l.Historico, l.Documento); err != nil {
+					return err
+				}
+				continue
+			}
+			for _, p := range l.Partidas {
+				var debito, credito string
+				if p.Natureza == entity.NaturezaDebito {
+					debito = p.Conta.Codigo
+				} else {
+					credito = p.Conta.Codigo
+				}
+				if err := w.WriteRow(l.Data, l.Lote, debito, credito, p.Valor, l.Historico, l.Documento); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		h.logger.Error("Erro ao exportar lançamentos contábeis", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// handleContabilError mapeia erros de domínio da exportação contábil para respostas HTTP
+func handleContabilError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPlanoContasMapeamentoNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrPlanoContasOrigemInvalida),
+		errors.Is(err, domain.ErrPlanoContasChaveInvalida),
+		errors.Is(err, domain.ErrPlanoContasContaObrigatoria):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrLancamentosDesbalanceados):
+		return c.JSON(http.StatusUnprocessableEntity, map[string]string{"error": err.Error()})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "erro interno"})
+	}
+}
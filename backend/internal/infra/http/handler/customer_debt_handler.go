@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/customerdebt"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// CustomerDebtHandler agrupa os handlers do fiado (dívidas de clientes)
+type CustomerDebtHandler struct {
+	listDividasUC        *customerdebt.ListDividasClienteUseCase
+	registrarPagamentoUC *customerdebt.RegistrarPagamentoUseCase
+	getAgingUC           *customerdebt.GetAgingUseCase
+	logger               *zap.Logger
+}
+
+// NewCustomerDebtHandler cria um novo handler de fiado
+func NewCustomerDebtHandler(
+	listDividasUC *customerdebt.ListDividasClienteUseCase,
+	registrarPagamentoUC *customerdebt.RegistrarPagamentoUseCase,
+	getAgingUC *customerdebt.GetAgingUseCase,
+	logger *zap.Logger,
+) *CustomerDebtHandler {
+	return &CustomerDebtHandler{
+		listDividasUC:        listDividasUC,
+		registrarPagamentoUC: registrarPagamentoUC,
+		getAgingUC:           getAgingUC,
+		logger:               logger,
+	}
+}
+
+// RegisterRoutes registra as rotas do fiado
+func (h *CustomerDebtHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/customers/:id/debts", h.ListByCustomer, mw.RequireAdminAccess(h.logger))
+	g.POST("/customer-debts/:id/payments", h.RegistrarPagamento, mw.RequirePermission(h.logger, valueobject.PermCommandClose))
+	g.GET("/customer-debts/aging", h.GetAging, mw.RequireOwnerOrManager(h.logger))
+}
+
+// ListByCustomer lista as dívidas de um cliente
+// @Summary Listar fiado do cliente
+// @Description Lista dívidas do cliente geradas por comandas fechadas com saldo devedor
+// @Tags Fiado
+// @Produce json
+// @Param id path string true "ID do cliente"
+// @Param abertas query bool false "Somente dívidas em aberto (padrão true)"
+// @Success 200 {object} dto.CustomerDebtListResponse
+// @Failure 400 {object} map[string]string
+// @Router /api/v1/customers/{id}/debts [get]
+func (h *CustomerDebtHandler) ListByCustomer(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	customerID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID do cliente inválido"})
+	}
+
+	somenteAbertas := c.QueryParam("abertas") != "false"
+
+	debts, err := h.listDividasUC.Execute(c.Request().Context(), tenantID, customerID, somenteAbertas)
+	if err != nil {
+		h.logger.Error("Erro ao listar fiado do cliente", zap.Error(err))
+		return handleCustomerDebtError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToCustomerDebtListResponse(customerID.String(), debts))
+}
+
+// RegistrarPagamento registra pagamento parcial ou total de uma dívida
+// @Summary Pagar fiado
+// @Description Registra pagamento de dívida; o valor entra no caixa e gera conta a receber
+// @Tags Fiado
+// @Accept json
+// @Produce json
+// @Param id path string true "ID da dívida"
+// @Param request body dto.RegistrarPagamentoDividaRequest true "Dados do pagamento"
+// @Success 201 {object} dto.CustomerDebtResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string "Dívida já quitada"
+// @Router /api/v1/customer-debts/{id}/payments [post]
+func (h *CustomerDebtHandler) RegistrarPagamento(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	userID, err := getUserIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "usuário não identificado"})
+	}
+
+	debtID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "ID da dívida inválido"})
+	}
+
+	var req dto.RegistrarPagamentoDividaRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "dados inválidos"})
+	}
+
+	meioPagamentoID, err := uuid.Parse(req.MeioPagamentoID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "meio_pagamento_id inválido"})
+	}
+
+	valor, err := decimal.NewFromString(req.Valor)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "valor inválido"})
+	}
+
+	var commandID *uuid.UUID
+	if req.CommandID != nil && *req.CommandID != "" {
+		id, err := uuid.Parse(*req.CommandID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"error": "command_id inválido"})
+		}
+		commandID = &id
+	}
+
+	debt, err := h.registrarPagamentoUC.Execute(c.Request().Context(), customerdebt.RegistrarPagamentoInput{
+		TenantID:        tenantID,
+		UserID:          userID,
+		DebtID:          debtID,
+		MeioPagamentoID: meioPagamentoID,
+		Valor:           valor,
+		CommandID:       commandID,
+		Observacoes:     req.Observacoes,
+	})
+	if err != nil {
+		h.logger.Error("Erro ao registrar pagamento de fiado", zap.Error(err))
+		return handleCustomerDebtError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, mapper.ToCustomerDebtResponse(debt))
+}
+
+// GetAging retorna o relatório de fiado por cliente
+// @Summary Relatório de fiado
+// @Description Lista clientes com saldo devedor, ordenados por saldo e idade da dívida mais antiga
+// @Tags Fiado
+// @Produce json
+// @Success 200 {object} dto.CustomerDebtAgingReportResponse
+// @Router /api/v1/customer-debts/aging [get]
+func (h *CustomerDebtHandler) GetAging(c echo.Context) error {
+	tenantID, err := getTenantIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusUnauthorized, map[string]string{"error": "tenant não identificado"})
+	}
+
+	items, err := h.getAgingUC.Execute(c.Request().Context(), tenantID)
+	if err != nil {
+		h.logger.Error("Erro ao gerar relatório de fiado", zap.Error(err))
+		return handleCustomerDebtError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, mapper.ToCustomerDebtAgingReportResponse(items))
+}
+
+// handleCustomerDebtError mapeia erros de domínio do fiado para respostas HTTP
+func handleCustomerDebtError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrCustomerDebtNotFound):
+		return c.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCustomerDebtJaQuitada):
+		return c.JSON(http.StatusConflict, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCustomerDebtValorInvalido),
+		errors.Is(err, domain.ErrCustomerDebtValorExcedente),
+		errors.Is(err, domain.ErrMeioPagamentoNotFound):
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case errors.Is(err, domain.ErrCaixaNaoAberto):
+		return c.JSON(http.StatusConflict, map[string]string{"error": "nenhum caixa aberto. Abra o caixa antes de receber pagamentos"})
+	default:
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "erro interno"})
+	}
+}
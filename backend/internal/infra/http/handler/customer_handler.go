@@ -9,6 +9,7 @@ import (
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
@@ -442,7 +443,7 @@ func (h *CustomerHandler) InactivateCustomer(c echo.Context) error {
 		})
 	}
 
-	err := h.inactivateUC.Execute(ctx, tenantID, customerID)
+	err := h.inactivateUC.Execute(ctx, tenantID, customerID, middleware.GetUserID(c))
 	if err != nil {
 		h.logger.Error("Erro ao inativar cliente", zap.Error(err))
 
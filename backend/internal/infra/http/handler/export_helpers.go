@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
+	"github.com/labstack/echo/v4"
+)
+
+// exportMaxRows limita a quantidade de linhas exportadas em listagens paginadas
+const exportMaxRows = 10000
+
+// exportFormatFromQuery lê ?format= (csv, xlsx, pdf). Vazio ou "json" mantém a resposta JSON.
+func exportFormatFromQuery(c echo.Context) (export.Format, bool, error) {
+	raw := strings.TrimSpace(c.QueryParam("format"))
+	if raw == "" || strings.EqualFold(raw, "json") {
+		return "", false, nil
+	}
+	format, err := export.ParseFormat(raw)
+	if err != nil {
+		return "", false, err
+	}
+	return format, true, nil
+}
+
+// badExportFormat responde 400 para formato de exportação inválido
+func badExportFormat(c echo.Context, err error) error {
+	return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+		Error:   "bad_request",
+		Message: err.Error(),
+	})
+}
+
+// streamExport envia o relatório como anexo, gravando as linhas direto na resposta
+func streamExport(c echo.Context, format export.Format, baseName string, doc export.Document, fill func(w export.Writer) error) error {
+	filename := format.Filename(fmt.Sprintf("%s_%s", baseName, time.Now().Format("20060102")))
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, format.ContentType())
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, filename))
+	res.WriteHeader(http.StatusOK)
+
+	w, err := export.NewWriter(format, res, doc)
+	if err != nil {
+		return err
+	}
+	if err := fill(w); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// exportPeriodo descreve o período filtrado no subtítulo do relatório
+func exportPeriodo(inicio, fim *time.Time) string {
+	switch {
+	case inicio != nil && fim != nil:
+		return fmt.Sprintf("Período: %s a %s", export.FormatDate(*inicio), export.FormatDate(*fim))
+	case inicio != nil:
+		return "A partir de " + export.FormatDate(*inicio)
+	case fim != nil:
+		return "Até " + export.FormatDate(*fim)
+	}
+	return ""
+}
@@ -0,0 +1,219 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/export"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// Exportação de relatórios financeiros (?format=csv|xlsx|pdf)
+// =============================================================================
+
+func (h *FinancialHandler) exportContasPagar(c echo.Context, format export.Format, contas []*entity.ContaPagar, inicio, fim *time.Time) error {
+	doc := export.Document{
+		Title:    "Contas a Pagar",
+		Subtitle: exportPeriodo(inicio, fim),
+		Columns: []export.Column{
+			{Title: "Vencimento", Kind: export.KindDate},
+			{Title: "Descrição", Kind: export.KindText, Width: 3.5},
+			{Title: "Fornecedor", Kind: export.KindText, Width: 2.5},
+			{Title: "Tipo", Kind: export.KindText, Width: 1.1},
+			{Title: "Status", Kind: export.KindText, Width: 1.2},
+			{Title: "Valor", Kind: export.KindMoney},
+			{Title: "Pagamento", Kind: export.KindDate},
+		},
+	}
+
+	return h.streamExport(c, format, "contas_a_pagar", doc, func(w export.Writer) error {
+		for _, conta := range contas {
+			if err := w.WriteRow(
+				conta.DataVencimento,
+				conta.Descricao,
+				conta.Fornecedor,
+				string(conta.Tipo),
+				string(conta.Status),
+				conta.Valor.Value(),
+				conta.DataPagamento,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h *FinancialHandler) exportContasReceber(c echo.Context, format export.Format, contas []*entity.ContaReceber, inicio, fim *time.Time) error {
+	doc := export.Document{
+		Title:    "Contas a Receber",
+		Subtitle: exportPeriodo(inicio, fim),
+		Columns: []export.Column{
+			{Title: "Vencimento", Kind: export.KindDate},
+			{Title: "Origem", Kind: export.KindText, Width: 1.3},
+			{Title: "Descrição", Kind: export.KindText, Width: 3.5},
+			{Title: "Status", Kind: export.KindText, Width: 1.2},
+			{Title: "Valor", Kind: export.KindMoney},
+			{Title: "Valor Pago", Kind: export.KindMoney},
+			{Title: "Em Aberto", Kind: export.KindMoney},
+			{Title: "Recebimento", Kind: export.KindDate},
+		},
+	}
+
+	return h.streamExport(c, format, "contas_a_receber", doc, func(w export.Writer) error {
+		for _, conta := range contas {
+			if err := w.WriteRow(
+				conta.DataVencimento,
+				conta.Origem,
+				conta.DescricaoOrigem,
+				string(conta.Status),
+				conta.Valor.Value(),
+				conta.ValorPago.Value(),
+				conta.ValorAberto.Value(),
+				conta.DataRecebimento,
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h *FinancialHandler) exportFluxoCaixa(c echo.Context, format export.Format, fluxos []*entity.FluxoCaixaDiario, inicio, fim time.Time) error {
+	var inicioPtr, fimPtr *time.Time
+	if !inicio.IsZero() {
+		inicioPtr = &inicio
+	}
+	if !fim.IsZero() {
+		fimPtr = &fim
+	}
+
+	doc := export.Document{
+		Title:    "Fluxo de Caixa Diário",
+		Subtitle: exportPeriodo(inicioPtr, fimPtr),
+		Columns: []export.Column{
+			{Title: "Data", Kind: export.KindDate},
+			{Title: "Saldo Inicial", Kind: export.KindMoney},
+			{Title: "Entradas Confirmadas", Kind: export.KindMoney},
+			{Title: "Entradas Previstas", Kind: export.KindMoney},
+			{Title: "Saídas Pagas", Kind: export.KindMoney},
+			{Title: "Saídas Previstas", Kind: export.KindMoney},
+			{Title: "Saldo Final", Kind: export.KindMoney},
+		},
+	}
+
+	return h.streamExport(c, format, "fluxo_de_caixa", doc, func(w export.Writer) error {
+		for _, f := range fluxos {
+			if err := w.WriteRow(
+				f.Data,
+				f.SaldoInicial.Value(),
+				f.EntradasConfirmadas.Value(),
+				f.EntradasPrevistas.Value(),
+				f.SaidasPagas.Value(),
+				f.SaidasPrevistas.Value(),
+				f.SaldoFinal.Value(),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (h *FinancialHandler) exportDREs(c echo.Context, format export.Format, dres []*entity.DREMensal) error {
+	doc := export.Document{
+		Title: "DRE Mensal",
+		Columns: []export.Column{
+			{Title: "Mês", Kind: export.KindText, Width: 0.9},
+			{Title: "Receita Serviços", Kind: export.KindMoney},
+			{Title: "Receita Produtos", Kind: export.KindMoney},
+			{Title: "Receita Planos", Kind: export.KindMoney},
+			{Title: "Receita Total", Kind: export.KindMoney},
+			{Title: "Custos Variáveis", Kind: export.KindMoney},
+			{Title: "Despesas", Kind: export.KindMoney},
+			{Title: "Resultado Bruto", Kind: export.KindMoney},
+			{Title: "Margem Bruta", Kind: export.KindPercent, Width: 1.1},
+			{Title: "Resultado Operacional", Kind: export.KindMoney},
+			{Title: "Margem Operacional", Kind: export.KindPercent, Width: 1.1},
+			{Title: "Lucro Líquido", Kind: export.KindMoney},
+		},
+	}
+
+	return h.streamExport(c, format, "dre", doc, func(w export.Writer) error {
+		for _, d := range dres {
+			if err := w.WriteRow(
+				d.MesAno.String(),
+				d.ReceitaServicos.Value(),
+				d.ReceitaProdutos.Value(),
+				d.ReceitaPlanos.Value(),
+				d.ReceitaTotal.Value(),
+				d.CustoVariavelTotal.Value(),
+				d.DespesaTotal.Value(),
+				d.ResultadoBruto.Value(),
+				d.MargemBruta.Value(),
+				d.ResultadoOperacional.Value(),
+				d.MargemOperacional.Value(),
+				d.LucroLiquido.Value(),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// exportDRE exporta um DRE no formato de demonstrativo (linha, valor)
+func (h *FinancialHandler) exportDRE(c echo.Context, format export.Format, dre *entity.DREMensal) error {
+	doc := export.Document{
+		Title:    "Demonstrativo de Resultado (DRE)",
+		Subtitle: "Competência: " + dre.MesAno.String(),
+		Columns: []export.Column{
+			{Title: "Conta", Kind: export.KindText, Width: 4},
+			{Title: "Valor", Kind: export.KindMoney, Width: 1.5},
+			{Title: "% Receita", Kind: export.KindPercent, Width: 1},
+		},
+	}
+
+	receita := dre.ReceitaTotal.Value()
+	demonstrativo := []struct {
+		conta string
+		valor decimal.Decimal
+	}{
+		{"(+) Receita de Serviços", dre.ReceitaServicos.Value()},
+		{"(+) Receita de Produtos", dre.ReceitaProdutos.Value()},
+		{"(+) Receita de Planos", dre.ReceitaPlanos.Value()},
+		{"(=) Receita Total", receita},
+		{"(-) Comissões", dre.CustoComissoes.Value()},
+		{"(-) Insumos", dre.CustoInsumos.Value()},
+		{"(=) Resultado Bruto", dre.ResultadoBruto.Value()},
+		{"(-) Despesas Fixas", dre.DespesaFixa.Value()},
+		{"(-) Despesas Variáveis", dre.DespesaVariavel.Value()},
+		{"(=) Resultado Operacional", dre.ResultadoOperacional.Value()},
+		{"(=) Lucro Líquido", dre.LucroLiquido.Value()},
+	}
+
+	return h.streamExport(c, format, "dre_"+dre.MesAno.String(), doc, func(w export.Writer) error {
+		for _, l := range demonstrativo {
+			var percentual any
+			if !receita.IsZero() {
+				percentual = l.valor.Div(receita).Mul(decimal.NewFromInt(100))
+			}
+			if err := w.WriteRow(l.conta, l.valor, percentual); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// streamExport envia o relatório e registra falhas de escrita (a resposta já pode ter sido iniciada)
+func (h *FinancialHandler) streamExport(c echo.Context, format export.Format, baseName string, doc export.Document, fill func(w export.Writer) error) error {
+	if err := streamExport(c, format, baseName, doc, fill); err != nil {
+		h.logger.Error("Erro ao exportar relatório financeiro", zap.String("relatorio", baseName), zap.Error(err))
+		return err
+	}
+	return nil
+}
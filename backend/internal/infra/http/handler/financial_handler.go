@@ -498,6 +498,7 @@ func (h *FinancialHandler) GetContaPagar(c echo.Context) error {
 // @Param status query string false "Filtrar por status"
 // @Param data_inicio query string false "Data início (YYYY-MM-DD)"
 // @Param data_fim query string false "Data fim (YYYY-MM-DD)"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {array} dto.ContaPagarResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -515,6 +516,11 @@ func (h *FinancialHandler) ListContasPagar(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	// Bind query params
 	var req dto.ListContasPagarRequest
 	if err := c.Bind(&req); err != nil {
@@ -531,6 +537,9 @@ func (h *FinancialHandler) ListContasPagar(c echo.Context) error {
 	if req.PageSize < 1 || req.PageSize > 100 {
 		req.PageSize = 20
 	}
+	if exportar {
+		req.Page, req.PageSize = 1, exportMaxRows
+	}
 
 	// Parse datas
 	var dataInicioPtr, dataFimPtr *time.Time
@@ -599,6 +608,10 @@ func (h *FinancialHandler) ListContasPagar(c echo.Context) error {
 		})
 	}
 
+	if exportar {
+		return h.exportContasPagar(c, format, contas, dataInicioPtr, dataFimPtr)
+	}
+
 	// Converter para response
 	responses := make([]dto.ContaPagarResponse, len(contas))
 	for i, conta := range contas {
@@ -783,6 +796,7 @@ func (h *FinancialHandler) GetContaReceber(c echo.Context) error {
 // @Param status query string false "Filtrar por status"
 // @Param data_inicio query string false "Data início (YYYY-MM-DD)"
 // @Param data_fim query string false "Data fim (YYYY-MM-DD)"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {array} dto.ContaReceberResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -799,6 +813,11 @@ func (h *FinancialHandler) ListContasReceber(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	var req dto.ListContasReceberRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -813,6 +832,9 @@ func (h *FinancialHandler) ListContasReceber(c echo.Context) error {
 	if req.PageSize < 1 || req.PageSize > 100 {
 		req.PageSize = 20
 	}
+	if exportar {
+		req.Page, req.PageSize = 1, exportMaxRows
+	}
 
 	var dataInicioPtr, dataFimPtr *time.Time
 	if req.DataInicio != nil {
@@ -871,6 +893,10 @@ func (h *FinancialHandler) ListContasReceber(c echo.Context) error {
 		})
 	}
 
+	if exportar {
+		return h.exportContasReceber(c, format, contas, dataInicioPtr, dataFimPtr)
+	}
+
 	responses := make([]dto.ContaReceberResponse, len(contas))
 	for i, conta := range contas {
 		responses[i] = mapper.ToContaReceberResponse(conta)
@@ -1186,6 +1212,7 @@ func (h *FinancialHandler) GetFluxoCaixa(c echo.Context) error {
 // @Produce json
 // @Param data_inicio query string false "Data início"
 // @Param data_fim query string false "Data fim"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {array} dto.FluxoCaixaDiarioResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -1202,6 +1229,11 @@ func (h *FinancialHandler) ListFluxoCaixa(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	var req dto.ListFluxoCaixaRequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -1233,6 +1265,10 @@ func (h *FinancialHandler) ListFluxoCaixa(c echo.Context) error {
 		})
 	}
 
+	if exportar {
+		return h.exportFluxoCaixa(c, format, fluxos, dataInicio, dataFim)
+	}
+
 	responses := make([]dto.FluxoCaixaDiarioResponse, len(fluxos))
 	for i, fluxo := range fluxos {
 		responses[i] = mapper.ToFluxoCaixaDiarioResponse(fluxo)
@@ -1246,6 +1282,7 @@ func (h *FinancialHandler) ListFluxoCaixa(c echo.Context) error {
 // @Tags Financial
 // @Produce json
 // @Param month path string true "Mês (YYYY-MM)"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {object} dto.DREMensalResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -1262,6 +1299,11 @@ func (h *FinancialHandler) GetDRE(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	id := c.Param("month")
 	if id == "" {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -1279,6 +1321,10 @@ func (h *FinancialHandler) GetDRE(c echo.Context) error {
 		})
 	}
 
+	if exportar {
+		return h.exportDRE(c, format, dre)
+	}
+
 	response := mapper.ToDREMensalResponse(dre)
 	return c.JSON(http.StatusOK, response)
 }
@@ -1289,6 +1335,7 @@ func (h *FinancialHandler) GetDRE(c echo.Context) error {
 // @Produce json
 // @Param mes_ano_inicio query string false "Mês/Ano início (YYYY-MM)"
 // @Param mes_ano_fim query string false "Mês/Ano fim (YYYY-MM)"
+// @Param format query string false "Exportar como arquivo: csv, xlsx ou pdf (padrão: JSON)"
 // @Success 200 {array} dto.DREMensalResponse
 // @Failure 400 {object} dto.ErrorResponse
 // @Failure 500 {object} dto.ErrorResponse
@@ -1305,6 +1352,11 @@ func (h *FinancialHandler) ListDRE(c echo.Context) error {
 		})
 	}
 
+	format, exportar, err := exportFormatFromQuery(c)
+	if err != nil {
+		return badExportFormat(c, err)
+	}
+
 	var req dto.ListDRERequest
 	if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
@@ -1335,6 +1387,10 @@ func (h *FinancialHandler) ListDRE(c echo.Context) error {
 		})
 	}
 
+	if exportar {
+		return h.exportDREs(c, format, dres)
+	}
+
 	responses := make([]dto.DREMensalResponse, len(dres))
 	for i, dre := range dres {
 		responses[i] = mapper.ToDREMensalResponse(dre)
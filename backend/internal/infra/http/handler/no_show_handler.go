@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/noshow"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+)
+
+// NoShowHandler agrupa os handlers da política de não comparecimento, do
+// índice de confiabilidade do cliente e do sinal dos agendamentos.
+type NoShowHandler struct {
+	getPolicyUC      *noshow.GetPolicyUseCase
+	updatePolicyUC   *noshow.UpdatePolicyUseCase
+	reliabilityUC    *noshow.GetReliabilityUseCase
+	requireDepositUC *noshow.RequireDepositUseCase
+	getDepositUC     *noshow.GetDepositUseCase
+	logger           *zap.Logger
+}
+
+// NewNoShowHandler cria um novo handler da política de não comparecimento
+func NewNoShowHandler(
+	getPolicyUC *noshow.GetPolicyUseCase,
+	updatePolicyUC *noshow.UpdatePolicyUseCase,
+	reliabilityUC *noshow.GetReliabilityUseCase,
+	requireDepositUC *noshow.RequireDepositUseCase,
+	getDepositUC *noshow.GetDepositUseCase,
+	logger *zap.Logger,
+) *NoShowHandler {
+	return &NoShowHandler{
+		getPolicyUC:      getPolicyUC,
+		updatePolicyUC:   updatePolicyUC,
+		reliabilityUC:    reliabilityUC,
+		requireDepositUC: requireDepositUC,
+		getDepositUC:     getDepositUC,
+		logger:           logger,
+	}
+}
+
+// GetPolicy godoc
+// @Summary Consultar política de não comparecimento
+// @Description Sem configuração, retorna a política padrão (desativada)
+// @Tags Não Comparecimento
+// @Produce json
+// @Success 200 {object} dto.NoShowPolicyResponse
+// @Router /api/v1/no-show-policy [get]
+// @Security BearerAuth
+func (h *NoShowHandler) GetPolicy(c echo.Context) error {
+	policy, err := h.getPolicyUC.Execute(c.Request().Context(), middleware.GetTenantID(c))
+	if err != nil {
+		return h.handleNoShowError(c, err, "Erro ao buscar política de não comparecimento")
+	}
+
+	return c.JSON(http.StatusOK, mapper.NoShowPolicyToResponse(policy))
+}
+
+// UpdatePolicy godoc
+// @Summary Configurar política de não comparecimento
+// @Description Clientes com max_no_shows faltas em window_days dias pagam sinal via PIX ao agendar
+// @Tags Não Comparecimento
+// @Accept json
+// @Produce json
+// @Param request body dto.UpdateNoShowPolicyRequest true "Política"
+// @Success 200 {object} dto.NoShowPolicyResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /api/v1/no-show-policy [put]
+// @Security BearerAuth
+func (h *NoShowHandler) UpdatePolicy(c echo.Context) error {
+	var req dto.UpdateNoShowPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+	depositValue, err := decimal.NewFromString(req.DepositValue)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "deposit_value inválido",
+		})
+	}
+
+	policy, err := h.updatePolicyUC.Execute(c.Request().Context(), noshow.UpdatePolicyInput{
+		TenantID:             middleware.GetTenantID(c),
+		Enabled:              req.Enabled,
+		MaxNoShows:           req.MaxNoShows,
+		WindowDays:           req.WindowDays,
+		DepositType:          req.DepositType,
+		DepositValue:         depositValue,
+		PaymentDeadlineHours: req.PaymentDeadlineHours,
+		MeioPagamentoID:      req.MeioPagamentoID,
+	})
+	if err != nil {
+		return h.handleNoShowError(c, err, "Erro ao atualizar política de não comparecimento")
+	}
+
+	return c.JSON(http.StatusOK, mapper.NoShowPolicyToResponse(policy))
+}
+
+// GetCustomerReliability godoc
+// @Summary Índice de confiabilidade do cliente
+// @Description Comparecimentos e faltas na janela da política e se um novo agendamento exige sinal
+// @Tags Não Comparecimento
+// @Produce json
+// @Param id path string true "ID do cliente"
+// @Success 200 {object} dto.CustomerReliabilityResponse
+// @Router /api/v1/customers/{id}/reliability [get]
+// @Security BearerAuth
+func (h *NoShowHandler) GetCustomerReliability(c echo.Context) error {
+	out, err := h.reliabilityUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleNoShowError(c, err, "Erro ao calcular confiabilidade do cliente")
+	}
+
+	return c.JSON(http.StatusOK, mapper.CustomerReliabilityToResponse(out))
+}
+
+// GetDeposit godoc
+// @Summary Consultar sinal do agendamento
+// @Tags Não Comparecimento
+// @Produce json
+// @Param id path string true "ID do agendamento"
+// @Success 200 {object} dto.AppointmentDepositResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/appointments/{id}/deposit [get]
+// @Security BearerAuth
+func (h *NoShowHandler) GetDeposit(c echo.Context) error {
+	deposit, err := h.getDepositUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleNoShowError(c, err, "Erro ao buscar sinal do agendamento")
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentDepositToResponse(deposit))
+}
+
+// RequireDeposit godoc
+// @Summary Exigir sinal do agendamento
+// @Description Aplica a política ao agendamento e gera a cobrança PIX; refaz a cobrança se a geração anterior falhou
+// @Tags Não Comparecimento
+// @Produce json
+// @Param id path string true "ID do agendamento"
+// @Success 200 {object} dto.AppointmentDepositResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Failure 422 {object} dto.ErrorResponse
+// @Router /api/v1/appointments/{id}/deposit [post]
+// @Security BearerAuth
+func (h *NoShowHandler) RequireDeposit(c echo.Context) error {
+	deposit, err := h.requireDepositUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c), c.Param("id"))
+	if err != nil {
+		return h.handleNoShowError(c, err, "Erro ao exigir sinal do agendamento")
+	}
+
+	return c.JSON(http.StatusOK, mapper.AppointmentDepositToResponse(deposit))
+}
+
+// handleNoShowError mapeia erros da política de não comparecimento
+func (h *NoShowHandler) handleNoShowError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrAppointmentDepositNotFound),
+		errors.Is(err, domain.ErrAppointmentNotFound),
+		errors.Is(err, domain.ErrAppointmentCustomerNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentInvalidStatusTransition):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentDepositNotNeeded):
+		return c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{Error: "deposit_not_needed", Message: err.Error()})
+	case errors.Is(err, domain.ErrNoShowPolicyInvalid),
+		errors.Is(err, domain.ErrAppointmentCustomerRequired),
+		errors.Is(err, domain.ErrInvalidID),
+		errors.Is(err, domain.ErrTenantIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
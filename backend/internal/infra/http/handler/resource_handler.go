@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/resource"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// ResourceHandler agrupa os handlers dos recursos da unidade e dos recursos
+// exigidos pelos serviços.
+type ResourceHandler struct {
+	createUC             *resource.CreateResourceUseCase
+	listUC               *resource.ListResourcesUseCase
+	updateUC             *resource.UpdateResourceUseCase
+	getServiceResourceUC *resource.GetServiceResourcesUseCase
+	setServiceResourceUC *resource.SetServiceResourcesUseCase
+	logger               *zap.Logger
+}
+
+// NewResourceHandler cria um novo handler de recursos
+func NewResourceHandler(
+	createUC *resource.CreateResourceUseCase,
+	listUC *resource.ListResourcesUseCase,
+	updateUC *resource.UpdateResourceUseCase,
+	getServiceResourceUC *resource.GetServiceResourcesUseCase,
+	setServiceResourceUC *resource.SetServiceResourcesUseCase,
+	logger *zap.Logger,
+) *ResourceHandler {
+	return &ResourceHandler{
+		createUC:             createUC,
+		listUC:               listUC,
+		updateUC:             updateUC,
+		getServiceResourceUC: getServiceResourceUC,
+		setServiceResourceUC: setServiceResourceUC,
+		logger:               logger,
+	}
+}
+
+// CreateResource godoc
+// @Summary Cadastrar recurso da unidade
+// @Description Cadeira, sala ou lavatório; capacity é quantos atendimentos o recurso comporta ao mesmo tempo
+// @Tags Recursos
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateResourceRequest true "Recurso"
+// @Success 201 {object} dto.ResourceResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Router /api/v1/resources [post]
+// @Security BearerAuth
+func (h *ResourceHandler) CreateResource(c echo.Context) error {
+	var req dto.CreateResourceRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	r, err := h.createUC.Execute(c.Request().Context(), resource.CreateResourceInput{
+		TenantID: middleware.GetTenantID(c),
+		UnitID:   middleware.GetUnitID(c),
+		Nome:     req.Nome,
+		Capacity: req.Capacity,
+	})
+	if err != nil {
+		return h.handleResourceError(c, err, "Erro ao cadastrar recurso")
+	}
+
+	return c.JSON(http.StatusCreated, mapper.ResourceToResponse(r))
+}
+
+// ListResources godoc
+// @Summary Listar recursos da unidade
+// @Tags Recursos
+// @Produce json
+// @Param include_inactive query bool false "Incluir recursos desativados"
+// @Success 200 {array} dto.ResourceResponse
+// @Router /api/v1/resources [get]
+// @Security BearerAuth
+func (h *ResourceHandler) ListResources(c echo.Context) error {
+	includeInactive := c.QueryParam("include_inactive") == "true"
+	resources, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c), includeInactive)
+	if err != nil {
+		return h.handleResourceError(c, err, "Erro ao listar recursos")
+	}
+
+	return c.JSON(http.StatusOK, mapper.ResourcesToResponse(resources))
+}
+
+// UpdateResource godoc
+// @Summary Alterar recurso da unidade
+// @Description Recurso desativado deixa de limitar a agenda
+// @Tags Recursos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do recurso"
+// @Param request body dto.UpdateResourceRequest true "Recurso"
+// @Success 200 {object} dto.ResourceResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/resources/{id} [put]
+// @Security BearerAuth
+func (h *ResourceHandler) UpdateResource(c echo.Context) error {
+	var req dto.UpdateResourceRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	r, err := h.updateUC.Execute(c.Request().Context(), resource.UpdateResourceInput{
+		TenantID: middleware.GetTenantID(c),
+		ID:       c.Param("id"),
+		Nome:     req.Nome,
+		Capacity: req.Capacity,
+		Ativo:    req.Ativo,
+	})
+	if err != nil {
+		return h.handleResourceError(c, err, "Erro ao atualizar recurso")
+	}
+
+	return c.JSON(http.StatusOK, mapper.ResourceToResponse(r))
+}
+
+// GetServiceResources godoc
+// @Summary Recursos exigidos pelo serviço
+// @Tags Recursos
+// @Produce json
+// @Param id path string true "ID do serviço"
+// @Success 200 {array} dto.ServiceResourceResponse
+// @Router /api/v1/servicos/{id}/resources [get]
+// @Security BearerAuth
+func (h *ResourceHandler) GetServiceResources(c echo.Context) error {
+	reqs, err := h.getServiceResourceUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleResourceError(c, err, "Erro ao buscar recursos do serviço")
+	}
+
+	return c.JSON(http.StatusOK, mapper.ServiceResourcesToResponse(reqs))
+}
+
+// SetServiceResources godoc
+// @Summary Definir recursos exigidos pelo serviço
+// @Description Substitui a lista; vale para os próximos agendamentos
+// @Tags Recursos
+// @Accept json
+// @Produce json
+// @Param id path string true "ID do serviço"
+// @Param request body dto.SetServiceResourcesRequest true "Recursos"
+// @Success 200 {array} dto.ServiceResourceResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/servicos/{id}/resources [put]
+// @Security BearerAuth
+func (h *ResourceHandler) SetServiceResources(c echo.Context) error {
+	var req dto.SetServiceResourcesRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	reqs, err := h.setServiceResourceUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"), mapper.ServiceResourcesFromRequest(req))
+	if err != nil {
+		return h.handleResourceError(c, err, "Erro ao definir recursos do serviço")
+	}
+
+	return c.JSON(http.StatusOK, mapper.ServiceResourcesToResponse(reqs))
+}
+
+// handleResourceError mapeia erros dos recursos
+func (h *ResourceHandler) handleResourceError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrResourceNotFound),
+		errors.Is(err, domain.ErrAppointmentServiceNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrResourceNameRequired),
+		errors.Is(err, domain.ErrResourceCapacityInvalid),
+		errors.Is(err, domain.ErrServiceResourceInvalid),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrUnitIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
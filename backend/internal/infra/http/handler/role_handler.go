@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	authUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/auth"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// ROLE HANDLER
+// Catálogo de permissões e papéis personalizados do tenant (somente dono,
+// via permissão roles.manage)
+// =============================================================================
+
+type RoleHandler struct {
+	listUC    *authUC.ListTenantRolesUseCase
+	createUC  *authUC.CreateTenantRoleUseCase
+	updateUC  *authUC.UpdateTenantRoleUseCase
+	deleteUC  *authUC.DeleteTenantRoleUseCase
+	assignUC  *authUC.AssignTenantRoleUseCase
+	validator *validator.Validate
+	logger    *zap.Logger
+}
+
+func NewRoleHandler(
+	listUC *authUC.ListTenantRolesUseCase,
+	createUC *authUC.CreateTenantRoleUseCase,
+	updateUC *authUC.UpdateTenantRoleUseCase,
+	deleteUC *authUC.DeleteTenantRoleUseCase,
+	assignUC *authUC.AssignTenantRoleUseCase,
+	logger *zap.Logger,
+) *RoleHandler {
+	return &RoleHandler{
+		listUC:    listUC,
+		createUC:  createUC,
+		updateUC:  updateUC,
+		deleteUC:  deleteUC,
+		assignUC:  assignUC,
+		validator: validator.New(),
+		logger:    logger,
+	}
+}
+
+// Catalog - GET /roles/permissions
+// Lista as permissões disponíveis e o modelo de cada papel fixo.
+func (h *RoleHandler) Catalog(c echo.Context) error {
+	return c.JSON(http.StatusOK, authUC.PermissionCatalog())
+}
+
+// List - GET /roles
+func (h *RoleHandler) List(c echo.Context) error {
+	roles, err := h.listUC.Execute(c.Request().Context(), mw.GetTenantID(c))
+	if err != nil {
+		return h.handleRoleError(c, err, "Erro ao listar papéis")
+	}
+
+	return c.JSON(http.StatusOK, roles)
+}
+
+// Create - POST /roles
+func (h *RoleHandler) Create(c echo.Context) error {
+	req, ok := h.bindRole(c)
+	if !ok {
+		return nil
+	}
+
+	role, err := h.createUC.Execute(c.Request().Context(), mw.GetTenantID(c), req)
+	if err != nil {
+		return h.handleRoleError(c, err, "Erro ao criar papel")
+	}
+
+	return c.JSON(http.StatusCreated, role)
+}
+
+// Update - PUT /roles/:id
+// As novas permissões valem a partir do próximo token de cada usuário.
+func (h *RoleHandler) Update(c echo.Context) error {
+	req, ok := h.bindRole(c)
+	if !ok {
+		return nil
+	}
+
+	role, err := h.updateUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id"), req)
+	if err != nil {
+		return h.handleRoleError(c, err, "Erro ao atualizar papel")
+	}
+
+	return c.JSON(http.StatusOK, role)
+}
+
+// Delete - DELETE /roles/:id
+func (h *RoleHandler) Delete(c echo.Context) error {
+	if err := h.deleteUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id")); err != nil {
+		return h.handleRoleError(c, err, "Erro ao excluir papel")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// Assign - PUT /roles/assignments
+// Define o papel personalizado de um usuário em uma unidade.
+func (h *RoleHandler) Assign(c echo.Context) error {
+	var req dto.AssignTenantRoleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "user_id e unit_id são obrigatórios; custom_role_id deve ser um UUID",
+		})
+	}
+
+	if err := h.assignUC.Execute(c.Request().Context(), mw.GetTenantID(c), req); err != nil {
+		return h.handleRoleError(c, err, "Erro ao atribuir papel")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *RoleHandler) bindRole(c echo.Context) (dto.TenantRoleRequest, bool) {
+	var req dto.TenantRoleRequest
+	if err := c.Bind(&req); err != nil {
+		_ = c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+		return req, false
+	}
+	if err := h.validator.Struct(req); err != nil {
+		_ = c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "nome, base_role e permissoes são obrigatórios",
+		})
+		return req, false
+	}
+	return req, true
+}
+
+// handleRoleError mapeia erros dos papéis personalizados
+func (h *RoleHandler) handleRoleError(c echo.Context, err error, msg string) error {
+	switch err {
+	case domain.ErrPapelNaoEncontrado, domain.ErrVinculoUnidadeNaoExiste:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrPapelNomeDuplicado, domain.ErrPapelEmUso:
+		return c.JSON(http.StatusConflict, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrPapelPermissaoInvalida, domain.ErrInvalidTenantID, domain.ErrInvalidID, domain.ErrInvalidUnitID:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
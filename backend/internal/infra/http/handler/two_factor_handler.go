@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	authUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/auth"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// TWO FACTOR HANDLER
+// Segundo passo do login (TOTP/código de recuperação), cadastro do aplicativo
+// autenticador e política do tenant que exige 2FA para proprietários e gerentes
+// =============================================================================
+
+type TwoFactorHandler struct {
+	loginMFAUC      *authUC.LoginMFAUseCase
+	loginMFASetupUC *authUC.LoginMFASetupUseCase
+	statusUC        *authUC.GetTwoFactorStatusUseCase
+	setupUC         *authUC.SetupTwoFactorUseCase
+	confirmUC       *authUC.ConfirmTwoFactorUseCase
+	disableUC       *authUC.DisableTwoFactorUseCase
+	recoveryCodesUC *authUC.RegenerateRecoveryCodesUseCase
+	updatePolicyUC  *authUC.UpdateTwoFactorPolicyUseCase
+	validator       *validator.Validate
+	logger          *zap.Logger
+}
+
+func NewTwoFactorHandler(
+	loginMFAUC *authUC.LoginMFAUseCase,
+	loginMFASetupUC *authUC.LoginMFASetupUseCase,
+	statusUC *authUC.GetTwoFactorStatusUseCase,
+	setupUC *authUC.SetupTwoFactorUseCase,
+	confirmUC *authUC.ConfirmTwoFactorUseCase,
+	disableUC *authUC.DisableTwoFactorUseCase,
+	recoveryCodesUC *authUC.RegenerateRecoveryCodesUseCase,
+	updatePolicyUC *authUC.UpdateTwoFactorPolicyUseCase,
+	logger *zap.Logger,
+) *TwoFactorHandler {
+	return &TwoFactorHandler{
+		loginMFAUC:      loginMFAUC,
+		loginMFASetupUC: loginMFASetupUC,
+		statusUC:        statusUC,
+		setupUC:         setupUC,
+		confirmUC:       confirmUC,
+		disableUC:       disableUC,
+		recoveryCodesUC: recoveryCodesUC,
+		updatePolicyUC:  updatePolicyUC,
+		validator:       validator.New(),
+		logger:          logger,
+	}
+}
+
+// LoginMFA - POST /auth/login/2fa (público, validado pelo mfa_token)
+// Conclui o login com o código do aplicativo ou um código de recuperação.
+func (h *TwoFactorHandler) LoginMFA(c echo.Context) error {
+	var req dto.LoginMFARequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "mfa_token e code são obrigatórios",
+		})
+	}
+
+	response, refreshToken, err := h.loginMFAUC.Execute(c.Request().Context(), req, clientInfo(c))
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro no segundo passo do login")
+	}
+
+	setRefreshCookie(c, refreshToken)
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// LoginMFASetup - POST /auth/login/2fa/setup (público, validado pelo mfa_token)
+// Cadastro do aplicativo durante o login quando a política exige 2FA.
+func (h *TwoFactorHandler) LoginMFASetup(c echo.Context) error {
+	var req dto.LoginMFASetupRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "mfa_token é obrigatório",
+		})
+	}
+
+	resp, err := h.loginMFASetupUC.Execute(c.Request().Context(), req.MFAToken)
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao iniciar cadastro 2FA no login")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Status - GET /auth/2fa
+func (h *TwoFactorHandler) Status(c echo.Context) error {
+	resp, err := h.statusUC.Execute(c.Request().Context(), mw.GetUserID(c))
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao consultar 2FA")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Setup - POST /auth/2fa/setup
+// Retorna o segredo e a URI otpauth:// para o QR code; o 2FA só fica ativo após /confirm.
+func (h *TwoFactorHandler) Setup(c echo.Context) error {
+	resp, err := h.setupUC.Execute(c.Request().Context(), mw.GetUserID(c))
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao iniciar cadastro 2FA")
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// Confirm - POST /auth/2fa/confirm
+// Ativa o 2FA e retorna os códigos de recuperação (exibidos uma única vez).
+func (h *TwoFactorHandler) Confirm(c echo.Context) error {
+	req, ok := h.bindCode(c)
+	if !ok {
+		return nil
+	}
+
+	codes, err := h.confirmUC.Execute(c.Request().Context(), mw.GetUserID(c), req.Code)
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao confirmar 2FA")
+	}
+
+	return c.JSON(http.StatusOK, dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// Disable - POST /auth/2fa/disable
+func (h *TwoFactorHandler) Disable(c echo.Context) error {
+	req, ok := h.bindCode(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.disableUC.Execute(c.Request().Context(), mw.GetUserID(c), req.Code); err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao desativar 2FA")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RegenerateRecoveryCodes - POST /auth/2fa/recovery-codes
+func (h *TwoFactorHandler) RegenerateRecoveryCodes(c echo.Context) error {
+	req, ok := h.bindCode(c)
+	if !ok {
+		return nil
+	}
+
+	codes, err := h.recoveryCodesUC.Execute(c.Request().Context(), mw.GetUserID(c), req.Code)
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao gerar códigos de recuperação")
+	}
+
+	return c.JSON(http.StatusOK, dto.RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// UpdatePolicy - PUT /auth/2fa/policy (apenas proprietário)
+func (h *TwoFactorHandler) UpdatePolicy(c echo.Context) error {
+	var req dto.TwoFactorPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "exigir_2fa_admin é obrigatório",
+		})
+	}
+
+	exigir, err := h.updatePolicyUC.Execute(c.Request().Context(), mw.GetTenantID(c), mw.GetUserID(c), *req.Exigir2FAAdmin)
+	if err != nil {
+		return h.handleTwoFactorError(c, err, "Erro ao atualizar política de 2FA")
+	}
+
+	return c.JSON(http.StatusOK, map[string]bool{
+		"exigir_2fa_admin": exigir,
+	})
+}
+
+// bindCode lê e valida o corpo {code}; em caso de erro já escreve a resposta
+func (h *TwoFactorHandler) bindCode(c echo.Context) (dto.TwoFactorCodeRequest, bool) {
+	var req dto.TwoFactorCodeRequest
+	if err := c.Bind(&req); err != nil || h.validator.Struct(req) != nil {
+		_ = c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "code é obrigatório",
+		})
+		return req, false
+	}
+	return req, true
+}
+
+// handleTwoFactorError mapeia erros do 2FA
+func (h *TwoFactorHandler) handleTwoFactorError(c echo.Context, err error, msg string) error {
+	var bloqueio *authUC.BloqueioLoginError
+	if errors.As(err, &bloqueio) {
+		c.Response().Header().Set("Retry-After", strconv.Itoa(int(bloqueio.RetryAfter().Seconds())))
+		return c.JSON(http.StatusTooManyRequests, map[string]string{
+			"error": domain.ErrLoginBloqueado.Error(),
+		})
+	}
+	switch err {
+	case domain.ErrCodigo2FAInvalido, domain.ErrDesafio2FAInvalido:
+		return c.JSON(http.StatusUnauthorized, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrDoisFatoresJaAtivo, domain.ErrDoisFatoresInativo, domain.ErrDoisFatoresNaoIniciado:
+		return c.JSON(http.StatusConflict, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrDoisFatoresObrigatorio, domain.ErrDoisFatoresPoliticaSem2FA:
+		return c.JSON(http.StatusForbidden, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrContaDesativada:
+		return c.JSON(http.StatusForbidden, map[string]string{
+			"error": "Conta desativada",
+		})
+	case domain.ErrUsuarioNaoEncontrado:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrInvalidTenantID:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
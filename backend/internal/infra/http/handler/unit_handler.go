@@ -4,8 +4,11 @@ import (
 	"net/http"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	authUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/auth"
 	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/unit"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -26,6 +29,7 @@ type UnitHandler struct {
 	getDefaultUC    *unit.GetDefaultUnitUseCase
 	checkAccessUC   *unit.CheckUserAccessToUnitUseCase
 	listUnitUsersUC *unit.ListUnitUsersUseCase
+	switchUnitUC    *authUC.SwitchUnitUseCase
 	logger          *zap.Logger
 }
 
@@ -44,6 +48,7 @@ func NewUnitHandler(
 	getDefaultUC *unit.GetDefaultUnitUseCase,
 	checkAccessUC *unit.CheckUserAccessToUnitUseCase,
 	listUnitUsersUC *unit.ListUnitUsersUseCase,
+	switchUnitUC *authUC.SwitchUnitUseCase,
 	logger *zap.Logger,
 ) *UnitHandler {
 	return &UnitHandler{
@@ -60,6 +65,7 @@ func NewUnitHandler(
 		getDefaultUC:    getDefaultUC,
 		checkAccessUC:   checkAccessUC,
 		listUnitUsersUC: listUnitUsersUC,
+		switchUnitUC:    switchUnitUC,
 		logger:          logger,
 	}
 }
@@ -472,11 +478,24 @@ func (h *UnitHandler) SwitchUnit(c echo.Context) error {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "vínculo não encontrado"})
 	}
 
-	// TODO: Gerar novo token JWT com unit_id
-	// Por ora, retornamos sem o novo token (será implementado junto com auth)
+	// Reemitir access token com a unidade ativa e o papel do usuário nela
+	token, err := h.switchUnitUC.Execute(c.Request().Context(), userID.String(), mw.GetSessionID(c), unitID.String())
+	if err != nil {
+		h.logger.Error("SwitchUnit: erro ao reemitir token", zap.Error(err))
+		if err == entity.ErrUserUnitNaoEncontrado {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "usuário não tem acesso a esta unidade"})
+		}
+		if err == domain.ErrDoisFatoresObrigatorio {
+			return c.JSON(http.StatusForbidden, map[string]string{"error": "A política da barbearia exige autenticação em dois fatores para o seu perfil nesta unidade"})
+		}
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+
 	return c.JSON(http.StatusOK, dto.SwitchUnitResponse{
 		Unit:        *userUnit,
-		AccessToken: "", // Será preenchido quando integrar com auth
+		AccessToken: token.AccessToken,
+		Role:        token.Role,
+		Permissions: token.Permissions,
 	})
 }
 
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/waitlist"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// WaitlistHandler agrupa os handlers da lista de espera. As rotas de oferta
+// são públicas e validadas pelo token do link enviado ao cliente.
+type WaitlistHandler struct {
+	createUC  *waitlist.CreateEntryUseCase
+	listUC    *waitlist.ListEntriesUseCase
+	cancelUC  *waitlist.CancelEntryUseCase
+	getUC     *waitlist.GetOfferUseCase
+	claimUC   *waitlist.ClaimOfferUseCase
+	declineUC *waitlist.DeclineOfferUseCase
+	logger    *zap.Logger
+}
+
+// NewWaitlistHandler cria um novo handler da lista de espera
+func NewWaitlistHandler(
+	createUC *waitlist.CreateEntryUseCase,
+	listUC *waitlist.ListEntriesUseCase,
+	cancelUC *waitlist.CancelEntryUseCase,
+	getUC *waitlist.GetOfferUseCase,
+	claimUC *waitlist.ClaimOfferUseCase,
+	declineUC *waitlist.DeclineOfferUseCase,
+	logger *zap.Logger,
+) *WaitlistHandler {
+	return &WaitlistHandler{
+		createUC:  createUC,
+		listUC:    listUC,
+		cancelUC:  cancelUC,
+		getUC:     getUC,
+		claimUC:   claimUC,
+		declineUC: declineUC,
+		logger:    logger,
+	}
+}
+
+// CreateEntry godoc
+// @Summary Incluir cliente na lista de espera
+// @Description Horários liberados por cancelamento, remarcação ou não comparecimento são oferecidos por prioridade aos clientes compatíveis
+// @Tags Lista de Espera
+// @Accept json
+// @Produce json
+// @Param request body dto.CreateWaitlistEntryRequest true "Dados da entrada"
+// @Success 201 {object} dto.WaitlistEntryResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/waitlist [post]
+// @Security BearerAuth
+func (h *WaitlistHandler) CreateEntry(c echo.Context) error {
+	unitID := middleware.GetUnitID(c)
+	if unitID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "unit_required",
+			Message: domain.ErrUnitIDRequired.Error(),
+		})
+	}
+
+	var req dto.CreateWaitlistEntryRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	entry, err := h.createUC.Execute(c.Request().Context(), waitlist.CreateEntryInput{
+		TenantID:        middleware.GetTenantID(c),
+		UnitID:          unitID,
+		CustomerID:      req.CustomerID,
+		ServiceIDs:      req.ServiceIDs,
+		ProfessionalIDs: req.ProfessionalIDs,
+		WindowStart:     req.WindowStart,
+		WindowEnd:       req.WindowEnd,
+		Priority:        req.Priority,
+		Notes:           req.Notes,
+		CreatedBy:       middleware.GetUserID(c),
+	})
+	if err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao incluir na lista de espera")
+	}
+
+	return c.JSON(http.StatusCreated, mapper.WaitlistEntryToResponse(entry))
+}
+
+// ListEntries godoc
+// @Summary Listar lista de espera
+// @Tags Lista de Espera
+// @Produce json
+// @Param status query string false "WAITING, OFFERED, FULFILLED, CANCELED ou EXPIRED"
+// @Success 200 {array} dto.WaitlistEntryResponse
+// @Router /api/v1/waitlist [get]
+// @Security BearerAuth
+func (h *WaitlistHandler) ListEntries(c echo.Context) error {
+	entries, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c), c.QueryParam("status"))
+	if err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao listar lista de espera")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WaitlistEntriesToResponse(entries))
+}
+
+// CancelEntry godoc
+// @Summary Retirar cliente da lista de espera
+// @Description Uma oferta pendente é cancelada e o horário passa ao próximo da fila
+// @Tags Lista de Espera
+// @Produce json
+// @Param id path string true "ID da entrada"
+// @Success 200 {object} dto.WaitlistEntryResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/waitlist/{id} [delete]
+// @Security BearerAuth
+func (h *WaitlistHandler) CancelEntry(c echo.Context) error {
+	entry, err := h.cancelUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao retirar da lista de espera")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WaitlistEntryToResponse(entry))
+}
+
+// GetOffer - GET /public/waitlist/offers?token= (público)
+// Dados exibidos na página do link antes de confirmar ou recusar.
+func (h *WaitlistHandler) GetOffer(c echo.Context) error {
+	token := c.QueryParam("token")
+	if token == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "token é obrigatório",
+		})
+	}
+
+	out, err := h.getUC.Execute(c.Request().Context(), token)
+	if err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao buscar oferta")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WaitlistOfferToResponse(out))
+}
+
+// ClaimOffer - POST /public/waitlist/offers/claim (público)
+// Confirma a oferta em um clique e cria o agendamento.
+func (h *WaitlistHandler) ClaimOffer(c echo.Context) error {
+	var req dto.WaitlistOfferTokenRequest
+	if err := c.Bind(&req); err != nil || req.Token == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "token é obrigatório",
+		})
+	}
+
+	appt, err := h.claimUC.Execute(c.Request().Context(), req.Token)
+	if err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao confirmar oferta")
+	}
+
+	return c.JSON(http.StatusCreated, dto.ClaimWaitlistOfferResponse{
+		AppointmentID: appt.ID,
+		StartTime:     appt.StartTime,
+		EndTime:       appt.EndTime,
+	})
+}
+
+// DeclineOffer - POST /public/waitlist/offers/decline (público)
+// O cliente continua na lista de espera.
+func (h *WaitlistHandler) DeclineOffer(c echo.Context) error {
+	var req dto.WaitlistOfferTokenRequest
+	if err := c.Bind(&req); err != nil || req.Token == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: "token é obrigatório",
+		})
+	}
+
+	if err := h.declineUC.Execute(c.Request().Context(), req.Token); err != nil {
+		return h.handleWaitlistError(c, err, "Erro ao recusar oferta")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// handleWaitlistError mapeia erros da lista de espera
+func (h *WaitlistHandler) handleWaitlistError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrWaitlistEntryNotFound),
+		errors.Is(err, domain.ErrWaitlistOfferNotFound),
+		errors.Is(err, domain.ErrAppointmentProfessionalNotFound),
+		errors.Is(err, domain.ErrAppointmentCustomerNotFound),
+		errors.Is(err, domain.ErrAppointmentServiceNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrWaitlistOfferExpired):
+		return c.JSON(http.StatusGone, dto.ErrorResponse{Error: "offer_expired", Message: err.Error()})
+	case errors.Is(err, domain.ErrWaitlistOfferUnavailable),
+		errors.Is(err, domain.ErrWaitlistEntryClosed):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
+	case errors.Is(err, domain.ErrWaitlistWindowInvalid),
+		errors.Is(err, domain.ErrAppointmentCustomerRequired),
+		errors.Is(err, domain.ErrAppointmentServicesRequired),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrUnitIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/mapper"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/walkin"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// WalkInHandler agrupa os handlers da fila de encaixe (clientes sem horário
+// marcado atendidos pela lista da vez).
+type WalkInHandler struct {
+	checkInUC    *walkin.CheckInUseCase
+	listUC       *walkin.ListQueueUseCase
+	assignNextUC *walkin.AssignNextUseCase
+	cancelUC     *walkin.CancelUseCase
+	logger       *zap.Logger
+}
+
+// NewWalkInHandler cria um novo handler da fila de encaixe
+func NewWalkInHandler(
+	checkInUC *walkin.CheckInUseCase,
+	listUC *walkin.ListQueueUseCase,
+	assignNextUC *walkin.AssignNextUseCase,
+	cancelUC *walkin.CancelUseCase,
+	logger *zap.Logger,
+) *WalkInHandler {
+	return &WalkInHandler{
+		checkInUC:    checkInUC,
+		listUC:       listUC,
+		assignNextUC: assignNextUC,
+		cancelUC:     cancelUC,
+		logger:       logger,
+	}
+}
+
+// CheckIn godoc
+// @Summary Registrar chegada de cliente sem horário
+// @Description Encaixa o cliente no próximo barbeiro livre da lista da vez (agendamento em CHECKED_IN e vez registrada); sem barbeiro livre, o cliente aguarda na fila com a previsão de espera
+// @Tags Fila de Encaixe
+// @Accept json
+// @Produce json
+// @Param request body dto.CheckInWalkInRequest true "Cliente e serviços"
+// @Success 201 {object} dto.WalkInResponse
+// @Failure 400 {object} dto.ErrorResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Router /api/v1/walk-ins [post]
+// @Security BearerAuth
+func (h *WalkInHandler) CheckIn(c echo.Context) error {
+	unitID := middleware.GetUnitID(c)
+	if unitID == "" {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "unit_required",
+			Message: domain.ErrUnitIDRequired.Error(),
+		})
+	}
+
+	var req dto.CheckInWalkInRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "bad_request",
+			Message: "Dados inválidos",
+		})
+	}
+	if err := c.Validate(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	}
+
+	item, err := h.checkInUC.Execute(c.Request().Context(), walkin.CheckInInput{
+		TenantID:   middleware.GetTenantID(c),
+		UnitID:     unitID,
+		CustomerID: req.CustomerID,
+		ServiceIDs: req.ServiceIDs,
+		Notes:      req.Notes,
+		CreatedBy:  middleware.GetUserID(c),
+	})
+	if err != nil {
+		return h.handleWalkInError(c, err, "Erro ao registrar chegada na fila de encaixe")
+	}
+
+	return c.JSON(http.StatusCreated, mapper.WalkInQueueItemToResponse(*item))
+}
+
+// ListQueue godoc
+// @Summary Listar fila de encaixe
+// @Description Clientes aguardando, na ordem de chegada, com a previsão de início do atendimento
+// @Tags Fila de Encaixe
+// @Produce json
+// @Success 200 {array} dto.WalkInResponse
+// @Router /api/v1/walk-ins [get]
+// @Security BearerAuth
+func (h *WalkInHandler) ListQueue(c echo.Context) error {
+	items, err := h.listUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c))
+	if err != nil {
+		return h.handleWalkInError(c, err, "Erro ao listar fila de encaixe")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WalkInQueueToResponse(items))
+}
+
+// AssignNext godoc
+// @Summary Chamar próximos da fila de encaixe
+// @Description Encaixa os clientes aguardando nos barbeiros que ficaram livres, pela lista da vez
+// @Tags Fila de Encaixe
+// @Produce json
+// @Success 200 {array} dto.WalkInResponse
+// @Router /api/v1/walk-ins/assign [post]
+// @Security BearerAuth
+func (h *WalkInHandler) AssignNext(c echo.Context) error {
+	assigned, err := h.assignNextUC.Execute(c.Request().Context(), middleware.GetTenantID(c), middleware.GetUnitID(c))
+	if err != nil {
+		return h.handleWalkInError(c, err, "Erro ao chamar próximos da fila de encaixe")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WalkInsToResponse(assigned))
+}
+
+// Cancel godoc
+// @Summary Retirar cliente da fila de encaixe
+// @Tags Fila de Encaixe
+// @Produce json
+// @Param id path string true "ID da chegada"
+// @Success 200 {object} dto.WalkInResponse
+// @Failure 404 {object} dto.ErrorResponse
+// @Failure 409 {object} dto.ErrorResponse
+// @Router /api/v1/walk-ins/{id} [delete]
+// @Security BearerAuth
+func (h *WalkInHandler) Cancel(c echo.Context) error {
+	walkIn, err := h.cancelUC.Execute(c.Request().Context(), middleware.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleWalkInError(c, err, "Erro ao retirar da fila de encaixe")
+	}
+
+	return c.JSON(http.StatusOK, mapper.WalkInToResponse(walkIn))
+}
+
+// handleWalkInError mapeia erros da fila de encaixe
+func (h *WalkInHandler) handleWalkInError(c echo.Context, err error, msg string) error {
+	switch {
+	case errors.Is(err, domain.ErrWalkInNotFound),
+		errors.Is(err, domain.ErrAppointmentCustomerNotFound),
+		errors.Is(err, domain.ErrAppointmentServiceNotFound):
+		return c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "not_found", Message: err.Error()})
+	case errors.Is(err, domain.ErrWalkInNotWaiting):
+		return c.JSON(http.StatusConflict, dto.ErrorResponse{Error: "conflict", Message: err.Error()})
+	case errors.Is(err, domain.ErrAppointmentCustomerRequired),
+		errors.Is(err, domain.ErrAppointmentServicesRequired),
+		errors.Is(err, domain.ErrTenantIDRequired),
+		errors.Is(err, domain.ErrUnitIDRequired):
+		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "validation_error", Message: err.Error()})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "internal_error", Message: "Erro interno do servidor"})
+	}
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	webhookUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/webhook"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	mw "github.com/andviana23/barber-analytics-backend/internal/infra/http/middleware"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// WEBHOOK ENDPOINT HANDLER
+// Webhooks de saída: endpoints do tenant, entregas e reenvio (somente dono)
+// =============================================================================
+
+type WebhookEndpointHandler struct {
+	listUC           *webhookUC.ListWebhookEndpointsUseCase
+	createUC         *webhookUC.CreateWebhookEndpointUseCase
+	updateUC         *webhookUC.UpdateWebhookEndpointUseCase
+	rotateUC         *webhookUC.RotateWebhookSecretUseCase
+	deleteUC         *webhookUC.DeleteWebhookEndpointUseCase
+	listDeliveriesUC *webhookUC.ListWebhookDeliveriesUseCase
+	getDeliveryUC    *webhookUC.GetWebhookDeliveryUseCase
+	redeliverUC      *webhookUC.RedeliverWebhookUseCase
+	validator        *validator.Validate
+	logger           *zap.Logger
+}
+
+func NewWebhookEndpointHandler(
+	listUC *webhookUC.ListWebhookEndpointsUseCase,
+	createUC *webhookUC.CreateWebhookEndpointUseCase,
+	updateUC *webhookUC.UpdateWebhookEndpointUseCase,
+	rotateUC *webhookUC.RotateWebhookSecretUseCase,
+	deleteUC *webhookUC.DeleteWebhookEndpointUseCase,
+	listDeliveriesUC *webhookUC.ListWebhookDeliveriesUseCase,
+	getDeliveryUC *webhookUC.GetWebhookDeliveryUseCase,
+	redeliverUC *webhookUC.RedeliverWebhookUseCase,
+	logger *zap.Logger,
+) *WebhookEndpointHandler {
+	return &WebhookEndpointHandler{
+		listUC:           listUC,
+		createUC:         createUC,
+		updateUC:         updateUC,
+		rotateUC:         rotateUC,
+		deleteUC:         deleteUC,
+		listDeliveriesUC: listDeliveriesUC,
+		getDeliveryUC:    getDeliveryUC,
+		redeliverUC:      redeliverUC,
+		validator:        validator.New(),
+		logger:           logger,
+	}
+}
+
+// Events - GET /webhook-endpoints/events
+func (h *WebhookEndpointHandler) Events(c echo.Context) error {
+	return c.JSON(http.StatusOK, webhookUC.WebhookEventCatalog())
+}
+
+// List - GET /webhook-endpoints
+func (h *WebhookEndpointHandler) List(c echo.Context) error {
+	endpoints, err := h.listUC.Execute(c.Request().Context(), mw.GetTenantID(c))
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao listar endpoints de webhook")
+	}
+
+	return c.JSON(http.StatusOK, endpoints)
+}
+
+// Create - POST /webhook-endpoints
+// O segredo de assinatura é retornado somente nesta resposta.
+func (h *WebhookEndpointHandler) Create(c echo.Context) error {
+	var req dto.CreateWebhookEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "url e ao menos um evento são obrigatórios",
+		})
+	}
+
+	endpoint, err := h.createUC.Execute(c.Request().Context(), mw.GetTenantID(c), mw.GetUserID(c), req)
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao criar endpoint de webhook")
+	}
+
+	return c.JSON(http.StatusCreated, endpoint)
+}
+
+// Update - PUT /webhook-endpoints/:id
+func (h *WebhookEndpointHandler) Update(c echo.Context) error {
+	var req dto.UpdateWebhookEndpointRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Dados inválidos",
+		})
+	}
+	if err := h.validator.Struct(req); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "url e ao menos um evento são obrigatórios",
+		})
+	}
+
+	endpoint, err := h.updateUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id"), req)
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao atualizar endpoint de webhook")
+	}
+
+	return c.JSON(http.StatusOK, endpoint)
+}
+
+// RotateSecret - POST /webhook-endpoints/:id/rotate-secret
+func (h *WebhookEndpointHandler) RotateSecret(c echo.Context) error {
+	endpoint, err := h.rotateUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao rotacionar segredo do webhook")
+	}
+
+	return c.JSON(http.StatusOK, endpoint)
+}
+
+// Delete - DELETE /webhook-endpoints/:id
+func (h *WebhookEndpointHandler) Delete(c echo.Context) error {
+	if err := h.deleteUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id")); err != nil {
+		return h.handleWebhookError(c, err, "Erro ao remover endpoint de webhook")
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// ListDeliveries - GET /webhook-endpoints/deliveries?endpoint_id=&status=&limit=
+func (h *WebhookEndpointHandler) ListDeliveries(c echo.Context) error {
+	var filter dto.ListWebhookDeliveriesFilter
+	if err := c.Bind(&filter); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": "Filtros inválidos",
+		})
+	}
+
+	deliveries, err := h.listDeliveriesUC.Execute(c.Request().Context(), mw.GetTenantID(c), filter)
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao listar entregas de webhook")
+	}
+
+	return c.JSON(http.StatusOK, deliveries)
+}
+
+// GetDelivery - GET /webhook-endpoints/deliveries/:id
+func (h *WebhookEndpointHandler) GetDelivery(c echo.Context) error {
+	delivery, err := h.getDeliveryUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id"))
+	if err != nil {
+		return h.handleWebhookError(c, err, "Erro ao buscar entrega de webhook")
+	}
+
+	return c.JSON(http.StatusOK, delivery)
+}
+
+// Redeliver - POST /webhook-endpoints/deliveries/:id/redeliver
+func (h *WebhookEndpointHandler) Redeliver(c echo.Context) error {
+	if err := h.redeliverUC.Execute(c.Request().Context(), mw.GetTenantID(c), c.Param("id")); err != nil {
+		return h.handleWebhookError(c, err, "Erro ao reenviar webhook")
+	}
+
+	return c.JSON(http.StatusAccepted, map[string]string{
+		"message": "Entrega recolocada na fila",
+	})
+}
+
+// handleWebhookError mapeia erros dos webhooks de saída
+func (h *WebhookEndpointHandler) handleWebhookError(c echo.Context, err error, msg string) error {
+	switch err {
+	case domain.ErrWebhookEndpointNaoEncontrado, domain.ErrWebhookEntregaNaoEncontrada:
+		return c.JSON(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+	case domain.ErrWebhookURLInvalida, domain.ErrWebhookEventoInvalido,
+		domain.ErrInvalidTenantID, domain.ErrInvalidID:
+		return c.JSON(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": "Erro interno do servidor",
+		})
+	}
+}
@@ -1,14 +1,18 @@
 package handler
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/application/usecase/noshow"
 	subUC "github.com/andviana23/barber-analytics-backend/internal/application/usecase/subscription"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/gateway/asaas"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/metrics"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
@@ -17,7 +21,8 @@ import (
 // Reference: FLUXO_ASSINATURA.md — Seção 6.6 (Fluxo Processar Webhook)
 type WebhookHandler struct {
 	processUC    *subUC.ProcessWebhookUseCase
-	processUCV2  *subUC.ProcessWebhookUseCaseV2 // Use case v2 com suporte a log
+	processUCV2  *subUC.ProcessWebhookUseCaseV2       // Use case v2 com suporte a log
+	depositUC    *noshow.ConfirmDepositPaymentUseCase // Sinais de agendamento (opcional)
 	useV2        bool
 	webhookToken string
 	logger       *zap.Logger
@@ -40,11 +45,13 @@ func NewWebhookHandler(
 // NewWebhookHandlerV2 creates a new webhook handler using V2 use case
 func NewWebhookHandlerV2(
 	processUCV2 *subUC.ProcessWebhookUseCaseV2,
+	depositUC *noshow.ConfirmDepositPaymentUseCase,
 	webhookToken string,
 	logger *zap.Logger,
 ) *WebhookHandler {
 	return &WebhookHandler{
 		processUCV2:  processUCV2,
+		depositUC:    depositUC,
 		webhookToken: webhookToken,
 		logger:       logger,
 		useV2:        true,
@@ -67,6 +74,7 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 		h.logger.Warn("invalid webhook token",
 			zap.String("received_token", maskToken(token)),
 		)
+		metrics.WebhooksTotal.WithLabelValues("asaas", metrics.WebhookTokenInvalido).Inc()
 		return c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
 			Error:   "unauthorized",
 			Message: "Invalid webhook token",
@@ -77,6 +85,7 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 	body, err := io.ReadAll(c.Request().Body)
 	if err != nil {
 		h.logger.Error("failed to read webhook body", zap.Error(err))
+		metrics.WebhooksTotal.WithLabelValues("asaas", metrics.WebhookPayloadInvalido).Inc()
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error:   "bad_request",
 			Message: "Failed to read request body",
@@ -90,6 +99,7 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 			zap.Error(err),
 			zap.String("body", string(body)),
 		)
+		metrics.WebhooksTotal.WithLabelValues("asaas", metrics.WebhookPayloadInvalido).Inc()
 		return c.JSON(http.StatusBadRequest, dto.ErrorResponse{
 			Error:   "bad_request",
 			Message: "Failed to parse webhook event",
@@ -107,6 +117,27 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 	// For now, we process synchronously but quickly
 	ctx := c.Request().Context()
 
+	resultado := metrics.WebhookProcessado
+	if handled, err := h.handleDepositPayment(ctx, event); handled || err != nil {
+		if err != nil {
+			// Unlike subscriptions, a lost deposit confirmation would let the
+			// expiry job cancel a paid appointment: answer 5xx so Asaas retries
+			h.logger.Error("failed to confirm appointment deposit",
+				zap.String("payment_id", getPaymentID(event)),
+				zap.Error(err),
+			)
+			metrics.WebhooksTotal.WithLabelValues("asaas", metrics.WebhookFalha).Inc()
+			return c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to confirm appointment deposit",
+			})
+		}
+		metrics.WebhooksTotal.WithLabelValues("asaas", resultado).Inc()
+		return c.JSON(http.StatusOK, map[string]string{
+			"status": "received",
+		})
+	}
+
 	if h.useV2 {
 		// Extrair IP do cliente
 		clientIP := net.ParseIP(c.RealIP())
@@ -115,6 +146,7 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 				zap.String("event", event.Event),
 				zap.Error(err),
 			)
+			resultado = metrics.WebhookFalha
 			// Still return 200 to avoid Asaas retrying
 		}
 	} else {
@@ -123,17 +155,46 @@ func (h *WebhookHandler) HandleAsaasWebhook(c echo.Context) error {
 				zap.String("event", event.Event),
 				zap.Error(err),
 			)
+			resultado = metrics.WebhookFalha
 			// Still return 200 to avoid Asaas retrying
 			// The error is logged for investigation
 		}
 	}
 
+	metrics.WebhooksTotal.WithLabelValues("asaas", resultado).Inc()
+
 	// Step 5: Return 200 OK immediately (REGRA AS-005)
 	return c.JSON(http.StatusOK, map[string]string{
 		"status": "received",
 	})
 }
 
+// handleDepositPayment confirms appointment deposits (single PIX charges,
+// without subscription). Returns false when the event is not a deposit payment.
+func (h *WebhookHandler) handleDepositPayment(ctx context.Context, event asaas.WebhookEvent) (bool, error) {
+	if h.depositUC == nil || event.Payment == nil || event.Payment.Subscription != "" {
+		return false, nil
+	}
+	switch event.Event {
+	case asaas.EventPaymentReceived, asaas.EventPaymentConfirmed, "PAYMENT_RECEIVED_IN_CASH":
+	default:
+		return false, nil
+	}
+
+	paidAt := time.Now()
+	for _, s := range []string{event.Payment.PaymentDate, event.Payment.ClientPaymentDate, event.Payment.ConfirmedDate} {
+		if s == "" {
+			continue
+		}
+		if t, err := asaas.ParseDate(s); err == nil {
+			paidAt = t
+			break
+		}
+	}
+
+	return h.depositUC.Execute(ctx, event.Payment.ID, paidAt)
+}
+
 // maskToken masks the token for logging (shows first 4 and last 4 chars)
 func maskToken(token string) string {
 	if len(token) <= 8 {
@@ -0,0 +1,195 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// CHAVES DE API
+// Integrações autenticam com "X-API-Key: bap_..." (ou "Authorization: Bearer
+// bap_..."). A requisição recebe o mesmo contexto de tenant e unidade de um
+// JWT, mas sem usuário: o acesso é decidido pelos escopos da chave em
+// APIKeyScopeGuard, que nega qualquer rota fora de APIKeyRoutes, e o RBAC
+// trata a chave no máximo como APIKeyRoleCeiling.
+// =============================================================================
+
+// RoleIntegration é o papel no contexto de requisições autenticadas por chave
+const RoleIntegration Role = "INTEGRATION"
+
+// APIKeyRoleCeiling é o maior papel que uma chave de API pode exercer nas
+// rotas com RBAC: rotas só de dono/gerente ficam fora mesmo com escopo
+const APIKeyRoleCeiling = RoleReceptionist
+
+// APIKeyAuthenticator valida a chave e retorna a identidade da integração
+type APIKeyAuthenticator interface {
+	Execute(ctx context.Context, chave, ip string) (*dto.APIKeyPrincipal, error)
+}
+
+// APIKeyRoute associa método e rota (padrão registrado no echo, com :param)
+// ao escopo exigido
+type APIKeyRoute struct {
+	Method string
+	Path   string
+	Scope  valueobject.APIKeyScope
+}
+
+// APIKeyRoutes são as únicas rotas acessíveis por chave de API. Rotas novas
+// ficam fora até serem listadas aqui; cancelar, concluir, não comparecimento
+// e sinal continuam exclusivos dos usuários.
+var APIKeyRoutes = []APIKeyRoute{
+	{http.MethodGet, "/api/v1/financial/payables", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/payables/:id", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/receivables", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/receivables/:id", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/compensations", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/compensations/:id", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/cashflow", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/cashflow/:id", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/dre", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/dre/:month", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/dashboard", valueobject.ScopeFinancialRead},
+	{http.MethodGet, "/api/v1/financial/projections", valueobject.ScopeFinancialRead},
+
+	{http.MethodGet, "/api/v1/appointments", valueobject.ScopeAppointmentsRead},
+	{http.MethodGet, "/api/v1/appointments/:id", valueobject.ScopeAppointmentsRead},
+	{http.MethodGet, "/api/v1/appointments/:id/history", valueobject.ScopeAppointmentsRead},
+	{http.MethodPost, "/api/v1/appointments", valueobject.ScopeAppointmentsWrite},
+	{http.MethodPatch, "/api/v1/appointments/:id/reschedule", valueobject.ScopeAppointmentsWrite},
+	{http.MethodPost, "/api/v1/appointments/:id/confirm", valueobject.ScopeAppointmentsWrite},
+
+	{http.MethodGet, "/api/v1/customers", valueobject.ScopeCustomersRead},
+	{http.MethodGet, "/api/v1/customers/search", valueobject.ScopeCustomersRead},
+	{http.MethodGet, "/api/v1/customers/:id", valueobject.ScopeCustomersRead},
+}
+
+// extractAPIKey lê a chave do header X-API-Key ou do Authorization
+func extractAPIKey(c echo.Context) string {
+	if key := strings.TrimSpace(c.Request().Header.Get("X-API-Key")); key != "" {
+		return key
+	}
+	parts := strings.SplitN(c.Request().Header.Get("Authorization"), " ", 2)
+	if len(parts) == 2 && parts[0] == "Bearer" && auth.IsAPIKey(parts[1]) {
+		return parts[1]
+	}
+	return ""
+}
+
+// AuthMiddleware aceita JWT de usuário ou chave de API. Sem chave na
+// requisição, delega ao JWTMiddleware.
+func AuthMiddleware(jwtManager *auth.JWTManager, apiKeys APIKeyAuthenticator, logger *zap.Logger) echo.MiddlewareFunc {
+	jwtMiddleware := JWTMiddleware(jwtManager, logger)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		jwtNext := jwtMiddleware(next)
+		return func(c echo.Context) error {
+			chave := extractAPIKey(c)
+			if chave == "" || apiKeys == nil {
+				return jwtNext(c)
+			}
+
+			principal, err := apiKeys.Execute(c.Request().Context(), chave, c.RealIP())
+			if err != nil {
+				if errors.Is(err, domain.ErrChaveAPIInvalida) {
+					logger.Warn("Chave de API inválida", zap.String("ip", c.RealIP()))
+					return echo.NewHTTPError(http.StatusUnauthorized, "Chave de API inválida, revogada ou expirada")
+				}
+				logger.Error("Erro ao validar chave de API", zap.Error(err))
+				return echo.NewHTTPError(http.StatusInternalServerError, "Erro ao validar chave de API")
+			}
+
+			escopos := make([]valueobject.APIKeyScope, len(principal.Escopos))
+			for i, s := range principal.Escopos {
+				escopos[i] = valueobject.APIKeyScope(s)
+			}
+
+			c.Set("tenant_id", principal.TenantID)
+			c.Set("role", string(RoleIntegration))
+			c.Set("permissions", valueobject.ScopePermissions(escopos...))
+			c.Set("api_key_id", principal.KeyID)
+			c.Set("api_key_scopes", escopos)
+
+			// Chave vinculada a uma unidade ignora o header, como o JWT
+			unitID := principal.UnitID
+			if unitID == "" {
+				unitID = c.Request().Header.Get("X-Unit-ID")
+			}
+			if unitID != "" {
+				c.Set("unit_id", unitID)
+			}
+
+			logger.Debug("Chave de API validada",
+				zap.String("api_key_id", principal.KeyID),
+				zap.String("tenant_id", principal.TenantID),
+			)
+			return next(c)
+		}
+	}
+}
+
+// APIKeyScopeGuard libera requisições de chave de API apenas nas rotas
+// informadas (método e caminho exatos) e com o escopo exigido. Requisições
+// com JWT passam direto.
+func APIKeyScopeGuard(logger *zap.Logger, routes []APIKeyRoute) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !IsAPIKeyRequest(c) {
+				return next(c)
+			}
+
+			path := c.Path()
+			if path == "" {
+				path = c.Request().URL.Path
+			}
+			method := c.Request().Method
+			for _, r := range routes {
+				if r.Method != method || r.Path != path {
+					continue
+				}
+				if HasAPIKeyScope(c, r.Scope) {
+					return next(c)
+				}
+				break
+			}
+
+			if logger != nil {
+				logger.Warn("Chave de API sem escopo para a rota",
+					zap.String("api_key_id", GetAPIKeyID(c)),
+					zap.String("method", method),
+					zap.String("path", path),
+				)
+			}
+			return echo.NewHTTPError(http.StatusForbidden, "Acesso negado: escopo da chave de API insuficiente")
+		}
+	}
+}
+
+// IsAPIKeyRequest indica se a requisição foi autenticada por chave de API
+func IsAPIKeyRequest(c echo.Context) bool {
+	return GetAPIKeyID(c) != ""
+}
+
+// GetAPIKeyID extrai o ID da chave de API do context
+func GetAPIKeyID(c echo.Context) string {
+	id, _ := c.Get("api_key_id").(string)
+	return id
+}
+
+// HasAPIKeyScope verifica se a chave da requisição concede o escopo
+func HasAPIKeyScope(c echo.Context, scope valueobject.APIKeyScope) bool {
+	escopos, _ := c.Get("api_key_scopes").([]valueobject.APIKeyScope)
+	for _, s := range escopos {
+		if s.Includes(scope) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/application/dto"
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// Chaves de API: mesmo contexto de tenant/unidade do JWT, acesso por escopo
+// =============================================================================
+
+type fakeAPIKeyAuthenticator map[string]*dto.APIKeyPrincipal
+
+func (f fakeAPIKeyAuthenticator) Execute(_ context.Context, chave, _ string) (*dto.APIKeyPrincipal, error) {
+	if p, ok := f[chave]; ok {
+		return p, nil
+	}
+	return nil, domain.ErrChaveAPIInvalida
+}
+
+// servidorComChaves monta rotas como em main.go: autenticação + guarda de
+// escopos no grupo, RBAC/permissão por rota
+func servidorComChaves(apiKeys APIKeyAuthenticator) *echo.Echo {
+	e := echo.New()
+	logger := zap.NewNop()
+	ok := func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]string{
+			"tenant_id": GetTenantID(c),
+			"unit_id":   GetUnitID(c),
+		})
+	}
+
+	g := e.Group("/api/v1", AuthMiddleware(auth.NewJWTManager(), apiKeys, logger), APIKeyScopeGuard(logger, APIKeyRoutes))
+	g.GET("/appointments", ok, RequireAnyRole(logger))
+	g.POST("/appointments", ok, RequireAnyRole(logger))
+	g.POST("/appointments/:id/cancel", ok, RequireAdminAccess(logger))
+	g.POST("/appointments/:id/no-show", ok, RequireOwnerOrManager(logger))
+	g.GET("/financial/dre", ok, RequirePermission(logger, valueobject.PermDRERead))
+	g.GET("/customers", ok)
+	g.GET("/units", ok, RequireAdminAccess(logger))
+	return e
+}
+
+func chamar(e *echo.Echo, method, path string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestAuthMiddleware_ChaveDeAPIPorEscopo(t *testing.T) {
+	tenantID, unitID := uuid.NewString(), uuid.NewString()
+	e := servidorComChaves(fakeAPIKeyAuthenticator{
+		"bap_agenda": {KeyID: uuid.NewString(), TenantID: tenantID, UnitID: unitID, Escopos: []string{"appointments:write"}},
+		"bap_bi":     {KeyID: uuid.NewString(), TenantID: tenantID, Escopos: []string{"financial:read"}},
+	})
+
+	// Escopo de escrita inclui leitura; unidade da chave prevalece sobre o header
+	rec := chamar(e, http.MethodGet, "/api/v1/appointments", map[string]string{"X-API-Key": "bap_agenda", "X-Unit-ID": uuid.NewString()})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), tenantID)
+	assert.Contains(t, rec.Body.String(), unitID)
+
+	// Escrita liberada só nas rotas listadas
+	rec = chamar(e, http.MethodPost, "/api/v1/appointments", map[string]string{"Authorization": "Bearer bap_agenda"})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Sem o escopo de clientes
+	rec = chamar(e, http.MethodGet, "/api/v1/customers", map[string]string{"X-API-Key": "bap_agenda"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	// financial:read concede as permissões de leitura do financeiro
+	rec = chamar(e, http.MethodGet, "/api/v1/financial/dre", map[string]string{"X-API-Key": "bap_bi"})
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Rotas fora do catálogo são negadas a qualquer chave
+	rec = chamar(e, http.MethodGet, "/api/v1/units", map[string]string{"X-API-Key": "bap_bi"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestAuthMiddleware_ChaveInvalida(t *testing.T) {
+	e := servidorComChaves(fakeAPIKeyAuthenticator{})
+
+	rec := chamar(e, http.MethodGet, "/api/v1/appointments", map[string]string{"X-API-Key": "bap_revogada"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestAuthMiddleware_JWTContinuaFuncionando(t *testing.T) {
+	e := servidorComChaves(fakeAPIKeyAuthenticator{})
+	tenantID := uuid.NewString()
+	token, err := auth.NewJWTManager().GenerateAccessToken(uuid.NewString(), tenantID, "", "a@b.com", "MANAGER", "", nil)
+	require.NoError(t, err)
+
+	rec := chamar(e, http.MethodGet, "/api/v1/units", map[string]string{"Authorization": "Bearer " + token})
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), tenantID)
+}
+
+func TestAuthMiddleware_ChaveDeEscritaNaoAlcancaRotasRestritas(t *testing.T) {
+	e := servidorComChaves(fakeAPIKeyAuthenticator{
+		"bap_agenda": {KeyID: uuid.NewString(), TenantID: uuid.NewString(), UnitID: uuid.NewString(), Escopos: []string{"appointments:write"}},
+	})
+
+	// Mesmo prefixo de agendamentos, mas fora da lista da chave
+	for _, path := range []string{"/api/v1/appointments/1/no-show", "/api/v1/appointments/1/cancel"} {
+		rec := chamar(e, http.MethodPost, path, map[string]string{"X-API-Key": "bap_agenda"})
+		assert.Equal(t, http.StatusForbidden, rec.Code, path)
+	}
+
+	// Teto de papel: mesmo liberada no catálogo, rota só de dono/gerente é negada
+	ceiling := echo.New()
+	logger := zap.NewNop()
+	rotas := []APIKeyRoute{{http.MethodPost, "/api/v1/appointments/:id/no-show", valueobject.ScopeAppointmentsWrite}}
+	ceiling.Group("/api/v1", AuthMiddleware(auth.NewJWTManager(), fakeAPIKeyAuthenticator{
+		"bap_agenda": {KeyID: uuid.NewString(), TenantID: uuid.NewString(), Escopos: []string{"appointments:write"}},
+	}, logger), APIKeyScopeGuard(logger, rotas)).
+		POST("/appointments/:id/no-show", func(c echo.Context) error { return c.NoContent(http.StatusOK) }, RequireOwnerOrManager(logger))
+
+	rec := chamar(ceiling, http.MethodPost, "/api/v1/appointments/1/no-show", map[string]string{"X-API-Key": "bap_agenda"})
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"strings"
 
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -13,31 +14,9 @@ import (
 // Baseado em FLUXO_LOGIN.md
 // =============================================================================
 
-// roleMapping mapeia roles do banco (português/minúsculo) para roles RBAC (inglês/maiúsculo)
-var roleMapping = map[string]string{
-	// Português (banco de dados)
-	"owner":         "OWNER",
-	"manager":       "MANAGER",
-	"barbeiro":      "BARBER",
-	"barber":        "BARBER",
-	"recepcionista": "RECEPTIONIST",
-	"receptionist":  "RECEPTIONIST",
-	"contador":      "ACCOUNTANT",
-	// Inglês (já normalizado)
-	"OWNER":        "OWNER",
-	"MANAGER":      "MANAGER",
-	"BARBER":       "BARBER",
-	"RECEPTIONIST": "RECEPTIONIST",
-	"ACCOUNTANT":   "ACCOUNTANT",
-}
-
 // normalizeRole converte role do banco para formato RBAC padronizado
 func normalizeRole(role string) string {
-	if normalized, ok := roleMapping[strings.ToLower(role)]; ok {
-		return normalized
-	}
-	// Se não encontrar mapeamento, retorna uppercase como fallback
-	return strings.ToUpper(role)
+	return valueobject.NormalizeRole(role)
 }
 
 // JWTMiddleware valida access token e injeta dados no context
@@ -74,8 +53,19 @@ func JWTMiddleware(jwtManager *auth.JWTManager, logger *zap.Logger) echo.Middlew
 			c.Set("tenant_id", claims.TenantID)
 			c.Set("email", claims.Email)
 			c.Set("role", normalizeRole(claims.Role)) // Normaliza role para RBAC
+			// Permissões efetivas; tokens sem o claim usam o modelo do papel
+			if claims.Permissions != nil {
+				c.Set("permissions", valueobject.NewPermissionSet(claims.Permissions...))
+			} else {
+				c.Set("permissions", valueobject.TemplatePermissions(claims.Role))
+			}
+			if claims.SessionID != "" {
+				c.Set("session_id", claims.SessionID)
+			}
 
-			// Unidade atual (multi-unidade). Prioridade: claim → header X-Unit-ID → vazio
+			// Unidade atual (multi-unidade). Prioridade: claim → header X-Unit-ID → vazio.
+			// Com unidade no token, o role é o papel do usuário nessa unidade e o
+			// header é ignorado: trocar de unidade exige POST /units/switch.
 			unitID := claims.UnitID
 			if unitID == "" {
 				unitID = c.Request().Header.Get("X-Unit-ID")
@@ -120,6 +110,12 @@ func GetUnitID(c echo.Context) string {
 	return unitID
 }
 
+// GetSessionID extrai o ID da sessão (dispositivo) do context
+func GetSessionID(c echo.Context) string {
+	sessionID, _ := c.Get("session_id").(string)
+	return sessionID
+}
+
 // GetUserEmail extrai email do context
 func GetUserEmail(c echo.Context) string {
 	email, _ := c.Get("email").(string)
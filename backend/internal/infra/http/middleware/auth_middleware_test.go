@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// Papel por unidade: o access token carrega a unidade ativa e o papel do
+// usuário nela; o RBAC avalia esse papel.
+// =============================================================================
+
+// executarProtegido roda JWTMiddleware + RequireOwnerOrManager com o token informado
+func executarProtegido(t *testing.T, token, headerUnit string) (int, string) {
+	t.Helper()
+	e := echo.New()
+	jwtManager := auth.NewJWTManager()
+	logger := zap.NewNop()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	if headerUnit != "" {
+		req.Header.Set("X-Unit-ID", headerUnit)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	var unitID string
+	h := JWTMiddleware(jwtManager, logger)(RequireOwnerOrManager(logger)(func(c echo.Context) error {
+		unitID = GetUnitID(c)
+		return c.NoContent(http.StatusOK)
+	}))
+
+	if err := h(c); err != nil {
+		if he, ok := err.(*echo.HTTPError); ok {
+			return he.Code, unitID
+		}
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	return rec.Code, unitID
+}
+
+func TestRBAC_UsaPapelDaUnidadeAtiva(t *testing.T) {
+	jwtManager := auth.NewJWTManager()
+	userID, tenantID := uuid.NewString(), uuid.NewString()
+	unidadeGerente, unidadeBarbeiro := uuid.NewString(), uuid.NewString()
+
+	// Mesmo usuário: gerente em uma unidade, barbeiro na outra
+	tokenGerente, err := jwtManager.GenerateAccessToken(userID, tenantID, unidadeGerente, "a@b.com", "MANAGER", uuid.NewString(), nil)
+	require.NoError(t, err)
+	tokenBarbeiro, err := jwtManager.GenerateAccessToken(userID, tenantID, unidadeBarbeiro, "a@b.com", "barbeiro", uuid.NewString(), nil)
+	require.NoError(t, err)
+
+	code, unit := executarProtegido(t, tokenGerente, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, unidadeGerente, unit)
+
+	code, _ = executarProtegido(t, tokenBarbeiro, "")
+	assert.Equal(t, http.StatusForbidden, code)
+}
+
+func TestJWTMiddleware_HeaderNaoTrocaUnidadeDoToken(t *testing.T) {
+	jwtManager := auth.NewJWTManager()
+	unidadeToken := uuid.NewString()
+
+	token, err := jwtManager.GenerateAccessToken(uuid.NewString(), uuid.NewString(), unidadeToken, "a@b.com", "MANAGER", "", nil)
+	require.NoError(t, err)
+
+	// O papel vale só para a unidade do token: trocar de unidade exige /units/switch
+	code, unit := executarProtegido(t, token, uuid.NewString())
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, unidadeToken, unit)
+}
+
+// =============================================================================
+// Permissões nomeadas: o token carrega as permissões efetivas; tokens sem a
+// claim usam o modelo do papel.
+// =============================================================================
+
+func executarComPermissao(t *testing.T, token string, perm valueobject.Permission) int {
+	t.Helper()
+	e := echo.New()
+	logger := zap.NewNop()
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	h := JWTMiddleware(auth.NewJWTManager(), logger)(RequirePermission(logger, perm)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}))
+	if err := h(c); err != nil {
+		if he, ok := err.(*echo.HTTPError); ok {
+			return he.Code
+		}
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	return rec.Code
+}
+
+func TestRequirePermission_ModeloDoPapelSemClaim(t *testing.T) {
+	jwtManager := auth.NewJWTManager()
+
+	token, err := jwtManager.GenerateAccessToken(uuid.NewString(), uuid.NewString(), "", "a@b.com", "recepcionista", "", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, executarComPermissao(t, token, valueobject.PermCaixaFechar))
+	assert.Equal(t, http.StatusForbidden, executarComPermissao(t, token, valueobject.PermCaixaSangria))
+}
+
+func TestRequirePermission_PapelPersonalizado(t *testing.T) {
+	jwtManager := auth.NewJWTManager()
+
+	// Barbeiro com papel personalizado que permite sangria, mas não abrir comandas
+	token, err := jwtManager.GenerateAccessToken(uuid.NewString(), uuid.NewString(), uuid.NewString(), "a@b.com", "BARBER", "",
+		[]string{valueobject.PermCaixaRead.String(), valueobject.PermCaixaSangria.String()})
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, executarComPermissao(t, token, valueobject.PermCaixaSangria))
+	assert.Equal(t, http.StatusForbidden, executarComPermissao(t, token, valueobject.PermCommandOperate))
+}
@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// IPExtractor define de onde vem o IP do cliente (c.RealIP), base do bloqueio
+// de login e do rate limit por IP. Sem proxies confiáveis usa o endereço da
+// conexão; com eles (TRUSTED_PROXIES="10.0.0.0/8,203.0.113.7") lê o
+// X-Forwarded-For só através dessas faixas. X-Real-IP e X-Forwarded-For
+// enviados diretamente pelo cliente são ignorados.
+func IPExtractor(trustedProxies string) (echo.IPExtractor, error) {
+	var opts []echo.TrustOption
+	for _, item := range strings.Split(trustedProxies, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if !strings.Contains(item, "/") {
+			ip := net.ParseIP(item)
+			if ip == nil {
+				return nil, fmt.Errorf("proxy confiável inválido: %q", item)
+			}
+			bits := 128
+			if ip.To4() != nil {
+				bits = 32
+			}
+			item = fmt.Sprintf("%s/%d", item, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(item)
+		if err != nil {
+			return nil, fmt.Errorf("proxy confiável inválido: %q", item)
+		}
+		opts = append(opts, echo.TrustIPRange(ipNet))
+	}
+	if len(opts) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+	// Só as faixas configuradas: loopback e redes privadas não são confiáveis por padrão
+	opts = append(opts, echo.TrustLoopback(false), echo.TrustLinkLocal(false), echo.TrustPrivateNet(false))
+	return echo.ExtractIPFromXFFHeader(opts...), nil
+}
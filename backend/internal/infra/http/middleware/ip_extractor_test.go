@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPExtractor(t *testing.T) {
+	_, err := IPExtractor("10.0.0.0/8, nao-e-ip")
+	assert.Error(t, err)
+
+	extractor, err := IPExtractor("203.0.113.7")
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Header.Set(echo.HeaderXForwardedFor, "198.51.100.9")
+
+	// XFF de quem não é proxy confiável é ignorado
+	req.RemoteAddr = "192.0.2.1:40000"
+	assert.Equal(t, "192.0.2.1", extractor(req))
+
+	// Vindo do proxy, vale o cliente que ele informou
+	req.RemoteAddr = "203.0.113.7:40000"
+	assert.Equal(t, "198.51.100.9", extractor(req))
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/metrics"
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPMetrics registra http_requests_total e http_request_duration_seconds.
+// O rótulo path usa o template da rota (/api/v1/commands/:id), nunca a URL
+// real, para manter a cardinalidade limitada.
+func HTTPMetrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				if he, ok := err.(*echo.HTTPError); ok {
+					status = he.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			path := c.Path()
+			if path == "" {
+				path = "desconhecida"
+			}
+			method := c.Request().Method
+
+			metrics.HTTPRequestsTotal.WithLabelValues(method, path, strconv.Itoa(status)).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(method, path).Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// RequireMetricsToken protege /metrics com "Authorization: Bearer <token>".
+// Token vazio recusa todas as requisições: as métricas expõem faturamento por
+// tenant e nunca ficam abertas por falta de configuração.
+func RequireMetricsToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if token == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Token de métricas não configurado")
+			}
+			recebido := c.Request().Header.Get("Authorization")
+			if subtle.ConstantTimeCompare([]byte(recebido), []byte("Bearer "+token)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Token de métricas inválido")
+			}
+			return next(c)
+		}
+	}
+}
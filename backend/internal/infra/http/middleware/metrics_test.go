@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/metrics"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPMetrics_UsaTemplateDaRota(t *testing.T) {
+	e := echo.New()
+	e.Use(HTTPMetrics())
+	e.GET("/api/v1/commands/:id", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	antes := testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "/api/v1/commands/:id", "200"))
+	for _, id := range []string{"a", "b", "c"} {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/commands/"+id, nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	// Uma única série para os três IDs
+	depois := testutil.ToFloat64(metrics.HTTPRequestsTotal.WithLabelValues(http.MethodGet, "/api/v1/commands/:id", "200"))
+	assert.Equal(t, 3.0, depois-antes)
+}
+
+func TestRequireMetricsToken(t *testing.T) {
+	e := echo.New()
+	e.GET("/metrics", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, RequireMetricsToken("segredo"))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer segredo")
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestRequireMetricsToken_SemTokenRecusa(t *testing.T) {
+	e := echo.New()
+	e.GET("/metrics", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	}, RequireMetricsToken(""))
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// PERMISSION MIDDLEWARE
+// Controle de acesso por permissões nomeadas (caixa.sangria, financial.dre.read...)
+// As permissões vêm do token: modelo do papel fixo ou papel personalizado do
+// tenant na unidade ativa.
+// =============================================================================
+
+// RequirePermission exige todas as permissões informadas
+func RequirePermission(logger *zap.Logger, perms ...valueobject.Permission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !GetPermissions(c).Has(perms...) {
+				if logger != nil {
+					logger.Warn("Acesso negado por permissão",
+						zap.String("user_id", GetUserID(c)),
+						zap.String("path", c.Request().URL.Path),
+						zap.Any("required", perms),
+					)
+				}
+				return echo.NewHTTPError(http.StatusForbidden, "Acesso negado: permissão insuficiente")
+			}
+			return next(c)
+		}
+	}
+}
+
+// GetPermissions extrai as permissões efetivas do context (injetadas pelo
+// JWTMiddleware); sem elas, usa o modelo do papel
+func GetPermissions(c echo.Context) valueobject.PermissionSet {
+	if perms, ok := c.Get("permissions").(valueobject.PermissionSet); ok {
+		return perms
+	}
+	return valueobject.TemplatePermissions(GetUserRole(c))
+}
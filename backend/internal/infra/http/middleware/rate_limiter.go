@@ -1,121 +1,289 @@
 package middleware
 
 import (
+	"context"
+	"math"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"go.uber.org/zap"
 )
 
-// RateLimiterConfig configura o rate limiter
-type RateLimiterConfig struct {
-	RequestsPerWindow int           // Número máximo de requests
-	Window            time.Duration // Janela de tempo
-	KeyFunc           func(c echo.Context) string
+// =============================================================================
+// RATE LIMITER
+// Token bucket por política (classe de rota) e chave (IP, usuário ou tenant).
+// Cada política repõe Limit tokens ao longo de Window, permitindo rajadas de
+// até Limit requisições. O estado fica em um TokenBucketStore: memória do
+// processo (desenvolvimento/testes) ou Postgres (compartilhado entre réplicas e
+// preservado entre deploys). Respostas trazem os cabeçalhos RateLimit-*.
+// =============================================================================
+
+// TokenBucketStore armazena os buckets. Take repõe os tokens pelo tempo
+// decorrido, consome um se houver e retorna o saldo após o consumo.
+type TokenBucketStore interface {
+	Take(ctx context.Context, key string, capacity, refillPerSecond float64, ttl time.Duration) (tokens float64, allowed bool, err error)
 }
 
-// RateLimiter implementa rate limiting em memória
-type RateLimiter struct {
-	config  RateLimiterConfig
-	clients map[string]*clientBucket
-	mu      sync.RWMutex
+// RateLimitKeyFunc extrai a dimensão da chave (ex.: "ip:203.0.113.7")
+type RateLimitKeyFunc func(c echo.Context) string
+
+// RateLimitPolicy define o limite de uma classe de rota
+type RateLimitPolicy struct {
+	Name   string        // prefixo da chave (login, public, webhooks, api-user...)
+	Limit  int           // capacidade do bucket (rajada máxima)
+	Window time.Duration // tempo para repor Limit tokens
+	Key    RateLimitKeyFunc
 }
 
-type clientBucket struct {
-	requests  int
-	resetTime time.Time
+// Políticas padrão por classe de rota (sobrescritas por RATE_LIMIT_<CLASSE>)
+var (
+	// Login, 2FA e recuperação de senha: por IP, baixo para conter força bruta
+	RateLimitLogin = RateLimitPolicy{Name: "login", Limit: 10, Window: time.Minute, Key: KeyByIP}
+	// Rotas públicas (refresh, convites, agendamento público): por IP
+	RateLimitPublic = RateLimitPolicy{Name: "public", Limit: 60, Window: time.Minute, Key: KeyByIP}
+	// Webhooks de gateways: por IP de origem
+	RateLimitWebhooks = RateLimitPolicy{Name: "webhooks", Limit: 300, Window: time.Minute, Key: KeyByIP}
+	// API autenticada: por usuário e, somados, por tenant
+	RateLimitAPIUser   = RateLimitPolicy{Name: "api-user", Limit: 300, Window: time.Minute, Key: KeyByUser}
+	RateLimitAPITenant = RateLimitPolicy{Name: "api-tenant", Limit: 1200, Window: time.Minute, Key: KeyByTenant}
+)
+
+// KeyByIP identifica o cliente pelo IP resolvido pelo IPExtractor do Echo
+// (ver IPExtractor): cabeçalhos de proxy forjados pelo cliente não trocam a chave
+func KeyByIP(c echo.Context) string {
+	return "ip:" + c.RealIP()
 }
 
-// NewRateLimiter cria um novo rate limiter
-func NewRateLimiter(config RateLimiterConfig) *RateLimiter {
-	if config.KeyFunc == nil {
-		config.KeyFunc = func(c echo.Context) string {
-			return c.RealIP()
-		}
+// KeyByUser identifica o usuário autenticado ou a chave de API (IP se não
+// houver credencial)
+func KeyByUser(c echo.Context) string {
+	if keyID := GetAPIKeyID(c); keyID != "" {
+		return "apikey:" + keyID
+	}
+	if userID := GetUserID(c); userID != "" {
+		return "user:" + userID
 	}
+	return KeyByIP(c)
+}
 
-	rl := &RateLimiter{
-		config:  config,
-		clients: make(map[string]*clientBucket),
+// KeyByTenant identifica o tenant autenticado (IP se não houver token)
+func KeyByTenant(c echo.Context) string {
+	if tenantID := GetTenantID(c); tenantID != "" {
+		return "tenant:" + tenantID
 	}
+	return KeyByIP(c)
+}
 
-	// Limpar buckets expirados a cada minuto
-	go rl.cleanup()
+// FromEnv sobrescreve limite e janela com o formato "limite/janela"
+// (ex.: RATE_LIMIT_LOGIN=5/1m). Valores inválidos mantêm o padrão.
+func (p RateLimitPolicy) FromEnv(key string) RateLimitPolicy {
+	val := strings.TrimSpace(os.Getenv(key))
+	if val == "" {
+		return p
+	}
+	parts := strings.SplitN(val, "/", 2)
+	if len(parts) != 2 {
+		return p
+	}
+	limit, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil || limit <= 0 {
+		return p
+	}
+	window, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+	if err != nil || window <= 0 {
+		return p
+	}
+	p.Limit = limit
+	p.Window = window
+	return p
+}
+
+func (p RateLimitPolicy) refillPerSecond() float64 {
+	return float64(p.Limit) / p.Window.Seconds()
+}
+
+// RateLimiter aplica políticas sobre um TokenBucketStore
+type RateLimiter struct {
+	store  TokenBucketStore
+	logger *zap.Logger
+}
 
-	return rl
+// NewRateLimiter cria um rate limiter com o store informado
+func NewRateLimiter(store TokenBucketStore, logger *zap.Logger) *RateLimiter {
+	return &RateLimiter{store: store, logger: logger}
 }
 
-// Middleware retorna o middleware de rate limiting
-func (rl *RateLimiter) Middleware() echo.MiddlewareFunc {
+// rateLimitStatus é o saldo de uma política para os cabeçalhos
+type rateLimitStatus struct {
+	policy    RateLimitPolicy
+	remaining int
+	reset     int // segundos
+}
+
+// Limit retorna o middleware que exige saldo em todas as políticas. Os
+// cabeçalhos refletem a política mais restritiva. Falhas no store não
+// bloqueiam a requisição (fail-open), apenas são registradas.
+func (rl *RateLimiter) Limit(policies ...RateLimitPolicy) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			key := rl.config.KeyFunc(c)
-
-			rl.mu.Lock()
-			bucket, exists := rl.clients[key]
-			now := time.Now()
+			var mais *rateLimitStatus
+			for _, p := range policies {
+				key := p.Name + ":" + p.Key(c)
+				tokens, allowed, err := rl.store.Take(c.Request().Context(), key, float64(p.Limit), p.refillPerSecond(), p.Window)
+				if err != nil {
+					if rl.logger != nil {
+						rl.logger.Warn("Erro no rate limiter, requisição liberada",
+							zap.String("policy", p.Name),
+							zap.Error(err),
+						)
+					}
+					continue
+				}
 
-			if !exists || now.After(bucket.resetTime) {
-				// Criar novo bucket ou resetar
-				bucket = &clientBucket{
-					requests:  1,
-					resetTime: now.Add(rl.config.Window),
+				status := statusDoBucket(p, tokens, allowed)
+				if !allowed {
+					writeRateLimitHeaders(c, status)
+					c.Response().Header().Set("Retry-After", strconv.Itoa(status.reset))
+					if rl.logger != nil {
+						rl.logger.Warn("Rate limit excedido",
+							zap.String("policy", p.Name),
+							zap.String("key", key),
+							zap.String("path", c.Request().URL.Path),
+						)
+					}
+					return echo.NewHTTPError(http.StatusTooManyRequests, "Muitas requisições. Tente novamente em instantes.")
+				}
+				if mais == nil || status.remaining < mais.remaining {
+					mais = &status
 				}
-				rl.clients[key] = bucket
-				rl.mu.Unlock()
-				return next(c)
 			}
 
-			if bucket.requests >= rl.config.RequestsPerWindow {
-				rl.mu.Unlock()
-				retryAfter := int(time.Until(bucket.resetTime).Seconds())
-				c.Response().Header().Set("Retry-After", string(rune(retryAfter)))
-				c.Response().Header().Set("X-RateLimit-Limit", string(rune(rl.config.RequestsPerWindow)))
-				c.Response().Header().Set("X-RateLimit-Remaining", "0")
-				return echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded. Try again later.")
+			if mais != nil {
+				writeRateLimitHeaders(c, *mais)
 			}
+			return next(c)
+		}
+	}
+}
 
-			bucket.requests++
-			remaining := rl.config.RequestsPerWindow - bucket.requests
-			rl.mu.Unlock()
+// statusDoBucket calcula saldo e reset: liberado, segundos até encher o
+// bucket; bloqueado, segundos até o próximo token
+func statusDoBucket(p RateLimitPolicy, tokens float64, allowed bool) rateLimitStatus {
+	rate := p.refillPerSecond()
+	falta := float64(p.Limit) - tokens
+	if !allowed {
+		falta = 1 - tokens
+	}
+	reset := int(math.Ceil(falta / rate))
+	if reset < 0 {
+		reset = 0
+	}
+	remaining := int(math.Floor(tokens))
+	if remaining < 0 {
+		remaining = 0
+	}
+	return rateLimitStatus{policy: p, remaining: remaining, reset: reset}
+}
 
-			c.Response().Header().Set("X-RateLimit-Limit", string(rune(rl.config.RequestsPerWindow)))
-			c.Response().Header().Set("X-RateLimit-Remaining", string(rune(remaining)))
+// writeRateLimitHeaders escreve os cabeçalhos RateLimit-* (IETF httpapi-ratelimit-headers)
+func writeRateLimitHeaders(c echo.Context, s rateLimitStatus) {
+	h := c.Response().Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(s.policy.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(s.remaining))
+	h.Set("RateLimit-Reset", strconv.Itoa(s.reset))
+	h.Set("RateLimit-Policy", strconv.Itoa(s.policy.Limit)+";w="+strconv.Itoa(int(s.policy.Window.Seconds())))
+}
 
-			return next(c)
-		}
+// =============================================================================
+// Store em memória (por processo)
+// =============================================================================
+
+// MemoryRateLimitStore mantém os buckets no processo. Os limites zeram a cada
+// deploy e se multiplicam pelo número de réplicas: use o store do Postgres em
+// produção.
+type MemoryRateLimitStore struct {
+	buckets map[string]*memoryBucket
+	mu      sync.Mutex
+	now     func() time.Time
+}
+
+type memoryBucket struct {
+	tokens     float64
+	atualizado time.Time
+	expiraEm   time.Time
+}
+
+// NewMemoryRateLimitStore cria o store em memória
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	s := &MemoryRateLimitStore{
+		buckets: make(map[string]*memoryBucket),
+		now:     time.Now,
+	}
+
+	// Limpar buckets expirados a cada minuto
+	go s.cleanup()
+
+	return s
+}
+
+// Take implementa TokenBucketStore
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, capacity, refillPerSecond float64, ttl time.Duration) (float64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: capacity, atualizado: now}
+		s.buckets[key] = b
+	}
+
+	if elapsed := now.Sub(b.atualizado).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(capacity, b.tokens+elapsed*refillPerSecond)
+	}
+	b.atualizado = now
+	b.expiraEm = now.Add(ttl)
+
+	if b.tokens < 1 {
+		return b.tokens, false, nil
 	}
+	b.tokens--
+	return b.tokens, true, nil
 }
 
 // cleanup remove buckets expirados periodicamente
-func (rl *RateLimiter) cleanup() {
+func (s *MemoryRateLimitStore) cleanup() {
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 
 	for range ticker.C {
-		rl.mu.Lock()
-		now := time.Now()
-		for key, bucket := range rl.clients {
-			if now.After(bucket.resetTime) {
-				delete(rl.clients, key)
+		s.mu.Lock()
+		now := s.now()
+		for key, b := range s.buckets {
+			if now.After(b.expiraEm) {
+				delete(s.buckets, key)
 			}
 		}
-		rl.mu.Unlock()
+		s.mu.Unlock()
 	}
 }
 
-// RateLimitByUserID cria um rate limiter baseado em user_id
+// RateLimitByUserID cria um rate limiter em memória baseado em user_id
 func RateLimitByUserID(requestsPerWindow int, window time.Duration) echo.MiddlewareFunc {
-	limiter := NewRateLimiter(RateLimiterConfig{
-		RequestsPerWindow: requestsPerWindow,
-		Window:            window,
-		KeyFunc: func(c echo.Context) string {
-			userID := GetUserIDFromContext(c)
-			return "user:" + userID
+	limiter := NewRateLimiter(NewMemoryRateLimitStore(), nil)
+	return limiter.Limit(RateLimitPolicy{
+		Name:   "user",
+		Limit:  requestsPerWindow,
+		Window: window,
+		Key: func(c echo.Context) string {
+			return GetUserIDFromContext(c)
 		},
 	})
-	return limiter.Middleware()
 }
 
 // RateLimitExportData rate limiter específico para export (1x por dia)
@@ -0,0 +1,109 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+// relogioFixo permite avançar o tempo do store em memória nos testes
+type relogioFixo struct{ t time.Time }
+
+func (r *relogioFixo) now() time.Time { return r.t }
+
+func executarLimitado(t *testing.T, h echo.HandlerFunc, ip string) *httptest.ResponseRecorder {
+	t.Helper()
+	return executarLimitadoComXFF(t, h, ip, "")
+}
+
+// executarLimitadoComXFF envia a requisição da conexão ip com o
+// X-Forwarded-For escolhido pelo cliente, sem proxies confiáveis
+func executarLimitadoComXFF(t *testing.T, h echo.HandlerFunc, ip, xff string) *httptest.ResponseRecorder {
+	t.Helper()
+	e := echo.New()
+	extractor, err := IPExtractor("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.IPExtractor = extractor
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", nil)
+	req.RemoteAddr = ip + ":51000"
+	if xff != "" {
+		req.Header.Set(echo.HeaderXForwardedFor, xff)
+		req.Header.Set(echo.HeaderXRealIP, xff)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if err := h(c); err != nil {
+		if he, ok := err.(*echo.HTTPError); ok {
+			rec.Code = he.Code
+		} else {
+			t.Fatalf("erro inesperado: %v", err)
+		}
+	}
+	return rec
+}
+
+func TestRateLimiter_TokenBucketComCabecalhos(t *testing.T) {
+	relogio := &relogioFixo{t: time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)}
+	store := &MemoryRateLimitStore{buckets: map[string]*memoryBucket{}, now: relogio.now}
+	policy := RateLimitPolicy{Name: "login", Limit: 3, Window: time.Minute, Key: KeyByIP}
+
+	h := NewRateLimiter(store, zap.NewNop()).Limit(policy)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	for i := 0; i < 3; i++ {
+		rec := executarLimitado(t, h, "203.0.113.7")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	rec := executarLimitado(t, h, "203.0.113.7")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "3", rec.Header().Get("RateLimit-Limit"))
+	assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "20", rec.Header().Get("Retry-After")) // 1 token a cada 20s
+	assert.Equal(t, "3;w=60", rec.Header().Get("RateLimit-Policy"))
+
+	// Outro IP tem bucket próprio
+	assert.Equal(t, http.StatusOK, executarLimitado(t, h, "198.51.100.1").Code)
+
+	// Após 20s um token é reposto
+	relogio.t = relogio.t.Add(20 * time.Second)
+	rec = executarLimitado(t, h, "203.0.113.7")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "0", rec.Header().Get("RateLimit-Remaining"))
+	assert.Equal(t, "60", rec.Header().Get("RateLimit-Reset"))
+}
+
+func TestRateLimiter_XFFForjadoNaoGeraNovoBucket(t *testing.T) {
+	store := &MemoryRateLimitStore{buckets: map[string]*memoryBucket{}, now: time.Now}
+	policy := RateLimitPolicy{Name: "login", Limit: 2, Window: time.Minute, Key: KeyByIP}
+
+	h := NewRateLimiter(store, zap.NewNop()).Limit(policy)(func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	// Cada tentativa inventa um cabeçalho diferente; o bucket é o da conexão
+	codes := make([]int, 0, 3)
+	for _, xff := range []string{"1.1.1.1", "2.2.2.2", "3.3.3.3"} {
+		codes = append(codes, executarLimitadoComXFF(t, h, "203.0.113.7", xff).Code)
+	}
+	assert.Equal(t, []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+}
+
+func TestRateLimitPolicy_FromEnv(t *testing.T) {
+	t.Setenv("RATE_LIMIT_TESTE", "5/30s")
+	p := RateLimitLogin.FromEnv("RATE_LIMIT_TESTE")
+	assert.Equal(t, 5, p.Limit)
+	assert.Equal(t, 30*time.Second, p.Window)
+
+	t.Setenv("RATE_LIMIT_TESTE", "invalido")
+	assert.Equal(t, RateLimitLogin.Limit, RateLimitLogin.FromEnv("RATE_LIMIT_TESTE").Limit)
+}
@@ -28,12 +28,18 @@ type RBACConfig struct {
 	Logger       *zap.Logger // Logger para auditoria
 }
 
-// RBAC cria um middleware que valida se o usuário tem uma das roles permitidas
+// RBAC cria um middleware que valida se o usuário tem uma das roles permitidas.
+// A role do contexto é a da unidade ativa (user_units.role_override), emitida
+// no token pelo login, refresh ou troca de unidade. Chaves de API, além do
+// escopo (APIKeyScopeGuard), valem no máximo como APIKeyRoleCeiling.
 func RBAC(config RBACConfig) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			// Extrair role do contexto (injetada pelo JWTMiddleware)
 			userRole, ok := c.Get("role").(string)
+			if IsAPIKeyRequest(c) {
+				userRole, ok = string(APIKeyRoleCeiling), true
+			}
 			if !ok || userRole == "" {
 				if config.Logger != nil {
 					config.Logger.Warn("Role não encontrada no token",
@@ -0,0 +1,133 @@
+// Package ical gera calendários no formato iCalendar (RFC 5545), usados nos
+// feeds .ics que os aplicativos de calendário assinam.
+package ical
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+// ContentType é o MIME type do arquivo .ics
+const ContentType = "text/calendar; charset=utf-8"
+
+// fusoBrasilia é o fuso dos horários do calendário. Os eventos recorrentes
+// precisam do horário local: em UTC um bloqueio às 22h cairia no dia seguinte
+// e o BYDAY da regra apontaria para o dia errado.
+var fusoBrasilia = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// refreshInterval sugere aos aplicativos de quanto em quanto tempo buscar o feed
+const refreshInterval = "PT15M"
+
+// maxLineOctets é o tamanho máximo de uma linha antes da dobra (RFC 5545 3.1)
+const maxLineOctets = 75
+
+const (
+	layoutLocal = "20060102T150405"
+	layoutUTC   = "20060102T150405Z"
+)
+
+// Write grava o calendário com os eventos no destino
+func Write(w io.Writer, name string, events []entity.CalendarEvent) error {
+	cw := &writer{w: bufio.NewWriter(w)}
+
+	cw.line("BEGIN:VCALENDAR")
+	cw.line("VERSION:2.0")
+	cw.line("PRODID:-//Barber Analytics//Agenda//PT-BR")
+	cw.line("CALSCALE:GREGORIAN")
+	cw.line("METHOD:PUBLISH")
+	cw.line("X-WR-CALNAME:" + escape(name))
+	cw.line("X-WR-TIMEZONE:" + fusoBrasilia.String())
+	cw.line("REFRESH-INTERVAL;VALUE=DURATION:" + refreshInterval)
+	cw.line("X-PUBLISHED-TTL:" + refreshInterval)
+
+	cw.line("BEGIN:VTIMEZONE")
+	cw.line("TZID:" + fusoBrasilia.String())
+	cw.line("BEGIN:STANDARD")
+	cw.line("DTSTART:19700101T000000")
+	cw.line("TZOFFSETFROM:-0300")
+	cw.line("TZOFFSETTO:-0300")
+	cw.line("TZNAME:-03")
+	cw.line("END:STANDARD")
+	cw.line("END:VTIMEZONE")
+
+	for _, ev := range events {
+		cw.line("BEGIN:VEVENT")
+		cw.line("UID:" + ev.UID)
+		cw.line("DTSTAMP:" + ev.UpdatedAt.UTC().Format(layoutUTC))
+		cw.line("LAST-MODIFIED:" + ev.UpdatedAt.UTC().Format(layoutUTC))
+		cw.line("DTSTART;TZID=" + fusoBrasilia.String() + ":" + ev.Start.In(fusoBrasilia).Format(layoutLocal))
+		cw.line("DTEND;TZID=" + fusoBrasilia.String() + ":" + ev.End.In(fusoBrasilia).Format(layoutLocal))
+		if ev.RRule != "" && !strings.ContainsAny(ev.RRule, "\r\n") { // regra gravada no banco, sem validação
+			cw.line("RRULE:" + strings.TrimPrefix(ev.RRule, "RRULE:"))
+		}
+		cw.line("SUMMARY:" + escape(ev.Summary))
+		if ev.Description != "" {
+			cw.line("DESCRIPTION:" + escape(ev.Description))
+		}
+		if ev.Status != "" {
+			cw.line("STATUS:" + ev.Status)
+		}
+		cw.line("TRANSP:OPAQUE")
+		cw.line("END:VEVENT")
+	}
+
+	cw.line("END:VCALENDAR")
+	if cw.err != nil {
+		return cw.err
+	}
+	return cw.w.Flush()
+}
+
+// writer grava linhas terminadas em CRLF, dobrando as longas
+type writer struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (cw *writer) line(s string) {
+	if cw.err != nil {
+		return
+	}
+	_, cw.err = cw.w.WriteString(fold(s) + "\r\n")
+}
+
+// fold quebra a linha em trechos de até 75 octetos; as continuações começam
+// com um espaço. Não corta caracteres UTF-8 ao meio.
+func fold(s string) string {
+	if len(s) <= maxLineOctets {
+		return s
+	}
+
+	var b strings.Builder
+	limit := maxLineOctets
+	n := 0
+	for _, r := range s {
+		size := utf8.RuneLen(r)
+		if n+size > limit {
+			b.WriteString("\r\n ")
+			// o espaço da continuação conta no limite da linha
+			limit = maxLineOctets - 1
+			n = 0
+		}
+		b.WriteRune(r)
+		n += size
+	}
+	return b.String()
+}
+
+// escape aplica o escape de valores TEXT (RFC 5545 3.3.11)
+func escape(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", "",
+	).Replace(s)
+}
@@ -0,0 +1,81 @@
+package ical
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+)
+
+func TestWrite(t *testing.T) {
+	start := time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC) // 22h do dia 1 em Brasília
+	events := []entity.CalendarEvent{{
+		UID:         "blocked-1@barber-analytics",
+		Summary:     "Bloqueio: Curso; barba, bigode",
+		Description: "linha 1\nlinha 2",
+		Start:       start,
+		End:         start.Add(time.Hour),
+		Status:      entity.CalendarEventConfirmed,
+		RRule:       "FREQ=WEEKLY;BYDAY=SU",
+		UpdatedAt:   start,
+	}}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "Agenda - João", events); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:blocked-1@barber-analytics\r\n",
+		"DTSTART;TZID=America/Sao_Paulo:20260301T220000\r\n",
+		"RRULE:FREQ=WEEKLY;BYDAY=SU\r\n",
+		`SUMMARY:Bloqueio: Curso\; barba\, bigode` + "\r\n",
+		`DESCRIPTION:linha 1\nlinha 2` + "\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("saída sem %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	line := "SUMMARY:" + strings.Repeat("ção ", 40)
+	folded := fold(line)
+
+	for i, part := range strings.Split(folded, "\r\n") {
+		if len(part) > maxLineOctets {
+			t.Errorf("linha %d com %d octetos", i, len(part))
+		}
+		if i > 0 && !strings.HasPrefix(part, " ") {
+			t.Errorf("continuação %d sem espaço inicial", i)
+		}
+	}
+	if strings.ReplaceAll(folded, "\r\n ", "") != line {
+		t.Error("desdobrar não recupera a linha original")
+	}
+}
+
+func TestWrite_RRuleComQuebraDeLinha(t *testing.T) {
+	start := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	events := []entity.CalendarEvent{{
+		UID:       "blocked-2@barber-analytics",
+		Summary:   "Bloqueio",
+		Start:     start,
+		End:       start.Add(time.Hour),
+		RRule:     "FREQ=DAILY\r\nBEGIN:VALARM",
+		UpdatedAt: start,
+	}}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, "Agenda", events); err != nil {
+		t.Fatalf("erro inesperado: %v", err)
+	}
+	if out := buf.String(); strings.Contains(out, "RRULE") || strings.Contains(out, "VALARM") {
+		t.Errorf("regra inválida não deveria ser escrita:\n%s", out)
+	}
+}
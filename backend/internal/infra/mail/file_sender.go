@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// FileSender grava cada email como arquivo .eml (uso em desenvolvimento).
+// Os links de recuperação e convite podem ser abertos direto do arquivo.
+type FileSender struct {
+	dir    string
+	from   mail.Address
+	logger *zap.Logger
+}
+
+// NewFileSender cria um sender que grava em dir
+func NewFileSender(dir, from, fromName string, logger *zap.Logger) *FileSender {
+	return &FileSender{
+		dir:    dir,
+		from:   mail.Address{Name: fromName, Address: from},
+		logger: logger,
+	}
+}
+
+var nomeArquivoInvalido = regexp.MustCompile(`[^a-zA-Z0-9._-]+`)
+
+// Send grava o email em <dir>/<timestamp>_<destinatario>.eml
+func (s *FileSender) Send(_ context.Context, msg port.EmailMessage) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("erro ao criar diretório de emails: %w", err)
+	}
+
+	now := time.Now()
+	nome := fmt.Sprintf("%s_%s.eml", now.Format("20060102T150405.000000000"), nomeArquivoInvalido.ReplaceAllString(msg.To, "_"))
+	path := filepath.Join(s.dir, nome)
+	if err := os.WriteFile(path, buildMessage(s.from, msg, now), 0o600); err != nil {
+		return fmt.Errorf("erro ao gravar email: %w", err)
+	}
+
+	s.logger.Info("Email gravado em arquivo",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("path", path),
+	)
+	return nil
+}
@@ -0,0 +1,81 @@
+// Package mail implementa port.MailSender: gravação em arquivo (.eml) para
+// desenvolvimento e envio por SMTP para produção.
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/mail"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// Config define o remetente e o transporte dos emails
+type Config struct {
+	From     string // endereço do remetente (MAIL_FROM)
+	FromName string // nome exibido do remetente
+	Dir      string // diretório dos .eml quando não há SMTP configurado (MAIL_DIR)
+	SMTPHost string
+	SMTPPort int
+	SMTPUser string
+	SMTPPass string
+}
+
+// ConfigFromEnv lê a configuração de email das variáveis de ambiente
+func ConfigFromEnv() Config {
+	cfg := Config{
+		From:     os.Getenv("MAIL_FROM"),
+		FromName: os.Getenv("MAIL_FROM_NAME"),
+		Dir:      os.Getenv("MAIL_DIR"),
+		SMTPHost: os.Getenv("SMTP_HOST"),
+		SMTPUser: os.Getenv("SMTP_USER"),
+		SMTPPass: os.Getenv("SMTP_PASSWORD"),
+	}
+	cfg.SMTPPort, _ = strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if cfg.SMTPPort == 0 {
+		cfg.SMTPPort = 587
+	}
+	if cfg.From == "" {
+		cfg.From = "nao-responda@barberanalytics.local"
+	}
+	if cfg.FromName == "" {
+		cfg.FromName = "Barber Analytics Pro"
+	}
+	if cfg.Dir == "" {
+		cfg.Dir = "tmp/mail"
+	}
+	return cfg
+}
+
+// NewSender escolhe o transporte: SMTP quando SMTP_HOST está definido,
+// senão grava os emails em arquivo (desenvolvimento)
+func NewSender(cfg Config, logger *zap.Logger) port.MailSender {
+	if cfg.SMTPHost != "" {
+		logger.Info("Envio de email via SMTP", zap.String("host", cfg.SMTPHost), zap.Int("port", cfg.SMTPPort))
+		return NewSMTPSender(cfg, logger)
+	}
+	logger.Info("Emails serão gravados em arquivo (SMTP_HOST não definido)", zap.String("dir", cfg.Dir))
+	return NewFileSender(cfg.Dir, cfg.From, cfg.FromName, logger)
+}
+
+// buildMessage monta a mensagem RFC 5322 em UTF-8
+func buildMessage(from mail.Address, msg port.EmailMessage, now time.Time) []byte {
+	to := mail.Address{Name: msg.ToName, Address: msg.To}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from.String())
+	fmt.Fprintf(&buf, "To: %s\r\n", to.String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(msg.Body)
+	return buf.Bytes()
+}
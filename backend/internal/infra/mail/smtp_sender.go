@@ -0,0 +1,62 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"go.uber.org/zap"
+)
+
+// SMTPSender envia emails por SMTP com STARTTLS (quando oferecido pelo servidor)
+type SMTPSender struct {
+	addr   string
+	auth   smtp.Auth
+	from   mail.Address
+	logger *zap.Logger
+}
+
+// NewSMTPSender cria um sender SMTP a partir da configuração
+func NewSMTPSender(cfg Config, logger *zap.Logger) *SMTPSender {
+	var auth smtp.Auth
+	if cfg.SMTPUser != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPass, cfg.SMTPHost)
+	}
+	return &SMTPSender{
+		addr:   net.JoinHostPort(cfg.SMTPHost, strconv.Itoa(cfg.SMTPPort)),
+		auth:   auth,
+		from:   mail.Address{Name: cfg.FromName, Address: cfg.From},
+		logger: logger,
+	}
+}
+
+// Send envia o email. smtp.SendMail usa STARTTLS automaticamente e não aceita
+// contexto, por isso o envio roda em goroutine respeitando o cancelamento.
+func (s *SMTPSender) Send(ctx context.Context, msg port.EmailMessage) error {
+	body := buildMessage(s.from, msg, time.Now())
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(s.addr, s.auth, s.from.Address, []string{msg.To}, body)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("erro ao enviar email via SMTP: %w", err)
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.logger.Info("Email enviado",
+		zap.String("to", msg.To),
+		zap.String("subject", msg.Subject),
+	)
+	return nil
+}
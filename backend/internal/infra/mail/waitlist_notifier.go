@@ -0,0 +1,65 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+)
+
+// caminhoOfertaListaEspera página do frontend que confirma ou recusa a oferta
+const caminhoOfertaListaEspera = "/lista-de-espera/oferta"
+
+// fusoBrasilia horário exibido nos emails (sem horário de verão desde 2019)
+var fusoBrasilia = time.FixedZone("America/Sao_Paulo", -3*60*60)
+
+// ErrNoRecipient cliente sem email cadastrado
+var ErrNoRecipient = errors.New("cliente sem email cadastrado")
+
+// WaitlistNotifier implementa port.WaitlistNotifier por email
+type WaitlistNotifier struct {
+	sender port.MailSender
+	appURL string
+}
+
+// NewWaitlistNotifier cria o notificador da lista de espera. appURL é a base
+// do link de confirmação em um clique.
+func NewWaitlistNotifier(sender port.MailSender, appURL string) *WaitlistNotifier {
+	return &WaitlistNotifier{
+		sender: sender,
+		appURL: appURL,
+	}
+}
+
+// NotifySlotOffer envia o email com o horário oferecido e o link
+func (n *WaitlistNotifier) NotifySlotOffer(ctx context.Context, offer port.WaitlistSlotOffer) error {
+	if offer.CustomerEmail == "" {
+		return ErrNoRecipient
+	}
+
+	link := strings.TrimRight(n.appURL, "/") + caminhoOfertaListaEspera + "?token=" + url.QueryEscape(offer.Token)
+	profissional := ""
+	if offer.ProfessionalName != "" {
+		profissional = " com " + offer.ProfessionalName
+	}
+	start := offer.StartTime.In(fusoBrasilia)
+
+	return n.sender.Send(ctx, port.EmailMessage{
+		To:      offer.CustomerEmail,
+		ToName:  offer.CustomerName,
+		Subject: "Abriu um horário para você",
+		Body: fmt.Sprintf(
+			"Olá, %s.\n\nVocê está na nossa lista de espera e abriu um horário%s em %s, às %s.\n\nPara confirmar, acesse o link abaixo:\n\n%s\n\nA oferta vale até %s; depois disso o horário é oferecido ao próximo cliente da lista. Se não puder ir, use o mesmo link para recusar: você continua na lista de espera.\n",
+			offer.CustomerName,
+			profissional,
+			start.Format("02/01/2006"),
+			start.Format("15:04"),
+			link,
+			offer.ExpiresAt.In(fusoBrasilia).Format("02/01 15:04"),
+		),
+	})
+}
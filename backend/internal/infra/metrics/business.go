@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// =============================================================================
+// MÉTRICAS DE NEGÓCIO
+// Cardinalidade controlada: rótulos só com tenant_id, unit_id e conjuntos
+// fixos (tipo do meio de pagamento, resultado, job). Nada de IDs de comanda,
+// cliente ou profissional. Os gauges são recalculados a partir do banco pelo
+// BusinessMetricsRefresher, então valem igual em todas as réplicas (agregue
+// com max) e sobrevivem a reinícios.
+// =============================================================================
+
+// SemUnidade é o rótulo usado quando o registro não tem unidade associada
+const SemUnidade = "sem_unidade"
+
+// formasPagamento são os tipos aceitos em meios_pagamento.tipo
+var formasPagamento = map[string]bool{
+	"DINHEIRO": true, "PIX": true, "CREDITO": true, "DEBITO": true,
+	"TRANSFERENCIA": true, "BOLETO": true, "OUTRO": true,
+}
+
+var (
+	ComandasFechadasHoje = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_comandas_fechadas_hoje",
+			Help: "Comandas fechadas no dia corrente (America/Sao_Paulo)",
+		},
+		[]string{"tenant_id", "unit_id"},
+	)
+
+	ReceitaHojeReais = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_receita_hoje_reais",
+			Help: "Receita recebida em comandas fechadas no dia, por tipo de meio de pagamento",
+		},
+		[]string{"tenant_id", "unit_id", "forma_pagamento"},
+	)
+
+	CaixaAbertoSegundos = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_caixa_aberto_segundos",
+			Help: "Tempo desde a abertura do caixa atualmente aberto",
+		},
+		[]string{"tenant_id"},
+	)
+
+	CaixaFechamentosDivergentesHoje = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_caixa_fechamentos_divergentes_hoje",
+			Help: "Fechamentos de caixa com divergência no dia corrente",
+		},
+		[]string{"tenant_id"},
+	)
+
+	CaixaDivergenciaHojeReais = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_caixa_divergencia_hoje_reais",
+			Help: "Soma absoluta das divergências de fechamento de caixa no dia corrente",
+		},
+		[]string{"tenant_id"},
+	)
+
+	AgendamentosNoShowTaxa = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_agendamentos_no_show_taxa",
+			Help: "Faltas / (concluídos + faltas) nos últimos 7 dias (0 a 1)",
+		},
+		[]string{"tenant_id", "unit_id"},
+	)
+
+	AssinaturasInadimplentes = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "bap_assinaturas_inadimplentes",
+			Help: "Assinaturas de clientes com status INADIMPLENTE",
+		},
+		[]string{"tenant_id"},
+	)
+
+	// WebhooksTotal conta webhooks recebidos por provedor e resultado
+	// (processado, falha, token_invalido, payload_invalido)
+	WebhooksTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "bap_webhooks_total",
+			Help: "Webhooks recebidos por provedor e resultado do processamento",
+		},
+		[]string{"provider", "resultado"},
+	)
+
+	BusinessMetricsLastRefreshTimestamp = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "bap_business_metrics_last_refresh_timestamp",
+			Help: "Timestamp Unix da última atualização bem-sucedida das métricas de negócio",
+		},
+	)
+)
+
+// Resultados de processamento de webhook
+const (
+	WebhookProcessado      = "processado"
+	WebhookFalha           = "falha"
+	WebhookTokenInvalido   = "token_invalido"
+	WebhookPayloadInvalido = "payload_invalido"
+)
+
+// FormaPagamento normaliza o tipo do meio de pagamento para o conjunto fixo
+func FormaPagamento(tipo string) string {
+	if formasPagamento[tipo] {
+		return tipo
+	}
+	return "OUTRO"
+}
+
+func unidade(unitID string) string {
+	if unitID == "" {
+		return SemUnidade
+	}
+	return unitID
+}
+
+// BusinessMetricsRefresher recalcula os gauges de negócio a partir do banco
+type BusinessMetricsRefresher struct {
+	queries *db.Queries
+}
+
+// NewBusinessMetricsRefresher cria o refresher das métricas de negócio
+func NewBusinessMetricsRefresher(queries *db.Queries) *BusinessMetricsRefresher {
+	return &BusinessMetricsRefresher{queries: queries}
+}
+
+// Refresh consulta os agregados e substitui os valores dos gauges (séries de
+// tenants/unidades sem dados no período deixam de ser exportadas)
+func (r *BusinessMetricsRefresher) Refresh(ctx context.Context) error {
+	comandas, err := r.queries.MetricsComandasFechadasHoje(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar comandas fechadas: %w", err)
+	}
+	receita, err := r.queries.MetricsReceitaHojePorFormaPagamento(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar receita: %w", err)
+	}
+	caixas, err := r.queries.MetricsCaixasAbertos(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar caixas abertos: %w", err)
+	}
+	divergencias, err := r.queries.MetricsDivergenciaCaixaHoje(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar divergências de caixa: %w", err)
+	}
+	noShow, err := r.queries.MetricsNoShowUltimos7Dias(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar faltas: %w", err)
+	}
+	inadimplentes, err := r.queries.MetricsAssinaturasInadimplentes(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao consultar assinaturas inadimplentes: %w", err)
+	}
+
+	ComandasFechadasHoje.Reset()
+	for _, row := range comandas {
+		ComandasFechadasHoje.WithLabelValues(row.TenantID.String(), unidade(row.UnitID)).Set(float64(row.Total))
+	}
+
+	ReceitaHojeReais.Reset()
+	for _, row := range receita {
+		ReceitaHojeReais.WithLabelValues(row.TenantID.String(), unidade(row.UnitID), FormaPagamento(row.FormaPagamento)).Add(row.Total)
+	}
+
+	CaixaAbertoSegundos.Reset()
+	for _, row := range caixas {
+		CaixaAbertoSegundos.WithLabelValues(row.TenantID.String()).Set(row.AbertoSegundos)
+	}
+
+	CaixaFechamentosDivergentesHoje.Reset()
+	CaixaDivergenciaHojeReais.Reset()
+	for _, row := range divergencias {
+		CaixaFechamentosDivergentesHoje.WithLabelValues(row.TenantID.String()).Set(float64(row.Fechamentos))
+		CaixaDivergenciaHojeReais.WithLabelValues(row.TenantID.String()).Set(row.DivergenciaTotal)
+	}
+
+	AgendamentosNoShowTaxa.Reset()
+	for _, row := range noShow {
+		if row.Total == 0 {
+			continue
+		}
+		AgendamentosNoShowTaxa.WithLabelValues(row.TenantID.String(), unidade(row.UnitID)).Set(float64(row.NoShow) / float64(row.Total))
+	}
+
+	AssinaturasInadimplentes.Reset()
+	for _, row := range inadimplentes {
+		AssinaturasInadimplentes.WithLabelValues(row.TenantID.String()).Set(float64(row.Total))
+	}
+
+	BusinessMetricsLastRefreshTimestamp.SetToCurrentTime()
+	return nil
+}
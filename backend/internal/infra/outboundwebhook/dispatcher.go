@@ -0,0 +1,292 @@
+package outboundwebhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/telemetry"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// Status das entregas
+const (
+	StatusPendente = "PENDENTE"
+	StatusEntregue = "ENTREGUE"
+	StatusFalhou   = "FALHOU"
+)
+
+// RetryBackoff é o intervalo antes de cada nova tentativa. Esgotada a lista
+// (7 tentativas em ~21h), a entrega fica FALHOU e só volta por reenvio manual.
+var RetryBackoff = []time.Duration{
+	time.Minute,
+	5 * time.Minute,
+	30 * time.Minute,
+	2 * time.Hour,
+	6 * time.Hour,
+	12 * time.Hour,
+}
+
+const (
+	defaultBatchSize   = 50
+	defaultWorkers     = 5
+	defaultHTTPTimeout = 10 * time.Second
+	// leaseDuration cobre o pior caso de um lote (batch/workers * timeout)
+	leaseDuration = 2 * time.Minute
+	// maxResponseBytes limita o trecho da resposta gravado por tentativa
+	maxResponseBytes = 1024
+)
+
+// deliveryStore são as queries usadas pelo Dispatcher
+type deliveryStore interface {
+	ClaimWebhookDeliveries(ctx context.Context, arg db.ClaimWebhookDeliveriesParams) ([]db.ClaimWebhookDeliveriesRow, error)
+	CreateWebhookDeliveryAttempt(ctx context.Context, arg db.CreateWebhookDeliveryAttemptParams) error
+	UpdateWebhookDeliveryResult(ctx context.Context, arg db.UpdateWebhookDeliveryResultParams) error
+}
+
+// Dispatcher envia as entregas pendentes. Várias réplicas podem rodar o job
+// ao mesmo tempo: cada entrega é reservada com FOR UPDATE SKIP LOCKED.
+type Dispatcher struct {
+	store     deliveryStore
+	cipher    *auth.SecretCipher
+	client    *http.Client
+	logger    *zap.Logger
+	batchSize int32
+	workers   int
+	now       func() time.Time
+}
+
+// NewDispatcher cria o dispatcher. Endpoints em redes privadas, loopback ou
+// link-local são recusados, exceto com WEBHOOK_ALLOW_PRIVATE_NETWORKS=true
+// (desenvolvimento).
+func NewDispatcher(queries *db.Queries, cipher *auth.SecretCipher, logger *zap.Logger) *Dispatcher {
+	allowPrivate, _ := strconv.ParseBool(os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"))
+	return &Dispatcher{
+		store:     queries,
+		cipher:    cipher,
+		client:    newHTTPClient(allowPrivate),
+		logger:    logger,
+		batchSize: defaultBatchSize,
+		workers:   defaultWorkers,
+		now:       time.Now,
+	}
+}
+
+// newHTTPClient não segue redirecionamentos e, opcionalmente, bloqueia a
+// conexão com IPs internos (resolvidos no momento do dial, o que também
+// cobre DNS apontando para a rede interna)
+func newHTTPClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	if !allowPrivate {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternalIP(ip) {
+				return fmt.Errorf("endereço %s não permitido para webhooks", host)
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	transport.Proxy = nil
+	return &http.Client{
+		Timeout:   defaultHTTPTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func isInternalIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast()
+}
+
+// DeliverPending envia um lote de entregas vencidas
+func (d *Dispatcher) DeliverPending(ctx context.Context) error {
+	rows, err := d.store.ClaimWebhookDeliveries(ctx, db.ClaimWebhookDeliveriesParams{
+		Limite:        d.batchSize,
+		LeaseSegundos: leaseDuration.Seconds(),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao reservar entregas de webhook: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+
+	jobs := make(chan db.ClaimWebhookDeliveriesRow)
+	var wg sync.WaitGroup
+	for i := 0; i < d.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for row := range jobs {
+				d.deliver(ctx, row)
+			}
+		}()
+	}
+	for _, row := range rows {
+		jobs <- row
+	}
+	close(jobs)
+	wg.Wait()
+	return nil
+}
+
+// attemptResult é o resultado de um POST ao endpoint
+type attemptResult struct {
+	statusHTTP *int32
+	resposta   *string
+	err        error
+	duracao    time.Duration
+}
+
+func (r attemptResult) ok() bool {
+	return r.err == nil && r.statusHTTP != nil && *r.statusHTTP >= 200 && *r.statusHTTP < 300
+}
+
+// deliver envia uma entrega, grava a tentativa e agenda a próxima, se houver
+func (d *Dispatcher) deliver(ctx context.Context, row db.ClaimWebhookDeliveriesRow) {
+	ctx, span := telemetry.Tracer().Start(ctx, "webhook "+row.Evento,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("webhook.delivery_id", row.ID.String()),
+			attribute.String("webhook.endpoint_id", row.EndpointID.String()),
+		),
+	)
+	defer span.End()
+	logger := telemetry.Logger(ctx, d.logger).With(
+		zap.String("delivery_id", row.ID.String()),
+		zap.String("evento", row.Evento),
+	)
+
+	tentativa := row.Tentativas + 1
+	var result attemptResult
+	secret, err := d.cipher.Decrypt(row.SecretCifrado)
+	if err != nil {
+		result = attemptResult{err: err}
+	} else {
+		result = d.send(ctx, row, secret)
+	}
+	if result.statusHTTP != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", int(*result.statusHTTP)))
+	}
+
+	var erro *string
+	if !result.ok() {
+		msg := describeFailure(result)
+		erro = &msg
+		telemetry.RecordError(span, errors.New(msg))
+	}
+
+	if err := d.store.CreateWebhookDeliveryAttempt(ctx, db.CreateWebhookDeliveryAttemptParams{
+		DeliveryID: row.ID,
+		Tentativa:  tentativa,
+		StatusHttp: result.statusHTTP,
+		Erro:       erro,
+		Resposta:   result.resposta,
+		DuracaoMs:  int32(result.duracao.Milliseconds()),
+	}); err != nil {
+		logger.Error("Erro ao registrar tentativa de webhook", zap.Error(err))
+	}
+
+	params := db.UpdateWebhookDeliveryResultParams{
+		ID:               row.ID,
+		Tentativas:       tentativa,
+		UltimoStatusHttp: result.statusHTTP,
+		UltimoErro:       erro,
+	}
+	now := d.now()
+	switch {
+	case result.ok():
+		params.Status = StatusEntregue
+		params.ProximaTentativaEm = pgtype.Timestamptz{Time: now, Valid: true}
+	case int(tentativa) <= len(RetryBackoff):
+		params.Status = StatusPendente
+		params.ProximaTentativaEm = pgtype.Timestamptz{Time: now.Add(RetryBackoff[tentativa-1]), Valid: true}
+	default:
+		params.Status = StatusFalhou
+		params.ProximaTentativaEm = pgtype.Timestamptz{Time: now, Valid: true}
+	}
+
+	if err := d.store.UpdateWebhookDeliveryResult(ctx, params); err != nil {
+		logger.Error("Erro ao atualizar entrega de webhook", zap.Error(err))
+		return
+	}
+
+	switch params.Status {
+	case StatusEntregue:
+		logger.Debug("Webhook entregue", zap.Int32("tentativa", tentativa))
+	case StatusPendente:
+		logger.Warn("Falha ao entregar webhook, nova tentativa agendada",
+			zap.Int32("tentativa", tentativa),
+			zap.Time("proxima_tentativa_em", params.ProximaTentativaEm.Time),
+			zap.String("erro", *erro),
+		)
+	default:
+		logger.Error("Webhook descartado após esgotar as tentativas",
+			zap.Int32("tentativa", tentativa),
+			zap.String("erro", *erro),
+		)
+	}
+}
+
+// send faz o POST assinado do payload
+func (d *Dispatcher) send(ctx context.Context, row db.ClaimWebhookDeliveriesRow, secret string) attemptResult {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, row.Url, bytes.NewReader(row.Payload))
+	if err != nil {
+		return attemptResult{err: err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "BarberAnalytics-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, row.Evento)
+	req.Header.Set(HeaderDelivery, row.ID.String())
+	req.Header.Set(HeaderSignature, Sign(secret, d.now(), row.Payload))
+
+	start := time.Now()
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return attemptResult{err: err, duracao: time.Since(start)}
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	status := int32(resp.StatusCode)
+	result := attemptResult{statusHTTP: &status, duracao: time.Since(start)}
+	if len(body) > 0 {
+		// Corte no limite pode partir um caractere; TEXT exige UTF-8 válido
+		s := strings.ToValidUTF8(string(body), "\uFFFD")
+		result.resposta = &s
+	}
+	return result
+}
+
+func describeFailure(r attemptResult) string {
+	if r.err != nil {
+		return r.err.Error()
+	}
+	if r.statusHTTP != nil {
+		return fmt.Sprintf("endpoint respondeu HTTP %d", *r.statusHTTP)
+	}
+	return "falha desconhecida"
+}
@@ -0,0 +1,144 @@
+package outboundwebhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeStore struct {
+	mu       sync.Mutex
+	claimed  []db.ClaimWebhookDeliveriesRow
+	attempts []db.CreateWebhookDeliveryAttemptParams
+	results  []db.UpdateWebhookDeliveryResultParams
+}
+
+func (f *fakeStore) ClaimWebhookDeliveries(context.Context, db.ClaimWebhookDeliveriesParams) ([]db.ClaimWebhookDeliveriesRow, error) {
+	rows := f.claimed
+	f.claimed = nil
+	return rows, nil
+}
+
+func (f *fakeStore) CreateWebhookDeliveryAttempt(_ context.Context, arg db.CreateWebhookDeliveryAttemptParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.attempts = append(f.attempts, arg)
+	return nil
+}
+
+func (f *fakeStore) UpdateWebhookDeliveryResult(_ context.Context, arg db.UpdateWebhookDeliveryResultParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.results = append(f.results, arg)
+	return nil
+}
+
+func novaEntrega(t *testing.T, cipher *auth.SecretCipher, url, secret string, tentativas int32) db.ClaimWebhookDeliveriesRow {
+	t.Helper()
+	enc, err := cipher.Encrypt(secret)
+	require.NoError(t, err)
+	return db.ClaimWebhookDeliveriesRow{
+		ID:            pgtype.UUID{Bytes: uuid.New(), Valid: true},
+		Evento:        "appointment.created",
+		Payload:       []byte(`{"id":"evt","type":"appointment.created"}`),
+		Tentativas:    tentativas,
+		Url:           url,
+		SecretCifrado: enc,
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	body := []byte(`{"a":1}`)
+	header := Sign("whsec_x", now, body)
+
+	assert.True(t, Verify("whsec_x", header, body, now.Add(time.Minute), 5*time.Minute))
+	assert.False(t, Verify("whsec_y", header, body, now, 5*time.Minute))
+	assert.False(t, Verify("whsec_x", header, []byte(`{"a":2}`), now, 5*time.Minute))
+	assert.False(t, Verify("whsec_x", header, body, now.Add(10*time.Minute), 5*time.Minute))
+}
+
+func TestDispatcher_EntregaAssinadaERetentativa(t *testing.T) {
+	var recebido []byte
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/falha" {
+			http.Error(w, "indisponível", http.StatusServiceUnavailable)
+			return
+		}
+		recebido, _ = io.ReadAll(r.Body)
+		if !Verify("whsec_ok", r.Header.Get(HeaderSignature), recebido, time.Now(), time.Minute) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, "appointment.created", r.Header.Get(HeaderEvent))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	cipher, err := auth.NewSecretCipher()
+	require.NoError(t, err)
+	now := time.Now()
+	ok := novaEntrega(t, cipher, srv.URL+"/ok", "whsec_ok", 0)
+	falha := novaEntrega(t, cipher, srv.URL+"/falha", "whsec_ok", 1)
+	esgotada := novaEntrega(t, cipher, srv.URL+"/falha", "whsec_ok", int32(len(RetryBackoff)))
+
+	store := &fakeStore{claimed: []db.ClaimWebhookDeliveriesRow{ok, falha, esgotada}}
+	d := &Dispatcher{
+		store:     store,
+		cipher:    cipher,
+		client:    srv.Client(),
+		logger:    zap.NewNop(),
+		batchSize: 10,
+		workers:   2,
+		now:       func() time.Time { return now },
+	}
+	require.NoError(t, d.DeliverPending(context.Background()))
+
+	assert.JSONEq(t, string(ok.Payload), string(recebido))
+	require.Len(t, store.attempts, 3)
+	require.Len(t, store.results, 3)
+
+	porID := map[pgtype.UUID]db.UpdateWebhookDeliveryResultParams{}
+	for _, r := range store.results {
+		porID[r.ID] = r
+	}
+
+	assert.Equal(t, StatusEntregue, porID[ok.ID].Status)
+	assert.Equal(t, int32(1), porID[ok.ID].Tentativas)
+
+	// Segunda tentativa falhou: próxima após o segundo intervalo
+	r := porID[falha.ID]
+	assert.Equal(t, StatusPendente, r.Status)
+	assert.Equal(t, int32(2), r.Tentativas)
+	assert.Equal(t, now.Add(RetryBackoff[1]), r.ProximaTentativaEm.Time)
+	require.NotNil(t, r.UltimoStatusHttp)
+	assert.Equal(t, int32(http.StatusServiceUnavailable), *r.UltimoStatusHttp)
+
+	assert.Equal(t, StatusFalhou, porID[esgotada.ID].Status)
+}
+
+func TestNewHTTPClient_BloqueiaRedeInterna(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	_, err := newHTTPClient(false).Get(srv.URL)
+	assert.Error(t, err)
+
+	resp, err := newHTTPClient(true).Get(srv.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
@@ -0,0 +1,104 @@
+package outboundwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// WEBHOOKS DE SAÍDA
+// Os use cases publicam eventos de domínio; o Publisher grava uma entrega por
+// endpoint inscrito (outbox em webhook_deliveries) e o Dispatcher, chamado pelo
+// scheduler, envia as entregas assinadas com HMAC e agenda as retentativas.
+// =============================================================================
+
+// Envelope é o corpo JSON enviado aos endpoints. ID é o mesmo em todas as
+// entregas do evento e serve de chave de idempotência no receptor.
+type Envelope struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	TenantID   string         `json:"tenant_id"`
+	UnitID     string         `json:"unit_id,omitempty"`
+	OccurredAt string         `json:"occurred_at"`
+	Data       map[string]any `json:"data"`
+}
+
+// Publisher implementa port.EventPublisher sobre a tabela de entregas
+type Publisher struct {
+	queries *db.Queries
+	logger  *zap.Logger
+}
+
+// NewPublisher cria o publisher dos webhooks de saída
+func NewPublisher(queries *db.Queries, logger *zap.Logger) *Publisher {
+	return &Publisher{queries: queries, logger: logger}
+}
+
+var _ port.EventPublisher = (*Publisher)(nil)
+
+// Publish enfileira o evento para os endpoints ativos do tenant inscritos nele
+func (p *Publisher) Publish(ctx context.Context, evt port.DomainEvent) error {
+	var tenantUUID pgtype.UUID
+	if err := tenantUUID.Scan(evt.TenantID); err != nil {
+		return fmt.Errorf("tenant inválido no evento %s: %w", evt.Type, err)
+	}
+
+	endpoints, err := p.queries.ListWebhookEndpointsForEvent(ctx, db.ListWebhookEndpointsForEventParams{
+		TenantID: tenantUUID,
+		Evento:   string(evt.Type),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao buscar endpoints de webhook: %w", err)
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	eventID := uuid.New()
+	occurredAt := evt.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	data := evt.Data
+	if data == nil {
+		data = map[string]any{}
+	}
+	payload, err := json.Marshal(Envelope{
+		ID:         eventID.String(),
+		Type:       string(evt.Type),
+		TenantID:   evt.TenantID,
+		UnitID:     evt.UnitID,
+		OccurredAt: occurredAt.UTC().Format(time.RFC3339),
+		Data:       data,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao serializar evento %s: %w", evt.Type, err)
+	}
+
+	for _, ep := range endpoints {
+		if err := p.queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+			TenantID:   tenantUUID,
+			EndpointID: ep.ID,
+			Evento:     string(evt.Type),
+			EventID:    pgtype.UUID{Bytes: eventID, Valid: true},
+			Payload:    payload,
+		}); err != nil {
+			return fmt.Errorf("erro ao enfileirar webhook: %w", err)
+		}
+	}
+
+	p.logger.Debug("Evento enfileirado para webhooks",
+		zap.String("evento", string(evt.Type)),
+		zap.String("event_id", eventID.String()),
+		zap.Int("endpoints", len(endpoints)),
+	)
+	return nil
+}
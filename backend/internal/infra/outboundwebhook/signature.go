@@ -0,0 +1,74 @@
+package outboundwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+)
+
+// Headers enviados em cada entrega
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// SecretPrefix identifica os segredos de assinatura
+const SecretPrefix = "whsec_"
+
+// GenerateSecret gera o segredo HMAC de um endpoint
+func GenerateSecret() (string, error) {
+	token, err := auth.GenerateOpaqueToken()
+	if err != nil {
+		return "", err
+	}
+	return SecretPrefix + token, nil
+}
+
+// Sign monta o header de assinatura "t=<unix>,v1=<hex>", onde v1 é o
+// HMAC-SHA256 de "<unix>.<corpo>" com o segredo do endpoint. O timestamp
+// assinado permite ao receptor rejeitar reenvios antigos (replay).
+func Sign(secret string, ts time.Time, body []byte) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return fmt.Sprintf("t=%s,v1=%s", t, computeHMAC(secret, t, body))
+}
+
+// Verify confere a assinatura e a tolerância do timestamp. É o que o
+// receptor deve implementar; usado também nos testes.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) bool {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch k {
+		case "t":
+			t = v
+		case "v1":
+			v1 = v
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || v1 == "" {
+		return false
+	}
+	if d := now.Sub(time.Unix(unix, 0)); d > tolerance || d < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(v1), []byte(computeHMAC(secret, t, body)))
+}
+
+func computeHMAC(secret, t string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
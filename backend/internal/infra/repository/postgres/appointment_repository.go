@@ -3,7 +3,9 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
@@ -12,11 +14,24 @@ import (
 	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
 	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/shopspring/decimal"
 )
 
+// appointmentOverlapConstraint impede que o mesmo profissional tenha dois
+// serviços ativos sobrepostos (migration 077, que substituiu a constraint
+// por agendamento da migration 073)
+const appointmentOverlapConstraint = "appointment_services_no_overlap"
+
+// isAppointmentOverlap identifica a violação da exclusion constraint
+// (23P01), que resolve no banco a corrida entre duas reservas simultâneas
+func isAppointmentOverlap(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23P01" && pgErr.ConstraintName == appointmentOverlapConstraint
+}
+
 // AppointmentRepository implementa port.AppointmentRepository usando sqlc.
 type AppointmentRepository struct {
 	queries *db.Queries
@@ -60,21 +75,33 @@ func (r *AppointmentRepository) Create(ctx context.Context, appointment *entity.
 
 	result, err := qtx.CreateAppointment(ctx, params)
 	if err != nil {
+		if isAppointmentOverlap(err) {
+			return domain.ErrAppointmentConflict
+		}
 		return fmt.Errorf("erro ao criar agendamento: %w", err)
 	}
 
 	// 2. Criar os serviços do agendamento
-	for _, svc := range appointment.Services {
-		svcParams := db.CreateAppointmentServiceParams{
-			AppointmentID:     uuidStringToPgtype(appointment.ID),
-			ServiceID:         uuidStringToPgtype(svc.ServiceID),
-			PriceAtBooking:    svc.PriceAtBooking.Value(),
-			DurationAtBooking: int32(svc.DurationAtBooking),
-		}
-		if err := qtx.CreateAppointmentService(ctx, svcParams); err != nil {
-			return fmt.Errorf("erro ao criar serviço do agendamento: %w", err)
+	if err := createAppointmentServices(ctx, qtx, appointment); err != nil {
+		return err
+	}
+
+	// 3. Reservar os recursos da unidade exigidos pelos serviços
+	for _, al := range appointment.Resources {
+		if err := qtx.CreateAppointmentResource(ctx, db.CreateAppointmentResourceParams{
+			TenantID:        entityUUIDToPgtype(appointment.TenantID),
+			AppointmentID:   uuidStringToPgtype(appointment.ID),
+			ServiceID:       uuidStringToPgtype(al.ServiceID),
+			ResourceID:      uuidStringToPgtype(al.ResourceID),
+			OffsetMinutes:   int32(al.OffsetMinutes),
+			DurationMinutes: intToPgMinutes(al.DurationMinutes),
+		}); err != nil {
+			return fmt.Errorf("erro ao reservar recurso do agendamento: %w", err)
 		}
 	}
+	if err := checkResourceCapacity(ctx, qtx, appointment); err != nil {
+		return err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("erro ao confirmar transação: %w", err)
@@ -109,11 +136,36 @@ func (r *AppointmentRepository) FindByID(ctx context.Context, tenantID, unitID,
 		return nil, fmt.Errorf("erro ao buscar serviços do agendamento: %w", err)
 	}
 
-	return r.rowToDomain(&row, services), nil
+	// Buscar recursos reservados
+	resources, err := r.queries.ListAppointmentResources(ctx, uuidStringToPgtype(id))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar recursos do agendamento: %w", err)
+	}
+
+	appointment := r.rowToDomain(&row, services)
+	for _, res := range resources {
+		appointment.Resources = append(appointment.Resources, entity.ResourceAllocation{
+			ServiceID:       pgUUIDToString(res.ServiceID),
+			ResourceID:      pgUUIDToString(res.ResourceID),
+			OffsetMinutes:   int(res.OffsetMinutes),
+			DurationMinutes: pgMinutesToInt(res.DurationMinutes),
+			ResourceName:    res.ResourceName,
+		})
+	}
+	return appointment, nil
 }
 
-// Update atualiza um agendamento existente.
+// Update atualiza um agendamento existente e regrava os serviços com o
+// profissional e o horário de cada um (transação).
 func (r *AppointmentRepository) Update(ctx context.Context, appointment *entity.Appointment) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
 	params := db.UpdateAppointmentParams{
 		ID:                    uuidStringToPgtype(appointment.ID),
 		TenantID:              entityUUIDToPgtype(appointment.TenantID),
@@ -131,14 +183,43 @@ func (r *AppointmentRepository) Update(ctx context.Context, appointment *entity.
 		CommandID:             uuidStrPtrToPgtype(appointment.CommandID),
 	}
 
-	result, err := r.queries.UpdateAppointment(ctx, params)
+	result, err := qtx.UpdateAppointment(ctx, params)
 	if err != nil {
 		if err == pgx.ErrNoRows {
 			return domain.ErrAppointmentNotFound
 		}
+		if isAppointmentOverlap(err) {
+			return domain.ErrAppointmentConflict
+		}
 		return fmt.Errorf("erro ao atualizar agendamento: %w", err)
 	}
 
+	// Os serviços são removidos e recriados: assim a exclusion constraint
+	// não compara o horário novo com o antigo do próprio agendamento
+	if len(appointment.Services) > 0 {
+		previous, err := qtx.GetAppointmentServices(ctx, uuidStringToPgtype(appointment.ID))
+		if err != nil {
+			return fmt.Errorf("erro ao buscar serviços do agendamento: %w", err)
+		}
+		if err := qtx.DeleteAppointmentServices(ctx, uuidStringToPgtype(appointment.ID)); err != nil {
+			return fmt.Errorf("erro ao atualizar serviços do agendamento: %w", err)
+		}
+		if err := createAppointmentServices(ctx, qtx, appointment); err != nil {
+			return err
+		}
+		// Só remarcações disputam os recursos de novo: mudanças de status
+		// não podem falhar porque a capacidade do recurso foi reduzida
+		if servicesRescheduled(previous, appointment.Services) {
+			if err := checkResourceCapacity(ctx, qtx, appointment); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+
 	appointment.UpdatedAt = timestamptzToTime(result.UpdatedAt)
 	return nil
 }
@@ -221,18 +302,7 @@ func (r *AppointmentRepository) List(ctx context.Context, tenantID string, filte
 		}
 
 		// Mapear serviços por appointment_id
-		servicesByAppointment := make(map[string][]entity.AppointmentService)
-		for _, svc := range services {
-			apptID := pgUUIDToString(svc.AppointmentID)
-			servicesByAppointment[apptID] = append(servicesByAppointment[apptID], entity.AppointmentService{
-				AppointmentID:     apptID,
-				ServiceID:         pgUUIDToString(svc.ServiceID),
-				PriceAtBooking:    valueobject.NewMoneyFromDecimal(svc.PriceAtBooking),
-				DurationAtBooking: int(svc.DurationAtBooking),
-				CreatedAt:         timestamptzToTime(svc.CreatedAt),
-				ServiceName:       svc.ServiceName,
-			})
-		}
+		servicesByAppointment := groupAppointmentServices(services)
 
 		// Atribuir serviços aos agendamentos
 		for _, appt := range appointments {
@@ -280,18 +350,7 @@ func (r *AppointmentRepository) ListByProfessionalAndDateRange(
 			return nil, fmt.Errorf("erro ao buscar serviços dos agendamentos: %w", err)
 		}
 
-		servicesByAppointment := make(map[string][]entity.AppointmentService)
-		for _, svc := range services {
-			apptID := pgUUIDToString(svc.AppointmentID)
-			servicesByAppointment[apptID] = append(servicesByAppointment[apptID], entity.AppointmentService{
-				AppointmentID:     apptID,
-				ServiceID:         pgUUIDToString(svc.ServiceID),
-				PriceAtBooking:    valueobject.NewMoneyFromDecimal(svc.PriceAtBooking),
-				DurationAtBooking: int(svc.DurationAtBooking),
-				CreatedAt:         timestamptzToTime(svc.CreatedAt),
-				ServiceName:       svc.ServiceName,
-			})
-		}
+		servicesByAppointment := groupAppointmentServices(services)
 
 		for _, appt := range appointments {
 			if svcs, ok := servicesByAppointment[appt.ID]; ok {
@@ -330,18 +389,7 @@ func (r *AppointmentRepository) ListByCustomer(ctx context.Context, tenantID, un
 			return nil, fmt.Errorf("erro ao buscar serviços dos agendamentos: %w", err)
 		}
 
-		servicesByAppointment := make(map[string][]entity.AppointmentService)
-		for _, svc := range services {
-			apptID := pgUUIDToString(svc.AppointmentID)
-			servicesByAppointment[apptID] = append(servicesByAppointment[apptID], entity.AppointmentService{
-				AppointmentID:     apptID,
-				ServiceID:         pgUUIDToString(svc.ServiceID),
-				PriceAtBooking:    valueobject.NewMoneyFromDecimal(svc.PriceAtBooking),
-				DurationAtBooking: int(svc.DurationAtBooking),
-				CreatedAt:         timestamptzToTime(svc.CreatedAt),
-				ServiceName:       svc.ServiceName,
-			})
-		}
+		servicesByAppointment := groupAppointmentServices(services)
 
 		for _, appt := range appointments {
 			if svcs, ok := servicesByAppointment[appt.ID]; ok {
@@ -371,7 +419,7 @@ func (r *AppointmentRepository) CheckConflict(
 		TenantID:       uuidStringToPgtype(tenantID),
 		UnitID:         uuidStringToPgtype(unitID),
 		ProfessionalID: uuidStringToPgtype(professionalID),
-		ID:             excludeID,
+		ExcludeID:      excludeID,
 		StartTime:      timestampToTimestamptz(startTime),
 		EndTime:        timestampToTimestamptz(endTime),
 	}
@@ -496,6 +544,123 @@ func (r *AppointmentRepository) GetDailyStats(ctx context.Context, tenantID, uni
 	}, nil
 }
 
+// createAppointmentServices grava os serviços do agendamento. O serviço só
+// ocupa a agenda do profissional enquanto o agendamento está ativo.
+func createAppointmentServices(ctx context.Context, qtx *db.Queries, appointment *entity.Appointment) error {
+	active := appointment.Status != valueobject.AppointmentStatusCanceled &&
+		appointment.Status != valueobject.AppointmentStatusNoShow
+	for _, svc := range appointment.Services {
+		svcParams := db.CreateAppointmentServiceParams{
+			AppointmentID:     uuidStringToPgtype(appointment.ID),
+			ServiceID:         uuidStringToPgtype(svc.ServiceID),
+			PriceAtBooking:    svc.PriceAtBooking.Value(),
+			DurationAtBooking: int32(svc.DurationAtBooking),
+			ProfessionalID:    uuidStringToPgtype(svc.ProfessionalID),
+			Sequence:          int32(svc.Sequence),
+			StartTime:         timestampToTimestamptz(svc.StartTime),
+			EndTime:           timestampToTimestamptz(svc.EndTime),
+			Active:            active,
+		}
+		if err := qtx.CreateAppointmentService(ctx, svcParams); err != nil {
+			if isAppointmentOverlap(err) {
+				return domain.ErrAppointmentConflict
+			}
+			return fmt.Errorf("erro ao criar serviço do agendamento: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkResourceCapacity confere, dentro da transação que gravou o
+// agendamento, se os recursos reservados continuam dentro da capacidade.
+// Cada recurso fica travado até o fim da transação, então reservas
+// simultâneas do mesmo recurso são conferidas uma de cada vez.
+func checkResourceCapacity(ctx context.Context, qtx *db.Queries, appointment *entity.Appointment) error {
+	windows := appointment.ResourceWindows()
+	if len(windows) == 0 || !appointment.IsActive() {
+		return nil
+	}
+
+	// Trava em ordem fixa para evitar deadlock entre reservas concorrentes
+	ids := make([]string, 0, len(windows))
+	for _, w := range windows {
+		ids = append(ids, w.ResourceID)
+	}
+	sort.Strings(ids)
+
+	tenantID := appointment.TenantID.String()
+	capacity := make(map[string]int, len(ids))
+	for _, id := range ids {
+		if _, ok := capacity[id]; ok {
+			continue
+		}
+		c, err := qtx.LockResourceCapacity(ctx, db.LockResourceCapacityParams{
+			ID:       uuidStringToPgtype(id),
+			TenantID: entityUUIDToPgtype(appointment.TenantID),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.ErrResourceNotFound
+			}
+			return fmt.Errorf("erro ao travar recurso: %w", err)
+		}
+		capacity[id] = int(c)
+	}
+
+	// O pico já inclui as reservas deste agendamento
+	for _, w := range windows {
+		peak, err := resourcePeakUsage(ctx, qtx, tenantID, w.ResourceID, w.StartTime, w.EndTime, "")
+		if err != nil {
+			return err
+		}
+		if peak > capacity[w.ResourceID] {
+			return domain.ErrResourceUnavailable
+		}
+	}
+	return nil
+}
+
+// servicesRescheduled verifica se algum serviço mudou de horário
+func servicesRescheduled(previous []db.GetAppointmentServicesRow, services []entity.AppointmentService) bool {
+	if len(previous) != len(services) {
+		return true
+	}
+	starts := make(map[string]time.Time, len(previous))
+	for _, row := range previous {
+		starts[pgUUIDToString(row.ServiceID)] = timestamptzToTime(row.StartTime)
+	}
+	for _, svc := range services {
+		start, ok := starts[svc.ServiceID]
+		if !ok || !start.Equal(svc.StartTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupAppointmentServices agrupa por appointment_id os serviços carregados
+// de uma vez para vários agendamentos
+func groupAppointmentServices(services []db.GetServicesForAppointmentsRow) map[string][]entity.AppointmentService {
+	servicesByAppointment := make(map[string][]entity.AppointmentService)
+	for _, svc := range services {
+		apptID := pgUUIDToString(svc.AppointmentID)
+		servicesByAppointment[apptID] = append(servicesByAppointment[apptID], entity.AppointmentService{
+			AppointmentID:     apptID,
+			ServiceID:         pgUUIDToString(svc.ServiceID),
+			ProfessionalID:    pgUUIDToString(svc.ProfessionalID),
+			PriceAtBooking:    valueobject.NewMoneyFromDecimal(svc.PriceAtBooking),
+			DurationAtBooking: int(svc.DurationAtBooking),
+			Sequence:          int(svc.Sequence),
+			StartTime:         timestamptzToTime(svc.StartTime),
+			EndTime:           timestamptzToTime(svc.EndTime),
+			CreatedAt:         timestamptzToTime(svc.CreatedAt),
+			ServiceName:       svc.ServiceName,
+			ProfessionalName:  svc.ProfessionalName,
+		})
+	}
+	return servicesByAppointment
+}
+
 // === Métodos de conversão ===
 
 func (r *AppointmentRepository) rowToDomain(row *db.GetAppointmentByIDRow, services []db.GetAppointmentServicesRow) *entity.Appointment {
@@ -505,10 +670,15 @@ func (r *AppointmentRepository) rowToDomain(row *db.GetAppointmentByIDRow, servi
 		domainServices = append(domainServices, entity.AppointmentService{
 			AppointmentID:     pgUUIDToString(svc.AppointmentID),
 			ServiceID:         pgUUIDToString(svc.ServiceID),
+			ProfessionalID:    pgUUIDToString(svc.ProfessionalID),
 			PriceAtBooking:    valueobject.NewMoneyFromDecimal(svc.PriceAtBooking),
 			DurationAtBooking: int(svc.DurationAtBooking),
+			Sequence:          int(svc.Sequence),
+			StartTime:         timestamptzToTime(svc.StartTime),
+			EndTime:           timestamptzToTime(svc.EndTime),
 			CreatedAt:         timestamptzToTime(svc.CreatedAt),
 			ServiceName:       svc.ServiceName,
+			ProfessionalName:  svc.ProfessionalName,
 		})
 	}
 
@@ -0,0 +1,242 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AppointmentSeriesRepository implementa port.AppointmentSeriesRepository usando sqlc.
+type AppointmentSeriesRepository struct {
+	queries *db.Queries
+}
+
+// NewAppointmentSeriesRepository cria uma nova instância do repositório.
+func NewAppointmentSeriesRepository(queries *db.Queries) *AppointmentSeriesRepository {
+	return &AppointmentSeriesRepository{
+		queries: queries,
+	}
+}
+
+// Create cria a série.
+func (r *AppointmentSeriesRepository) Create(ctx context.Context, series *entity.AppointmentSeries) error {
+	serviceIDs := make([]pgtype.UUID, len(series.ServiceIDs))
+	for i, id := range series.ServiceIDs {
+		serviceIDs[i] = uuidStringToPgtype(id)
+	}
+
+	row, err := r.queries.CreateAppointmentSeries(ctx, db.CreateAppointmentSeriesParams{
+		ID:             uuidStringToPgtype(series.ID),
+		TenantID:       entityUUIDToPgtype(series.TenantID),
+		UnitID:         entityUUIDToPgtype(series.UnitID),
+		ProfessionalID: uuidStringToPgtype(series.ProfessionalID),
+		CustomerID:     uuidStringToPgtype(series.CustomerID),
+		ServiceIds:     serviceIDs,
+		StartTime:      timestampToTimestamptz(series.StartTime),
+		IntervalWeeks:  int32(series.IntervalWeeks),
+		EndsOn:         timePtrToDate(series.EndsOn),
+		Notes:          strPtrToPgText(series.Notes),
+		CreatedBy:      uuidStrPtrToPgtype(series.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao criar série de agendamentos: %w", err)
+	}
+
+	series.CreatedAt = timestamptzToTime(row.CreatedAt)
+	series.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindByID busca uma série do tenant.
+func (r *AppointmentSeriesRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.AppointmentSeries, error) {
+	row, err := r.queries.GetAppointmentSeries(ctx, db.GetAppointmentSeriesParams{
+		ID:       uuidStringToPgtype(id),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAppointmentSeriesNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar série de agendamentos: %w", err)
+	}
+	return seriesRowToDomain(row), nil
+}
+
+// Update atualiza horário, profissional, término, status e geração.
+func (r *AppointmentSeriesRepository) Update(ctx context.Context, series *entity.AppointmentSeries) error {
+	row, err := r.queries.UpdateAppointmentSeries(ctx, db.UpdateAppointmentSeriesParams{
+		ID:             uuidStringToPgtype(series.ID),
+		TenantID:       entityUUIDToPgtype(series.TenantID),
+		ProfessionalID: uuidStringToPgtype(series.ProfessionalID),
+		StartTime:      timestampToTimestamptz(series.StartTime),
+		EndsOn:         timePtrToDate(series.EndsOn),
+		Notes:          strPtrToPgText(series.Notes),
+		Status:         series.Status,
+		EndedReason:    strPtrToPgText(series.EndedReason),
+		EndedAt:        timePtrToPgTimestamptz(series.EndedAt),
+		GeneratedUntil: timePtrToPgTimestamptz(series.GeneratedUntil),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrAppointmentSeriesNotFound
+		}
+		return fmt.Errorf("erro ao atualizar série de agendamentos: %w", err)
+	}
+
+	series.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// ListByCustomer lista as séries de um cliente.
+func (r *AppointmentSeriesRepository) ListByCustomer(ctx context.Context, tenantID, customerID string) ([]*entity.AppointmentSeries, error) {
+	rows, err := r.queries.ListAppointmentSeriesByCustomer(ctx, db.ListAppointmentSeriesByCustomerParams{
+		TenantID:   uuidStringToPgtype(tenantID),
+		CustomerID: uuidStringToPgtype(customerID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar séries do cliente: %w", err)
+	}
+
+	out := make([]*entity.AppointmentSeries, len(rows))
+	for i, row := range rows {
+		out[i] = seriesRowToDomain(row)
+	}
+	return out, nil
+}
+
+// ListToGenerate lista séries ativas ainda não geradas até o horizonte.
+func (r *AppointmentSeriesRepository) ListToGenerate(ctx context.Context, horizon time.Time, limit int) ([]*entity.AppointmentSeries, error) {
+	rows, err := r.queries.ListAppointmentSeriesToGenerate(ctx, db.ListAppointmentSeriesToGenerateParams{
+		Horizon: timestampToTimestamptz(horizon),
+		Limite:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar séries a gerar: %w", err)
+	}
+
+	out := make([]*entity.AppointmentSeries, len(rows))
+	for i, row := range rows {
+		out[i] = seriesRowToDomain(row)
+	}
+	return out, nil
+}
+
+// NextOccurrenceIndex retorna o índice da próxima ocorrência a gerar.
+func (r *AppointmentSeriesRepository) NextOccurrenceIndex(ctx context.Context, seriesID string) (int, error) {
+	next, err := r.queries.GetAppointmentSeriesNextIndex(ctx, uuidStringToPgtype(seriesID))
+	if err != nil {
+		return 0, fmt.Errorf("erro ao buscar próxima ocorrência da série: %w", err)
+	}
+	return int(next), nil
+}
+
+// CreateOccurrence registra uma ocorrência; false se já estava registrada.
+func (r *AppointmentSeriesRepository) CreateOccurrence(ctx context.Context, o *entity.AppointmentSeriesOccurrence) (bool, error) {
+	n, err := r.queries.CreateAppointmentSeriesOccurrence(ctx, db.CreateAppointmentSeriesOccurrenceParams{
+		SeriesID:        uuidStringToPgtype(o.SeriesID),
+		OccurrenceIndex: int32(o.Index),
+		TenantID:        entityUUIDToPgtype(o.TenantID),
+		ScheduledStart:  timestampToTimestamptz(o.ScheduledStart),
+		AppointmentID:   uuidStrPtrToPgtype(o.AppointmentID),
+		Status:          o.Status,
+		Detail:          strPtrToPgText(o.Detail),
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao registrar ocorrência da série: %w", err)
+	}
+	return n > 0, nil
+}
+
+// UpdateOccurrence atualiza horário, agendamento e status da ocorrência.
+func (r *AppointmentSeriesRepository) UpdateOccurrence(ctx context.Context, o *entity.AppointmentSeriesOccurrence) error {
+	err := r.queries.UpdateAppointmentSeriesOccurrence(ctx, db.UpdateAppointmentSeriesOccurrenceParams{
+		SeriesID:        uuidStringToPgtype(o.SeriesID),
+		OccurrenceIndex: int32(o.Index),
+		ScheduledStart:  timestampToTimestamptz(o.ScheduledStart),
+		AppointmentID:   uuidStrPtrToPgtype(o.AppointmentID),
+		Status:          o.Status,
+		Detail:          strPtrToPgText(o.Detail),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar ocorrência da série: %w", err)
+	}
+	return nil
+}
+
+// ListOccurrences lista as ocorrências geradas da série.
+func (r *AppointmentSeriesRepository) ListOccurrences(ctx context.Context, tenantID, seriesID string) ([]*entity.AppointmentSeriesOccurrence, error) {
+	rows, err := r.queries.ListAppointmentSeriesOccurrences(ctx, db.ListAppointmentSeriesOccurrencesParams{
+		SeriesID: uuidStringToPgtype(seriesID),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar ocorrências da série: %w", err)
+	}
+
+	out := make([]*entity.AppointmentSeriesOccurrence, len(rows))
+	for i, row := range rows {
+		out[i] = occurrenceRowToDomain(row)
+	}
+	return out, nil
+}
+
+// FindOccurrenceByAppointment busca a ocorrência que gerou o agendamento.
+func (r *AppointmentSeriesRepository) FindOccurrenceByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentSeriesOccurrence, error) {
+	row, err := r.queries.GetAppointmentSeriesOccurrenceByAppointment(ctx, db.GetAppointmentSeriesOccurrenceByAppointmentParams{
+		AppointmentID: uuidStringToPgtype(appointmentID),
+		TenantID:      uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAppointmentNotInSeries
+		}
+		return nil, fmt.Errorf("erro ao buscar ocorrência do agendamento: %w", err)
+	}
+	return occurrenceRowToDomain(row), nil
+}
+
+func seriesRowToDomain(row db.AppointmentSeries) *entity.AppointmentSeries {
+	serviceIDs := make([]string, len(row.ServiceIds))
+	for i, id := range row.ServiceIds {
+		serviceIDs[i] = pgUUIDToString(id)
+	}
+
+	return &entity.AppointmentSeries{
+		ID:             pgUUIDToString(row.ID),
+		TenantID:       pgtypeToEntityUUID(row.TenantID),
+		UnitID:         pgtypeToEntityUUID(row.UnitID),
+		ProfessionalID: pgUUIDToString(row.ProfessionalID),
+		CustomerID:     pgUUIDToString(row.CustomerID),
+		ServiceIDs:     serviceIDs,
+		StartTime:      timestamptzToTime(row.StartTime),
+		IntervalWeeks:  int(row.IntervalWeeks),
+		EndsOn:         dateToTimePtr(row.EndsOn),
+		Notes:          pgTextToStr(row.Notes),
+		Status:         row.Status,
+		EndedReason:    pgTextToStr(row.EndedReason),
+		EndedAt:        timestamptzToTimePtr(row.EndedAt),
+		GeneratedUntil: timestamptzToTimePtr(row.GeneratedUntil),
+		CreatedBy:      pgUUIDPtrToString(row.CreatedBy),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		UpdatedAt:      timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func occurrenceRowToDomain(row db.AppointmentSeriesOccurrence) *entity.AppointmentSeriesOccurrence {
+	return &entity.AppointmentSeriesOccurrence{
+		SeriesID:       pgUUIDToString(row.SeriesID),
+		Index:          int(row.OccurrenceIndex),
+		TenantID:       pgtypeToEntityUUID(row.TenantID),
+		ScheduledStart: timestamptzToTime(row.ScheduledStart),
+		AppointmentID:  pgUUIDPtrToString(row.AppointmentID),
+		Status:         row.Status,
+		Detail:         pgTextToStr(row.Detail),
+	}
+}
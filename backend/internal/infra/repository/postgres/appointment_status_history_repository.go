@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/valueobject"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+)
+
+// AppointmentStatusHistoryRepository implementa
+// port.AppointmentStatusHistoryRepository usando sqlc.
+type AppointmentStatusHistoryRepository struct {
+	queries *db.Queries
+}
+
+// NewAppointmentStatusHistoryRepository cria uma nova instância do repositório.
+func NewAppointmentStatusHistoryRepository(queries *db.Queries) *AppointmentStatusHistoryRepository {
+	return &AppointmentStatusHistoryRepository{queries: queries}
+}
+
+// Append grava uma mudança de status.
+func (r *AppointmentStatusHistoryRepository) Append(ctx context.Context, change *entity.AppointmentStatusChange) error {
+	id, err := r.queries.CreateAppointmentStatusHistory(ctx, db.CreateAppointmentStatusHistoryParams{
+		TenantID:      entityUUIDToPgtype(change.TenantID),
+		AppointmentID: uuidStringToPgtype(change.AppointmentID),
+		FromStatus:    change.FromStatus.String(),
+		ToStatus:      change.ToStatus.String(),
+		ActorID:       uuidStrPtrToPgtype(change.ActorID),
+		Reason:        strPtrToPgText(change.Reason),
+		ChangedAt:     timestampToTimestamptz(change.ChangedAt),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao gravar histórico de status do agendamento: %w", err)
+	}
+
+	change.ID = pgUUIDToString(id)
+	return nil
+}
+
+// ListByAppointment lista as mudanças do agendamento em ordem cronológica.
+func (r *AppointmentStatusHistoryRepository) ListByAppointment(ctx context.Context, tenantID, appointmentID string) ([]*entity.AppointmentStatusChange, error) {
+	rows, err := r.queries.ListAppointmentStatusHistory(ctx, db.ListAppointmentStatusHistoryParams{
+		TenantID:      uuidStringToPgtype(tenantID),
+		AppointmentID: uuidStringToPgtype(appointmentID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar histórico de status do agendamento: %w", err)
+	}
+
+	out := make([]*entity.AppointmentStatusChange, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &entity.AppointmentStatusChange{
+			ID:            pgUUIDToString(row.ID),
+			TenantID:      pgtypeToEntityUUID(row.TenantID),
+			AppointmentID: pgUUIDToString(row.AppointmentID),
+			FromStatus:    valueobject.AppointmentStatus(row.FromStatus),
+			ToStatus:      valueobject.AppointmentStatus(row.ToStatus),
+			ActorID:       pgUUIDPtrToString(row.ActorID),
+			ActorName:     row.ActorName,
+			Reason:        pgTextToStr(row.Reason),
+			ChangedAt:     timestamptzToTime(row.ChangedAt),
+		})
+	}
+	return out, nil
+}
+
+// TimingStats soma os tempos da operação por unidade e profissional (o
+// responsável, com os tempos do atendimento, e os profissionais dos demais
+// serviços, só com agendamentos e cancelamentos).
+func (r *AppointmentStatusHistoryRepository) TimingStats(ctx context.Context, tenantID string, filter port.AppointmentTimingFilter) ([]entity.AppointmentTimingStats, error) {
+	rows, err := r.queries.AppointmentTimingStats(ctx, db.AppointmentTimingStatsParams{
+		ToleranceSeconds:  filter.Tolerance.Seconds(),
+		LateCancelSeconds: filter.LateCancelWindow.Seconds(),
+		TenantID:          uuidStringToPgtype(tenantID),
+		StartFrom:         timestampToTimestamptz(filter.StartFrom),
+		StartTo:           timestampToTimestamptz(filter.StartTo),
+		UnitID:            uuidStrPtrToPgtype(filter.UnitID),
+		ProfessionalID:    uuidStrPtrToPgtype(filter.ProfessionalID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao calcular indicadores de tempo dos agendamentos: %w", err)
+	}
+
+	out := make([]entity.AppointmentTimingStats, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, entity.AppointmentTimingStats{
+			UnitID:              row.UnitID,
+			UnitName:            row.UnitName,
+			ProfessionalID:      pgUUIDToString(row.ProfessionalID),
+			ProfessionalName:    row.ProfessionalName,
+			Responsible:         row.Responsible,
+			Appointments:        int(row.Appointments),
+			WaitCount:           int(row.WaitCount),
+			WaitSeconds:         row.WaitSeconds,
+			ServiceCount:        int(row.ServiceCount),
+			ServiceSeconds:      row.ServiceSeconds,
+			ExpectedSeconds:     row.ExpectedSeconds,
+			OverrunCount:        int(row.OverrunCount),
+			CheckInCount:        int(row.CheckInCount),
+			LateArrivals:        int(row.LateArrivals),
+			ArrivalDelaySeconds: row.ArrivalDelaySeconds,
+			StartCount:          int(row.StartCount),
+			LateStarts:          int(row.LateStarts),
+			StartDelaySeconds:   row.StartDelaySeconds,
+			CancelCount:         int(row.CancelCount),
+			CancelLeadSeconds:   row.CancelLeadSeconds,
+			LateCancellations:   int(row.LateCancellations),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// BankStatementRepository implementa port.BankStatementRepository usando PostgreSQL/sqlc
+type BankStatementRepository struct {
+	queries *db.Queries
+}
+
+// Compile-time check: garante que BankStatementRepository implementa port.BankStatementRepository
+var _ port.BankStatementRepository = (*BankStatementRepository)(nil)
+
+// NewBankStatementRepository cria uma nova instância do repositório
+func NewBankStatementRepository(queries *db.Queries) *BankStatementRepository {
+	return &BankStatementRepository{queries: queries}
+}
+
+// ============================================================
+// IMPORTS
+// ============================================================
+
+// CreateImport registra um novo arquivo de extrato importado
+func (r *BankStatementRepository) CreateImport(ctx context.Context, imp *entity.BankStatementImport) error {
+	result, err := r.queries.CreateBankStatementImport(ctx, db.CreateBankStatementImportParams{
+		ID:            uuidToPgUUID(imp.ID),
+		TenantID:      uuidToPgUUID(imp.TenantID),
+		ArquivoNome:   imp.ArquivoNome,
+		Formato:       string(imp.Formato),
+		ContaBancaria: imp.ContaBancaria,
+		PeriodoInicio: timePtrToDate(imp.PeriodoInicio),
+		PeriodoFim:    timePtrToDate(imp.PeriodoFim),
+		ImportadoPor:  uuidPtrToPgUUID(imp.ImportadoPor),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao registrar importação de extrato: %w", err)
+	}
+
+	imp.CreatedAt = timestamptzToTime(result.CreatedAt)
+	return nil
+}
+
+// FindImportByID busca uma importação por ID
+func (r *BankStatementRepository) FindImportByID(ctx context.Context, importID, tenantID uuid.UUID) (*entity.BankStatementImport, error) {
+	result, err := r.queries.GetBankStatementImportByID(ctx, db.GetBankStatementImportByIDParams{
+		ID:       uuidToPgUUID(importID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrExtratoImportNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar importação de extrato: %w", err)
+	}
+
+	return r.rowToImport(&result), nil
+}
+
+// ListImports lista importações do tenant (mais recentes primeiro)
+func (r *BankStatementRepository) ListImports(ctx context.Context, tenantID uuid.UUID, limit, offset int) ([]*entity.BankStatementImport, error) {
+	results, err := r.queries.ListBankStatementImports(ctx, db.ListBankStatementImportsParams{
+		TenantID:    uuidToPgUUID(tenantID),
+		LimitCount:  int32(limit),
+		OffsetCount: int32(offset),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar importações de extrato: %w", err)
+	}
+
+	imports := make([]*entity.BankStatementImport, 0, len(results))
+	for i := range results {
+		imports = append(imports, r.rowToImport(&results[i]))
+	}
+	return imports, nil
+}
+
+// RefreshImportTotais recalcula os totais da importação a partir das linhas
+func (r *BankStatementRepository) RefreshImportTotais(ctx context.Context, importID, tenantID uuid.UUID) error {
+	err := r.queries.UpdateBankStatementImportTotais(ctx, db.UpdateBankStatementImportTotaisParams{
+		ID:       uuidToPgUUID(importID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar totais da importação: %w", err)
+	}
+	return nil
+}
+
+// ============================================================
+// LINES
+// ============================================================
+
+// CreateLine insere um lançamento; retorna false se já havia sido importado
+func (r *BankStatementRepository) CreateLine(ctx context.Context, line *entity.BankStatementLine) (bool, error) {
+	result, err := r.queries.CreateBankStatementLine(ctx, db.CreateBankStatementLineParams{
+		ID:             uuidToPgUUID(line.ID),
+		TenantID:       uuidToPgUUID(line.TenantID),
+		ImportID:       uuidToPgUUID(line.ImportID),
+		DataLancamento: timeToDate(line.DataLancamento),
+		Descricao:      line.Descricao,
+		Valor:          line.Valor,
+		Tipo:           string(line.Tipo),
+		Identificador:  line.Identificador,
+	})
+	if err != nil {
+		// ON CONFLICT DO NOTHING: lançamento duplicado
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("erro ao inserir lançamento de extrato: %w", err)
+	}
+
+	line.CreatedAt = timestamptzToTime(result.CreatedAt)
+	line.UpdatedAt = timestamptzToTime(result.UpdatedAt)
+	return true, nil
+}
+
+// FindLineByID busca um lançamento por ID
+func (r *BankStatementRepository) FindLineByID(ctx context.Context, lineID, tenantID uuid.UUID) (*entity.BankStatementLine, error) {
+	result, err := r.queries.GetBankStatementLineByID(ctx, db.GetBankStatementLineByIDParams{
+		ID:       uuidToPgUUID(lineID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrExtratoLinhaNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar lançamento de extrato: %w", err)
+	}
+
+	return r.rowToLine(&result), nil
+}
+
+// ListLinesByImport lista lançamentos de uma importação
+func (r *BankStatementRepository) ListLinesByImport(ctx context.Context, importID, tenantID uuid.UUID) ([]*entity.BankStatementLine, error) {
+	results, err := r.queries.ListBankStatementLinesByImport(ctx, db.ListBankStatementLinesByImportParams{
+		ImportID: uuidToPgUUID(importID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar lançamentos do extrato: %w", err)
+	}
+	return r.rowsToLines(results), nil
+}
+
+// ListLinesRevisao lista lançamentos pendentes ou divergentes
+func (r *BankStatementRepository) ListLinesRevisao(ctx context.Context, tenantID uuid.UUID) ([]*entity.BankStatementLine, error) {
+	results, err := r.queries.ListBankStatementLinesRevisao(ctx, uuidToPgUUID(tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar fila de revisão da conciliação: %w", err)
+	}
+	return r.rowsToLines(results), nil
+}
+
+// UpdateLineConciliacao persiste o resultado da conciliação de um lançamento
+func (r *BankStatementRepository) UpdateLineConciliacao(ctx context.Context, line *entity.BankStatementLine) error {
+	var matchTipo *string
+	if line.MatchTipo != nil {
+		s := string(*line.MatchTipo)
+		matchTipo = &s
+	}
+
+	err := r.queries.UpdateBankStatementLineConciliacao(ctx, db.UpdateBankStatementLineConciliacaoParams{
+		Status:        string(line.Status),
+		MatchTipo:     matchTipo,
+		MatchID:       uuidPtrToPgUUID(line.MatchID),
+		Diferenca:     decimalPtrToNumeric(line.Diferenca),
+		Observacao:    line.Observacao,
+		ConciliadoEm:  timePtrToPgTimestamptz(line.ConciliadoEm),
+		ConciliadoPor: uuidPtrToPgUUID(line.ConciliadoPor),
+		ID:            uuidToPgUUID(line.ID),
+		TenantID:      uuidToPgUUID(line.TenantID),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao atualizar conciliação do lançamento: %w", err)
+	}
+	return nil
+}
+
+// ExistsMatch verifica se um lançamento interno já foi conciliado
+func (r *BankStatementRepository) ExistsMatch(ctx context.Context, tenantID, matchID uuid.UUID) (bool, error) {
+	exists, err := r.queries.ExistsBankStatementMatch(ctx, db.ExistsBankStatementMatchParams{
+		TenantID: uuidToPgUUID(tenantID),
+		MatchID:  uuidToPgUUID(matchID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar conciliação existente: %w", err)
+	}
+	return exists, nil
+}
+
+// ============================================================
+// HELPERS
+// ============================================================
+
+func (r *BankStatementRepository) rowToImport(row *db.BankStatementImport) *entity.BankStatementImport {
+	return &entity.BankStatementImport{
+		ID:               pgUUIDToUUID(row.ID),
+		TenantID:         pgUUIDToUUID(row.TenantID),
+		ArquivoNome:      row.ArquivoNome,
+		Formato:          entity.FormatoExtrato(row.Formato),
+		ContaBancaria:    row.ContaBancaria,
+		PeriodoInicio:    dateToTimePtr(row.PeriodoInicio),
+		PeriodoFim:       dateToTimePtr(row.PeriodoFim),
+		TotalLinhas:      int(row.TotalLinhas),
+		TotalConciliadas: int(row.TotalConciliadas),
+		TotalPendentes:   int(row.TotalPendentes),
+		ImportadoPor:     pgUUIDToUUIDPtr(row.ImportadoPor),
+		CreatedAt:        timestamptzToTime(row.CreatedAt),
+	}
+}
+
+func (r *BankStatementRepository) rowsToLines(rows []db.BankStatementLine) []*entity.BankStatementLine {
+	lines := make([]*entity.BankStatementLine, 0, len(rows))
+	for i := range rows {
+		lines = append(lines, r.rowToLine(&rows[i]))
+	}
+	return lines
+}
+
+func (r *BankStatementRepository) rowToLine(row *db.BankStatementLine) *entity.BankStatementLine {
+	line := &entity.BankStatementLine{
+		ID:             pgUUIDToUUID(row.ID),
+		TenantID:       pgUUIDToUUID(row.TenantID),
+		ImportID:       pgUUIDToUUID(row.ImportID),
+		DataLancamento: dateToTime(row.DataLancamento),
+		Descricao:      row.Descricao,
+		Valor:          row.Valor,
+		Tipo:           entity.TipoLancamentoExtrato(row.Tipo),
+		Identificador:  row.Identificador,
+		Status:         entity.StatusLinhaExtrato(row.Status),
+		MatchID:        pgUUIDToUUIDPtr(row.MatchID),
+		Diferenca:      numericToDecimalPtr(row.Diferenca),
+		Observacao:     row.Observacao,
+		ConciliadoEm:   timestamptzToTimePtr(row.ConciliadoEm),
+		ConciliadoPor:  pgUUIDToUUIDPtr(row.ConciliadoPor),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		UpdatedAt:      timestamptzToTime(row.UpdatedAt),
+	}
+	if row.MatchTipo != nil {
+		t := entity.MatchTipoExtrato(*row.MatchTipo)
+		line.MatchTipo = &t
+	}
+	return line
+}
@@ -114,6 +114,24 @@ func (r *BarberTurnRepository) ListActive(ctx context.Context, tenantID string)
 	return result, nil
 }
 
+// ListActiveByUnit lista os barbeiros ativos na fila que atendem na unidade
+func (r *BarberTurnRepository) ListActiveByUnit(ctx context.Context, tenantID, unitID string) ([]*entity.BarberTurn, error) {
+	rows, err := r.queries.ListActiveBarbersTurnListByUnit(ctx, db.ListActiveBarbersTurnListByUnitParams{
+		TenantID: stringToUUID(tenantID),
+		UnitID:   stringToUUID(unitID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.BarberTurn, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, mapBarberTurnActiveRowToEntity(db.ListActiveBarbersTurnListRow(row)))
+	}
+
+	return result, nil
+}
+
 // GetNextBarber retorna o próximo barbeiro da fila
 func (r *BarberTurnRepository) GetNextBarber(ctx context.Context, tenantID string) (*entity.BarberTurn, error) {
 	row, err := r.queries.GetNextBarber(ctx, stringToUUID(tenantID))
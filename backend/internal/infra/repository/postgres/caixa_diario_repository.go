@@ -3,8 +3,10 @@ package postgres
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andviana23/barber-analytics-backend/internal/domain"
 	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
@@ -47,6 +49,7 @@ func (r *CaixaDiarioRepository) Create(ctx context.Context, caixa *entity.CaixaD
 		TotalReforcos:     caixa.TotalReforcos,
 		SaldoEsperado:     caixa.SaldoEsperado,
 		Status:            string(caixa.Status),
+		FechamentoCego:    caixa.FechamentoCego,
 	})
 	if err != nil {
 		return fmt.Errorf("erro ao criar caixa diário: %w", err)
@@ -136,6 +139,11 @@ func (r *CaixaDiarioRepository) Fechar(ctx context.Context, caixa *entity.CaixaD
 		return fmt.Errorf("caixa não foi fechado corretamente via entidade")
 	}
 
+	contagem, err := contagemToJSON(caixa.Contagem)
+	if err != nil {
+		return err
+	}
+
 	result, err := r.queries.FecharCaixaDiario(ctx, db.FecharCaixaDiarioParams{
 		ID:                       uuidToPgUUID(caixa.ID),
 		TenantID:                 uuidToPgUUID(caixa.TenantID),
@@ -143,7 +151,11 @@ func (r *CaixaDiarioRepository) Fechar(ctx context.Context, caixa *entity.CaixaD
 		DataFechamento:           timeToPgTimestamp(*caixa.DataFechamento),
 		SaldoReal:                decimalToNumeric(*caixa.SaldoReal),
 		Divergencia:              decimalToNumeric(*caixa.Divergencia),
+		Status:                   string(caixa.Status),
 		JustificativaDivergencia: caixa.JustificativaDivergencia,
+		Contagem:                 contagem,
+		UsuarioAprovacaoID:       uuidPtrToPgUUID(caixa.UsuarioAprovacaoID),
+		DataAprovacao:            timePtrToPgTimestamptz(caixa.DataAprovacao),
 	})
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -156,10 +168,66 @@ func (r *CaixaDiarioRepository) Fechar(ctx context.Context, caixa *entity.CaixaD
 	return nil
 }
 
+// Aprovar conclui um fechamento que aguardava aprovação gerencial
+func (r *CaixaDiarioRepository) Aprovar(ctx context.Context, caixa *entity.CaixaDiario) error {
+	if caixa.UsuarioAprovacaoID == nil || caixa.DataAprovacao == nil {
+		return fmt.Errorf("caixa não foi aprovado corretamente via entidade")
+	}
+
+	result, err := r.queries.AprovarCaixaDiario(ctx, db.AprovarCaixaDiarioParams{
+		ID:                 uuidToPgUUID(caixa.ID),
+		TenantID:           uuidToPgUUID(caixa.TenantID),
+		UsuarioAprovacaoID: uuidToPgUUID(*caixa.UsuarioAprovacaoID),
+		DataAprovacao:      timeToPgTimestamp(*caixa.DataAprovacao),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrCaixaNaoAguardaAprovacao
+		}
+		return fmt.Errorf("erro ao aprovar fechamento do caixa: %w", err)
+	}
+
+	caixa.UpdatedAt = timestamptzToTime(result.UpdatedAt)
+	return nil
+}
+
+// Reabrir devolve ao operador um fechamento que aguardava aprovação
+func (r *CaixaDiarioRepository) Reabrir(ctx context.Context, caixa *entity.CaixaDiario) error {
+	result, err := r.queries.ReabrirCaixaDiario(ctx, db.ReabrirCaixaDiarioParams{
+		ID:       uuidToPgUUID(caixa.ID),
+		TenantID: uuidToPgUUID(caixa.TenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrCaixaNaoAguardaAprovacao
+		}
+		return fmt.Errorf("erro ao reabrir caixa: %w", err)
+	}
+
+	caixa.UpdatedAt = timestamptzToTime(result.UpdatedAt)
+	return nil
+}
+
 // ============================================================
 // LIST
 // ============================================================
 
+// ListAguardandoAprovacao lista os caixas com divergência aguardando aprovação
+func (r *CaixaDiarioRepository) ListAguardandoAprovacao(ctx context.Context, tenantID uuid.UUID) ([]*entity.CaixaDiario, error) {
+	results, err := r.queries.ListCaixaDiarioAguardandoAprovacao(ctx, uuidToPgUUID(tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar caixas aguardando aprovação: %w", err)
+	}
+
+	caixas := make([]*entity.CaixaDiario, 0, len(results))
+	for i := range results {
+		row := db.ListCaixaDiarioHistoricoRow(results[i])
+		caixas = append(caixas, r.rowHistoricoToCaixaDiario(&row))
+	}
+
+	return caixas, nil
+}
+
 // ListHistorico lista caixas fechados com paginação
 func (r *CaixaDiarioRepository) ListHistorico(ctx context.Context, tenantID uuid.UUID, filters port.CaixaFilters) ([]*entity.CaixaDiario, error) {
 	results, err := r.queries.ListCaixaDiarioHistorico(ctx, db.ListCaixaDiarioHistoricoParams{
@@ -202,16 +270,23 @@ func (r *CaixaDiarioRepository) CountHistorico(ctx context.Context, tenantID uui
 
 // CreateOperacao registra uma operação no caixa
 func (r *CaixaDiarioRepository) CreateOperacao(ctx context.Context, op *entity.OperacaoCaixa) error {
-	result, err := r.queries.CreateOperacaoCaixa(ctx, db.CreateOperacaoCaixaParams{
-		ID:        uuidToPgUUID(op.ID),
-		CaixaID:   uuidToPgUUID(op.CaixaID),
-		TenantID:  uuidToPgUUID(op.TenantID),
-		Tipo:      string(op.Tipo),
-		Valor:     op.Valor,
-		Descricao: op.Descricao,
-		Destino:   op.Destino,
-		Origem:    op.Origem,
-		UsuarioID: uuidToPgUUID(op.UsuarioID),
+	return insertOperacaoCaixa(ctx, r.queries, op)
+}
+
+// insertOperacaoCaixa grava a operação com as queries informadas (permite
+// usar a transação de outro repositório).
+func insertOperacaoCaixa(ctx context.Context, q *db.Queries, op *entity.OperacaoCaixa) error {
+	result, err := q.CreateOperacaoCaixa(ctx, db.CreateOperacaoCaixaParams{
+		ID:             uuidToPgUUID(op.ID),
+		CaixaID:        uuidToPgUUID(op.CaixaID),
+		TenantID:       uuidToPgUUID(op.TenantID),
+		Tipo:           string(op.Tipo),
+		Valor:          op.Valor,
+		Descricao:      op.Descricao,
+		Destino:        op.Destino,
+		Origem:         op.Origem,
+		UsuarioID:      uuidToPgUUID(op.UsuarioID),
+		FormaPagamento: op.FormaPagamento,
 	})
 	if err != nil {
 		return fmt.Errorf("erro ao criar operação: %w", err)
@@ -239,6 +314,26 @@ func (r *CaixaDiarioRepository) ListOperacoes(ctx context.Context, caixaID, tena
 	return operacoes, nil
 }
 
+// ListOperacoesByPeriodo lista as operações de todos os caixas do tenant no período [inicio, fim)
+func (r *CaixaDiarioRepository) ListOperacoesByPeriodo(ctx context.Context, tenantID uuid.UUID, inicio, fim time.Time) ([]entity.OperacaoCaixa, error) {
+	results, err := r.queries.ListOperacoesByPeriodo(ctx, db.ListOperacoesByPeriodoParams{
+		TenantID:    uuidToPgUUID(tenantID),
+		CreatedAt:   timeToPgTimestamp(inicio),
+		CreatedAt_2: timeToPgTimestamp(fim),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar operações por período: %w", err)
+	}
+
+	operacoes := make([]entity.OperacaoCaixa, 0, len(results))
+	for i := range results {
+		row := db.ListOperacoesByCaixaRow(results[i])
+		operacoes = append(operacoes, r.rowToOperacao(&row))
+	}
+
+	return operacoes, nil
+}
+
 // ListOperacoesByTipo lista operações filtradas por tipo
 func (r *CaixaDiarioRepository) ListOperacoesByTipo(ctx context.Context, caixaID, tenantID uuid.UUID, tipo entity.TipoOperacaoCaixa) ([]entity.OperacaoCaixa, error) {
 	results, err := r.queries.ListOperacoesByCaixaAndTipo(ctx, db.ListOperacoesByCaixaAndTipoParams{
@@ -324,6 +419,10 @@ func (r *CaixaDiarioRepository) rowToCaixaDiario(row *db.GetCaixaDiarioByIDRow)
 		JustificativaDivergencia: row.JustificativaDivergencia,
 		CreatedAt:                timestamptzToTime(row.CreatedAt),
 		UpdatedAt:                timestamptzToTime(row.UpdatedAt),
+		FechamentoCego:           row.FechamentoCego,
+		Contagem:                 contagemFromJSON(row.Contagem),
+		UsuarioAprovacaoID:       pgUUIDToUUIDPtr(row.UsuarioAprovacaoID),
+		DataAprovacao:            timestamptzToTimePtr(row.DataAprovacao),
 		UsuarioAberturaNome:      pgTextToStr(row.UsuarioAberturaNome),
 		UsuarioFechamentoNome:    row.UsuarioFechamentoNome,
 	}
@@ -351,6 +450,10 @@ func (r *CaixaDiarioRepository) rowAbertoToCaixaDiario(row *db.GetCaixaDiarioAbe
 		JustificativaDivergencia: row.JustificativaDivergencia,
 		CreatedAt:                timestamptzToTime(row.CreatedAt),
 		UpdatedAt:                timestamptzToTime(row.UpdatedAt),
+		FechamentoCego:           row.FechamentoCego,
+		Contagem:                 contagemFromJSON(row.Contagem),
+		UsuarioAprovacaoID:       pgUUIDToUUIDPtr(row.UsuarioAprovacaoID),
+		DataAprovacao:            timestamptzToTimePtr(row.DataAprovacao),
 		UsuarioAberturaNome:      pgTextToStr(row.UsuarioAberturaNome),
 		UsuarioFechamentoNome:    row.UsuarioFechamentoNome,
 	}
@@ -378,6 +481,10 @@ func (r *CaixaDiarioRepository) rowHistoricoToCaixaDiario(row *db.ListCaixaDiari
 		JustificativaDivergencia: row.JustificativaDivergencia,
 		CreatedAt:                timestamptzToTime(row.CreatedAt),
 		UpdatedAt:                timestamptzToTime(row.UpdatedAt),
+		FechamentoCego:           row.FechamentoCego,
+		Contagem:                 contagemFromJSON(row.Contagem),
+		UsuarioAprovacaoID:       pgUUIDToUUIDPtr(row.UsuarioAprovacaoID),
+		DataAprovacao:            timestamptzToTimePtr(row.DataAprovacao),
 		UsuarioAberturaNome:      pgTextToStr(row.UsuarioAberturaNome),
 		UsuarioFechamentoNome:    row.UsuarioFechamentoNome,
 	}
@@ -387,34 +494,36 @@ func (r *CaixaDiarioRepository) rowHistoricoToCaixaDiario(row *db.ListCaixaDiari
 // rowToOperacao converte ListOperacoesByCaixaRow para entity
 func (r *CaixaDiarioRepository) rowToOperacao(row *db.ListOperacoesByCaixaRow) entity.OperacaoCaixa {
 	return entity.OperacaoCaixa{
-		ID:          pgUUIDToUUID(row.ID),
-		CaixaID:     pgUUIDToUUID(row.CaixaID),
-		TenantID:    pgUUIDToUUID(row.TenantID),
-		Tipo:        entity.TipoOperacaoCaixa(row.Tipo),
-		Valor:       row.Valor,
-		Descricao:   row.Descricao,
-		Destino:     row.Destino,
-		Origem:      row.Origem,
-		UsuarioID:   pgUUIDToUUID(row.UsuarioID),
-		CreatedAt:   timestamptzToTime(row.CreatedAt),
-		UsuarioNome: pgTextToStr(row.UsuarioNome),
+		ID:             pgUUIDToUUID(row.ID),
+		CaixaID:        pgUUIDToUUID(row.CaixaID),
+		TenantID:       pgUUIDToUUID(row.TenantID),
+		Tipo:           entity.TipoOperacaoCaixa(row.Tipo),
+		Valor:          row.Valor,
+		Descricao:      row.Descricao,
+		Destino:        row.Destino,
+		Origem:         row.Origem,
+		UsuarioID:      pgUUIDToUUID(row.UsuarioID),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		FormaPagamento: row.FormaPagamento,
+		UsuarioNome:    pgTextToStr(row.UsuarioNome),
 	}
 }
 
 // rowTipoToOperacao converte ListOperacoesByCaixaAndTipoRow para entity
 func (r *CaixaDiarioRepository) rowTipoToOperacao(row *db.ListOperacoesByCaixaAndTipoRow) entity.OperacaoCaixa {
 	return entity.OperacaoCaixa{
-		ID:          pgUUIDToUUID(row.ID),
-		CaixaID:     pgUUIDToUUID(row.CaixaID),
-		TenantID:    pgUUIDToUUID(row.TenantID),
-		Tipo:        entity.TipoOperacaoCaixa(row.Tipo),
-		Valor:       row.Valor,
-		Descricao:   row.Descricao,
-		Destino:     row.Destino,
-		Origem:      row.Origem,
-		UsuarioID:   pgUUIDToUUID(row.UsuarioID),
-		CreatedAt:   timestamptzToTime(row.CreatedAt),
-		UsuarioNome: pgTextToStr(row.UsuarioNome),
+		ID:             pgUUIDToUUID(row.ID),
+		CaixaID:        pgUUIDToUUID(row.CaixaID),
+		TenantID:       pgUUIDToUUID(row.TenantID),
+		Tipo:           entity.TipoOperacaoCaixa(row.Tipo),
+		Valor:          row.Valor,
+		Descricao:      row.Descricao,
+		Destino:        row.Destino,
+		Origem:         row.Origem,
+		UsuarioID:      pgUUIDToUUID(row.UsuarioID),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+		FormaPagamento: row.FormaPagamento,
+		UsuarioNome:    pgTextToStr(row.UsuarioNome),
 	}
 }
 
@@ -422,6 +531,30 @@ func (r *CaixaDiarioRepository) rowTipoToOperacao(row *db.ListOperacoesByCaixaAn
 // HELPERS locais para conversões específicas
 // ============================================================
 
+// contagemToJSON serializa a contagem por denominação para a coluna JSONB
+func contagemToJSON(c *entity.ContagemCaixa) ([]byte, error) {
+	if c == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(c.Itens)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar contagem do caixa: %w", err)
+	}
+	return data, nil
+}
+
+// contagemFromJSON lê a contagem gravada (nil quando o fechamento não foi itemizado)
+func contagemFromJSON(data []byte) *entity.ContagemCaixa {
+	if len(data) == 0 {
+		return nil
+	}
+	var itens []entity.ItemContagem
+	if err := json.Unmarshal(data, &itens); err != nil {
+		return nil
+	}
+	return &entity.ContagemCaixa{Itens: itens}
+}
+
 // numericToDecimalPtr converte pgtype.Numeric para *decimal.Decimal
 func numericToDecimalPtrLocal(n pgtype.Numeric) *decimal.Decimal {
 	if !n.Valid {
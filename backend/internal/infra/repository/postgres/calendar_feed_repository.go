@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+// CalendarFeedRepository implementa port.CalendarFeedRepository usando sqlc.
+type CalendarFeedRepository struct {
+	queries *db.Queries
+}
+
+// NewCalendarFeedRepository cria uma nova instância do repositório.
+func NewCalendarFeedRepository(queries *db.Queries) *CalendarFeedRepository {
+	return &CalendarFeedRepository{queries: queries}
+}
+
+// Create grava o feed (só o hash do token).
+func (r *CalendarFeedRepository) Create(ctx context.Context, feed *entity.CalendarFeed) error {
+	row, err := r.queries.CreateCalendarFeed(ctx, db.CreateCalendarFeedParams{
+		ID:             uuidStringToPgtype(feed.ID),
+		TenantID:       entityUUIDToPgtype(feed.TenantID),
+		UnitID:         entityUUIDToPgtype(feed.UnitID),
+		ProfessionalID: uuidStrPtrToPgtype(feed.ProfessionalID),
+		TokenHash:      feed.TokenHash,
+		CreatedBy:      uuidStrPtrToPgtype(feed.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao criar feed de agenda: %w", err)
+	}
+
+	feed.CreatedAt = timestamptzToTime(row.CreatedAt)
+	return nil
+}
+
+// FindByTokenHash busca o feed ativo do link.
+func (r *CalendarFeedRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*entity.CalendarFeed, error) {
+	row, err := r.queries.GetCalendarFeedByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCalendarFeedNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar feed de agenda: %w", err)
+	}
+	return calendarFeedRowToDomain(db.ListCalendarFeedsRow{
+		ID:             row.ID,
+		TenantID:       row.TenantID,
+		UnitID:         row.UnitID,
+		ProfessionalID: row.ProfessionalID,
+		TokenHash:      row.TokenHash,
+		CreatedBy:      row.CreatedBy,
+		LastAccessedAt: row.LastAccessedAt,
+		RevokedAt:      row.RevokedAt,
+		CreatedAt:      row.CreatedAt,
+	}), nil
+}
+
+// List lista os feeds ativos da unidade.
+func (r *CalendarFeedRepository) List(ctx context.Context, tenantID, unitID string) ([]*entity.CalendarFeed, error) {
+	rows, err := r.queries.ListCalendarFeeds(ctx, db.ListCalendarFeedsParams{
+		TenantID: uuidStringToPgtype(tenantID),
+		UnitID:   uuidStringToPgtype(unitID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar feeds de agenda: %w", err)
+	}
+
+	out := make([]*entity.CalendarFeed, len(rows))
+	for i, row := range rows {
+		out[i] = calendarFeedRowToDomain(row)
+	}
+	return out, nil
+}
+
+// Revoke revoga o feed (do profissional, se informado).
+func (r *CalendarFeedRepository) Revoke(ctx context.Context, tenantID, id, professionalID string) error {
+	n, err := r.queries.RevokeCalendarFeed(ctx, db.RevokeCalendarFeedParams{
+		ID:             uuidStringToPgtype(id),
+		TenantID:       uuidStringToPgtype(tenantID),
+		ProfessionalID: uuidStrPtrToPgtype(professionalID),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao revogar feed de agenda: %w", err)
+	}
+	if n == 0 {
+		return domain.ErrCalendarFeedNotFound
+	}
+	return nil
+}
+
+// Touch registra o último acesso ao feed.
+func (r *CalendarFeedRepository) Touch(ctx context.Context, id string) error {
+	if err := r.queries.TouchCalendarFeed(ctx, uuidStringToPgtype(id)); err != nil {
+		return fmt.Errorf("erro ao registrar acesso ao feed de agenda: %w", err)
+	}
+	return nil
+}
+
+// ListBlockedTimes lista os bloqueios do profissional do feed (ou dos
+// profissionais da unidade, no feed da unidade).
+func (r *CalendarFeedRepository) ListBlockedTimes(ctx context.Context, feed *entity.CalendarFeed, since time.Time) ([]*port.CalendarFeedBlockedTime, error) {
+	params := db.ListCalendarFeedBlockedTimesParams{
+		TenantID: entityUUIDToPgtype(feed.TenantID),
+		Since:    timestampToTimestamptz(since),
+	}
+	if feed.IsUnitFeed() {
+		params.UnitID = entityUUIDToPgtype(feed.UnitID)
+	} else {
+		params.ProfessionalID = uuidStringToPgtype(feed.ProfessionalID)
+	}
+
+	rows, err := r.queries.ListCalendarFeedBlockedTimes(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar bloqueios do feed de agenda: %w", err)
+	}
+
+	out := make([]*port.CalendarFeedBlockedTime, len(rows))
+	for i, row := range rows {
+		bt := toDomain(db.BlockedTime{
+			ID:             row.ID,
+			TenantID:       row.TenantID,
+			ProfessionalID: row.ProfessionalID,
+			StartTime:      row.StartTime,
+			EndTime:        row.EndTime,
+			Reason:         row.Reason,
+			IsRecurring:    row.IsRecurring,
+			RecurrenceRule: row.RecurrenceRule,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+			CreatedBy:      row.CreatedBy,
+		})
+		out[i] = &port.CalendarFeedBlockedTime{BlockedTime: *bt, ProfessionalName: row.ProfessionalName}
+	}
+	return out, nil
+}
+
+func calendarFeedRowToDomain(row db.ListCalendarFeedsRow) *entity.CalendarFeed {
+	return &entity.CalendarFeed{
+		ID:               pgUUIDToString(row.ID),
+		TenantID:         pgtypeToEntityUUID(row.TenantID),
+		UnitID:           pgtypeToEntityUUID(row.UnitID),
+		ProfessionalID:   pgUUIDPtrToString(row.ProfessionalID),
+		TokenHash:        row.TokenHash,
+		CreatedBy:        pgUUIDPtrToString(row.CreatedBy),
+		LastAccessedAt:   timestamptzToTimePtr(row.LastAccessedAt),
+		RevokedAt:        timestamptzToTimePtr(row.RevokedAt),
+		CreatedAt:        timestamptzToTime(row.CreatedAt),
+		ProfessionalName: row.ProfessionalName,
+	}
+}
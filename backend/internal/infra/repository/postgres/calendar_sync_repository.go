@@ -0,0 +1,391 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/infra/auth"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+// CalendarSyncRepository implementa port.CalendarSyncRepository usando sqlc.
+// As credenciais OAuth são gravadas cifradas.
+type CalendarSyncRepository struct {
+	queries *db.Queries
+	cipher  *auth.SecretCipher
+}
+
+// NewCalendarSyncRepository cria uma nova instância do repositório.
+func NewCalendarSyncRepository(queries *db.Queries, cipher *auth.SecretCipher) *CalendarSyncRepository {
+	return &CalendarSyncRepository{queries: queries, cipher: cipher}
+}
+
+// SaveConnection grava a conexão (substitui a do mesmo profissional).
+func (r *CalendarSyncRepository) SaveConnection(ctx context.Context, conn *entity.CalendarConnection) error {
+	access, refresh, err := r.encryptToken(conn.Token)
+	if err != nil {
+		return err
+	}
+
+	row, err := r.queries.UpsertCalendarConnection(ctx, db.UpsertCalendarConnectionParams{
+		ID:             uuidStringToPgtype(conn.ID),
+		TenantID:       entityUUIDToPgtype(conn.TenantID),
+		ProfessionalID: uuidStringToPgtype(conn.ProfessionalID),
+		Provider:       conn.Provider,
+		CalendarID:     conn.CalendarID,
+		AccountEmail:   strPtrToPgText(conn.AccountEmail),
+		AccessToken:    access,
+		RefreshToken:   refresh,
+		TokenExpiresAt: timestampToTimestamptz(conn.Token.ExpiresAt),
+		CreatedBy:      uuidStrPtrToPgtype(conn.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao gravar conexão com calendário: %w", err)
+	}
+
+	conn.ID = pgUUIDToString(row.ID)
+	conn.SyncToken = ""
+	conn.Status = row.Status
+	conn.LastError = ""
+	conn.FullSyncedAt = nil
+	conn.CreatedAt = timestamptzToTime(row.CreatedAt)
+	conn.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindConnection busca a conexão do tenant.
+func (r *CalendarSyncRepository) FindConnection(ctx context.Context, tenantID, id string) (*entity.CalendarConnection, error) {
+	row, err := r.queries.GetCalendarConnection(ctx, db.GetCalendarConnectionParams{
+		ID:       uuidStringToPgtype(id),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCalendarConnectionNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar conexão com calendário: %w", err)
+	}
+	return r.toDomain(row, "")
+}
+
+// ListConnections lista as conexões do tenant (ou do profissional).
+func (r *CalendarSyncRepository) ListConnections(ctx context.Context, tenantID, professionalID string) ([]*entity.CalendarConnection, error) {
+	rows, err := r.queries.ListCalendarConnections(ctx, db.ListCalendarConnectionsParams{
+		TenantID:       uuidStringToPgtype(tenantID),
+		ProfessionalID: uuidStrPtrToPgtype(professionalID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar conexões com calendário: %w", err)
+	}
+
+	out := make([]*entity.CalendarConnection, 0, len(rows))
+	for _, row := range rows {
+		conn, err := r.toDomain(db.CalendarConnection{
+			ID:             row.ID,
+			TenantID:       row.TenantID,
+			ProfessionalID: row.ProfessionalID,
+			Provider:       row.Provider,
+			CalendarID:     row.CalendarID,
+			AccountEmail:   row.AccountEmail,
+			AccessToken:    row.AccessToken,
+			RefreshToken:   row.RefreshToken,
+			TokenExpiresAt: row.TokenExpiresAt,
+			SyncToken:      row.SyncToken,
+			Status:         row.Status,
+			LastError:      row.LastError,
+			LastSyncedAt:   row.LastSyncedAt,
+			FullSyncedAt:   row.FullSyncedAt,
+			CreatedBy:      row.CreatedBy,
+			CreatedAt:      row.CreatedAt,
+			UpdatedAt:      row.UpdatedAt,
+		}, row.ProfessionalName)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, conn)
+	}
+	return out, nil
+}
+
+// ListActiveConnections lista as conexões ativas de todos os tenants.
+func (r *CalendarSyncRepository) ListActiveConnections(ctx context.Context) ([]*entity.CalendarConnection, error) {
+	rows, err := r.queries.ListActiveCalendarConnections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar conexões com calendário: %w", err)
+	}
+	return r.rowsToDomain(rows)
+}
+
+// ListConnectionsByProfessionals lista as conexões dos profissionais.
+func (r *CalendarSyncRepository) ListConnectionsByProfessionals(ctx context.Context, tenantID string, professionalIDs []string) ([]*entity.CalendarConnection, error) {
+	rows, err := r.queries.ListCalendarConnectionsByProfessionals(ctx, db.ListCalendarConnectionsByProfessionalsParams{
+		TenantID:        uuidStringToPgtype(tenantID),
+		ProfessionalIds: uuidStringsToPgtype(professionalIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar conexões com calendário: %w", err)
+	}
+	return r.rowsToDomain(rows)
+}
+
+// HasConnections indica se algum dos profissionais tem conexão ativa.
+func (r *CalendarSyncRepository) HasConnections(ctx context.Context, tenantID string, professionalIDs []string) (bool, error) {
+	ok, err := r.queries.HasCalendarConnections(ctx, db.HasCalendarConnectionsParams{
+		TenantID:        uuidStringToPgtype(tenantID),
+		ProfessionalIds: uuidStringsToPgtype(professionalIDs),
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao verificar conexões com calendário: %w", err)
+	}
+	return ok, nil
+}
+
+// UpdateTokens grava as credenciais renovadas.
+func (r *CalendarSyncRepository) UpdateTokens(ctx context.Context, conn *entity.CalendarConnection) error {
+	access, refresh, err := r.encryptToken(conn.Token)
+	if err != nil {
+		return err
+	}
+	if err := r.queries.UpdateCalendarConnectionTokens(ctx, db.UpdateCalendarConnectionTokensParams{
+		ID:             uuidStringToPgtype(conn.ID),
+		AccessToken:    access,
+		RefreshToken:   refresh,
+		TokenExpiresAt: timestampToTimestamptz(conn.Token.ExpiresAt),
+	}); err != nil {
+		return fmt.Errorf("erro ao gravar credenciais do calendário: %w", err)
+	}
+	return nil
+}
+
+// UpdateSyncState grava o resultado da última leitura.
+func (r *CalendarSyncRepository) UpdateSyncState(ctx context.Context, conn *entity.CalendarConnection) error {
+	if err := r.queries.UpdateCalendarConnectionSync(ctx, db.UpdateCalendarConnectionSyncParams{
+		SyncToken:    strPtrToPgText(conn.SyncToken),
+		Status:       conn.Status,
+		LastError:    strPtrToPgText(conn.LastError),
+		LastSyncedAt: timePtrToPgTimestamptz(conn.LastSyncedAt),
+		FullSyncedAt: timePtrToPgTimestamptz(conn.FullSyncedAt),
+		ID:           uuidStringToPgtype(conn.ID),
+	}); err != nil {
+		return fmt.Errorf("erro ao gravar sincronização do calendário: %w", err)
+	}
+	return nil
+}
+
+// DeleteConnection remove os bloqueios importados e a conexão.
+func (r *CalendarSyncRepository) DeleteConnection(ctx context.Context, conn *entity.CalendarConnection) error {
+	if err := r.queries.DeleteCalendarConnectionBlockedTimes(ctx, uuidStringToPgtype(conn.ID)); err != nil {
+		return fmt.Errorf("erro ao remover bloqueios importados do calendário: %w", err)
+	}
+	if err := r.queries.DeleteCalendarConnection(ctx, db.DeleteCalendarConnectionParams{
+		ID:       uuidStringToPgtype(conn.ID),
+		TenantID: entityUUIDToPgtype(conn.TenantID),
+	}); err != nil {
+		return fmt.Errorf("erro ao remover conexão com calendário: %w", err)
+	}
+	return nil
+}
+
+// ListEventLinks lista os eventos externos do agendamento.
+func (r *CalendarSyncRepository) ListEventLinks(ctx context.Context, appointmentID string) ([]entity.CalendarEventLink, error) {
+	rows, err := r.queries.ListCalendarEventLinks(ctx, uuidStringToPgtype(appointmentID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar eventos do agendamento no calendário: %w", err)
+	}
+
+	out := make([]entity.CalendarEventLink, len(rows))
+	for i, row := range rows {
+		out[i] = entity.CalendarEventLink{
+			ConnectionID:    pgUUIDToString(row.ConnectionID),
+			AppointmentID:   pgUUIDToString(row.AppointmentID),
+			SlotIndex:       int(row.SlotIndex),
+			ExternalEventID: row.ExternalEventID,
+		}
+	}
+	return out, nil
+}
+
+// SaveEventLink grava o evento externo do trecho do agendamento.
+func (r *CalendarSyncRepository) SaveEventLink(ctx context.Context, link entity.CalendarEventLink) error {
+	if err := r.queries.UpsertCalendarEventLink(ctx, db.UpsertCalendarEventLinkParams{
+		ConnectionID:    uuidStringToPgtype(link.ConnectionID),
+		AppointmentID:   uuidStringToPgtype(link.AppointmentID),
+		SlotIndex:       int32(link.SlotIndex),
+		ExternalEventID: link.ExternalEventID,
+	}); err != nil {
+		return fmt.Errorf("erro ao gravar evento do agendamento no calendário: %w", err)
+	}
+	return nil
+}
+
+// DeleteEventLink remove o vínculo com o evento externo.
+func (r *CalendarSyncRepository) DeleteEventLink(ctx context.Context, link entity.CalendarEventLink) error {
+	if err := r.queries.DeleteCalendarEventLink(ctx, db.DeleteCalendarEventLinkParams{
+		ConnectionID:  uuidStringToPgtype(link.ConnectionID),
+		AppointmentID: uuidStringToPgtype(link.AppointmentID),
+		SlotIndex:     int32(link.SlotIndex),
+	}); err != nil {
+		return fmt.Errorf("erro ao remover evento do agendamento no calendário: %w", err)
+	}
+	return nil
+}
+
+// ListImportedEvents lista os eventos importados da conexão.
+func (r *CalendarSyncRepository) ListImportedEvents(ctx context.Context, connectionID string) ([]entity.CalendarImportedEvent, error) {
+	rows, err := r.queries.ListCalendarImportedEvents(ctx, uuidStringToPgtype(connectionID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar eventos importados do calendário: %w", err)
+	}
+
+	out := make([]entity.CalendarImportedEvent, len(rows))
+	for i, row := range rows {
+		out[i] = entity.CalendarImportedEvent{
+			ExternalEventID: row.ExternalEventID,
+			BlockedTimeID:   pgUUIDToString(row.BlockedTimeID),
+			EndTime:         timestamptzToTime(row.EndTime),
+		}
+	}
+	return out, nil
+}
+
+// SaveImportedEvent vincula o evento externo ao bloqueio criado.
+func (r *CalendarSyncRepository) SaveImportedEvent(ctx context.Context, connectionID string, ev entity.CalendarImportedEvent) error {
+	if err := r.queries.CreateCalendarImportedEvent(ctx, db.CreateCalendarImportedEventParams{
+		ConnectionID:    uuidStringToPgtype(connectionID),
+		ExternalEventID: ev.ExternalEventID,
+		BlockedTimeID:   uuidStringToPgtype(ev.BlockedTimeID),
+	}); err != nil {
+		return fmt.Errorf("erro ao gravar evento importado do calendário: %w", err)
+	}
+	return nil
+}
+
+// SetAppointmentEventID grava no agendamento o evento externo principal.
+func (r *CalendarSyncRepository) SetAppointmentEventID(ctx context.Context, tenantID, appointmentID, eventID string) error {
+	if err := r.queries.SetAppointmentGoogleCalendarEventID(ctx, db.SetAppointmentGoogleCalendarEventIDParams{
+		ID:                    uuidStringToPgtype(appointmentID),
+		TenantID:              uuidStringToPgtype(tenantID),
+		GoogleCalendarEventID: strPtrToPgText(eventID),
+	}); err != nil {
+		return fmt.Errorf("erro ao gravar evento do calendário no agendamento: %w", err)
+	}
+	return nil
+}
+
+// Enqueue coloca o agendamento na fila de envio.
+func (r *CalendarSyncRepository) Enqueue(ctx context.Context, tenantID, appointmentID string) error {
+	if err := r.queries.EnqueueCalendarSync(ctx, db.EnqueueCalendarSyncParams{
+		AppointmentID: uuidStringToPgtype(appointmentID),
+		TenantID:      uuidStringToPgtype(tenantID),
+	}); err != nil {
+		return fmt.Errorf("erro ao enfileirar sincronização do calendário: %w", err)
+	}
+	return nil
+}
+
+// ListDue lista os agendamentos da fila prontos para envio.
+func (r *CalendarSyncRepository) ListDue(ctx context.Context, limit int) ([]entity.CalendarSyncItem, error) {
+	rows, err := r.queries.ListDueCalendarSync(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar fila de sincronização do calendário: %w", err)
+	}
+
+	out := make([]entity.CalendarSyncItem, len(rows))
+	for i, row := range rows {
+		out[i] = entity.CalendarSyncItem{
+			AppointmentID: pgUUIDToString(row.AppointmentID),
+			TenantID:      pgUUIDToString(row.TenantID),
+			Attempts:      int(row.Attempts),
+			NextAttemptAt: timestamptzToTime(row.NextAttemptAt),
+			LastError:     pgTextToStr(row.LastError),
+			EnqueuedAt:    timestamptzToTime(row.CreatedAt),
+		}
+	}
+	return out, nil
+}
+
+// Dequeue remove o item da fila se não foi enfileirado de novo.
+func (r *CalendarSyncRepository) Dequeue(ctx context.Context, item entity.CalendarSyncItem) error {
+	if err := r.queries.DeleteCalendarSync(ctx, db.DeleteCalendarSyncParams{
+		AppointmentID: uuidStringToPgtype(item.AppointmentID),
+		CreatedAt:     timestampToTimestamptz(item.EnqueuedAt),
+	}); err != nil {
+		return fmt.Errorf("erro ao remover item da fila de sincronização do calendário: %w", err)
+	}
+	return nil
+}
+
+// Retry agenda nova tentativa de envio.
+func (r *CalendarSyncRepository) Retry(ctx context.Context, item entity.CalendarSyncItem) error {
+	if err := r.queries.RetryCalendarSync(ctx, db.RetryCalendarSyncParams{
+		AppointmentID: uuidStringToPgtype(item.AppointmentID),
+		Attempts:      int32(item.Attempts),
+		NextAttemptAt: timestampToTimestamptz(item.NextAttemptAt),
+		LastError:     strPtrToPgText(item.LastError),
+	}); err != nil {
+		return fmt.Errorf("erro ao reagendar sincronização do calendário: %w", err)
+	}
+	return nil
+}
+
+func (r *CalendarSyncRepository) encryptToken(token entity.CalendarToken) (string, string, error) {
+	access, err := r.cipher.Encrypt(token.AccessToken)
+	if err != nil {
+		return "", "", fmt.Errorf("erro ao cifrar credenciais do calendário: %w", err)
+	}
+	refresh, err := r.cipher.Encrypt(token.RefreshToken)
+	if err != nil {
+		return "", "", fmt.Errorf("erro ao cifrar credenciais do calendário: %w", err)
+	}
+	return access, refresh, nil
+}
+
+func (r *CalendarSyncRepository) rowsToDomain(rows []db.CalendarConnection) ([]*entity.CalendarConnection, error) {
+	out := make([]*entity.CalendarConnection, 0, len(rows))
+	for _, row := range rows {
+		conn, err := r.toDomain(row, "")
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, conn)
+	}
+	return out, nil
+}
+
+func (r *CalendarSyncRepository) toDomain(row db.CalendarConnection, professionalName string) (*entity.CalendarConnection, error) {
+	access, err := r.cipher.Decrypt(row.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao decifrar credenciais do calendário: %w", err)
+	}
+	refresh, err := r.cipher.Decrypt(row.RefreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao decifrar credenciais do calendário: %w", err)
+	}
+
+	return &entity.CalendarConnection{
+		ID:             pgUUIDToString(row.ID),
+		TenantID:       pgtypeToEntityUUID(row.TenantID),
+		ProfessionalID: pgUUIDToString(row.ProfessionalID),
+		Provider:       row.Provider,
+		CalendarID:     row.CalendarID,
+		AccountEmail:   pgTextToStr(row.AccountEmail),
+		Token: entity.CalendarToken{
+			AccessToken:  access,
+			RefreshToken: refresh,
+			ExpiresAt:    timestamptzToTime(row.TokenExpiresAt),
+		},
+		SyncToken:        pgTextToStr(row.SyncToken),
+		Status:           row.Status,
+		LastError:        pgTextToStr(row.LastError),
+		LastSyncedAt:     timestamptzToTimePtr(row.LastSyncedAt),
+		FullSyncedAt:     timestamptzToTimePtr(row.FullSyncedAt),
+		CreatedBy:        pgUUIDPtrToString(row.CreatedBy),
+		CreatedAt:        timestamptzToTime(row.CreatedAt),
+		UpdatedAt:        timestamptzToTime(row.UpdatedAt),
+		ProfessionalName: professionalName,
+	}, nil
+}
@@ -182,6 +182,40 @@ func (r *CommandRepository) FindByAppointmentID(ctx context.Context, appointment
 
 // Update atualiza uma comanda
 func (r *CommandRepository) Update(ctx context.Context, command *entity.Command) error {
+	return updateCommand(ctx, r.queries, command)
+}
+
+// Close grava a comanda fechada e, quando debt não é nil, a dívida do saldo
+// devedor na mesma transação: a comanda nunca fica fechada sem o fiado.
+// Retorna a dívida gravada (ou a já existente para a comanda).
+func (r *CommandRepository) Close(ctx context.Context, command *entity.Command, debt *entity.CustomerDebt) (*entity.CustomerDebt, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+	if err := updateCommand(ctx, qtx, command); err != nil {
+		return nil, err
+	}
+
+	var saved *entity.CustomerDebt
+	if debt != nil {
+		if saved, err = insertCustomerDebt(ctx, qtx, debt); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return saved, nil
+}
+
+// updateCommand grava a comanda com as queries informadas (permite usar uma
+// transação)
+func updateCommand(ctx context.Context, q *db.Queries, command *entity.Command) error {
 	params := db.UpdateCommandParams{
 		ID:            uuidToUUID(command.ID),
 		TenantID:      uuidToUUID(command.TenantID),
@@ -209,7 +243,7 @@ func (r *CommandRepository) Update(ctx context.Context, command *entity.Command)
 		params.FechadoPor = uuidToUUID(*command.FechadoPor)
 	}
 
-	_, err := r.queries.UpdateCommand(ctx, params)
+	_, err := q.UpdateCommand(ctx, params)
 	if err != nil {
 		return fmt.Errorf("failed to update command: %w", err)
 	}
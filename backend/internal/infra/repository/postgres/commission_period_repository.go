@@ -473,6 +473,7 @@ func commissionPeriodRowToDomain(row db.ListCommissionPeriodsByStatusRow) *entit
 		UnitID:           unitID,
 		ReferenceMonth:   row.ReferenceMonth,
 		ProfessionalID:   professionalID,
+		ProfessionalName: row.ProfessionalName,
 		TotalGross:       row.TotalGross,
 		TotalCommission:  row.TotalCommission,
 		TotalAdvances:    row.TotalAdvances,
@@ -544,6 +545,7 @@ func commissionPeriodByProfessionalRowToDomain(row db.ListCommissionPeriodsByPro
 		UnitID:           unitID,
 		ReferenceMonth:   row.ReferenceMonth,
 		ProfessionalID:   professionalID,
+		ProfessionalName: row.ProfessionalName,
 		TotalGross:       row.TotalGross,
 		TotalCommission:  row.TotalCommission,
 		TotalAdvances:    row.TotalAdvances,
@@ -615,6 +617,7 @@ func commissionPeriodByTenantRowToDomain(row db.ListCommissionPeriodsByTenantRow
 		UnitID:           unitID,
 		ReferenceMonth:   row.ReferenceMonth,
 		ProfessionalID:   professionalID,
+		ProfessionalName: row.ProfessionalName,
 		TotalGross:       row.TotalGross,
 		TotalCommission:  row.TotalCommission,
 		TotalAdvances:    row.TotalAdvances,
@@ -26,6 +26,12 @@ func NewCompensacaoBancariaRepository(queries *db.Queries) *CompensacaoBancariaR
 
 // Create persiste uma nova compensação bancária.
 func (r *CompensacaoBancariaRepository) Create(ctx context.Context, comp *entity.CompensacaoBancaria) error {
+	return insertCompensacaoBancaria(ctx, r.queries, comp)
+}
+
+// insertCompensacaoBancaria grava a compensação com as queries informadas
+// (permite usar a transação de outro repositório).
+func insertCompensacaoBancaria(ctx context.Context, q *db.Queries, comp *entity.CompensacaoBancaria) error {
 	tenantUUID := entityUUIDToPgtype(comp.TenantID)
 	receitaUUID := uuidStringToPgtype(comp.ReceitaID)
 	meioPagamentoUUID := uuidStringToPgtype(comp.MeioPagamentoID)
@@ -47,7 +53,7 @@ func (r *CompensacaoBancariaRepository) Create(ctx context.Context, comp *entity
 		Status:          &statusStr,
 	}
 
-	result, err := r.queries.CreateCompensacaoBancaria(ctx, params)
+	result, err := q.CreateCompensacaoBancaria(ctx, params)
 	if err != nil {
 		return fmt.Errorf("erro ao criar compensação bancária: %w", err)
 	}
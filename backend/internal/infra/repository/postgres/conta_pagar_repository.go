@@ -206,6 +206,20 @@ func (r *ContaPagarRepository) ListByDateRange(ctx context.Context, tenantID str
 	return r.toDomainList(results)
 }
 
+// ListPagasByPeriod lista contas pagas em um período (data pagamento)
+func (r *ContaPagarRepository) ListPagasByPeriod(ctx context.Context, tenantID string, inicio, fim time.Time) ([]*entity.ContaPagar, error) {
+	results, err := r.queries.ListContasPagasByPeriod(ctx, db.ListContasPagasByPeriodParams{
+		TenantID:        uuidStringToPgtype(tenantID),
+		DataPagamento:   dateToDate(inicio),
+		DataPagamento_2: dateToDate(fim),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar contas pagas por período: %w", err)
+	}
+
+	return r.toDomainList(results)
+}
+
 // ListVencendoEm lista contas que vencem em até N dias
 func (r *ContaPagarRepository) ListVencendoEm(ctx context.Context, tenantID string, dias int) ([]*entity.ContaPagar, error) {
 	return r.ListVencendo(ctx, tenantID, int32(dias))
@@ -27,6 +27,12 @@ func NewContaReceberRepository(queries *db.Queries) *ContaReceberRepository {
 
 // Create persiste uma nova conta a receber.
 func (r *ContaReceberRepository) Create(ctx context.Context, conta *entity.ContaReceber) error {
+	return insertContaReceber(ctx, r.queries, conta)
+}
+
+// insertContaReceber grava a conta com as queries informadas (permite usar a
+// transação de outro repositório).
+func insertContaReceber(ctx context.Context, q *db.Queries, conta *entity.ContaReceber) error {
 	tenantUUID := entityUUIDToPgtype(conta.TenantID)
 
 	origemStr := conta.Origem
@@ -67,7 +73,7 @@ func (r *ContaReceberRepository) Create(ctx context.Context, conta *entity.Conta
 		params.DataRecebimento = dateToDate(*conta.DataRecebimento)
 	}
 
-	result, err := r.queries.CreateContaReceber(ctx, params)
+	result, err := q.CreateContaReceber(ctx, params)
 	if err != nil {
 		return fmt.Errorf("erro ao criar conta a receber: %w", err)
 	}
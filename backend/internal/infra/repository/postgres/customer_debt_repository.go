@@ -0,0 +1,302 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/shopspring/decimal"
+)
+
+// CustomerDebtRepository implementa port.CustomerDebtRepository usando PostgreSQL/sqlc
+type CustomerDebtRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+// Compile-time check: garante que CustomerDebtRepository implementa port.CustomerDebtRepository
+var _ port.CustomerDebtRepository = (*CustomerDebtRepository)(nil)
+
+// NewCustomerDebtRepository cria uma nova instância do repositório
+func NewCustomerDebtRepository(queries *db.Queries, pool *pgxpool.Pool) *CustomerDebtRepository {
+	return &CustomerDebtRepository{queries: queries, pool: pool}
+}
+
+// ============================================================
+// CREATE
+// ============================================================
+
+// Create insere uma nova dívida; se a comanda já gerou dívida, retorna a existente
+func (r *CustomerDebtRepository) Create(ctx context.Context, debt *entity.CustomerDebt) (*entity.CustomerDebt, error) {
+	return insertCustomerDebt(ctx, r.queries, debt)
+}
+
+// insertCustomerDebt grava a dívida com as queries informadas (permite usar a
+// transação de outro repositório). Se a comanda já tem dívida, retorna a
+// existente.
+func insertCustomerDebt(ctx context.Context, q *db.Queries, debt *entity.CustomerDebt) (*entity.CustomerDebt, error) {
+	result, err := q.CreateCustomerDebt(ctx, db.CreateCustomerDebtParams{
+		ID:            uuidToPgUUID(debt.ID),
+		TenantID:      uuidToPgUUID(debt.TenantID),
+		CustomerID:    uuidToPgUUID(debt.CustomerID),
+		CommandID:     uuidToPgUUID(debt.CommandID),
+		ValorOriginal: debt.ValorOriginal,
+		ValorPago:     debt.ValorPago,
+		Saldo:         debt.Saldo,
+		Status:        string(debt.Status),
+		DataAbertura:  timeToPgTimestamp(debt.DataAbertura),
+		Observacoes:   debt.Observacoes,
+	})
+	if err != nil {
+		// ON CONFLICT DO NOTHING não retorna linha: dívida já existe para a comanda
+		if errors.Is(err, pgx.ErrNoRows) {
+			existing, err := q.GetCustomerDebtByCommandID(ctx, db.GetCustomerDebtByCommandIDParams{
+				CommandID: uuidToPgUUID(debt.CommandID),
+				TenantID:  uuidToPgUUID(debt.TenantID),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("erro ao buscar dívida da comanda: %w", err)
+			}
+			return rowToCustomerDebt(&existing), nil
+		}
+		return nil, fmt.Errorf("erro ao criar dívida do cliente: %w", err)
+	}
+
+	return rowToCustomerDebt(&result), nil
+}
+
+// RegistrarPagamento abate o pagamento do saldo e grava operação de caixa,
+// contas a receber, compensações e o pagamento em uma única transação
+func (r *CustomerDebtRepository) RegistrarPagamento(
+	ctx context.Context,
+	payment *entity.CustomerDebtPayment,
+	operacao *entity.OperacaoCaixa,
+	contas []port.CustomerDebtReceivable,
+) (*entity.CustomerDebt, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	// Abate primeiro: com pagamentos concorrentes só passa quem o saldo cobre
+	row, err := qtx.AbaterCustomerDebtSaldo(ctx, db.AbaterCustomerDebtSaldoParams{
+		Valor:    payment.Valor,
+		ID:       uuidToPgUUID(payment.DebtID),
+		TenantID: uuidToPgUUID(payment.TenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, r.pagamentoRecusado(ctx, payment)
+		}
+		return nil, fmt.Errorf("erro ao abater saldo da dívida: %w", err)
+	}
+
+	if operacao != nil {
+		if err := insertOperacaoCaixa(ctx, qtx, operacao); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, c := range contas {
+		if err := insertContaReceber(ctx, qtx, c.Conta); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			if id, err := uuid.Parse(c.Conta.ID); err == nil {
+				payment.ContaReceberID = &id
+			}
+		}
+		if c.Compensacao != nil {
+			c.Compensacao.ReceitaID = c.Conta.ID
+			if err := insertCompensacaoBancaria(ctx, qtx, c.Compensacao); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	result, err := qtx.CreateCustomerDebtPayment(ctx, db.CreateCustomerDebtPaymentParams{
+		ID:              uuidToPgUUID(payment.ID),
+		TenantID:        uuidToPgUUID(payment.TenantID),
+		DebtID:          uuidToPgUUID(payment.DebtID),
+		CommandID:       uuidPtrToPgUUID(payment.CommandID),
+		MeioPagamentoID: uuidToPgUUID(payment.MeioPagamentoID),
+		Valor:           payment.Valor,
+		ContaReceberID:  uuidPtrToPgUUID(payment.ContaReceberID),
+		OperacaoCaixaID: uuidPtrToPgUUID(payment.OperacaoCaixaID),
+		RecebidoPor:     uuidPtrToPgUUID(payment.RecebidoPor),
+		Observacoes:     payment.Observacoes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao registrar pagamento de dívida: %w", err)
+	}
+	payment.CreatedAt = timestamptzToTime(result.CreatedAt)
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+	return rowToCustomerDebt(&row), nil
+}
+
+// pagamentoRecusado explica por que o abatimento não alterou a dívida
+func (r *CustomerDebtRepository) pagamentoRecusado(ctx context.Context, payment *entity.CustomerDebtPayment) error {
+	debt, err := r.FindByID(ctx, payment.DebtID, payment.TenantID)
+	if err != nil {
+		return err
+	}
+	if !debt.IsAberta() {
+		return domain.ErrCustomerDebtJaQuitada
+	}
+	return domain.ErrCustomerDebtValorExcedente
+}
+
+// ============================================================
+// READ
+// ============================================================
+
+// FindByID busca uma dívida por ID
+func (r *CustomerDebtRepository) FindByID(ctx context.Context, debtID, tenantID uuid.UUID) (*entity.CustomerDebt, error) {
+	result, err := r.queries.GetCustomerDebtByID(ctx, db.GetCustomerDebtByIDParams{
+		ID:       uuidToPgUUID(debtID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrCustomerDebtNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar dívida por ID: %w", err)
+	}
+
+	return rowToCustomerDebt(&result), nil
+}
+
+// FindByCommandID busca a dívida gerada por uma comanda
+func (r *CustomerDebtRepository) FindByCommandID(ctx context.Context, commandID, tenantID uuid.UUID) (*entity.CustomerDebt, error) {
+	result, err := r.queries.GetCustomerDebtByCommandID(ctx, db.GetCustomerDebtByCommandIDParams{
+		CommandID: uuidToPgUUID(commandID),
+		TenantID:  uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("erro ao buscar dívida da comanda: %w", err)
+	}
+
+	return rowToCustomerDebt(&result), nil
+}
+
+// ListByCustomer lista dívidas de um cliente
+func (r *CustomerDebtRepository) ListByCustomer(ctx context.Context, tenantID, customerID uuid.UUID, somenteAbertas bool) ([]*entity.CustomerDebt, error) {
+	results, err := r.queries.ListCustomerDebtsByCustomer(ctx, db.ListCustomerDebtsByCustomerParams{
+		TenantID:       uuidToPgUUID(tenantID),
+		CustomerID:     uuidToPgUUID(customerID),
+		SomenteAbertas: somenteAbertas,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar dívidas do cliente: %w", err)
+	}
+
+	debts := make([]*entity.CustomerDebt, 0, len(results))
+	for i := range results {
+		debts = append(debts, rowToCustomerDebt(&results[i]))
+	}
+	return debts, nil
+}
+
+// GetSaldoCliente retorna o saldo devedor total do cliente
+func (r *CustomerDebtRepository) GetSaldoCliente(ctx context.Context, tenantID, customerID uuid.UUID) (decimal.Decimal, error) {
+	saldo, err := r.queries.GetCustomerDebtSaldo(ctx, db.GetCustomerDebtSaldoParams{
+		TenantID:   uuidToPgUUID(tenantID),
+		CustomerID: uuidToPgUUID(customerID),
+	})
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("erro ao calcular saldo devedor do cliente: %w", err)
+	}
+	return saldo, nil
+}
+
+// ListAging retorna clientes com saldo devedor, ordenados por saldo e idade
+func (r *CustomerDebtRepository) ListAging(ctx context.Context, tenantID uuid.UUID) ([]*entity.CustomerDebtAging, error) {
+	results, err := r.queries.ListCustomerDebtAging(ctx, uuidToPgUUID(tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao gerar relatório de fiado: %w", err)
+	}
+
+	now := time.Now()
+	items := make([]*entity.CustomerDebtAging, 0, len(results))
+	for _, row := range results {
+		maisAntiga := timestamptzToTime(row.DividaMaisAntiga)
+		items = append(items, &entity.CustomerDebtAging{
+			CustomerID:        pgUUIDToUUID(row.CustomerID),
+			CustomerNome:      row.CustomerNome,
+			CustomerTelefone:  row.CustomerTelefone,
+			QuantidadeDividas: row.QuantidadeDividas,
+			SaldoTotal:        row.SaldoTotal,
+			DividaMaisAntiga:  maisAntiga,
+			DiasEmAberto:      int(now.Sub(maisAntiga).Hours() / 24),
+		})
+	}
+	return items, nil
+}
+
+// ListPayments lista pagamentos de uma dívida
+func (r *CustomerDebtRepository) ListPayments(ctx context.Context, debtID, tenantID uuid.UUID) ([]entity.CustomerDebtPayment, error) {
+	results, err := r.queries.ListCustomerDebtPayments(ctx, db.ListCustomerDebtPaymentsParams{
+		DebtID:   uuidToPgUUID(debtID),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar pagamentos da dívida: %w", err)
+	}
+
+	payments := make([]entity.CustomerDebtPayment, 0, len(results))
+	for _, row := range results {
+		payments = append(payments, entity.CustomerDebtPayment{
+			ID:              pgUUIDToUUID(row.ID),
+			TenantID:        pgUUIDToUUID(row.TenantID),
+			DebtID:          pgUUIDToUUID(row.DebtID),
+			CommandID:       pgUUIDToUUIDPtr(row.CommandID),
+			MeioPagamentoID: pgUUIDToUUID(row.MeioPagamentoID),
+			Valor:           row.Valor,
+			ContaReceberID:  pgUUIDToUUIDPtr(row.ContaReceberID),
+			OperacaoCaixaID: pgUUIDToUUIDPtr(row.OperacaoCaixaID),
+			RecebidoPor:     pgUUIDToUUIDPtr(row.RecebidoPor),
+			Observacoes:     row.Observacoes,
+			CreatedAt:       timestamptzToTime(row.CreatedAt),
+		})
+	}
+	return payments, nil
+}
+
+// ============================================================
+// HELPERS
+// ============================================================
+
+func rowToCustomerDebt(row *db.CustomerDebt) *entity.CustomerDebt {
+	return &entity.CustomerDebt{
+		ID:            pgUUIDToUUID(row.ID),
+		TenantID:      pgUUIDToUUID(row.TenantID),
+		CustomerID:    pgUUIDToUUID(row.CustomerID),
+		CommandID:     pgUUIDToUUID(row.CommandID),
+		ValorOriginal: row.ValorOriginal,
+		ValorPago:     row.ValorPago,
+		Saldo:         row.Saldo,
+		Status:        entity.StatusCustomerDebt(row.Status),
+		DataAbertura:  timestamptzToTime(row.DataAbertura),
+		DataQuitacao:  timestamptzToTimePtr(row.DataQuitacao),
+		Observacoes:   row.Observacoes,
+		CreatedAt:     timestamptzToTime(row.CreatedAt),
+		UpdatedAt:     timestamptzToTime(row.UpdatedAt),
+	}
+}
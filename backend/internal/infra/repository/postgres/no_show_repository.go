@@ -0,0 +1,207 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+// NoShowRepository implementa port.NoShowRepository usando sqlc.
+type NoShowRepository struct {
+	queries *db.Queries
+}
+
+// NewNoShowRepository cria uma nova instância do repositório.
+func NewNoShowRepository(queries *db.Queries) *NoShowRepository {
+	return &NoShowRepository{queries: queries}
+}
+
+// GetPolicy busca a política de não comparecimento do tenant.
+func (r *NoShowRepository) GetPolicy(ctx context.Context, tenantID string) (*entity.NoShowPolicy, error) {
+	row, err := r.queries.GetNoShowPolicy(ctx, uuidStringToPgtype(tenantID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrNoShowPolicyNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar política de não comparecimento: %w", err)
+	}
+	return noShowPolicyRowToDomain(row), nil
+}
+
+// SavePolicy cria ou atualiza a política do tenant.
+func (r *NoShowRepository) SavePolicy(ctx context.Context, policy *entity.NoShowPolicy) error {
+	row, err := r.queries.UpsertNoShowPolicy(ctx, db.UpsertNoShowPolicyParams{
+		TenantID:             entityUUIDToPgtype(policy.TenantID),
+		Enabled:              policy.Enabled,
+		MaxNoShows:           int32(policy.MaxNoShows),
+		WindowDays:           int32(policy.WindowDays),
+		DepositType:          policy.DepositType,
+		DepositValue:         policy.DepositValue,
+		PaymentDeadlineHours: int32(policy.PaymentDeadlineHours),
+		MeioPagamentoID:      uuidStrPtrToPgtype(policy.MeioPagamentoID),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao salvar política de não comparecimento: %w", err)
+	}
+
+	policy.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// AttendanceStats conta o histórico do cliente desde a data informada.
+func (r *NoShowRepository) AttendanceStats(ctx context.Context, tenantID, customerID string, since time.Time) (entity.AttendanceStats, error) {
+	row, err := r.queries.GetCustomerAttendanceStats(ctx, db.GetCustomerAttendanceStatsParams{
+		TenantID:   uuidStringToPgtype(tenantID),
+		CustomerID: uuidStringToPgtype(customerID),
+		StartTime:  timestampToTimestamptz(since),
+	})
+	if err != nil {
+		return entity.AttendanceStats{}, fmt.Errorf("erro ao contar comparecimentos do cliente: %w", err)
+	}
+	return entity.AttendanceStats{
+		Attended: int(row.Attended),
+		NoShows:  int(row.NoShows),
+		Canceled: int(row.Canceled),
+	}, nil
+}
+
+// CreateDeposit registra o sinal pendente do agendamento.
+func (r *NoShowRepository) CreateDeposit(ctx context.Context, deposit *entity.AppointmentDeposit) error {
+	row, err := r.queries.CreateAppointmentDeposit(ctx, db.CreateAppointmentDepositParams{
+		ID:            uuidStringToPgtype(deposit.ID),
+		TenantID:      entityUUIDToPgtype(deposit.TenantID),
+		UnitID:        entityUUIDToPgtype(deposit.UnitID),
+		AppointmentID: uuidStringToPgtype(deposit.AppointmentID),
+		CustomerID:    uuidStringToPgtype(deposit.CustomerID),
+		Amount:        deposit.Amount,
+		DueAt:         timestampToTimestamptz(deposit.DueAt),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao registrar sinal do agendamento: %w", err)
+	}
+
+	deposit.CreatedAt = timestamptzToTime(row.CreatedAt)
+	deposit.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindDepositByAppointment busca o sinal do agendamento.
+func (r *NoShowRepository) FindDepositByAppointment(ctx context.Context, tenantID, appointmentID string) (*entity.AppointmentDeposit, error) {
+	row, err := r.queries.GetAppointmentDepositByAppointment(ctx, db.GetAppointmentDepositByAppointmentParams{
+		AppointmentID: uuidStringToPgtype(appointmentID),
+		TenantID:      uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAppointmentDepositNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar sinal do agendamento: %w", err)
+	}
+	return appointmentDepositRowToDomain(row), nil
+}
+
+// FindDepositByAsaasPayment busca o sinal pela cobrança do Asaas.
+func (r *NoShowRepository) FindDepositByAsaasPayment(ctx context.Context, asaasPaymentID string) (*entity.AppointmentDeposit, error) {
+	row, err := r.queries.GetAppointmentDepositByAsaasPayment(ctx, &asaasPaymentID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrAppointmentDepositNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar sinal pela cobrança: %w", err)
+	}
+	return appointmentDepositRowToDomain(row), nil
+}
+
+// SetDepositCharge grava a cobrança PIX gerada no Asaas.
+func (r *NoShowRepository) SetDepositCharge(ctx context.Context, deposit *entity.AppointmentDeposit) error {
+	row, err := r.queries.SetAppointmentDepositCharge(ctx, db.SetAppointmentDepositChargeParams{
+		ID:             uuidStringToPgtype(deposit.ID),
+		TenantID:       entityUUIDToPgtype(deposit.TenantID),
+		AsaasPaymentID: strPtrToPgText(deposit.AsaasPaymentID),
+		InvoiceUrl:     strPtrToPgText(deposit.InvoiceURL),
+		PixPayload:     strPtrToPgText(deposit.PixPayload),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrAppointmentDepositNotFound
+		}
+		return fmt.Errorf("erro ao gravar cobrança do sinal: %w", err)
+	}
+
+	deposit.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// TransitionDeposit grava o novo status se o sinal ainda estiver em fromStatus.
+func (r *NoShowRepository) TransitionDeposit(ctx context.Context, deposit *entity.AppointmentDeposit, fromStatus string) (bool, error) {
+	n, err := r.queries.TransitionAppointmentDeposit(ctx, db.TransitionAppointmentDepositParams{
+		Status:           deposit.Status,
+		PaidAt:           timestamptzFromTimePtr(deposit.PaidAt),
+		SettledAt:        timestamptzFromTimePtr(deposit.SettledAt),
+		CommandPaymentID: uuidStrPtrToPgtype(deposit.CommandPaymentID),
+		ContaReceberID:   uuidStrPtrToPgtype(deposit.ContaReceberID),
+		ID:               uuidStringToPgtype(deposit.ID),
+		TenantID:         entityUUIDToPgtype(deposit.TenantID),
+		FromStatus:       fromStatus,
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao atualizar sinal do agendamento: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ListOverdueDeposits lista sinais pendentes com prazo vencido.
+func (r *NoShowRepository) ListOverdueDeposits(ctx context.Context, limit int) ([]*entity.AppointmentDeposit, error) {
+	rows, err := r.queries.ListOverdueAppointmentDeposits(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar sinais vencidos: %w", err)
+	}
+
+	out := make([]*entity.AppointmentDeposit, len(rows))
+	for i, row := range rows {
+		out[i] = appointmentDepositRowToDomain(row)
+	}
+	return out, nil
+}
+
+func noShowPolicyRowToDomain(row db.NoShowPolicy) *entity.NoShowPolicy {
+	return &entity.NoShowPolicy{
+		TenantID:             pgtypeToEntityUUID(row.TenantID),
+		Enabled:              row.Enabled,
+		MaxNoShows:           int(row.MaxNoShows),
+		WindowDays:           int(row.WindowDays),
+		DepositType:          row.DepositType,
+		DepositValue:         row.DepositValue,
+		PaymentDeadlineHours: int(row.PaymentDeadlineHours),
+		MeioPagamentoID:      pgUUIDPtrToString(row.MeioPagamentoID),
+		UpdatedAt:            timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func appointmentDepositRowToDomain(row db.AppointmentDeposit) *entity.AppointmentDeposit {
+	return &entity.AppointmentDeposit{
+		ID:               pgUUIDToString(row.ID),
+		TenantID:         pgtypeToEntityUUID(row.TenantID),
+		UnitID:           pgtypeToEntityUUID(row.UnitID),
+		AppointmentID:    pgUUIDToString(row.AppointmentID),
+		CustomerID:       pgUUIDToString(row.CustomerID),
+		Amount:           row.Amount,
+		Status:           row.Status,
+		AsaasPaymentID:   pgTextToStr(row.AsaasPaymentID),
+		InvoiceURL:       pgTextToStr(row.InvoiceUrl),
+		PixPayload:       pgTextToStr(row.PixPayload),
+		DueAt:            timestamptzToTime(row.DueAt),
+		PaidAt:           timestamptzToTimePtr(row.PaidAt),
+		SettledAt:        timestamptzToTimePtr(row.SettledAt),
+		CommandPaymentID: pgUUIDPtrToString(row.CommandPaymentID),
+		ContaReceberID:   pgUUIDPtrToString(row.ContaReceberID),
+		CreatedAt:        timestamptzToTime(row.CreatedAt),
+		UpdatedAt:        timestamptzToTime(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/port"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/google/uuid"
+)
+
+// PlanoContasRepository implementa port.PlanoContasRepository usando PostgreSQL/sqlc
+type PlanoContasRepository struct {
+	queries *db.Queries
+}
+
+// Compile-time check: garante que PlanoContasRepository implementa port.PlanoContasRepository
+var _ port.PlanoContasRepository = (*PlanoContasRepository)(nil)
+
+// NewPlanoContasRepository cria uma nova instância do repositório
+func NewPlanoContasRepository(queries *db.Queries) *PlanoContasRepository {
+	return &PlanoContasRepository{queries: queries}
+}
+
+// Upsert cria ou substitui o mapeamento da origem (tenant, tipo, chave)
+func (r *PlanoContasRepository) Upsert(ctx context.Context, m *entity.PlanoContasMapeamento) error {
+	result, err := r.queries.UpsertPlanoContasMapeamento(ctx, db.UpsertPlanoContasMapeamentoParams{
+		ID:          uuidToPgUUID(m.ID),
+		TenantID:    uuidToPgUUID(m.TenantID),
+		OrigemTipo:  string(m.OrigemTipo),
+		OrigemChave: m.OrigemChave,
+		ContaCodigo: m.ContaCodigo,
+		ContaNome:   m.ContaNome,
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao salvar mapeamento contábil: %w", err)
+	}
+
+	// Em caso de conflito o registro existente é mantido (ID e criação originais)
+	*m = *r.rowToMapeamento(&result)
+	return nil
+}
+
+// List lista os mapeamentos cadastrados do tenant
+func (r *PlanoContasRepository) List(ctx context.Context, tenantID uuid.UUID) ([]*entity.PlanoContasMapeamento, error) {
+	results, err := r.queries.ListPlanoContasMapeamentos(ctx, uuidToPgUUID(tenantID))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar mapeamentos contábeis: %w", err)
+	}
+
+	mapeamentos := make([]*entity.PlanoContasMapeamento, 0, len(results))
+	for i := range results {
+		mapeamentos = append(mapeamentos, r.rowToMapeamento(&results[i]))
+	}
+	return mapeamentos, nil
+}
+
+// Delete remove um mapeamento (a origem volta a usar a conta padrão)
+func (r *PlanoContasRepository) Delete(ctx context.Context, id, tenantID uuid.UUID) error {
+	rows, err := r.queries.DeletePlanoContasMapeamento(ctx, db.DeletePlanoContasMapeamentoParams{
+		ID:       uuidToPgUUID(id),
+		TenantID: uuidToPgUUID(tenantID),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao remover mapeamento contábil: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrPlanoContasMapeamentoNotFound
+	}
+	return nil
+}
+
+func (r *PlanoContasRepository) rowToMapeamento(row *db.PlanoContasMapeamento) *entity.PlanoContasMapeamento {
+	return &entity.PlanoContasMapeamento{
+		ID:          pgUUIDToUUID(row.ID),
+		TenantID:    pgUUIDToUUID(row.TenantID),
+		OrigemTipo:  entity.OrigemContaContabil(row.OrigemTipo),
+		OrigemChave: row.OrigemChave,
+		ContaCodigo: row.ContaCodigo,
+		ContaNome:   row.ContaNome,
+		CreatedAt:   timestamptzToTime(row.CreatedAt),
+		UpdatedAt:   timestamptzToTime(row.UpdatedAt),
+	}
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+)
+
+// RateLimitStorePG implementa o TokenBucketStore do rate limiter sobre a
+// tabela rate_limit_buckets: um único upsert atômico por requisição, com o
+// relógio do banco, vale para todas as réplicas e sobrevive a deploys.
+type RateLimitStorePG struct {
+	queries *db.Queries
+}
+
+// NewRateLimitStore cria o store compartilhado do rate limiting
+func NewRateLimitStore(queries *db.Queries) *RateLimitStorePG {
+	return &RateLimitStorePG{queries: queries}
+}
+
+// Take repõe os tokens pelo tempo decorrido e consome um, se houver
+func (s *RateLimitStorePG) Take(ctx context.Context, key string, capacity, refillPerSecond float64, ttl time.Duration) (float64, bool, error) {
+	row, err := s.queries.TakeRateLimitToken(ctx, db.TakeRateLimitTokenParams{
+		Chave:               key,
+		Capacidade:          capacity,
+		TtlSegundos:         ttl.Seconds(),
+		ReposicaoPorSegundo: refillPerSecond,
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("erro ao consumir token do rate limit: %w", err)
+	}
+	return row.Tokens, row.Permitido, nil
+}
+
+// PurgeExpired remove buckets sem uso há mais de uma janela
+func (s *RateLimitStorePG) PurgeExpired(ctx context.Context) (int64, error) {
+	n, err := s.queries.DeleteExpiredRateLimitBuckets(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao limpar buckets do rate limit: %w", err)
+	}
+	return n, nil
+}
@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ResourceRepository implementa port.ResourceRepository usando sqlc.
+type ResourceRepository struct {
+	queries *db.Queries
+	pool    *pgxpool.Pool
+}
+
+// NewResourceRepository cria uma nova instância do repositório.
+func NewResourceRepository(queries *db.Queries, pool *pgxpool.Pool) *ResourceRepository {
+	return &ResourceRepository{queries: queries, pool: pool}
+}
+
+// Create cadastra um recurso.
+func (r *ResourceRepository) Create(ctx context.Context, resource *entity.Resource) error {
+	row, err := r.queries.CreateResource(ctx, db.CreateResourceParams{
+		ID:       uuidStringToPgtype(resource.ID),
+		TenantID: entityUUIDToPgtype(resource.TenantID),
+		UnitID:   entityUUIDToPgtype(resource.UnitID),
+		Nome:     resource.Nome,
+		Capacity: int32(resource.Capacity),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao criar recurso: %w", err)
+	}
+
+	resource.CreatedAt = timestamptzToTime(row.CreatedAt)
+	resource.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindByID busca um recurso do tenant.
+func (r *ResourceRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.Resource, error) {
+	row, err := r.queries.GetResource(ctx, db.GetResourceParams{
+		ID:       uuidStringToPgtype(id),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar recurso: %w", err)
+	}
+	return resourceRowToDomain(row), nil
+}
+
+// List lista os recursos da unidade.
+func (r *ResourceRepository) List(ctx context.Context, tenantID, unitID string, includeInactive bool) ([]*entity.Resource, error) {
+	rows, err := r.queries.ListResources(ctx, db.ListResourcesParams{
+		TenantID:        uuidStringToPgtype(tenantID),
+		UnitID:          uuidStringToPgtype(unitID),
+		IncludeInactive: includeInactive,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar recursos: %w", err)
+	}
+
+	resources := make([]*entity.Resource, 0, len(rows))
+	for _, row := range rows {
+		resources = append(resources, resourceRowToDomain(row))
+	}
+	return resources, nil
+}
+
+// Update grava nome, capacidade e situação do recurso.
+func (r *ResourceRepository) Update(ctx context.Context, resource *entity.Resource) error {
+	row, err := r.queries.UpdateResource(ctx, db.UpdateResourceParams{
+		ID:       uuidStringToPgtype(resource.ID),
+		TenantID: entityUUIDToPgtype(resource.TenantID),
+		Nome:     resource.Nome,
+		Capacity: int32(resource.Capacity),
+		Ativo:    resource.Ativo,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrResourceNotFound
+		}
+		return fmt.Errorf("erro ao atualizar recurso: %w", err)
+	}
+
+	resource.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// ListServiceRequirements lista os recursos exigidos pelo serviço.
+func (r *ResourceRepository) ListServiceRequirements(ctx context.Context, tenantID, serviceID string) ([]entity.ServiceResourceRequirement, error) {
+	rows, err := r.queries.ListServiceResources(ctx, db.ListServiceResourcesParams{
+		ServicoID: uuidStringToPgtype(serviceID),
+		TenantID:  uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar recursos do serviço: %w", err)
+	}
+
+	requirements := make([]entity.ServiceResourceRequirement, 0, len(rows))
+	for _, row := range rows {
+		requirements = append(requirements, entity.ServiceResourceRequirement{
+			ServiceID:       pgUUIDToString(row.ServicoID),
+			ResourceID:      pgUUIDToString(row.ResourceID),
+			OffsetMinutes:   int(row.OffsetMinutes),
+			DurationMinutes: pgMinutesToInt(row.DurationMinutes),
+			ResourceName:    row.ResourceName,
+		})
+	}
+	return requirements, nil
+}
+
+// ReplaceServiceRequirements substitui os recursos exigidos pelo serviço
+// (transação).
+func (r *ResourceRepository) ReplaceServiceRequirements(ctx context.Context, tenantID, serviceID string, requirements []entity.ServiceResourceRequirement) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("erro ao iniciar transação: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := r.queries.WithTx(tx)
+
+	if err := qtx.DeleteServiceResources(ctx, db.DeleteServiceResourcesParams{
+		ServicoID: uuidStringToPgtype(serviceID),
+		TenantID:  uuidStringToPgtype(tenantID),
+	}); err != nil {
+		return fmt.Errorf("erro ao remover recursos do serviço: %w", err)
+	}
+
+	for _, req := range requirements {
+		if err := qtx.CreateServiceResource(ctx, db.CreateServiceResourceParams{
+			TenantID:        uuidStringToPgtype(tenantID),
+			ServicoID:       uuidStringToPgtype(serviceID),
+			ResourceID:      uuidStringToPgtype(req.ResourceID),
+			OffsetMinutes:   int32(req.OffsetMinutes),
+			DurationMinutes: intToPgMinutes(req.DurationMinutes),
+		}); err != nil {
+			return fmt.Errorf("erro ao gravar recurso do serviço: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("erro ao confirmar transação: %w", err)
+	}
+	return nil
+}
+
+// ListRequirementsForServices lista os recursos ativos da unidade exigidos
+// pelos serviços informados.
+func (r *ResourceRepository) ListRequirementsForServices(ctx context.Context, tenantID, unitID string, serviceIDs []string) ([]entity.ServiceResourceRequirement, error) {
+	rows, err := r.queries.ListResourceRequirementsForServices(ctx, db.ListResourceRequirementsForServicesParams{
+		TenantID:   uuidStringToPgtype(tenantID),
+		UnitID:     uuidStringToPgtype(unitID),
+		ServiceIds: uuidStringsToPgtype(serviceIDs),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar recursos dos serviços: %w", err)
+	}
+
+	requirements := make([]entity.ServiceResourceRequirement, 0, len(rows))
+	for _, row := range rows {
+		requirements = append(requirements, entity.ServiceResourceRequirement{
+			ServiceID:       pgUUIDToString(row.ServicoID),
+			ResourceID:      pgUUIDToString(row.ResourceID),
+			OffsetMinutes:   int(row.OffsetMinutes),
+			DurationMinutes: pgMinutesToInt(row.DurationMinutes),
+			ResourceName:    row.ResourceName,
+		})
+	}
+	return requirements, nil
+}
+
+// PeakUsage retorna o maior número de reservas simultâneas do recurso no
+// período, ignorando o agendamento informado.
+func (r *ResourceRepository) PeakUsage(ctx context.Context, tenantID, resourceID string, start, end time.Time, excludeAppointmentID string) (int, error) {
+	return resourcePeakUsage(ctx, r.queries, tenantID, resourceID, start, end, excludeAppointmentID)
+}
+
+// resourcePeakUsage consulta o pico de uso do recurso; usado também dentro da
+// transação do agendamento
+func resourcePeakUsage(ctx context.Context, q *db.Queries, tenantID, resourceID string, start, end time.Time, excludeAppointmentID string) (int, error) {
+	excludeID := pgtype.UUID{}
+	if excludeAppointmentID != "" {
+		excludeID = uuidStringToPgtype(excludeAppointmentID)
+	}
+
+	peak, err := q.GetResourcePeakUsage(ctx, db.GetResourcePeakUsageParams{
+		TenantID:   uuidStringToPgtype(tenantID),
+		ResourceID: uuidStringToPgtype(resourceID),
+		ExcludeID:  excludeID,
+		EndTime:    timestampToTimestamptz(end),
+		StartTime:  timestampToTimestamptz(start),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("erro ao verificar uso do recurso: %w", err)
+	}
+	return int(peak), nil
+}
+
+// === Métodos de conversão ===
+
+func resourceRowToDomain(row db.Resource) *entity.Resource {
+	return &entity.Resource{
+		ID:        pgUUIDToString(row.ID),
+		TenantID:  pgtypeToEntityUUID(row.TenantID),
+		UnitID:    pgtypeToEntityUUID(row.UnitID),
+		Nome:      row.Nome,
+		Capacity:  int(row.Capacity),
+		Ativo:     row.Ativo,
+		CreatedAt: timestamptzToTime(row.CreatedAt),
+		UpdatedAt: timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+// intToPgMinutes grava duração zero como NULL (até o fim do serviço)
+func intToPgMinutes(minutes int) *int32 {
+	if minutes <= 0 {
+		return nil
+	}
+	v := int32(minutes)
+	return &v
+}
+
+func pgMinutesToInt(minutes *int32) int {
+	if minutes == nil {
+		return 0
+	}
+	return int(*minutes)
+}
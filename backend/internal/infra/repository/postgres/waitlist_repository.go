@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// WaitlistRepository implementa port.WaitlistRepository usando sqlc.
+type WaitlistRepository struct {
+	queries *db.Queries
+}
+
+// NewWaitlistRepository cria uma nova instância do repositório.
+func NewWaitlistRepository(queries *db.Queries) *WaitlistRepository {
+	return &WaitlistRepository{queries: queries}
+}
+
+// CreateEntry inclui o cliente na lista de espera.
+func (r *WaitlistRepository) CreateEntry(ctx context.Context, entry *entity.WaitlistEntry) error {
+	row, err := r.queries.CreateWaitlistEntry(ctx, db.CreateWaitlistEntryParams{
+		ID:              uuidStringToPgtype(entry.ID),
+		TenantID:        entityUUIDToPgtype(entry.TenantID),
+		UnitID:          entityUUIDToPgtype(entry.UnitID),
+		CustomerID:      uuidStringToPgtype(entry.CustomerID),
+		ServiceIds:      uuidStringsToPgtype(entry.ServiceIDs),
+		ProfessionalIds: uuidStringsToPgtype(entry.ProfessionalIDs),
+		WindowStart:     timestampToTimestamptz(entry.WindowStart),
+		WindowEnd:       timestampToTimestamptz(entry.WindowEnd),
+		Priority:        int32(entry.Priority),
+		Notes:           strPtrToPgText(entry.Notes),
+		CreatedBy:       uuidStrPtrToPgtype(entry.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao incluir na lista de espera: %w", err)
+	}
+
+	entry.CreatedAt = timestamptzToTime(row.CreatedAt)
+	entry.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindEntryByID busca uma entrada do tenant.
+func (r *WaitlistRepository) FindEntryByID(ctx context.Context, tenantID, id string) (*entity.WaitlistEntry, error) {
+	row, err := r.queries.GetWaitlistEntry(ctx, db.GetWaitlistEntryParams{
+		ID:       uuidStringToPgtype(id),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWaitlistEntryNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar entrada da lista de espera: %w", err)
+	}
+	return waitlistEntryRowToDomain(row), nil
+}
+
+// UpdateEntryStatus grava status e agendamento da entrada.
+func (r *WaitlistRepository) UpdateEntryStatus(ctx context.Context, entry *entity.WaitlistEntry) error {
+	row, err := r.queries.UpdateWaitlistEntryStatus(ctx, db.UpdateWaitlistEntryStatusParams{
+		ID:            uuidStringToPgtype(entry.ID),
+		TenantID:      entityUUIDToPgtype(entry.TenantID),
+		Status:        entry.Status,
+		AppointmentID: uuidStrPtrToPgtype(entry.AppointmentID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ErrWaitlistEntryNotFound
+		}
+		return fmt.Errorf("erro ao atualizar entrada da lista de espera: %w", err)
+	}
+
+	entry.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// ListEntries lista as entradas da unidade (status vazio = todas).
+func (r *WaitlistRepository) ListEntries(ctx context.Context, tenantID, unitID, status string) ([]*entity.WaitlistEntry, error) {
+	rows, err := r.queries.ListWaitlistEntries(ctx, db.ListWaitlistEntriesParams{
+		TenantID: uuidStringToPgtype(tenantID),
+		UnitID:   uuidStringToPgtype(unitID),
+		Status:   strPtrToPgText(status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar lista de espera: %w", err)
+	}
+
+	out := make([]*entity.WaitlistEntry, len(rows))
+	for i, row := range rows {
+		out[i] = waitlistEntryRowToDomain(row)
+	}
+	return out, nil
+}
+
+// ListCandidates lista as entradas que podem receber o horário liberado.
+func (r *WaitlistRepository) ListCandidates(ctx context.Context, tenantID, unitID, professionalID string, slotStart, slotEnd time.Time, limit int) ([]*entity.WaitlistEntry, error) {
+	rows, err := r.queries.ListWaitlistCandidates(ctx, db.ListWaitlistCandidatesParams{
+		TenantID:       uuidStringToPgtype(tenantID),
+		UnitID:         uuidStringToPgtype(unitID),
+		SlotEnd:        timestampToTimestamptz(slotEnd),
+		SlotStart:      timestampToTimestamptz(slotStart),
+		ProfessionalID: uuidStringToPgtype(professionalID),
+		Limite:         int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao buscar candidatos da lista de espera: %w", err)
+	}
+
+	out := make([]*entity.WaitlistEntry, len(rows))
+	for i, row := range rows {
+		out[i] = waitlistEntryRowToDomain(row)
+	}
+	return out, nil
+}
+
+// ExpireEntries encerra as entradas com período vencido.
+func (r *WaitlistRepository) ExpireEntries(ctx context.Context) (int64, error) {
+	n, err := r.queries.ExpireWaitlistEntries(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("erro ao expirar lista de espera: %w", err)
+	}
+	return n, nil
+}
+
+// CreateOffer registra a oferta; false se o horário já tem oferta pendente.
+func (r *WaitlistRepository) CreateOffer(ctx context.Context, offer *entity.WaitlistOffer) (bool, error) {
+	n, err := r.queries.CreateWaitlistOffer(ctx, db.CreateWaitlistOfferParams{
+		ID:             uuidStringToPgtype(offer.ID),
+		TenantID:       entityUUIDToPgtype(offer.TenantID),
+		UnitID:         entityUUIDToPgtype(offer.UnitID),
+		EntryID:        uuidStringToPgtype(offer.EntryID),
+		ProfessionalID: uuidStringToPgtype(offer.ProfessionalID),
+		SlotStart:      timestampToTimestamptz(offer.SlotStart),
+		SlotEnd:        timestampToTimestamptz(offer.SlotEnd),
+		StartTime:      timestampToTimestamptz(offer.StartTime),
+		EndTime:        timestampToTimestamptz(offer.EndTime),
+		TokenHash:      offer.TokenHash,
+		ExpiresAt:      timestampToTimestamptz(offer.ExpiresAt),
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao registrar oferta da lista de espera: %w", err)
+	}
+	return n > 0, nil
+}
+
+// FindOfferByTokenHash busca a oferta pelo hash do token do link.
+func (r *WaitlistRepository) FindOfferByTokenHash(ctx context.Context, tokenHash string) (*entity.WaitlistOffer, error) {
+	row, err := r.queries.GetWaitlistOfferByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWaitlistOfferNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar oferta da lista de espera: %w", err)
+	}
+	return waitlistOfferRowToDomain(row), nil
+}
+
+// FindPendingOfferByEntry busca a oferta pendente da entrada.
+func (r *WaitlistRepository) FindPendingOfferByEntry(ctx context.Context, tenantID, entryID string) (*entity.WaitlistOffer, error) {
+	row, err := r.queries.GetPendingWaitlistOfferByEntry(ctx, db.GetPendingWaitlistOfferByEntryParams{
+		EntryID:  uuidStringToPgtype(entryID),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWaitlistOfferNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar oferta pendente: %w", err)
+	}
+	return waitlistOfferRowToDomain(row), nil
+}
+
+// TransitionOffer muda o status se a oferta ainda estiver em fromStatus.
+func (r *WaitlistRepository) TransitionOffer(ctx context.Context, offerID, fromStatus, status, appointmentID string) (bool, error) {
+	n, err := r.queries.TransitionWaitlistOffer(ctx, db.TransitionWaitlistOfferParams{
+		Status:        status,
+		AppointmentID: uuidStrPtrToPgtype(appointmentID),
+		ID:            uuidStringToPgtype(offerID),
+		FromStatus:    fromStatus,
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao atualizar oferta da lista de espera: %w", err)
+	}
+	return n > 0, nil
+}
+
+// ListExpiredOffers lista ofertas pendentes com prazo vencido.
+func (r *WaitlistRepository) ListExpiredOffers(ctx context.Context, limit int) ([]*entity.WaitlistOffer, error) {
+	rows, err := r.queries.ListExpiredWaitlistOffers(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar ofertas expiradas: %w", err)
+	}
+
+	out := make([]*entity.WaitlistOffer, len(rows))
+	for i, row := range rows {
+		out[i] = waitlistOfferRowToDomain(row)
+	}
+	return out, nil
+}
+
+func uuidStringsToPgtype(ids []string) []pgtype.UUID {
+	out := make([]pgtype.UUID, len(ids))
+	for i, id := range ids {
+		out[i] = uuidStringToPgtype(id)
+	}
+	return out
+}
+
+func pgtypeUUIDsToStrings(ids []pgtype.UUID) []string {
+	out := make([]string, len(ids))
+	for i, id := range ids {
+		out[i] = pgUUIDToString(id)
+	}
+	return out
+}
+
+func waitlistEntryRowToDomain(row db.WaitlistEntry) *entity.WaitlistEntry {
+	return &entity.WaitlistEntry{
+		ID:              pgUUIDToString(row.ID),
+		TenantID:        pgtypeToEntityUUID(row.TenantID),
+		UnitID:          pgtypeToEntityUUID(row.UnitID),
+		CustomerID:      pgUUIDToString(row.CustomerID),
+		ServiceIDs:      pgtypeUUIDsToStrings(row.ServiceIds),
+		ProfessionalIDs: pgtypeUUIDsToStrings(row.ProfessionalIds),
+		WindowStart:     timestamptzToTime(row.WindowStart),
+		WindowEnd:       timestamptzToTime(row.WindowEnd),
+		Priority:        int(row.Priority),
+		Notes:           pgTextToStr(row.Notes),
+		Status:          row.Status,
+		AppointmentID:   pgUUIDPtrToString(row.AppointmentID),
+		CreatedBy:       pgUUIDPtrToString(row.CreatedBy),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+	}
+}
+
+func waitlistOfferRowToDomain(row db.WaitlistOffer) *entity.WaitlistOffer {
+	return &entity.WaitlistOffer{
+		ID:             pgUUIDToString(row.ID),
+		TenantID:       pgtypeToEntityUUID(row.TenantID),
+		UnitID:         pgtypeToEntityUUID(row.UnitID),
+		EntryID:        pgUUIDToString(row.EntryID),
+		ProfessionalID: pgUUIDToString(row.ProfessionalID),
+		SlotStart:      timestamptzToTime(row.SlotStart),
+		SlotEnd:        timestamptzToTime(row.SlotEnd),
+		StartTime:      timestamptzToTime(row.StartTime),
+		EndTime:        timestamptzToTime(row.EndTime),
+		TokenHash:      row.TokenHash,
+		ExpiresAt:      timestamptzToTime(row.ExpiresAt),
+		Status:         row.Status,
+		AppointmentID:  pgUUIDPtrToString(row.AppointmentID),
+		RespondedAt:    timestamptzToTimePtr(row.RespondedAt),
+		CreatedAt:      timestamptzToTime(row.CreatedAt),
+	}
+}
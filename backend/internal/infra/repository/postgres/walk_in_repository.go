@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andviana23/barber-analytics-backend/internal/domain"
+	"github.com/andviana23/barber-analytics-backend/internal/domain/entity"
+	db "github.com/andviana23/barber-analytics-backend/internal/infra/db/sqlc"
+	"github.com/jackc/pgx/v5"
+)
+
+// WalkInRepository implementa port.WalkInRepository usando sqlc.
+type WalkInRepository struct {
+	queries *db.Queries
+}
+
+// NewWalkInRepository cria uma nova instância do repositório.
+func NewWalkInRepository(queries *db.Queries) *WalkInRepository {
+	return &WalkInRepository{queries: queries}
+}
+
+// Create registra a chegada do cliente na fila de encaixe.
+func (r *WalkInRepository) Create(ctx context.Context, walkIn *entity.WalkIn) error {
+	row, err := r.queries.CreateWalkIn(ctx, db.CreateWalkInParams{
+		ID:              uuidStringToPgtype(walkIn.ID),
+		TenantID:        entityUUIDToPgtype(walkIn.TenantID),
+		UnitID:          entityUUIDToPgtype(walkIn.UnitID),
+		CustomerID:      uuidStringToPgtype(walkIn.CustomerID),
+		ServiceIds:      uuidStringsToPgtype(walkIn.ServiceIDs),
+		DurationMinutes: int32(walkIn.DurationMinutes),
+		Notes:           strPtrToPgText(walkIn.Notes),
+		CreatedBy:       uuidStrPtrToPgtype(walkIn.CreatedBy),
+	})
+	if err != nil {
+		return fmt.Errorf("erro ao registrar chegada na fila de encaixe: %w", err)
+	}
+
+	walkIn.CheckedInAt = timestamptzToTime(row.CheckedInAt)
+	walkIn.CreatedAt = timestamptzToTime(row.CreatedAt)
+	walkIn.UpdatedAt = timestamptzToTime(row.UpdatedAt)
+	return nil
+}
+
+// FindByID busca uma chegada do tenant.
+func (r *WalkInRepository) FindByID(ctx context.Context, tenantID, id string) (*entity.WalkIn, error) {
+	row, err := r.queries.GetWalkIn(ctx, db.GetWalkInParams{
+		ID:       uuidStringToPgtype(id),
+		TenantID: uuidStringToPgtype(tenantID),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrWalkInNotFound
+		}
+		return nil, fmt.Errorf("erro ao buscar chegada na fila de encaixe: %w", err)
+	}
+	return walkInRowToDomain(row), nil
+}
+
+// List lista as chegadas da unidade desde since (status vazio = todos).
+func (r *WalkInRepository) List(ctx context.Context, tenantID, unitID string, since time.Time, status string) ([]*entity.WalkIn, error) {
+	rows, err := r.queries.ListWalkIns(ctx, db.ListWalkInsParams{
+		TenantID: uuidStringToPgtype(tenantID),
+		UnitID:   uuidStringToPgtype(unitID),
+		Since:    timestampToTimestamptz(since),
+		Status:   strPtrToPgText(status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("erro ao listar fila de encaixe: %w", err)
+	}
+
+	out := make([]*entity.WalkIn, len(rows))
+	for i, row := range rows {
+		out[i] = walkInRowToDomain(db.GetWalkInRow(row))
+	}
+	return out, nil
+}
+
+// Transition grava status, barbeiro e agendamento se a chegada ainda estiver
+// em fromStatus.
+func (r *WalkInRepository) Transition(ctx context.Context, walkIn *entity.WalkIn, fromStatus string) (bool, error) {
+	n, err := r.queries.TransitionWalkIn(ctx, db.TransitionWalkInParams{
+		Status:         walkIn.Status,
+		ProfessionalID: uuidStrPtrToPgtype(walkIn.ProfessionalID),
+		AppointmentID:  uuidStrPtrToPgtype(walkIn.AppointmentID),
+		ID:             uuidStringToPgtype(walkIn.ID),
+		TenantID:       entityUUIDToPgtype(walkIn.TenantID),
+		FromStatus:     fromStatus,
+	})
+	if err != nil {
+		return false, fmt.Errorf("erro ao atualizar fila de encaixe: %w", err)
+	}
+	return n > 0, nil
+}
+
+func walkInRowToDomain(row db.GetWalkInRow) *entity.WalkIn {
+	return &entity.WalkIn{
+		ID:              pgUUIDToString(row.ID),
+		TenantID:        pgtypeToEntityUUID(row.TenantID),
+		UnitID:          pgtypeToEntityUUID(row.UnitID),
+		CustomerID:      pgUUIDToString(row.CustomerID),
+		ServiceIDs:      pgtypeUUIDsToStrings(row.ServiceIds),
+		DurationMinutes: int(row.DurationMinutes),
+		Notes:           pgTextToStr(row.Notes),
+		Status:          row.Status,
+		ProfessionalID:  pgUUIDPtrToString(row.ProfessionalID),
+		AppointmentID:   pgUUIDPtrToString(row.AppointmentID),
+		CreatedBy:       pgUUIDPtrToString(row.CreatedBy),
+		CheckedInAt:     timestamptzToTime(row.CheckedInAt),
+		AssignedAt:      timestamptzToTimePtr(row.AssignedAt),
+		CanceledAt:      timestamptzToTimePtr(row.CanceledAt),
+		CreatedAt:       timestamptzToTime(row.CreatedAt),
+		UpdatedAt:       timestamptzToTime(row.UpdatedAt),
+		CustomerName:    row.CustomerName,
+	}
+}
@@ -25,6 +25,34 @@ type SubscriptionJobDeps struct {
 	ProcessOverdue *subscriptionUC.ProcessOverdueSubscriptionsUseCase
 }
 
+// MaintenanceJobDeps agrega rotinas de manutenção que não dependem de tenant
+type MaintenanceJobDeps struct {
+	RateLimitStore interface {
+		PurgeExpired(ctx context.Context) (int64, error)
+	}
+	BusinessMetrics interface {
+		Refresh(ctx context.Context) error
+	}
+	OutboundWebhooks interface {
+		DeliverPending(ctx context.Context) error
+	}
+	AppointmentSeries interface {
+		Execute(ctx context.Context) (int, error)
+	}
+	WaitlistOffers interface {
+		Execute(ctx context.Context) (int, error)
+	}
+	NoShowDeposits interface {
+		Execute(ctx context.Context) (int, error)
+	}
+	CalendarPush interface {
+		Execute(ctx context.Context) (int, error)
+	}
+	CalendarPull interface {
+		Execute(ctx context.Context) (int, error)
+	}
+}
+
 // RegisterFinancialJobs registra os cron jobs financeiros com base nas envs.
 func RegisterFinancialJobs(s *Scheduler, logger *zap.Logger, deps FinancialJobDeps, tenants []string) error {
 	// DRE mensal (mês anterior, todo dia 1 às 03:00)
@@ -211,6 +239,135 @@ func RegisterSubscriptionJobs(s *Scheduler, logger *zap.Logger, deps Subscriptio
 	return nil
 }
 
+// RegisterMaintenanceJobs registra cron jobs de manutenção
+func RegisterMaintenanceJobs(s *Scheduler, logger *zap.Logger, deps MaintenanceJobDeps) error {
+	// Limpar buckets expirados do rate limiting (store Postgres)
+	if deps.RateLimitStore != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "PurgeRateLimitBuckets",
+			Schedule:    getEnvSchedule("CRON_RATE_LIMIT_PURGE_SCHEDULE", "0 */10 * * * *"),
+			Enabled:     getEnvBool("CRON_RATE_LIMIT_PURGE_ENABLED", true),
+			FeatureFlag: "FF_CRON_RATE_LIMIT_PURGE",
+			Job: func(ctx context.Context) error {
+				n, err := deps.RateLimitStore.PurgeExpired(ctx)
+				if err == nil && n > 0 {
+					logger.Debug("Buckets de rate limit removidos", zap.Int64("total", n))
+				}
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Recalcular gauges de negócio exportados ao Prometheus
+	if deps.BusinessMetrics != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "RefreshBusinessMetrics",
+			Schedule:    getEnvSchedule("CRON_BUSINESS_METRICS_SCHEDULE", "0 * * * * *"),
+			Enabled:     getEnvBool("CRON_BUSINESS_METRICS_ENABLED", true),
+			FeatureFlag: "FF_CRON_BUSINESS_METRICS",
+			Job:         deps.BusinessMetrics.Refresh,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Enviar webhooks de saída pendentes e retentativas vencidas
+	if deps.OutboundWebhooks != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "DeliverOutboundWebhooks",
+			Schedule:    getEnvSchedule("CRON_OUTBOUND_WEBHOOKS_SCHEDULE", "*/15 * * * * *"),
+			Enabled:     getEnvBool("CRON_OUTBOUND_WEBHOOKS_ENABLED", true),
+			FeatureFlag: "FF_CRON_OUTBOUND_WEBHOOKS",
+			Job:         deps.OutboundWebhooks.DeliverPending,
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Gerar ocorrências das séries de agendamentos recorrentes (diário, 02:30)
+	if deps.AppointmentSeries != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "GenerateAppointmentSeries",
+			Schedule:    getEnvSchedule("CRON_APPOINTMENT_SERIES_SCHEDULE", "0 30 2 * * *"),
+			Enabled:     getEnvBool("CRON_APPOINTMENT_SERIES_ENABLED", true),
+			FeatureFlag: "FF_CRON_APPOINTMENT_SERIES",
+			Job: func(ctx context.Context) error {
+				_, err := deps.AppointmentSeries.Execute(ctx)
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Expirar ofertas da lista de espera e passar o horário adiante (a cada minuto)
+	if deps.WaitlistOffers != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "ExpireWaitlistOffers",
+			Schedule:    getEnvSchedule("CRON_WAITLIST_OFFERS_SCHEDULE", "0 * * * * *"),
+			Enabled:     getEnvBool("CRON_WAITLIST_OFFERS_ENABLED", true),
+			FeatureFlag: "FF_CRON_WAITLIST_OFFERS",
+			Job: func(ctx context.Context) error {
+				_, err := deps.WaitlistOffers.Execute(ctx)
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Cancelar agendamentos com sinal não pago no prazo (a cada minuto)
+	if deps.NoShowDeposits != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "ExpireNoShowDeposits",
+			Schedule:    getEnvSchedule("CRON_NO_SHOW_DEPOSITS_SCHEDULE", "0 * * * * *"),
+			Enabled:     getEnvBool("CRON_NO_SHOW_DEPOSITS_ENABLED", true),
+			FeatureFlag: "FF_CRON_NO_SHOW_DEPOSITS",
+			Job: func(ctx context.Context) error {
+				_, err := deps.NoShowDeposits.Execute(ctx)
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Enviar agendamentos da fila ao Google Agenda (a cada minuto)
+	if deps.CalendarPush != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "PushCalendarEvents",
+			Schedule:    getEnvSchedule("CRON_CALENDAR_PUSH_SCHEDULE", "0 * * * * *"),
+			Enabled:     getEnvBool("CRON_CALENDAR_PUSH_ENABLED", true),
+			FeatureFlag: "FF_CRON_CALENDAR_PUSH",
+			Job: func(ctx context.Context) error {
+				_, err := deps.CalendarPush.Execute(ctx)
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Importar eventos ocupados do Google Agenda como bloqueios (a cada 5 minutos)
+	if deps.CalendarPull != nil {
+		if err := s.AddJob(JobConfig{
+			Name:        "PullCalendarEvents",
+			Schedule:    getEnvSchedule("CRON_CALENDAR_PULL_SCHEDULE", "0 */5 * * * *"),
+			Enabled:     getEnvBool("CRON_CALENDAR_PULL_ENABLED", true),
+			FeatureFlag: "FF_CRON_CALENDAR_PULL",
+			Job: func(ctx context.Context) error {
+				_, err := deps.CalendarPull.Execute(ctx)
+				return err
+			},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func getEnvSchedule(key, def string) string {
 	val := os.Getenv(key)
 	if val == "" {
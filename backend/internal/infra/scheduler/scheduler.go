@@ -6,9 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/andviana23/barber-analytics-backend/internal/infra/telemetry"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/robfig/cron/v3"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -36,8 +39,10 @@ type Scheduler struct {
 
 // Metrics agrupa métricas Prometheus para os jobs.
 type Metrics struct {
-	duration *prometheus.SummaryVec
-	errors   *prometheus.CounterVec
+	duration    *prometheus.SummaryVec
+	errors      *prometheus.CounterVec
+	runs        *prometheus.CounterVec
+	lastSuccess *prometheus.GaugeVec
 }
 
 // New cria um scheduler com cron spec padrão (segundos habilitados).
@@ -68,6 +73,14 @@ func newMetrics() *Metrics {
 			Name: "bap_scheduler_job_errors_total",
 			Help: "Total de erros por job",
 		}, []string{"job"}),
+		runs: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "bap_scheduler_job_runs_total",
+			Help: "Execuções de cada job por resultado (sucesso, falha)",
+		}, []string{"job", "status"}),
+		lastSuccess: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "bap_scheduler_job_last_success_timestamp",
+			Help: "Timestamp Unix da última execução bem-sucedida de cada job",
+		}, []string{"job"}),
 	}
 }
 
@@ -100,7 +113,13 @@ func (s *Scheduler) AddJob(cfg JobConfig) error {
 func (s *Scheduler) wrap(cfg JobConfig) func() {
 	return func() {
 		start := time.Now()
-		ctx := context.Background()
+		// Cada execução é a raiz de um trace ("job <nome>"); as queries e
+		// use cases chamados pelo job viram spans filhos
+		ctx, span := telemetry.Tracer().Start(context.Background(), "job "+cfg.Name,
+			trace.WithAttributes(attribute.String("job.name", cfg.Name)),
+		)
+		defer span.End()
+		logger := telemetry.Logger(ctx, s.logger)
 
 		var err error
 		if len(cfg.Tenants) > 0 && cfg.TenantRunner != nil {
@@ -108,10 +127,10 @@ func (s *Scheduler) wrap(cfg JobConfig) func() {
 				if tenantID == "" {
 					continue
 				}
-				if e := cfg.TenantRunner(ctx, tenantID); e != nil {
+				if e := s.runTenant(ctx, cfg, tenantID); e != nil {
 					err = e
 					s.metrics.errors.WithLabelValues(cfg.Name).Inc()
-					s.logger.Warn("Erro ao executar job para tenant",
+					logger.Warn("Erro ao executar job para tenant",
 						zap.String("job", cfg.Name),
 						zap.String("tenant_id", tenantID),
 						zap.Error(e),
@@ -122,21 +141,40 @@ func (s *Scheduler) wrap(cfg JobConfig) func() {
 			err = cfg.Job(ctx)
 			if err != nil {
 				s.metrics.errors.WithLabelValues(cfg.Name).Inc()
-				s.logger.Warn("Erro ao executar job",
+				logger.Warn("Erro ao executar job",
 					zap.String("job", cfg.Name),
 					zap.Error(err),
 				)
 			}
 		} else {
-			s.logger.Warn("Job sem função associada", zap.String("job", cfg.Name))
+			logger.Warn("Job sem função associada", zap.String("job", cfg.Name))
 		}
+		telemetry.RecordError(span, err)
 
 		elapsed := time.Since(start)
 		s.metrics.duration.WithLabelValues(cfg.Name).Observe(elapsed.Seconds())
+		if err != nil {
+			s.metrics.runs.WithLabelValues(cfg.Name, "falha").Inc()
+		} else {
+			s.metrics.runs.WithLabelValues(cfg.Name, "sucesso").Inc()
+			s.metrics.lastSuccess.WithLabelValues(cfg.Name).SetToCurrentTime()
+		}
 		s.runLogFn(0, cfg, start, err)
 	}
 }
 
+// runTenant executa o job de um tenant em um span próprio
+func (s *Scheduler) runTenant(ctx context.Context, cfg JobConfig, tenantID string) error {
+	ctx, span := telemetry.Tracer().Start(ctx, "job "+cfg.Name+" tenant",
+		trace.WithAttributes(attribute.String("tenant.id", tenantID)),
+	)
+	defer span.End()
+
+	err := cfg.TenantRunner(ctx, tenantID)
+	telemetry.RecordError(span, err)
+	return err
+}
+
 // Start inicia o cron em background.
 func (s *Scheduler) Start() {
 	s.cron.Start()
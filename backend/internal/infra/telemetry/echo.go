@@ -0,0 +1,112 @@
+package telemetry
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// EchoMiddleware cria um span de servidor por requisição, continuando o trace
+// recebido em traceparent. O nome usa o template da rota (GET /api/v1/commands/:id)
+// e o trace id volta no cabeçalho X-Trace-ID. Erros 5xx são registrados no
+// log com trace_id.
+func EchoMiddleware(logger *zap.Logger) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := c.Path()
+			if route == "" {
+				route = req.URL.Path
+			}
+			ctx, span := Tracer().Start(ctx, req.Method+" "+route,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					attribute.String("http.request.method", req.Method),
+					attribute.String("http.route", route),
+					attribute.String("url.path", req.URL.Path),
+					attribute.String("client.address", c.RealIP()),
+					attribute.String("user_agent.original", req.UserAgent()),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ctx))
+			if sc := span.SpanContext(); sc.IsValid() {
+				c.Response().Header().Set("X-Trace-ID", sc.TraceID().String())
+			}
+
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				if he, ok := err.(*echo.HTTPError); ok {
+					status = he.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+			span.SetAttributes(attribute.Int("http.response.status_code", status))
+			if userID, ok := c.Get("user_id").(string); ok && userID != "" {
+				span.SetAttributes(attribute.String("enduser.id", userID))
+			}
+			if tenantID, ok := c.Get("tenant_id").(string); ok && tenantID != "" {
+				span.SetAttributes(attribute.String("tenant.id", tenantID))
+			}
+
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, http.StatusText(status))
+				if err != nil {
+					span.RecordError(err)
+				}
+				Logger(ctx, logger).Error("Erro interno na requisição",
+					zap.String("method", req.Method),
+					zap.String("route", route),
+					zap.Int("status", status),
+					zap.String("error", fmt.Sprint(err)),
+				)
+			}
+			return err
+		}
+	}
+}
+
+// AccessLog registra cada requisição no logger da aplicação, com trace_id e
+// span_id do span criado por EchoMiddleware (deve ser registrado depois dele).
+// Erros seguem para o error handler do echo, que define o status do log.
+func AccessLog(logger *zap.Logger) echo.MiddlewareFunc {
+	return middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		HandleError:  true,
+		LogMethod:    true,
+		LogURI:       true,
+		LogRoutePath: true,
+		LogStatus:    true,
+		LogLatency:   true,
+		LogRemoteIP:  true,
+		LogError:     true,
+		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
+			fields := []zap.Field{
+				zap.String("method", v.Method),
+				zap.String("uri", v.URI),
+				zap.String("route", v.RoutePath),
+				zap.Int("status", v.Status),
+				zap.Duration("latency", v.Latency),
+				zap.String("remote_ip", v.RemoteIP),
+			}
+			if v.Error != nil {
+				fields = append(fields, zap.Error(v.Error))
+			}
+			Logger(c.Request().Context(), logger).Info("requisição HTTP", fields...)
+			return nil
+		},
+	})
+}
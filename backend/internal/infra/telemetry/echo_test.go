@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	prevProvider, prevPropagator := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevProvider)
+		otel.SetTextMapPropagator(prevPropagator)
+	})
+	return recorder
+}
+
+func TestEchoMiddleware_ContinuaTraceERetornaTraceID(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	e := echo.New()
+	e.Use(EchoMiddleware(zap.NewNop()))
+	var ctxDoHandler trace.SpanContext
+	e.GET("/api/v1/commands/:id", func(c echo.Context) error {
+		ctxDoHandler = trace.SpanContextFromContext(c.Request().Context())
+		c.Set("tenant_id", "tenant-1")
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/123", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "GET /api/v1/commands/:id", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", rec.Header().Get("X-Trace-ID"))
+	assert.Equal(t, span.SpanContext().SpanID(), ctxDoHandler.SpanID())
+	assert.Contains(t, span.Attributes(), attribute.String("tenant.id", "tenant-1"))
+}
+
+func TestEchoMiddleware_ErroInternoMarcaSpan(t *testing.T) {
+	recorder := setupRecorder(t)
+
+	e := echo.New()
+	e.Use(EchoMiddleware(zap.NewNop()))
+	e.GET("/falha", func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/falha", nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Contains(t, spans[0].Attributes(), attribute.Int("http.response.status_code", http.StatusInternalServerError))
+}
+
+func TestAccessLog_RegistraTraceID(t *testing.T) {
+	setupRecorder(t)
+	core, logs := observer.New(zap.InfoLevel)
+
+	e := echo.New()
+	e.Use(EchoMiddleware(zap.NewNop()))
+	e.Use(AccessLog(zap.New(core)))
+	e.GET("/api/v1/commands/:id", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/commands/123", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	require.Equal(t, 1, logs.Len())
+	fields := logs.All()[0].ContextMap()
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", fields["trace_id"])
+	assert.Equal(t, "/api/v1/commands/:id", fields["route"])
+	assert.EqualValues(t, http.StatusOK, fields["status"])
+}
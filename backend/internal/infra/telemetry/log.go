@@ -0,0 +1,29 @@
+package telemetry
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// TraceFields retorna trace_id e span_id do span ativo no contexto (vazio se
+// não houver span amostrado), para correlacionar logs e traces
+func TraceFields(ctx context.Context) []zap.Field {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	return []zap.Field{
+		zap.String("trace_id", sc.TraceID().String()),
+		zap.String("span_id", sc.SpanID().String()),
+	}
+}
+
+// Logger retorna o logger com trace_id/span_id do contexto
+func Logger(ctx context.Context, logger *zap.Logger) *zap.Logger {
+	if fields := TraceFields(ctx); fields != nil {
+		return logger.With(fields...)
+	}
+	return logger
+}
@@ -0,0 +1,56 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// sqlcQueryName extrai o nome da query do cabeçalho gerado pelo sqlc
+// ("-- name: GetCommandByID :one")
+var sqlcQueryName = regexp.MustCompile(`^\s*--\s*name:\s*(\w+)`)
+
+// PgxTracer implementa pgx.QueryTracer: um span de cliente por query, nomeado
+// pela query sqlc quando disponível. Os parâmetros não são registrados.
+type PgxTracer struct{}
+
+// NewPgxTracer cria o tracer de queries (ConnConfig.Tracer)
+func NewPgxTracer() *PgxTracer {
+	return &PgxTracer{}
+}
+
+// TraceQueryStart abre o span da query
+func (t *PgxTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := "db.query"
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", "postgresql"),
+		attribute.String("db.statement", data.SQL),
+	}
+	if m := sqlcQueryName.FindStringSubmatch(data.SQL); m != nil {
+		name = "db " + m[1]
+		attrs = append(attrs, attribute.String("db.operation.name", m[1]))
+	}
+
+	ctx, _ = Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+// TraceQueryEnd fecha o span com o resultado
+func (t *PgxTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+	} else {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	span.End()
+}
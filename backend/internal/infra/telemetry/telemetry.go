@@ -0,0 +1,99 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// =============================================================================
+// TELEMETRIA (OpenTelemetry)
+// Traces de requisições HTTP, use cases, queries pgx, cliente Asaas e jobs do
+// scheduler. O exportador é escolhido por OTEL_TRACES_EXPORTER:
+//   - none (padrão): nenhum provider é registrado; os spans viram no-op
+//   - otlp: OTLP/HTTP (OTEL_EXPORTER_OTLP_ENDPOINT, OTEL_EXPORTER_OTLP_HEADERS...)
+//   - stdout: imprime os spans no stdout, para depuração local
+// Amostragem segue OTEL_TRACES_SAMPLER/OTEL_TRACES_SAMPLER_ARG.
+// =============================================================================
+
+// InstrumentationName identifica os tracers da aplicação
+const InstrumentationName = "github.com/andviana23/barber-analytics-backend"
+
+// DefaultServiceName é usado quando OTEL_SERVICE_NAME não está definido
+const DefaultServiceName = "barber-analytics-backend"
+
+// Tracer retorna o tracer da aplicação (no-op se a telemetria estiver desligada)
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// Setup registra o TracerProvider global conforme as envs. A função retornada
+// descarrega os spans pendentes e deve ser chamada no encerramento.
+func Setup(ctx context.Context, logger *zap.Logger) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporterName := strings.ToLower(strings.TrimSpace(os.Getenv("OTEL_TRACES_EXPORTER")))
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch exporterName {
+	case "", "none":
+		logger.Info("Telemetria desligada (OTEL_TRACES_EXPORTER=none)")
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		exporter, err = otlptracehttp.New(ctx)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("OTEL_TRACES_EXPORTER inválido: %s (use otlp, stdout ou none)", exporterName)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar exportador de traces: %w", err)
+	}
+
+	attrs := []attribute.KeyValue{attribute.String("service.name", DefaultServiceName)}
+	if env := os.Getenv("ENV"); env != "" {
+		attrs = append(attrs, attribute.String("deployment.environment", env))
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attrs...),
+		resource.WithFromEnv(), // OTEL_SERVICE_NAME e OTEL_RESOURCE_ATTRIBUTES prevalecem
+		resource.WithTelemetrySDK(),
+		resource.WithHost(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao montar resource de telemetria: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	logger.Info("Telemetria habilitada", zap.String("exporter", exporterName))
+	return provider.Shutdown, nil
+}
+
+// RecordError marca o span como erro
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
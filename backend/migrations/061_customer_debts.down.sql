@@ -0,0 +1,9 @@
+-- Migration: 061_customer_debts (rollback)
+-- Description: Remove ledger de fiado
+
+DROP INDEX IF EXISTS idx_customer_debt_payments_debt;
+DROP TABLE IF EXISTS customer_debt_payments;
+
+DROP INDEX IF EXISTS idx_customer_debts_abertas;
+DROP INDEX IF EXISTS idx_customer_debts_customer;
+DROP TABLE IF EXISTS customer_debts;
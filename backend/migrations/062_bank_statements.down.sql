@@ -0,0 +1,9 @@
+-- Migration: 062_bank_statements (rollback)
+-- Description: Remove tabelas de importação de extratos bancários
+
+DROP INDEX IF EXISTS idx_bank_statement_lines_revisao;
+DROP INDEX IF EXISTS idx_bank_statement_lines_import;
+DROP TABLE IF EXISTS bank_statement_lines;
+
+DROP INDEX IF EXISTS idx_bank_statement_imports_tenant;
+DROP TABLE IF EXISTS bank_statement_imports;
@@ -0,0 +1,5 @@
+-- Migration: 063_plano_contas (rollback)
+-- Description: Remove o mapeamento do plano de contas contábil
+
+DROP INDEX IF EXISTS idx_plano_contas_mapeamento_tenant;
+DROP TABLE IF EXISTS plano_contas_mapeamento;
@@ -0,0 +1,26 @@
+-- Migration: 063_plano_contas
+-- Description: Mapeamento do plano de contas contábil (categorias, meios de pagamento
+--              e contas padrão) para a exportação de lançamentos ao contador.
+
+-- ============================================================================
+-- TABELA: plano_contas_mapeamento
+-- origem_tipo = CATEGORIA      → origem_chave = categorias.id
+-- origem_tipo = MEIO_PAGAMENTO → origem_chave = meios_pagamento.id
+-- origem_tipo = PADRAO         → origem_chave = chave fixa (CAIXA, BANCOS, ...)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS plano_contas_mapeamento (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    origem_tipo VARCHAR(20) NOT NULL CHECK (origem_tipo IN ('CATEGORIA', 'MEIO_PAGAMENTO', 'PADRAO')),
+    origem_chave VARCHAR(64) NOT NULL,
+    conta_codigo VARCHAR(30) NOT NULL,
+    conta_nome VARCHAR(150) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT uq_plano_contas_mapeamento_origem UNIQUE (tenant_id, origem_tipo, origem_chave)
+);
+
+CREATE INDEX IF NOT EXISTS idx_plano_contas_mapeamento_tenant
+    ON plano_contas_mapeamento(tenant_id);
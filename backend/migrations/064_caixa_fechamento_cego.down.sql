@@ -0,0 +1,22 @@
+-- Migration: 064_caixa_fechamento_cego (rollback)
+-- Description: Remove contagem, modo cego e aprovação do fechamento de caixa
+
+DROP INDEX IF EXISTS idx_caixa_diario_aberto_unico;
+
+UPDATE caixa_diario SET status = 'FECHADO' WHERE status = 'AGUARDANDO_APROVACAO';
+
+ALTER TABLE caixa_diario DROP CONSTRAINT IF EXISTS caixa_diario_status_check;
+ALTER TABLE caixa_diario ADD CONSTRAINT caixa_diario_status_check
+    CHECK (status IN ('ABERTO', 'FECHADO'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_caixa_diario_aberto_unico
+    ON caixa_diario(tenant_id)
+    WHERE status = 'ABERTO';
+
+ALTER TABLE caixa_diario
+    DROP COLUMN IF EXISTS data_aprovacao,
+    DROP COLUMN IF EXISTS usuario_aprovacao_id,
+    DROP COLUMN IF EXISTS contagem,
+    DROP COLUMN IF EXISTS fechamento_cego;
+
+ALTER TABLE operacoes_caixa DROP COLUMN IF EXISTS forma_pagamento;
@@ -0,0 +1,40 @@
+-- Migration: 064_caixa_fechamento_cego
+-- Description: Fechamento de caixa com contagem de cédulas/moedas, modo cego,
+--              aprovação gerencial de divergências e forma de pagamento das vendas.
+
+-- ============================================================================
+-- operacoes_caixa.forma_pagamento: tipo do meio de pagamento da VENDA
+-- (DINHEIRO, PIX, CREDITO, ...). NULL em registros antigos = dinheiro.
+-- ============================================================================
+
+ALTER TABLE operacoes_caixa
+    ADD COLUMN IF NOT EXISTS forma_pagamento VARCHAR(20);
+
+COMMENT ON COLUMN operacoes_caixa.forma_pagamento IS 'Para VENDA: tipo do meio de pagamento (NULL em registros antigos = dinheiro)';
+
+-- ============================================================================
+-- caixa_diario: modo cego, contagem e aprovação
+-- ============================================================================
+
+ALTER TABLE caixa_diario
+    ADD COLUMN IF NOT EXISTS fechamento_cego BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS contagem JSONB,
+    ADD COLUMN IF NOT EXISTS usuario_aprovacao_id UUID REFERENCES users(id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS data_aprovacao TIMESTAMPTZ;
+
+COMMENT ON COLUMN caixa_diario.fechamento_cego IS 'Oculta o saldo esperado do operador até a contagem ser enviada';
+COMMENT ON COLUMN caixa_diario.contagem IS 'Contagem da gaveta por denominação: [{"denominacao":"100.00","quantidade":3}]';
+COMMENT ON COLUMN caixa_diario.usuario_aprovacao_id IS 'Gerente que aprovou a divergência acima do limite';
+
+ALTER TABLE caixa_diario DROP CONSTRAINT IF EXISTS caixa_diario_status_check;
+ALTER TABLE caixa_diario ADD CONSTRAINT caixa_diario_status_check
+    CHECK (status IN ('ABERTO', 'AGUARDANDO_APROVACAO', 'FECHADO'));
+
+COMMENT ON COLUMN caixa_diario.status IS 'ABERTO, AGUARDANDO_APROVACAO (divergência acima do limite) ou FECHADO';
+
+-- Um caixa aguardando aprovação pode ser reaberto para recontagem,
+-- então também bloqueia a abertura de outro caixa
+DROP INDEX IF EXISTS idx_caixa_diario_aberto_unico;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_caixa_diario_aberto_unico
+    ON caixa_diario(tenant_id)
+    WHERE status IN ('ABERTO', 'AGUARDANDO_APROVACAO');
@@ -0,0 +1,18 @@
+-- Migration: 065_auth_sessions (rollback)
+-- Description: Volta refresh tokens em texto puro e remove sessões e bloqueio de login
+
+DROP TABLE IF EXISTS auth_login_throttle;
+
+DELETE FROM refresh_tokens;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_session;
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS used_at,
+    DROP COLUMN IF EXISTS token_hash,
+    DROP COLUMN IF EXISTS session_id;
+ALTER TABLE refresh_tokens ADD COLUMN token VARCHAR(500) NOT NULL UNIQUE;
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_token ON refresh_tokens(token);
+
+DROP INDEX IF EXISTS idx_auth_sessions_tenant_ativas;
+DROP INDEX IF EXISTS idx_auth_sessions_user_ativas;
+DROP TABLE IF EXISTS auth_sessions;
@@ -0,0 +1,64 @@
+-- Migration: 065_auth_sessions
+-- Description: Endurecimento do login — sessões por dispositivo, refresh tokens
+--              armazenados como hash (SHA-256) com rotação e detecção de reuso,
+--              e bloqueio progressivo de tentativas por conta e por IP.
+
+-- ============================================================================
+-- TABELA: auth_sessions
+-- Uma sessão por login (dispositivo). Todos os refresh tokens emitidos pela
+-- rotação pertencem à mesma sessão (família); revogar a sessão invalida todos.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS auth_sessions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+
+    -- Dispositivo
+    user_agent VARCHAR(255),
+    ip_address VARCHAR(45),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    -- Revogação: LOGOUT, REVOGADA (pelo usuário/dono) ou REUTILIZACAO (token repetido)
+    revoked_at TIMESTAMPTZ,
+    revoked_reason VARCHAR(20)
+        CHECK (revoked_reason IN ('LOGOUT', 'REVOGADA', 'REUTILIZACAO'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_user_ativas
+    ON auth_sessions(user_id) WHERE revoked_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_auth_sessions_tenant_ativas
+    ON auth_sessions(tenant_id) WHERE revoked_at IS NULL;
+
+-- ============================================================================
+-- refresh_tokens: hash em vez do token em texto puro
+-- Tokens antigos não podem ser convertidos para uma sessão; os usuários
+-- precisarão fazer login novamente após a migração.
+-- ============================================================================
+
+DELETE FROM refresh_tokens;
+
+DROP INDEX IF EXISTS idx_refresh_tokens_token;
+ALTER TABLE refresh_tokens DROP COLUMN token;
+
+ALTER TABLE refresh_tokens
+    ADD COLUMN session_id UUID NOT NULL REFERENCES auth_sessions(id) ON DELETE CASCADE,
+    ADD COLUMN token_hash CHAR(64) NOT NULL UNIQUE,
+    ADD COLUMN used_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_session ON refresh_tokens(session_id);
+
+-- ============================================================================
+-- TABELA: auth_login_throttle
+-- Contador de falhas de login por chave ("email:<email>" ou "ip:<endereço>")
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS auth_login_throttle (
+    chave VARCHAR(320) PRIMARY KEY,
+    falhas INTEGER NOT NULL DEFAULT 0,
+    ultima_falha TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    bloqueado_ate TIMESTAMPTZ
+);
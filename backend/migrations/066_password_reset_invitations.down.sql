@@ -0,0 +1,9 @@
+-- Migration: 066_password_reset_invitations (rollback)
+-- Description: Remove recuperação de senha e convites de colaboradores
+
+DROP INDEX IF EXISTS idx_user_invitations_tenant;
+DROP INDEX IF EXISTS idx_user_invitations_pendente_email;
+DROP TABLE IF EXISTS user_invitations;
+
+DROP INDEX IF EXISTS idx_password_reset_tokens_user_pendentes;
+DROP TABLE IF EXISTS password_reset_tokens;
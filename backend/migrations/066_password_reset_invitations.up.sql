@@ -0,0 +1,52 @@
+-- Migration: 066_password_reset_invitations
+-- Description: Recuperação de senha e convite de colaboradores. Os tokens são
+--              de uso único, expiram e são armazenados apenas como hash (SHA-256).
+
+-- ============================================================================
+-- TABELA: password_reset_tokens
+-- Um pedido de "esqueci minha senha". Um novo pedido invalida os anteriores.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    ip_address VARCHAR(45),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_reset_tokens_user_pendentes
+    ON password_reset_tokens(user_id) WHERE used_at IS NULL;
+
+-- ============================================================================
+-- TABELA: user_invitations
+-- Convite do dono/gerente para um novo colaborador. Ao aceitar, o convidado
+-- define a senha, o usuário é criado e vinculado à unidade com o papel do convite.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS user_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    nome VARCHAR(255) NOT NULL,
+    role VARCHAR(50) NOT NULL
+        CHECK (role IN ('MANAGER', 'RECEPTIONIST', 'BARBER')),
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_at TIMESTAMPTZ,
+    accepted_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    revoked_at TIMESTAMPTZ
+);
+
+-- Apenas um convite pendente por email no tenant (reenviar substitui o anterior)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_user_invitations_pendente_email
+    ON user_invitations(tenant_id, LOWER(email))
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_invitations_tenant
+    ON user_invitations(tenant_id, created_at DESC);
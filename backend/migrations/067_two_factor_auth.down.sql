@@ -0,0 +1,8 @@
+-- Migration: 067_two_factor_auth (rollback)
+-- Description: Remove autenticação em dois fatores
+
+DROP TABLE IF EXISTS tenant_auth_policies;
+DROP INDEX IF EXISTS idx_auth_mfa_challenges_expires;
+DROP TABLE IF EXISTS auth_mfa_challenges;
+DROP TABLE IF EXISTS user_recovery_codes;
+DROP TABLE IF EXISTS user_totp;
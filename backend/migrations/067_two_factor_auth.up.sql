@@ -0,0 +1,63 @@
+-- Migration: 067_two_factor_auth
+-- Description: Autenticação em dois fatores (TOTP, RFC 6238) com códigos de
+--              recuperação, segundo passo do login e política do tenant que
+--              obriga 2FA para proprietários e gerentes.
+
+-- ============================================================================
+-- TABELA: user_totp
+-- Segredo TOTP cifrado (AES-256-GCM). confirmed_at nulo = cadastro iniciado
+-- e ainda não confirmado com um código do aplicativo.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS user_totp (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret_enc TEXT NOT NULL,
+    confirmed_at TIMESTAMPTZ,
+    -- Último passo de 30s aceito: impede reutilizar o mesmo código
+    last_used_step BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ============================================================================
+-- TABELA: user_recovery_codes
+-- Códigos de uso único para quando o aplicativo autenticador não está à mão.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS user_recovery_codes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code_hash CHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_recovery_codes_unique UNIQUE (user_id, code_hash)
+);
+
+-- ============================================================================
+-- TABELA: auth_mfa_challenges
+-- Login com senha correta aguardando o segundo fator (5 minutos, 5 tentativas).
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS auth_mfa_challenges (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    tentativas INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_auth_mfa_challenges_expires
+    ON auth_mfa_challenges(expires_at);
+
+-- ============================================================================
+-- TABELA: tenant_auth_policies
+-- Políticas de autenticação do tenant. Sem linha = padrões (2FA opcional).
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS tenant_auth_policies (
+    tenant_id UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    exigir_2fa_admin BOOLEAN NOT NULL DEFAULT false,
+    atualizado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,4 @@
+-- Migration: 068_unit_scoped_sessions (rollback)
+-- Description: Remove a unidade ativa das sessões
+
+ALTER TABLE auth_sessions DROP COLUMN IF EXISTS unit_id;
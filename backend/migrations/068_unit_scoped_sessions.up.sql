@@ -0,0 +1,13 @@
+-- Migration: 068_unit_scoped_sessions
+-- Description: Unidade ativa por sessão. O access token passa a carregar a
+--              unidade ativa e o papel do usuário nela (user_units.role_override,
+--              ou users.role quando não houver).
+
+-- ============================================================================
+-- auth_sessions.unit_id
+-- Unidade ativa do dispositivo: definida no login (unidade padrão), alterada
+-- por POST /units/switch e preservada nos refreshes.
+-- ============================================================================
+
+ALTER TABLE auth_sessions
+    ADD COLUMN IF NOT EXISTS unit_id UUID REFERENCES units(id) ON DELETE SET NULL;
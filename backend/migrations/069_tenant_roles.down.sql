@@ -0,0 +1,7 @@
+-- Migration: 069_tenant_roles (rollback)
+-- Description: Remove papéis personalizados por tenant
+
+DROP INDEX IF EXISTS idx_user_units_custom_role;
+ALTER TABLE user_units DROP COLUMN IF EXISTS custom_role_id;
+DROP INDEX IF EXISTS uq_tenant_roles_nome;
+DROP TABLE IF EXISTS tenant_roles;
@@ -0,0 +1,35 @@
+-- Migration: 069_tenant_roles
+-- Description: Papéis personalizados por tenant. Cada papel parte de um papel
+--              fixo (base_role, usado pelas rotas ainda protegidas por papel) e
+--              define o próprio conjunto de permissões nomeadas.
+
+-- ============================================================================
+-- TABELA: tenant_roles
+-- permissoes guarda os códigos do catálogo (ex.: caixa.sangria, financial.dre.read)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS tenant_roles (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    nome VARCHAR(60) NOT NULL,
+    descricao VARCHAR(255),
+    base_role VARCHAR(20) NOT NULL
+        CHECK (base_role IN ('MANAGER', 'RECEPTIONIST', 'BARBER', 'ACCOUNTANT')),
+    permissoes TEXT[] NOT NULL DEFAULT '{}',
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_tenant_roles_nome
+    ON tenant_roles(tenant_id, LOWER(nome));
+
+-- ============================================================================
+-- user_units.custom_role_id
+-- Papel personalizado do usuário na unidade; prevalece sobre role_override
+-- ============================================================================
+
+ALTER TABLE user_units
+    ADD COLUMN IF NOT EXISTS custom_role_id UUID REFERENCES tenant_roles(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_user_units_custom_role
+    ON user_units(custom_role_id) WHERE custom_role_id IS NOT NULL;
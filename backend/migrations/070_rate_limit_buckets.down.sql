@@ -0,0 +1,5 @@
+-- Migration: 070_rate_limit_buckets (rollback)
+-- Description: Remove o armazenamento compartilhado do rate limiting
+
+DROP INDEX IF EXISTS idx_rate_limit_buckets_expira;
+DROP TABLE IF EXISTS rate_limit_buckets;
@@ -0,0 +1,20 @@
+-- Migration: 070_rate_limit_buckets
+-- Description: Armazenamento compartilhado do rate limiting (token bucket).
+--              Os contadores sobrevivem a deploys e valem para todas as réplicas.
+
+-- ============================================================================
+-- TABELA: rate_limit_buckets
+-- chave = política + dimensão (ex.: login:ip:203.0.113.7, api:user:<uuid>)
+-- UNLOGGED: contadores são descartáveis e não precisam de WAL/réplica
+-- ============================================================================
+
+CREATE UNLOGGED TABLE IF NOT EXISTS rate_limit_buckets (
+    chave VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    permitido BOOLEAN NOT NULL DEFAULT TRUE,
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expira_em TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_expira
+    ON rate_limit_buckets(expira_em);
@@ -0,0 +1,6 @@
+-- Migration: 071_api_keys (rollback)
+-- Description: Remove as chaves de API dos tenants
+
+DROP INDEX IF EXISTS idx_api_keys_tenant;
+DROP INDEX IF EXISTS uq_api_keys_key_hash;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,32 @@
+-- Migration: 071_api_keys
+-- Description: Chaves de API por tenant para integrações (BI, bots). Só o hash
+--              SHA-256 da chave é gravado; o valor aparece uma única vez, na
+--              criação.
+
+-- ============================================================================
+-- TABELA: api_keys
+-- escopos: financial:read, appointments:read, appointments:write, customers:read
+-- unit_id NULL = a integração informa a unidade no header X-Unit-ID
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID REFERENCES units(id) ON DELETE CASCADE,
+    nome VARCHAR(60) NOT NULL,
+    prefixo VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    escopos TEXT[] NOT NULL DEFAULT '{}',
+    criado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    expira_em TIMESTAMPTZ,
+    ultimo_uso_em TIMESTAMPTZ,
+    ultimo_uso_ip VARCHAR(45),
+    revogada_em TIMESTAMPTZ,
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_api_keys_key_hash
+    ON api_keys(key_hash);
+
+CREATE INDEX IF NOT EXISTS idx_api_keys_tenant
+    ON api_keys(tenant_id, criado_em DESC);
@@ -0,0 +1,12 @@
+-- Migration: 072_webhook_endpoints (rollback)
+-- Description: Remove os webhooks de saída
+
+DROP INDEX IF EXISTS idx_webhook_delivery_attempts_delivery;
+DROP TABLE IF EXISTS webhook_delivery_attempts;
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_endpoint;
+DROP INDEX IF EXISTS idx_webhook_deliveries_pendentes;
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP INDEX IF EXISTS idx_webhook_endpoints_tenant;
+DROP TABLE IF EXISTS webhook_endpoints;
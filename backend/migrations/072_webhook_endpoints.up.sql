@@ -0,0 +1,80 @@
+-- Migration: 072_webhook_endpoints
+-- Description: Webhooks de saída. O tenant cadastra endpoints HTTPS e escolhe
+--              os eventos; cada evento gera uma entrega por endpoint (outbox),
+--              enviada pelo scheduler com assinatura HMAC e retentativas.
+
+-- ============================================================================
+-- TABELA: webhook_endpoints
+-- eventos: appointment.created, appointment.status_changed, command.closed,
+--          caixa.closed, subscription.overdue, stock.below_minimum
+-- secret_cifrado: segredo HMAC cifrado (AES-GCM), exibido uma única vez
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS webhook_endpoints (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    url VARCHAR(500) NOT NULL,
+    descricao VARCHAR(120),
+    eventos TEXT[] NOT NULL DEFAULT '{}',
+    secret_cifrado TEXT NOT NULL,
+    ativo BOOLEAN NOT NULL DEFAULT TRUE,
+    criado_por UUID REFERENCES users(id) ON DELETE SET NULL,
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_webhook_endpoints_https CHECK (url LIKE 'https://%')
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_endpoints_tenant
+    ON webhook_endpoints(tenant_id)
+    WHERE ativo;
+
+-- ============================================================================
+-- TABELA: webhook_deliveries
+-- Uma linha por (evento, endpoint). event_id é o mesmo para todos os endpoints
+-- do evento e permite idempotência no receptor.
+-- status: PENDENTE (aguardando envio ou retentativa), ENTREGUE, FALHOU
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    endpoint_id UUID NOT NULL REFERENCES webhook_endpoints(id) ON DELETE CASCADE,
+    evento VARCHAR(60) NOT NULL,
+    event_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDENTE',
+    tentativas INTEGER NOT NULL DEFAULT 0,
+    proxima_tentativa_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ultimo_status_http INTEGER,
+    ultimo_erro TEXT,
+    entregue_em TIMESTAMPTZ,
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    atualizado_em TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_webhook_deliveries_status CHECK (status IN ('PENDENTE', 'ENTREGUE', 'FALHOU'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pendentes
+    ON webhook_deliveries(proxima_tentativa_em)
+    WHERE status = 'PENDENTE';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_endpoint
+    ON webhook_deliveries(endpoint_id, criado_em DESC);
+
+-- ============================================================================
+-- TABELA: webhook_delivery_attempts
+-- Histórico de cada tentativa (código HTTP, erro, trecho da resposta)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS webhook_delivery_attempts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    delivery_id UUID NOT NULL REFERENCES webhook_deliveries(id) ON DELETE CASCADE,
+    tentativa INTEGER NOT NULL,
+    status_http INTEGER,
+    erro TEXT,
+    resposta TEXT,
+    duracao_ms INTEGER NOT NULL DEFAULT 0,
+    criado_em TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_delivery_attempts_delivery
+    ON webhook_delivery_attempts(delivery_id, tentativa);
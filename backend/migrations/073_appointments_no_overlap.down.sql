@@ -0,0 +1,5 @@
+-- Migration: 073_appointments_no_overlap (rollback)
+-- Description: Remove a proteção de sobreposição no banco. A extensão
+--              btree_gist é mantida.
+
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_no_overlap;
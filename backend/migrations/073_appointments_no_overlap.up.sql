@@ -0,0 +1,38 @@
+-- Migration: 073_appointments_no_overlap
+-- Description: Garante no banco que um profissional não tenha dois agendamentos
+--              ativos sobrepostos. A verificação prévia do use case continua
+--              (mensagem amigável), mas duas reservas simultâneas no mesmo
+--              horário não passam mais: a segunda recebe 23P01.
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+-- A constraint não pode ser criada com sobreposições já gravadas; aborta com a
+-- contagem para que sejam resolvidas (cancelar ou remarcar) antes de reaplicar
+DO $$
+DECLARE
+    conflitos BIGINT;
+BEGIN
+    SELECT COUNT(*) INTO conflitos
+    FROM appointments a
+    JOIN appointments b
+      ON a.tenant_id = b.tenant_id
+     AND a.professional_id = b.professional_id
+     AND a.id < b.id
+     AND a.start_time < b.end_time
+     AND b.start_time < a.end_time
+    WHERE a.status NOT IN ('CANCELED', 'NO_SHOW')
+      AND b.status NOT IN ('CANCELED', 'NO_SHOW');
+
+    IF conflitos > 0 THEN
+        RAISE EXCEPTION 'existem % pares de agendamentos ativos sobrepostos; resolva-os antes de aplicar a migration 073', conflitos;
+    END IF;
+END $$;
+
+-- Intervalo semiaberto [início, fim): agendamentos encostados não conflitam
+ALTER TABLE appointments
+    ADD CONSTRAINT appointments_no_overlap
+    EXCLUDE USING gist (
+        tenant_id WITH =,
+        professional_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (status NOT IN ('CANCELED', 'NO_SHOW'));
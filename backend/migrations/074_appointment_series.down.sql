@@ -0,0 +1,10 @@
+-- Migration: 074_appointment_series (rollback)
+-- Description: Remove as séries de agendamentos recorrentes. Os agendamentos
+--              já gerados permanecem.
+
+DROP INDEX IF EXISTS idx_appointment_series_occurrences_appointment;
+DROP TABLE IF EXISTS appointment_series_occurrences;
+
+DROP INDEX IF EXISTS idx_appointment_series_active;
+DROP INDEX IF EXISTS idx_appointment_series_customer;
+DROP TABLE IF EXISTS appointment_series;
@@ -0,0 +1,66 @@
+-- Migration: 074_appointment_series
+-- Description: Séries de agendamentos recorrentes (cliente fixo, mesmo
+--              profissional e horário a cada N semanas). As ocorrências são
+--              geradas com antecedência pelos fluxos normais de agendamento e
+--              cada uma registra se virou agendamento ou se deu conflito.
+
+-- ============================================================================
+-- TABELA: appointment_series
+-- start_time: primeira ocorrência; define dia da semana e horário da série
+-- interval_weeks: 1 = semanal, 2 = quinzenal ... 8
+-- ends_on: último dia em que pode haver ocorrência (NULL = sem fim)
+-- generated_until: até onde as ocorrências já foram geradas
+-- status: ACTIVE, ENDED
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS appointment_series (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    professional_id UUID NOT NULL REFERENCES profissionais(id) ON DELETE RESTRICT,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE RESTRICT,
+    service_ids UUID[] NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    interval_weeks INTEGER NOT NULL CHECK (interval_weeks BETWEEN 1 AND 8),
+    ends_on DATE,
+    notes TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'ENDED')),
+    ended_reason TEXT,
+    ended_at TIMESTAMPTZ,
+    generated_until TIMESTAMPTZ,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_appointment_series_services CHECK (cardinality(service_ids) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_series_customer
+    ON appointment_series(tenant_id, customer_id);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_series_active
+    ON appointment_series(generated_until)
+    WHERE status = 'ACTIVE';
+
+-- ============================================================================
+-- TABELA: appointment_series_occurrences
+-- Uma linha por ocorrência gerada (occurrence_index 0, 1, 2 ...).
+-- status: BOOKED (appointment_id preenchido), CONFLICT (horário ocupado ou
+--         bloqueado; detail traz o motivo), SKIPPED (horário já passou)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS appointment_series_occurrences (
+    series_id UUID NOT NULL REFERENCES appointment_series(id) ON DELETE CASCADE,
+    occurrence_index INTEGER NOT NULL CHECK (occurrence_index >= 0),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    scheduled_start TIMESTAMPTZ NOT NULL,
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('BOOKED', 'CONFLICT', 'SKIPPED')),
+    detail TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (series_id, occurrence_index)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_appointment_series_occurrences_appointment
+    ON appointment_series_occurrences(appointment_id)
+    WHERE appointment_id IS NOT NULL;
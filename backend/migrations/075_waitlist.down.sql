@@ -0,0 +1,12 @@
+-- Migration: 075_waitlist (rollback)
+-- Description: Remove a lista de espera. Agendamentos confirmados por ofertas
+--              permanecem.
+
+DROP INDEX IF EXISTS idx_waitlist_offers_entry;
+DROP INDEX IF EXISTS idx_waitlist_offers_expiring;
+DROP INDEX IF EXISTS idx_waitlist_offers_pending_slot;
+DROP TABLE IF EXISTS waitlist_offers;
+
+DROP INDEX IF EXISTS idx_waitlist_entries_customer;
+DROP INDEX IF EXISTS idx_waitlist_entries_waiting;
+DROP TABLE IF EXISTS waitlist_entries;
@@ -0,0 +1,81 @@
+-- Migration: 075_waitlist
+-- Description: Lista de espera. Quando um horário é liberado (cancelamento,
+--              remarcação ou não comparecimento), os clientes compatíveis
+--              recebem, um de cada vez e por prioridade, uma oferta com prazo
+--              para confirmar o horário em um clique.
+
+-- ============================================================================
+-- TABELA: waitlist_entries
+-- professional_ids: profissionais aceitos (vazio = qualquer profissional)
+-- window_start/window_end: período em que o cliente aceita ser atendido
+-- priority: maior primeiro; empate pela ordem de entrada
+-- status: WAITING, OFFERED (oferta pendente), FULFILLED, CANCELED, EXPIRED
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS waitlist_entries (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE CASCADE,
+    service_ids UUID[] NOT NULL,
+    professional_ids UUID[] NOT NULL DEFAULT '{}',
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    notes TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'WAITING'
+        CHECK (status IN ('WAITING', 'OFFERED', 'FULFILLED', 'CANCELED', 'EXPIRED')),
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_waitlist_entries_services CHECK (cardinality(service_ids) > 0),
+    CONSTRAINT chk_waitlist_entries_window CHECK (window_end > window_start)
+);
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_waiting
+    ON waitlist_entries(tenant_id, unit_id, priority DESC, created_at)
+    WHERE status = 'WAITING';
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_entries_customer
+    ON waitlist_entries(tenant_id, customer_id);
+
+-- ============================================================================
+-- TABELA: waitlist_offers
+-- slot_start/slot_end: horário liberado (passa para o próximo da fila se a
+--                      oferta expirar ou for recusada)
+-- start_time/end_time: agendamento oferecido (duração dos serviços do cliente)
+-- token_hash: SHA-256 do token do link de confirmação
+-- status: PENDING, CLAIMED, DECLINED, EXPIRED, CANCELED
+-- Só uma oferta pendente por horário liberado.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS waitlist_offers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    entry_id UUID NOT NULL REFERENCES waitlist_entries(id) ON DELETE CASCADE,
+    professional_id UUID NOT NULL REFERENCES profissionais(id) ON DELETE CASCADE,
+    slot_start TIMESTAMPTZ NOT NULL,
+    slot_end TIMESTAMPTZ NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'CLAIMED', 'DECLINED', 'EXPIRED', 'CANCELED')),
+    appointment_id UUID REFERENCES appointments(id) ON DELETE SET NULL,
+    responded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_waitlist_offers_pending_slot
+    ON waitlist_offers(tenant_id, professional_id, slot_start)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_offers_expiring
+    ON waitlist_offers(expires_at)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_waitlist_offers_entry
+    ON waitlist_offers(entry_id);
@@ -0,0 +1,11 @@
+-- Migration: 076_no_show_policy (rollback)
+-- Description: Remove a política de não comparecimento e os sinais. Pagamentos
+--              já creditados nas comandas e receitas retidas permanecem.
+
+DROP INDEX IF EXISTS idx_appointments_customer_start;
+
+DROP INDEX IF EXISTS idx_appointment_deposits_customer;
+DROP INDEX IF EXISTS idx_appointment_deposits_pending_due;
+DROP TABLE IF EXISTS appointment_deposits;
+
+DROP TABLE IF EXISTS no_show_policies;
@@ -0,0 +1,66 @@
+-- Migration: 076_no_show_policy
+-- Description: Política de não comparecimento por tenant. Clientes que
+--              faltaram max_no_shows vezes nos últimos window_days dias pagam
+--              um sinal via PIX (Asaas) ao agendar. O sinal é creditado na
+--              comanda quando o cliente comparece ou retido como receita
+--              quando ele falta.
+
+-- ============================================================================
+-- TABELA: no_show_policies (uma por tenant; ausente = política desativada)
+-- deposit_type: FIXED (deposit_value em R$) ou PERCENTAGE (% do agendamento)
+-- payment_deadline_hours: prazo para pagar após o agendamento, limitado ao
+--                         início do atendimento
+-- meio_pagamento_id: meio usado para creditar o sinal na comanda
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS no_show_policies (
+    tenant_id UUID PRIMARY KEY REFERENCES tenants(id) ON DELETE CASCADE,
+    enabled BOOLEAN NOT NULL DEFAULT false,
+    max_no_shows INTEGER NOT NULL DEFAULT 2 CHECK (max_no_shows > 0),
+    window_days INTEGER NOT NULL DEFAULT 90 CHECK (window_days > 0),
+    deposit_type VARCHAR(10) NOT NULL DEFAULT 'PERCENTAGE'
+        CHECK (deposit_type IN ('FIXED', 'PERCENTAGE')),
+    deposit_value NUMERIC(10,2) NOT NULL DEFAULT 30 CHECK (deposit_value > 0),
+    payment_deadline_hours INTEGER NOT NULL DEFAULT 2 CHECK (payment_deadline_hours > 0),
+    meio_pagamento_id UUID REFERENCES meios_pagamento(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- ============================================================================
+-- TABELA: appointment_deposits
+-- status: PENDING (aguardando PIX), PAID, CREDITED (na comanda),
+--         RETAINED (receita por não comparecimento), CANCELED, EXPIRED
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS appointment_deposits (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE RESTRICT,
+    appointment_id UUID NOT NULL UNIQUE REFERENCES appointments(id) ON DELETE CASCADE,
+    customer_id UUID NOT NULL REFERENCES clientes(id) ON DELETE CASCADE,
+    amount NUMERIC(10,2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'PAID', 'CREDITED', 'RETAINED', 'CANCELED', 'EXPIRED')),
+    asaas_payment_id VARCHAR(100) UNIQUE,
+    invoice_url TEXT,
+    pix_payload TEXT,
+    due_at TIMESTAMPTZ NOT NULL,
+    paid_at TIMESTAMPTZ,
+    settled_at TIMESTAMPTZ,
+    command_payment_id UUID REFERENCES command_payments(id) ON DELETE SET NULL,
+    conta_receber_id UUID REFERENCES contas_a_receber(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_deposits_pending_due
+    ON appointment_deposits(due_at)
+    WHERE status = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS idx_appointment_deposits_customer
+    ON appointment_deposits(tenant_id, customer_id);
+
+-- Contagem de faltas por cliente (política e índice de confiabilidade)
+CREATE INDEX IF NOT EXISTS idx_appointments_customer_start
+    ON appointments(tenant_id, customer_id, start_time);
@@ -0,0 +1,25 @@
+-- Migration: 077_appointment_service_professionals (rollback)
+-- Description: Volta a proteção de sobreposição para appointments. Falha se
+--              houver agendamentos com vários profissionais sobrepostos na
+--              agenda do responsável; resolva-os antes de reverter.
+
+DROP TRIGGER IF EXISTS trg_appointments_sync_services_active ON appointments;
+DROP FUNCTION IF EXISTS sync_appointment_services_active();
+
+ALTER TABLE appointment_services DROP CONSTRAINT IF EXISTS appointment_services_no_overlap;
+ALTER TABLE appointment_services DROP CONSTRAINT IF EXISTS appointment_services_time_check;
+
+ALTER TABLE appointments
+    ADD CONSTRAINT appointments_no_overlap
+    EXCLUDE USING gist (
+        tenant_id WITH =,
+        professional_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (status NOT IN ('CANCELED', 'NO_SHOW'));
+
+ALTER TABLE appointment_services
+    DROP COLUMN IF EXISTS active,
+    DROP COLUMN IF EXISTS end_time,
+    DROP COLUMN IF EXISTS start_time,
+    DROP COLUMN IF EXISTS sequence,
+    DROP COLUMN IF EXISTS professional_id;
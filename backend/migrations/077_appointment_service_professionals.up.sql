@@ -0,0 +1,78 @@
+-- Migration: 077_appointment_service_professionals
+-- Description: Cada serviço do agendamento passa a ter profissional, ordem e
+--              horário próprios (ex.: corte com João seguido de barba com
+--              Pedro no mesmo agendamento). A proteção de sobreposição sai de
+--              appointments e vai para appointment_services: cada profissional
+--              fica ocupado apenas no trecho dos seus serviços.
+--              appointments.professional_id segue como o responsável (o
+--              profissional do primeiro serviço).
+
+ALTER TABLE appointment_services
+    ADD COLUMN IF NOT EXISTS professional_id UUID REFERENCES profissionais(id) ON DELETE RESTRICT,
+    ADD COLUMN IF NOT EXISTS sequence INTEGER,
+    ADD COLUMN IF NOT EXISTS start_time TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS end_time TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS active BOOLEAN NOT NULL DEFAULT TRUE;
+
+-- Serviços já gravados: profissional do agendamento, em sequência a partir do
+-- início (limitados ao fim do agendamento, que pode ter sido encurtado)
+WITH ordenados AS (
+    SELECT
+        aps.appointment_id,
+        aps.service_id,
+        a.professional_id,
+        a.status,
+        a.end_time AS fim_agendamento,
+        ROW_NUMBER() OVER w AS seq,
+        a.start_time + ((SUM(aps.duration_at_booking) OVER w) - aps.duration_at_booking) * interval '1 minute' AS inicio,
+        a.start_time + (SUM(aps.duration_at_booking) OVER w) * interval '1 minute' AS fim
+    FROM appointment_services aps
+    JOIN appointments a ON a.id = aps.appointment_id
+    WINDOW w AS (PARTITION BY aps.appointment_id ORDER BY aps.created_at, aps.service_id)
+)
+UPDATE appointment_services aps
+SET professional_id = o.professional_id,
+    sequence = o.seq,
+    start_time = LEAST(o.inicio, o.fim_agendamento),
+    end_time = LEAST(o.fim, o.fim_agendamento),
+    active = o.status NOT IN ('CANCELED', 'NO_SHOW')
+FROM ordenados o
+WHERE aps.appointment_id = o.appointment_id
+  AND aps.service_id = o.service_id;
+
+ALTER TABLE appointment_services
+    ALTER COLUMN professional_id SET NOT NULL,
+    ALTER COLUMN sequence SET NOT NULL,
+    ALTER COLUMN start_time SET NOT NULL,
+    ALTER COLUMN end_time SET NOT NULL,
+    ADD CONSTRAINT appointment_services_time_check CHECK (end_time >= start_time);
+
+-- Sem sobreposição entre serviços ativos do mesmo profissional. Os dados
+-- migrados não se sobrepõem: vêm de agendamentos já protegidos pela 073.
+ALTER TABLE appointments DROP CONSTRAINT IF EXISTS appointments_no_overlap;
+
+ALTER TABLE appointment_services
+    ADD CONSTRAINT appointment_services_no_overlap
+    EXCLUDE USING gist (
+        professional_id WITH =,
+        tstzrange(start_time, end_time, '[)') WITH &&
+    ) WHERE (active);
+
+-- active espelha o status do agendamento, inclusive nas atualizações em lote
+-- (ex.: cancelamento das ocorrências futuras de uma série)
+CREATE OR REPLACE FUNCTION sync_appointment_services_active()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE appointment_services
+    SET active = NEW.status NOT IN ('CANCELED', 'NO_SHOW')
+    WHERE appointment_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_appointments_sync_services_active ON appointments;
+CREATE TRIGGER trg_appointments_sync_services_active
+    AFTER UPDATE OF status ON appointments
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status)
+    EXECUTE FUNCTION sync_appointment_services_active();
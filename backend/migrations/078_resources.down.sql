@@ -0,0 +1,11 @@
+-- Migration: 078_resources (rollback)
+-- Description: Remove os recursos da unidade e as reservas dos agendamentos.
+
+DROP INDEX IF EXISTS idx_appointment_resources_resource;
+DROP TABLE IF EXISTS appointment_resources;
+
+DROP INDEX IF EXISTS idx_service_resources_resource;
+DROP TABLE IF EXISTS service_resources;
+
+DROP INDEX IF EXISTS idx_resources_unit_nome;
+DROP TABLE IF EXISTS resources;
@@ -0,0 +1,64 @@
+-- Migration: 078_resources
+-- Description: Recursos físicos da unidade (cadeiras, salas, lavatório) com
+--              capacidade. Serviços declaram os recursos de que precisam e
+--              por quanto tempo; o agendamento reserva esses recursos e é
+--              recusado quando a capacidade se esgota no horário.
+
+-- ============================================================================
+-- TABELA: resources
+-- capacity: atendimentos simultâneos que o recurso comporta (ex.: 3 cadeiras)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS resources (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    nome VARCHAR(100) NOT NULL,
+    capacity INTEGER NOT NULL CHECK (capacity > 0),
+    ativo BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_resources_unit_nome
+    ON resources(tenant_id, unit_id, lower(nome))
+    WHERE ativo;
+
+-- ============================================================================
+-- TABELA: service_resources
+-- offset_minutes: quando o uso começa, contado do início do serviço
+-- duration_minutes: por quanto tempo (NULL = até o fim do serviço)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS service_resources (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    servico_id UUID NOT NULL REFERENCES servicos(id) ON DELETE CASCADE,
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE CASCADE,
+    offset_minutes INTEGER NOT NULL DEFAULT 0 CHECK (offset_minutes >= 0),
+    duration_minutes INTEGER CHECK (duration_minutes > 0),
+    PRIMARY KEY (servico_id, resource_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_service_resources_resource
+    ON service_resources(resource_id);
+
+-- ============================================================================
+-- TABELA: appointment_resources
+-- Recursos reservados por serviço do agendamento, copiados da configuração do
+-- serviço no momento da reserva. O horário de uso é derivado do horário do
+-- serviço (appointment_services), então acompanha remarcações e deixa de
+-- contar quando o agendamento é cancelado ou marcado como falta.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS appointment_resources (
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    service_id UUID NOT NULL REFERENCES servicos(id) ON DELETE RESTRICT,
+    resource_id UUID NOT NULL REFERENCES resources(id) ON DELETE RESTRICT,
+    offset_minutes INTEGER NOT NULL DEFAULT 0 CHECK (offset_minutes >= 0),
+    duration_minutes INTEGER CHECK (duration_minutes > 0),
+    PRIMARY KEY (appointment_id, service_id, resource_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_resources_resource
+    ON appointment_resources(tenant_id, resource_id);
@@ -0,0 +1,6 @@
+-- Migration: 079_walk_in_queue (rollback)
+-- Description: Remove a fila de encaixe.
+
+DROP INDEX IF EXISTS idx_walk_ins_unit_day;
+DROP INDEX IF EXISTS idx_walk_ins_waiting;
+DROP TABLE IF EXISTS walk_ins;
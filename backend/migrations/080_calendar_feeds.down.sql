@@ -0,0 +1,6 @@
+-- Migration: 080_calendar_feeds (rollback)
+-- Description: Remove os feeds iCalendar.
+
+DROP INDEX IF EXISTS idx_calendar_feeds_unit;
+DROP INDEX IF EXISTS idx_calendar_feeds_token;
+DROP TABLE IF EXISTS calendar_feeds;
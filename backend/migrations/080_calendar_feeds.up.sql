@@ -0,0 +1,30 @@
+-- Migration: 080_calendar_feeds
+-- Description: Feeds iCalendar (.ics) somente leitura para assinar a agenda
+--              no celular (Google Agenda, Apple Calendar, Outlook). Cada feed
+--              é protegido por um token aleatório; só o hash vai para o banco
+--              e o feed pode ser revogado a qualquer momento.
+
+-- ============================================================================
+-- TABELA: calendar_feeds
+-- professional_id: agenda do profissional em todas as unidades
+-- professional_id NULL: agenda da unidade inteira (unit_id)
+-- token_hash: SHA-256 (hex) do token do link
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    unit_id UUID NOT NULL REFERENCES units(id) ON DELETE CASCADE,
+    professional_id UUID REFERENCES profissionais(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    last_accessed_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_feeds_token
+    ON calendar_feeds(token_hash);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_feeds_unit
+    ON calendar_feeds(tenant_id, unit_id, created_at DESC);
@@ -0,0 +1,11 @@
+-- Migration: 081_calendar_sync (rollback)
+-- Description: Remove a sincronização com calendários externos. Os bloqueios
+--              importados permanecem como bloqueios comuns.
+
+DROP INDEX IF EXISTS idx_calendar_sync_queue_due;
+DROP TABLE IF EXISTS calendar_sync_queue;
+DROP INDEX IF EXISTS idx_calendar_imported_events_blocked;
+DROP TABLE IF EXISTS calendar_imported_events;
+DROP INDEX IF EXISTS idx_calendar_event_links_appointment;
+DROP TABLE IF EXISTS calendar_event_links;
+DROP TABLE IF EXISTS calendar_connections;
@@ -0,0 +1,89 @@
+-- Migration: 081_calendar_sync
+-- Description: Sincronização da agenda com calendários externos (Google
+--              Agenda). Cada profissional conecta a própria conta (OAuth);
+--              os agendamentos são enviados ao calendário dele e os eventos
+--              ocupados do calendário viram bloqueios de horário.
+
+-- ============================================================================
+-- TABELA: calendar_connections
+-- Credenciais OAuth por profissional. access_token e refresh_token são
+-- gravados cifrados (AES-256-GCM).
+-- sync_token: token incremental do provedor (só as mudanças desde a última
+--             leitura); vazio força leitura completa
+-- status: ACTIVE ou ERROR (acesso revogado na conta externa: reconectar)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS calendar_connections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    professional_id UUID NOT NULL REFERENCES profissionais(id) ON DELETE CASCADE,
+    provider VARCHAR(20) NOT NULL CHECK (provider IN ('GOOGLE')),
+    calendar_id VARCHAR(255) NOT NULL DEFAULT 'primary',
+    account_email VARCHAR(255),
+    access_token TEXT NOT NULL,
+    refresh_token TEXT NOT NULL,
+    token_expires_at TIMESTAMPTZ NOT NULL,
+    sync_token TEXT,
+    status VARCHAR(10) NOT NULL DEFAULT 'ACTIVE' CHECK (status IN ('ACTIVE', 'ERROR')),
+    last_error TEXT,
+    last_synced_at TIMESTAMPTZ,
+    full_synced_at TIMESTAMPTZ,
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_calendar_connections_professional UNIQUE (tenant_id, professional_id, provider)
+);
+
+-- ============================================================================
+-- TABELA: calendar_event_links
+-- Eventos criados no calendário externo: um por trecho do agendamento
+-- (slot_index = posição do trecho do profissional no agendamento)
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS calendar_event_links (
+    connection_id UUID NOT NULL REFERENCES calendar_connections(id) ON DELETE CASCADE,
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    slot_index INTEGER NOT NULL,
+    external_event_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (connection_id, appointment_id, slot_index)
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_event_links_appointment
+    ON calendar_event_links(appointment_id);
+
+-- ============================================================================
+-- TABELA: calendar_imported_events
+-- Eventos ocupados do calendário externo importados como bloqueio
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS calendar_imported_events (
+    connection_id UUID NOT NULL REFERENCES calendar_connections(id) ON DELETE CASCADE,
+    external_event_id VARCHAR(255) NOT NULL,
+    blocked_time_id UUID NOT NULL REFERENCES blocked_times(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (connection_id, external_event_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_calendar_imported_events_blocked
+    ON calendar_imported_events(blocked_time_id);
+
+-- ============================================================================
+-- TABELA: calendar_sync_queue
+-- Agendamentos a enviar aos calendários externos. O envio acontece fora da
+-- requisição e reconcilia o estado atual do agendamento (criar, atualizar ou
+-- remover eventos); falhas são retentadas com espera crescente.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS calendar_sync_queue (
+    appointment_id UUID PRIMARY KEY REFERENCES appointments(id) ON DELETE CASCADE,
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_calendar_sync_queue_due
+    ON calendar_sync_queue(next_attempt_at);
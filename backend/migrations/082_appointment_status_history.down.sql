@@ -0,0 +1,7 @@
+-- Migration: 082_appointment_status_history (rollback)
+-- Description: Remove o histórico de status dos agendamentos
+
+DROP TRIGGER IF EXISTS trg_appointment_status_history_append_only ON appointment_status_history;
+DROP FUNCTION IF EXISTS appointment_status_history_append_only();
+DROP INDEX IF EXISTS idx_appointment_status_history_appointment;
+DROP TABLE IF EXISTS appointment_status_history;
@@ -0,0 +1,52 @@
+-- Migration: 082_appointment_status_history
+-- Description: Histórico das mudanças de status dos agendamentos (quem,
+--              quando e por quê). Base dos indicadores de tempo de espera,
+--              pontualidade e antecedência dos cancelamentos.
+
+-- ============================================================================
+-- TABELA: appointment_status_history
+-- Somente inclusão: UPDATE e DELETE diretos são rejeitados pelo trigger.
+-- Exclusões em cascata (agendamento ou tenant removido) e o SET NULL do
+-- usuário removido continuam permitidos.
+-- actor_id: usuário que fez a mudança; NULL para jobs e webhooks
+-- Agendamentos anteriores a esta migration não têm histórico.
+-- ============================================================================
+
+CREATE TABLE IF NOT EXISTS appointment_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL REFERENCES tenants(id) ON DELETE CASCADE,
+    appointment_id UUID NOT NULL REFERENCES appointments(id) ON DELETE CASCADE,
+    from_status VARCHAR(20) NOT NULL,
+    to_status VARCHAR(20) NOT NULL
+        CHECK (to_status IN ('CREATED', 'CONFIRMED', 'CHECKED_IN', 'IN_SERVICE', 'AWAITING_PAYMENT', 'DONE', 'NO_SHOW', 'CANCELED')),
+    actor_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    reason TEXT,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_appointment_status_history_appointment
+    ON appointment_status_history(appointment_id, changed_at);
+
+-- ============================================================================
+-- TRIGGER: somente inclusão
+-- pg_trigger_depth() > 1 indica ação referencial (cascade / set null)
+-- ============================================================================
+
+CREATE OR REPLACE FUNCTION appointment_status_history_append_only()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF pg_trigger_depth() > 1 THEN
+        IF TG_OP = 'DELETE' THEN
+            RETURN OLD;
+        END IF;
+        RETURN NEW;
+    END IF;
+    RAISE EXCEPTION 'histórico de status do agendamento não pode ser alterado';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_appointment_status_history_append_only ON appointment_status_history;
+CREATE TRIGGER trg_appointment_status_history_append_only
+    BEFORE UPDATE OR DELETE ON appointment_status_history
+    FOR EACH ROW
+    EXECUTE FUNCTION appointment_status_history_append_only();